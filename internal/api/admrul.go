@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/pyhub-apps/pyhub-warp-cli/internal/logger"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/security"
 )
 
 // AdmrulSearchResponse represents the administrative rule search response
@@ -44,9 +45,7 @@ type AdmrulClient struct {
 // NewAdmrulClient creates a new Administrative Rule API client
 func NewAdmrulClient(apiKey string) *AdmrulClient {
 	return &AdmrulClient{
-		httpClient: &http.Client{
-			Timeout: DefaultTimeout,
-		},
+		httpClient:     newHTTPClient(),
 		baseURL:        "https://www.law.go.kr/DRF/lawSearch.do",
 		detailURL:      "https://www.law.go.kr/DRF/lawService.do",
 		apiKey:         apiKey,
@@ -61,36 +60,11 @@ func (c *AdmrulClient) GetAPIType() APIType {
 
 // Search performs an administrative rule search
 func (c *AdmrulClient) Search(ctx context.Context, req *UnifiedSearchRequest) (*SearchResponse, error) {
-	// Set defaults
-	if req.Type == "" {
-		req.Type = "JSON"
-	}
-	if req.PageNo == 0 {
-		req.PageNo = 1
-	}
-	if req.PageSize == 0 {
-		req.PageSize = 10
-	}
-
 	// Build URL with parameters
-	params := url.Values{}
-	params.Set("OC", c.apiKey)
-	params.Set("target", "admrul") // 행정규칙 검색
-	params.Set("query", req.Query)
-	params.Set("type", req.Type)
-	params.Set("page", fmt.Sprintf("%d", req.PageNo))
-	params.Set("display", fmt.Sprintf("%d", req.PageSize))
-
-	// Add optional filters
-	if req.Department != "" {
-		params.Set("소관부처", req.Department)
-	}
-	if req.Sort != "" {
-		params.Set("sort", req.Sort)
-	}
+	params := buildSearchParams(c.apiKey, "admrul", req) // 행정규칙 검색
 
 	fullURL := fmt.Sprintf("%s?%s", c.baseURL, params.Encode())
-	logger.Debug("Administrative Rule API Request URL: %s", fullURL)
+	logger.Debug("Administrative Rule API Request URL: %s", security.SanitizeURL(fullURL))
 
 	// Perform request with retries
 	body, err := c.doRequestWithRetry(ctx, fullURL)
@@ -116,6 +90,7 @@ func (c *AdmrulClient) Search(ctx context.Context, req *UnifiedSearchRequest) (*
 	response := &SearchResponse{
 		TotalCount: admrulResponse.TotalCount,
 		Page:       admrulResponse.Page,
+		PageSize:   req.PageSize,
 		Laws:       make([]LawInfo, len(admrulResponse.Admruls)),
 	}
 
@@ -129,6 +104,10 @@ func (c *AdmrulClient) Search(ctx context.Context, req *UnifiedSearchRequest) (*
 		}
 	}
 
+	// Apply the requested order to the page as well, which is all the order
+	// there is for keys the API cannot sort by
+	SortLaws(response.Laws, req.Sort, req.Order)
+
 	return response, nil
 }
 
@@ -142,7 +121,7 @@ func (c *AdmrulClient) GetDetail(ctx context.Context, admrulID string) (*LawDeta
 	params.Set("type", "JSON")
 
 	fullURL := fmt.Sprintf("%s?%s", c.detailURL, params.Encode())
-	logger.Debug("Administrative Rule Detail API Request URL: %s", fullURL)
+	logger.Debug("Administrative Rule Detail API Request URL: %s", security.SanitizeURL(fullURL))
 
 	// Perform request with retries
 	body, err := c.doRequestWithRetry(ctx, fullURL)
@@ -168,13 +147,11 @@ func (c *AdmrulClient) GetHistory(ctx context.Context, admrulID string) (*LawHis
 // doRequestWithRetry performs HTTP request with retry logic
 func (c *AdmrulClient) doRequestWithRetry(ctx context.Context, url string) ([]byte, error) {
 	var lastErr error
-	delay := c.retryBaseDelay
+	backoff := newBackoff(c.retryBaseDelay)
 
 	for i := 0; i < MaxRetries; i++ {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		default:
+		if ctx.Err() != nil {
+			return nil, contextError(ctx)
 		}
 
 		body, err := c.doRequest(ctx, url)
@@ -187,14 +164,17 @@ func (c *AdmrulClient) doRequestWithRetry(ctx context.Context, url string) ([]by
 		}
 
 		lastErr = err
-		if !c.shouldRetry(err) {
+		if !isRetryable(err) {
 			return nil, err
 		}
 
 		if i < MaxRetries-1 {
+			delay := backoff.RetryDelay(i+1, err)
 			logger.Debug("Retrying after %v (attempt %d/%d)", delay, i+1, MaxRetries)
-			time.Sleep(delay)
-			delay *= 2
+			recordRetry()
+			if err := sleepContext(ctx, delay); err != nil {
+				return nil, err
+			}
 		}
 	}
 
@@ -208,9 +188,9 @@ func (c *AdmrulClient) doRequest(ctx context.Context, url string) ([]byte, error
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := doHTTP(c.httpClient, req)
 	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
+		return nil, requestError(ctx, err)
 	}
 	defer resp.Body.Close()
 
@@ -222,12 +202,11 @@ func (c *AdmrulClient) doRequest(ctx context.Context, url string) ([]byte, error
 	// Check for HTML error response
 	if strings.HasPrefix(strings.TrimSpace(string(body)), "<!DOCTYPE") ||
 		strings.HasPrefix(strings.TrimSpace(string(body)), "<html") {
-		errMsg := c.parseHTMLError(string(body))
-		return nil, &APIKeyError{Message: errMsg}
+		return nil, c.parseHTMLError(string(body))
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, c.handleHTTPError(resp.StatusCode)
+		return nil, withRetryAfter(c.handleHTTPError(resp.StatusCode), resp)
 	}
 
 	return body, nil
@@ -241,24 +220,18 @@ func (c *AdmrulClient) handleHTTPError(statusCode int) error {
 	case http.StatusForbidden:
 		return &APIKeyError{Message: "API 접근 권한이 없습니다"}
 	case http.StatusNotFound:
-		return fmt.Errorf("요청한 행정규칙을 찾을 수 없습니다")
+		return newStatusError(ErrNotFound, statusCode, "요청한 행정규칙을 찾을 수 없습니다")
+	case http.StatusRequestTimeout:
+		return &RetryableError{Err: newStatusError(nil, statusCode, "요청 타임아웃: HTTP 408")}
 	case http.StatusTooManyRequests:
-		return fmt.Errorf("API 요청 한도를 초과했습니다. 잠시 후 다시 시도해주세요")
-	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable:
-		return fmt.Errorf("서버 오류가 발생했습니다. 잠시 후 다시 시도해주세요")
+		return &RetryableError{Err: newStatusError(ErrRateLimited, statusCode, "API 요청 한도를 초과했습니다. 잠시 후 다시 시도해주세요")}
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return &RetryableError{Err: newStatusError(ErrServiceUnavailable, statusCode, "서버 오류가 발생했습니다. 잠시 후 다시 시도해주세요")}
 	default:
 		return fmt.Errorf("HTTP 오류: %d", statusCode)
 	}
 }
 
-// shouldRetry determines if the error is retryable
-func (c *AdmrulClient) shouldRetry(err error) bool {
-	errStr := err.Error()
-	return strings.Contains(errStr, "서버 오류") ||
-		strings.Contains(errStr, "timeout") ||
-		strings.Contains(errStr, "connection refused")
-}
-
 // hasAPIError checks if the response contains an API error
 func (c *AdmrulClient) hasAPIError(body []byte) bool {
 	// Check for common error patterns in JSON/XML responses
@@ -296,26 +269,26 @@ func (c *AdmrulClient) parseAPIError(body []byte) error {
 	return fmt.Errorf("알 수 없는 API 오류")
 }
 
-// parseHTMLError extracts meaningful error message from HTML response
-func (c *AdmrulClient) parseHTMLError(html string) string {
+// parseHTMLError converts an HTML error page to an error of the matching kind
+func (c *AdmrulClient) parseHTMLError(html string) error {
 	htmlLower := strings.ToLower(html)
 
 	// Check for authentication/key related issues
 	if strings.Contains(htmlLower, "인증") || strings.Contains(htmlLower, "auth") ||
 		strings.Contains(htmlLower, "key") || strings.Contains(htmlLower, "키") {
-		return "API 인증 실패: API 키가 유효하지 않거나 만료되었습니다. 'warp config set law.key <API_KEY>' 명령으로 유효한 API 키를 설정해주세요."
+		return &APIKeyError{Message: "API 인증 실패: API 키가 유효하지 않거나 만료되었습니다. 'warp config set law.key <API_KEY>' 명령으로 유효한 API 키를 설정해주세요."}
 	}
 
 	// Check for service errors
 	if strings.Contains(htmlLower, "서비스") || strings.Contains(htmlLower, "service") {
-		return "서비스 일시 중단: 국가법령정보센터 서비스가 일시적으로 이용할 수 없습니다. 잠시 후 다시 시도해주세요."
+		return newStatusError(ErrServiceUnavailable, 0, "서비스 일시 중단: 국가법령정보센터 서비스가 일시적으로 이용할 수 없습니다. 잠시 후 다시 시도해주세요.")
 	}
 
 	// Check for not found errors
 	if strings.Contains(htmlLower, "not found") || strings.Contains(htmlLower, "404") {
-		return "요청한 행정규칙을 찾을 수 없습니다."
+		return newStatusError(ErrNotFound, 0, "요청한 행정규칙을 찾을 수 없습니다.")
 	}
 
-	// Default error message
-	return "API 요청 실패: 국가법령정보센터 API에서 오류가 발생했습니다. API 키를 확인하거나 잠시 후 다시 시도해주세요."
+	// Default: unexpected pages are mostly caused by the API key
+	return &APIKeyError{Message: "API 요청 실패: 국가법령정보센터 API에서 오류가 발생했습니다. API 키를 확인하거나 잠시 후 다시 시도해주세요."}
 }
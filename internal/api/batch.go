@@ -0,0 +1,126 @@
+package api
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// DetailResult is the outcome of fetching the detail of one ID in a batch
+type DetailResult struct {
+	ID     string
+	Detail *LawDetail
+	Err    error
+}
+
+// DetailFetcher fetches the detail of one ID
+type DetailFetcher func(ctx context.Context, id string) (*LawDetail, error)
+
+// BatchOptions limits how a batch sends requests
+type BatchOptions struct {
+	// Concurrency is the maximum number of requests in flight; at least 1
+	Concurrency int
+	// Interval is the minimum time between the start of two requests, so that
+	// a batch does not run into the rate limit of the servers. 0 means no limit.
+	Interval time.Duration
+}
+
+// GetDetails fetches the details of ids with fetch, running at most
+// opts.Concurrency requests at a time and starting them at most every
+// opts.Interval. A failed ID records its error and the others go on; IDs not
+// started when ctx is done record the context error. Results keep the order of
+// ids.
+func GetDetails(ctx context.Context, ids []string, fetch DetailFetcher, opts BatchOptions) []DetailResult {
+	results := make([]DetailResult, len(ids))
+	errs := RunBatch(ctx, len(ids), opts, func(ctx context.Context, i int) error {
+		detail, err := fetch(ctx, ids[i])
+		results[i].Detail = detail
+		return err
+	})
+	for i := range results {
+		results[i].ID = ids[i]
+		results[i].Err = errs[i]
+	}
+	return results
+}
+
+// RunBatch runs job for the indexes 0 to n-1 with the limits of opts and
+// returns the error of each index. Indexes not started when ctx is done
+// record the context error.
+func RunBatch(ctx context.Context, n int, opts BatchOptions, job func(ctx context.Context, i int) error) []error {
+	concurrency := opts.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	limiter := NewIntervalLimiter(opts.Interval)
+
+	errs := make([]error, n)
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < concurrency && w < n; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				if err := limiter.Wait(ctx); err != nil {
+					errs[i] = err
+					continue
+				}
+				errs[i] = job(ctx, i)
+			}
+		}()
+	}
+
+	for i := 0; i < n; i++ {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+	return errs
+}
+
+// IntervalLimiter spaces the start of requests by a minimum interval. It is
+// safe for concurrent use.
+type IntervalLimiter struct {
+	interval time.Duration
+	mu       sync.Mutex
+	next     time.Time
+}
+
+// NewIntervalLimiter creates a limiter that starts a request at most every
+// interval. An interval of 0 or less does not limit.
+func NewIntervalLimiter(interval time.Duration) *IntervalLimiter {
+	return &IntervalLimiter{interval: interval}
+}
+
+// Wait blocks until the next request may start or ctx is done
+func (l *IntervalLimiter) Wait(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if l.interval <= 0 {
+		return nil
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	start := l.next
+	if start.Before(now) {
+		start = now
+	}
+	l.next = start.Add(l.interval)
+	l.mu.Unlock()
+
+	delay := time.Until(start)
+	if delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
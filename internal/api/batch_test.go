@@ -0,0 +1,105 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestGetDetails(t *testing.T) {
+	var inFlight, maxInFlight int32
+	fetch := func(ctx context.Context, id string) (*LawDetail, error) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+
+		if id == "003" || id == "007" {
+			return nil, fmt.Errorf("법령을 찾을 수 없습니다: %s", id)
+		}
+		return &LawDetail{LawInfo: LawInfo{ID: id}}, nil
+	}
+
+	var ids []string
+	for i := 1; i <= 10; i++ {
+		ids = append(ids, fmt.Sprintf("%03d", i))
+	}
+
+	results := GetDetails(context.Background(), ids, fetch, BatchOptions{Concurrency: 3})
+
+	if got := atomic.LoadInt32(&maxInFlight); got > 3 {
+		t.Errorf("at most 3 requests should be in flight, got %d", got)
+	}
+	if len(results) != len(ids) {
+		t.Fatalf("expected %d results, got %d", len(ids), len(results))
+	}
+
+	failed := 0
+	for i, result := range results {
+		if result.ID != ids[i] {
+			t.Errorf("result %d has ID %q, want %q (order must be kept)", i, result.ID, ids[i])
+		}
+		if result.Err != nil {
+			failed++
+			if result.Detail != nil {
+				t.Errorf("failed result %s should have no detail", result.ID)
+			}
+			continue
+		}
+		if result.Detail == nil || result.Detail.ID != ids[i] {
+			t.Errorf("result %s has detail %+v", result.ID, result.Detail)
+		}
+	}
+	if failed != 2 {
+		t.Errorf("expected 2 failures to be recorded, got %d", failed)
+	}
+}
+
+func TestGetDetailsInterval(t *testing.T) {
+	var starts []time.Time
+	fetch := func(ctx context.Context, id string) (*LawDetail, error) {
+		starts = append(starts, time.Now())
+		return &LawDetail{}, nil
+	}
+
+	// A single worker keeps starts ordered
+	GetDetails(context.Background(), []string{"1", "2", "3"}, fetch, BatchOptions{Concurrency: 1, Interval: 30 * time.Millisecond})
+
+	if len(starts) != 3 {
+		t.Fatalf("expected 3 requests, got %d", len(starts))
+	}
+	for i := 1; i < len(starts); i++ {
+		if gap := starts[i].Sub(starts[i-1]); gap < 25*time.Millisecond {
+			t.Errorf("requests %d and %d started %v apart, want at least the interval", i-1, i, gap)
+		}
+	}
+}
+
+func TestGetDetailsCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	called := false
+	fetch := func(ctx context.Context, id string) (*LawDetail, error) {
+		called = true
+		return &LawDetail{}, nil
+	}
+
+	results := GetDetails(ctx, []string{"1", "2"}, fetch, BatchOptions{Concurrency: 2})
+	if called {
+		t.Error("no request should start after the context is done")
+	}
+	for _, result := range results {
+		if !errors.Is(result.Err, context.Canceled) {
+			t.Errorf("result %s should record the context error, got %v", result.ID, result.Err)
+		}
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"encoding/json"
 	"encoding/xml"
-	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -19,8 +18,9 @@ const (
 	// BaseURL is the National Law Information Center API endpoint
 	BaseURL = "https://www.law.go.kr/DRF/lawSearch.do"
 
-	// Default timeout for HTTP requests
-	DefaultTimeout = 10 * time.Second
+	// DefaultTimeout is the timeout of API operations unless set with
+	// --timeout or api.timeout (see SetTimeout)
+	DefaultTimeout = 30 * time.Second
 
 	// Maximum retry attempts
 	MaxRetries = 3
@@ -58,12 +58,53 @@ type SearchResponse struct {
 	Page       int        `json:"page" xml:"page"`
 	Laws       []LawInfo  `json:"law" xml:"law"`
 	Error      *ErrorInfo `json:"error,omitempty" xml:"error,omitempty"`
+	// FetchedCount is set by the unified search: the number of results its pages
+	// can reach, of the TotalCount reported by the servers. Each source is
+	// fetched only up to a limit, so only FetchedCount results can be shown.
+	FetchedCount int `json:"fetchedCnt,omitempty" xml:"fetchedCnt,omitempty"`
+	// PageSize is the page size of the request, 0 if unknown, so that the
+	// pages are counted right on a last page holding fewer results. It is
+	// not part of the output.
+	PageSize int `json:"-" xml:"-"`
+	// Sources and Warnings are set by the unified search: the outcome of each
+	// source and warnings when the results come (almost) only from one of them
+	Sources  []SourceStatus `json:"sources,omitempty" xml:"sources>source,omitempty"`
+	Warnings []string       `json:"warnings,omitempty" xml:"warnings>warning,omitempty"`
+	// Fingerprint is set with --fingerprint: the SHA-256 fingerprint of Laws,
+	// which stays the same as long as the results do
+	Fingerprint string `json:"fingerprint,omitempty" xml:"fingerprint,omitempty"`
+	// Summary is set with --summary: the number of results of each source and
+	// law type
+	Summary *ResultSummary `json:"summary,omitempty" xml:"summary,omitempty"`
+}
+
+// ResultSummary is the distribution of search results by source and law
+// type, the most frequent first
+type ResultSummary struct {
+	BySource  []SummaryCount `json:"by_source,omitempty" xml:"bySource>count,omitempty"`
+	ByLawType []SummaryCount `json:"by_law_type" xml:"byLawType>count"`
+}
+
+// SummaryCount is the number of results with one source or law type
+type SummaryCount struct {
+	Name  string `json:"name" xml:"name,attr"`
+	Count int    `json:"count" xml:",chardata"`
+}
+
+// PageableCount returns the number of results that can be shown page by page:
+// TotalCount, or FetchedCount when a unified search could merge fewer
+func (r *SearchResponse) PageableCount() int {
+	if r.FetchedCount > 0 && r.FetchedCount < r.TotalCount {
+		return r.FetchedCount
+	}
+	return r.TotalCount
 }
 
 // LawInfo represents individual law information
 type LawInfo struct {
 	ID         string `json:"법령ID" xml:"법령ID"`
 	Name       string `json:"법령명한글" xml:"법령명한글"`
+	NameHanja  string `json:"법령명한자" xml:"법령명한자"`
 	NameAbbrev string `json:"법령명약칭" xml:"법령명약칭"`
 	SerialNo   string `json:"법령일련번호" xml:"법령일련번호"`
 	PromulDate string `json:"공포일자" xml:"공포일자"`
@@ -73,8 +114,22 @@ type LawInfo struct {
 	EffectDate string `json:"시행일자" xml:"시행일자"`
 	LawType    string `json:"법령구분명" xml:"법령구분명"`
 	Source     string `json:"출처,omitempty" xml:"출처,omitempty"` // "국가법령" or "자치법규"
+	// ExtraFields keeps what a source returns beyond the fields of a law,
+	// keyed by the Extra* names: the court of a precedent, the agencies of
+	// a legal interpretation
+	ExtraFields map[string]string `json:"추가정보,omitempty" xml:"-"`
 }
 
+// Keys of LawInfo.ExtraFields
+const (
+	ExtraCourtName   = "법원명"
+	ExtraCaseNumber  = "사건번호"
+	ExtraJudgeDate   = "선고일자"
+	ExtraQueryAgency = "질의기관명"
+	ExtraReplyAgency = "회신기관명"
+	ExtraReplyDate   = "회신일자"
+)
+
 // ErrorInfo represents API error information
 type ErrorInfo struct {
 	Code    string `json:"code" xml:"code"`
@@ -103,17 +158,20 @@ func (e *APIKeyError) Error() string {
 	return e.Message
 }
 
+// Is reports that an APIKeyError is an ErrUnauthorized failure
+func (e *APIKeyError) Is(target error) bool {
+	return target == ErrUnauthorized
+}
+
 // NewClient creates a new API client
 func NewClient() (*Client, error) {
 	apiKey := config.GetAPIKey()
 	if apiKey == "" {
-		return nil, fmt.Errorf("API 키가 설정되지 않았습니다. 'warp config set law.key YOUR_KEY' 명령으로 설정하세요")
+		return nil, fmt.Errorf("%w. 'warp config set law.key YOUR_KEY' 명령으로 설정하세요", ErrNoAPIKey)
 	}
 
 	return &Client{
-		httpClient: &http.Client{
-			Timeout: DefaultTimeout,
-		},
+		httpClient:     newHTTPClient(),
 		baseURL:        BaseURL,
 		apiKey:         apiKey,
 		retryBaseDelay: InitialRetryDelay,
@@ -124,7 +182,8 @@ func NewClient() (*Client, error) {
 func NewClientWithConfig(apiKey string, timeout time.Duration) *Client {
 	return &Client{
 		httpClient: &http.Client{
-			Timeout: timeout,
+			Timeout:   timeout,
+			Transport: Transport(),
 		},
 		baseURL:        BaseURL,
 		apiKey:         apiKey,
@@ -135,9 +194,7 @@ func NewClientWithConfig(apiKey string, timeout time.Duration) *Client {
 // NewClientWithURL creates a new API client with custom base URL (for testing)
 func NewClientWithURL(apiKey string, baseURL string) *Client {
 	return &Client{
-		httpClient: &http.Client{
-			Timeout: DefaultTimeout,
-		},
+		httpClient:     newHTTPClient(),
 		baseURL:        baseURL,
 		apiKey:         apiKey,
 		retryBaseDelay: InitialRetryDelay,
@@ -150,12 +207,7 @@ func (c *Client) Search(ctx context.Context, req *SearchRequest) (*SearchRespons
 	if req.Type == "" {
 		req.Type = TypeJSON
 	}
-	if req.PageNo == 0 {
-		req.PageNo = 1
-	}
-	if req.PageSize == 0 {
-		req.PageSize = 10
-	}
+	req.PageNo, req.PageSize = normalizePage(req.PageNo, req.PageSize)
 
 	// Build URL with parameters
 	params := url.Values{}
@@ -170,16 +222,15 @@ func (c *Client) Search(ctx context.Context, req *SearchRequest) (*SearchRespons
 
 	// Perform request with retries
 	var lastErr error
-	retryDelay := c.retryBaseDelay
+	backoff := newBackoff(c.retryBaseDelay)
 
 	for attempt := 0; attempt < MaxRetries; attempt++ {
 		if attempt > 0 {
-			// Wait before retry with exponential backoff
-			select {
-			case <-time.After(retryDelay):
-				retryDelay *= 2
-			case <-ctx.Done():
-				return nil, ctx.Err()
+			// Wait before retry with exponential backoff and jitter,
+			// or as long as the server asked with Retry-After
+			recordRetry()
+			if err := sleepContext(ctx, backoff.RetryDelay(attempt, lastErr)); err != nil {
+				return nil, err
 			}
 		}
 
@@ -187,7 +238,7 @@ func (c *Client) Search(ctx context.Context, req *SearchRequest) (*SearchRespons
 		if err != nil {
 			lastErr = err
 			// Only retry on network errors or 5xx server errors
-			if !c.shouldRetry(err) {
+			if !isRetryable(err) {
 				return nil, err
 			}
 			continue
@@ -206,43 +257,15 @@ func (c *Client) doRequest(ctx context.Context, url string) (*SearchResponse, er
 		return nil, fmt.Errorf("요청 생성 실패: %w", err)
 	}
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := doHTTP(c.httpClient, req)
 	if err != nil {
-		// Do not retry on explicit context cancellation or deadline
-		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
-			return nil, fmt.Errorf("요청이 취소되었거나 시간 초과되었습니다: %w", err)
-		}
-		// Treat other transport-level problems as retryable
-		return nil, &RetryableError{Err: fmt.Errorf("네트워크 에러: %w", err)}
+		return nil, requestError(ctx, err)
 	}
 	defer resp.Body.Close()
 
 	// Check HTTP status first
 	if resp.StatusCode != http.StatusOK {
-		switch resp.StatusCode {
-		case http.StatusTooManyRequests: // 429
-			// Rate limit - retryable
-			return nil, &RetryableError{Err: fmt.Errorf("레이트 리밋: HTTP 429 (잠시 후 다시 시도하세요)")}
-		case http.StatusRequestTimeout: // 408
-			// Request timeout - retryable
-			return nil, &RetryableError{Err: fmt.Errorf("요청 타임아웃: HTTP 408")}
-		case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout: // 502, 503, 504
-			// Server errors - retryable
-			return nil, &RetryableError{Err: fmt.Errorf("일시적 서버 오류: HTTP %d", resp.StatusCode)}
-		case http.StatusInternalServerError: // 500
-			// Internal server error - retryable
-			return nil, &RetryableError{Err: fmt.Errorf("내부 서버 오류: HTTP 500")}
-		case http.StatusUnauthorized, http.StatusForbidden: // 401, 403
-			// Authentication errors - not retryable
-			return nil, fmt.Errorf("인증 실패: HTTP %d - API 키를 확인하세요", resp.StatusCode)
-		default:
-			if resp.StatusCode >= 500 {
-				// Any other 5xx error - retryable
-				return nil, &RetryableError{Err: fmt.Errorf("서버 에러: HTTP %d", resp.StatusCode)}
-			}
-			// 4xx errors - not retryable
-			return nil, fmt.Errorf("클라이언트 에러: HTTP %d", resp.StatusCode)
-		}
+		return nil, withRetryAfter(httpStatusError(resp.StatusCode), resp)
 	}
 
 	// Read response body only if status is OK
@@ -279,18 +302,3 @@ func (c *Client) doRequest(ctx context.Context, url string) (*SearchResponse, er
 
 	return &searchResp, nil
 }
-
-// shouldRetry determines if an error is retryable
-func (c *Client) shouldRetry(err error) bool {
-	if err == nil {
-		return false
-	}
-
-	// Check if it's a RetryableError
-	var retryableErr *RetryableError
-	if errors.As(err, &retryableErr) {
-		return true
-	}
-
-	return false
-}
@@ -0,0 +1,38 @@
+package api
+
+import (
+	"sort"
+	"sync"
+
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+)
+
+// nameCollator orders names in Korean (가나다) order.
+// Collation rules for mixed strings:
+//   - digits sort before Latin letters, which sort before Hangul
+//   - runs of digits compare by numeric value ("제2조" < "제10조")
+//   - Latin letters compare case-insensitively first, lowercase before uppercase on ties
+//
+// collate.Collator keeps internal buffers and is not safe for concurrent use,
+// so the shared instance is guarded by a mutex.
+var (
+	nameCollator   = collate.New(language.Korean, collate.Numeric)
+	nameCollatorMu sync.Mutex
+)
+
+// CompareNames compares two names using Korean locale collation.
+// It returns -1, 0 or 1.
+func CompareNames(a, b string) int {
+	nameCollatorMu.Lock()
+	defer nameCollatorMu.Unlock()
+	return nameCollator.CompareString(a, b)
+}
+
+// SortLawsByName sorts laws by name in Korean collation order, keeping the
+// original order of laws with equal names
+func SortLawsByName(laws []LawInfo) {
+	sort.SliceStable(laws, func(i, j int) bool {
+		return CompareNames(laws[i].Name, laws[j].Name) < 0
+	})
+}
@@ -0,0 +1,69 @@
+package api
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCompareNames(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"가정", "나라", -1},
+		{"하천법", "가족법", 1},
+		{"도로법", "도로법", 0},
+		{"각", "간", -1},          // final consonant order
+		{"까치", "가치", 1},         // tense consonant after plain
+		{"제2조", "제10조", -1},     // numeric comparison
+		{"2024 예산", "A 계획", -1}, // digits before Latin
+		{"Zebra", "가나", -1},     // Latin before Hangul
+		{"apple", "Banana", -1}, // case-insensitive
+		{"abc", "ABC", -1},      // lowercase first on ties
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+"_"+tt.b, func(t *testing.T) {
+			if got := CompareNames(tt.a, tt.b); got != tt.want {
+				t.Errorf("CompareNames(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSortLawsByName(t *testing.T) {
+	laws := []LawInfo{
+		{Name: "하천법"},
+		{Name: "개인정보 보호법 시행령"},
+		{Name: "도로교통법"},
+		{Name: "개인정보 보호법"},
+		{Name: "OECD 협약"},
+		{Name: "10대 과제 지원 조례"},
+		{Name: "2대 과제 지원 조례"},
+		{Name: "까치 보호 조례"},
+		{Name: "가축전염병 예방법"},
+	}
+
+	SortLawsByName(laws)
+
+	got := make([]string, len(laws))
+	for i, law := range laws {
+		got[i] = law.Name
+	}
+
+	want := []string{
+		"2대 과제 지원 조례",
+		"10대 과제 지원 조례",
+		"OECD 협약",
+		"가축전염병 예방법",
+		"개인정보 보호법",
+		"개인정보 보호법 시행령",
+		"까치 보호 조례",
+		"도로교통법",
+		"하천법",
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SortLawsByName() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,62 @@
+package api
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// contentKeys are the keys that hold the text of an object in detail
+// responses, in the order they are looked up
+var contentKeys = []string{"content", "내용", "개정문내용", "부칙내용", "별표내용", "조문내용", "항내용", "호내용", "목내용"}
+
+// flattenContent returns the text of a content field of a detail response,
+// which the API sends as a string, an array of lines, nested arrays, or objects
+// with the text under a content key. Parts are joined with newlines in their
+// order and blank parts are skipped. An object without a content key
+// contributes the text of all its values, in key order.
+func flattenContent(v interface{}) string {
+	var parts []string
+	collectContent(v, &parts)
+	return strings.Join(parts, "\n")
+}
+
+// collectContent appends the non-blank text parts of v to parts
+func collectContent(v interface{}, parts *[]string) {
+	switch value := v.(type) {
+	case nil:
+	case string:
+		if text := strings.TrimSpace(value); text != "" {
+			*parts = append(*parts, text)
+		}
+	case []interface{}:
+		for _, item := range value {
+			collectContent(item, parts)
+		}
+	case [][]interface{}:
+		for _, item := range value {
+			collectContent(item, parts)
+		}
+	case []string:
+		for _, item := range value {
+			collectContent(item, parts)
+		}
+	case map[string]interface{}:
+		for _, key := range contentKeys {
+			if content, ok := value[key]; ok {
+				collectContent(content, parts)
+				return
+			}
+		}
+		keys := make([]string, 0, len(value))
+		for key := range value {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			collectContent(value[key], parts)
+		}
+	default:
+		collectContent(fmt.Sprint(value), parts)
+	}
+}
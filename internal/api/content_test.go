@@ -0,0 +1,92 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestFlattenContent(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+		want string
+	}{
+		{"string", `"부칙 <제10465호, 2011.3.29>"`, "부칙 <제10465호, 2011.3.29>"},
+		{"null", `null`, ""},
+		{"string array", `["부칙 <제1호>", "  ", "이 법은 공포한 날부터 시행한다."]`, "부칙 <제1호>\n이 법은 공포한 날부터 시행한다."},
+		{"nested arrays", `[["제1조(시행일) 이 법은 공포 후 6개월이 경과한 날부터 시행한다.", "제2조(경과조치) 생략"], ["부칙 <제2호>"]]`,
+			"제1조(시행일) 이 법은 공포 후 6개월이 경과한 날부터 시행한다.\n제2조(경과조치) 생략\n부칙 <제2호>"},
+		{"object with content", `{"content": ["① 개정 내용", "② 개정 내용"], "type": "text"}`, "① 개정 내용\n② 개정 내용"},
+		{"objects in array", `[{"내용": "별표 1"}, {"content": {"별표내용": "구분 | 기준"}}]`, "별표 1\n구분 | 기준"},
+		{"object without content key", `{"b": "둘째", "a": ["첫째"], "n": 3}`, "첫째\n둘째\n3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var v interface{}
+			if err := json.Unmarshal([]byte(tt.json), &v); err != nil {
+				t.Fatal(err)
+			}
+			if got := flattenContent(v); got != tt.want {
+				t.Errorf("flattenContent(%s) = %q, want %q", tt.json, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNLICClient_GetDetailContent(t *testing.T) {
+	const body = `{"법령": {
+		"기본정보": {"법령ID": "001234", "법령명_한글": "개인정보 보호법"},
+		"개정문": {"개정문내용": [["【개정문】", "국회에서 의결된 개인정보 보호법 일부개정법률을 이에 공포한다."]]},
+		"별표": {"별표단위": [
+			{"별표번호": "0001", "별표제목": "과태료 부과기준", "별표내용": [["1. 일반기준"], ["2. 개별기준"]]},
+			{"별표번호": "0002", "별표제목": "서식", "별표내용": "별지 서식 참조"}
+		]},
+		"부칙": {"부칙단위": [
+			{"부칙번호": "10465", "부칙공포일자": "20110329", "부칙내용": "부칙 <제10465호, 2011.3.29>"},
+			{"부칙번호": "11690", "부칙공포일자": "20130323", "부칙내용": [["부칙 <제11690호, 2013.3.23>", "제1조(시행일) 이 법은 공포한 날부터 시행한다."]]},
+			{"부칙번호": "12504", "부칙공포일자": "20140318", "부칙내용": {"content": ["부칙 <제12504호, 2014.3.18>"]}}
+		]}
+	}}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	client := NewNLICClientWithURL("test-key", server.URL)
+	detail, err := client.GetDetail(context.Background(), "001234")
+	if err != nil {
+		t.Fatalf("GetDetail() error = %v", err)
+	}
+
+	if !detail.HasRevisionText || detail.RevisionText != "【개정문】\n국회에서 의결된 개인정보 보호법 일부개정법률을 이에 공포한다." {
+		t.Errorf("RevisionText = %q", detail.RevisionText)
+	}
+
+	wantTables := []string{"1. 일반기준\n2. 개별기준", "별지 서식 참조"}
+	if len(detail.Tables) != len(wantTables) {
+		t.Fatalf("Tables = %+v", detail.Tables)
+	}
+	for i, want := range wantTables {
+		if detail.Tables[i].Content != want {
+			t.Errorf("Tables[%d].Content = %q, want %q", i, detail.Tables[i].Content, want)
+		}
+	}
+
+	wantProvisions := []string{
+		"부칙 <제10465호, 2011.3.29>",
+		"부칙 <제11690호, 2013.3.23>\n제1조(시행일) 이 법은 공포한 날부터 시행한다.",
+		"부칙 <제12504호, 2014.3.18>",
+	}
+	if len(detail.SupplementaryProvisions) != len(wantProvisions) {
+		t.Fatalf("SupplementaryProvisions = %+v", detail.SupplementaryProvisions)
+	}
+	for i, want := range wantProvisions {
+		if got := detail.SupplementaryProvisions[i].Content; got != want {
+			t.Errorf("SupplementaryProvisions[%d].Content = %q, want %q", i, got, want)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package api
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// KST is the time zone of the dates of the law.go.kr APIs
+var KST = time.FixedZone("KST", 9*60*60)
+
+// separatedDate matches dates written with '.', '-' or '/' separators and
+// optional spaces, e.g. "2024.01.01", "2024-1-1", "2024. 1. 1."
+var separatedDate = regexp.MustCompile(`^(\d{4})\s*[./-]\s*(\d{1,2})\s*[./-]\s*(\d{1,2})\.?$`)
+
+// ParseDate parses a date of the APIs, YYYYMMDD or written with separators as
+// separatedDate, as the start of that day in KST. Other values, and days that
+// do not exist such as 2024.02.30, are not dates.
+func ParseDate(date string) (time.Time, bool) {
+	date = strings.TrimSpace(date)
+	var year, month, day string
+	if len(date) == 8 && strings.Trim(date, "0123456789") == "" {
+		year, month, day = date[:4], date[4:6], date[6:]
+	} else if m := separatedDate.FindStringSubmatch(date); m != nil {
+		year, month, day = m[1], m[2], m[3]
+	} else {
+		return time.Time{}, false
+	}
+
+	y, _ := strconv.Atoi(year)
+	mo, _ := strconv.Atoi(month)
+	d, _ := strconv.Atoi(day)
+	t := time.Date(y, time.Month(mo), d, 0, 0, 0, 0, KST)
+	// time.Date normalizes out of range values, e.g. February 30 to March 1
+	if t.Year() != y || int(t.Month()) != mo || t.Day() != d {
+		return time.Time{}, false
+	}
+	return t, true
+}
@@ -0,0 +1,33 @@
+package api
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDate(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+		ok   bool
+	}{
+		{"20230314", "2023-03-14T00:00:00+09:00", true},
+		{"2023.03.14", "2023-03-14T00:00:00+09:00", true},
+		{"2023-3-14", "2023-03-14T00:00:00+09:00", true},
+		{"2023/03/14", "2023-03-14T00:00:00+09:00", true},
+		{" 2023. 3. 14. ", "2023-03-14T00:00:00+09:00", true},
+		{"20240229", "2024-02-29T00:00:00+09:00", true},
+		{"", "", false},
+		{"202303", "", false},
+		{"20231399", "", false},
+		{"2023.02.30", "", false},
+		{"29999998", "", false},
+		{"미상", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := ParseDate(tt.in)
+		if ok != tt.ok || (ok && got.Format(time.RFC3339) != tt.want) {
+			t.Errorf("ParseDate(%q) = %v, %v; want %q, %v", tt.in, got, ok, tt.want, tt.ok)
+		}
+	}
+}
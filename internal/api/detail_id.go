@@ -0,0 +1,47 @@
+package api
+
+import "strings"
+
+// detailIDSeparator separates the source prefix from the identifier ("elis:456")
+const detailIDSeparator = ":"
+
+// DetailID returns the identifier a detail lookup takes for a search result: the
+// 법령일련번호 (MST), or the law ID when the serial number is missing. Results of a
+// unified search carry their source as a prefix ("nlic:123", "elis:456") so that
+// the detail lookup can pick the right API. Empty when the result has neither.
+func DetailID(law LawInfo) string {
+	id := law.SerialNo
+	if id == "" {
+		id = law.ID
+	}
+	if id == "" {
+		return ""
+	}
+
+	switch law.Source {
+	case sourceLabel("ELIS"):
+		return string(APITypeELIS) + detailIDSeparator + id
+	case sourceLabel("NLIC"):
+		return string(APITypeNLIC) + detailIDSeparator + id
+	default:
+		return id
+	}
+}
+
+// ParseDetailID splits an identifier written by DetailID into its source and
+// the plain identifier. Identifiers without a known prefix are returned as is
+// with fallback as their source.
+func ParseDetailID(id string, fallback APIType) (APIType, string) {
+	prefix, rest, found := strings.Cut(id, detailIDSeparator)
+	if !found || rest == "" {
+		return fallback, id
+	}
+	switch APIType(strings.ToLower(prefix)) {
+	case APITypeNLIC:
+		return APITypeNLIC, rest
+	case APITypeELIS:
+		return APITypeELIS, rest
+	default:
+		return fallback, id
+	}
+}
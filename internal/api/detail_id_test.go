@@ -0,0 +1,56 @@
+package api
+
+import "testing"
+
+func TestDetailID(t *testing.T) {
+	tests := []struct {
+		name string
+		law  LawInfo
+		want string
+	}{
+		{"serial number", LawInfo{ID: "001", SerialNo: "100"}, "100"},
+		{"falls back to the law ID", LawInfo{ID: "001"}, "001"},
+		{"no identifier", LawInfo{Name: "개인정보 보호법"}, ""},
+		{"national law of a unified search", LawInfo{SerialNo: "100", Source: "국가법령"}, "nlic:100"},
+		{"ordinance of a unified search", LawInfo{SerialNo: "456", Source: "자치법규"}, "elis:456"},
+		{"unknown source", LawInfo{SerialNo: "100", Source: "기타"}, "100"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DetailID(tt.law); got != tt.want {
+				t.Errorf("DetailID() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDetailID(t *testing.T) {
+	tests := []struct {
+		id         string
+		wantSource APIType
+		wantID     string
+	}{
+		{"100", APITypeNLIC, "100"},
+		{"nlic:100", APITypeNLIC, "100"},
+		{"elis:456", APITypeELIS, "456"},
+		{"ELIS:456", APITypeELIS, "456"},
+		{"prec:789", APITypeNLIC, "prec:789"},
+		{"elis:", APITypeNLIC, "elis:"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			source, id := ParseDetailID(tt.id, APITypeNLIC)
+			if source != tt.wantSource || id != tt.wantID {
+				t.Errorf("ParseDetailID(%q) = (%s, %q), want (%s, %q)", tt.id, source, id, tt.wantSource, tt.wantID)
+			}
+		})
+	}
+
+	// Round trip through DetailID
+	law := LawInfo{SerialNo: "456", Source: "자치법규"}
+	if source, id := ParseDetailID(DetailID(law), APITypeNLIC); source != APITypeELIS || id != "456" {
+		t.Errorf("round trip = (%s, %q), want (elis, \"456\")", source, id)
+	}
+}
@@ -0,0 +1,98 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ordinanceIDDigits is the number of digits from which a numeric ID is taken
+// for a 자치법규일련번호 or 자치법규ID. Law serial numbers (MST) have at most
+// six digits and law IDs are six digits with leading zeros.
+const ordinanceIDDigits = 7
+
+// detailSources returns the sources a unified detail lookup tries for id, in
+// order: ELIS first for the long numeric IDs of ordinances, NLIC first
+// otherwise. The order is a guess, so both are tried.
+func detailSources(id string) []APIType {
+	numeric := id != "" && strings.Trim(id, "0123456789") == ""
+	if numeric && len(id) >= ordinanceIDDigits && id[0] != '0' {
+		return []APIType{APITypeELIS, APITypeNLIC}
+	}
+	return []APIType{APITypeNLIC, APITypeELIS}
+}
+
+// DetailAttempt is the failed lookup of one source of a unified detail lookup
+type DetailAttempt struct {
+	Source APIType
+	Err    error
+}
+
+// DetailError is the failure of a unified detail lookup, with the error of
+// each source in the order they were tried. It unwraps to them, so that
+// errors.Is and errors.As find the cause (ErrUnauthorized, ErrNotFound, an
+// *APIKeyError, ...) whichever source it came from.
+type DetailError struct {
+	ID       string
+	Attempts []DetailAttempt
+}
+
+func (e *DetailError) Error() string {
+	causes := make([]string, len(e.Attempts))
+	for i, attempt := range e.Attempts {
+		causes[i] = fmt.Sprintf("%s: %v", detailSourceLabel(attempt.Source), attempt.Err)
+	}
+	return fmt.Sprintf("법령/조례 상세 정보를 찾을 수 없습니다 (ID: %s): %s", e.ID, strings.Join(causes, "; "))
+}
+
+// Unwrap returns the errors of the sources tried
+func (e *DetailError) Unwrap() []error {
+	errs := make([]error, len(e.Attempts))
+	for i, attempt := range e.Attempts {
+		errs[i] = attempt.Err
+	}
+	return errs
+}
+
+// NotFound reports whether every source tried answered that the ID does not
+// exist, rather than failing to answer
+func (e *DetailError) NotFound() bool {
+	for _, attempt := range e.Attempts {
+		if !errors.Is(attempt.Err, ErrNotFound) {
+			return false
+		}
+	}
+	return len(e.Attempts) > 0
+}
+
+// stopsDetailFallback reports whether err of one source fails the other
+// source alike, which then is not tried: both share the API key and the
+// deadline of the lookup
+func stopsDetailFallback(err error) bool {
+	return errors.Is(err, ErrUnauthorized) || errors.Is(err, ErrNoAPIKey) || IsTimeout(err) || errors.Is(err, context.Canceled)
+}
+
+// detailSourceLabel returns the name of a source shown in errors
+func detailSourceLabel(source APIType) string {
+	if source == APITypeELIS {
+		return sourceLabel("ELIS")
+	}
+	return sourceLabel("NLIC")
+}
+
+// detailNotFound returns the message of a detail response answering that
+// nothing matches the ID: an object whose "Law" is a string, such as
+// {"Law": "일치하는 법령이 없습니다. 법령명을 확인하여 주십시오."}
+func detailNotFound(body []byte) (string, bool) {
+	var resp map[string]json.RawMessage
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return "", false
+	}
+	var msg string
+	if err := json.Unmarshal(resp["Law"], &msg); err != nil || !strings.Contains(msg, "일치하는") {
+		return "", false
+	}
+	return strings.TrimSpace(msg), true
+}
@@ -0,0 +1,160 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/testutil"
+)
+
+func TestDetailSources(t *testing.T) {
+	nlicFirst := []APIType{APITypeNLIC, APITypeELIS}
+	elisFirst := []APIType{APITypeELIS, APITypeNLIC}
+	tests := []struct {
+		id   string
+		want []APIType
+	}{
+		{"270351", nlicFirst},  // 법령일련번호 (MST)
+		{"011357", nlicFirst},  // 법령ID
+		{"1526175", elisFirst}, // 자치법규일련번호
+		{"2005526", elisFirst}, // 자치법규ID
+		{"0123456", nlicFirst},
+		{"ABC12345", nlicFirst},
+		{"", nlicFirst},
+	}
+	for _, tt := range tests {
+		if got := detailSources(tt.id); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("detailSources(%q) = %v, want %v", tt.id, got, tt.want)
+		}
+	}
+}
+
+const (
+	nlicDetailBody    = `{"법령": {"기본정보": {"법령ID": "011357", "법령명_한글": "개인정보 보호법"}}}`
+	nlicNotFoundBody  = `{"Law": "일치하는 법령이 없습니다. 법령명을 확인하여 주십시오."}`
+	elisDetailBody    = `{"LawService": {"자치법규기본정보": {"자치법규ID": "2005526", "자치법규명": "서울특별시 주차장 설치 및 관리 조례"}}}`
+	elisNotFoundBody  = `{"Law": "일치하는 자치법규가 없습니다. 자치법규명을 확인하여 주십시오."}`
+	unregisteredError = "미신청된 목록/본문에 대한 접근입니다"
+)
+
+func TestUnifiedClient_GetDetailFallback(t *testing.T) {
+	tests := []struct {
+		name        string
+		id          string
+		nlic        testutil.Reply
+		elis        testutil.Reply
+		wantName    string
+		wantTargets []string
+		// wantKind is the kind of the error when both sources fail
+		wantKind error
+	}{
+		{
+			name:        "law serial number found by NLIC",
+			id:          "270351",
+			nlic:        testutil.Reply{Body: nlicDetailBody},
+			wantName:    "개인정보 보호법",
+			wantTargets: []string{"law"},
+		},
+		{
+			name:        "ordinance serial number found by ELIS first",
+			id:          "2005526",
+			elis:        testutil.Reply{Body: elisDetailBody},
+			wantName:    "서울특별시 주차장 설치 및 관리 조례",
+			wantTargets: []string{"ordin"},
+		},
+		{
+			name:        "falls back to ELIS when NLIC has no such law",
+			id:          "270351",
+			nlic:        testutil.Reply{Body: nlicNotFoundBody},
+			elis:        testutil.Reply{Body: elisDetailBody},
+			wantName:    "서울특별시 주차장 설치 및 관리 조례",
+			wantTargets: []string{"law", "ordin"},
+		},
+		{
+			name:        "not found by either source",
+			id:          "2005526",
+			nlic:        testutil.Reply{Body: nlicNotFoundBody},
+			elis:        testutil.Reply{Body: elisNotFoundBody},
+			wantTargets: []string{"ordin", "law"},
+			wantKind:    ErrNotFound,
+		},
+		{
+			name:        "rejected key is not tried on the other source",
+			id:          "270351",
+			nlic:        testutil.HTMLError(unregisteredError),
+			elis:        testutil.Reply{Body: elisDetailBody},
+			wantTargets: []string{"law"},
+			wantKind:    ErrUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := testutil.NewAPIServer(t)
+			server.Handle(testutil.TargetLaw, tt.nlic)
+			server.Handle(testutil.TargetOrdinance, tt.elis)
+			client := newTestUnifiedClient(server)
+			client.nlicClient.retryBaseDelay = time.Millisecond
+			client.elisClient.detailURL = server.URL
+			client.elisClient.retryBaseDelay = time.Millisecond
+
+			detail, err := client.GetDetail(context.Background(), tt.id)
+
+			var targets []string
+			for _, req := range server.Requests() {
+				targets = append(targets, req.Target)
+			}
+			if !reflect.DeepEqual(targets, tt.wantTargets) {
+				t.Errorf("requested targets = %v, want %v", targets, tt.wantTargets)
+			}
+
+			if tt.wantKind == nil {
+				if err != nil {
+					t.Fatalf("GetDetail() error = %v", err)
+				}
+				if detail.Name != tt.wantName {
+					t.Errorf("GetDetail() name = %q, want %q", detail.Name, tt.wantName)
+				}
+				return
+			}
+
+			var detailErr *DetailError
+			if !errors.As(err, &detailErr) {
+				t.Fatalf("GetDetail() error = %v, want a *DetailError", err)
+			}
+			if !errors.Is(err, tt.wantKind) {
+				t.Errorf("GetDetail() error = %v, want kind %v", err, tt.wantKind)
+			}
+			if len(detailErr.Attempts) != len(tt.wantTargets) {
+				t.Errorf("attempts = %+v, want one per request", detailErr.Attempts)
+			}
+			if got := detailErr.NotFound(); got != (tt.wantKind == ErrNotFound) {
+				t.Errorf("NotFound() = %v", got)
+			}
+		})
+	}
+}
+
+func TestDetailError(t *testing.T) {
+	err := &DetailError{ID: "270351", Attempts: []DetailAttempt{
+		{Source: APITypeNLIC, Err: newStatusError(ErrNotFound, 0, "법령 상세 정보를 찾을 수 없습니다")},
+		{Source: APITypeELIS, Err: &APIKeyError{Message: "API 인증 실패"}},
+	}}
+	for _, want := range []string{"ID: 270351", "국가법령: 법령 상세 정보를 찾을 수 없습니다", "자치법규: API 인증 실패"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("Error() = %q, want %q", err.Error(), want)
+		}
+	}
+
+	var apiKeyErr *APIKeyError
+	if !errors.As(err, &apiKeyErr) || !errors.Is(err, ErrUnauthorized) || !errors.Is(err, ErrNotFound) {
+		t.Errorf("DetailError should unwrap to the errors of every source: %v", err)
+	}
+	if err.NotFound() {
+		t.Error("NotFound() = true, want false when a source failed otherwise")
+	}
+}
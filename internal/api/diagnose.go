@@ -0,0 +1,147 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+// ProbeTarget describes a search endpoint to diagnose
+type ProbeTarget struct {
+	Name    string // display name
+	Target  string // value of the "target" query parameter
+	Wrapper string // top-level key of a successful JSON response
+}
+
+var (
+	// ProbeNLIC probes the national law search endpoint
+	ProbeNLIC = ProbeTarget{Name: "국가법령 (NLIC)", Target: "law", Wrapper: "LawSearch"}
+	// ProbeELIS probes the local ordinance search endpoint
+	ProbeELIS = ProbeTarget{Name: "자치법규 (ELIS)", Target: "ordin", Wrapper: "OrdinSearch"}
+)
+
+// probeQuery is the lightweight query used for diagnostic searches
+const probeQuery = "민법"
+
+// ProbeResult is the outcome of a single diagnostic check
+type ProbeResult struct {
+	Name       string
+	OK         bool
+	StatusCode int
+	Elapsed    time.Duration
+	Problem    string
+	Hint       string
+}
+
+// ProbeConnectivity checks that the API server is reachable (DNS, TLS, proxy)
+func ProbeConnectivity(ctx context.Context, httpClient *http.Client, baseURL string) ProbeResult {
+	result := ProbeResult{Name: "네트워크 연결"}
+
+	start := time.Now()
+	resp, err := doProbeRequest(ctx, httpClient, baseURL)
+	result.Elapsed = time.Since(start)
+	if err != nil {
+		result.Problem = fmt.Sprintf("서버에 연결할 수 없습니다: %v", err)
+		result.Hint = networkHint()
+		return result
+	}
+	defer resp.Body.Close()
+
+	// Any HTTP response means the network path works; API errors are checked separately
+	result.OK = true
+	result.StatusCode = resp.StatusCode
+	return result
+}
+
+// ProbeSearch runs a one-item test search and checks HTTP status, HTML error pages and response parsing
+func ProbeSearch(ctx context.Context, httpClient *http.Client, baseURL, apiKey string, target ProbeTarget) ProbeResult {
+	result := ProbeResult{Name: target.Name}
+
+	if apiKey == "" {
+		result.Problem = "API 키가 설정되지 않았습니다"
+		result.Hint = "'warp config set law.key YOUR_EMAIL_ID' 명령으로 설정하세요"
+		return result
+	}
+
+	params := url.Values{}
+	params.Set("OC", apiKey)
+	params.Set("target", target.Target)
+	params.Set("type", "JSON")
+	params.Set("query", probeQuery)
+	params.Set("display", "1")
+	params.Set("page", "1")
+
+	start := time.Now()
+	resp, err := doProbeRequest(ctx, httpClient, fmt.Sprintf("%s?%s", baseURL, params.Encode()))
+	result.Elapsed = time.Since(start)
+	if err != nil {
+		result.Problem = fmt.Sprintf("요청 실패: %v", err)
+		result.Hint = networkHint()
+		return result
+	}
+	defer resp.Body.Close()
+	result.StatusCode = resp.StatusCode
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		result.Problem = fmt.Sprintf("응답 읽기 실패: %v", err)
+		result.Hint = networkHint()
+		return result
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		result.Problem = fmt.Sprintf("HTTP %d 응답", resp.StatusCode)
+		result.Hint = "국가법령정보센터 서비스 상태를 확인하거나 잠시 후 다시 시도하세요"
+		return result
+	}
+
+	trimmed := strings.TrimSpace(string(body))
+	if strings.HasPrefix(trimmed, "<") {
+		result.Problem = "HTML 에러 페이지 응답"
+		result.Hint = ParseHTMLError(trimmed)
+		return result
+	}
+
+	var parsed map[string]json.RawMessage
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		result.Problem = fmt.Sprintf("응답 파싱 실패: %v", err)
+		result.Hint = "API 응답 형식이 변경되었을 수 있습니다. 최신 버전으로 업데이트하세요"
+		return result
+	}
+	if _, ok := parsed[target.Wrapper]; !ok {
+		result.Problem = fmt.Sprintf("예상하지 못한 응답 구조 (%s 없음)", target.Wrapper)
+		result.Hint = "API 응답 형식이 변경되었을 수 있습니다. 최신 버전으로 업데이트하세요"
+		return result
+	}
+
+	result.OK = true
+	return result
+}
+
+// doProbeRequest performs a single GET request without retries
+func doProbeRequest(ctx context.Context, httpClient *http.Client, rawURL string) (*http.Response, error) {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: DefaultTimeout, Transport: Transport()}
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	return doHTTP(httpClient, req)
+}
+
+// networkHint returns a hint for connection failures, mentioning proxy settings when present
+func networkHint() string {
+	for _, name := range []string{"HTTPS_PROXY", "https_proxy", "HTTP_PROXY", "http_proxy"} {
+		if proxy := os.Getenv(name); proxy != "" {
+			return fmt.Sprintf("프록시 설정(%s=%s)을 확인하세요", name, proxy)
+		}
+	}
+	return "인터넷 연결과 방화벽 설정을 확인하세요. 프록시가 필요하면 HTTPS_PROXY 환경변수나 'warp config set api.proxy'로 설정하세요"
+}
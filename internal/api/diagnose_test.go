@@ -0,0 +1,133 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestProbeSearch(t *testing.T) {
+	tests := []struct {
+		name        string
+		apiKey      string
+		status      int
+		body        string
+		target      ProbeTarget
+		wantOK      bool
+		wantProblem string
+		wantHint    string
+	}{
+		{
+			name:   "NLIC success",
+			apiKey: "test-key",
+			status: http.StatusOK,
+			body:   `{"LawSearch": {"totalCnt": "1", "law": []}}`,
+			target: ProbeNLIC,
+			wantOK: true,
+		},
+		{
+			name:   "ELIS success",
+			apiKey: "test-key",
+			status: http.StatusOK,
+			body:   `{"OrdinSearch": {"resultCode": "00"}}`,
+			target: ProbeELIS,
+			wantOK: true,
+		},
+		{
+			name:        "Missing API key",
+			target:      ProbeNLIC,
+			wantProblem: "API 키가 설정되지 않았습니다",
+			wantHint:    "warp config set",
+		},
+		{
+			name:        "HTML error page reuses ParseHTMLError hint",
+			apiKey:      "test-key",
+			status:      http.StatusOK,
+			body:        `<html><body>미신청된 목록/본문에 대한 접근입니다</body></html>`,
+			target:      ProbeELIS,
+			wantProblem: "HTML 에러 페이지",
+			wantHint:    "OPEN API 신청",
+		},
+		{
+			name:        "HTTP error status",
+			apiKey:      "test-key",
+			status:      http.StatusInternalServerError,
+			target:      ProbeNLIC,
+			wantProblem: "HTTP 500",
+		},
+		{
+			name:        "Invalid JSON",
+			apiKey:      "test-key",
+			status:      http.StatusOK,
+			body:        `{invalid`,
+			target:      ProbeNLIC,
+			wantProblem: "응답 파싱 실패",
+		},
+		{
+			name:        "Unexpected structure",
+			apiKey:      "test-key",
+			status:      http.StatusOK,
+			body:        `{"Other": {}}`,
+			target:      ProbeNLIC,
+			wantProblem: "LawSearch 없음",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotQuery map[string][]string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotQuery = r.URL.Query()
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			result := ProbeSearch(context.Background(), &http.Client{Timeout: 5 * time.Second}, server.URL, tt.apiKey, tt.target)
+
+			if result.OK != tt.wantOK {
+				t.Errorf("OK = %v, want %v (problem: %s)", result.OK, tt.wantOK, result.Problem)
+			}
+			if tt.wantProblem != "" && !strings.Contains(result.Problem, tt.wantProblem) {
+				t.Errorf("Problem = %q, want it to contain %q", result.Problem, tt.wantProblem)
+			}
+			if tt.wantHint != "" && !strings.Contains(result.Hint, tt.wantHint) {
+				t.Errorf("Hint = %q, want it to contain %q", result.Hint, tt.wantHint)
+			}
+			if tt.apiKey != "" {
+				if got := gotQuery["target"]; len(got) != 1 || got[0] != tt.target.Target {
+					t.Errorf("target = %v, want %s", got, tt.target.Target)
+				}
+				if got := gotQuery["display"]; len(got) != 1 || got[0] != "1" {
+					t.Errorf("display = %v, want 1", got)
+				}
+			}
+		})
+	}
+}
+
+func TestProbeConnectivity(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+
+	// Any HTTP response counts as reachable
+	result := ProbeConnectivity(context.Background(), nil, server.URL)
+	if !result.OK || result.StatusCode != http.StatusBadRequest {
+		t.Errorf("expected reachable server, got %+v", result)
+	}
+
+	// A closed server is a network failure with a hint
+	server.Close()
+	t.Setenv("HTTPS_PROXY", "http://proxy.example:8080")
+	result = ProbeConnectivity(context.Background(), nil, server.URL)
+	if result.OK {
+		t.Error("expected connectivity failure for closed server")
+	}
+	if !strings.Contains(result.Hint, "proxy.example") {
+		t.Errorf("Hint should mention proxy settings, got %q", result.Hint)
+	}
+}
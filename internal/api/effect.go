@@ -0,0 +1,68 @@
+package api
+
+import (
+	"time"
+)
+
+// EffectDateLayout is the layout of LawInfo.EffectDate (YYYYMMDD)
+const EffectDateLayout = "20060102"
+
+// EffectStatus classifies a law by its effective date relative to a reference date
+type EffectStatus int
+
+const (
+	// EffectUnknown means the effective date is missing or not in YYYYMMDD form
+	EffectUnknown EffectStatus = iota
+	// EffectInForce means the law took effect on or before the reference date
+	EffectInForce
+	// EffectUpcoming means the law takes effect after the reference date
+	EffectUpcoming
+)
+
+// String returns the Korean label of the status
+func (s EffectStatus) String() string {
+	switch s {
+	case EffectInForce:
+		return "시행 중"
+	case EffectUpcoming:
+		return "시행 예정"
+	default:
+		return "판정 불가"
+	}
+}
+
+// ParseEffectDate parses a YYYYMMDD date. Any other form is rejected.
+func ParseEffectDate(value string) (time.Time, error) {
+	return time.Parse(EffectDateLayout, value)
+}
+
+// EffectStatusAt classifies the law by its effective date as of the given date.
+// A law whose effective date is the reference date itself is in force.
+func (l LawInfo) EffectStatusAt(asOf time.Time) EffectStatus {
+	effect, err := ParseEffectDate(l.EffectDate)
+	if err != nil {
+		return EffectUnknown
+	}
+	// Compare calendar dates only, ignoring the time of day and time zone of asOf
+	y, m, d := asOf.Date()
+	if effect.After(time.Date(y, m, d, 0, 0, 0, 0, time.UTC)) {
+		return EffectUpcoming
+	}
+	return EffectInForce
+}
+
+// FilterLawsByEffect returns the laws with the given status as of the given date,
+// along with the number of laws whose status could not be determined
+func FilterLawsByEffect(laws []LawInfo, status EffectStatus, asOf time.Time) ([]LawInfo, int) {
+	filtered := make([]LawInfo, 0, len(laws))
+	unknown := 0
+	for _, law := range laws {
+		switch law.EffectStatusAt(asOf) {
+		case status:
+			filtered = append(filtered, law)
+		case EffectUnknown:
+			unknown++
+		}
+	}
+	return filtered, unknown
+}
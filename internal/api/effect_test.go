@@ -0,0 +1,62 @@
+package api
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEffectStatusAt(t *testing.T) {
+	// Late evening in Seoul must still count as the same calendar day
+	asOf := time.Date(2024, 3, 15, 23, 30, 0, 0, time.FixedZone("KST", 9*60*60))
+
+	tests := []struct {
+		name       string
+		effectDate string
+		want       EffectStatus
+	}{
+		{"past", "20240101", EffectInForce},
+		{"same day", "20240315", EffectInForce},
+		{"next day", "20240316", EffectUpcoming},
+		{"far future", "20300101", EffectUpcoming},
+		{"empty", "", EffectUnknown},
+		{"dotted", "2024.03.16", EffectUnknown},
+		{"invalid date", "20241345", EffectUnknown},
+		{"too short", "202403", EffectUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			law := LawInfo{EffectDate: tt.effectDate}
+			if got := law.EffectStatusAt(asOf); got != tt.want {
+				t.Errorf("EffectStatusAt(%q) = %v, want %v", tt.effectDate, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilterLawsByEffect(t *testing.T) {
+	asOf := time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)
+	laws := []LawInfo{
+		{Name: "시행 중", EffectDate: "20240101"},
+		{Name: "오늘 시행", EffectDate: "20240315"},
+		{Name: "시행 예정", EffectDate: "20240401"},
+		{Name: "날짜 없음", EffectDate: ""},
+		{Name: "비표준", EffectDate: "2024-04-01"},
+	}
+
+	upcoming, unknown := FilterLawsByEffect(laws, EffectUpcoming, asOf)
+	if len(upcoming) != 1 || upcoming[0].Name != "시행 예정" {
+		t.Errorf("upcoming = %v, want [시행 예정]", upcoming)
+	}
+	if unknown != 2 {
+		t.Errorf("unknown = %d, want 2", unknown)
+	}
+
+	inForce, unknown := FilterLawsByEffect(laws, EffectInForce, asOf)
+	if len(inForce) != 2 || inForce[0].Name != "시행 중" || inForce[1].Name != "오늘 시행" {
+		t.Errorf("in force = %v, want [시행 중 오늘 시행]", inForce)
+	}
+	if unknown != 2 {
+		t.Errorf("unknown = %d, want 2", unknown)
+	}
+}
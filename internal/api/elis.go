@@ -6,12 +6,12 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
-	"strconv"
 	"strings"
 	"time"
 
 	"github.com/pyhub-apps/pyhub-warp-cli/internal/config"
 	"github.com/pyhub-apps/pyhub-warp-cli/internal/logger"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/security"
 )
 
 // ELISClient handles ELIS (자치법규정보시스템) API requests
@@ -28,9 +28,7 @@ type ELISClient struct {
 // NewELISClient creates a new ELIS API client
 func NewELISClient(apiKey string) *ELISClient {
 	return &ELISClient{
-		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
-		},
+		httpClient:     newHTTPClient(),
 		baseURL:        "https://www.law.go.kr/DRF/lawSearch.do",  // 자치법규 목록
 		detailURL:      "https://www.law.go.kr/DRF/lawService.do", // 자치법규 본문
 		apiKey:         apiKey,
@@ -64,42 +62,37 @@ type OrdinanceDetail struct {
 // OrdinanceSearchResponse represents the search response for ordinances
 type OrdinanceSearchResponse struct {
 	OrdinSearch struct {
-		ResultCode string                   `json:"resultCode"`
-		ResultMsg  string                   `json:"resultMsg"`
-		TotalCnt   string                   `json:"totalCnt"`
-		Page       string                   `json:"page"`
-		NumOfRows  string                   `json:"numOfRows"`
-		Law        []map[string]interface{} `json:"law"`
+		ResultCode string         `json:"resultCode"`
+		ResultMsg  string         `json:"resultMsg"`
+		TotalCnt   responseNumber `json:"totalCnt"`
+		Page       responseNumber `json:"page"`
+		NumOfRows  responseNumber `json:"numOfRows"`
+		Law        responseItems  `json:"law"`
 	} `json:"OrdinSearch"`
 }
 
 // Search searches for local ordinances
 func (c *ELISClient) Search(ctx context.Context, req *UnifiedSearchRequest) (*SearchResponse, error) {
 	// Build query parameters
-	params := url.Values{}
-	params.Set("OC", c.apiKey)
-	params.Set("target", "ordin") // 자치법규 대상
+	params := buildSearchParams(c.apiKey, "ordin", req) // 자치법규 대상
+	params.Set("type", "json")
 
-	// Add region to query if provided
-	query := req.Query
-	if req.Region != "" {
-		query = req.Region + " " + query
+	// A metropolitan region is filtered by the API with its code; the rest of
+	// the filter (a 시군구 or an unknown region) is matched on the results,
+	// rather than mixed into the query where it matches the text of ordinances
+	region := parseRegionFilter(req.Region)
+	if region.region != nil {
+		params.Set("org", region.region.Code)
 	}
-	params.Set("query", query)
 
-	params.Set("page", fmt.Sprintf("%d", req.PageNo))
-	params.Set("display", fmt.Sprintf("%d", req.PageSize))
-	params.Set("type", "json")
-
-	// Add sort order
-	if req.Sort != "" {
-		params.Set("sort", req.Sort)
-	} else {
-		params.Set("sort", "date") // 기본값: 날짜순
+	// Without a sort key the newest ordinances come first
+	if req.Sort == "" {
+		sort, _ := sortParam("ordin", SortPromulDate, OrderDesc)
+		params.Set("sort", sort)
 	}
 
 	fullURL := fmt.Sprintf("%s?%s", c.baseURL, params.Encode())
-	logger.Debug("ELIS API Request URL: %s", fullURL)
+	logger.Debug("ELIS API Request URL: %s", security.SanitizeURL(fullURL))
 
 	// Make request with retry logic
 	body, err := c.doRequestWithRetry(ctx, fullURL)
@@ -113,13 +106,9 @@ func (c *ELISClient) Search(ctx context.Context, req *UnifiedSearchRequest) (*Se
 		// Check if response is HTML (error page)
 		bodyStr := string(body)
 		if strings.HasPrefix(strings.TrimSpace(bodyStr), "<!DOCTYPE") || strings.HasPrefix(strings.TrimSpace(bodyStr), "<html") {
-			errorMsg := c.parseHTMLError(bodyStr)
-			logger.Debug("HTML error response detected: %s", errorMsg)
-			// Check if it's an API key error
-			if strings.Contains(errorMsg, "API 인증 실패") || strings.Contains(errorMsg, "API 키") {
-				return nil, &APIKeyError{Message: errorMsg}
-			}
-			return nil, fmt.Errorf("%s", errorMsg)
+			htmlErr := c.parseHTMLError(bodyStr)
+			logger.Debug("HTML error response detected: %v", htmlErr)
+			return nil, htmlErr
 		}
 		logger.Debug("Response body: %s", bodyStr)
 		return nil, fmt.Errorf("응답 파싱 실패: %w", err)
@@ -130,20 +119,16 @@ func (c *ELISClient) Search(ctx context.Context, req *UnifiedSearchRequest) (*Se
 		return nil, fmt.Errorf("API 오류: %s", elisResp.OrdinSearch.ResultMsg)
 	}
 
-	// Parse total count and page
-	totalCount := 0
-	if tc, err := strconv.Atoi(elisResp.OrdinSearch.TotalCnt); err == nil {
-		totalCount = tc
-	}
-	page := 1
-	if p, err := strconv.Atoi(elisResp.OrdinSearch.Page); err == nil {
-		page = p
-	}
+	// Parse total count and page; without a total count the results of the
+	// page are all that is known
+	totalCount := parseResponseNumber("totalCnt", elisResp.OrdinSearch.TotalCnt, len(elisResp.OrdinSearch.Law))
+	page := parseResponseNumber("page", elisResp.OrdinSearch.Page, 1)
 
 	// Convert to unified SearchResponse
 	searchResp := &SearchResponse{
 		TotalCount: totalCount,
 		Page:       page,
+		PageSize:   req.PageSize,
 		Laws:       make([]LawInfo, 0, len(elisResp.OrdinSearch.Law)),
 	}
 
@@ -178,8 +163,19 @@ func (c *ELISClient) Search(ctx context.Context, req *UnifiedSearchRequest) (*Se
 			law.EffectDate = v
 		}
 
+		if !region.match(law.Department) {
+			continue
+		}
 		searchResp.Laws = append(searchResp.Laws, law)
 	}
+	if region.local != "" {
+		logger.Debug("ELIS 지역 필터(%s): 현재 페이지 %d개 중 %d개", region.local, len(elisResp.OrdinSearch.Law), len(searchResp.Laws))
+		searchResp.TotalCount = len(searchResp.Laws)
+	}
+
+	// Apply the requested order to the page as well, so every source sorts alike
+	SortLaws(searchResp.Laws, req.Sort, req.Order)
+	PrioritizeLaws(searchResp.Laws, req.Priority)
 
 	return searchResp, nil
 }
@@ -193,7 +189,7 @@ func (c *ELISClient) GetDetail(ctx context.Context, ordinanceID string) (*LawDet
 	params.Set("type", "json")
 
 	fullURL := fmt.Sprintf("%s?%s", c.detailURL, params.Encode())
-	logger.Debug("ELIS Detail API Request URL: %s", fullURL)
+	logger.Debug("ELIS Detail API Request URL: %s", security.SanitizeURL(fullURL))
 
 	body, err := c.doRequestWithRetry(ctx, fullURL)
 	if err != nil {
@@ -206,22 +202,16 @@ func (c *ELISClient) GetDetail(ctx context.Context, ordinanceID string) (*LawDet
 		// Check if response is HTML (error page)
 		bodyStr := string(body)
 		if strings.HasPrefix(strings.TrimSpace(bodyStr), "<!DOCTYPE") || strings.HasPrefix(strings.TrimSpace(bodyStr), "<html") {
-			errorMsg := c.parseHTMLError(bodyStr)
-			logger.Debug("HTML error response detected: %s", errorMsg)
-			// Check if it's an API key error
-			if strings.Contains(errorMsg, "API 인증 실패") || strings.Contains(errorMsg, "API 키") {
-				return nil, &APIKeyError{Message: errorMsg}
-			}
-			return nil, fmt.Errorf("%s", errorMsg)
+			htmlErr := c.parseHTMLError(bodyStr)
+			logger.Debug("HTML error response detected: %v", htmlErr)
+			return nil, htmlErr
 		}
 		return nil, fmt.Errorf("자치법규 상세 정보 파싱 실패: %w", err)
 	}
 
 	// Check for error message
-	if lawMsg, ok := detailResp["Law"].(string); ok {
-		if strings.Contains(lawMsg, "일치하는 자치법규가 없습니다") {
-			return nil, fmt.Errorf("자치법규 상세 정보를 찾을 수 없습니다")
-		}
+	if _, ok := detailNotFound(body); ok {
+		return nil, newStatusError(ErrNotFound, 0, "자치법규 상세 정보를 찾을 수 없습니다")
 	}
 
 	// Parse LawService response
@@ -322,14 +312,13 @@ func (c *ELISClient) doRequestWithRetry(ctx context.Context, url string) ([]byte
 
 	for attempt := 0; attempt < c.maxRetries; attempt++ {
 		if attempt > 0 {
-			// Exponential backoff
-			delay := c.retryBaseDelay * time.Duration(1<<uint(attempt-1))
+			// Exponential backoff with jitter, or the wait asked with Retry-After
+			delay := newBackoff(c.retryBaseDelay).RetryDelay(attempt, lastErr)
 			logger.Debug("Retrying after %v (attempt %d/%d)", delay, attempt+1, c.maxRetries)
 
-			select {
-			case <-time.After(delay):
-			case <-ctx.Done():
-				return nil, ctx.Err()
+			recordRetry()
+			if err := sleepContext(ctx, delay); err != nil {
+				return nil, err
 			}
 		}
 
@@ -338,9 +327,12 @@ func (c *ELISClient) doRequestWithRetry(ctx context.Context, url string) ([]byte
 			return nil, fmt.Errorf("요청 생성 실패: %w", err)
 		}
 
-		resp, err := c.httpClient.Do(req)
+		resp, err := doHTTP(c.httpClient, req)
 		if err != nil {
-			lastErr = fmt.Errorf("HTTP 요청 실패: %w", err)
+			lastErr = requestError(ctx, err)
+			if !isRetryable(lastErr) {
+				return nil, lastErr
+			}
 			continue
 		}
 		defer resp.Body.Close()
@@ -349,12 +341,12 @@ func (c *ELISClient) doRequestWithRetry(ctx context.Context, url string) ([]byte
 		if resp.StatusCode == http.StatusServiceUnavailable ||
 			resp.StatusCode == http.StatusTooManyRequests ||
 			resp.StatusCode >= 500 {
-			lastErr = fmt.Errorf("서버 에러: HTTP %d", resp.StatusCode)
+			lastErr = &RetryableError{Err: withRetryAfter(newStatusError(StatusKind(resp.StatusCode), resp.StatusCode, "서버 에러: HTTP %d", resp.StatusCode), resp)}
 			continue
 		}
 
 		if resp.StatusCode != http.StatusOK {
-			return nil, fmt.Errorf("클라이언트 에러: HTTP %d", resp.StatusCode)
+			return nil, newStatusError(StatusKind(resp.StatusCode), resp.StatusCode, "클라이언트 에러: HTTP %d", resp.StatusCode)
 		}
 
 		// Read response body
@@ -389,8 +381,8 @@ func (c *ELISClient) GetAPIType() APIType {
 	return APITypeELIS
 }
 
-// parseHTMLError extracts meaningful error message from HTML error page
-func (c *ELISClient) parseHTMLError(html string) string {
+// parseHTMLError converts an HTML error page to an error of the matching kind
+func (c *ELISClient) parseHTMLError(html string) error {
 	// Common patterns for error messages in HTML pages
 	patterns := []struct {
 		start string
@@ -413,17 +405,17 @@ func (c *ELISClient) parseHTMLError(html string) string {
 	// Check for authentication/key related issues
 	if strings.Contains(htmlLower, "인증") || strings.Contains(htmlLower, "auth") ||
 		strings.Contains(htmlLower, "key") || strings.Contains(htmlLower, "키") {
-		return "API 인증 실패: API 키가 유효하지 않거나 만료되었습니다. 'warp config set law.key YOUR_API_KEY' 명령으로 올바른 API 키를 설정하세요"
+		return &APIKeyError{Message: "API 인증 실패: API 키가 유효하지 않거나 만료되었습니다. 'warp config set law.key YOUR_API_KEY' 명령으로 올바른 API 키를 설정하세요"}
 	}
 
 	// Check for rate limit
 	if strings.Contains(htmlLower, "limit") || strings.Contains(htmlLower, "제한") {
-		return "API 호출 제한 초과: 일일 호출 한도를 초과했습니다. 잠시 후 다시 시도하세요"
+		return newStatusError(ErrRateLimited, 0, "API 호출 제한 초과: 일일 호출 한도를 초과했습니다. 잠시 후 다시 시도하세요")
 	}
 
 	// Check for service unavailable
 	if strings.Contains(htmlLower, "maintenance") || strings.Contains(htmlLower, "점검") {
-		return "서비스 점검 중: 자치법규정보시스템 API가 현재 점검 중입니다"
+		return newStatusError(ErrServiceUnavailable, 0, "서비스 점검 중: 자치법규정보시스템 API가 현재 점검 중입니다")
 	}
 
 	// Try to extract error message from patterns
@@ -441,16 +433,16 @@ func (c *ELISClient) parseHTMLError(html string) string {
 					// Check if it's just a generic title
 					if strings.Contains(msg, "국가법령정보") && !strings.Contains(msg, "오류") {
 						// Generic title, return more specific message
-						return "API 인증 실패: API 키가 올바르지 않습니다. 'warp config set law.key YOUR_API_KEY' 명령으로 유효한 API 키를 설정하세요"
+						return &APIKeyError{Message: "API 인증 실패: API 키가 올바르지 않습니다. 'warp config set law.key YOUR_API_KEY' 명령으로 유효한 API 키를 설정하세요"}
 					}
-					return fmt.Sprintf("API 오류: %s", msg)
+					return fmt.Errorf("API 오류: %s", msg)
 				}
 			}
 		}
 	}
 
 	// Default message if no specific error found
-	return "API 요청 실패: 서버가 예상하지 못한 응답을 반환했습니다. API 키를 확인하거나 잠시 후 다시 시도하세요"
+	return &APIKeyError{Message: "API 요청 실패: 서버가 예상하지 못한 응답을 반환했습니다. API 키를 확인하거나 잠시 후 다시 시도하세요"}
 }
 
 // Ensure ELISClient implements ClientInterface
@@ -0,0 +1,101 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/logger"
+)
+
+// responseNumber is a number of an API response, which ELIS sends as a string
+// ("1356", "1,356", " 12 ") or, at times, as a JSON number
+type responseNumber string
+
+// UnmarshalJSON accepts a string, a number or null
+func (n *responseNumber) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	switch {
+	case bytes.Equal(data, []byte("null")):
+		*n = ""
+		return nil
+	case len(data) > 0 && data[0] == '"':
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		*n = responseNumber(s)
+		return nil
+	default:
+		var number json.Number
+		if err := json.Unmarshal(data, &number); err != nil {
+			return fmt.Errorf("숫자가 아닌 값: %s", data)
+		}
+		*n = responseNumber(number.String())
+		return nil
+	}
+}
+
+// parseResponseNumber parses the number field of a response, ignoring thousands
+// separators and spaces. A value that is still not a number is recovered from
+// its leading digits when it has some ("1356건"), and otherwise replaced by
+// fallback; both are logged. An empty value is fallback.
+func parseResponseNumber(field string, value responseNumber, fallback int) int {
+	cleaned := strings.Map(func(r rune) rune {
+		if r == ',' || unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, string(value))
+	if cleaned == "" {
+		return fallback
+	}
+	if n, err := strconv.Atoi(cleaned); err == nil {
+		return n
+	}
+
+	if end := strings.IndexFunc(cleaned, func(r rune) bool { return r < '0' || r > '9' }); end > 0 {
+		if n, err := strconv.Atoi(cleaned[:end]); err == nil {
+			logger.Warn("응답의 %s 값 %q를 %d(으)로 읽었습니다", field, string(value), n)
+			return n
+		}
+	}
+	logger.Warn("응답의 %s 값 %q를 숫자로 읽을 수 없어 %d(으)로 처리합니다", field, string(value), fallback)
+	return fallback
+}
+
+// responseItems are the results of a response, which an API sends as an
+// array, as a single object when there is one result, or as null or an empty
+// string when there is none
+type responseItems []map[string]interface{}
+
+// UnmarshalJSON accepts an array of objects, an object, null or a string
+func (items *responseItems) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		*items = nil
+		return nil
+	}
+	switch data[0] {
+	case '[':
+		var list []map[string]interface{}
+		if err := json.Unmarshal(data, &list); err != nil {
+			return err
+		}
+		*items = list
+	case '{':
+		var item map[string]interface{}
+		if err := json.Unmarshal(data, &item); err != nil {
+			return err
+		}
+		*items = responseItems{item}
+	case 'n', '"':
+		*items = nil
+	default:
+		return fmt.Errorf("결과 목록이 아닌 값: %s", data)
+	}
+	return nil
+}
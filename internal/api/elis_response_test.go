@@ -0,0 +1,137 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/testutil"
+)
+
+func TestParseResponseNumber(t *testing.T) {
+	tests := []struct {
+		value responseNumber
+		want  int
+	}{
+		{"1356", 1356},
+		{"1,356", 1356},
+		{" 12 ", 12},
+		{"1 234 567", 1234567},
+		{"1,356건", 1356},
+		{"", -1},
+		{"없음", -1},
+		{"-", -1},
+	}
+	for _, tt := range tests {
+		if got := parseResponseNumber("totalCnt", tt.value, -1); got != tt.want {
+			t.Errorf("parseResponseNumber(%q) = %d, want %d", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestOrdinanceSearchResponseUnmarshal(t *testing.T) {
+	tests := []struct {
+		name      string
+		body      string
+		wantTotal responseNumber
+		wantIDs   []string
+	}{
+		{
+			name:      "array of results",
+			body:      `{"OrdinSearch": {"totalCnt": "1,356", "law": [{"자치법규ID": "E1"}, {"자치법규ID": "E2"}]}}`,
+			wantTotal: "1,356",
+			wantIDs:   []string{"E1", "E2"},
+		},
+		{
+			name:      "single object",
+			body:      `{"OrdinSearch": {"totalCnt": "1", "law": {"자치법규ID": "E1"}}}`,
+			wantTotal: "1",
+			wantIDs:   []string{"E1"},
+		},
+		{
+			name:      "numeric count and no results",
+			body:      `{"OrdinSearch": {"totalCnt": 0, "law": null}}`,
+			wantTotal: "0",
+		},
+		{
+			name:      "empty string for no results",
+			body:      `{"OrdinSearch": {"totalCnt": "0", "law": ""}}`,
+			wantTotal: "0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var resp OrdinanceSearchResponse
+			if err := json.Unmarshal([]byte(tt.body), &resp); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+			var ids []string
+			for _, item := range resp.OrdinSearch.Law {
+				ids = append(ids, item["자치법규ID"].(string))
+			}
+			if resp.OrdinSearch.TotalCnt != tt.wantTotal || !reflect.DeepEqual(ids, tt.wantIDs) {
+				t.Errorf("totalCnt = %q, ids = %v, want %q, %v", resp.OrdinSearch.TotalCnt, ids, tt.wantTotal, tt.wantIDs)
+			}
+		})
+	}
+
+	var resp OrdinanceSearchResponse
+	if err := json.Unmarshal([]byte(`{"OrdinSearch": {"law": 3}}`), &resp); err == nil {
+		t.Error("Unmarshal() of a number as results succeeded, want an error")
+	}
+}
+
+func TestELISClient_SearchLooseResponse(t *testing.T) {
+	server := testutil.NewAPIServer(t)
+	client := NewELISClient("test-key")
+	client.baseURL = server.URL
+	client.retryBaseDelay = time.Millisecond
+
+	tests := []struct {
+		name      string
+		body      string
+		wantTotal int
+		wantPage  int
+		wantIDs   []string
+	}{
+		{
+			name:      "count with a thousands separator",
+			body:      `{"OrdinSearch": {"resultCode": "00", "totalCnt": "1,356", "page": " 2 ", "law": [{"자치법규ID": "E1"}, {"자치법규ID": "E2"}]}}`,
+			wantTotal: 1356,
+			wantPage:  2,
+			wantIDs:   []string{"E1", "E2"},
+		},
+		{
+			name:      "single result as an object",
+			body:      `{"OrdinSearch": {"resultCode": "00", "totalCnt": "1", "page": "1", "law": {"자치법규ID": "E1", "자치법규명": "주차장 조례"}}}`,
+			wantTotal: 1,
+			wantPage:  1,
+			wantIDs:   []string{"E1"},
+		},
+		{
+			name:      "unreadable count falls back to the results",
+			body:      `{"OrdinSearch": {"resultCode": "00", "totalCnt": "N/A", "law": [{"자치법규ID": "E1"}]}}`,
+			wantTotal: 1,
+			wantPage:  1,
+			wantIDs:   []string{"E1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server.Handle(testutil.TargetOrdinance, testutil.Reply{Body: tt.body})
+			resp, err := client.Search(context.Background(), &UnifiedSearchRequest{Query: "주차", PageNo: 1, PageSize: 10})
+			if err != nil {
+				t.Fatalf("Search() error = %v", err)
+			}
+			var ids []string
+			for _, law := range resp.Laws {
+				ids = append(ids, law.ID)
+			}
+			if resp.TotalCount != tt.wantTotal || resp.Page != tt.wantPage || !reflect.DeepEqual(ids, tt.wantIDs) {
+				t.Errorf("Search() = total %d, page %d, ids %v, want %d, %d, %v", resp.TotalCount, resp.Page, ids, tt.wantTotal, tt.wantPage, tt.wantIDs)
+			}
+		})
+	}
+}
@@ -2,7 +2,10 @@ package api
 
 import (
 	"errors"
+	"fmt"
+	"net/http"
 	"strings"
+	"time"
 )
 
 var (
@@ -14,8 +17,69 @@ var (
 
 	// ErrInvalidAPIType indicates an invalid API type was specified
 	ErrInvalidAPIType = errors.New("잘못된 API 타입입니다")
+
+	// ErrOffline indicates that a lookup in offline mode is not in the cache
+	ErrOffline = errors.New("오프라인 모드: 캐시에 없음")
 )
 
+// Kinds of API failures. Client errors wrap one of them so that callers can
+// tell them apart with errors.Is instead of matching messages, which differ by
+// client and change over time. An *APIKeyError also matches ErrUnauthorized.
+var (
+	// ErrUnauthorized indicates that the API key was rejected or lacks permission
+	ErrUnauthorized = errors.New("API 인증 실패")
+
+	// ErrRateLimited indicates that the call limit of the API was exceeded
+	ErrRateLimited = errors.New("API 호출 한도 초과")
+
+	// ErrNotFound indicates that the requested law or document does not exist
+	ErrNotFound = errors.New("요청한 자료를 찾을 수 없음")
+
+	// ErrServiceUnavailable indicates a server error or maintenance
+	ErrServiceUnavailable = errors.New("API 서비스를 일시적으로 이용할 수 없음")
+)
+
+// StatusError is an API failure with its kind. StatusCode is the HTTP status
+// of the response, 0 when the failure was read from an error page. Kind is nil
+// for statuses without a kind, such as 400. RetryAfter is the wait asked for by
+// the Retry-After header of a 429 or 503 response, 0 without one.
+type StatusError struct {
+	Kind       error
+	StatusCode int
+	Message    string
+	RetryAfter time.Duration
+}
+
+func (e *StatusError) Error() string {
+	return e.Message
+}
+
+// Unwrap returns the kind, so errors.Is(err, ErrRateLimited) and the like work
+func (e *StatusError) Unwrap() error {
+	return e.Kind
+}
+
+// newStatusError creates a StatusError with a formatted message
+func newStatusError(kind error, statusCode int, format string, args ...interface{}) *StatusError {
+	return &StatusError{Kind: kind, StatusCode: statusCode, Message: fmt.Sprintf(format, args...)}
+}
+
+// StatusKind returns the kind of failure of an HTTP status code, nil for
+// statuses without one
+func StatusKind(statusCode int) error {
+	switch {
+	case statusCode == http.StatusUnauthorized, statusCode == http.StatusForbidden:
+		return ErrUnauthorized
+	case statusCode == http.StatusNotFound:
+		return ErrNotFound
+	case statusCode == http.StatusTooManyRequests:
+		return ErrRateLimited
+	case statusCode >= 500:
+		return ErrServiceUnavailable
+	}
+	return nil
+}
+
 // ParseHTMLError extracts meaningful error message from HTML error page
 func ParseHTMLError(html string) string {
 	// Check for specific error messages in the HTML response
@@ -54,3 +118,27 @@ func ParseHTMLError(html string) string {
 	// Default error message
 	return "국가법령정보센터 API에서 오류가 발생했습니다. 이메일 ID를 확인하거나 잠시 후 다시 시도해주세요"
 }
+
+// httpStatusError converts a non-OK HTTP status to an error of its kind.
+// Rate limits, timeouts and server errors are wrapped in a RetryableError.
+func httpStatusError(statusCode int) error {
+	switch statusCode {
+	case http.StatusTooManyRequests: // 429
+		return &RetryableError{Err: newStatusError(ErrRateLimited, statusCode, "레이트 리밋: HTTP 429 (잠시 후 다시 시도하세요)")}
+	case http.StatusRequestTimeout: // 408
+		return &RetryableError{Err: newStatusError(nil, statusCode, "요청 타임아웃: HTTP 408")}
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout: // 502, 503, 504
+		return &RetryableError{Err: newStatusError(ErrServiceUnavailable, statusCode, "일시적 서버 오류: HTTP %d", statusCode)}
+	case http.StatusInternalServerError: // 500
+		return &RetryableError{Err: newStatusError(ErrServiceUnavailable, statusCode, "내부 서버 오류: HTTP 500")}
+	case http.StatusUnauthorized, http.StatusForbidden: // 401, 403
+		// Authentication errors - not retryable
+		return newStatusError(ErrUnauthorized, statusCode, "인증 실패: HTTP %d - API 키를 확인하세요", statusCode)
+	default:
+		if statusCode >= 500 {
+			return &RetryableError{Err: newStatusError(ErrServiceUnavailable, statusCode, "서버 에러: HTTP %d", statusCode)}
+		}
+		// 4xx errors - not retryable
+		return newStatusError(StatusKind(statusCode), statusCode, "클라이언트 에러: HTTP %d", statusCode)
+	}
+}
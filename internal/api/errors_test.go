@@ -0,0 +1,157 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHTTPStatusErrorKinds(t *testing.T) {
+	tests := []struct {
+		status    int
+		kind      error
+		retryable bool
+	}{
+		{http.StatusUnauthorized, ErrUnauthorized, false},
+		{http.StatusForbidden, ErrUnauthorized, false},
+		{http.StatusNotFound, ErrNotFound, false},
+		{http.StatusTooManyRequests, ErrRateLimited, true},
+		{http.StatusInternalServerError, ErrServiceUnavailable, true},
+		{http.StatusBadGateway, ErrServiceUnavailable, true},
+		{http.StatusServiceUnavailable, ErrServiceUnavailable, true},
+		{http.StatusGatewayTimeout, ErrServiceUnavailable, true},
+		{http.StatusRequestTimeout, nil, true},
+		{http.StatusBadRequest, nil, false},
+	}
+
+	kinds := []error{ErrUnauthorized, ErrNotFound, ErrRateLimited, ErrServiceUnavailable}
+	clients := map[string]func(int) error{
+		"nlic":   (&NLICClient{}).handleHTTPError,
+		"admrul": (&AdmrulClient{}).handleHTTPError,
+		"expc":   (&ExpcClient{}).handleHTTPError,
+		"prec":   (&PrecClient{}).handleHTTPError,
+	}
+
+	for name, handle := range clients {
+		for _, tt := range tests {
+			t.Run(fmt.Sprintf("%s/%d", name, tt.status), func(t *testing.T) {
+				err := handle(tt.status)
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				for _, kind := range kinds {
+					if got, want := errors.Is(err, kind), kind == tt.kind; got != want {
+						t.Errorf("errors.Is(%v, %v) = %v, want %v", err, kind, got, want)
+					}
+				}
+				if isRetryable(err) != tt.retryable {
+					t.Errorf("retryable = %v, want %v", !tt.retryable, tt.retryable)
+				}
+			})
+		}
+	}
+}
+
+func TestStatusErrorMessage(t *testing.T) {
+	err := (&PrecClient{}).handleHTTPError(http.StatusNotFound)
+	if err.Error() != "요청한 판례를 찾을 수 없습니다" {
+		t.Errorf("message should be kept, got %q", err.Error())
+	}
+
+	var statusErr *StatusError
+	if !errors.As(fmt.Errorf("판례 상세 조회 실패: %w", err), &statusErr) || statusErr.StatusCode != http.StatusNotFound {
+		t.Errorf("wrapped error should expose the status code, got %v", statusErr)
+	}
+
+	// An APIKeyError is an ErrUnauthorized failure without knowing its status
+	if !errors.Is(fmt.Errorf("검색 실패: %w", &APIKeyError{Message: "API 키 오류"}), ErrUnauthorized) {
+		t.Error("APIKeyError should match ErrUnauthorized")
+	}
+}
+
+func TestParseHTMLErrorKinds(t *testing.T) {
+	tests := []struct {
+		name   string
+		html   string
+		kind   error
+		apiKey bool
+	}{
+		{"auth", "<html><body>인증키가 올바르지 않습니다</body></html>", ErrUnauthorized, true},
+		{"rate limit", "<html><body>일일 호출 제한을 초과했습니다</body></html>", ErrRateLimited, false},
+		{"maintenance", "<html><body>시스템 점검 중입니다</body></html>", ErrServiceUnavailable, false},
+		{"unknown", "<html><body></body></html>", ErrUnauthorized, true},
+	}
+
+	clients := map[string]func(string) error{
+		"nlic": (&NLICClient{}).parseHTMLError,
+		"elis": (&ELISClient{}).parseHTMLError,
+	}
+	for name, parse := range clients {
+		for _, tt := range tests {
+			t.Run(name+"/"+tt.name, func(t *testing.T) {
+				err := parse(tt.html)
+				if !errors.Is(err, tt.kind) {
+					t.Errorf("parseHTMLError() = %v, want kind %v", err, tt.kind)
+				}
+				var apiKeyErr *APIKeyError
+				if errors.As(err, &apiKeyErr) != tt.apiKey {
+					t.Errorf("APIKeyError = %v, want %v (%v)", !tt.apiKey, tt.apiKey, err)
+				}
+			})
+		}
+	}
+
+	// NLIC: access to a list the key was not registered for
+	err := (&NLICClient{}).parseHTMLError("<html>미신청된 목록/본문에 대한 접근입니다</html>")
+	if !errors.Is(err, ErrUnauthorized) {
+		t.Errorf("unregistered access should be ErrUnauthorized, got %v", err)
+	}
+
+	// The administrative rule client also recognizes not found pages
+	if err := (&AdmrulClient{}).parseHTMLError("<html>404 Not Found</html>"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("not found page should be ErrNotFound, got %v", err)
+	}
+}
+
+func TestELISStatusErrors(t *testing.T) {
+	tests := []struct {
+		status int
+		kind   error
+	}{
+		{http.StatusForbidden, ErrUnauthorized},
+		{http.StatusNotFound, ErrNotFound},
+		{http.StatusTooManyRequests, ErrRateLimited},
+		{http.StatusServiceUnavailable, ErrServiceUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.status), func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			client := NewELISClient("test-key")
+			client.baseURL = server.URL
+			client.maxRetries = 1
+
+			_, err := client.Search(context.Background(), &UnifiedSearchRequest{Query: "조례"})
+			if !errors.Is(err, tt.kind) {
+				t.Errorf("Search() = %v, want kind %v", err, tt.kind)
+			}
+		})
+	}
+}
+
+func TestNoAPIKeyError(t *testing.T) {
+	_, err := CreateClientWithOptions(APITypePrec, WithAPIKey(""))
+	if !errors.Is(err, ErrNoAPIKey) {
+		t.Errorf("missing key should wrap ErrNoAPIKey, got %v", err)
+	}
+	if err.Error() != "API 키가 설정되지 않았습니다. 'warp config set law.key YOUR_KEY' 명령으로 설정하세요" {
+		t.Errorf("message should be kept, got %q", err.Error())
+	}
+}
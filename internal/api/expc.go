@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/pyhub-apps/pyhub-warp-cli/internal/logger"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/security"
 )
 
 // ExpcSearchResponse represents the legal interpretation search response
@@ -33,6 +34,39 @@ type ExpcInfo struct {
 	DetailLink   string `xml:"법령해석례상세링크"`
 }
 
+// ExpcDetailResponse represents the legal interpretation detail response
+type ExpcDetailResponse struct {
+	XMLName       xml.Name `xml:"ExpcService"`
+	ID            string   `xml:"법령해석례일련번호"`
+	Title         string   `xml:"안건명"`
+	CaseNumber    string   `xml:"안건번호"`
+	InterpretDate string   `xml:"해석일자"`
+	InterpretDept string   `xml:"해석기관명"`
+	QueryDept     string   `xml:"질의기관명"`
+	Question      string   `xml:"질의요지"`
+	Answer        string   `xml:"회답"`
+	Reason        string   `xml:"이유"`
+}
+
+// toLawDetail converts the interpretation to a LawDetail, its question,
+// answer and reasons as sections
+func (r *ExpcDetailResponse) toLawDetail() *LawDetail {
+	detail := &LawDetail{
+		LawInfo: LawInfo{
+			ID:         r.ID,
+			Name:       r.Title,
+			LawType:    "법령해석례",
+			Department: r.QueryDept,
+			PromulDate: r.InterpretDate,
+			PromulNo:   r.CaseNumber,
+		},
+	}
+	detail.Sections = appendSection(detail.Sections, "질의요지", r.Question)
+	detail.Sections = appendSection(detail.Sections, "회답", r.Answer)
+	detail.Sections = appendSection(detail.Sections, "이유", r.Reason)
+	return detail
+}
+
 // ExpcClient represents the Legal Interpretation API client (법령해석례 API 클라이언트)
 type ExpcClient struct {
 	httpClient     *http.Client
@@ -45,9 +79,7 @@ type ExpcClient struct {
 // NewExpcClient creates a new Legal Interpretation API client
 func NewExpcClient(apiKey string) *ExpcClient {
 	return &ExpcClient{
-		httpClient: &http.Client{
-			Timeout: DefaultTimeout,
-		},
+		httpClient:     newHTTPClient(),
 		baseURL:        "https://www.law.go.kr/DRF/lawSearch.do",
 		detailURL:      "https://www.law.go.kr/DRF/lawService.do",
 		apiKey:         apiKey,
@@ -62,36 +94,11 @@ func (c *ExpcClient) GetAPIType() APIType {
 
 // Search performs a legal interpretation search
 func (c *ExpcClient) Search(ctx context.Context, req *UnifiedSearchRequest) (*SearchResponse, error) {
-	// Set defaults
-	if req.Type == "" {
-		req.Type = "JSON"
-	}
-	if req.PageNo == 0 {
-		req.PageNo = 1
-	}
-	if req.PageSize == 0 {
-		req.PageSize = 10
-	}
-
 	// Build URL with parameters
-	params := url.Values{}
-	params.Set("OC", c.apiKey)
-	params.Set("target", "expc") // 법령해석례 검색
-	params.Set("query", req.Query)
-	params.Set("type", req.Type)
-	params.Set("page", fmt.Sprintf("%d", req.PageNo))
-	params.Set("display", fmt.Sprintf("%d", req.PageSize))
-
-	// Add optional filters
-	if req.Department != "" {
-		params.Set("소관부처", req.Department)
-	}
-	if req.Sort != "" {
-		params.Set("sort", req.Sort)
-	}
+	params := buildSearchParams(c.apiKey, "expc", req) // 법령해석례 검색
 
 	fullURL := fmt.Sprintf("%s?%s", c.baseURL, params.Encode())
-	logger.Debug("Legal Interpretation API Request URL: %s", fullURL)
+	logger.Debug("Legal Interpretation API Request URL: %s", security.SanitizeURL(fullURL))
 
 	// Perform request with retries
 	body, err := c.doRequestWithRetry(ctx, fullURL)
@@ -117,6 +124,7 @@ func (c *ExpcClient) Search(ctx context.Context, req *UnifiedSearchRequest) (*Se
 	response := &SearchResponse{
 		TotalCount: expcResponse.TotalCount,
 		Page:       expcResponse.Page,
+		PageSize:   req.PageSize,
 		Laws:       make([]LawInfo, len(expcResponse.Expcs)),
 	}
 
@@ -128,9 +136,18 @@ func (c *ExpcClient) Search(ctx context.Context, req *UnifiedSearchRequest) (*Se
 			Department: expc.QueryDept,
 			PromulDate: expc.ResponseDate,
 			PromulNo:   expc.CaseNumber,
+			ExtraFields: map[string]string{
+				ExtraQueryAgency: expc.QueryDept,
+				ExtraReplyAgency: expc.ResponseDept,
+				ExtraReplyDate:   expc.ResponseDate,
+			},
 		}
 	}
 
+	// Apply the requested order to the page as well, which is all the order
+	// there is for keys the API cannot sort by
+	SortLaws(response.Laws, req.Sort, req.Order)
+
 	return response, nil
 }
 
@@ -141,10 +158,10 @@ func (c *ExpcClient) GetDetail(ctx context.Context, expcID string) (*LawDetail,
 	params.Set("OC", c.apiKey)
 	params.Set("target", "expc") // 법령해석례 상세
 	params.Set("ID", expcID)
-	params.Set("type", "JSON")
+	params.Set("type", "XML") // The JSON detail is empty or laid out differently
 
 	fullURL := fmt.Sprintf("%s?%s", c.detailURL, params.Encode())
-	logger.Debug("Legal Interpretation Detail API Request URL: %s", fullURL)
+	logger.Debug("Legal Interpretation Detail API Request URL: %s", security.SanitizeURL(fullURL))
 
 	// Perform request with retries
 	body, err := c.doRequestWithRetry(ctx, fullURL)
@@ -153,13 +170,13 @@ func (c *ExpcClient) GetDetail(ctx context.Context, expcID string) (*LawDetail,
 	}
 
 	// Parse response
-	var detail LawDetail
-	if err := json.Unmarshal(body, &detail); err != nil {
-		logger.Error("JSON parsing failed for legal interpretation detail: %v", err)
+	var expcDetail ExpcDetailResponse
+	if err := decodeDetailXML(body, "ExpcService", &expcDetail, "요청한 법령해석례를 찾을 수 없습니다"); err != nil {
+		logger.Error("XML parsing failed for legal interpretation detail: %v", err)
 		return nil, fmt.Errorf("법령해석례 상세 정보 파싱 실패: %w", err)
 	}
 
-	return &detail, nil
+	return expcDetail.toLawDetail(), nil
 }
 
 // GetHistory retrieves legal interpretation history (법령해석례는 이력이 없으므로 미지원)
@@ -170,13 +187,11 @@ func (c *ExpcClient) GetHistory(ctx context.Context, expcID string) (*LawHistory
 // doRequestWithRetry performs HTTP request with retry logic
 func (c *ExpcClient) doRequestWithRetry(ctx context.Context, url string) ([]byte, error) {
 	var lastErr error
-	delay := c.retryBaseDelay
+	backoff := newBackoff(c.retryBaseDelay)
 
 	for i := 0; i < MaxRetries; i++ {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		default:
+		if ctx.Err() != nil {
+			return nil, contextError(ctx)
 		}
 
 		body, err := c.doRequest(ctx, url)
@@ -189,14 +204,17 @@ func (c *ExpcClient) doRequestWithRetry(ctx context.Context, url string) ([]byte
 		}
 
 		lastErr = err
-		if !c.shouldRetry(err) {
+		if !isRetryable(err) {
 			return nil, err
 		}
 
 		if i < MaxRetries-1 {
+			delay := backoff.RetryDelay(i+1, err)
 			logger.Debug("Retrying after %v (attempt %d/%d)", delay, i+1, MaxRetries)
-			time.Sleep(delay)
-			delay *= 2
+			recordRetry()
+			if err := sleepContext(ctx, delay); err != nil {
+				return nil, err
+			}
 		}
 	}
 
@@ -210,9 +228,9 @@ func (c *ExpcClient) doRequest(ctx context.Context, url string) ([]byte, error)
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := doHTTP(c.httpClient, req)
 	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
+		return nil, requestError(ctx, err)
 	}
 	defer resp.Body.Close()
 
@@ -224,12 +242,11 @@ func (c *ExpcClient) doRequest(ctx context.Context, url string) ([]byte, error)
 	// Check for HTML error response
 	if strings.HasPrefix(strings.TrimSpace(string(body)), "<!DOCTYPE") ||
 		strings.HasPrefix(strings.TrimSpace(string(body)), "<html") {
-		errMsg := c.parseHTMLError(string(body))
-		return nil, &APIKeyError{Message: errMsg}
+		return nil, c.parseHTMLError(string(body))
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, c.handleHTTPError(resp.StatusCode)
+		return nil, withRetryAfter(c.handleHTTPError(resp.StatusCode), resp)
 	}
 
 	return body, nil
@@ -243,24 +260,18 @@ func (c *ExpcClient) handleHTTPError(statusCode int) error {
 	case http.StatusForbidden:
 		return &APIKeyError{Message: "API 접근 권한이 없습니다"}
 	case http.StatusNotFound:
-		return fmt.Errorf("요청한 법령해석례를 찾을 수 없습니다")
+		return newStatusError(ErrNotFound, statusCode, "요청한 법령해석례를 찾을 수 없습니다")
+	case http.StatusRequestTimeout:
+		return &RetryableError{Err: newStatusError(nil, statusCode, "요청 타임아웃: HTTP 408")}
 	case http.StatusTooManyRequests:
-		return fmt.Errorf("API 요청 한도를 초과했습니다. 잠시 후 다시 시도해주세요")
-	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable:
-		return fmt.Errorf("서버 오류가 발생했습니다. 잠시 후 다시 시도해주세요")
+		return &RetryableError{Err: newStatusError(ErrRateLimited, statusCode, "API 요청 한도를 초과했습니다. 잠시 후 다시 시도해주세요")}
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return &RetryableError{Err: newStatusError(ErrServiceUnavailable, statusCode, "서버 오류가 발생했습니다. 잠시 후 다시 시도해주세요")}
 	default:
 		return fmt.Errorf("HTTP 오류: %d", statusCode)
 	}
 }
 
-// shouldRetry determines if the error is retryable
-func (c *ExpcClient) shouldRetry(err error) bool {
-	errStr := err.Error()
-	return strings.Contains(errStr, "서버 오류") ||
-		strings.Contains(errStr, "timeout") ||
-		strings.Contains(errStr, "connection refused")
-}
-
 // hasAPIError checks if the response contains an API error
 func (c *ExpcClient) hasAPIError(body []byte) bool {
 	// Check for common error patterns in JSON/XML responses
@@ -298,26 +309,26 @@ func (c *ExpcClient) parseAPIError(body []byte) error {
 	return fmt.Errorf("알 수 없는 API 오류")
 }
 
-// parseHTMLError extracts meaningful error message from HTML response
-func (c *ExpcClient) parseHTMLError(html string) string {
+// parseHTMLError converts an HTML error page to an error of the matching kind
+func (c *ExpcClient) parseHTMLError(html string) error {
 	htmlLower := strings.ToLower(html)
 
 	// Check for authentication/key related issues
 	if strings.Contains(htmlLower, "인증") || strings.Contains(htmlLower, "auth") ||
 		strings.Contains(htmlLower, "key") || strings.Contains(htmlLower, "키") {
-		return "API 인증 실패: API 키가 유효하지 않거나 만료되었습니다. 'warp config set law.key <API_KEY>' 명령으로 유효한 API 키를 설정해주세요."
+		return &APIKeyError{Message: "API 인증 실패: API 키가 유효하지 않거나 만료되었습니다. 'warp config set law.key <API_KEY>' 명령으로 유효한 API 키를 설정해주세요."}
 	}
 
 	// Check for service errors
 	if strings.Contains(htmlLower, "서비스") || strings.Contains(htmlLower, "service") {
-		return "서비스 일시 중단: 국가법령정보센터 서비스가 일시적으로 이용할 수 없습니다. 잠시 후 다시 시도해주세요."
+		return newStatusError(ErrServiceUnavailable, 0, "서비스 일시 중단: 국가법령정보센터 서비스가 일시적으로 이용할 수 없습니다. 잠시 후 다시 시도해주세요.")
 	}
 
 	// Check for not found errors
 	if strings.Contains(htmlLower, "not found") || strings.Contains(htmlLower, "404") {
-		return "요청한 법령해석례를 찾을 수 없습니다."
+		return newStatusError(ErrNotFound, 0, "요청한 법령해석례를 찾을 수 없습니다.")
 	}
 
-	// Default error message
-	return "API 요청 실패: 국가법령정보센터 API에서 오류가 발생했습니다. API 키를 확인하거나 잠시 후 다시 시도해주세요."
+	// Default: unexpected pages are mostly caused by the API key
+	return &APIKeyError{Message: "API 요청 실패: 국가법령정보센터 API에서 오류가 발생했습니다. API 키를 확인하거나 잠시 후 다시 시도해주세요."}
 }
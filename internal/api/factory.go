@@ -3,66 +3,176 @@ package api
 import (
 	"context"
 	"fmt"
+	"net/http"
 
 	"github.com/pyhub-apps/pyhub-warp-cli/internal/config"
 )
 
-// CreateClient creates an API client for the specified type
+// APIKeyProvider returns the API key to use for the given API type
+type APIKeyProvider func(apiType APIType) string
+
+// ClientOptions holds the dependencies used to construct API clients
+type ClientOptions struct {
+	KeyProvider APIKeyProvider
+	HTTPClient  *http.Client
+	BaseURL     string
+	Cache       ClientCache
+}
+
+// ClientCache wraps a client created by the factory, e.g. to answer it from
+// a cache (cache.Wrapper)
+type ClientCache func(client ClientInterface) ClientInterface
+
+// ClientOption configures ClientOptions
+type ClientOption func(*ClientOptions)
+
+// WithAPIKeyProvider overrides how API keys are resolved
+func WithAPIKeyProvider(provider APIKeyProvider) ClientOption {
+	return func(o *ClientOptions) {
+		o.KeyProvider = provider
+	}
+}
+
+// WithAPIKey uses a fixed API key for every API type
+func WithAPIKey(key string) ClientOption {
+	return WithAPIKeyProvider(func(APIType) string { return key })
+}
+
+// WithHTTPClient overrides the HTTP client used for requests
+func WithHTTPClient(client *http.Client) ClientOption {
+	return func(o *ClientOptions) {
+		o.HTTPClient = client
+	}
+}
+
+// WithBaseURL points search, detail and history requests at a single URL (for testing)
+func WithBaseURL(baseURL string) ClientOption {
+	return func(o *ClientOptions) {
+		o.BaseURL = baseURL
+	}
+}
+
+// WithCache wraps every created client with cache; a nil cache leaves them
+// unwrapped
+func WithCache(cache ClientCache) ClientOption {
+	return func(o *ClientOptions) {
+		o.Cache = cache
+	}
+}
+
+// DefaultAPIKeyProvider resolves API keys from the configuration
+func DefaultAPIKeyProvider(apiType APIType) string {
+	apiKey := config.GetNLICAPIKey()
+	if apiKey == "" && apiType == APITypeELIS {
+		// ELIS uses the same API key as NLIC (both from law.go.kr), with its own key as fallback
+		apiKey = config.GetELISAPIKey()
+	}
+	return apiKey
+}
+
+// CreateClient creates an API client for the specified type using the configured API key
 func CreateClient(apiType APIType) (ClientInterface, error) {
+	return CreateClientWithOptions(apiType)
+}
+
+// CreateClientWithOptions creates an API client for the specified type with injected dependencies
+func CreateClientWithOptions(apiType APIType, opts ...ClientOption) (ClientInterface, error) {
+	options := &ClientOptions{
+		KeyProvider: DefaultAPIKeyProvider,
+	}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	client, err := options.create(apiType)
+	if err != nil || options.Cache == nil {
+		return client, err
+	}
+	return options.Cache(client), nil
+}
+
+// create creates the client of apiType with the options, without the cache
+func (o *ClientOptions) create(apiType APIType) (ClientInterface, error) {
+	apiKey := o.KeyProvider(apiType)
+
 	switch apiType {
 	case APITypeNLIC:
-		apiKey := config.GetNLICAPIKey()
 		if apiKey == "" {
-			return nil, fmt.Errorf("NLIC API 키가 설정되지 않았습니다. 'warp config set law.nlic.key YOUR_KEY' 명령으로 설정하세요")
+			return nil, fmt.Errorf("NLIC %w. 'warp config set law.nlic.key YOUR_KEY' 명령으로 설정하세요", ErrNoAPIKey)
 		}
 		// Use the dedicated NLIC client
-		return NewNLICClient(apiKey), nil
+		return o.applyNLIC(NewNLICClient(apiKey)), nil
 
 	case APITypeELIS:
-		// ELIS uses the same API key as NLIC (both from law.go.kr)
-		apiKey := config.GetNLICAPIKey()
 		if apiKey == "" {
-			// Try ELIS-specific key first
-			apiKey = config.GetString("law.elis.key")
-			if apiKey == "" {
-				return nil, fmt.Errorf("API 키가 설정되지 않았습니다. 'warp config set law.key YOUR_KEY' 명령으로 설정하세요")
-			}
+			return nil, fmt.Errorf("%w. 'warp config set law.key YOUR_KEY' 명령으로 설정하세요", ErrNoAPIKey)
 		}
-		return NewELISClient(apiKey), nil
+		return o.applyELIS(NewELISClient(apiKey)), nil
 
 	case APITypeAll:
 		// Unified client for searching both NLIC and ELIS
-		return NewUnifiedClient()
+		if apiKey == "" {
+			return nil, fmt.Errorf("%w. 'warp config set law.key YOUR_KEY' 명령으로 설정하세요", ErrNoAPIKey)
+		}
+		return &UnifiedClient{
+			nlicClient: o.applyNLIC(NewNLICClient(apiKey)),
+			elisClient: o.applyELIS(NewELISClient(apiKey)),
+		}, nil
 
 	case APITypePrec:
 		// Precedent API client (판례)
-		apiKey := config.GetNLICAPIKey()
 		if apiKey == "" {
-			return nil, fmt.Errorf("API 키가 설정되지 않았습니다. 'warp config set law.key YOUR_KEY' 명령으로 설정하세요")
+			return nil, fmt.Errorf("%w. 'warp config set law.key YOUR_KEY' 명령으로 설정하세요", ErrNoAPIKey)
 		}
-		return NewPrecClient(apiKey), nil
+		client := NewPrecClient(apiKey)
+		o.apply(&client.httpClient, &client.baseURL, &client.detailURL)
+		return client, nil
 
 	case APITypeAdmrul:
 		// Administrative Rule API client (행정규칙)
-		apiKey := config.GetNLICAPIKey()
 		if apiKey == "" {
-			return nil, fmt.Errorf("API 키가 설정되지 않았습니다. 'warp config set law.key YOUR_KEY' 명령으로 설정하세요")
+			return nil, fmt.Errorf("%w. 'warp config set law.key YOUR_KEY' 명령으로 설정하세요", ErrNoAPIKey)
 		}
-		return NewAdmrulClient(apiKey), nil
+		client := NewAdmrulClient(apiKey)
+		o.apply(&client.httpClient, &client.baseURL, &client.detailURL)
+		return client, nil
 
 	case APITypeExpc:
 		// Legal Interpretation API client (법령해석례)
-		apiKey := config.GetNLICAPIKey()
 		if apiKey == "" {
-			return nil, fmt.Errorf("API 키가 설정되지 않았습니다. 'warp config set law.key YOUR_KEY' 명령으로 설정하세요")
+			return nil, fmt.Errorf("%w. 'warp config set law.key YOUR_KEY' 명령으로 설정하세요", ErrNoAPIKey)
 		}
-		return NewExpcClient(apiKey), nil
+		client := NewExpcClient(apiKey)
+		o.apply(&client.httpClient, &client.baseURL, &client.detailURL)
+		return client, nil
 
 	default:
 		return nil, fmt.Errorf("알 수 없는 API 타입: %s", apiType)
 	}
 }
 
+// apply overrides the HTTP client and endpoint URLs of a client when set
+func (o *ClientOptions) apply(httpClient **http.Client, urls ...*string) {
+	if o.HTTPClient != nil {
+		*httpClient = o.HTTPClient
+	}
+	if o.BaseURL != "" {
+		for _, u := range urls {
+			*u = o.BaseURL
+		}
+	}
+}
+
+func (o *ClientOptions) applyNLIC(client *NLICClient) *NLICClient {
+	o.apply(&client.httpClient, &client.baseURL, &client.detailURL, &client.historyURL)
+	return client
+}
+
+func (o *ClientOptions) applyELIS(client *ELISClient) *ELISClient {
+	o.apply(&client.httpClient, &client.baseURL, &client.detailURL)
+	return client
+}
+
 // CreateDefaultClient creates a client using the default (NLIC) API
 func CreateDefaultClient() (ClientInterface, error) {
 	return CreateClient(APITypeNLIC)
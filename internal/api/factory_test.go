@@ -0,0 +1,141 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCreateClientWithOptions(t *testing.T) {
+	tests := []struct {
+		name     string
+		apiType  APIType
+		wantType APIType
+	}{
+		{"NLIC client", APITypeNLIC, APITypeNLIC},
+		{"ELIS client", APITypeELIS, APITypeELIS},
+		{"Unified client", APITypeAll, APITypeAll},
+		{"Precedent client", APITypePrec, APITypePrec},
+		{"Admin rule client", APITypeAdmrul, APITypeAdmrul},
+		{"Interpretation client", APITypeExpc, APITypeExpc},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := CreateClientWithOptions(tt.apiType, WithAPIKey("test-key"))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if client.GetAPIType() != tt.wantType {
+				t.Errorf("GetAPIType() = %s, want %s", client.GetAPIType(), tt.wantType)
+			}
+		})
+	}
+}
+
+func TestCreateClientWithOptions_MissingKey(t *testing.T) {
+	apiTypes := []APIType{APITypeNLIC, APITypeELIS, APITypeAll, APITypePrec, APITypeAdmrul, APITypeExpc}
+
+	for _, apiType := range apiTypes {
+		t.Run(string(apiType), func(t *testing.T) {
+			_, err := CreateClientWithOptions(apiType, WithAPIKey(""))
+			if err == nil {
+				t.Error("expected error for missing API key")
+			}
+		})
+	}
+}
+
+func TestCreateClientWithOptions_UnknownType(t *testing.T) {
+	if _, err := CreateClientWithOptions(APIType("unknown"), WithAPIKey("test-key")); err == nil {
+		t.Error("expected error for unknown API type")
+	}
+}
+
+func TestCreateClientWithOptions_KeyProvider(t *testing.T) {
+	var requested []APIType
+	provider := func(apiType APIType) string {
+		requested = append(requested, apiType)
+		return "provided-key"
+	}
+
+	client, err := CreateClientWithOptions(APITypePrec, WithAPIKeyProvider(provider))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(requested) != 1 || requested[0] != APITypePrec {
+		t.Errorf("provider called with %v, want [%s]", requested, APITypePrec)
+	}
+	if prec := client.(*PrecClient); prec.apiKey != "provided-key" {
+		t.Errorf("apiKey = %q, want %q", prec.apiKey, "provided-key")
+	}
+}
+
+func TestCreateClientWithOptions_InjectedServer(t *testing.T) {
+	var gotKey string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotKey = r.URL.Query().Get("OC")
+		w.Write([]byte(`{"LawSearch": {"totalCnt": "1", "page": "1", "law": [{"법령ID": "001", "법령명한글": "테스트법"}]}}`))
+	}))
+	defer server.Close()
+
+	httpClient := &http.Client{Timeout: 5 * time.Second}
+	client, err := CreateClientWithOptions(APITypeNLIC,
+		WithAPIKey("injected-key"),
+		WithHTTPClient(httpClient),
+		WithBaseURL(server.URL),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	nlic := client.(*NLICClient)
+	if nlic.httpClient != httpClient {
+		t.Error("injected HTTP client was not used")
+	}
+	if nlic.detailURL != server.URL || nlic.historyURL != server.URL {
+		t.Error("base URL should apply to detail and history endpoints")
+	}
+
+	result, err := client.Search(context.Background(), &UnifiedSearchRequest{Query: "테스트", PageNo: 1, PageSize: 10})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotKey != "injected-key" {
+		t.Errorf("request OC = %q, want %q", gotKey, "injected-key")
+	}
+	if len(result.Laws) != 1 {
+		t.Errorf("expected 1 law, got %d", len(result.Laws))
+	}
+}
+
+func TestCreateClientWithOptions_Cache(t *testing.T) {
+	var wrapped ClientInterface
+	cache := func(client ClientInterface) ClientInterface {
+		wrapped = client
+		return &LegacyClientWrapper{}
+	}
+
+	client, err := CreateClientWithOptions(APITypeELIS, WithAPIKey("test-key"), WithCache(cache))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := wrapped.(*ELISClient); !ok {
+		t.Errorf("cache wrapped %T, want *ELISClient", wrapped)
+	}
+	if _, ok := client.(*LegacyClientWrapper); !ok {
+		t.Errorf("client = %T, want the client of the cache", client)
+	}
+
+	// A nil cache and a failed client are not wrapped
+	if client, _ := CreateClientWithOptions(APITypeELIS, WithAPIKey("test-key"), WithCache(nil)); client == nil || client.GetAPIType() != APITypeELIS {
+		t.Errorf("client with a nil cache = %v, want the ELIS client", client)
+	}
+	wrapped = nil
+	if _, err := CreateClientWithOptions(APITypeELIS, WithAPIKey(""), WithCache(cache)); err == nil || wrapped != nil {
+		t.Errorf("missing key: err = %v, wrapped = %v; want an error and no wrapping", err, wrapped)
+	}
+}
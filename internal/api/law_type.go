@@ -0,0 +1,97 @@
+package api
+
+import (
+	"regexp"
+	"strings"
+)
+
+// KnownLawTypes lists the law types (법령구분) of the search results of every
+// source. Ministry ordinances are named after their ministry (기획재정부령),
+// see IsKnownLawType.
+var KnownLawTypes = []string{
+	"헌법", "법률", "대통령령", "총리령", "부령",
+	"국회규칙", "대법원규칙", "헌법재판소규칙", "중앙선거관리위원회규칙", "감사원규칙",
+	"대통령긴급명령", "대통령긴급재정경제명령",
+	"자치법규", "훈령", "예규", "고시", "법령해석례",
+}
+
+// lawTypeAliases maps other spellings of law types to the ones of KnownLawTypes
+var lawTypeAliases = map[string]string{
+	"憲法":     "헌법",
+	"法律":     "법률",
+	"大統領令":   "대통령령",
+	"總理令":    "총리령",
+	"部令":     "부령",
+	"시행령":    "대통령령",
+	"긴급명령":   "대통령긴급명령",
+	"긴급재정명령": "대통령긴급재정경제명령",
+}
+
+// lawTypeAnnotation matches a parenthesized annotation such as the Hanja of
+// "법률(法律)"
+var lawTypeAnnotation = regexp.MustCompile(`[(（][^)）]*[)）]`)
+
+// NormalizeLawType returns the canonical spelling of a law type: annotations
+// in parentheses and spaces are dropped and aliases are resolved, so that
+// "법률(法律)" and "대통령 령" become "법률" and "대통령령".
+func NormalizeLawType(lawType string) string {
+	lawType = lawTypeAnnotation.ReplaceAllString(lawType, "")
+	lawType = strings.Join(strings.Fields(lawType), "")
+	if alias, ok := lawTypeAliases[lawType]; ok {
+		return alias
+	}
+	return lawType
+}
+
+// ParseLawTypes returns the normalized law types of a comma-separated law
+// type filter, without blanks or repeats
+func ParseLawTypes(value string) []string {
+	var types []string
+	for _, part := range strings.Split(value, ",") {
+		lawType := NormalizeLawType(part)
+		if lawType == "" || containsString(types, lawType) {
+			continue
+		}
+		types = append(types, lawType)
+	}
+	return types
+}
+
+// IsKnownLawType reports whether a normalized law type is one of
+// KnownLawTypes or the ordinance of a ministry
+func IsKnownLawType(lawType string) bool {
+	return containsString(KnownLawTypes, lawType) || strings.HasSuffix(lawType, "부령")
+}
+
+// MatchLawType reports whether the law type of a result is one of types,
+// both compared normalized. No types matches every law type.
+func MatchLawType(lawType string, types []string) bool {
+	if len(types) == 0 {
+		return true
+	}
+	return containsString(types, NormalizeLawType(lawType))
+}
+
+// FilterLawTypes returns the laws whose law type is one of types
+func FilterLawTypes(laws []LawInfo, types []string) []LawInfo {
+	if len(types) == 0 {
+		return laws
+	}
+	var filtered []LawInfo
+	for _, law := range laws {
+		if MatchLawType(law.LawType, types) {
+			filtered = append(filtered, law)
+		}
+	}
+	return filtered
+}
+
+// containsString reports whether values contains value
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,119 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestNormalizeLawType(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"법률", "법률"},
+		{" 법률(法律) ", "법률"},
+		{"대통령 령", "대통령령"},
+		{"대통령령（大統領令）", "대통령령"},
+		{"法律", "법률"},
+		{"시행령", "대통령령"},
+		{"기획재정부령", "기획재정부령"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := NormalizeLawType(tt.in); got != tt.want {
+			t.Errorf("NormalizeLawType(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParseLawTypes(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []string
+	}{
+		{"", nil},
+		{"법률", []string{"법률"}},
+		{"법률, 대통령령 ,,총리령", []string{"법률", "대통령령", "총리령"}},
+		{"법률(法律),법률,시행령,대통령령", []string{"법률", "대통령령"}},
+	}
+	for _, tt := range tests {
+		if got := ParseLawTypes(tt.in); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParseLawTypes(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestIsKnownLawType(t *testing.T) {
+	for _, lawType := range []string{"법률", "대통령령", "부령", "기획재정부령", "자치법규"} {
+		if !IsKnownLawType(lawType) {
+			t.Errorf("%q should be known", lawType)
+		}
+	}
+	for _, lawType := range []string{"법율", "대통령", ""} {
+		if IsKnownLawType(lawType) {
+			t.Errorf("%q should not be known", lawType)
+		}
+	}
+}
+
+func TestFilterLawTypes(t *testing.T) {
+	laws := []LawInfo{
+		{ID: "1", LawType: "법률"},
+		{ID: "2", LawType: "대통령령(大統領令)"},
+		{ID: "3", LawType: "총리령"},
+	}
+	got := FilterLawTypes(laws, []string{"법률", "대통령령"})
+	if len(got) != 2 || got[0].ID != "1" || got[1].ID != "2" {
+		t.Errorf("FilterLawTypes() = %+v", got)
+	}
+	if got := FilterLawTypes(laws, nil); len(got) != 3 {
+		t.Errorf("no law types should keep every law, got %d", len(got))
+	}
+}
+
+func TestNLICClient_SearchLawTypes(t *testing.T) {
+	var lawTypeParam string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lawTypeParam = r.URL.Query().Get("법령구분")
+		w.Write([]byte(`{"LawSearch": {"totalCnt": "30", "page": "1", "law": [
+			{"법령ID": "001", "법령명한글": "테스트법", "법령구분명": "법률"},
+			{"법령ID": "002", "법령명한글": "테스트법 시행령", "법령구분명": "대통령령"},
+			{"법령ID": "003", "법령명한글": "테스트법 시행규칙", "법령구분명": "총리령"}
+		]}}`))
+	}))
+	defer server.Close()
+
+	client := &NLICClient{
+		httpClient:     &http.Client{Timeout: 5 * time.Second},
+		baseURL:        server.URL,
+		apiKey:         "test-key",
+		retryBaseDelay: time.Millisecond,
+	}
+	search := func(lawType string) *SearchResponse {
+		t.Helper()
+		resp, err := client.Search(context.Background(), &UnifiedSearchRequest{Query: "테스트", PageNo: 1, PageSize: 10, Type: "JSON", LawType: lawType})
+		if err != nil {
+			t.Fatalf("Search(%q) error = %v", lawType, err)
+		}
+		return resp
+	}
+
+	// A single law type is sent to the API, normalized
+	resp := search("법률(法律)")
+	if lawTypeParam != "법률" || resp.TotalCount != 30 || len(resp.Laws) != 3 {
+		t.Errorf("single law type: param %q, total %d, %d laws", lawTypeParam, resp.TotalCount, len(resp.Laws))
+	}
+
+	// Several are filtered from the page, which keeps the total of the server
+	resp = search("법률, 총리령")
+	if lawTypeParam != "" {
+		t.Errorf("several law types should not be sent, got %q", lawTypeParam)
+	}
+	if resp.TotalCount != 30 || len(resp.Laws) != 2 || resp.Laws[0].ID != "001" || resp.Laws[1].ID != "003" {
+		t.Errorf("several law types: total %d, laws %+v", resp.TotalCount, resp.Laws)
+	}
+}
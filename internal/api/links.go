@@ -0,0 +1,218 @@
+package api
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// LawSiteURL is the site that relative links of detail responses point into
+const LawSiteURL = "https://www.law.go.kr"
+
+// RelatedLaw is a law related to a law detail, with the link to its text
+type RelatedLaw struct {
+	Name string `json:"법령명" xml:"법령명"`
+	URL  string `json:"링크,omitempty" xml:"링크,omitempty"`
+}
+
+// Attachment is a file attached to a law detail, such as the form of a
+// table, with the URL it is downloaded from
+type Attachment struct {
+	Name string `json:"파일명" xml:"파일명"`
+	URL  string `json:"링크,omitempty" xml:"링크,omitempty"`
+	Size int64  `json:"크기,omitempty" xml:"크기,omitempty"` // bytes, 0 if unknown
+}
+
+// Keys of the link fields in detail responses, in the order they are looked up
+var (
+	relatedLawNameKeys = []string{"법령명", "법령명한글", "관련법령명", "name"}
+	attachmentNameKeys = []string{"파일명", "첨부파일명", "name"}
+	linkURLKeys        = []string{"법령상세링크", "링크", "파일링크", "다운로드링크", "url", "URL"}
+	attachmentSizeKeys = []string{"파일크기", "크기", "size"}
+)
+
+// parseRelatedLaws returns the related laws of the 관련법령 field of a detail
+// response: names, objects with a name and a link, or arrays and wrapper
+// objects of them. A law without a link links to its page by name.
+func parseRelatedLaws(v interface{}) []RelatedLaw {
+	var laws []RelatedLaw
+	for _, link := range collectLinks(v, relatedLawNameKeys) {
+		if link.url == "" {
+			link.url = LawNameURL(link.name)
+		}
+		laws = append(laws, RelatedLaw{Name: link.name, URL: link.url})
+	}
+	return laws
+}
+
+// LawNameURL returns the page of the law name on LawSiteURL, which shows
+// the law in force under that name
+func LawNameURL(name string) string {
+	name = strings.Join(strings.Fields(name), "")
+	if name == "" {
+		return ""
+	}
+	return LawSiteURL + "/" + url.PathEscape("법령") + "/" + url.PathEscape(name)
+}
+
+// LawIDURL returns the page of law on LawSiteURL by its ID: the ordinance
+// page for 자치법규 results, the law page for the others. It is empty for a
+// law without an ID.
+func LawIDURL(law LawInfo) string {
+	id := strings.TrimSpace(law.ID)
+	if id == "" {
+		return ""
+	}
+	if law.Source == "자치법규" || law.LawType == "자치법규" {
+		return LawSiteURL + "/LSW/ordinInfoP.do?ordinId=" + url.QueryEscape(id)
+	}
+	return LawSiteURL + "/LSW/lsInfoP.do?lsId=" + url.QueryEscape(id)
+}
+
+// lawSourceURLs are the templates of the pages of laws on LawSiteURL by
+// source (LawInfo.Source): the page by ID and the page by serial number
+var lawSourceURLs = map[string]struct{ byID, bySerialNo string }{
+	"국가법령": {"/LSW/lsInfoP.do?lsId=%s", "/LSW/lsInfoP.do?lsiSeq=%s"},
+	"자치법규": {"/LSW/ordinInfoP.do?ordinId=%s", "/LSW/ordinInfoP.do?ordinSeq=%s"},
+}
+
+// LawSourceURL returns the page of law on LawSiteURL following the template
+// of its source, by its ID or else its serial number (법령일련번호). Sources
+// may be given as labels or as NLIC and ELIS. It is empty for a law without
+// a known source or without either number.
+func LawSourceURL(law LawInfo) string {
+	source := strings.TrimSpace(law.Source)
+	if source == "NLIC" || source == "ELIS" {
+		source = sourceLabel(source)
+	}
+	templates, ok := lawSourceURLs[source]
+	if !ok {
+		return ""
+	}
+	if id := strings.TrimSpace(law.ID); id != "" {
+		return LawSiteURL + fmt.Sprintf(templates.byID, url.QueryEscape(id))
+	}
+	if serialNo := strings.TrimSpace(law.SerialNo); serialNo != "" {
+		return LawSiteURL + fmt.Sprintf(templates.bySerialNo, url.QueryEscape(serialNo))
+	}
+	return ""
+}
+
+// parseAttachments returns the attachments of the 첨부파일 field of a detail
+// response, in the shapes parseRelatedLaws accepts
+func parseAttachments(v interface{}) []Attachment {
+	var files []Attachment
+	for _, link := range collectLinks(v, attachmentNameKeys) {
+		files = append(files, Attachment{Name: link.name, URL: link.url, Size: link.size})
+	}
+	return files
+}
+
+// tableAttachments returns the form files linked from a table unit
+func tableAttachments(unit TableUnit) []Attachment {
+	title := strings.TrimSpace(strings.TrimSpace(unit.TableNumber) + " " + strings.TrimSpace(unit.TableTitle))
+	var files []Attachment
+	for _, file := range []struct{ name, url, kind string }{
+		{unit.TableFileName, unit.TableFileLink, "HWP"},
+		{unit.TablePDFName, unit.TablePDFLink, "PDF"},
+	} {
+		link := absoluteLawURL(file.url)
+		if link == "" {
+			continue
+		}
+		name := strings.TrimSpace(file.name)
+		if name == "" {
+			name = fmt.Sprintf("별표 %s (%s)", title, file.kind)
+		}
+		files = append(files, Attachment{Name: name, URL: link})
+	}
+	return files
+}
+
+// namedLink is a named link found in a detail response
+type namedLink struct {
+	name string
+	url  string
+	size int64
+}
+
+// collectLinks returns the links in v. Strings are names without a link;
+// objects with one of nameKeys are links; other arrays and objects are
+// searched for them, objects in key order.
+func collectLinks(v interface{}, nameKeys []string) []namedLink {
+	var links []namedLink
+	var walk func(v interface{})
+	walk = func(v interface{}) {
+		switch value := v.(type) {
+		case string:
+			if name := strings.TrimSpace(value); name != "" {
+				links = append(links, namedLink{name: name})
+			}
+		case []interface{}:
+			for _, item := range value {
+				walk(item)
+			}
+		case map[string]interface{}:
+			if name := firstString(value, nameKeys); name != "" {
+				links = append(links, namedLink{
+					name: name,
+					url:  absoluteLawURL(firstString(value, linkURLKeys)),
+					size: parseSize(firstString(value, attachmentSizeKeys)),
+				})
+				return
+			}
+			keys := make([]string, 0, len(value))
+			for key := range value {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				walk(value[key])
+			}
+		}
+	}
+	walk(v)
+	return links
+}
+
+// firstString returns the first non-blank value of keys in m as a string
+func firstString(m map[string]interface{}, keys []string) string {
+	for _, key := range keys {
+		switch value := m[key].(type) {
+		case string:
+			if s := strings.TrimSpace(value); s != "" {
+				return s
+			}
+		case float64:
+			return strconv.FormatFloat(value, 'f', -1, 64)
+		}
+	}
+	return ""
+}
+
+// parseSize parses a file size in bytes, ignoring separators; 0 if unknown
+func parseSize(s string) int64 {
+	size, err := strconv.ParseInt(strings.ReplaceAll(s, ",", ""), 10, 64)
+	if err != nil || size < 0 {
+		return 0
+	}
+	return size
+}
+
+// absoluteLawURL returns link as an absolute URL; the detail API returns
+// links relative to LawSiteURL
+func absoluteLawURL(link string) string {
+	link = strings.TrimSpace(link)
+	switch {
+	case link == "":
+		return ""
+	case strings.HasPrefix(link, "http://"), strings.HasPrefix(link, "https://"):
+		return link
+	case strings.HasPrefix(link, "/"):
+		return LawSiteURL + link
+	default:
+		return LawSiteURL + "/" + link
+	}
+}
@@ -0,0 +1,144 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestParseRelatedLaws(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+		want []RelatedLaw
+	}{
+		{"null", `null`, nil},
+		{"names", `["개인정보 보호법 시행령", " "]`, []RelatedLaw{
+			{Name: "개인정보 보호법 시행령", URL: LawNameURL("개인정보보호법시행령")},
+		}},
+		{"objects in a unit", `{"관련법령단위": [
+			{"법령명": "신용정보법", "법령상세링크": "/DRF/lawService.do?ID=2&target=law"},
+			{"관련법령명": "전자정부법", "링크": "https://www.law.go.kr/LSW/lsInfoP.do?lsiSeq=3"}
+		]}`, []RelatedLaw{
+			{Name: "신용정보법", URL: "https://www.law.go.kr/DRF/lawService.do?ID=2&target=law"},
+			{Name: "전자정부법", URL: "https://www.law.go.kr/LSW/lsInfoP.do?lsiSeq=3"},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var v interface{}
+			if err := json.Unmarshal([]byte(tt.json), &v); err != nil {
+				t.Fatal(err)
+			}
+			if got := parseRelatedLaws(v); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseRelatedLaws() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLawNameURL(t *testing.T) {
+	want := "https://www.law.go.kr/" + url.PathEscape("법령") + "/" + url.PathEscape("개인정보보호법")
+	if got := LawNameURL(" 개인정보 보호법 "); got != want {
+		t.Errorf("LawNameURL() = %q, want %q", got, want)
+	}
+	if got := LawNameURL(" "); got != "" {
+		t.Errorf("LawNameURL of a blank name = %q, want empty", got)
+	}
+}
+
+func TestLawIDURL(t *testing.T) {
+	tests := []struct {
+		law  LawInfo
+		want string
+	}{
+		{LawInfo{ID: "011357", LawType: "법률"}, "https://www.law.go.kr/LSW/lsInfoP.do?lsId=011357"},
+		{LawInfo{ID: " 2000111 ", Source: "자치법규"}, "https://www.law.go.kr/LSW/ordinInfoP.do?ordinId=2000111"},
+		{LawInfo{ID: "2000112", LawType: "자치법규"}, "https://www.law.go.kr/LSW/ordinInfoP.do?ordinId=2000112"},
+		{LawInfo{Name: "ID 없음"}, ""},
+	}
+	for _, tt := range tests {
+		if got := LawIDURL(tt.law); got != tt.want {
+			t.Errorf("LawIDURL(%+v) = %q, want %q", tt.law, got, tt.want)
+		}
+	}
+}
+
+func TestLawSourceURL(t *testing.T) {
+	tests := []struct {
+		law  LawInfo
+		want string
+	}{
+		{LawInfo{ID: "011357", Source: "국가법령"}, "https://www.law.go.kr/LSW/lsInfoP.do?lsId=011357"},
+		{LawInfo{SerialNo: "248613", Source: "NLIC"}, "https://www.law.go.kr/LSW/lsInfoP.do?lsiSeq=248613"},
+		{LawInfo{ID: "2000111", SerialNo: "1500000", Source: "자치법규"}, "https://www.law.go.kr/LSW/ordinInfoP.do?ordinId=2000111"},
+		{LawInfo{SerialNo: "1500000", Source: "ELIS"}, "https://www.law.go.kr/LSW/ordinInfoP.do?ordinSeq=1500000"},
+		{LawInfo{ID: "011357"}, ""},
+		{LawInfo{Name: "번호 없음", Source: "국가법령"}, ""},
+	}
+	for _, tt := range tests {
+		if got := LawSourceURL(tt.law); got != tt.want {
+			t.Errorf("LawSourceURL(%+v) = %q, want %q", tt.law, got, tt.want)
+		}
+	}
+}
+
+func TestParseAttachments(t *testing.T) {
+	var v interface{}
+	if err := json.Unmarshal([]byte(`[
+		{"파일명": "서식1.hwp", "파일링크": "/LSW/flDownload.do?flSeq=1", "파일크기": "12,288"},
+		{"첨부파일명": "안내.pdf", "다운로드링크": "https://www.law.go.kr/LSW/flDownload.do?flSeq=2", "파일크기": 2048},
+		{"파일명": "링크 없음.txt"}
+	]`), &v); err != nil {
+		t.Fatal(err)
+	}
+	want := []Attachment{
+		{Name: "서식1.hwp", URL: "https://www.law.go.kr/LSW/flDownload.do?flSeq=1", Size: 12288},
+		{Name: "안내.pdf", URL: "https://www.law.go.kr/LSW/flDownload.do?flSeq=2", Size: 2048},
+		{Name: "링크 없음.txt"},
+	}
+	if got := parseAttachments(v); !reflect.DeepEqual(got, want) {
+		t.Errorf("parseAttachments() = %+v, want %+v", got, want)
+	}
+}
+
+func TestNLICClient_GetDetailLinks(t *testing.T) {
+	const body = `{"법령": {
+		"기본정보": {"법령ID": "001234", "법령명_한글": "개인정보 보호법"},
+		"별표": {"별표단위": [{
+			"별표번호": "0001", "별표제목": "과태료 부과기준", "별표내용": "내용",
+			"별표서식파일링크": "/LSW/flDownload.do?flSeq=10",
+			"별표서식PDF파일링크": "/LSW/flDownload.do?flSeq=11",
+			"별표PDF파일명": "별표1.pdf"
+		}]},
+		"관련법령": [{"법령명": "개인정보 보호법 시행령", "법령상세링크": "/DRF/lawService.do?ID=5"}],
+		"첨부파일": [{"파일명": "안내서.hwp", "파일링크": "/LSW/flDownload.do?flSeq=20", "파일크기": "1024"}]
+	}}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	detail, err := NewNLICClientWithURL("test-key", server.URL).GetDetail(context.Background(), "001234")
+	if err != nil {
+		t.Fatalf("GetDetail() error = %v", err)
+	}
+
+	wantLaws := []RelatedLaw{{Name: "개인정보 보호법 시행령", URL: "https://www.law.go.kr/DRF/lawService.do?ID=5"}}
+	if !reflect.DeepEqual(detail.RelatedLaws, wantLaws) {
+		t.Errorf("RelatedLaws = %+v, want %+v", detail.RelatedLaws, wantLaws)
+	}
+	wantFiles := []Attachment{
+		{Name: "별표 0001 과태료 부과기준 (HWP)", URL: "https://www.law.go.kr/LSW/flDownload.do?flSeq=10"},
+		{Name: "별표1.pdf", URL: "https://www.law.go.kr/LSW/flDownload.do?flSeq=11"},
+		{Name: "안내서.hwp", URL: "https://www.law.go.kr/LSW/flDownload.do?flSeq=20", Size: 1024},
+	}
+	if !reflect.DeepEqual(detail.Attachments, wantFiles) {
+		t.Errorf("Attachments = %+v, want %+v", detail.Attachments, wantFiles)
+	}
+}
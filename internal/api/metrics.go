@@ -0,0 +1,260 @@
+package api
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// MetricsFileName is the name of the file inside the config directory that
+// keeps the metrics of the last session run with --metrics
+const MetricsFileName = "metrics.json"
+
+// MetricsLatencyBuckets are the upper bounds of the latency histogram of
+// Metrics; a last bucket holds the slower requests
+var MetricsLatencyBuckets = []time.Duration{
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+	10 * time.Second,
+}
+
+// Metrics collects the HTTP requests of the clients during a session
+// (--metrics): how many were sent and retried, the status codes of their
+// responses and a histogram of their latencies. It is safe for concurrent use.
+type Metrics struct {
+	mu       sync.Mutex
+	snapshot MetricsSnapshot
+}
+
+// MetricsSnapshot is a copy of the counts of Metrics
+type MetricsSnapshot struct {
+	// Requests counts every request sent, retries included
+	Requests int `json:"requests"`
+	// Retries counts the requests sent again after a retryable failure
+	Retries int `json:"retries"`
+	// Failures counts the requests that got no response, such as a refused
+	// connection or a timeout
+	Failures int `json:"failures"`
+	// Statuses counts the responses by status code
+	Statuses map[int]int `json:"statuses,omitempty"`
+	// Latency counts the requests by MetricsLatencyBuckets, with one more
+	// bucket for the slower ones
+	Latency []int `json:"latency"`
+	// LatencySum is the time all requests took
+	LatencySum time.Duration `json:"latency_sum"`
+}
+
+// NewMetrics returns an empty collector
+func NewMetrics() *Metrics {
+	return &Metrics{snapshot: MetricsSnapshot{
+		Statuses: make(map[int]int),
+		Latency:  make([]int, len(MetricsLatencyBuckets)+1),
+	}}
+}
+
+var (
+	metricsMu sync.RWMutex
+	metrics   *Metrics
+)
+
+// SetMetrics sets the collector of the HTTP requests of the clients; nil
+// stops collecting
+func SetMetrics(m *Metrics) {
+	metricsMu.Lock()
+	defer metricsMu.Unlock()
+	metrics = m
+}
+
+// currentMetrics returns the collector set with SetMetrics, or nil
+func currentMetrics() *Metrics {
+	metricsMu.RLock()
+	defer metricsMu.RUnlock()
+	return metrics
+}
+
+// recordRetry counts a retry of the clients, if metrics are collected
+func recordRetry() {
+	if m := currentMetrics(); m != nil {
+		m.retry()
+	}
+}
+
+// observe counts a request that took elapsed, answered with resp or failed with err
+func (m *Metrics) observe(resp *http.Response, err error, elapsed time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	s := &m.snapshot
+	s.Requests++
+	if err != nil || resp == nil {
+		s.Failures++
+	} else {
+		s.Statuses[resp.StatusCode]++
+	}
+	bucket := sort.Search(len(MetricsLatencyBuckets), func(i int) bool {
+		return elapsed <= MetricsLatencyBuckets[i]
+	})
+	s.Latency[bucket]++
+	s.LatencySum += elapsed
+}
+
+// retry counts a retry
+func (m *Metrics) retry() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.snapshot.Retries++
+}
+
+// Snapshot returns a copy of the counts collected so far
+func (m *Metrics) Snapshot() MetricsSnapshot {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	s := m.snapshot
+	s.Statuses = make(map[int]int, len(m.snapshot.Statuses))
+	for code, count := range m.snapshot.Statuses {
+		s.Statuses[code] = count
+	}
+	s.Latency = append([]int(nil), m.snapshot.Latency...)
+	return s
+}
+
+// SuccessRate returns the share of requests answered with a 2xx status, 0
+// without requests
+func (s MetricsSnapshot) SuccessRate() float64 {
+	if s.Requests == 0 {
+		return 0
+	}
+	succeeded := 0
+	for code, count := range s.Statuses {
+		if code >= 200 && code < 300 {
+			succeeded += count
+		}
+	}
+	return float64(succeeded) / float64(s.Requests)
+}
+
+// MeanLatency returns the average time of a request, 0 without requests
+func (s MetricsSnapshot) MeanLatency() time.Duration {
+	if s.Requests == 0 {
+		return 0
+	}
+	return s.LatencySum / time.Duration(s.Requests)
+}
+
+// StatusCodes returns the status codes of the responses in ascending order
+func (s MetricsSnapshot) StatusCodes() []int {
+	codes := make([]int, 0, len(s.Statuses))
+	for code := range s.Statuses {
+		codes = append(codes, code)
+	}
+	sort.Ints(codes)
+	return codes
+}
+
+// WritePrometheus writes the snapshot in the Prometheus text exposition
+// format, so that a textfile collector can pick it up
+func (s MetricsSnapshot) WritePrometheus(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	writeFamily := func(name, kind, help string) {
+		fmt.Fprintf(bw, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+	}
+
+	writeFamily("warp_api_requests_total", "counter", "HTTP requests sent to the law.go.kr APIs, retries included.")
+	fmt.Fprintf(bw, "warp_api_requests_total %d\n", s.Requests)
+	writeFamily("warp_api_retries_total", "counter", "Requests sent again after a retryable failure.")
+	fmt.Fprintf(bw, "warp_api_retries_total %d\n", s.Retries)
+	writeFamily("warp_api_request_failures_total", "counter", "Requests that got no response.")
+	fmt.Fprintf(bw, "warp_api_request_failures_total %d\n", s.Failures)
+	writeFamily("warp_api_responses_total", "counter", "Responses by HTTP status code.")
+	for _, code := range s.StatusCodes() {
+		fmt.Fprintf(bw, "warp_api_responses_total{code=\"%d\"} %d\n", code, s.Statuses[code])
+	}
+
+	writeFamily("warp_api_request_duration_seconds", "histogram", "Time of the requests.")
+	cumulative := 0
+	for i, bound := range MetricsLatencyBuckets {
+		if i < len(s.Latency) {
+			cumulative += s.Latency[i]
+		}
+		le := strconv.FormatFloat(bound.Seconds(), 'g', -1, 64)
+		fmt.Fprintf(bw, "warp_api_request_duration_seconds_bucket{le=\"%s\"} %d\n", le, cumulative)
+	}
+	fmt.Fprintf(bw, "warp_api_request_duration_seconds_bucket{le=\"+Inf\"} %d\n", s.Requests)
+	fmt.Fprintf(bw, "warp_api_request_duration_seconds_sum %s\n", strconv.FormatFloat(s.LatencySum.Seconds(), 'g', -1, 64))
+	fmt.Fprintf(bw, "warp_api_request_duration_seconds_count %d\n", s.Requests)
+	return bw.Flush()
+}
+
+// SaveMetrics writes a snapshot to path as JSON
+func SaveMetrics(path string, s MetricsSnapshot) error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode metrics: %w", err)
+	}
+	return replaceFile(path, data)
+}
+
+// SavePrometheusMetrics writes a snapshot to path in the Prometheus text
+// format (see WritePrometheus)
+func SavePrometheusMetrics(path string, s MetricsSnapshot) error {
+	var buf bytes.Buffer
+	if err := s.WritePrometheus(&buf); err != nil {
+		return err
+	}
+	return replaceFile(path, buf.Bytes())
+}
+
+// replaceFile writes data to a temporary file next to path and renames it
+// over path, so that readers never see a partly written file
+func replaceFile(path string, data []byte) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return fmt.Errorf("failed to create metrics directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(dir, filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to write metrics: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write metrics: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write metrics: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to write metrics: %w", err)
+	}
+	return nil
+}
+
+// LoadMetrics reads a snapshot written by SaveMetrics
+func LoadMetrics(path string) (MetricsSnapshot, error) {
+	var s MetricsSnapshot
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return s, err
+	}
+	if err := json.Unmarshal(data, &s); err != nil {
+		return s, fmt.Errorf("failed to parse metrics %s: %w", path, err)
+	}
+	return s, nil
+}
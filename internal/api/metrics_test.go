@@ -0,0 +1,161 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/testutil"
+)
+
+func TestMetricsClientRequests(t *testing.T) {
+	m := NewMetrics()
+	SetMetrics(m)
+	t.Cleanup(func() { SetMetrics(nil) })
+
+	server := testutil.NewAPIServer(t)
+	calls := 0
+	server.HandleFunc(testutil.TargetLaw, func(testutil.Request) testutil.Reply {
+		calls++
+		if calls == 1 {
+			return testutil.Reply{Status: http.StatusServiceUnavailable}
+		}
+		return testutil.Results(1, testutil.Item{"법령ID": "001", "법령명한글": "민법"})
+	})
+	client := NewNLICClientWithURL("test-key", server.URL)
+	client.retryBaseDelay = time.Millisecond
+
+	if _, err := client.Search(context.Background(), &UnifiedSearchRequest{Query: "민법", PageNo: 1, PageSize: 10, Type: "JSON"}); err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+
+	s := m.Snapshot()
+	if s.Requests != 2 || s.Retries != 1 || s.Failures != 0 {
+		t.Errorf("requests, retries, failures = %d, %d, %d; want 2, 1, 0", s.Requests, s.Retries, s.Failures)
+	}
+	if want := map[int]int{200: 1, 503: 1}; !reflect.DeepEqual(s.Statuses, want) {
+		t.Errorf("statuses = %v, want %v", s.Statuses, want)
+	}
+	total := 0
+	for _, count := range s.Latency {
+		total += count
+	}
+	if total != 2 {
+		t.Errorf("latency histogram counts %d requests, want 2", total)
+	}
+	if got := s.SuccessRate(); got != 0.5 {
+		t.Errorf("SuccessRate() = %v, want 0.5", got)
+	}
+}
+
+func TestMetricsConcurrent(t *testing.T) {
+	m := NewMetrics()
+	SetMetrics(m)
+	t.Cleanup(func() { SetMetrics(nil) })
+
+	server := testutil.NewAPIServer(t)
+	server.Handle(testutil.TargetLaw, testutil.Results(1, testutil.Item{"법령ID": "001", "법령명한글": "민법"}))
+	client := NewNLICClientWithURL("test-key", server.URL)
+
+	const workers = 20
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			if _, err := client.Search(context.Background(), &UnifiedSearchRequest{Query: "민법", PageNo: 1, PageSize: 10, Type: "JSON"}); err != nil {
+				t.Errorf("Search() error = %v", err)
+			}
+			recordRetry()
+		}()
+		go func() {
+			defer wg.Done()
+			m.Snapshot()
+		}()
+	}
+	wg.Wait()
+
+	s := m.Snapshot()
+	if s.Requests != workers || s.Retries != workers || s.Statuses[200] != workers {
+		t.Errorf("requests, retries, 200s = %d, %d, %d; want %d each", s.Requests, s.Retries, s.Statuses[200], workers)
+	}
+}
+
+func TestMetricsObserveBuckets(t *testing.T) {
+	m := NewMetrics()
+	m.observe(&http.Response{StatusCode: 200}, nil, 30*time.Millisecond)
+	m.observe(&http.Response{StatusCode: 200}, nil, 100*time.Millisecond)
+	m.observe(nil, context.DeadlineExceeded, 15*time.Second)
+
+	s := m.Snapshot()
+	want := make([]int, len(MetricsLatencyBuckets)+1)
+	want[0], want[1], want[len(want)-1] = 1, 1, 1
+	if !reflect.DeepEqual(s.Latency, want) {
+		t.Errorf("latency = %v, want %v", s.Latency, want)
+	}
+	if s.Failures != 1 || s.Statuses[200] != 2 {
+		t.Errorf("failures = %d, 200s = %d; want 1, 2", s.Failures, s.Statuses[200])
+	}
+
+	// A snapshot is a copy
+	s.Statuses[200] = 9
+	s.Latency[0] = 9
+	if again := m.Snapshot(); again.Statuses[200] != 2 || again.Latency[0] != 1 {
+		t.Errorf("changing a snapshot changed the metrics: %+v", again)
+	}
+}
+
+func TestMetricsWritePrometheus(t *testing.T) {
+	m := NewMetrics()
+	m.observe(&http.Response{StatusCode: 200}, nil, 80*time.Millisecond)
+	m.observe(&http.Response{StatusCode: 429}, nil, 400*time.Millisecond)
+	m.retry()
+
+	var buf bytes.Buffer
+	if err := m.Snapshot().WritePrometheus(&buf); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		"# TYPE warp_api_requests_total counter\nwarp_api_requests_total 2\n",
+		"warp_api_retries_total 1\n",
+		"warp_api_request_failures_total 0\n",
+		`warp_api_responses_total{code="200"} 1` + "\n" + `warp_api_responses_total{code="429"} 1`,
+		"# TYPE warp_api_request_duration_seconds histogram\n",
+		`warp_api_request_duration_seconds_bucket{le="0.05"} 0`,
+		`warp_api_request_duration_seconds_bucket{le="0.1"} 1`,
+		`warp_api_request_duration_seconds_bucket{le="0.5"} 2`,
+		`warp_api_request_duration_seconds_bucket{le="+Inf"} 2`,
+		"warp_api_request_duration_seconds_sum 0.48\n",
+		"warp_api_request_duration_seconds_count 2\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output should contain %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestSaveLoadMetrics(t *testing.T) {
+	m := NewMetrics()
+	m.observe(&http.Response{StatusCode: 200}, nil, 120*time.Millisecond)
+	m.retry()
+	want := m.Snapshot()
+
+	path := filepath.Join(t.TempDir(), "warp", MetricsFileName)
+	if err := SaveMetrics(path, want); err != nil {
+		t.Fatalf("SaveMetrics() error = %v", err)
+	}
+	got, err := LoadMetrics(path)
+	if err != nil {
+		t.Fatalf("LoadMetrics() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("LoadMetrics() = %+v, want %+v", got, want)
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"encoding/json"
 	"encoding/xml"
-	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -14,6 +13,7 @@ import (
 	"time"
 
 	"github.com/pyhub-apps/pyhub-warp-cli/internal/logger"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/security"
 )
 
 // NLICClient represents the National Law Information Center API client
@@ -29,9 +29,7 @@ type NLICClient struct {
 // NewNLICClient creates a new NLIC API client
 func NewNLICClient(apiKey string) *NLICClient {
 	return &NLICClient{
-		httpClient: &http.Client{
-			Timeout: DefaultTimeout,
-		},
+		httpClient:     newHTTPClient(),
 		baseURL:        BaseURL,
 		detailURL:      "https://www.law.go.kr/DRF/lawService.do",
 		historyURL:     "https://www.law.go.kr/DRF/lawHistory.do",
@@ -43,9 +41,7 @@ func NewNLICClient(apiKey string) *NLICClient {
 // NewNLICClientWithURL creates a new NLIC API client with custom URLs (for testing)
 func NewNLICClientWithURL(apiKey, baseURL string) *NLICClient {
 	return &NLICClient{
-		httpClient: &http.Client{
-			Timeout: DefaultTimeout,
-		},
+		httpClient:     newHTTPClient(),
 		baseURL:        baseURL,
 		detailURL:      baseURL, // Use same URL for testing
 		historyURL:     baseURL, // Use same URL for testing
@@ -61,39 +57,16 @@ func (c *NLICClient) GetAPIType() APIType {
 
 // Search performs a law search
 func (c *NLICClient) Search(ctx context.Context, req *UnifiedSearchRequest) (*SearchResponse, error) {
-	// Set defaults
-	if req.Type == "" {
-		req.Type = "JSON"
-	}
-	if req.PageNo == 0 {
-		req.PageNo = 1
-	}
-	if req.PageSize == 0 {
-		req.PageSize = 10
-	}
-
 	// Build URL with parameters
-	params := url.Values{}
-	params.Set("OC", c.apiKey)
-	params.Set("target", "law")
-	params.Set("query", req.Query)
-	params.Set("type", req.Type)
-	params.Set("page", fmt.Sprintf("%d", req.PageNo))
-	params.Set("display", fmt.Sprintf("%d", req.PageSize))
-
-	// Add optional filters
-	if req.LawType != "" {
-		params.Set("법령구분", req.LawType)
-	}
-	if req.Department != "" {
-		params.Set("소관부처", req.Department)
-	}
-	if req.Sort != "" {
-		params.Set("sort", req.Sort)
+	params := buildSearchParams(c.apiKey, "law", req)
+	// The API filters by a single law type; several are filtered from the page
+	lawTypes := ParseLawTypes(req.LawType)
+	if len(lawTypes) == 1 {
+		params.Set("법령구분", lawTypes[0])
 	}
 
 	fullURL := fmt.Sprintf("%s?%s", c.baseURL, params.Encode())
-	logger.Debug("API Request URL: %s", fullURL)
+	logger.Debug("API Request URL: %s", security.SanitizeURL(fullURL))
 
 	// Perform request with retries
 	body, err := c.doRequestWithRetry(ctx, fullURL)
@@ -117,13 +90,9 @@ func (c *NLICClient) Search(ctx context.Context, req *UnifiedSearchRequest) (*Se
 			bodyStr := string(body)
 			if strings.HasPrefix(strings.TrimSpace(bodyStr), "<!DOCTYPE") || strings.HasPrefix(strings.TrimSpace(bodyStr), "<html") {
 				// Parse HTML error message
-				errorMsg := c.parseHTMLError(bodyStr)
-				logger.Debug("HTML error response detected: %s", errorMsg)
-				// Check if it's an API key error
-				if strings.Contains(errorMsg, "API 인증 실패") || strings.Contains(errorMsg, "API 키") {
-					return nil, &APIKeyError{Message: errorMsg}
-				}
-				return nil, fmt.Errorf("%s", errorMsg)
+				htmlErr := c.parseHTMLError(bodyStr)
+				logger.Debug("HTML error response detected: %v", htmlErr)
+				return nil, htmlErr
 			}
 			// Log non-HTML parsing errors for debugging
 			if len(bodyStr) > 500 {
@@ -141,6 +110,13 @@ func (c *NLICClient) Search(ctx context.Context, req *UnifiedSearchRequest) (*Se
 		}
 		searchResp.Laws = wrapper.LawSearch.Law
 	} else {
+		// An HTML error page is well-formed enough to decode as an empty result
+		bodyStr := strings.TrimSpace(string(body))
+		if strings.HasPrefix(bodyStr, "<!DOCTYPE") || strings.HasPrefix(bodyStr, "<html") {
+			htmlErr := c.parseHTMLError(bodyStr)
+			logger.Debug("HTML error response detected: %v", htmlErr)
+			return nil, htmlErr
+		}
 		if err := xml.Unmarshal(body, &searchResp); err != nil {
 			return nil, fmt.Errorf("XML 파싱 실패: %w", err)
 		}
@@ -150,6 +126,17 @@ func (c *NLICClient) Search(ctx context.Context, req *UnifiedSearchRequest) (*Se
 	if searchResp.Page == 0 {
 		searchResp.Page = req.PageNo
 	}
+	searchResp.PageSize = req.PageSize
+
+	// The total stays the one of the server, so that the pages are counted
+	// over every result
+	if len(lawTypes) > 1 {
+		searchResp.Laws = FilterLawTypes(searchResp.Laws, lawTypes)
+	}
+
+	// Apply the requested order to the page as well, so every source sorts alike
+	SortLaws(searchResp.Laws, req.Sort, req.Order)
+	PrioritizeLaws(searchResp.Laws, req.Priority)
 
 	return &searchResp, nil
 }
@@ -176,19 +163,19 @@ func (c *NLICClient) GetDetail(ctx context.Context, lawID string) (*LawDetail, e
 	}
 	logger.Debug("Law Detail API Response (first %d chars): %s", maxLen, string(body[:maxLen]))
 
+	if _, ok := detailNotFound(body); ok {
+		return nil, newStatusError(ErrNotFound, 0, "법령 상세 정보를 찾을 수 없습니다 (법령일련번호: %s)", lawID)
+	}
+
 	// Parse the response using the correct structure
 	var detailResp LawDetailResponse
 	if err := json.Unmarshal(body, &detailResp); err != nil {
 		// Check if response is HTML (error page)
 		bodyStr := string(body)
 		if strings.HasPrefix(strings.TrimSpace(bodyStr), "<!DOCTYPE") || strings.HasPrefix(strings.TrimSpace(bodyStr), "<html") {
-			errorMsg := c.parseHTMLError(bodyStr)
-			logger.Debug("HTML error response detected: %s", errorMsg)
-			// Check if it's an API key error
-			if strings.Contains(errorMsg, "API 인증 실패") || strings.Contains(errorMsg, "API 키") {
-				return nil, &APIKeyError{Message: errorMsg}
-			}
-			return nil, fmt.Errorf("%s", errorMsg)
+			htmlErr := c.parseHTMLError(bodyStr)
+			logger.Debug("HTML error response detected: %v", htmlErr)
+			return nil, htmlErr
 		}
 		return nil, fmt.Errorf("응답 데이터 파싱 실패: %w", err)
 	}
@@ -208,6 +195,7 @@ func (c *NLICClient) GetDetail(ctx context.Context, lawID string) (*LawDetail, e
 		basicInfo := detailResp.Law.BasicInfo
 		detail.LawInfo.ID = basicInfo.LawID
 		detail.LawInfo.Name = basicInfo.LawNameKorean
+		detail.LawInfo.NameHanja = strings.TrimSpace(basicInfo.LawNameHanja)
 		detail.LawInfo.PromulDate = basicInfo.PromulgationDate
 		detail.LawInfo.PromulNo = basicInfo.PromulgationNumber
 		detail.LawInfo.EffectDate = basicInfo.EffectiveDate
@@ -225,16 +213,9 @@ func (c *NLICClient) GetDetail(ctx context.Context, lawID string) (*LawDetail, e
 	}
 
 	// Process revision text
-	if detailResp.Law.Revisions.Content != nil {
-		detail.HasRevisionText = true
-		// Convert revision content to string if it exists
-		if revStr, ok := detailResp.Law.Revisions.Content.(string); ok {
-			detail.RevisionText = revStr
-		} else {
-			// Mark that revision exists but don't try to parse complex structure
-			detail.RevisionText = "(개정문 내용 있음)"
-		}
-	}
+	// The revision text can be a string or nested arrays of lines
+	detail.RevisionText = flattenContent(detailResp.Law.Revisions.Content)
+	detail.HasRevisionText = detail.RevisionText != ""
 
 	// Convert tables from the API response
 	for _, unit := range detailResp.Law.Tables.TableUnits {
@@ -243,19 +224,17 @@ func (c *NLICClient) GetDetail(ctx context.Context, lawID string) (*LawDetail, e
 			Title:  unit.TableTitle,
 		}
 
-		// Handle table content which can be string or array
-		if unit.TableContent != nil {
-			if tableStr, ok := unit.TableContent.(string); ok {
-				table.Content = tableStr
-			} else {
-				// If it's an array or other type, just mark as existing
-				table.Content = "(별표 내용 있음)"
-			}
-		}
+		// Table content can be a string, an array or an object
+		table.Content = flattenContent(unit.TableContent)
+		detail.Attachments = append(detail.Attachments, tableAttachments(unit)...)
 
 		detail.Tables = append(detail.Tables, table)
 	}
 
+	// Keep the links of related laws and attached files
+	detail.RelatedLaws = parseRelatedLaws(detailResp.Law.RelatedLaws)
+	detail.Attachments = append(detail.Attachments, parseAttachments(detailResp.Law.Attachments)...)
+
 	// Convert supplementary provisions from the API response
 	for _, unit := range detailResp.Law.SupplementaryProvisions.ProvisionUnits {
 		supp := SupplementaryProvision{
@@ -263,24 +242,8 @@ func (c *NLICClient) GetDetail(ctx context.Context, lawID string) (*LawDetail, e
 			PromulgationDate: unit.ProvisionDate,
 		}
 
-		// Handle provision content which can be string or array
-		if unit.ProvisionContent != nil {
-			if provStr, ok := unit.ProvisionContent.(string); ok {
-				supp.Content = provStr
-			} else if provArr, ok := unit.ProvisionContent.([]interface{}); ok {
-				// If it's an array, join the elements
-				var contentParts []string
-				for _, part := range provArr {
-					if str, ok := part.(string); ok {
-						contentParts = append(contentParts, str)
-					}
-				}
-				supp.Content = strings.Join(contentParts, "\n")
-			} else {
-				// If it's something else, just mark as existing
-				supp.Content = "(부칙 내용 있음)"
-			}
-		}
+		// Provision content can be a string, an array or an object
+		supp.Content = flattenContent(unit.ProvisionContent)
 
 		detail.SupplementaryProvisions = append(detail.SupplementaryProvisions, supp)
 	}
@@ -292,6 +255,9 @@ func (c *NLICClient) GetDetail(ctx context.Context, lawID string) (*LawDetail, e
 			Title:      unit.ArticleTitle,
 			Content:    unit.ArticleContent,
 			EffectDate: unit.ArticleEffectDate,
+			MoveBefore: strings.TrimSpace(unit.ArticleMoveBefore),
+			MoveAfter:  strings.TrimSpace(unit.ArticleMoveAfter),
+			Paragraphs: parseParagraphs(unit.Paragraphs),
 		}
 		detail.Articles = append(detail.Articles, article)
 
@@ -336,13 +302,9 @@ func (c *NLICClient) GetHistory(ctx context.Context, lawID string) (*LawHistory,
 		// Check if response is HTML (error page)
 		bodyStr := string(body)
 		if strings.HasPrefix(strings.TrimSpace(bodyStr), "<!DOCTYPE") || strings.HasPrefix(strings.TrimSpace(bodyStr), "<html") {
-			errorMsg := c.parseHTMLError(bodyStr)
-			logger.Debug("HTML error response detected: %s", errorMsg)
-			// Check if it's an API key error
-			if strings.Contains(errorMsg, "API 인증 실패") || strings.Contains(errorMsg, "API 키") {
-				return nil, &APIKeyError{Message: errorMsg}
-			}
-			return nil, fmt.Errorf("%s", errorMsg)
+			htmlErr := c.parseHTMLError(bodyStr)
+			logger.Debug("HTML error response detected: %v", htmlErr)
+			return nil, htmlErr
 		}
 		// Try parsing as a wrapper
 		var wrapper struct {
@@ -367,16 +329,15 @@ func (c *NLICClient) GetHistory(ctx context.Context, lawID string) (*LawHistory,
 // doRequestWithRetry performs an HTTP request with retry logic
 func (c *NLICClient) doRequestWithRetry(ctx context.Context, url string) ([]byte, error) {
 	var lastErr error
-	retryDelay := c.retryBaseDelay
+	backoff := newBackoff(c.retryBaseDelay)
 
 	for attempt := 0; attempt < MaxRetries; attempt++ {
 		if attempt > 0 {
-			// Wait before retry with exponential backoff
-			select {
-			case <-time.After(retryDelay):
-				retryDelay *= 2
-			case <-ctx.Done():
-				return nil, ctx.Err()
+			// Wait before retry with exponential backoff and jitter,
+			// or as long as the server asked with Retry-After
+			recordRetry()
+			if err := sleepContext(ctx, backoff.RetryDelay(attempt, lastErr)); err != nil {
+				return nil, err
 			}
 		}
 
@@ -384,7 +345,7 @@ func (c *NLICClient) doRequestWithRetry(ctx context.Context, url string) ([]byte
 		if err != nil {
 			lastErr = err
 			// Only retry on network errors or 5xx server errors
-			if !c.shouldRetry(err) {
+			if !isRetryable(err) {
 				return nil, err
 			}
 			continue
@@ -403,20 +364,15 @@ func (c *NLICClient) doRequest(ctx context.Context, url string) ([]byte, error)
 		return nil, fmt.Errorf("요청 생성 실패: %w", err)
 	}
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := doHTTP(c.httpClient, req)
 	if err != nil {
-		// Do not retry on explicit context cancellation or deadline
-		if ctx.Err() != nil {
-			return nil, fmt.Errorf("요청이 취소되었거나 시간 초과되었습니다: %w", ctx.Err())
-		}
-		// Treat other transport-level problems as retryable
-		return nil, &RetryableError{Err: fmt.Errorf("네트워크 에러: %w", err)}
+		return nil, requestError(ctx, err)
 	}
 	defer resp.Body.Close()
 
 	// Check HTTP status
 	if resp.StatusCode != http.StatusOK {
-		return nil, c.handleHTTPError(resp.StatusCode)
+		return nil, withRetryAfter(c.handleHTTPError(resp.StatusCode), resp)
 	}
 
 	// Read response body
@@ -435,39 +391,7 @@ func (c *NLICClient) doRequest(ctx context.Context, url string) ([]byte, error)
 
 // handleHTTPError converts HTTP status codes to appropriate errors
 func (c *NLICClient) handleHTTPError(statusCode int) error {
-	switch statusCode {
-	case http.StatusTooManyRequests:
-		return &RetryableError{Err: fmt.Errorf("레이트 리밋: HTTP 429 (잠시 후 다시 시도하세요)")}
-	case http.StatusRequestTimeout:
-		return &RetryableError{Err: fmt.Errorf("요청 타임아웃: HTTP 408")}
-	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
-		return &RetryableError{Err: fmt.Errorf("일시적 서버 오류: HTTP %d", statusCode)}
-	case http.StatusInternalServerError:
-		return &RetryableError{Err: fmt.Errorf("내부 서버 오류: HTTP 500")}
-	case http.StatusUnauthorized, http.StatusForbidden:
-		return fmt.Errorf("인증 실패: HTTP %d - API 키를 확인하세요", statusCode)
-	default:
-		if statusCode >= 500 {
-			return &RetryableError{Err: fmt.Errorf("서버 에러: HTTP %d", statusCode)}
-		}
-		return fmt.Errorf("클라이언트 에러: HTTP %d", statusCode)
-	}
-}
-
-// shouldRetry determines if an error is retryable
-func (c *NLICClient) shouldRetry(err error) bool {
-	if err == nil {
-		return false
-	}
-
-	// Check if it's explicitly marked as retryable
-	var retryableErr *RetryableError
-	if errors.As(err, &retryableErr) {
-		return true
-	}
-
-	// Also check for network-related errors in the message
-	return strings.Contains(err.Error(), "네트워크") || strings.Contains(err.Error(), "타임아웃")
+	return httpStatusError(statusCode)
 }
 
 // hasAPIError checks if the response contains an API error
@@ -499,15 +423,15 @@ func (c *NLICClient) parseAPIError(body []byte) error {
 	return fmt.Errorf("알 수 없는 API 에러")
 }
 
-// parseHTMLError extracts meaningful error message from HTML error page
-func (c *NLICClient) parseHTMLError(html string) string {
+// parseHTMLError converts an HTML error page to an error of the matching kind
+func (c *NLICClient) parseHTMLError(html string) error {
 	// Check for specific error messages in the HTML response
 	if strings.Contains(html, "미신청된 목록/본문에 대한 접근입니다") {
-		return "API 사용 권한이 없습니다. https://open.law.go.kr 에서 로그인 후 [OPEN API] -> [OPEN API 신청]에서 필요한 법령 종류를 체크해주세요"
+		return newStatusError(ErrUnauthorized, 0, "API 사용 권한이 없습니다. https://open.law.go.kr 에서 로그인 후 [OPEN API] -> [OPEN API 신청]에서 필요한 법령 종류를 체크해주세요")
 	}
 
 	if strings.Contains(html, "페이지 접속에 실패하였습니다") {
-		return "API 접속 실패: API 키를 확인하거나 서비스 상태를 점검해주세요"
+		return &APIKeyError{Message: "API 접속 실패: API 키를 확인하거나 서비스 상태를 점검해주세요"}
 	}
 
 	// Common patterns for error messages in HTML pages
@@ -532,17 +456,17 @@ func (c *NLICClient) parseHTMLError(html string) string {
 	// Check for authentication/key related issues
 	if strings.Contains(htmlLower, "인증") || strings.Contains(htmlLower, "auth") ||
 		strings.Contains(htmlLower, "key") || strings.Contains(htmlLower, "키") {
-		return "API 인증 실패: 이메일 ID가 올바르지 않습니다. 'warp config set law.key YOUR_EMAIL_ID' 명령으로 이메일 @ 앞부분을 설정하세요"
+		return &APIKeyError{Message: "API 인증 실패: 이메일 ID가 올바르지 않습니다. 'warp config set law.key YOUR_EMAIL_ID' 명령으로 이메일 @ 앞부분을 설정하세요"}
 	}
 
 	// Check for rate limit
 	if strings.Contains(htmlLower, "limit") || strings.Contains(htmlLower, "제한") {
-		return "API 호출 제한 초과: 일일 호출 한도를 초과했습니다. 잠시 후 다시 시도하세요"
+		return newStatusError(ErrRateLimited, 0, "API 호출 제한 초과: 일일 호출 한도를 초과했습니다. 잠시 후 다시 시도하세요")
 	}
 
 	// Check for service unavailable
 	if strings.Contains(htmlLower, "maintenance") || strings.Contains(htmlLower, "점검") {
-		return "서비스 점검 중: 국가법령정보센터 API가 현재 점검 중입니다"
+		return newStatusError(ErrServiceUnavailable, 0, "서비스 점검 중: 국가법령정보센터 API가 현재 점검 중입니다")
 	}
 
 	// Try to extract error message from patterns
@@ -560,22 +484,22 @@ func (c *NLICClient) parseHTMLError(html string) string {
 					// Check if it's just a generic title
 					if strings.Contains(msg, "국가법령정보") && !strings.Contains(msg, "오류") {
 						// Generic title, return more specific message
-						return "API 인증 실패: API 키가 올바르지 않습니다. 'warp config set law.key YOUR_API_KEY' 명령으로 유효한 API 키를 설정하세요"
+						return &APIKeyError{Message: "API 인증 실패: API 키가 올바르지 않습니다. 'warp config set law.key YOUR_API_KEY' 명령으로 유효한 API 키를 설정하세요"}
 					}
-					return fmt.Sprintf("API 오류: %s", msg)
+					return fmt.Errorf("API 오류: %s", msg)
 				}
 			}
 		}
 	}
 
 	// Default message if no specific error found
-	return "API 요청 실패: 서버가 예상하지 못한 응답을 반환했습니다. API 키를 확인하거나 잠시 후 다시 시도하세요"
+	return &APIKeyError{Message: "API 요청 실패: 서버가 예상하지 못한 응답을 반환했습니다. API 키를 확인하거나 잠시 후 다시 시도하세요"}
 }
 
 // stripHTMLTags removes HTML tags from a string
 func stripHTMLTags(s string) string {
 	// Simple regex-like approach to remove HTML tags
-	result := ""
+	var result strings.Builder
 	inTag := false
 	for _, ch := range s {
 		if ch == '<' {
@@ -583,8 +507,8 @@ func stripHTMLTags(s string) string {
 		} else if ch == '>' {
 			inTag = false
 		} else if !inTag {
-			result += string(ch)
+			result.WriteRune(ch)
 		}
 	}
-	return strings.TrimSpace(result)
+	return strings.TrimSpace(result.String())
 }
@@ -234,6 +234,9 @@ func TestNLICClient_GetDetail(t *testing.T) {
 				if result.Name != tt.wantName {
 					t.Errorf("GetDetail() Name = %v, want %v", result.Name, tt.wantName)
 				}
+				if tt.wantName != "" && result.NameHanja != "個人情報保護法" {
+					t.Errorf("GetDetail() NameHanja = %v, want 個人情報保護法", result.NameHanja)
+				}
 				if len(result.Articles) != tt.wantArticles {
 					t.Errorf("GetDetail() Articles count = %v, want %v", len(result.Articles), tt.wantArticles)
 				}
@@ -0,0 +1,56 @@
+package api
+
+import "strings"
+
+// paragraphLevel names the fields of a level of the article hierarchy in
+// detail responses
+type paragraphLevel struct {
+	key, numberKey, contentKey string
+}
+
+// paragraphLevels are the levels below an article: 항, 호 and 목
+var paragraphLevels = []paragraphLevel{
+	{"항", "항번호", "항내용"},
+	{"호", "호번호", "호내용"},
+	{"목", "목번호", "목내용"},
+}
+
+// parseParagraphs returns the 항 of an article unit with their 호 and 목. The
+// API sends each level as an array, a single object, or text, mixed at
+// times; an object without its own number and text, as an article with 호
+// but no 항 has, contributes the units below it.
+func parseParagraphs(v interface{}) []Paragraph {
+	return parseParagraphLevel(v, 0)
+}
+
+// parseParagraphLevel returns the units of level in v
+func parseParagraphLevel(v interface{}, level int) []Paragraph {
+	switch value := v.(type) {
+	case []interface{}:
+		var paragraphs []Paragraph
+		for _, item := range value {
+			paragraphs = append(paragraphs, parseParagraphLevel(item, level)...)
+		}
+		return paragraphs
+	case map[string]interface{}:
+		fields := paragraphLevels[level]
+		p := Paragraph{
+			Number:  strings.TrimSpace(flattenContent(value[fields.numberKey])),
+			Content: flattenContent(value[fields.contentKey]),
+		}
+		if level+1 < len(paragraphLevels) {
+			p.Items = parseParagraphLevel(value[paragraphLevels[level+1].key], level+1)
+		}
+		if p.Number == "" && p.Content == "" {
+			return p.Items
+		}
+		return []Paragraph{p}
+	case nil:
+		return nil
+	default:
+		if text := flattenContent(value); text != "" {
+			return []Paragraph{{Content: text}}
+		}
+		return nil
+	}
+}
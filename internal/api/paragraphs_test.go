@@ -0,0 +1,91 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestParseParagraphs(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+		want []Paragraph
+	}{
+		{"null", `null`, nil},
+		{
+			"array of 항",
+			`[{"항번호": "①", "항내용": "① 첫째 항"}, {"항번호": "②", "항내용": "② 둘째 항"}]`,
+			[]Paragraph{{Number: "①", Content: "① 첫째 항"}, {Number: "②", Content: "② 둘째 항"}},
+		},
+		{
+			"single object with 호 and 목",
+			`{"항번호": "①", "항내용": "① 다음 각 호와 같다.", "호": [
+				{"호번호": "1.", "호내용": "1. 첫째 호", "목": {"목번호": "가.", "목내용": [["가. 첫째 목"]]}},
+				{"호번호": "2.", "호내용": "2. 둘째 호"}
+			]}`,
+			[]Paragraph{{Number: "①", Content: "① 다음 각 호와 같다.", Items: []Paragraph{
+				{Number: "1.", Content: "1. 첫째 호", Items: []Paragraph{{Number: "가.", Content: "가. 첫째 목"}}},
+				{Number: "2.", Content: "2. 둘째 호"},
+			}}},
+		},
+		{
+			"호 without a 항",
+			`{"호": [{"호번호": "1.", "호내용": "1. 첫째 호"}, {"호번호": "2.", "호내용": "2. 둘째 호"}]}`,
+			[]Paragraph{{Number: "1.", Content: "1. 첫째 호"}, {Number: "2.", Content: "2. 둘째 호"}},
+		},
+		{
+			"mixed text and objects",
+			`["① 본문만 있는 항", {"항번호": "②", "항내용": "② 객체인 항"}, "  "]`,
+			[]Paragraph{{Content: "① 본문만 있는 항"}, {Number: "②", Content: "② 객체인 항"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var v interface{}
+			if err := json.Unmarshal([]byte(tt.json), &v); err != nil {
+				t.Fatal(err)
+			}
+			if got := parseParagraphs(v); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseParagraphs(%s) = %+v, want %+v", tt.json, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNLICClient_GetDetailParagraphs(t *testing.T) {
+	const body = `{"법령": {
+		"기본정보": {"법령ID": "001234", "법령명_한글": "개인정보 보호법"},
+		"조문": {"조문단위": [
+			{"조문번호": "1", "조문제목": "목적", "조문내용": "제1조(목적) 이 법은 개인정보의 처리에 관한 사항을 정한다."},
+			{"조문번호": "2", "조문제목": "정의", "조문내용": "제2조(정의) 이 법에서 사용하는 용어의 뜻은 다음과 같다.",
+				"항": {"호": [{"호번호": "1.", "호내용": "1. \"개인정보\"란 다음 각 목의 정보를 말한다.",
+					"목": [{"목번호": "가.", "목내용": "가. 성명 등으로 알아볼 수 있는 정보"}]}]}}
+		]}
+	}}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	detail, err := NewNLICClientWithURL("test-key", server.URL).GetDetail(context.Background(), "001234")
+	if err != nil {
+		t.Fatalf("GetDetail() error = %v", err)
+	}
+	if len(detail.Articles) != 2 {
+		t.Fatalf("Articles = %+v, want 2", detail.Articles)
+	}
+	if detail.Articles[0].Paragraphs != nil {
+		t.Errorf("Articles[0].Paragraphs = %+v, want none", detail.Articles[0].Paragraphs)
+	}
+	want := []Paragraph{{Number: "1.", Content: `1. "개인정보"란 다음 각 목의 정보를 말한다.`, Items: []Paragraph{
+		{Number: "가.", Content: "가. 성명 등으로 알아볼 수 있는 정보"},
+	}}}
+	if got := detail.Articles[1].Paragraphs; !reflect.DeepEqual(got, want) {
+		t.Errorf("Articles[1].Paragraphs = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,75 @@
+package api
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/logger"
+)
+
+// DefaultPageSize is the number of results of a search page unless a size is
+// given. The --size flags of the search commands and the clients default to
+// it, so that a query shows the same results whichever command runs it.
+const DefaultPageSize = 50
+
+// MaxPageSize is the largest page a search asks for; larger sizes are
+// lowered to it
+const MaxPageSize = 1000
+
+// Defaults of the search requests sent by the clients
+const (
+	defaultSearchType = "JSON"
+	defaultPageNo     = 1
+)
+
+// normalizePage returns the page and page size a search sends: a page below
+// 1 is the first page, a size below 1 the default size, and a size above
+// MaxPageSize MaxPageSize
+func normalizePage(pageNo, pageSize int) (int, int) {
+	if pageNo < 1 {
+		pageNo = defaultPageNo
+	}
+	switch {
+	case pageSize < 1:
+		pageSize = DefaultPageSize
+	case pageSize > MaxPageSize:
+		pageSize = MaxPageSize
+	}
+	return pageNo, pageSize
+}
+
+// buildSearchParams returns the query parameters shared by the search APIs
+// of law.go.kr for a search of target: the API key, the query, the response
+// type, the page and its size, and the sort order (when the API of target
+// sorts by the key) and department filter when given. A missing type and the
+// page and size normalizePage sends are filled in req first, so that the
+// caller reads the values that were sent. Clients add the parameters only
+// their API has.
+func buildSearchParams(apiKey, target string, req *UnifiedSearchRequest) url.Values {
+	if req.Type == "" {
+		req.Type = defaultSearchType
+	}
+	req.PageNo, req.PageSize = normalizePage(req.PageNo, req.PageSize)
+
+	params := url.Values{}
+	params.Set("OC", apiKey)
+	params.Set("target", target)
+	params.Set("query", req.Query)
+	params.Set("type", req.Type)
+	params.Set("page", fmt.Sprintf("%d", req.PageNo))
+	params.Set("display", fmt.Sprintf("%d", req.PageSize))
+
+	// Add optional filters
+	if req.Department != "" {
+		params.Set("소관부처", req.Department)
+	}
+	sort, ok := sortParam(target, req.Sort, req.Order)
+	switch {
+	case sort != "":
+		params.Set("sort", sort)
+	case !ok:
+		// Without the order of the API the pages are not sorted as a whole
+		logger.Info("%s 검색 API는 %s 정렬을 지원하지 않아 페이지마다 결과만 정렬합니다", target, req.Sort)
+	}
+	return params
+}
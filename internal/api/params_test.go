@@ -0,0 +1,87 @@
+package api
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/testutil"
+)
+
+func TestBuildSearchParams(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		req := &UnifiedSearchRequest{Query: "개인정보"}
+		params := buildSearchParams("test-key", "law", req)
+		want := map[string]string{"OC": "test-key", "target": "law", "query": "개인정보", "type": "JSON", "page": "1", "display": strconv.Itoa(DefaultPageSize)}
+		for name, value := range want {
+			if got := params.Get(name); got != value {
+				t.Errorf("%s = %q, want %q", name, got, value)
+			}
+		}
+		if len(params) != len(want) {
+			t.Errorf("params = %v, want only %v", params, want)
+		}
+		// The defaults are filled in the request, as they were sent
+		if req.Type != "JSON" || req.PageNo != 1 || req.PageSize != DefaultPageSize {
+			t.Errorf("request = %+v, want the defaults filled", req)
+		}
+	})
+
+	t.Run("given values and filters", func(t *testing.T) {
+		req := &UnifiedSearchRequest{Query: "도로", Type: "XML", PageNo: 3, PageSize: 50, Department: "국토교통부", Sort: SortName, Order: OrderDesc}
+		params := buildSearchParams("test-key", "admrul", req)
+		want := map[string]string{"type": "XML", "page": "3", "display": "50", "소관부처": "국토교통부", "sort": "ldes"}
+		for name, value := range want {
+			if got := params.Get(name); got != value {
+				t.Errorf("%s = %q, want %q", name, got, value)
+			}
+		}
+	})
+
+	t.Run("out of range page and size", func(t *testing.T) {
+		tests := []struct {
+			page, size         int
+			wantPage, wantSize string
+		}{
+			{0, 0, "1", strconv.Itoa(DefaultPageSize)},
+			{-3, -10, "1", strconv.Itoa(DefaultPageSize)},
+			{2, MaxPageSize + 1, "2", strconv.Itoa(MaxPageSize)},
+			{1, 1 << 30, "1", strconv.Itoa(MaxPageSize)},
+		}
+		for _, tt := range tests {
+			req := &UnifiedSearchRequest{Query: "도로", PageNo: tt.page, PageSize: tt.size}
+			params := buildSearchParams("test-key", "law", req)
+			if params.Get("page") != tt.wantPage || params.Get("display") != tt.wantSize {
+				t.Errorf("page %d, size %d: page, display = %s, %s; want %s, %s",
+					tt.page, tt.size, params.Get("page"), params.Get("display"), tt.wantPage, tt.wantSize)
+			}
+		}
+	})
+
+	t.Run("relevance sort", func(t *testing.T) {
+		params := buildSearchParams("test-key", "law", &UnifiedSearchRequest{Query: "도로", Sort: SortRelevance})
+		if params.Has("sort") {
+			t.Errorf("sort = %q, want the API order", params.Get("sort"))
+		}
+	})
+}
+
+func TestSearchClientsDefaults(t *testing.T) {
+	for _, tc := range searchClientCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := testutil.NewAPIServer(t)
+			client := tc.newClient(server)
+			if _, err := client.Search(context.Background(), &UnifiedSearchRequest{Query: "개인정보", Type: "XML", Sort: SortPromulDate}); err != nil {
+				t.Fatalf("Search() error = %v", err)
+			}
+			requests := server.Requests()
+			if len(requests) != 1 {
+				t.Fatalf("server received %d requests, want 1", len(requests))
+			}
+			params := requests[0].Params
+			if params.Get("OC") != "test-key" || params.Get("page") != "1" || params.Get("display") != strconv.Itoa(DefaultPageSize) || params.Get("sort") != "ddes" {
+				t.Errorf("params = %v, want the key, the first page of the default size and newest first", params)
+			}
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/pyhub-apps/pyhub-warp-cli/internal/logger"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/security"
 )
 
 // PrecSearchResponse represents the precedent search response
@@ -33,6 +34,45 @@ type PrecInfo struct {
 	DetailLink string `xml:"판례상세링크"`
 }
 
+// PrecDetailResponse represents the precedent detail response
+type PrecDetailResponse struct {
+	XMLName     xml.Name `xml:"PrecService"`
+	ID          string   `xml:"판례정보일련번호"`
+	CaseName    string   `xml:"사건명"`
+	CaseNumber  string   `xml:"사건번호"`
+	JudgeDate   string   `xml:"선고일자"`
+	CourtName   string   `xml:"법원명"`
+	CaseType    string   `xml:"사건종류명"`
+	JudgeType   string   `xml:"판결유형"`
+	Holdings    string   `xml:"판시사항"`
+	Summary     string   `xml:"판결요지"`
+	RefArticles string   `xml:"참조조문"`
+	RefCases    string   `xml:"참조판례"`
+	Content     string   `xml:"판례내용"`
+}
+
+// toLawDetail converts the precedent to a LawDetail, its holdings, summary,
+// references and full text as sections
+func (r *PrecDetailResponse) toLawDetail() *LawDetail {
+	detail := &LawDetail{
+		LawInfo: LawInfo{
+			ID:         r.ID,
+			Name:       r.CaseName,
+			LawType:    "판례",
+			Department: r.CourtName,
+			PromulDate: r.JudgeDate,
+			PromulNo:   r.CaseNumber,
+			Category:   strings.TrimSpace(r.CaseType + " " + r.JudgeType),
+		},
+	}
+	detail.Sections = appendSection(detail.Sections, "판시사항", r.Holdings)
+	detail.Sections = appendSection(detail.Sections, "판결요지", r.Summary)
+	detail.Sections = appendSection(detail.Sections, "참조조문", r.RefArticles)
+	detail.Sections = appendSection(detail.Sections, "참조판례", r.RefCases)
+	detail.Sections = appendSection(detail.Sections, "전문", r.Content)
+	return detail
+}
+
 // PrecClient represents the Precedent API client (판례 API 클라이언트)
 type PrecClient struct {
 	httpClient     *http.Client
@@ -45,9 +85,7 @@ type PrecClient struct {
 // NewPrecClient creates a new Precedent API client
 func NewPrecClient(apiKey string) *PrecClient {
 	return &PrecClient{
-		httpClient: &http.Client{
-			Timeout: DefaultTimeout,
-		},
+		httpClient:     newHTTPClient(),
 		baseURL:        "https://www.law.go.kr/DRF/lawSearch.do",
 		detailURL:      "https://www.law.go.kr/DRF/lawService.do",
 		apiKey:         apiKey,
@@ -62,33 +100,11 @@ func (c *PrecClient) GetAPIType() APIType {
 
 // Search performs a precedent search
 func (c *PrecClient) Search(ctx context.Context, req *UnifiedSearchRequest) (*SearchResponse, error) {
-	// Set defaults
-	if req.Type == "" {
-		req.Type = "JSON"
-	}
-	if req.PageNo == 0 {
-		req.PageNo = 1
-	}
-	if req.PageSize == 0 {
-		req.PageSize = 10
-	}
-
 	// Build URL with parameters
-	params := url.Values{}
-	params.Set("OC", c.apiKey)
-	params.Set("target", "prec") // 판례 검색
-	params.Set("query", req.Query)
-	params.Set("type", req.Type)
-	params.Set("page", fmt.Sprintf("%d", req.PageNo))
-	params.Set("display", fmt.Sprintf("%d", req.PageSize))
-
-	// Add optional filters
-	if req.Sort != "" {
-		params.Set("sort", req.Sort)
-	}
+	params := buildSearchParams(c.apiKey, "prec", req) // 판례 검색
 
 	fullURL := fmt.Sprintf("%s?%s", c.baseURL, params.Encode())
-	logger.Debug("Precedent API Request URL: %s", fullURL)
+	logger.Debug("Precedent API Request URL: %s", security.SanitizeURL(fullURL))
 
 	// Perform request with retries
 	body, err := c.doRequestWithRetry(ctx, fullURL)
@@ -114,6 +130,7 @@ func (c *PrecClient) Search(ctx context.Context, req *UnifiedSearchRequest) (*Se
 	response := &SearchResponse{
 		TotalCount: precResponse.TotalCount,
 		Page:       precResponse.Page,
+		PageSize:   req.PageSize,
 		Laws:       make([]LawInfo, len(precResponse.Precs)),
 	}
 
@@ -125,9 +142,18 @@ func (c *PrecClient) Search(ctx context.Context, req *UnifiedSearchRequest) (*Se
 			Department: prec.CourtName,
 			PromulDate: prec.JudgeDate,
 			PromulNo:   prec.CaseNumber,
+			ExtraFields: map[string]string{
+				ExtraCourtName:  prec.CourtName,
+				ExtraCaseNumber: prec.CaseNumber,
+				ExtraJudgeDate:  prec.JudgeDate,
+			},
 		}
 	}
 
+	// Apply the requested order to the page as well, which is all the order
+	// there is for keys the API cannot sort by
+	SortLaws(response.Laws, req.Sort, req.Order)
+
 	return response, nil
 }
 
@@ -138,10 +164,10 @@ func (c *PrecClient) GetDetail(ctx context.Context, precID string) (*LawDetail,
 	params.Set("OC", c.apiKey)
 	params.Set("target", "prec") // 판례 상세
 	params.Set("ID", precID)
-	params.Set("type", "JSON")
+	params.Set("type", "XML") // The JSON detail is empty or laid out differently
 
 	fullURL := fmt.Sprintf("%s?%s", c.detailURL, params.Encode())
-	logger.Debug("Precedent Detail API Request URL: %s", fullURL)
+	logger.Debug("Precedent Detail API Request URL: %s", security.SanitizeURL(fullURL))
 
 	// Perform request with retries
 	body, err := c.doRequestWithRetry(ctx, fullURL)
@@ -157,13 +183,13 @@ func (c *PrecClient) GetDetail(ctx context.Context, precID string) (*LawDetail,
 	logger.Debug("Precedent Detail API Response (first %d chars): %s", maxLen, string(body[:maxLen]))
 
 	// Parse response
-	var detail LawDetail
-	if err := json.Unmarshal(body, &detail); err != nil {
-		logger.Error("JSON parsing failed for precedent detail: %v", err)
+	var precDetail PrecDetailResponse
+	if err := decodeDetailXML(body, "PrecService", &precDetail, "요청한 판례를 찾을 수 없습니다"); err != nil {
+		logger.Error("XML parsing failed for precedent detail: %v", err)
 		return nil, fmt.Errorf("판례 상세 정보 파싱 실패: %w", err)
 	}
 
-	return &detail, nil
+	return precDetail.toLawDetail(), nil
 }
 
 // GetHistory retrieves precedent history (판례는 이력이 없으므로 미지원)
@@ -174,13 +200,11 @@ func (c *PrecClient) GetHistory(ctx context.Context, precID string) (*LawHistory
 // doRequestWithRetry performs HTTP request with retry logic
 func (c *PrecClient) doRequestWithRetry(ctx context.Context, url string) ([]byte, error) {
 	var lastErr error
-	delay := c.retryBaseDelay
+	backoff := newBackoff(c.retryBaseDelay)
 
 	for i := 0; i < MaxRetries; i++ {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		default:
+		if ctx.Err() != nil {
+			return nil, contextError(ctx)
 		}
 
 		body, err := c.doRequest(ctx, url)
@@ -193,14 +217,17 @@ func (c *PrecClient) doRequestWithRetry(ctx context.Context, url string) ([]byte
 		}
 
 		lastErr = err
-		if !c.shouldRetry(err) {
+		if !isRetryable(err) {
 			return nil, err
 		}
 
 		if i < MaxRetries-1 {
+			delay := backoff.RetryDelay(i+1, err)
 			logger.Debug("Retrying after %v (attempt %d/%d)", delay, i+1, MaxRetries)
-			time.Sleep(delay)
-			delay *= 2
+			recordRetry()
+			if err := sleepContext(ctx, delay); err != nil {
+				return nil, err
+			}
 		}
 	}
 
@@ -214,9 +241,9 @@ func (c *PrecClient) doRequest(ctx context.Context, url string) ([]byte, error)
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := doHTTP(c.httpClient, req)
 	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
+		return nil, requestError(ctx, err)
 	}
 	defer resp.Body.Close()
 
@@ -228,12 +255,11 @@ func (c *PrecClient) doRequest(ctx context.Context, url string) ([]byte, error)
 	// Check for HTML error response
 	if strings.HasPrefix(strings.TrimSpace(string(body)), "<!DOCTYPE") ||
 		strings.HasPrefix(strings.TrimSpace(string(body)), "<html") {
-		errMsg := c.parseHTMLError(string(body))
-		return nil, &APIKeyError{Message: errMsg}
+		return nil, c.parseHTMLError(string(body))
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, c.handleHTTPError(resp.StatusCode)
+		return nil, withRetryAfter(c.handleHTTPError(resp.StatusCode), resp)
 	}
 
 	return body, nil
@@ -247,24 +273,18 @@ func (c *PrecClient) handleHTTPError(statusCode int) error {
 	case http.StatusForbidden:
 		return &APIKeyError{Message: "API 접근 권한이 없습니다"}
 	case http.StatusNotFound:
-		return fmt.Errorf("요청한 판례를 찾을 수 없습니다")
+		return newStatusError(ErrNotFound, statusCode, "요청한 판례를 찾을 수 없습니다")
+	case http.StatusRequestTimeout:
+		return &RetryableError{Err: newStatusError(nil, statusCode, "요청 타임아웃: HTTP 408")}
 	case http.StatusTooManyRequests:
-		return fmt.Errorf("API 요청 한도를 초과했습니다. 잠시 후 다시 시도해주세요")
-	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable:
-		return fmt.Errorf("서버 오류가 발생했습니다. 잠시 후 다시 시도해주세요")
+		return &RetryableError{Err: newStatusError(ErrRateLimited, statusCode, "API 요청 한도를 초과했습니다. 잠시 후 다시 시도해주세요")}
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return &RetryableError{Err: newStatusError(ErrServiceUnavailable, statusCode, "서버 오류가 발생했습니다. 잠시 후 다시 시도해주세요")}
 	default:
 		return fmt.Errorf("HTTP 오류: %d", statusCode)
 	}
 }
 
-// shouldRetry determines if the error is retryable
-func (c *PrecClient) shouldRetry(err error) bool {
-	errStr := err.Error()
-	return strings.Contains(errStr, "서버 오류") ||
-		strings.Contains(errStr, "timeout") ||
-		strings.Contains(errStr, "connection refused")
-}
-
 // hasAPIError checks if the response contains an API error
 func (c *PrecClient) hasAPIError(body []byte) bool {
 	// Check for common error patterns in JSON/XML responses
@@ -302,26 +322,26 @@ func (c *PrecClient) parseAPIError(body []byte) error {
 	return fmt.Errorf("알 수 없는 API 오류")
 }
 
-// parseHTMLError extracts meaningful error message from HTML response
-func (c *PrecClient) parseHTMLError(html string) string {
+// parseHTMLError converts an HTML error page to an error of the matching kind
+func (c *PrecClient) parseHTMLError(html string) error {
 	htmlLower := strings.ToLower(html)
 
 	// Check for authentication/key related issues
 	if strings.Contains(htmlLower, "인증") || strings.Contains(htmlLower, "auth") ||
 		strings.Contains(htmlLower, "key") || strings.Contains(htmlLower, "키") {
-		return "API 인증 실패: API 키가 유효하지 않거나 만료되었습니다. 'warp config set law.key <API_KEY>' 명령으로 유효한 API 키를 설정해주세요."
+		return &APIKeyError{Message: "API 인증 실패: API 키가 유효하지 않거나 만료되었습니다. 'warp config set law.key <API_KEY>' 명령으로 유효한 API 키를 설정해주세요."}
 	}
 
 	// Check for service errors
 	if strings.Contains(htmlLower, "서비스") || strings.Contains(htmlLower, "service") {
-		return "서비스 일시 중단: 국가법령정보센터 서비스가 일시적으로 이용할 수 없습니다. 잠시 후 다시 시도해주세요."
+		return newStatusError(ErrServiceUnavailable, 0, "서비스 일시 중단: 국가법령정보센터 서비스가 일시적으로 이용할 수 없습니다. 잠시 후 다시 시도해주세요.")
 	}
 
 	// Check for not found errors
 	if strings.Contains(htmlLower, "not found") || strings.Contains(htmlLower, "404") {
-		return "요청한 판례를 찾을 수 없습니다."
+		return newStatusError(ErrNotFound, 0, "요청한 판례를 찾을 수 없습니다.")
 	}
 
-	// Default error message
-	return "API 요청 실패: 국가법령정보센터 API에서 오류가 발생했습니다. API 키를 확인하거나 잠시 후 다시 시도해주세요."
+	// Default: unexpected pages are mostly caused by the API key
+	return &APIKeyError{Message: "API 요청 실패: 국가법령정보센터 API에서 오류가 발생했습니다. API 키를 확인하거나 잠시 후 다시 시도해주세요."}
 }
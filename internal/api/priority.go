@@ -0,0 +1,101 @@
+package api
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Priority pins results of some departments and law types to the top of a
+// search (UnifiedSearchRequest.Priority). Earlier entries rank higher;
+// departments are compared first, then law types. In a priority file:
+//
+//	departments:
+//	  - 개인정보보호위원회
+//	  - 서울특별시
+//	law_types:
+//	  - 법률
+//	  - 대통령령
+type Priority struct {
+	Departments []string `yaml:"departments" json:"departments,omitempty"`
+	LawTypes    []string `yaml:"law_types" json:"law_types,omitempty"`
+}
+
+// ParsePriority parses a priority file. Unknown keys, blank or repeated
+// values and a file without any value are errors.
+func ParsePriority(data []byte) (*Priority, error) {
+	var p Priority
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&p); err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+
+	for _, list := range []struct {
+		key    string
+		values []string
+	}{{"departments", p.Departments}, {"law_types", p.LawTypes}} {
+		seen := make(map[string]bool)
+		for i, value := range list.values {
+			value = strings.TrimSpace(value)
+			if value == "" {
+				return nil, fmt.Errorf("%s의 %d번째 값이 비어 있습니다", list.key, i+1)
+			}
+			if seen[value] {
+				return nil, fmt.Errorf("%s에 %q가 중복되었습니다", list.key, value)
+			}
+			seen[value] = true
+			list.values[i] = value
+		}
+	}
+	if len(p.Departments) == 0 && len(p.LawTypes) == 0 {
+		return nil, fmt.Errorf("우선순위가 없습니다 (departments, law_types 중 하나 이상 지정)")
+	}
+	return &p, nil
+}
+
+// PrioritizeLaws orders laws in place by the ranks of p, keeping the current
+// order among laws of the same rank. Run after SortLaws, the priority becomes
+// the first sort key and the sort key the second. Values not in p go after
+// all listed ones. A nil p keeps the order.
+func PrioritizeLaws(laws []LawInfo, p *Priority) {
+	if p == nil {
+		return
+	}
+	departments := priorityRanks(p.Departments)
+	lawTypes := priorityRanks(p.LawTypes)
+	rank := func(l LawInfo) (int, int) {
+		return priorityRank(departments, l.Department), priorityRank(lawTypes, l.LawType)
+	}
+
+	sort.SliceStable(laws, func(i, j int) bool {
+		di, ti := rank(laws[i])
+		dj, tj := rank(laws[j])
+		if di != dj {
+			return di < dj
+		}
+		return ti < tj
+	})
+}
+
+// priorityRanks maps each value to its position in values
+func priorityRanks(values []string) map[string]int {
+	ranks := make(map[string]int, len(values))
+	for i, v := range values {
+		ranks[v] = i
+	}
+	return ranks
+}
+
+// priorityRank returns the rank of value, or len(ranks) for values not ranked
+func priorityRank(ranks map[string]int, value string) int {
+	if r, ok := ranks[strings.TrimSpace(value)]; ok {
+		return r
+	}
+	return len(ranks)
+}
@@ -0,0 +1,73 @@
+package api
+
+import "strings"
+
+// QueryMatch is how a query must appear in the names of search results. The
+// law.go.kr search APIs have no phrase or AND parameter and return the results
+// matching any word of a query, so the stricter modes filter the results.
+type QueryMatch int
+
+const (
+	// MatchAnyTerm keeps the results as the API returns them
+	MatchAnyTerm QueryMatch = iota
+	// MatchPhrase keeps the results whose name contains the whole query
+	MatchPhrase
+	// MatchAllTerms keeps the results whose name contains every word of the query
+	MatchAllTerms
+)
+
+// UnquoteQuery returns query without the double quotes around it, and whether
+// it had them; a quoted query asks for its phrase
+func UnquoteQuery(query string) (string, bool) {
+	query = strings.TrimSpace(query)
+	for _, quotes := range [][2]string{{`"`, `"`}, {"“", "”"}} {
+		if len(query) < len(quotes[0])+len(quotes[1]) ||
+			!strings.HasPrefix(query, quotes[0]) || !strings.HasSuffix(query, quotes[1]) {
+			continue
+		}
+		if inner := strings.TrimSpace(query[len(quotes[0]) : len(query)-len(quotes[1])]); inner != "" {
+			return inner, true
+		}
+	}
+	return query, false
+}
+
+// compactText drops the spaces of s and folds its case, so that "개인정보
+// 보호법" and "개인정보보호법" compare equal
+func compactText(s string) string {
+	return strings.ToLower(strings.Join(strings.Fields(s), ""))
+}
+
+// Match reports whether a law name matches query in mode m. Spaces and case
+// are ignored, and quotes around the query are dropped.
+func (m QueryMatch) Match(name, query string) bool {
+	query, _ = UnquoteQuery(query)
+	name = compactText(name)
+	switch m {
+	case MatchPhrase:
+		return strings.Contains(name, compactText(query))
+	case MatchAllTerms:
+		for _, term := range strings.Fields(query) {
+			if !strings.Contains(name, compactText(term)) {
+				return false
+			}
+		}
+		return true
+	default:
+		return true
+	}
+}
+
+// FilterQueryMatch returns the laws whose name matches query in mode m
+func FilterQueryMatch(laws []LawInfo, query string, m QueryMatch) []LawInfo {
+	if m == MatchAnyTerm {
+		return laws
+	}
+	var filtered []LawInfo
+	for _, law := range laws {
+		if m.Match(law.Name, query) {
+			filtered = append(filtered, law)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,78 @@
+package api
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestUnquoteQuery(t *testing.T) {
+	tests := []struct {
+		query      string
+		want       string
+		wantQuoted bool
+	}{
+		{`"개인정보 보호"`, "개인정보 보호", true},
+		{` “개인정보 보호” `, "개인정보 보호", true},
+		{`" 도로 교통 "`, "도로 교통", true},
+		{"개인정보 보호", "개인정보 보호", false},
+		{`"개인정보`, `"개인정보`, false},
+		{`""`, `""`, false},
+		{`"`, `"`, false},
+	}
+	for _, tt := range tests {
+		got, quoted := UnquoteQuery(tt.query)
+		if got != tt.want || quoted != tt.wantQuoted {
+			t.Errorf("UnquoteQuery(%q) = %q, %v; want %q, %v", tt.query, got, quoted, tt.want, tt.wantQuoted)
+		}
+	}
+}
+
+func TestQueryMatch(t *testing.T) {
+	tests := []struct {
+		mode  QueryMatch
+		name  string
+		query string
+		want  bool
+	}{
+		{MatchPhrase, "개인정보 보호법", "개인정보 보호", true},
+		{MatchPhrase, "개인정보보호법 시행령", "개인정보 보호", true},
+		{MatchPhrase, "개인정보 보호법", `"개인정보 보호"`, true},
+		{MatchPhrase, "보호관찰 등에 관한 법률", "개인정보 보호", false},
+		{MatchPhrase, "신용정보의 이용 및 보호에 관한 법률", "정보 보호", false},
+		{MatchPhrase, "GDPR 대응 지침", "gdpr", true},
+		{MatchAllTerms, "신용정보의 이용 및 보호에 관한 법률", "정보 보호", true},
+		{MatchAllTerms, "신용정보의 이용 및 보호에 관한 법률", "보호 신용정보", true},
+		{MatchAllTerms, "보호관찰 등에 관한 법률", "개인정보 보호", false},
+		{MatchAnyTerm, "도로교통법", "개인정보 보호", true},
+	}
+	for _, tt := range tests {
+		if got := tt.mode.Match(tt.name, tt.query); got != tt.want {
+			t.Errorf("%d.Match(%q, %q) = %v, want %v", tt.mode, tt.name, tt.query, got, tt.want)
+		}
+	}
+}
+
+func TestFilterQueryMatch(t *testing.T) {
+	laws := []LawInfo{
+		{ID: "1", Name: "개인정보 보호법"},
+		{ID: "2", Name: "보호관찰 등에 관한 법률"},
+		{ID: "3", Name: "신용정보의 이용 및 보호에 관한 법률"},
+	}
+	ids := func(laws []LawInfo) []string {
+		var ids []string
+		for _, law := range laws {
+			ids = append(ids, law.ID)
+		}
+		return ids
+	}
+
+	if got := ids(FilterQueryMatch(laws, "정보 보호", MatchPhrase)); !reflect.DeepEqual(got, []string{"1"}) {
+		t.Errorf("phrase: ids = %v, want [1]", got)
+	}
+	if got := ids(FilterQueryMatch(laws, "정보 보호", MatchAllTerms)); !reflect.DeepEqual(got, []string{"1", "3"}) {
+		t.Errorf("all terms: ids = %v, want [1 3]", got)
+	}
+	if got := FilterQueryMatch(laws, "정보 보호", MatchAnyTerm); len(got) != len(laws) {
+		t.Errorf("any term should keep every law, got %d", len(got))
+	}
+}
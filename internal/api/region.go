@@ -0,0 +1,76 @@
+package api
+
+import "strings"
+
+// Region is a metropolitan local government (광역자치단체), which the
+// ordinance search filters by its organization code (org parameter)
+type Region struct {
+	Name    string   // official name, e.g. 서울특별시
+	Code    string   // organization code of the ordinance search API
+	Aliases []string // short and former names, e.g. 서울, 서울시
+}
+
+// Regions lists the metropolitan local governments. 광주시 is left out of the
+// aliases of 광주광역시 since it is also a city of 경기도.
+var Regions = []Region{
+	{Name: "서울특별시", Code: "6110000", Aliases: []string{"서울", "서울시"}},
+	{Name: "부산광역시", Code: "6260000", Aliases: []string{"부산", "부산시"}},
+	{Name: "대구광역시", Code: "6270000", Aliases: []string{"대구", "대구시"}},
+	{Name: "인천광역시", Code: "6280000", Aliases: []string{"인천", "인천시"}},
+	{Name: "광주광역시", Code: "6290000", Aliases: []string{"광주"}},
+	{Name: "대전광역시", Code: "6300000", Aliases: []string{"대전", "대전시"}},
+	{Name: "울산광역시", Code: "6310000", Aliases: []string{"울산", "울산시"}},
+	{Name: "세종특별자치시", Code: "5690000", Aliases: []string{"세종", "세종시"}},
+	{Name: "경기도", Code: "6410000", Aliases: []string{"경기"}},
+	{Name: "강원특별자치도", Code: "6530000", Aliases: []string{"강원", "강원도"}},
+	{Name: "충청북도", Code: "6430000", Aliases: []string{"충북"}},
+	{Name: "충청남도", Code: "6440000", Aliases: []string{"충남"}},
+	{Name: "전북특별자치도", Code: "6540000", Aliases: []string{"전북", "전라북도"}},
+	{Name: "전라남도", Code: "6460000", Aliases: []string{"전남"}},
+	{Name: "경상북도", Code: "6470000", Aliases: []string{"경북"}},
+	{Name: "경상남도", Code: "6480000", Aliases: []string{"경남"}},
+	{Name: "제주특별자치도", Code: "6500000", Aliases: []string{"제주", "제주도"}},
+}
+
+// LookupRegion returns the region named name, by its official name or an
+// alias, spaces ignored
+func LookupRegion(name string) (Region, bool) {
+	name = strings.Join(strings.Fields(name), "")
+	for _, region := range Regions {
+		if region.Name == name || containsString(region.Aliases, name) {
+			return region, true
+		}
+	}
+	return Region{}, false
+}
+
+// regionFilter is a region filter of the ordinance search: the region sent
+// as the org parameter, if the filter names one, and the rest of the filter,
+// such as a 시군구 or a region that is not a metropolitan one, which is
+// matched against the local government of the results
+type regionFilter struct {
+	region *Region
+	local  string
+}
+
+// parseRegionFilter parses a region filter such as "서울", "서울 강남구" or
+// "수원시"
+func parseRegionFilter(filter string) regionFilter {
+	fields := strings.Fields(filter)
+	if len(fields) == 0 {
+		return regionFilter{}
+	}
+	if region, ok := LookupRegion(fields[0]); ok {
+		return regionFilter{region: &region, local: strings.Join(fields[1:], "")}
+	}
+	return regionFilter{local: strings.Join(fields, "")}
+}
+
+// match reports whether the local government of a result (지자체기관명)
+// matches the part of the filter the API does not filter by
+func (f regionFilter) match(localGov string) bool {
+	if f.local == "" {
+		return true
+	}
+	return strings.Contains(strings.Join(strings.Fields(localGov), ""), f.local)
+}
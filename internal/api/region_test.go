@@ -0,0 +1,85 @@
+package api
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/testutil"
+)
+
+func TestLookupRegion(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"서울", "6110000"},
+		{"서울시", "6110000"},
+		{"서울특별시", "6110000"},
+		{" 서울 특별시 ", "6110000"},
+		{"강원도", "6530000"},
+		{"전라북도", "6540000"},
+		{"제주", "6500000"},
+		{"광주시", ""},
+		{"강남구", ""},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		region, ok := LookupRegion(tt.name)
+		if ok != (tt.want != "") || region.Code != tt.want {
+			t.Errorf("LookupRegion(%q) = %+v, %v; want code %q", tt.name, region, ok, tt.want)
+		}
+	}
+}
+
+func TestELISClient_SearchRegion(t *testing.T) {
+	server := testutil.NewAPIServer(t)
+	server.HandleFunc(testutil.TargetOrdinance, func(r testutil.Request) testutil.Reply {
+		return testutil.Results(40,
+			testutil.Item{"자치법규ID": "E1", "자치법규명": "주차장 조례", "지자체기관명": "서울특별시 강남구"},
+			testutil.Item{"자치법규ID": "E2", "자치법규명": "주차장 조례", "지자체기관명": "서울특별시 서초구"},
+			testutil.Item{"자치법규ID": "E3", "자치법규명": "강남구 주차 조례", "지자체기관명": "서울특별시"},
+		)
+	})
+	client := NewELISClient("test-key")
+	client.baseURL = server.URL
+	client.retryBaseDelay = time.Millisecond
+
+	tests := []struct {
+		region    string
+		wantOrg   string
+		wantIDs   []string
+		wantTotal int
+	}{
+		// A metropolitan region is left to the API, its total kept
+		{"서울", "6110000", []string{"E1", "E2", "E3"}, 40},
+		{"서울특별시", "6110000", []string{"E1", "E2", "E3"}, 40},
+		// A 시군구 matches the local government, not the names of ordinances
+		{"서울 강남구", "6110000", []string{"E1"}, 1},
+		{"서초구", "", []string{"E2"}, 1},
+		{"", "", []string{"E1", "E2", "E3"}, 40},
+	}
+	for _, tt := range tests {
+		t.Run(tt.region, func(t *testing.T) {
+			resp, err := client.Search(context.Background(), &UnifiedSearchRequest{Query: "주차", Region: tt.region, PageNo: 1, PageSize: 10, Sort: SortRelevance})
+			if err != nil {
+				t.Fatalf("Search() error = %v", err)
+			}
+			requests := server.Requests()
+			last := requests[len(requests)-1]
+			if last.Query != "주차" {
+				t.Errorf("query = %q, the region should not be mixed in", last.Query)
+			}
+			if got := last.Params.Get("org"); got != tt.wantOrg {
+				t.Errorf("org = %q, want %q", got, tt.wantOrg)
+			}
+			if resp.PageSize != 10 {
+				t.Errorf("PageSize = %d, want the size of the request", resp.PageSize)
+			}
+			if got := sortedIDs(resp.Laws); !reflect.DeepEqual(got, tt.wantIDs) || resp.TotalCount != tt.wantTotal {
+				t.Errorf("results = %v (total %d), want %v (total %d)", got, resp.TotalCount, tt.wantIDs, tt.wantTotal)
+			}
+		})
+	}
+}
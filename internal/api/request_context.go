@@ -0,0 +1,70 @@
+package api
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+)
+
+// RequestContext describes the search a command is running. It is built once
+// when the command starts and carried in the context, so the steps after the
+// API call (post-processing, output, logging, history) read the same request
+// instead of being handed the query, page and size one by one.
+type RequestContext struct {
+	Query  string
+	Source string // search target given by the user, e.g. "nlic", "all"; empty when fixed by the command
+	Page   int
+	Size   int
+	// Format is the output format of the results, e.g. "table" or "json"
+	Format string
+	// StartedAt is when the command started the search
+	StartedAt time.Time
+	// CorrelationID identifies the run in log output
+	CorrelationID string
+	// Empty is set by the command once the API answered without results
+	Empty bool
+}
+
+// requestContextKey is the context key of the RequestContext
+type requestContextKey struct{}
+
+// NewRequestContext creates the RequestContext of a search starting now,
+// with its results written in format
+func NewRequestContext(query, source, format string, page, size int) *RequestContext {
+	return &RequestContext{
+		Query:         query,
+		Source:        source,
+		Format:        format,
+		Page:          page,
+		Size:          size,
+		StartedAt:     time.Now(),
+		CorrelationID: newCorrelationID(),
+	}
+}
+
+// Elapsed returns the time since the search started
+func (rc *RequestContext) Elapsed() time.Duration {
+	return time.Since(rc.StartedAt)
+}
+
+// WithRequestContext returns a copy of ctx carrying rc
+func WithRequestContext(ctx context.Context, rc *RequestContext) context.Context {
+	return context.WithValue(ctx, requestContextKey{}, rc)
+}
+
+// RequestContextFrom returns the RequestContext carried by ctx, if any
+func RequestContextFrom(ctx context.Context) (*RequestContext, bool) {
+	rc, ok := ctx.Value(requestContextKey{}).(*RequestContext)
+	return rc, ok && rc != nil
+}
+
+// newCorrelationID returns a short random hex ID
+func newCorrelationID() string {
+	b := make([]byte, 4)
+	if _, err := rand.Read(b); err != nil {
+		// Only used to tell runs apart in logs, so the time is good enough
+		return time.Now().Format("150405.000")
+	}
+	return hex.EncodeToString(b)
+}
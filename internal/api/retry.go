@@ -0,0 +1,164 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultRetryJitter spreads each retry delay by up to ±50%, so that
+	// requests failing together do not all retry at the same moment
+	DefaultRetryJitter = 0.5
+
+	// MaxRetryAfter caps the wait asked for by a Retry-After header
+	MaxRetryAfter = time.Minute
+)
+
+// Backoff computes the delays between retries: exponential from Base, with
+// random jitter
+type Backoff struct {
+	// Base is the delay before the first retry, doubled for each further retry
+	Base time.Duration
+	// Jitter is the maximum relative change of a delay, between 0 and 1
+	Jitter float64
+	// Rand returns a random number in [0, 1). Tests inject a fixed source;
+	// nil uses math/rand.
+	Rand func() float64
+}
+
+// newBackoff returns the backoff of the clients for a base delay
+func newBackoff(base time.Duration) Backoff {
+	return Backoff{Base: base, Jitter: DefaultRetryJitter}
+}
+
+// Delay returns the delay before the given retry, 1 for the first one
+func (b Backoff) Delay(retry int) time.Duration {
+	if retry < 1 {
+		retry = 1
+	}
+	delay := b.Base << uint(retry-1)
+	if b.Jitter <= 0 {
+		return delay
+	}
+
+	random := b.Rand
+	if random == nil {
+		random = rand.Float64
+	}
+	// Scale by a factor in [1-Jitter, 1+Jitter)
+	factor := 1 + b.Jitter*(2*random()-1)
+	return time.Duration(float64(delay) * factor)
+}
+
+// RetryDelay returns the delay before the given retry after err: the wait of a
+// Retry-After header when the server sent one, the backoff delay otherwise
+func (b Backoff) RetryDelay(retry int, err error) time.Duration {
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) && statusErr.RetryAfter > 0 {
+		return statusErr.RetryAfter
+	}
+	return b.Delay(retry)
+}
+
+// ParseRetryAfter parses the value of a Retry-After header, given either in
+// seconds or as an HTTP date, into the wait from now. A date in the past is a
+// wait of 0; waits longer than MaxRetryAfter are capped.
+func ParseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+
+	var wait time.Duration
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		wait = time.Duration(seconds) * time.Second
+	} else {
+		at, err := http.ParseTime(value)
+		if err != nil {
+			return 0, false
+		}
+		wait = at.Sub(now)
+		if wait < 0 {
+			wait = 0
+		}
+	}
+
+	if wait > MaxRetryAfter {
+		wait = MaxRetryAfter
+	}
+	return wait, true
+}
+
+// withRetryAfter records the Retry-After header of a 429 or 503 response on
+// the StatusError in err
+func withRetryAfter(err error, resp *http.Response) error {
+	if resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode != http.StatusServiceUnavailable {
+		return err
+	}
+	var statusErr *StatusError
+	if !errors.As(err, &statusErr) {
+		return err
+	}
+	if wait, ok := ParseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+		statusErr.RetryAfter = wait
+	}
+	return err
+}
+
+// sleepContext waits for d or until ctx is done
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return contextError(ctx)
+	}
+}
+
+// contextError returns the error of a request whose ctx was cancelled or
+// timed out; it matches context.Canceled or context.DeadlineExceeded
+func contextError(ctx context.Context) error {
+	return fmt.Errorf("요청이 취소되었거나 시간 초과되었습니다: %w", ctx.Err())
+}
+
+// requestError returns the error of a request that could not be sent. It is
+// not retried when ctx was cancelled or timed out; other transport problems,
+// such as a refused connection, are a RetryableError. The URL of the error
+// has its API key masked, since the error reaches logs and stderr.
+func requestError(ctx context.Context, err error) error {
+	if ctx.Err() != nil {
+		return contextError(ctx)
+	}
+	// Offline mode refuses requests; retrying them would only wait
+	if errors.Is(err, ErrOffline) {
+		return ErrOffline
+	}
+	return &RetryableError{Err: fmt.Errorf("네트워크 에러: %w", redactError(err))}
+}
+
+// IsNetworkError reports whether err was caused by the request not reaching
+// the server (connection, DNS or TLS failure) rather than by its answer
+func IsNetworkError(err error) bool {
+	var urlErr *url.Error
+	return errors.As(err, &urlErr)
+}
+
+// isRetryable reports whether a failed request is worth retrying. The clients
+// mark such failures as a RetryableError: transport problems, 408, 429 and
+// server errors.
+func isRetryable(err error) bool {
+	var retryableErr *RetryableError
+	return errors.As(err, &retryableErr)
+}
@@ -0,0 +1,252 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fixedRand returns the given numbers in turn, as a Backoff.Rand source
+func fixedRand(values ...float64) func() float64 {
+	i := 0
+	return func() float64 {
+		v := values[i%len(values)]
+		i++
+		return v
+	}
+}
+
+func TestBackoffDelay(t *testing.T) {
+	tests := []struct {
+		name    string
+		backoff Backoff
+		want    []time.Duration
+	}{
+		{
+			name:    "no jitter doubles the delay",
+			backoff: Backoff{Base: 100 * time.Millisecond},
+			want:    []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond},
+		},
+		{
+			name:    "middle of the range keeps the delay",
+			backoff: Backoff{Base: 100 * time.Millisecond, Jitter: 0.5, Rand: fixedRand(0.5)},
+			want:    []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond},
+		},
+		{
+			name:    "jitter spreads delays by up to 50%",
+			backoff: Backoff{Base: 100 * time.Millisecond, Jitter: 0.5, Rand: fixedRand(0, 0.75, 0.25)},
+			want:    []time.Duration{50 * time.Millisecond, 250 * time.Millisecond, 300 * time.Millisecond},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i, want := range tt.want {
+				if got := tt.backoff.Delay(i + 1); got != want {
+					t.Errorf("Delay(%d) = %v, want %v", i+1, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestBackoffDelayRange(t *testing.T) {
+	// With the default source every delay stays within ±50% of the exponential delay
+	b := newBackoff(time.Second)
+	for retry := 1; retry <= 3; retry++ {
+		base := time.Second << uint(retry-1)
+		for i := 0; i < 100; i++ {
+			d := b.Delay(retry)
+			if d < base/2 || d >= base*3/2 {
+				t.Fatalf("Delay(%d) = %v, want within [%v, %v)", retry, d, base/2, base*3/2)
+			}
+		}
+	}
+}
+
+func TestBackoffRetryDelay(t *testing.T) {
+	b := Backoff{Base: time.Second}
+
+	limited := &RetryableError{Err: &StatusError{Kind: ErrRateLimited, StatusCode: 429, RetryAfter: 7 * time.Second}}
+	if got := b.RetryDelay(1, limited); got != 7*time.Second {
+		t.Errorf("RetryDelay() = %v, want the Retry-After wait", got)
+	}
+
+	unavailable := &StatusError{Kind: ErrServiceUnavailable, StatusCode: 503}
+	if got := b.RetryDelay(2, unavailable); got != 2*time.Second {
+		t.Errorf("RetryDelay() without Retry-After = %v, want the backoff delay", got)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		value  string
+		want   time.Duration
+		wantOK bool
+	}{
+		{"45", 45 * time.Second, true},
+		{" 3 ", 3 * time.Second, true},
+		{"0", 0, true},
+		{"Thu, 02 Jan 2025 03:04:35 GMT", 30 * time.Second, true},
+		{"Thursday, 02-Jan-25 03:04:15 GMT", 10 * time.Second, true},
+		{"Thu Jan  2 03:04:10 2025", 5 * time.Second, true},
+		{"Thu, 02 Jan 2025 03:00:00 GMT", 0, true}, // already passed
+		{"86400", MaxRetryAfter, true},
+		{"", 0, false},
+		{"-5", 0, false},
+		{"soon", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, ok := ParseRetryAfter(tt.value, now)
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("ParseRetryAfter(%q) = %v, %v; want %v, %v", tt.value, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestWithRetryAfter(t *testing.T) {
+	header := http.Header{}
+	header.Set("Retry-After", "4")
+
+	err := withRetryAfter(httpStatusError(http.StatusTooManyRequests), &http.Response{StatusCode: http.StatusTooManyRequests, Header: header})
+	if got := (Backoff{Base: time.Hour}).RetryDelay(1, err); got != 4*time.Second {
+		t.Errorf("429 with Retry-After should wait 4s, got %v", got)
+	}
+
+	// Only 429 and 503 carry a meaningful Retry-After
+	err = withRetryAfter(httpStatusError(http.StatusInternalServerError), &http.Response{StatusCode: http.StatusInternalServerError, Header: header})
+	if got := (Backoff{Base: time.Second}).RetryDelay(1, err); got != time.Second {
+		t.Errorf("500 should use the backoff delay, got %v", got)
+	}
+}
+
+func TestClient_RetryAfter(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"totalCnt": 0, "page": 1, "law": []}`))
+	}))
+	defer server.Close()
+
+	client := NewNLICClient("test-api-key")
+	client.baseURL = server.URL
+	// The backoff alone would outlast the context; only Retry-After lets the retry happen
+	client.retryBaseDelay = time.Hour
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	start := time.Now()
+	if _, err := client.Search(ctx, &UnifiedSearchRequest{Query: "test", Type: "JSON"}); err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if attempts != 2 {
+		t.Errorf("expected 2 attempts, got %d", attempts)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("retry came after %v, want at least the Retry-After wait", elapsed)
+	}
+}
+
+func TestIsNetworkError(t *testing.T) {
+	// Nothing listens on the port of a closed server
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+	_, dialErr := http.Get(server.URL)
+
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"connection refused", dialErr, true},
+		{"wrapped", requestError(context.Background(), dialErr), true},
+		{"status", httpStatusError(http.StatusServiceUnavailable), false},
+		{"other", errors.New("응답 파싱 실패"), false},
+		{"nil", nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsNetworkError(tt.err); got != tt.want {
+				t.Errorf("IsNetworkError(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTransportErrorMasksAPIKey(t *testing.T) {
+	// Nothing listens on the port of a closed server
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+	const key = "SECRETKEY123456"
+
+	clients := []struct {
+		name   string
+		search func() error
+	}{
+		{"Client", func() error {
+			c := &Client{httpClient: newHTTPClient(), baseURL: server.URL, apiKey: key, retryBaseDelay: time.Millisecond}
+			_, err := c.Search(context.Background(), &SearchRequest{Query: "민법", PageNo: 1, PageSize: 10})
+			return err
+		}},
+		{"NLIC", func() error {
+			c := NewNLICClientWithURL(key, server.URL)
+			c.retryBaseDelay = time.Millisecond
+			_, err := c.Search(context.Background(), &UnifiedSearchRequest{Query: "민법", PageNo: 1, PageSize: 10})
+			return err
+		}},
+		{"ELIS", func() error {
+			c := NewELISClient(key)
+			c.baseURL, c.retryBaseDelay = server.URL, time.Millisecond
+			_, err := c.Search(context.Background(), &UnifiedSearchRequest{Query: "주차", PageNo: 1, PageSize: 10})
+			return err
+		}},
+		{"Prec", func() error {
+			c := NewPrecClient(key)
+			c.baseURL, c.retryBaseDelay = server.URL, time.Millisecond
+			_, err := c.Search(context.Background(), &UnifiedSearchRequest{Query: "손해배상", PageNo: 1, PageSize: 10})
+			return err
+		}},
+		{"Expc", func() error {
+			c := NewExpcClient(key)
+			c.baseURL, c.retryBaseDelay = server.URL, time.Millisecond
+			_, err := c.Search(context.Background(), &UnifiedSearchRequest{Query: "건축", PageNo: 1, PageSize: 10})
+			return err
+		}},
+		{"Admrul", func() error {
+			c := NewAdmrulClient(key)
+			c.baseURL, c.retryBaseDelay = server.URL, time.Millisecond
+			_, err := c.Search(context.Background(), &UnifiedSearchRequest{Query: "훈령", PageNo: 1, PageSize: 10})
+			return err
+		}},
+	}
+	for _, tt := range clients {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.search()
+			if err == nil {
+				t.Fatal("Search() error = nil, want a transport error")
+			}
+			if strings.Contains(err.Error(), key) {
+				t.Errorf("error shows the API key: %v", err)
+			}
+			if !strings.Contains(err.Error(), "OC=SE***") || !IsNetworkError(err) {
+				t.Errorf("error = %v, want the network error with the masked key", err)
+			}
+		})
+	}
+}
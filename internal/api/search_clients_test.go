@@ -0,0 +1,278 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/testutil"
+)
+
+// searchClientCase is a search client of one source, with a result in the
+// field names of its API
+type searchClientCase struct {
+	name   string
+	target string
+	item   testutil.Item
+	wantID string
+	// wantExtra is the ExtraFields of the result, for the sources keeping them
+	wantExtra map[string]string
+	// newClient returns the client searching server, retrying without delay
+	newClient func(server *testutil.APIServer) ClientInterface
+}
+
+var searchClientCases = []searchClientCase{
+	{
+		name:   "nlic",
+		target: testutil.TargetLaw,
+		item:   testutil.Item{"법령ID": "N1", "법령명한글": "개인정보 보호법"},
+		wantID: "N1",
+		newClient: func(server *testutil.APIServer) ClientInterface {
+			c := NewNLICClientWithURL("test-key", server.URL)
+			c.retryBaseDelay = time.Millisecond
+			return c
+		},
+	},
+	{
+		name:   "elis",
+		target: testutil.TargetOrdinance,
+		item:   testutil.Item{"자치법규ID": "E1", "자치법규명": "서울특별시 개인정보 보호 조례"},
+		wantID: "E1",
+		newClient: func(server *testutil.APIServer) ClientInterface {
+			c := NewELISClient("test-key")
+			c.baseURL = server.URL
+			c.retryBaseDelay = time.Millisecond
+			return c
+		},
+	},
+	{
+		name:   "prec",
+		target: testutil.TargetPrecedent,
+		item: testutil.Item{"판례일련번호": "P1", "사건명": "개인정보 유출 손해배상",
+			"법원명": "대법원", "사건번호": "2023다12345", "선고일자": "20240118"},
+		wantID:    "P1",
+		wantExtra: map[string]string{ExtraCourtName: "대법원", ExtraCaseNumber: "2023다12345", ExtraJudgeDate: "20240118"},
+		newClient: func(server *testutil.APIServer) ClientInterface {
+			c := NewPrecClient("test-key")
+			c.baseURL = server.URL
+			c.retryBaseDelay = time.Millisecond
+			return c
+		},
+	},
+	{
+		name:   "admrul",
+		target: testutil.TargetAdmRule,
+		item:   testutil.Item{"행정규칙일련번호": "A1", "행정규칙명": "개인정보의 안전성 확보조치 기준"},
+		wantID: "A1",
+		newClient: func(server *testutil.APIServer) ClientInterface {
+			c := NewAdmrulClient("test-key")
+			c.baseURL = server.URL
+			c.retryBaseDelay = time.Millisecond
+			return c
+		},
+	},
+	{
+		name:   "expc",
+		target: testutil.TargetInterpretation,
+		item: testutil.Item{"법령해석례일련번호": "X1", "안건명": "개인정보 제3자 제공 관련",
+			"질의기관명": "민원인", "회신기관명": "법제처", "회신일자": "20210615"},
+		wantID:    "X1",
+		wantExtra: map[string]string{ExtraQueryAgency: "민원인", ExtraReplyAgency: "법제처", ExtraReplyDate: "20210615"},
+		newClient: func(server *testutil.APIServer) ClientInterface {
+			c := NewExpcClient("test-key")
+			c.baseURL = server.URL
+			c.retryBaseDelay = time.Millisecond
+			return c
+		},
+	},
+}
+
+// searchClientRequest is the request every client case sends
+func searchClientRequest() *UnifiedSearchRequest {
+	return &UnifiedSearchRequest{Query: "개인정보", PageNo: 2, PageSize: 10, Type: "XML"}
+}
+
+func TestSearchClients(t *testing.T) {
+	for _, tc := range searchClientCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := testutil.NewAPIServer(t)
+			server.Check(func(r testutil.Request) error {
+				if r.Target != tc.target || r.Query != "개인정보" || r.Page != 2 {
+					return fmt.Errorf("target, query, page = %q, %q, %d; want %q, 개인정보, 2", r.Target, r.Query, r.Page, tc.target)
+				}
+				return nil
+			})
+			client := tc.newClient(server)
+
+			server.Handle(tc.target, testutil.Results(11, tc.item))
+			resp, err := client.Search(context.Background(), searchClientRequest())
+			if err != nil {
+				t.Fatalf("Search() error = %v", err)
+			}
+			if resp.TotalCount != 11 || len(resp.Laws) != 1 || resp.Laws[0].ID != tc.wantID || resp.Laws[0].Name == "" {
+				t.Fatalf("Search() = %+v, want 11 results with %s on the page", resp, tc.wantID)
+			}
+			for key, want := range tc.wantExtra {
+				if got := resp.Laws[0].ExtraFields[key]; got != want {
+					t.Errorf("ExtraFields[%s] = %q, want %q", key, got, want)
+				}
+			}
+
+			server.Handle(tc.target, testutil.Empty())
+			resp, err = client.Search(context.Background(), searchClientRequest())
+			if err != nil || resp.TotalCount != 0 || len(resp.Laws) != 0 {
+				t.Errorf("empty Search() = %+v, %v; want no results", resp, err)
+			}
+
+			// A query-specific reply replaces the reply of the target
+			server.HandleQuery(tc.target, "개인정보", testutil.HTMLError("인증키가 유효하지 않습니다"))
+			_, err = client.Search(context.Background(), searchClientRequest())
+			var keyErr *APIKeyError
+			if !errors.As(err, &keyErr) {
+				t.Errorf("HTML error page: Search() error = %v, want an APIKeyError", err)
+			}
+
+			server.HandleQuery(tc.target, "개인정보", testutil.RateLimited(0))
+			if _, err = client.Search(context.Background(), searchClientRequest()); !errors.Is(err, ErrRateLimited) {
+				t.Errorf("429: Search() error = %v, want ErrRateLimited", err)
+			}
+
+			if got := len(server.Requests()); got < 4 {
+				t.Errorf("server received %d requests, want at least 4", got)
+			}
+		})
+	}
+}
+
+func TestSearchClientsContextCancel(t *testing.T) {
+	for _, tc := range searchClientCases {
+		t.Run(tc.name+"/cancelled before the request", func(t *testing.T) {
+			server := testutil.NewAPIServer(t)
+			server.Handle(tc.target, testutil.Results(1, tc.item))
+			client := tc.newClient(server)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			_, err := client.Search(ctx, searchClientRequest())
+			if !errors.Is(err, context.Canceled) {
+				t.Errorf("Search() error = %v, want context.Canceled", err)
+			}
+			if got := len(server.Requests()); got != 0 {
+				t.Errorf("server received %d requests, want none", got)
+			}
+		})
+
+		t.Run(tc.name+"/cancelled during retries", func(t *testing.T) {
+			server := testutil.NewAPIServer(t)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			// The first reply is a retryable error, and the search is cancelled meanwhile
+			server.HandleFunc(tc.target, func(testutil.Request) testutil.Reply {
+				cancel()
+				return testutil.Reply{Status: http.StatusServiceUnavailable}
+			})
+			client := tc.newClient(server)
+
+			_, err := client.Search(ctx, searchClientRequest())
+			if !errors.Is(err, context.Canceled) {
+				t.Errorf("Search() error = %v, want context.Canceled", err)
+			}
+			if got := len(server.Requests()); got != 1 {
+				t.Errorf("server received %d requests, want 1 without retries", got)
+			}
+		})
+
+		t.Run(tc.name+"/deadline", func(t *testing.T) {
+			server := testutil.NewAPIServer(t)
+			server.Handle(tc.target, testutil.Results(1, tc.item))
+			client := tc.newClient(server)
+
+			ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+			defer cancel()
+			_, err := client.Search(ctx, searchClientRequest())
+			if !errors.Is(err, context.DeadlineExceeded) {
+				t.Errorf("Search() error = %v, want context.DeadlineExceeded", err)
+			}
+			if got := len(server.Requests()); got != 0 {
+				t.Errorf("server received %d requests, want none", got)
+			}
+		})
+	}
+}
+
+func TestSearchClientsSort(t *testing.T) {
+	// wantSort is the sort parameter of each source by sort key; a key
+	// missing from it is not sent
+	wantSort := map[string]map[string]string{
+		"nlic":   {SortName: "lasc", SortEffectDate: "efdes", "": ""},
+		"elis":   {SortName: "lasc", SortEffectDate: "efdes", "": "ddes"},
+		"admrul": {SortName: "lasc", SortEffectDate: "efdes", "": ""},
+		"prec":   {SortName: "lasc", "": ""},
+		"expc":   {SortName: "lasc", "": ""},
+	}
+
+	for _, tc := range searchClientCases {
+		t.Run(tc.name, func(t *testing.T) {
+			first, second := testutil.Item{}, testutil.Item{}
+			for key, value := range tc.item {
+				first[key], second[key] = value, value
+			}
+			for _, key := range []string{"법령명한글", "자치법규명", "사건명", "행정규칙명", "안건명"} {
+				if _, ok := tc.item[key]; ok {
+					first[key], second[key] = "하 규정", "가 규정"
+				}
+			}
+			server := testutil.NewAPIServer(t)
+			server.Handle(tc.target, testutil.Results(40, first, second))
+			client := tc.newClient(server)
+
+			for _, key := range []string{SortName, SortEffectDate, ""} {
+				// Every page is asked for in the same order
+				for page := 1; page <= 2; page++ {
+					req := searchClientRequest()
+					req.PageNo, req.Sort = page, key
+					resp, err := client.Search(context.Background(), req)
+					if err != nil {
+						t.Fatalf("Search(%q, page %d) error = %v", key, page, err)
+					}
+					requests := server.Requests()
+					last := requests[len(requests)-1]
+					if got := last.Params.Get("sort"); got != wantSort[tc.name][key] {
+						t.Errorf("Search(%q, page %d) sort = %q, want %q", key, page, got, wantSort[tc.name][key])
+					}
+					if key == SortName && (len(resp.Laws) != 2 || resp.Laws[0].Name != "가 규정") {
+						t.Errorf("Search(%q, page %d) = %+v, want the page sorted by name", key, page, resp.Laws)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"transport error", requestError(context.Background(), errors.New("connection refused")), true},
+		{"cancelled", requestError(cancelled, errors.New("context canceled")), false},
+		{"service unavailable", httpStatusError(http.StatusServiceUnavailable), true},
+		{"wrapped", fmt.Errorf("검색 실패: %w", httpStatusError(http.StatusBadGateway)), true},
+		{"not found", httpStatusError(http.StatusNotFound), false},
+		{"plain error", errors.New("timeout"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isRetryable(tt.err); got != tt.want {
+				t.Errorf("isRetryable(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
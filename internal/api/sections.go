@@ -0,0 +1,58 @@
+package api
+
+import (
+	"encoding/xml"
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+)
+
+// lineBreakTag matches the <br> tags the detail APIs write inside their text
+var lineBreakTag = regexp.MustCompile(`(?i)<br\s*/?>`)
+
+// sectionText returns the text of a section of a detail response as plain
+// text: line breaks for <br> tags, no other tags, entities unescaped and
+// blank lines dropped
+func sectionText(s string) string {
+	s = lineBreakTag.ReplaceAllString(s, "\n")
+	s = html.UnescapeString(stripHTMLTags(s))
+	var lines []string
+	for _, line := range strings.Split(strings.ReplaceAll(s, "\r\n", "\n"), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// appendSection adds a section with the text of content, unless it is empty
+func appendSection(sections []DetailSection, title, content string) []DetailSection {
+	if text := sectionText(content); text != "" {
+		sections = append(sections, DetailSection{Title: title, Content: text})
+	}
+	return sections
+}
+
+// decodeDetailXML parses the XML detail response body into v, whose XMLName
+// must be root. The APIs answer an unknown ID with a <Law> element holding a
+// message instead, which is returned as ErrNotFound with notFound.
+func decodeDetailXML(body []byte, root string, v interface{}, notFound string) error {
+	var probe struct {
+		XMLName xml.Name
+		Message string `xml:",chardata"`
+	}
+	if err := xml.Unmarshal(body, &probe); err != nil {
+		return fmt.Errorf("XML 파싱 실패: %w", err)
+	}
+	if probe.XMLName.Local != root {
+		if message := strings.TrimSpace(probe.Message); message != "" {
+			notFound += ": " + message
+		}
+		return newStatusError(ErrNotFound, 0, "%s", notFound)
+	}
+	if err := xml.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("XML 파싱 실패: %w", err)
+	}
+	return nil
+}
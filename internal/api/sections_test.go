@@ -0,0 +1,137 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// detailServer serves the fixture testdata/name to every request, recording
+// the query of the last one
+func detailServer(t *testing.T, name string, query *string) *httptest.Server {
+	t.Helper()
+	body, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*query = r.URL.RawQuery
+		w.Header().Set("Content-Type", "application/xml;charset=UTF-8")
+		w.Write(body)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestExpcClient_GetDetail(t *testing.T) {
+	var query string
+	server := detailServer(t, "expc_detail.xml", &query)
+	client := NewExpcClient("test-key")
+	client.detailURL = server.URL
+	client.retryBaseDelay = time.Millisecond
+
+	detail, err := client.GetDetail(context.Background(), "313107")
+	if err != nil {
+		t.Fatalf("GetDetail() error = %v", err)
+	}
+	if want := "ID=313107&OC=test-key&target=expc&type=XML"; query != want {
+		t.Errorf("query = %q, want %q", query, want)
+	}
+
+	wantInfo := LawInfo{
+		ID:         "313107",
+		Name:       "민원인 - 「개인정보 보호법」 제17조제1항에 따른 개인정보의 제3자 제공의 의미",
+		LawType:    "법령해석례",
+		Department: "민원인",
+		PromulDate: "20210615",
+		PromulNo:   "21-0123",
+	}
+	if !reflect.DeepEqual(detail.LawInfo, wantInfo) {
+		t.Errorf("LawInfo = %+v, want %+v", detail.LawInfo, wantInfo)
+	}
+	wantSections := []DetailSection{
+		{"질의요지", "「개인정보 보호법」 제17조제1항에 따른 \"제3자 제공\"에\n수탁자에게 개인정보를 이전하는 경우가 포함되는지?"},
+		{"회답", "이 사안의 경우 수탁자에게 개인정보를 이전하는 것은 제3자 제공에 해당하지 않습니다."},
+		{"이유", "「개인정보 보호법」 제26조에서는 \"업무위탁\"에 따른 개인정보의 처리 제한을 별도로 규정하고 있는데,\n같은 법 제17조제1항의 제3자 제공과는 구별됩니다.\n따라서 이 사안의 경우 수탁자에게의 이전은 제3자 제공에 해당하지 않습니다."},
+	}
+	if !reflect.DeepEqual(detail.Sections, wantSections) {
+		t.Errorf("Sections = %+v, want %+v", detail.Sections, wantSections)
+	}
+}
+
+func TestPrecClient_GetDetail(t *testing.T) {
+	var query string
+	server := detailServer(t, "prec_detail.xml", &query)
+	client := NewPrecClient("test-key")
+	client.detailURL = server.URL
+	client.retryBaseDelay = time.Millisecond
+
+	detail, err := client.GetDetail(context.Background(), "228541")
+	if err != nil {
+		t.Fatalf("GetDetail() error = %v", err)
+	}
+	if want := "ID=228541&OC=test-key&target=prec&type=XML"; query != want {
+		t.Errorf("query = %q, want %q", query, want)
+	}
+
+	wantInfo := LawInfo{
+		ID:         "228541",
+		Name:       "손해배상(기)",
+		LawType:    "판례",
+		Department: "대법원",
+		PromulDate: "20200213",
+		PromulNo:   "2018다12345",
+		Category:   "민사 판결",
+	}
+	if !reflect.DeepEqual(detail.LawInfo, wantInfo) {
+		t.Errorf("LawInfo = %+v, want %+v", detail.LawInfo, wantInfo)
+	}
+	var titles []string
+	for _, section := range detail.Sections {
+		titles = append(titles, section.Title)
+	}
+	if want := []string{"판시사항", "판결요지", "참조조문", "참조판례", "전문"}; !reflect.DeepEqual(titles, want) {
+		t.Errorf("section titles = %v, want %v", titles, want)
+	}
+	if got, want := detail.Sections[0].Content, "[1] 개인정보 유출로 인한 정신적 손해배상책임의 인정 기준\n[2] 위자료 산정 시 고려할 사정"; got != want {
+		t.Errorf("판시사항 = %q, want %q", got, want)
+	}
+	if got, want := detail.Sections[4].Content, "【원고, 상고인】 원고 1 외 2인\n【피고, 피상고인】 주식회사 ○○\n【주    문】\n상고를 모두 기각한다.\n【이    유】\n상고이유를 판단한다."; got != want {
+		t.Errorf("전문 = %q, want %q", got, want)
+	}
+}
+
+func TestPrecClient_GetDetailNotFound(t *testing.T) {
+	var query string
+	server := detailServer(t, "prec_detail_not_found.xml", &query)
+	client := NewPrecClient("test-key")
+	client.detailURL = server.URL
+	client.retryBaseDelay = time.Millisecond
+
+	_, err := client.GetDetail(context.Background(), "0")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetDetail() error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestSectionText(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"첫 줄<br/>둘째 줄<BR>셋째 줄<br />", "첫 줄\n둘째 줄\n셋째 줄"},
+		{"  <b>강조</b> &amp; &lt;표&gt;  ", "강조 & <표>"},
+		{"<br/><br/>", ""},
+		{"줄\r\n\r\n다음 줄", "줄\n다음 줄"},
+	}
+	for _, tt := range tests {
+		if got := sectionText(tt.in); got != tt.want {
+			t.Errorf("sectionText(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
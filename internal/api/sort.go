@@ -0,0 +1,196 @@
+package api
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Sort keys of search results (UnifiedSearchRequest.Sort)
+const (
+	// SortRelevance keeps the order returned by the API
+	SortRelevance = "relevance"
+	// SortName orders by law name in Korean (가나다) order
+	SortName = "name"
+	// SortEffectDate orders by 시행일자
+	SortEffectDate = "effectDate"
+	// SortPromulDate orders by 공포일자
+	SortPromulDate = "promulDate"
+	// SortDate is the former name of SortPromulDate
+	SortDate = "date"
+)
+
+// Sort orders (UnifiedSearchRequest.Order)
+const (
+	OrderAsc  = "asc"
+	OrderDesc = "desc"
+)
+
+// sortKeys lists the accepted sort keys in the order they are documented
+var sortKeys = []string{SortRelevance, SortName, SortEffectDate, SortPromulDate}
+
+// NormalizeSort returns the canonical sort key for a --sort value, matching
+// keys case-insensitively. "date" means SortPromulDate; empty stays empty
+// (no sort requested).
+func NormalizeSort(key string) (string, error) {
+	if key == "" {
+		return "", nil
+	}
+	if strings.EqualFold(key, SortDate) {
+		return SortPromulDate, nil
+	}
+	for _, k := range sortKeys {
+		if strings.EqualFold(key, k) {
+			return k, nil
+		}
+	}
+	return "", fmt.Errorf("잘못된 정렬 기준: %s (%s 중 선택)", key, strings.Join(sortKeys, ", "))
+}
+
+// NormalizeOrder returns the sort order for a --order value. Empty means the
+// natural order of the key: ascending for names, newest first for dates.
+func NormalizeOrder(key, order string) (string, error) {
+	switch strings.ToLower(order) {
+	case OrderAsc:
+		return OrderAsc, nil
+	case OrderDesc:
+		return OrderDesc, nil
+	case "":
+		if key == SortName {
+			return OrderAsc, nil
+		}
+		return OrderDesc, nil
+	default:
+		return "", fmt.Errorf("잘못된 정렬 방향: %s (asc, desc 중 선택)", order)
+	}
+}
+
+// ValidateSort checks a --sort and --order combination
+func ValidateSort(key, order string) error {
+	key, err := NormalizeSort(key)
+	if err != nil {
+		return err
+	}
+	_, err = NormalizeOrder(key, order)
+	return err
+}
+
+// SortLaws orders laws in place by the given key and order. Dates are compared
+// as parsed by ParseDate, so YYYYMMDD and YYYY.MM.DD dates sort together. The
+// sort is stable, and laws whose key is empty or not a date always go last,
+// whatever the order. Relevance, an empty key and invalid keys keep the order
+// returned by the API.
+func SortLaws(laws []LawInfo, key, order string) {
+	key, err := NormalizeSort(key)
+	if err != nil || key == "" || key == SortRelevance {
+		return
+	}
+	order, err = NormalizeOrder(key, order)
+	if err != nil {
+		return
+	}
+
+	// The keys are parsed once rather than at every comparison
+	keys := make([]lawSortKey, len(laws))
+	for i, law := range laws {
+		keys[i] = sortKeyOf(law, key)
+	}
+	index := make([]int, len(laws))
+	for i := range index {
+		index[i] = i
+	}
+	sort.SliceStable(index, func(i, j int) bool {
+		a, b := keys[index[i]], keys[index[j]]
+		if !a.ok || !b.ok {
+			return a.ok && !b.ok
+		}
+		if order == OrderDesc {
+			return a.compare(b) > 0
+		}
+		return a.compare(b) < 0
+	})
+
+	sorted := make([]LawInfo, len(laws))
+	for i, k := range index {
+		sorted[i] = laws[k]
+	}
+	copy(laws, sorted)
+}
+
+// lawSortKey is the value a law is sorted by for a sort key: its name, or a
+// date parsed by ParseDate
+type lawSortKey struct {
+	name string
+	date time.Time
+	ok   bool // false if the law has no value for the key
+}
+
+// sortKeyOf returns the sort key of law for key (SortName or a date key)
+func sortKeyOf(law LawInfo, key string) lawSortKey {
+	switch key {
+	case SortName:
+		name := strings.TrimSpace(law.Name)
+		return lawSortKey{name: name, ok: name != ""}
+	case SortEffectDate:
+		date, ok := ParseDate(law.EffectDate)
+		return lawSortKey{date: date, ok: ok}
+	default:
+		date, ok := ParseDate(law.PromulDate)
+		return lawSortKey{date: date, ok: ok}
+	}
+}
+
+// compare compares two keys of the same sort key
+func (k lawSortKey) compare(other lawSortKey) int {
+	if k.name != "" || other.name != "" {
+		return CompareNames(k.name, other.name)
+	}
+	return k.date.Compare(other.date)
+}
+
+// sortParamPrefixes maps the sort keys a search API sorts by to the prefix
+// of its sort parameter, which ends in asc or des
+type sortParamPrefixes map[string]string
+
+var (
+	// lawSortParams are the sort parameters of the law, ordin and admrul
+	// searches: name, promulgation (or issue) date and effective date
+	lawSortParams = sortParamPrefixes{SortName: "l", SortPromulDate: "d", SortEffectDate: "ef"}
+	// caseSortParams are those of the prec and expc searches, which sort by
+	// case name and by the date of judgment or reply but have no effective date
+	caseSortParams = sortParamPrefixes{SortName: "l", SortPromulDate: "d"}
+)
+
+// searchSortParams are the sort parameters of the search APIs by target
+var searchSortParams = map[string]sortParamPrefixes{
+	"law":    lawSortParams,
+	"ordin":  lawSortParams,
+	"admrul": lawSortParams,
+	"prec":   caseSortParams,
+	"expc":   caseSortParams,
+}
+
+// sortParam returns the sort parameter of the law.go.kr search API of target
+// for a sort key and order, or "" to leave the API order (relevance). ok is
+// false when the API cannot sort by the key; the clients then only sort the
+// results of each page, with SortLaws.
+func sortParam(target, key, order string) (param string, ok bool) {
+	key, err := NormalizeSort(key)
+	if err != nil || key == "" || key == SortRelevance {
+		return "", true
+	}
+	order, err = NormalizeOrder(key, order)
+	if err != nil {
+		return "", true
+	}
+
+	prefix, ok := searchSortParams[target][key]
+	if !ok {
+		return "", false
+	}
+	if order == OrderAsc {
+		return prefix + "asc", true
+	}
+	return prefix + "des", true
+}
@@ -0,0 +1,77 @@
+package api
+
+import "fmt"
+
+// Source states reported by the unified search
+const (
+	// SourceOK means the source answered with results
+	SourceOK = "ok"
+	// SourceEmpty means the source answered without results
+	SourceEmpty = "empty"
+	// SourceFailed means the source did not answer (outage, timeout, API error)
+	SourceFailed = "failed"
+)
+
+// minSourceShare is the share of the total results below which a source that
+// answered is reported as skewed
+const minSourceShare = 0.05
+
+// SourceStatus is the outcome of one source of a unified search
+type SourceStatus struct {
+	Source     string `json:"source" xml:"source,attr"` // "NLIC" or "ELIS"
+	Label      string `json:"label" xml:"label,attr"`   // "국가법령" or "자치법규"
+	Status     string `json:"status" xml:"status,attr"`
+	TotalCount int    `json:"total_count" xml:"totalCount,attr"`
+	Error      string `json:"error,omitempty" xml:"error,attr,omitempty"`
+}
+
+// sourceLabel returns the result label of a unified search source
+func sourceLabel(source string) string {
+	if source == "ELIS" {
+		return "자치법규"
+	}
+	return "국가법령"
+}
+
+// newSourceStatus records the outcome of a source search
+func newSourceStatus(source string, resp *SearchResponse, err error) SourceStatus {
+	status := SourceStatus{Source: source, Label: sourceLabel(source)}
+	switch {
+	case err != nil:
+		status.Status = SourceFailed
+		status.Error = err.Error()
+	case resp == nil || resp.TotalCount == 0:
+		status.Status = SourceEmpty
+	default:
+		status.Status = SourceOK
+		status.TotalCount = resp.TotalCount
+	}
+	return status
+}
+
+// SourceWarnings reports unified search results that come (almost) only from one
+// source. A failed source is reported as an outage; a source that answered
+// without results while another has results is reported as having no results;
+// a source whose share of the total is below minSourceShare is reported as skewed.
+// Nothing is reported when no source has results and none failed.
+func SourceWarnings(sources []SourceStatus) []string {
+	total := 0
+	for _, s := range sources {
+		total += s.TotalCount
+	}
+
+	var warnings []string
+	for _, s := range sources {
+		name := fmt.Sprintf("%s(%s)", s.Source, s.Label)
+		switch {
+		case s.Status == SourceFailed:
+			warnings = append(warnings, fmt.Sprintf("%s 검색에 실패해 결과에서 빠졌습니다 (장애): %s", name, s.Error))
+		case total == 0:
+		case s.Status == SourceEmpty:
+			warnings = append(warnings, fmt.Sprintf("%s에서 결과가 없습니다 (무결과)", name))
+		case float64(s.TotalCount) < float64(total)*minSourceShare:
+			warnings = append(warnings, fmt.Sprintf("%s 결과가 전체 %d개 중 %d개뿐입니다", name, total, s.TotalCount))
+		}
+	}
+	return warnings
+}
@@ -0,0 +1,89 @@
+package api
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestNewSourceStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		resp       *SearchResponse
+		err        error
+		wantStatus string
+		wantCount  int
+	}{
+		{"results", &SearchResponse{TotalCount: 12}, nil, SourceOK, 12},
+		{"no results", &SearchResponse{TotalCount: 0}, nil, SourceEmpty, 0},
+		{"nil response", nil, nil, SourceEmpty, 0},
+		{"error", nil, errors.New("timeout"), SourceFailed, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := newSourceStatus("ELIS", tt.resp, tt.err)
+			if got.Status != tt.wantStatus || got.TotalCount != tt.wantCount {
+				t.Errorf("newSourceStatus() = %+v, want status %s and count %d", got, tt.wantStatus, tt.wantCount)
+			}
+			if got.Label != "자치법규" {
+				t.Errorf("Label = %q, want 자치법규", got.Label)
+			}
+			if (tt.err != nil) != (got.Error != "") {
+				t.Errorf("Error = %q, want error %v", got.Error, tt.err)
+			}
+		})
+	}
+}
+
+func TestSourceWarnings(t *testing.T) {
+	nlic := func(count int) SourceStatus {
+		status := SourceStatus{Source: "NLIC", Label: "국가법령", Status: SourceOK, TotalCount: count}
+		if count == 0 {
+			status.Status = SourceEmpty
+		}
+		return status
+	}
+	elis := func(count int) SourceStatus {
+		status := SourceStatus{Source: "ELIS", Label: "자치법규", Status: SourceOK, TotalCount: count}
+		if count == 0 {
+			status.Status = SourceEmpty
+		}
+		return status
+	}
+	elisFailed := SourceStatus{Source: "ELIS", Label: "자치법규", Status: SourceFailed, Error: "context deadline exceeded"}
+
+	tests := []struct {
+		name    string
+		sources []SourceStatus
+		want    []string // substrings, one per expected warning
+	}{
+		{"balanced", []SourceStatus{nlic(40), elis(60)}, nil},
+		{"both empty", []SourceStatus{nlic(0), elis(0)}, nil},
+		{"no results", []SourceStatus{nlic(30), elis(0)}, []string{"ELIS(자치법규)에서 결과가 없습니다 (무결과)"}},
+		{"outage", []SourceStatus{nlic(30), elisFailed}, []string{"ELIS(자치법규) 검색에 실패", "장애", "context deadline exceeded"}},
+		{"outage without other results", []SourceStatus{nlic(0), elisFailed}, []string{"장애"}},
+		{"extremely low share", []SourceStatus{nlic(2), elis(98)}, []string{"NLIC(국가법령) 결과가 전체 100개 중 2개뿐입니다"}},
+		{"low but acceptable share", []SourceStatus{nlic(5), elis(95)}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SourceWarnings(tt.sources)
+			if len(tt.want) == 0 {
+				if len(got) != 0 {
+					t.Errorf("SourceWarnings() = %q, want none", got)
+				}
+				return
+			}
+			if len(got) != 1 {
+				t.Fatalf("SourceWarnings() = %q, want one warning", got)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(got[0], want) {
+					t.Errorf("warning %q does not contain %q", got[0], want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// requestTimeout bounds each API operation (a search, detail or history lookup
+// including its retries) and each HTTP request of the clients
+var requestTimeout = DefaultTimeout
+
+// SetTimeout sets the timeout of API operations; d <= 0 restores DefaultTimeout.
+// Clients created afterwards use it for their HTTP requests.
+func SetTimeout(d time.Duration) {
+	if d <= 0 {
+		d = DefaultTimeout
+	}
+	requestTimeout = d
+}
+
+// Timeout returns the timeout of API operations
+func Timeout() time.Duration {
+	return requestTimeout
+}
+
+// ParseTimeout parses a timeout such as "45s" or "1m30s". A plain number is a
+// number of seconds.
+func ParseTimeout(value string) (time.Duration, error) {
+	value = strings.TrimSpace(value)
+	if seconds, err := strconv.Atoi(value); err == nil {
+		value = fmt.Sprintf("%ds", seconds)
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("잘못된 시간 제한: %q (예: 45s, 1m30s)", value)
+	}
+	return d, nil
+}
+
+// IsTimeout reports whether err was caused by an API operation or HTTP
+// request running out of time
+func IsTimeout(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
@@ -0,0 +1,118 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseTimeout(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{"45s", 45 * time.Second, false},
+		{"1m30s", 90 * time.Second, false},
+		{"500ms", 500 * time.Millisecond, false},
+		{"20", 20 * time.Second, false},
+		{" 10s ", 10 * time.Second, false},
+		{"0", 0, true},
+		{"-5s", 0, true},
+		{"soon", 0, true},
+		{"", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseTimeout(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseTimeout(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseTimeout(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetTimeout(t *testing.T) {
+	defer SetTimeout(0)
+
+	SetTimeout(45 * time.Second)
+	if Timeout() != 45*time.Second {
+		t.Errorf("Timeout() = %v, want 45s", Timeout())
+	}
+	if got := NewNLICClient("test-key").httpClient.Timeout; got != 45*time.Second {
+		t.Errorf("NLIC HTTP client timeout = %v, want 45s", got)
+	}
+	if got := NewELISClient("test-key").httpClient.Timeout; got != 45*time.Second {
+		t.Errorf("ELIS HTTP client timeout = %v, want 45s", got)
+	}
+
+	SetTimeout(0)
+	if Timeout() != DefaultTimeout {
+		t.Errorf("SetTimeout(0) should restore DefaultTimeout, got %v", Timeout())
+	}
+}
+
+func TestIsTimeout(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"deadline", context.DeadlineExceeded, true},
+		{"wrapped deadline", fmt.Errorf("NLIC: %w", context.DeadlineExceeded), true},
+		{"joined deadline", errors.Join(errors.New("ELIS: fail"), fmt.Errorf("NLIC: %w", context.DeadlineExceeded)), true},
+		{"canceled", context.Canceled, false},
+		{"other", errors.New("서버 오류"), false},
+		{"nil", nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsTimeout(tt.err); got != tt.want {
+				t.Errorf("IsTimeout(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnifiedClient_SearchTimeout(t *testing.T) {
+	// Both sources hang until the request is cancelled
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	t.Cleanup(server.Close)
+
+	elis := NewELISClient("test-key")
+	elis.baseURL = server.URL
+	client := &UnifiedClient{
+		nlicClient: NewNLICClientWithURL("test-key", server.URL),
+		elisClient: elis,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.Search(ctx, &UnifiedSearchRequest{Query: "주차", PageNo: 1, PageSize: 10, Type: "JSON"})
+	if err == nil {
+		t.Fatal("Search() should fail when the shared context runs out of time")
+	}
+	if !IsTimeout(err) {
+		t.Errorf("Search() error should be a timeout, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "NLIC") || !strings.Contains(err.Error(), "ELIS") {
+		t.Errorf("Search() error should report both sources, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("both searches should stop at the deadline, took %v", elapsed)
+	}
+}
@@ -0,0 +1,171 @@
+package api
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/security"
+)
+
+// DefaultTraceBodyLimit is the number of bytes of a response body shown by
+// --trace; the whole body goes to --trace-file
+const DefaultTraceBodyLimit = 2048
+
+// Tracer writes the HTTP exchanges of the clients (--trace). Each exchange is
+// written to Output with the body cut at BodyLimit bytes, and in full to File.
+type Tracer struct {
+	// Output receives the exchanges with their bodies cut, nil for none
+	Output io.Writer
+	// File receives the exchanges with their whole bodies, nil for none
+	File io.Writer
+	// BodyLimit is the number of body bytes written to Output; a negative
+	// limit writes the whole body
+	BodyLimit int
+
+	mu sync.Mutex
+}
+
+var (
+	tracerMu sync.RWMutex
+	tracer   *Tracer
+)
+
+// SetTracer sets the tracer of the HTTP requests of the clients; nil stops tracing
+func SetTracer(t *Tracer) {
+	tracerMu.Lock()
+	defer tracerMu.Unlock()
+	tracer = t
+}
+
+// currentTracer returns the tracer set with SetTracer, or nil
+func currentTracer() *Tracer {
+	tracerMu.RLock()
+	defer tracerMu.RUnlock()
+	return tracer
+}
+
+// doHTTP sends req with client. Every client sends its requests through it,
+// so that --trace and --metrics see the requests of all of them and all of
+// them carry the User-Agent of the CLI.
+func doHTTP(client *http.Client, req *http.Request) (*http.Response, error) {
+	if req.Header.Get("User-Agent") == "" {
+		req.Header.Set("User-Agent", UserAgent())
+	}
+	t, m := currentTracer(), currentMetrics()
+	if t == nil && m == nil {
+		return client.Do(req)
+	}
+
+	start := time.Now()
+	resp, err := client.Do(req)
+	elapsed := time.Since(start)
+	if m != nil {
+		m.observe(resp, err, elapsed)
+	}
+	if t == nil {
+		return resp, err
+	}
+	if err != nil {
+		t.write(req, nil, nil, err, elapsed)
+		return resp, err
+	}
+
+	// Read the body for the trace and hand an identical one to the caller,
+	// failing at the same point if it could not be read
+	body, readErr := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	var replay io.Reader = bytes.NewReader(body)
+	if readErr != nil {
+		replay = io.MultiReader(replay, failingReader{readErr})
+	}
+	resp.Body = io.NopCloser(replay)
+	t.write(req, resp, body, readErr, elapsed)
+	return resp, nil
+}
+
+// failingReader is a reader that fails with err
+type failingReader struct{ err error }
+
+func (r failingReader) Read([]byte) (int, error) { return 0, r.err }
+
+// write writes an exchange, with "> " before the request and "< " before the response
+func (t *Tracer) write(req *http.Request, resp *http.Response, body []byte, err error, elapsed time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, target := range []struct {
+		w     io.Writer
+		limit int
+	}{{t.Output, t.BodyLimit}, {t.File, -1}} {
+		if target.w == nil {
+			continue
+		}
+		var buf bytes.Buffer
+		fmt.Fprintf(&buf, "> %s %s\n", req.Method, security.SanitizeURL(req.URL.String()))
+		if resp == nil {
+			fmt.Fprintf(&buf, "< 요청 실패 (%s): %v\n\n", elapsed.Round(time.Millisecond), redactError(err))
+			target.w.Write(buf.Bytes())
+			continue
+		}
+
+		fmt.Fprintf(&buf, "< %s %s (%s)\n", resp.Proto, resp.Status, elapsed.Round(time.Millisecond))
+		names := make([]string, 0, len(resp.Header))
+		for name := range resp.Header {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			fmt.Fprintf(&buf, "< %s: %s\n", name, strings.Join(resp.Header[name], ", "))
+		}
+		if err != nil {
+			fmt.Fprintf(&buf, "< 본문 읽기 실패: %v\n", err)
+		}
+		writeTraceBody(&buf, body, target.limit)
+		target.w.Write(buf.Bytes())
+	}
+}
+
+// writeTraceBody writes body after a blank line, cut at limit bytes (a
+// negative limit writes it all)
+func writeTraceBody(buf *bytes.Buffer, body []byte, limit int) {
+	if len(body) == 0 {
+		buf.WriteString("\n")
+		return
+	}
+	buf.WriteString("<\n")
+	shown := body
+	if limit >= 0 && len(body) > limit {
+		// Do not cut a UTF-8 character in the middle
+		end := limit
+		for end > 0 && !utf8.RuneStart(body[end]) {
+			end--
+		}
+		shown = body[:end]
+	}
+	buf.Write(shown)
+	if !bytes.HasSuffix(shown, []byte("\n")) {
+		buf.WriteString("\n")
+	}
+	if len(shown) < len(body) {
+		fmt.Fprintf(buf, "< ... 본문 %d바이트 중 %d바이트만 표시 (전체는 --trace-file로 저장)\n", len(body), len(shown))
+	}
+	buf.WriteString("\n")
+}
+
+// redactError returns err with the API key masked in the URL of a request error
+func redactError(err error) error {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return &url.Error{Op: urlErr.Op, URL: security.SanitizeURL(urlErr.URL), Err: urlErr.Err}
+	}
+	return err
+}
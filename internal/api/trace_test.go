@@ -0,0 +1,81 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTraceClientRequest(t *testing.T) {
+	body := strings.Repeat("가", 1000) // 3000 bytes
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/xml")
+		w.Header().Set("X-Request-Id", "abc")
+		w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	var output, file bytes.Buffer
+	SetTracer(&Tracer{Output: &output, File: &file, BodyLimit: 100})
+	defer SetTracer(nil)
+
+	client := &NLICClient{
+		httpClient:     &http.Client{Timeout: 5 * time.Second},
+		baseURL:        server.URL,
+		apiKey:         "secret-api-key",
+		retryBaseDelay: time.Millisecond,
+	}
+	got, err := client.doRequest(context.Background(), server.URL+"?OC=secret-api-key&query=test")
+	if err != nil {
+		t.Fatalf("doRequest() error = %v", err)
+	}
+	if string(got) != body {
+		t.Errorf("doRequest() returned %d bytes, want the whole body of %d", len(got), len(body))
+	}
+
+	for name, trace := range map[string]string{"output": output.String(), "file": file.String()} {
+		if strings.Contains(trace, "secret-api-key") {
+			t.Errorf("%s trace exposes the API key:\n%s", name, trace)
+		}
+		for _, want := range []string{"> GET " + server.URL + "?OC=se***(14자)&query=test", "< HTTP/1.1 200 OK", "< Content-Type: application/xml", "< X-Request-Id: abc"} {
+			if !strings.Contains(trace, want) {
+				t.Errorf("%s trace missing %q:\n%s", name, want, trace)
+			}
+		}
+	}
+
+	// The output keeps the start of the body, cut on a character boundary
+	if !strings.Contains(output.String(), "\n"+strings.Repeat("가", 33)+"\n") || strings.Contains(output.String(), strings.Repeat("가", 34)) {
+		t.Errorf("output trace does not cut the body at 99 bytes:\n%s", output.String())
+	}
+	if !strings.Contains(output.String(), "본문 3000바이트 중 99바이트만 표시") {
+		t.Errorf("output trace missing the cut note:\n%s", output.String())
+	}
+	if !strings.Contains(file.String(), body) {
+		t.Error("file trace does not have the whole body")
+	}
+}
+
+func TestTraceFailedRequest(t *testing.T) {
+	var output bytes.Buffer
+	SetTracer(&Tracer{Output: &output, BodyLimit: DefaultTraceBodyLimit})
+	defer SetTracer(nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "http://127.0.0.1:1/?OC=secret-api-key", nil)
+	if _, err := doHTTP(&http.Client{}, req); err == nil {
+		t.Fatal("doHTTP() error = nil, want the failure")
+	}
+	trace := output.String()
+	if !strings.Contains(trace, "> GET http://127.0.0.1:1/?OC=se***(14자)") || !strings.Contains(trace, "< 요청 실패") {
+		t.Errorf("trace of a failed request = %q", trace)
+	}
+	if strings.Contains(trace, "secret-api-key") {
+		t.Errorf("trace exposes the API key in the error: %q", trace)
+	}
+}
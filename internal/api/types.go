@@ -40,12 +40,15 @@ type UnifiedSearchRequest struct {
 	PageNo     int               // Page number (1-based)
 	PageSize   int               // Results per page
 	Type       string            // Response type (JSON/XML)
-	Region     string            // Region filter (for ELIS)
-	LawType    string            // Law type filter
+	Region     string            // Region filter (for ELIS), e.g. "서울" or "서울 강남구"
+	LawType    string            // Law type filter; several separated by commas
 	Department string            // Department filter
 	DateFrom   string            // Date range start (YYYYMMDD)
 	DateTo     string            // Date range end (YYYYMMDD)
-	Sort       string            // Sort order
+	Sort       string            // Sort key (relevance, name, effectDate, promulDate)
+	Order      string            // Sort order (asc, desc); empty for the natural order of Sort
+	Priority   *Priority         // Ranks that order results before Sort; nil for none
+	Limit      int               // Results kept from the start of the sorted page; 0 for the whole page
 	Extras     map[string]string // API-specific extra parameters
 }
 
@@ -54,12 +57,21 @@ type LawDetail struct {
 	LawInfo
 	Content                 string                   `json:"조문내용" xml:"조문내용"`
 	Articles                []Article                `json:"조문" xml:"조문"`
-	Attachments             []string                 `json:"첨부파일" xml:"첨부파일"`
-	RelatedLaws             []string                 `json:"관련법령" xml:"관련법령"`
+	Attachments             []Attachment             `json:"첨부파일" xml:"첨부파일"`
+	RelatedLaws             []RelatedLaw             `json:"관련법령" xml:"관련법령"`
 	RevisionText            string                   `json:"개정문" xml:"개정문"`     // 개정문 내용
 	Tables                  []Table                  `json:"별표" xml:"별표"`       // 별표 목록
 	SupplementaryProvisions []SupplementaryProvision `json:"부칙" xml:"부칙"`       // 부칙 목록
 	HasRevisionText         bool                     `json:"개정문존재" xml:"개정문존재"` // 개정문 존재 여부
+	// Sections are the text of documents that have no articles, such as the
+	// 질의요지 of an interpretation or the 판결요지 of a precedent
+	Sections []DetailSection `json:"본문,omitempty" xml:"본문,omitempty"`
+}
+
+// DetailSection is a titled part of the text of a document
+type DetailSection struct {
+	Title   string `json:"제목" xml:"제목"`
+	Content string `json:"내용" xml:"내용"`
 }
 
 // LawDetailResponse represents the actual API response structure for law detail
@@ -75,6 +87,8 @@ type LawDetailContent struct {
 	Tables                  TableContent                   `json:"별표" xml:"별표"`
 	ArticlesRaw             ArticlesContent                `json:"조문" xml:"조문"`
 	SupplementaryProvisions SupplementaryProvisionsContent `json:"부칙" xml:"부칙"`
+	RelatedLaws             interface{}                    `json:"관련법령" xml:"-"` // Names or objects with links
+	Attachments             interface{}                    `json:"첨부파일" xml:"-"` // Objects with file links
 }
 
 // BasicInfo represents basic law information
@@ -115,11 +129,15 @@ type TableContent struct {
 
 // TableUnit represents a single table unit from the API
 type TableUnit struct {
-	TableKey     string      `json:"별표키" xml:"별표키"`
-	TableNumber  string      `json:"별표번호" xml:"별표번호"`
-	TableTitle   string      `json:"별표제목" xml:"별표제목"`
-	TableContent interface{} `json:"별표내용" xml:"별표내용"` // Can be string or array
-	TableHoYN    string      `json:"별표서식여부" xml:"별표서식여부"`
+	TableKey      string      `json:"별표키" xml:"별표키"`
+	TableNumber   string      `json:"별표번호" xml:"별표번호"`
+	TableTitle    string      `json:"별표제목" xml:"별표제목"`
+	TableContent  interface{} `json:"별표내용" xml:"별표내용"` // Can be string or array
+	TableHoYN     string      `json:"별표서식여부" xml:"별표서식여부"`
+	TableFileLink string      `json:"별표서식파일링크" xml:"별표서식파일링크"`
+	TablePDFLink  string      `json:"별표서식PDF파일링크" xml:"별표서식PDF파일링크"`
+	TableFileName string      `json:"별표HWP파일명" xml:"별표HWP파일명"`
+	TablePDFName  string      `json:"별표PDF파일명" xml:"별표PDF파일명"`
 }
 
 // SupplementaryProvisionsContent represents supplementary provisions structure
@@ -180,6 +198,21 @@ type Article struct {
 	Title      string `json:"조문제목" xml:"조문제목"`
 	Content    string `json:"조문내용" xml:"조문내용"`
 	EffectDate string `json:"시행일자" xml:"시행일자"`
+	// MoveBefore and MoveAfter are the numbers the article had before and
+	// has after it was moved by an amendment, if it was
+	MoveBefore string `json:"조문이동이전,omitempty" xml:"조문이동이전,omitempty"`
+	MoveAfter  string `json:"조문이동이후,omitempty" xml:"조문이동이후,omitempty"`
+	// Paragraphs are the 항 of the article, with their 호 and 목, when the
+	// API sends them apart from Content
+	Paragraphs []Paragraph `json:"항,omitempty" xml:"항,omitempty"`
+}
+
+// Paragraph is a 항 of an article, a 호 of a 항 or a 목 of a 호; Items are
+// the units of the next level
+type Paragraph struct {
+	Number  string      `json:"번호" xml:"번호"`
+	Content string      `json:"내용" xml:"내용"`
+	Items   []Paragraph `json:"하위,omitempty" xml:"하위,omitempty"`
 }
 
 // LawHistory represents law amendment history
@@ -2,14 +2,23 @@ package api
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"sort"
 	"sync"
 
 	"github.com/pyhub-apps/pyhub-warp-cli/internal/config"
 	"github.com/pyhub-apps/pyhub-warp-cli/internal/logger"
 )
 
+// unifiedFetchSize is the number of results requested from each source per
+// page of a unified search (the largest page size of the law.go.kr search APIs)
+const unifiedFetchSize = 100
+
+// maxUnifiedFetch bounds the results a unified search collects from each
+// source, so that a late page does not fetch a whole source. Pages of the
+// merged results can reach this far.
+const maxUnifiedFetch = 1000
+
 // UnifiedClient handles unified search across multiple APIs
 type UnifiedClient struct {
 	nlicClient *NLICClient
@@ -24,7 +33,7 @@ func NewUnifiedClient() (*UnifiedClient, error) {
 		// Try legacy key path
 		apiKey = config.GetString("law.key")
 		if apiKey == "" {
-			return nil, fmt.Errorf("API 키가 설정되지 않았습니다. 'warp config set law.key YOUR_KEY' 명령으로 설정하세요")
+			return nil, fmt.Errorf("%w. 'warp config set law.key YOUR_KEY' 명령으로 설정하세요", ErrNoAPIKey)
 		}
 	}
 
@@ -34,15 +43,42 @@ func NewUnifiedClient() (*UnifiedClient, error) {
 	}, nil
 }
 
-// Search performs parallel search across all APIs
+// Search performs parallel search across all APIs. The sources cannot page
+// through the merged order, so each is read from its first page, in the
+// order of the merge, until it holds the results up to the end of the
+// requested page; the page is then cut from the merged results. A Limit
+// below the page size ends the reading at the last result kept.
 func (c *UnifiedClient) Search(ctx context.Context, req *UnifiedSearchRequest) (*SearchResponse, error) {
 	// Create channels for results
 	type searchResult struct {
 		source   string
 		response *SearchResponse
+		complete bool
 		err      error
 	}
 
+	pageNo, requestedSize := normalizePage(req.PageNo, req.PageSize)
+	pageSize := requestedSize
+	if req.Limit > 0 && req.Limit < pageSize {
+		pageSize = req.Limit
+	}
+	need := (pageNo-1)*requestedSize + pageSize
+
+	// Merged results are ordered by promulgation date (newest first) unless
+	// requested otherwise; the sources are asked for the same order, so that
+	// their first results are the first of the merge
+	sortKey := req.Sort
+	if sortKey == "" {
+		sortKey = SortPromulDate
+	}
+	sourceReq := *req
+	sourceReq.Sort = sortKey
+
+	// Both searches share one context, so cancelling the unified search or
+	// running out of time stops both, and neither outlives Search
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
 	resultsChan := make(chan searchResult, 2)
 	var wg sync.WaitGroup
 
@@ -51,10 +87,11 @@ func (c *UnifiedClient) Search(ctx context.Context, req *UnifiedSearchRequest) (
 	go func() {
 		defer wg.Done()
 		logger.Debug("Starting NLIC search for: %s", req.Query)
-		resp, err := c.nlicClient.Search(ctx, req)
+		resp, complete, err := fetchSource(ctx, "NLIC", c.nlicClient.Search, sourceReq, need)
 		resultsChan <- searchResult{
 			source:   "NLIC",
 			response: resp,
+			complete: complete,
 			err:      err,
 		}
 	}()
@@ -64,10 +101,11 @@ func (c *UnifiedClient) Search(ctx context.Context, req *UnifiedSearchRequest) (
 	go func() {
 		defer wg.Done()
 		logger.Debug("Starting ELIS search for: %s", req.Query)
-		resp, err := c.elisClient.Search(ctx, req)
+		resp, complete, err := fetchSource(ctx, "ELIS", c.elisClient.Search, sourceReq, need)
 		resultsChan <- searchResult{
 			source:   "ELIS",
 			response: resp,
+			complete: complete,
 			err:      err,
 		}
 	}()
@@ -78,15 +116,20 @@ func (c *UnifiedClient) Search(ctx context.Context, req *UnifiedSearchRequest) (
 		close(resultsChan)
 	}()
 
-	// Collect results
-	var allLaws []LawInfo
+	// Collect results per source, so that the API order is kept for relevance
 	totalCount := 0
-	errors := []error{}
+	errs := []error{}
+	statuses := map[string]SourceStatus{}
+	lawsBySource := map[string][]LawInfo{}
+	var partial []int // sizes of the sources with results left to fetch
+	reachable := 0
+	capped := false
 
 	for result := range resultsChan {
+		statuses[result.source] = newSourceStatus(result.source, result.response, result.err)
 		if result.err != nil {
 			logger.Error("%s search error: %v", result.source, result.err)
-			errors = append(errors, fmt.Errorf("%s: %w", result.source, result.err))
+			errs = append(errs, fmt.Errorf("%s: %w", result.source, result.err))
 			continue
 		}
 
@@ -95,73 +138,128 @@ func (c *UnifiedClient) Search(ctx context.Context, req *UnifiedSearchRequest) (
 
 			// Add source information to each law
 			for i := range result.response.Laws {
-				if result.source == "ELIS" {
-					// Mark ELIS results
-					result.response.Laws[i].Source = "자치법규"
-				} else {
-					// Mark NLIC results
-					result.response.Laws[i].Source = "국가법령"
-				}
+				result.response.Laws[i].Source = sourceLabel(result.source)
 			}
 
-			allLaws = append(allLaws, result.response.Laws...)
+			lawsBySource[result.source] = result.response.Laws
 			totalCount += result.response.TotalCount
+
+			if result.complete {
+				reachable += len(result.response.Laws)
+				continue
+			}
+			partial = append(partial, len(result.response.Laws))
+			reachable += min(result.response.TotalCount, maxUnifiedFetch)
+			capped = capped || result.response.TotalCount > maxUnifiedFetch
 		}
 	}
 
 	// If all searches failed, return error
-	if len(errors) == 2 {
-		return nil, fmt.Errorf("모든 API 검색 실패: %v", errors)
+	if len(errs) == 2 {
+		return nil, fmt.Errorf("모든 API 검색 실패: %w", errors.Join(errs...))
 	}
 
-	// Sort results by date (newest first)
-	sort.Slice(allLaws, func(i, j int) bool {
-		// Sort by promulgation date, newest first
-		return allLaws[i].PromulDate > allLaws[j].PromulDate
-	})
+	// National laws come first, whichever source answered first
+	allLaws := append(append([]LawInfo{}, lawsBySource["NLIC"]...), lawsBySource["ELIS"]...)
 
-	// Apply pagination
-	startIdx := (req.PageNo - 1) * req.PageSize
-	endIdx := startIdx + req.PageSize
+	SortLaws(allLaws, sortKey, req.Order)
+	PrioritizeLaws(allLaws, req.Priority)
 
-	if startIdx > len(allLaws) {
-		startIdx = len(allLaws)
+	// The merged order is exact as far as every source with results left
+	// was read; later results could still be preceded by unread ones
+	for _, n := range partial {
+		if n < len(allLaws) {
+			allLaws = allLaws[:n]
+		}
 	}
-	if endIdx > len(allLaws) {
-		endIdx = len(allLaws)
+	// A source beyond maxUnifiedFetch, likewise, bounds the pages of any request
+	if capped {
+		reachable = min(reachable, maxUnifiedFetch)
 	}
 
+	// Apply pagination
+	startIdx := min(max((pageNo-1)*requestedSize, 0), len(allLaws))
+	endIdx := min(max(startIdx+pageSize, startIdx), len(allLaws))
+
 	paginatedLaws := allLaws[startIdx:endIdx]
 
+	// Report the sources in a fixed order, whichever answered first
+	sources := []SourceStatus{statuses["NLIC"], statuses["ELIS"]}
+
 	// Create unified response
 	response := &SearchResponse{
-		TotalCount: totalCount,
-		Page:       req.PageNo,
-		Laws:       paginatedLaws,
+		TotalCount:   totalCount,
+		FetchedCount: reachable,
+		Page:         pageNo,
+		PageSize:     requestedSize,
+		Laws:         paginatedLaws,
+		Sources:      sources,
+		Warnings:     SourceWarnings(sources),
 	}
 
-	logger.Info("통합 검색 완료: 총 %d개 결과 (NLIC+ELIS)", len(allLaws))
+	logger.Info("통합 검색 완료: 총 %d개 중 %d개 병합 (NLIC+ELIS)", totalCount, len(allLaws))
 
 	return response, nil
 }
 
-// GetDetail retrieves detailed information (tries NLIC first, then ELIS)
-func (c *UnifiedClient) GetDetail(ctx context.Context, lawID string) (*LawDetail, error) {
-	// Try NLIC first (for national laws)
-	detail, err := c.nlicClient.GetDetail(ctx, lawID)
-	if err == nil {
-		return detail, nil
-	}
-
-	logger.Debug("NLIC detail failed, trying ELIS: %v", err)
+// fetchSource collects the results of search for req from the first page on,
+// unifiedFetchSize at a time, until it holds need of them (at most
+// maxUnifiedFetch) and reports whether those are all that can be fetched.
+// Only the first page must succeed: a later failure ends the collection with
+// the results so far, which are then all the source has to offer.
+func fetchSource(ctx context.Context, source string, search func(context.Context, *UnifiedSearchRequest) (*SearchResponse, error), req UnifiedSearchRequest, need int) (*SearchResponse, bool, error) {
+	need = min(max(need, 1), maxUnifiedFetch)
+	req.PageSize = unifiedFetchSize
+
+	var collected *SearchResponse
+	for page := 1; ; page++ {
+		pageReq := req
+		pageReq.PageNo = page
+		resp, err := search(ctx, &pageReq)
+		if err != nil {
+			if collected == nil || ctx.Err() != nil {
+				return nil, false, err
+			}
+			logger.Warn("%s %d페이지 수집 실패, 앞의 %d개만 병합합니다: %v", source, page, len(collected.Laws), err)
+			return collected, true, nil
+		}
 
-	// Try ELIS (for local ordinances)
-	detail, err = c.elisClient.GetDetail(ctx, lawID)
-	if err == nil {
-		return detail, nil
+		if collected == nil {
+			collected = resp
+		} else {
+			collected.Laws = append(collected.Laws, resp.Laws...)
+		}
+		// A short page is the last one, whatever total the server reported
+		if len(resp.Laws) < unifiedFetchSize || len(collected.Laws) >= collected.TotalCount {
+			return collected, true, nil
+		}
+		if len(collected.Laws) >= need {
+			return collected, false, nil
+		}
 	}
+}
 
-	return nil, fmt.Errorf("법령/조례 상세 정보를 찾을 수 없습니다 (ID: %s)", lawID)
+// GetDetail retrieves detailed information from the source the ID looks like
+// it belongs to, then from the other. When both fail the error is a
+// *DetailError with the cause of each.
+func (c *UnifiedClient) GetDetail(ctx context.Context, lawID string) (*LawDetail, error) {
+	detailErr := &DetailError{ID: lawID}
+	for _, source := range detailSources(lawID) {
+		client := ClientInterface(c.nlicClient)
+		if source == APITypeELIS {
+			client = c.elisClient
+		}
+		detail, err := client.GetDetail(ctx, lawID)
+		if err == nil {
+			return detail, nil
+		}
+		logger.Debug("%s 상세 조회 실패 (ID: %s): %v", detailSourceLabel(source), lawID, err)
+		detailErr.Attempts = append(detailErr.Attempts, DetailAttempt{Source: source, Err: err})
+		if stopsDetailFallback(err) {
+			break
+		}
+	}
+	return nil, detailErr
 }
 
 // GetHistory retrieves law history (only NLIC supports this)
@@ -0,0 +1,58 @@
+package api
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/logger"
+)
+
+// SourceResults is the answer of one source of SearchEachSource
+type SourceResults struct {
+	Source   string // "NLIC" or "ELIS"
+	Response *SearchResponse
+	Err      error
+}
+
+// SearchEachSource searches every source at once, like Search, but sends the
+// page of each source on the returned channel as soon as it arrives instead
+// of merging them. Each source is asked for the requested page on its own;
+// its laws carry its label and are sorted and prioritized as requested, but
+// the sources come in the order they answer. The channel is closed once all
+// of them have answered; cancelling ctx stops the ones still searching.
+func (c *UnifiedClient) SearchEachSource(ctx context.Context, req *UnifiedSearchRequest) <-chan SourceResults {
+	sources := []struct {
+		name   string
+		search func(context.Context, *UnifiedSearchRequest) (*SearchResponse, error)
+	}{
+		{"NLIC", c.nlicClient.Search},
+		{"ELIS", c.elisClient.Search},
+	}
+
+	// Buffered for every source, so that none blocks on a reader that stopped
+	results := make(chan SourceResults, len(sources))
+	var wg sync.WaitGroup
+	for _, source := range sources {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			logger.Debug("Starting %s search for: %s", source.name, req.Query)
+			sourceReq := *req
+			resp, err := source.search(ctx, &sourceReq)
+			if err == nil && resp != nil {
+				for i := range resp.Laws {
+					resp.Laws[i].Source = sourceLabel(source.name)
+				}
+				SortLaws(resp.Laws, req.Sort, req.Order)
+				PrioritizeLaws(resp.Laws, req.Priority)
+			}
+			results <- SourceResults{Source: source.name, Response: resp, Err: err}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+	return results
+}
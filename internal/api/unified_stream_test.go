@@ -0,0 +1,49 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/testutil"
+)
+
+func TestUnifiedClient_SearchEachSource(t *testing.T) {
+	// The national law search answers only after the ordinances were received
+	release := make(chan struct{})
+	server := testutil.NewAPIServer(t)
+	server.HandleFunc(testutil.TargetLaw, func(testutil.Request) testutil.Reply {
+		<-release
+		return testutil.HTMLError("일시적인 오류")
+	})
+	server.Handle(testutil.TargetOrdinance, testutil.Results(2,
+		testutil.Item{"자치법규ID": "E1", "자치법규명": "서울특별시 주차장 조례", "공포일자": "20230101"},
+		testutil.Item{"자치법규ID": "E2", "자치법규명": "가평군 주차장 조례", "공포일자": "20240101"},
+	))
+	client := newTestUnifiedClient(server)
+
+	results := client.SearchEachSource(context.Background(), &UnifiedSearchRequest{
+		Query: "주차", PageNo: 1, PageSize: 10, Sort: SortPromulDate, Type: "JSON",
+	})
+
+	first := <-results
+	close(release)
+	if first.Source != "ELIS" || first.Err != nil {
+		t.Fatalf("first result = %s (error %v), want the ordinances before the national laws answer", first.Source, first.Err)
+	}
+	if got := sortedIDs(first.Response.Laws); len(got) != 2 || got[0] != "E2" {
+		t.Errorf("ordinances = %v, want [E2 E1] (newest first)", got)
+	}
+	for _, law := range first.Response.Laws {
+		if law.Source != "자치법규" {
+			t.Errorf("law %s source = %q, want 자치법규", law.ID, law.Source)
+		}
+	}
+
+	second := <-results
+	if second.Source != "NLIC" || second.Err == nil {
+		t.Errorf("second result = %s (error %v), want the failed national law search", second.Source, second.Err)
+	}
+	if _, ok := <-results; ok {
+		t.Error("results should be closed after every source answered")
+	}
+}
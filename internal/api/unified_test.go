@@ -2,9 +2,15 @@ package api
 
 import (
 	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/pyhub-apps/pyhub-warp-cli/internal/config"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/testutil"
 )
 
 func TestUnifiedClient_Search(t *testing.T) {
@@ -152,3 +158,596 @@ func TestUnifiedClient_GetDetail(t *testing.T) {
 		t.Error("Expected nil detail for invalid ID")
 	}
 }
+
+// sortTestLaws has duplicate, missing and differently formatted keys
+func sortTestLaws() []LawInfo {
+	return []LawInfo{
+		{ID: "1", Name: "하천법", PromulDate: "20200101", EffectDate: "20210101"},
+		{ID: "2", Name: "가족법", PromulDate: "", EffectDate: "20190101"},
+		{ID: "3", Name: "", PromulDate: "20220101", EffectDate: "2023.01.01"},
+		{ID: "4", Name: "도로법", PromulDate: "20200101", EffectDate: ""},
+		{ID: "5", Name: "가족법", PromulDate: "20180101", EffectDate: "20190101"},
+	}
+}
+
+func sortedIDs(laws []LawInfo) []string {
+	ids := make([]string, len(laws))
+	for i, law := range laws {
+		ids[i] = law.ID
+	}
+	return ids
+}
+
+func TestSortLaws(t *testing.T) {
+	tests := []struct {
+		name  string
+		key   string
+		order string
+		want  []string
+	}{
+		{"relevance keeps the API order", SortRelevance, "", []string{"1", "2", "3", "4", "5"}},
+		{"relevance ignores the order", SortRelevance, OrderAsc, []string{"1", "2", "3", "4", "5"}},
+		{"no sort keeps the API order", "", "", []string{"1", "2", "3", "4", "5"}},
+		{"name defaults to ascending", SortName, "", []string{"2", "5", "4", "1", "3"}},
+		{"name descending", SortName, OrderDesc, []string{"1", "4", "2", "5", "3"}},
+		{"promulgation date defaults to newest first", SortPromulDate, "", []string{"3", "1", "4", "5", "2"}},
+		{"promulgation date ascending", SortPromulDate, OrderAsc, []string{"5", "1", "4", "3", "2"}},
+		{"date is promulgation date", SortDate, OrderDesc, []string{"3", "1", "4", "5", "2"}},
+		{"effective date descending", SortEffectDate, OrderDesc, []string{"3", "1", "2", "5", "4"}},
+		{"effective date ascending", SortEffectDate, OrderAsc, []string{"2", "5", "1", "3", "4"}},
+		{"keys are case-insensitive", "EFFECTDATE", "ASC", []string{"2", "5", "1", "3", "4"}},
+		{"invalid key keeps the API order", "popularity", "", []string{"1", "2", "3", "4", "5"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			laws := sortTestLaws()
+			SortLaws(laws, tt.key, tt.order)
+			if got := sortedIDs(laws); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SortLaws(%q, %q) = %v, want %v", tt.key, tt.order, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSortLawsMixedDates(t *testing.T) {
+	laws := func() []LawInfo {
+		return []LawInfo{
+			{ID: "1", PromulDate: "2023.3.5"},
+			{ID: "2", PromulDate: ""},
+			{ID: "3", PromulDate: "20230214"},
+			{ID: "4", PromulDate: "미상"},
+			{ID: "5", PromulDate: "2023-12-01"},
+			{ID: "6", PromulDate: "2023. 03. 05."},
+			{ID: "7", PromulDate: "2023.02.30"},
+		}
+	}
+	tests := []struct {
+		order string
+		want  []string
+	}{
+		// A month written without a leading zero still sorts as its date, the
+		// same day keeps the API order and dates that do not parse go last
+		{OrderDesc, []string{"5", "1", "6", "3", "2", "4", "7"}},
+		{OrderAsc, []string{"3", "1", "6", "5", "2", "4", "7"}},
+	}
+	for _, tt := range tests {
+		got := laws()
+		SortLaws(got, SortPromulDate, tt.order)
+		if ids := sortedIDs(got); !reflect.DeepEqual(ids, tt.want) {
+			t.Errorf("SortLaws(%q) = %v, want %v", tt.order, ids, tt.want)
+		}
+	}
+}
+
+func TestValidateSort(t *testing.T) {
+	tests := []struct {
+		key, order string
+		wantErr    bool
+	}{
+		{"", "", false},
+		{"date", "", false},
+		{"relevance", "desc", false},
+		{"effectDate", "asc", false},
+		{"promulDate", "DESC", false},
+		{"popularity", "", true},
+		{"name", "up", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key+"_"+tt.order, func(t *testing.T) {
+			if err := ValidateSort(tt.key, tt.order); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateSort(%q, %q) error = %v, wantErr %v", tt.key, tt.order, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSortParam(t *testing.T) {
+	tests := []struct {
+		target, key, order, want string
+		wantOK                   bool
+	}{
+		{"law", "", "", "", true},
+		{"law", SortRelevance, OrderAsc, "", true},
+		{"law", SortName, "", "lasc", true},
+		{"law", SortName, OrderDesc, "ldes", true},
+		{"law", SortDate, "", "ddes", true},
+		{"law", SortPromulDate, OrderAsc, "dasc", true},
+		{"law", SortEffectDate, "", "efdes", true},
+		{"law", SortEffectDate, OrderAsc, "efasc", true},
+		{"ordin", "name", "", "lasc", true},
+		{"ordin", "date", "", "ddes", true},
+		{"admrul", SortEffectDate, OrderAsc, "efasc", true},
+		{"prec", SortName, OrderDesc, "ldes", true},
+		{"prec", SortPromulDate, "", "ddes", true},
+		// Keys an API cannot sort by are left to the clients
+		{"prec", SortEffectDate, "", "", false},
+		{"expc", SortEffectDate, OrderAsc, "", false},
+		{"unknown", SortName, "", "", false},
+	}
+
+	for _, tt := range tests {
+		got, ok := sortParam(tt.target, tt.key, tt.order)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("sortParam(%q, %q, %q) = %q, %v, want %q, %v", tt.target, tt.key, tt.order, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func priorityTestLaws() []LawInfo {
+	return []LawInfo{
+		{ID: "1", Name: "하천법", Department: "환경부", LawType: "법률", PromulDate: "20200101"},
+		{ID: "2", Name: "개인정보 보호법 시행령", Department: "개인정보보호위원회", LawType: "대통령령", PromulDate: "20230101"},
+		{ID: "3", Name: "도로법", Department: "국토교통부", LawType: "법률", PromulDate: "20220101"},
+		{ID: "4", Name: "개인정보 보호법", Department: "개인정보보호위원회", LawType: "법률", PromulDate: "20210101"},
+		{ID: "5", Name: "도로법 시행령", Department: "국토교통부", LawType: "대통령령", PromulDate: "20240101"},
+		{ID: "6", Name: "물환경보전법", Department: "환경부", LawType: "법률", PromulDate: "20190101"},
+	}
+}
+
+func TestPrioritizeLaws(t *testing.T) {
+	tests := []struct {
+		name     string
+		priority *Priority
+		key      string
+		want     []string
+	}{
+		{"no priority keeps the sort", nil, SortPromulDate, []string{"5", "2", "3", "4", "1", "6"}},
+		{
+			"departments first, unlisted last by date",
+			&Priority{Departments: []string{"개인정보보호위원회", "환경부"}},
+			SortPromulDate,
+			[]string{"2", "4", "1", "6", "5", "3"},
+		},
+		{
+			"law types rank within a department",
+			&Priority{Departments: []string{"국토교통부"}, LawTypes: []string{"법률"}},
+			SortPromulDate,
+			[]string{"3", "5", "4", "1", "6", "2"},
+		},
+		{
+			"law types alone, then by name",
+			&Priority{LawTypes: []string{"대통령령"}},
+			SortName,
+			[]string{"2", "5", "4", "3", "6", "1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			laws := priorityTestLaws()
+			SortLaws(laws, tt.key, "")
+			PrioritizeLaws(laws, tt.priority)
+			if got := sortedIDs(laws); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PrioritizeLaws() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPrioritizeLawsDeterministic(t *testing.T) {
+	priority := &Priority{Departments: []string{"환경부", "국토교통부"}, LawTypes: []string{"대통령령", "법률"}}
+	want := []string{"1", "6", "5", "3", "2", "4"}
+
+	// Every rotation of the API order gives the same result
+	base := priorityTestLaws()
+	for shift := 0; shift < len(base); shift++ {
+		laws := append(append([]LawInfo{}, base[shift:]...), base[:shift]...)
+		SortLaws(laws, SortPromulDate, "")
+		PrioritizeLaws(laws, priority)
+		if got := sortedIDs(laws); !reflect.DeepEqual(got, want) {
+			t.Errorf("rotation %d: PrioritizeLaws() = %v, want %v", shift, got, want)
+		}
+	}
+}
+
+func TestParsePriority(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    *Priority
+		wantErr string
+	}{
+		{
+			name: "departments and law types",
+			data: "departments:\n  - 환경부\n  - \" 국토교통부 \"\nlaw_types: [법률]\n",
+			want: &Priority{Departments: []string{"환경부", "국토교통부"}, LawTypes: []string{"법률"}},
+		},
+		{name: "law types only", data: "law_types:\n  - 대통령령\n", want: &Priority{LawTypes: []string{"대통령령"}}},
+		{name: "empty file", data: "", wantErr: "우선순위가 없습니다"},
+		{name: "unknown key", data: "ministries:\n  - 환경부\n", wantErr: "ministries"},
+		{name: "not a list", data: "departments: 환경부\n", wantErr: "line 1"},
+		{name: "broken yaml", data: "departments: [환경부\n", wantErr: "line"},
+		{name: "repeated value", data: "departments: [환경부, 환경부]\n", wantErr: "중복"},
+		{name: "blank value", data: "law_types: [법률, \"\"]\n", wantErr: "2번째 값이 비어 있습니다"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParsePriority([]byte(tt.data))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("ParsePriority() error = %v, want it to contain %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParsePriority() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParsePriority() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+// newSortTestUnifiedClient serves two national laws and two ordinances in a fixed API order
+func newSortTestUnifiedClient(t *testing.T) *UnifiedClient {
+	t.Helper()
+
+	server := testutil.NewAPIServer(t)
+	server.Handle(testutil.TargetOrdinance, testutil.Results(2,
+		testutil.Item{"자치법규ID": "E1", "자치법규명": "서울특별시 주차장 조례", "공포일자": "20230101", "시행일자": ""},
+		testutil.Item{"자치법규ID": "E2", "자치법규명": "가평군 주차장 조례", "공포일자": "20240101", "시행일자": "20240301"},
+	))
+	server.Handle(testutil.TargetLaw, testutil.Results(2,
+		testutil.Item{"법령ID": "N1", "법령명한글": "주차장법", "공포일자": "20220101", "시행일자": "20220701"},
+		testutil.Item{"법령ID": "N2", "법령명한글": "도로교통법", "공포일자": "", "시행일자": "20250101"},
+	))
+	return newTestUnifiedClient(server)
+}
+
+// newTestUnifiedClient returns a unified client whose sources both search server
+func newTestUnifiedClient(server *testutil.APIServer) *UnifiedClient {
+	elis := NewELISClient("test-key")
+	elis.baseURL = server.URL
+	return &UnifiedClient{
+		nlicClient: NewNLICClientWithURL("test-key", server.URL),
+		elisClient: elis,
+	}
+}
+
+func TestUnifiedClient_SearchSort(t *testing.T) {
+	client := newSortTestUnifiedClient(t)
+
+	tests := []struct {
+		name  string
+		sort  string
+		order string
+		want  []string
+	}{
+		{"default is newest promulgation first", "", "", []string{"E2", "E1", "N1", "N2"}},
+		{"relevance keeps each source's API order, national laws first", SortRelevance, "", []string{"N1", "N2", "E1", "E2"}},
+		{"name ascending", SortName, "", []string{"E2", "N2", "E1", "N1"}},
+		{"name descending", SortName, OrderDesc, []string{"N1", "E1", "N2", "E2"}},
+		{"effective date ascending, empty last", SortEffectDate, OrderAsc, []string{"N1", "E2", "N2", "E1"}},
+		{"promulgation date ascending, empty last", SortPromulDate, OrderAsc, []string{"N1", "E1", "E2", "N2"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := client.Search(context.Background(), &UnifiedSearchRequest{
+				Query:    "주차",
+				PageNo:   1,
+				PageSize: 10,
+				Sort:     tt.sort,
+				Order:    tt.order,
+				Type:     "JSON",
+			})
+			if err != nil {
+				t.Fatalf("Search() error = %v", err)
+			}
+			if got := sortedIDs(resp.Laws); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Search(sort=%q, order=%q) = %v, want %v", tt.sort, tt.order, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnifiedClient_SearchPriority(t *testing.T) {
+	client := newSortTestUnifiedClient(t)
+
+	// The priority applies to the merged results before the page is cut
+	resp, err := client.Search(context.Background(), &UnifiedSearchRequest{
+		Query:    "주차",
+		PageNo:   1,
+		PageSize: 3,
+		Sort:     SortName,
+		Priority: &Priority{LawTypes: []string{"자치법규"}},
+		Type:     "JSON",
+	})
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if got := sortedIDs(resp.Laws); !reflect.DeepEqual(got, []string{"E2", "E1", "N2"}) {
+		t.Errorf("Search() = %v, want ordinances first, then by name", got)
+	}
+}
+
+func TestSingleClientSearchSort(t *testing.T) {
+	client := newSortTestUnifiedClient(t)
+
+	resp, err := client.nlicClient.Search(context.Background(), &UnifiedSearchRequest{
+		Query: "주차", PageNo: 1, PageSize: 10, Sort: SortEffectDate, Type: "JSON",
+	})
+	if err != nil {
+		t.Fatalf("NLIC Search() error = %v", err)
+	}
+	if got := sortedIDs(resp.Laws); !reflect.DeepEqual(got, []string{"N2", "N1"}) {
+		t.Errorf("NLIC results = %v, want newest effective date first", got)
+	}
+
+	resp, err = client.elisClient.Search(context.Background(), &UnifiedSearchRequest{
+		Query: "주차", PageNo: 1, PageSize: 10, Sort: SortEffectDate, Order: OrderDesc,
+	})
+	if err != nil {
+		t.Fatalf("ELIS Search() error = %v", err)
+	}
+	if got := sortedIDs(resp.Laws); !reflect.DeepEqual(got, []string{"E2", "E1"}) {
+		t.Errorf("ELIS results = %v, want the ordinance without an effective date last", got)
+	}
+}
+
+// newCountTestUnifiedClient serves nlicTotal national laws and elisTotal ordinances
+// page by page, each source newest first with the promulgation dates of the
+// two interleaved
+func newCountTestUnifiedClient(t *testing.T, nlicTotal, elisTotal int) *UnifiedClient {
+	t.Helper()
+	return newTestUnifiedClient(newCountTestServer(t, nlicTotal, elisTotal))
+}
+
+// newCountTestServer is the server of newCountTestUnifiedClient
+func newCountTestServer(t *testing.T, nlicTotal, elisTotal int) *testutil.APIServer {
+	t.Helper()
+
+	server := testutil.NewAPIServer(t)
+	results := func(idKey, nameKey, prefix string, total, step int) func(testutil.Request) testutil.Reply {
+		return func(r testutil.Request) testutil.Reply {
+			display, _ := strconv.Atoi(r.Params.Get("display"))
+			var items []testutil.Item
+			for i := (r.Page-1)*display + 1; i <= total && i <= r.Page*display; i++ {
+				items = append(items, testutil.Item{
+					idKey:   fmt.Sprintf("%s%d", prefix, i),
+					nameKey: fmt.Sprintf("법령 %d", i),
+					"공포일자":  time.Date(2024, 1, 1, 0, 0, 0, 0, KST).AddDate(0, 0, -step*i).Format("20060102"),
+				})
+			}
+			return testutil.Results(total, items...)
+		}
+	}
+	server.HandleFunc(testutil.TargetLaw, results("법령ID", "법령명한글", "N", nlicTotal, 2))
+	server.HandleFunc(testutil.TargetOrdinance, results("자치법규ID", "자치법규명", "E", elisTotal, 3))
+	return server
+}
+
+// idRange returns the IDs prefix+from to prefix+to
+func idRange(prefix string, from, to int) []string {
+	ids := []string{}
+	for i := from; i <= to; i++ {
+		ids = append(ids, fmt.Sprintf("%s%d", prefix, i))
+	}
+	return ids
+}
+
+func TestUnifiedClient_SearchCounts(t *testing.T) {
+	tests := []struct {
+		name        string
+		nlicTotal   int
+		elisTotal   int
+		page        int
+		wantTotal   int
+		wantFetched int
+		wantIDs     []string
+	}{
+		{"first page spans both sources", 3, 250, 1, 253, 253, append(idRange("N", 1, 3), idRange("E", 1, 7)...)},
+		{"second page is not cut from the first", 3, 250, 2, 253, 253, idRange("E", 8, 17)},
+		{"page beyond the first source page", 3, 250, 11, 253, 253, idRange("E", 98, 107)},
+		{"last page", 3, 250, 26, 253, 253, idRange("E", 248, 250)},
+		{"beyond the results", 3, 250, 27, 253, 253, []string{}},
+		{"everything fetched", 4, 5, 1, 9, 9, append(idRange("N", 1, 4), idRange("E", 1, 5)...)},
+		{"both sources over the fetch size", 150, 120, 20, 270, 270, idRange("E", 41, 50)},
+		{"last page within the fetch limit", 1200, 0, 100, 1200, maxUnifiedFetch, idRange("N", 991, 1000)},
+		{"beyond the fetch limit", 1200, 0, 101, 1200, maxUnifiedFetch, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newCountTestUnifiedClient(t, tt.nlicTotal, tt.elisTotal)
+			resp, err := client.Search(context.Background(), &UnifiedSearchRequest{
+				Query:    "주차",
+				PageNo:   tt.page,
+				PageSize: 10,
+				Sort:     SortRelevance,
+				Type:     "JSON",
+			})
+			if err != nil {
+				t.Fatalf("Search() error = %v", err)
+			}
+			if resp.TotalCount != tt.wantTotal || resp.FetchedCount != tt.wantFetched {
+				t.Errorf("TotalCount, FetchedCount = %d, %d, want %d, %d", resp.TotalCount, resp.FetchedCount, tt.wantTotal, tt.wantFetched)
+			}
+			if got := sortedIDs(resp.Laws); !reflect.DeepEqual(got, tt.wantIDs) {
+				t.Errorf("page %d = %v, want %v", tt.page, got, tt.wantIDs)
+			}
+		})
+	}
+}
+
+func TestUnifiedClient_SearchLimit(t *testing.T) {
+	search := func(page, size, limit int) ([]string, map[string]int) {
+		t.Helper()
+		server := newCountTestServer(t, 250, 250)
+		resp, err := newTestUnifiedClient(server).Search(context.Background(), &UnifiedSearchRequest{
+			Query:    "주차",
+			PageNo:   page,
+			PageSize: size,
+			Limit:    limit,
+			Type:     "JSON",
+		})
+		if err != nil {
+			t.Fatalf("Search(page=%d, size=%d, limit=%d) error = %v", page, size, limit, err)
+		}
+		if resp.TotalCount != 500 || resp.PageSize != size {
+			t.Errorf("TotalCount, PageSize = %d, %d, want 500, %d", resp.TotalCount, resp.PageSize, size)
+		}
+		calls := map[string]int{}
+		for _, r := range server.Requests() {
+			calls[r.Target]++
+		}
+		return sortedIDs(resp.Laws), calls
+	}
+
+	tests := []struct {
+		name      string
+		page      int
+		size      int
+		limit     int
+		wantCalls int // requests to each source
+	}{
+		{"no limit", 1, 300, 0, 3},
+		{"limit ends the reading early", 1, 300, 5, 1},
+		{"limit of a later page", 2, 150, 20, 2},
+		{"limit over the page size", 1, 150, 500, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			all, _ := search(tt.page, tt.size, 0)
+			want := all
+			if tt.limit > 0 && tt.limit < len(all) {
+				want = all[:tt.limit]
+			}
+
+			got, calls := search(tt.page, tt.size, tt.limit)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("results = %v, want the first %d of the page %v", got, len(want), all)
+			}
+			if calls[testutil.TargetLaw] != tt.wantCalls || calls[testutil.TargetOrdinance] != tt.wantCalls {
+				t.Errorf("requests per source = %v, want %d each", calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestUnifiedClient_SearchOutOfRangePage(t *testing.T) {
+	tests := []struct {
+		name     string
+		page     int
+		size     int
+		wantPage int
+		wantSize int
+		wantLaws int
+	}{
+		{"page 0", 0, 10, 1, 10, 10},
+		{"negative page", -2, 10, 1, 10, 10},
+		{"size 0", 1, 0, 1, DefaultPageSize, 40},
+		{"negative size", 1, -5, 1, DefaultPageSize, 40},
+		{"size over the maximum", 1, MaxPageSize * 10, 1, MaxPageSize, 40},
+		{"page past the results", 100, 10, 100, 10, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newCountTestServer(t, 20, 20)
+			resp, err := newTestUnifiedClient(server).Search(context.Background(), &UnifiedSearchRequest{
+				Query:    "주차",
+				PageNo:   tt.page,
+				PageSize: tt.size,
+				Type:     "JSON",
+			})
+			if err != nil {
+				t.Fatalf("Search() error = %v", err)
+			}
+			if resp.Page != tt.wantPage || resp.PageSize != tt.wantSize || len(resp.Laws) != tt.wantLaws {
+				t.Errorf("Page, PageSize, results = %d, %d, %d; want %d, %d, %d",
+					resp.Page, resp.PageSize, len(resp.Laws), tt.wantPage, tt.wantSize, tt.wantLaws)
+			}
+		})
+	}
+}
+
+func TestUnifiedClient_SearchLaterPageFails(t *testing.T) {
+	server := testutil.NewAPIServer(t)
+	server.HandleFunc(testutil.TargetLaw, func(r testutil.Request) testutil.Reply {
+		if r.Page > 1 {
+			return testutil.HTMLError("일시적인 오류")
+		}
+		var items []testutil.Item
+		for i := 1; i <= unifiedFetchSize; i++ {
+			items = append(items, testutil.Item{"법령ID": fmt.Sprintf("N%d", i), "법령명한글": fmt.Sprintf("법령 %d", i)})
+		}
+		return testutil.Results(250, items...)
+	})
+	server.Handle(testutil.TargetOrdinance, testutil.Results(2,
+		testutil.Item{"자치법규ID": "E1", "자치법규명": "조례 1"},
+		testutil.Item{"자치법규ID": "E2", "자치법규명": "조례 2"},
+	))
+	client := newTestUnifiedClient(server)
+
+	// The results fetched before the failure are still merged and paged
+	resp, err := client.Search(context.Background(), &UnifiedSearchRequest{
+		Query: "주차", PageNo: 11, PageSize: 10, Sort: SortRelevance, Type: "JSON",
+	})
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if resp.TotalCount != 252 || resp.FetchedCount != 102 {
+		t.Errorf("TotalCount, FetchedCount = %d, %d, want 252, 102", resp.TotalCount, resp.FetchedCount)
+	}
+	if got := sortedIDs(resp.Laws); !reflect.DeepEqual(got, []string{"E1", "E2"}) {
+		t.Errorf("page 11 = %v, want the ordinances after the fetched laws", got)
+	}
+}
+
+func TestUnifiedClient_SearchPagesConsistent(t *testing.T) {
+	client := newCountTestUnifiedClient(t, 230, 180)
+	search := func(page, size int) []string {
+		t.Helper()
+		resp, err := client.Search(context.Background(), &UnifiedSearchRequest{
+			Query:    "주차",
+			PageNo:   page,
+			PageSize: size,
+			Type:     "JSON",
+		})
+		if err != nil {
+			t.Fatalf("Search(page=%d, size=%d) error = %v", page, size, err)
+		}
+		return sortedIDs(resp.Laws)
+	}
+
+	// Walking the pages of the date-sorted merge shows each result once, in
+	// the order of a single page holding all of them
+	want := search(1, 410)
+	if len(want) != 410 {
+		t.Fatalf("single page has %d results, want 410", len(want))
+	}
+	var got []string
+	for page := 1; page <= 41; page++ {
+		got = append(got, search(page, 10)...)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("pages of 10 = %v, want %v", got, want)
+	}
+	if want[0] != "N1" || want[1] != "E1" || want[len(want)-1] != "E180" {
+		t.Errorf("merged results = %v..., want newest first across the sources", want[:4])
+	}
+}
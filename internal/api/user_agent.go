@@ -0,0 +1,51 @@
+package api
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// userAgentProduct is the product name of the default User-Agent header
+const userAgentProduct = "warp-cli"
+
+// userAgent is the User-Agent header of the requests of the clients, so that
+// law.go.kr can tell them apart from anonymous traffic
+var userAgent = DefaultUserAgent("dev")
+
+// DefaultUserAgent returns the User-Agent of the given CLI version, such as
+// "warp-cli/1.2.0"
+func DefaultUserAgent(version string) string {
+	version = strings.TrimSpace(version)
+	if version == "" {
+		version = "dev"
+	}
+	return userAgentProduct + "/" + version
+}
+
+// SetUserAgent sets the User-Agent header of the requests of the clients;
+// empty restores the default of the dev version
+func SetUserAgent(ua string) {
+	ua = strings.TrimSpace(ua)
+	if ua == "" {
+		ua = DefaultUserAgent("dev")
+	}
+	userAgent = ua
+}
+
+// UserAgent returns the User-Agent header of the requests of the clients
+func UserAgent() string {
+	return userAgent
+}
+
+// ValidateUserAgent rejects an empty User-Agent and one with control
+// characters, which cannot be sent in a header
+func ValidateUserAgent(ua string) error {
+	if strings.TrimSpace(ua) == "" {
+		return fmt.Errorf("User-Agent가 비어 있습니다")
+	}
+	if strings.ContainsFunc(ua, unicode.IsControl) {
+		return fmt.Errorf("User-Agent에 줄바꿈이나 제어 문자를 넣을 수 없습니다: %q", ua)
+	}
+	return nil
+}
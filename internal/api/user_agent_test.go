@@ -0,0 +1,55 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/testutil"
+)
+
+func TestSearchClientsUserAgent(t *testing.T) {
+	defer SetUserAgent("")
+
+	for _, ua := range []string{DefaultUserAgent("1.2.0"), "warp-cli/1.2.0 (team@example.com)"} {
+		SetUserAgent(ua)
+		for _, tc := range searchClientCases {
+			t.Run(tc.name, func(t *testing.T) {
+				server := testutil.NewAPIServer(t)
+				if _, err := tc.newClient(server).Search(context.Background(), searchClientRequest()); err != nil {
+					t.Fatalf("Search() error = %v", err)
+				}
+				for _, r := range server.Requests() {
+					if got := r.Header.Get("User-Agent"); got != ua {
+						t.Errorf("User-Agent = %q, want %q", got, ua)
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestUserAgent(t *testing.T) {
+	defer SetUserAgent("")
+
+	if got := DefaultUserAgent(" 1.2.0 "); got != "warp-cli/1.2.0" {
+		t.Errorf("DefaultUserAgent() = %q, want warp-cli/1.2.0", got)
+	}
+	if got := DefaultUserAgent(""); got != "warp-cli/dev" {
+		t.Errorf("DefaultUserAgent(\"\") = %q, want warp-cli/dev", got)
+	}
+
+	SetUserAgent("custom/1.0")
+	SetUserAgent("  ")
+	if got := UserAgent(); got != "warp-cli/dev" {
+		t.Errorf("UserAgent() after SetUserAgent(blank) = %q, want the default", got)
+	}
+
+	for _, ua := range []string{"", "warp\r\nX-Injected: 1", "warp\tcli"} {
+		if err := ValidateUserAgent(ua); err == nil {
+			t.Errorf("ValidateUserAgent(%q) = nil, want an error", ua)
+		}
+	}
+	if err := ValidateUserAgent("warp-cli/1.0 (team@example.com)"); err != nil {
+		t.Errorf("ValidateUserAgent() error = %v", err)
+	}
+}
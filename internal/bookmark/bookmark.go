@@ -0,0 +1,175 @@
+// Package bookmark keeps frequently used laws under short aliases, so they can
+// be opened without searching for them again.
+//
+// Each bookmark stores the law ID, its source and when it was added, with the
+// law name, type and department looked up once when adding it.
+package bookmark
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/fileutil"
+)
+
+const (
+	// FileName is the name of the bookmark file inside the config directory
+	FileName = "bookmarks.json"
+)
+
+// lockTimeout is how long Add and Remove wait for another writer
+var lockTimeout = 2 * time.Second
+
+var (
+	// ErrLocked is returned when the bookmark file stays locked by another process
+	ErrLocked = errors.New("bookmark file is locked by another process")
+	// ErrExists is returned when adding an alias that is already bookmarked
+	ErrExists = errors.New("bookmark alias already exists")
+	// ErrNotFound is returned for an alias that is not bookmarked
+	ErrNotFound = errors.New("bookmark not found")
+)
+
+// Bookmark is a law saved under an alias
+type Bookmark struct {
+	Alias      string    `json:"alias"`
+	ID         string    `json:"id"`
+	Source     string    `json:"source"`
+	Name       string    `json:"name,omitempty"`
+	LawType    string    `json:"law_type,omitempty"`
+	Department string    `json:"department,omitempty"`
+	AddedAt    time.Time `json:"added_at"`
+}
+
+// Store reads and writes the bookmark file
+type Store struct {
+	path string
+}
+
+// New creates a store for the bookmark file at path
+func New(path string) *Store {
+	return &Store{path: path}
+}
+
+// Path returns the bookmark file path
+func (s *Store) Path() string {
+	return s.path
+}
+
+// List returns the bookmarks in the order they were added.
+// A missing bookmark file is not an error.
+func (s *Store) List() ([]Bookmark, error) {
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read bookmarks: %w", err)
+	}
+
+	var bookmarks []Bookmark
+	if err := json.Unmarshal(data, &bookmarks); err != nil {
+		return nil, fmt.Errorf("failed to parse bookmarks %s: %w", s.path, err)
+	}
+	return bookmarks, nil
+}
+
+// Get returns the bookmark with the given alias
+func (s *Store) Get(alias string) (Bookmark, error) {
+	bookmarks, err := s.List()
+	if err != nil {
+		return Bookmark{}, err
+	}
+	if i := indexOf(bookmarks, alias); i >= 0 {
+		return bookmarks[i], nil
+	}
+	return Bookmark{}, fmt.Errorf("%w: %s", ErrNotFound, strings.TrimSpace(alias))
+}
+
+// Add saves b. An alias already bookmarked returns ErrExists unless overwrite
+// is set, in which case the bookmark is replaced in place. An empty alias
+// uses the law ID.
+func (s *Store) Add(b Bookmark, overwrite bool) error {
+	b.Alias = strings.TrimSpace(b.Alias)
+	b.ID = strings.TrimSpace(b.ID)
+	if b.ID == "" {
+		return fmt.Errorf("bookmark needs a law ID")
+	}
+	if b.Alias == "" {
+		b.Alias = b.ID
+	}
+	if b.AddedAt.IsZero() {
+		b.AddedAt = time.Now()
+	}
+
+	return s.withLock(func() error {
+		bookmarks, err := s.List()
+		if err != nil {
+			return err
+		}
+		if i := indexOf(bookmarks, b.Alias); i >= 0 {
+			if !overwrite {
+				return fmt.Errorf("%w: %s", ErrExists, b.Alias)
+			}
+			bookmarks[i] = b
+		} else {
+			bookmarks = append(bookmarks, b)
+		}
+		return s.write(bookmarks)
+	})
+}
+
+// Remove deletes the bookmark with the given alias
+func (s *Store) Remove(alias string) error {
+	return s.withLock(func() error {
+		bookmarks, err := s.List()
+		if err != nil {
+			return err
+		}
+		i := indexOf(bookmarks, alias)
+		if i < 0 {
+			return fmt.Errorf("%w: %s", ErrNotFound, strings.TrimSpace(alias))
+		}
+		return s.write(append(bookmarks[:i], bookmarks[i+1:]...))
+	})
+}
+
+// indexOf returns the position of the bookmark with alias, -1 if none
+func indexOf(bookmarks []Bookmark, alias string) int {
+	alias = strings.TrimSpace(alias)
+	for i, b := range bookmarks {
+		if b.Alias == alias {
+			return i
+		}
+	}
+	return -1
+}
+
+// write replaces the bookmark file atomically so readers never see a partial file
+func (s *Store) write(bookmarks []Bookmark) error {
+	if bookmarks == nil {
+		bookmarks = []Bookmark{}
+	}
+	data, err := json.MarshalIndent(bookmarks, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode bookmarks: %w", err)
+	}
+
+	if err := fileutil.WriteAtomic(s.path, data); err != nil {
+		return fmt.Errorf("failed to write bookmarks: %w", err)
+	}
+	return nil
+}
+
+// withLock runs fn while holding an exclusive lock file next to the bookmark
+// file, so concurrent warp processes do not lose each other's bookmarks
+func (s *Store) withLock(fn func() error) error {
+	err := fileutil.WithLock(s.path, lockTimeout, fn)
+	if errors.Is(err, fileutil.ErrLocked) {
+		return ErrLocked
+	}
+	return err
+}
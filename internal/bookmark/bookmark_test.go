@@ -0,0 +1,128 @@
+package bookmark
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+func newTestStore(t *testing.T) *Store {
+	t.Helper()
+	return New(filepath.Join(t.TempDir(), "nested", FileName))
+}
+
+func TestStoreCRUD(t *testing.T) {
+	store := newTestStore(t)
+
+	bookmarks, err := store.List()
+	if err != nil || len(bookmarks) != 0 {
+		t.Fatalf("List() on missing file = %v, %v; want empty", bookmarks, err)
+	}
+
+	at := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	privacy := Bookmark{Alias: " 개보법 ", ID: "011357", Source: "nlic", Name: "개인정보 보호법", AddedAt: at}
+	if err := store.Add(privacy, false); err != nil {
+		t.Fatalf("Add() error: %v", err)
+	}
+	// Without an alias the ID is the alias
+	if err := store.Add(Bookmark{ID: "elis-1", Source: "elis", AddedAt: at.Add(time.Hour)}, false); err != nil {
+		t.Fatalf("Add() without alias error: %v", err)
+	}
+
+	got, err := store.Get("개보법")
+	privacy.Alias = "개보법"
+	if err != nil || !reflect.DeepEqual(got, privacy) {
+		t.Errorf("Get() = %+v, %v; want %+v", got, err, privacy)
+	}
+	if got, err := store.Get("elis-1"); err != nil || got.Source != "elis" {
+		t.Errorf("Get(ID alias) = %+v, %v", got, err)
+	}
+
+	// A taken alias is refused unless overwriting, which keeps its position
+	if err := store.Add(Bookmark{Alias: "개보법", ID: "999"}, false); !errors.Is(err, ErrExists) {
+		t.Errorf("Add() of a taken alias error = %v, want ErrExists", err)
+	}
+	if err := store.Add(Bookmark{Alias: "개보법", ID: "011358", Source: "nlic", AddedAt: at}, true); err != nil {
+		t.Fatalf("Add() overwrite error: %v", err)
+	}
+	bookmarks, _ = store.List()
+	if len(bookmarks) != 2 || bookmarks[0].Alias != "개보법" || bookmarks[0].ID != "011358" || bookmarks[1].Alias != "elis-1" {
+		t.Errorf("List() after overwrite = %+v", bookmarks)
+	}
+
+	if err := store.Remove("개보법"); err != nil {
+		t.Fatalf("Remove() error: %v", err)
+	}
+	if _, err := store.Get("개보법"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get() after Remove error = %v, want ErrNotFound", err)
+	}
+	if err := store.Remove("개보법"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Remove() of a missing alias error = %v, want ErrNotFound", err)
+	}
+	if err := store.Add(Bookmark{Alias: "빈 ID"}, false); err == nil {
+		t.Error("Add() without an ID should fail")
+	}
+}
+
+func TestStorePersistence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), FileName)
+	at := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	want := Bookmark{Alias: "도교법", ID: "001638", Source: "nlic", Name: "도로교통법", LawType: "법률", Department: "경찰청", AddedAt: at}
+	if err := New(path).Add(want, false); err != nil {
+		t.Fatal(err)
+	}
+
+	// Another store, as in a later warp run, reads what the first one wrote
+	bookmarks, err := New(path).List()
+	if err != nil || len(bookmarks) != 1 || !reflect.DeepEqual(bookmarks[0], want) {
+		t.Errorf("List() from a new store = %+v, %v; want %+v", bookmarks, err, want)
+	}
+
+	if err := New(path).Remove("도교법"); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil || string(data) != "[]" {
+		t.Errorf("file after removing the last bookmark = %q, %v; want []", data, err)
+	}
+	if _, err := os.Stat(path + ".lock"); !os.IsNotExist(err) {
+		t.Error("lock file should be removed")
+	}
+
+	if err := os.WriteFile(path, []byte("{broken"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := New(path).List(); err == nil {
+		t.Error("List() of a corrupt file should fail")
+	}
+	// A corrupt file is not overwritten, so bookmarks are never silently lost
+	if err := New(path).Add(want, false); err == nil {
+		t.Error("Add() to a corrupt file should fail")
+	}
+}
+
+func TestStoreConcurrentAdd(t *testing.T) {
+	store := newTestStore(t)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if err := store.Add(Bookmark{Alias: fmt.Sprintf("law%d", i), ID: fmt.Sprint(i)}, false); err != nil {
+				t.Errorf("Add(%d) error: %v", i, err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	bookmarks, err := store.List()
+	if err != nil || len(bookmarks) != 10 {
+		t.Errorf("List() after concurrent adds = %d bookmarks, %v; want 10", len(bookmarks), err)
+	}
+}
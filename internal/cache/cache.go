@@ -0,0 +1,367 @@
+// Package cache stores search responses, law details and histories on disk so
+// that repeated lookups are answered without calling the API. The statistics
+// of merged search results are kept with them, so that they go stale together.
+//
+// Each response is a JSON file in the cache directory named after a hash of
+// its kind, the source and the request. Entries older than the TTL are stale:
+// they are not returned and are replaced by the next lookup. In offline mode
+// stale entries are returned too and nothing else is looked up.
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/logger"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/stats"
+)
+
+const (
+	// DirName is the name of the cache directory inside the config directory
+	DirName = "cache"
+	// DefaultTTL is how long a cached response stays fresh when no TTL is configured
+	DefaultTTL = time.Hour
+)
+
+// Kinds of cached responses, part of their keys
+const (
+	KindSearch  = "search"
+	KindDetail  = "detail"
+	KindHistory = "history"
+	KindStats   = "stats"
+)
+
+// Entry is a cached response: a search response, a law detail or a history,
+// or the statistics of merged search results
+type Entry struct {
+	Key      string              `json:"key"`
+	StoredAt time.Time           `json:"stored_at"`
+	Response *api.SearchResponse `json:"response,omitempty"`
+	Detail   *api.LawDetail      `json:"detail,omitempty"`
+	History  *api.LawHistory     `json:"history,omitempty"`
+	Stats    *stats.Summary      `json:"stats,omitempty"`
+}
+
+// Store reads and writes cached responses in a directory
+type Store struct {
+	dir string
+	ttl time.Duration
+	now func() time.Time
+}
+
+// New creates a store for the cache directory dir whose entries stay fresh
+// for ttl. A ttl of zero or less uses DefaultTTL.
+func New(dir string, ttl time.Duration) *Store {
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+	return &Store{dir: dir, ttl: ttl, now: time.Now}
+}
+
+// Dir returns the cache directory
+func (s *Store) Dir() string {
+	return s.dir
+}
+
+// TTL returns how long entries stay fresh
+func (s *Store) TTL() time.Duration {
+	return s.ttl
+}
+
+// ParseTTL parses a TTL such as "30m" or "2h". A plain number is a number of
+// seconds; 0 turns the cache off.
+func ParseTTL(value string) (time.Duration, error) {
+	value = strings.TrimSpace(value)
+	if seconds, err := strconv.Atoi(value); err == nil {
+		value = fmt.Sprintf("%ds", seconds)
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("잘못된 캐시 유효 시간: %q (예: 30m, 2h, 0은 캐시 끔)", value)
+	}
+	return d, nil
+}
+
+// SearchKey returns the cache key of a search of source with req
+func SearchKey(source string, req *api.UnifiedSearchRequest) string {
+	return key(KindSearch, source, req)
+}
+
+// DetailKey returns the cache key of the detail of lawID from source
+func DetailKey(source, lawID string) string {
+	return key(KindDetail, source, strings.TrimSpace(lawID))
+}
+
+// HistoryKey returns the cache key of the history of lawID from source
+func HistoryKey(source, lawID string) string {
+	return key(KindHistory, source, strings.TrimSpace(lawID))
+}
+
+// StatsKey returns the cache key of the statistics of the results of query,
+// collected in pages of pageSize into the merged file of dir
+func StatsKey(dir, query string, pageSize int) string {
+	return key(KindStats, "", struct {
+		Dir      string `json:"dir"`
+		Query    string `json:"query"`
+		PageSize int    `json:"page_size"`
+	}{dir, query, pageSize})
+}
+
+// key returns the cache key of a lookup of kind from source with request
+func key(kind, source string, request interface{}) string {
+	// A struct marshals its fields and map keys in a fixed order, so equal
+	// requests always hash to the same key
+	data, _ := json.Marshal(struct {
+		Kind    string      `json:"kind"`
+		Source  string      `json:"source"`
+		Request interface{} `json:"request"`
+	}{kind, source, request})
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// Get returns the entry stored for key, fresh or not, or nil if there is none
+func (s *Store) Get(key string) (*Entry, error) {
+	data, err := os.ReadFile(s.path(key))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cache: %w", err)
+	}
+
+	var entry Entry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, fmt.Errorf("failed to parse cache entry %s: %w", s.path(key), err)
+	}
+	if entry.Response == nil && entry.Detail == nil && entry.History == nil && entry.Stats == nil {
+		return nil, fmt.Errorf("cache entry %s has no response", s.path(key))
+	}
+	return &entry, nil
+}
+
+// Fresh reports whether a response younger than the TTL is stored for key
+func (s *Store) Fresh(key string) bool {
+	_, ok := s.Lookup(key)
+	return ok
+}
+
+// Lookup returns the search response stored for key if it is still fresh
+func (s *Store) Lookup(key string) (*api.SearchResponse, bool) {
+	entry, ok := s.lookup(key, false)
+	if !ok || entry.Response == nil {
+		return nil, false
+	}
+	return entry.Response, true
+}
+
+// LookupStats returns the statistics stored for key if they are still fresh
+func (s *Store) LookupStats(key string) (*stats.Summary, bool) {
+	entry, ok := s.lookup(key, false)
+	if !ok || entry.Stats == nil {
+		return nil, false
+	}
+	return entry.Stats, true
+}
+
+// lookup returns the entry stored for key if it is still fresh, or with stale
+// set if it is there at all
+func (s *Store) lookup(key string, stale bool) (*Entry, bool) {
+	entry, err := s.Get(key)
+	if err != nil {
+		// A corrupt entry is a miss; the next lookup replaces it
+		logger.Debug("Ignoring cache entry: %v", err)
+		return nil, false
+	}
+	if entry == nil || (!stale && s.now().Sub(entry.StoredAt) >= s.ttl) {
+		return nil, false
+	}
+	return entry, true
+}
+
+// Put stores the search response resp for key
+func (s *Store) Put(key string, resp *api.SearchResponse) error {
+	return s.put(Entry{Key: key, Response: resp})
+}
+
+// PutStats stores the statistics summary for key
+func (s *Store) PutStats(key string, summary *stats.Summary) error {
+	return s.put(Entry{Key: key, Stats: summary})
+}
+
+// put stores entry under its key
+func (s *Store) put(entry Entry) error {
+	key := entry.Key
+	entry.StoredAt = s.now()
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to encode cache entry: %w", err)
+	}
+
+	if err := os.MkdirAll(s.dir, 0700); err != nil {
+		return fmt.Errorf("failed to create cache directory: %w", err)
+	}
+
+	// Write through a temp file so that concurrent readers never see a partial entry
+	tmp, err := os.CreateTemp(s.dir, key+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to write cache: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write cache: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write cache: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), s.path(key)); err != nil {
+		return fmt.Errorf("failed to write cache: %w", err)
+	}
+	return nil
+}
+
+// path returns the file of the entry for key
+func (s *Store) path(key string) string {
+	return filepath.Join(s.dir, key+".json")
+}
+
+// Searcher is the search method of an API client
+type Searcher interface {
+	Search(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error)
+}
+
+// Client answers searches, details and histories from the store and passes
+// misses to the wrapped searcher, storing its responses. Details and
+// histories are looked up only if the searcher is an api.ClientInterface.
+type Client struct {
+	searcher Searcher
+	store    *Store
+	source   string
+	// offline answers only from the store, stale entries included, and
+	// returns api.ErrOffline for misses
+	offline bool
+}
+
+// NewClient wraps searcher, whose searches go to source, with the store
+func NewClient(searcher Searcher, store *Store, source string) *Client {
+	return &Client{searcher: searcher, store: store, source: source}
+}
+
+// NewOfflineClient returns a client answering the lookups of source from the
+// store only, without calling any API
+func NewOfflineClient(store *Store, source string) *Client {
+	return &Client{store: store, source: source, offline: true}
+}
+
+// Wrapper returns the api.ClientCache that answers the clients of the factory
+// from store. Offline, they are answered from the store only.
+func Wrapper(store *Store, offline bool) api.ClientCache {
+	return func(client api.ClientInterface) api.ClientInterface {
+		if offline {
+			return NewOfflineClient(store, string(client.GetAPIType()))
+		}
+		return NewClient(client, store, string(client.GetAPIType()))
+	}
+}
+
+// Search returns the cached response for req if it is fresh and searches otherwise
+func (c *Client) Search(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+	key := SearchKey(c.source, req)
+	entry, err := c.fetch(key, req.Query, func() (Entry, error) {
+		resp, err := c.searcher.Search(ctx, req)
+		return Entry{Response: resp}, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	// The page size is not stored with the response
+	entry.Response.PageSize = req.PageSize
+	return entry.Response, nil
+}
+
+// GetDetail returns the cached detail of lawID if it is fresh and looks it up otherwise
+func (c *Client) GetDetail(ctx context.Context, lawID string) (*api.LawDetail, error) {
+	entry, err := c.fetch(DetailKey(c.source, lawID), lawID, func() (Entry, error) {
+		client, err := c.client()
+		if err != nil {
+			return Entry{}, err
+		}
+		detail, err := client.GetDetail(ctx, lawID)
+		return Entry{Detail: detail}, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entry.Detail, nil
+}
+
+// GetHistory returns the cached history of lawID if it is fresh and looks it up otherwise
+func (c *Client) GetHistory(ctx context.Context, lawID string) (*api.LawHistory, error) {
+	entry, err := c.fetch(HistoryKey(c.source, lawID), lawID, func() (Entry, error) {
+		client, err := c.client()
+		if err != nil {
+			return Entry{}, err
+		}
+		history, err := client.GetHistory(ctx, lawID)
+		return Entry{History: history}, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entry.History, nil
+}
+
+// GetAPIType returns the API of the source of the client
+func (c *Client) GetAPIType() api.APIType {
+	return api.APIType(c.source)
+}
+
+// fetch returns the entry stored for key, or the one looked up by lookup,
+// which it stores. In offline mode only the store is read.
+func (c *Client) fetch(key, what string, lookup func() (Entry, error)) (*Entry, error) {
+	if entry, ok := c.store.lookup(key, c.offline); ok {
+		logger.Debug("Cache hit for %q (%s)", what, c.source)
+		return entry, nil
+	}
+	if c.offline {
+		return nil, c.miss(what)
+	}
+
+	entry, err := lookup()
+	if err != nil {
+		return nil, err
+	}
+	entry.Key = key
+	// A response that cannot be cached is still a good response
+	if err := c.store.put(entry); err != nil {
+		logger.Debug("Failed to cache response: %v", err)
+	}
+	return &entry, nil
+}
+
+// miss returns the error of a lookup of what that is not in the cache in
+// offline mode
+func (c *Client) miss(what string) error {
+	return fmt.Errorf("%w: %s", api.ErrOffline, what)
+}
+
+// client returns the wrapped searcher as a client for details and histories
+func (c *Client) client() (api.ClientInterface, error) {
+	client, ok := c.searcher.(api.ClientInterface)
+	if !ok {
+		return nil, api.ErrNotImplemented
+	}
+	return client, nil
+}
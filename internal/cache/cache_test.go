@@ -0,0 +1,315 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/stats"
+)
+
+// countingSearcher counts its searches and answers with the query as law name
+type countingSearcher struct {
+	calls int
+	err   error
+}
+
+func (s *countingSearcher) Search(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+	s.calls++
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &api.SearchResponse{TotalCount: 1, Laws: []api.LawInfo{{ID: "001", Name: req.Query}}}, nil
+}
+
+// countingClient is a countingSearcher that also looks up details and histories
+type countingClient struct {
+	countingSearcher
+	details   int
+	histories int
+}
+
+func (c *countingClient) GetDetail(ctx context.Context, lawID string) (*api.LawDetail, error) {
+	c.details++
+	return &api.LawDetail{LawInfo: api.LawInfo{ID: lawID, Name: "개인정보 보호법"}}, nil
+}
+
+func (c *countingClient) GetHistory(ctx context.Context, lawID string) (*api.LawHistory, error) {
+	c.histories++
+	return &api.LawHistory{LawID: lawID, Histories: []api.HistoryRecord{{Date: "20230314", Type: "일부개정"}}}, nil
+}
+
+func (c *countingClient) GetAPIType() api.APIType {
+	return api.APITypeNLIC
+}
+
+func TestSearchKey(t *testing.T) {
+	req := &api.UnifiedSearchRequest{Query: "개인정보", PageNo: 1, PageSize: 50, Type: "XML"}
+	same := &api.UnifiedSearchRequest{Query: "개인정보", PageNo: 1, PageSize: 50, Type: "XML"}
+
+	if SearchKey("nlic", req) != SearchKey("nlic", same) {
+		t.Error("equal requests should have the same key")
+	}
+	if SearchKey("nlic", req) == SearchKey("elis", req) {
+		t.Error("sources should have different keys")
+	}
+	other := &api.UnifiedSearchRequest{Query: "개인정보", PageNo: 2, PageSize: 50, Type: "XML"}
+	if SearchKey("nlic", req) == SearchKey("nlic", other) {
+		t.Error("different pages should have different keys")
+	}
+}
+
+func TestKeysByKind(t *testing.T) {
+	keys := map[string]string{
+		"search":  SearchKey("nlic", &api.UnifiedSearchRequest{Query: "011357"}),
+		"detail":  DetailKey("nlic", "011357"),
+		"history": HistoryKey("nlic", "011357"),
+		"elis":    DetailKey("elis", "011357"),
+	}
+	seen := make(map[string]string)
+	for name, key := range keys {
+		if other, ok := seen[key]; ok {
+			t.Errorf("%s and %s have the same key", name, other)
+		}
+		seen[key] = name
+	}
+	if DetailKey("nlic", " 011357 ") != keys["detail"] {
+		t.Error("detail keys should ignore surrounding spaces of the ID")
+	}
+}
+
+func TestStoreFreshness(t *testing.T) {
+	store := New(t.TempDir(), time.Hour)
+	now := time.Date(2025, 1, 2, 9, 0, 0, 0, time.UTC)
+	store.now = func() time.Time { return now }
+
+	if store.Fresh("k") {
+		t.Fatal("missing entry should not be fresh")
+	}
+	if err := store.Put("k", &api.SearchResponse{TotalCount: 3}); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+
+	now = now.Add(59 * time.Minute)
+	resp, ok := store.Lookup("k")
+	if !ok || resp.TotalCount != 3 {
+		t.Fatalf("Lookup() = %v, %v; want the stored response", resp, ok)
+	}
+
+	now = now.Add(time.Minute)
+	if store.Fresh("k") {
+		t.Error("entry as old as the TTL should be stale")
+	}
+	// A stale entry is still there
+	if entry, err := store.Get("k"); err != nil || entry == nil {
+		t.Errorf("Get() = %v, %v; want the stale entry", entry, err)
+	}
+}
+
+func TestStoreStats(t *testing.T) {
+	store := New(t.TempDir(), time.Hour)
+	now := time.Date(2025, 1, 2, 9, 0, 0, 0, time.UTC)
+	store.now = func() time.Time { return now }
+
+	key := StatsKey("/tmp/pages", "개인정보", 50)
+	if key == StatsKey("/tmp/pages", "개인정보", 20) || key == StatsKey("/tmp/other", "개인정보", 50) {
+		t.Error("statistics of other merged files should have other keys")
+	}
+	summary := stats.Compute([]api.LawInfo{{ID: "001", Department: "개인정보보호위원회", PromulDate: "20230314"}})
+	if err := store.PutStats(key, summary); err != nil {
+		t.Fatalf("PutStats() error = %v", err)
+	}
+	if got, ok := store.LookupStats(key); !ok || !got.Equal(summary) {
+		t.Errorf("LookupStats() = %+v, %v; want the stored statistics", got, ok)
+	}
+	if _, ok := store.Lookup(key); ok {
+		t.Error("statistics should not be a search response")
+	}
+
+	// The statistics go stale with the rest of the cache
+	now = now.Add(time.Hour)
+	if _, ok := store.LookupStats(key); ok {
+		t.Error("statistics as old as the TTL should be stale")
+	}
+}
+
+func TestStoreCorruptEntry(t *testing.T) {
+	dir := t.TempDir()
+	store := New(dir, time.Hour)
+	if err := os.WriteFile(filepath.Join(dir, "k.json"), []byte("{"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := store.Get("k"); err == nil {
+		t.Error("Get() should fail for a corrupt entry")
+	}
+	if store.Fresh("k") {
+		t.Error("corrupt entry should be a miss")
+	}
+}
+
+func TestClientSearch(t *testing.T) {
+	searcher := &countingSearcher{}
+	client := NewClient(searcher, New(t.TempDir(), time.Hour), "nlic")
+	req := &api.UnifiedSearchRequest{Query: "도로교통법", PageNo: 1, PageSize: 10}
+
+	for i := 0; i < 2; i++ {
+		resp, err := client.Search(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Search() error = %v", err)
+		}
+		if len(resp.Laws) != 1 || resp.Laws[0].Name != "도로교통법" {
+			t.Errorf("Search() = %+v", resp)
+		}
+	}
+	if searcher.calls != 1 {
+		t.Errorf("expected 1 API search, got %d", searcher.calls)
+	}
+
+	// Another page is not in the cache
+	if _, err := client.Search(context.Background(), &api.UnifiedSearchRequest{Query: "도로교통법", PageNo: 2, PageSize: 10}); err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if searcher.calls != 2 {
+		t.Errorf("expected 2 API searches, got %d", searcher.calls)
+	}
+}
+
+func TestClientSearchError(t *testing.T) {
+	searcher := &countingSearcher{err: errors.New("boom")}
+	store := New(t.TempDir(), time.Hour)
+	client := NewClient(searcher, store, "nlic")
+	req := &api.UnifiedSearchRequest{Query: "도로교통법"}
+
+	if _, err := client.Search(context.Background(), req); err == nil {
+		t.Fatal("Search() should return the API error")
+	}
+	if store.Fresh(SearchKey("nlic", req)) {
+		t.Error("failed searches should not be cached")
+	}
+}
+
+func TestClientDetailAndHistory(t *testing.T) {
+	client := &countingClient{}
+	cached := NewClient(client, New(t.TempDir(), time.Hour), "nlic")
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		detail, err := cached.GetDetail(ctx, "011357")
+		if err != nil || detail.Name != "개인정보 보호법" {
+			t.Fatalf("GetDetail() = %+v, %v", detail, err)
+		}
+		history, err := cached.GetHistory(ctx, "011357")
+		if err != nil || len(history.Histories) != 1 {
+			t.Fatalf("GetHistory() = %+v, %v", history, err)
+		}
+	}
+	if client.details != 1 || client.histories != 1 {
+		t.Errorf("lookups = %d details, %d histories; want 1 each", client.details, client.histories)
+	}
+	if cached.GetAPIType() != api.APITypeNLIC {
+		t.Errorf("GetAPIType() = %q", cached.GetAPIType())
+	}
+
+	// A searcher without details cannot look them up
+	if _, err := NewClient(&countingSearcher{}, New(t.TempDir(), time.Hour), "nlic").GetDetail(ctx, "1"); !errors.Is(err, api.ErrNotImplemented) {
+		t.Errorf("GetDetail() without a detail client error = %v", err)
+	}
+}
+
+func TestOfflineClient(t *testing.T) {
+	store := New(t.TempDir(), time.Hour)
+	now := time.Date(2025, 1, 2, 9, 0, 0, 0, time.UTC)
+	store.now = func() time.Time { return now }
+	ctx := context.Background()
+	req := &api.UnifiedSearchRequest{Query: "개인정보", PageNo: 1, PageSize: 50}
+
+	online := NewClient(&countingClient{}, store, "nlic")
+	if _, err := online.Search(ctx, req); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := online.GetDetail(ctx, "011357"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Entries past the TTL are still shown offline
+	now = now.Add(48 * time.Hour)
+	offline := NewOfflineClient(store, "nlic")
+	resp, err := offline.Search(ctx, &api.UnifiedSearchRequest{Query: "개인정보", PageNo: 1, PageSize: 50})
+	if err != nil || len(resp.Laws) != 1 {
+		t.Fatalf("offline Search() = %+v, %v; want the cached response", resp, err)
+	}
+	if detail, err := offline.GetDetail(ctx, "011357"); err != nil || detail.ID != "011357" {
+		t.Fatalf("offline GetDetail() = %+v, %v; want the cached detail", detail, err)
+	}
+
+	// Misses are reported without looking anything up
+	misses := []func() error{
+		func() error {
+			_, err := offline.Search(ctx, &api.UnifiedSearchRequest{Query: "도로교통법"})
+			return err
+		},
+		func() error { _, err := offline.GetDetail(ctx, "000001"); return err },
+		func() error { _, err := offline.GetHistory(ctx, "011357"); return err },
+		func() error { _, err := NewOfflineClient(store, "elis").GetDetail(ctx, "011357"); return err },
+	}
+	for i, miss := range misses {
+		if err := miss(); !errors.Is(err, api.ErrOffline) {
+			t.Errorf("miss %d error = %v, want ErrOffline", i, err)
+		}
+	}
+}
+
+func TestWrapper(t *testing.T) {
+	store := New(t.TempDir(), time.Hour)
+	ctx := context.Background()
+	req := &api.UnifiedSearchRequest{Query: "개인정보", PageNo: 1, PageSize: 50}
+
+	searcher := &countingClient{}
+	online := Wrapper(store, false)(searcher)
+	for i := 0; i < 2; i++ {
+		if _, err := online.Search(ctx, req); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if searcher.calls != 1 || online.GetAPIType() != api.APITypeNLIC {
+		t.Errorf("calls = %d, type = %s; want 1 search of nlic", searcher.calls, online.GetAPIType())
+	}
+
+	offlineSearcher := &countingClient{}
+	offline := Wrapper(store, true)(offlineSearcher)
+	if _, err := offline.Search(ctx, req); err != nil {
+		t.Fatalf("offline Search() error = %v, want the cached response", err)
+	}
+	if _, err := offline.GetDetail(ctx, "011357"); !errors.Is(err, api.ErrOffline) || offlineSearcher.details != 0 {
+		t.Errorf("offline GetDetail() error = %v, want ErrOffline without a lookup", err)
+	}
+}
+
+func TestParseTTL(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{"30m", 30 * time.Minute, false},
+		{"2h", 2 * time.Hour, false},
+		{"90", 90 * time.Second, false},
+		{"0", 0, false},
+		{"-1h", 0, true},
+		{"soon", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseTTL(tt.value)
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("ParseTTL(%q) = %v, %v; want %v, error %v", tt.value, got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
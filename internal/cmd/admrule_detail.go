@@ -5,9 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"strings"
-	"time"
 
 	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	cliErrors "github.com/pyhub-apps/pyhub-warp-cli/internal/errors"
 	"github.com/pyhub-apps/pyhub-warp-cli/internal/logger"
 	outputPkg "github.com/pyhub-apps/pyhub-warp-cli/internal/output"
 	"github.com/spf13/cobra"
@@ -69,7 +69,7 @@ func runAdmruleDetailCommand(cmd *cobra.Command, args []string) error {
 	}
 
 	// Get detail with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), api.Timeout())
 	defer cancel()
 
 	detail, err := client.GetDetail(ctx, admrulID)
@@ -79,8 +79,8 @@ func runAdmruleDetailCommand(cmd *cobra.Command, args []string) error {
 		if errors.As(err, &apiKeyErr) {
 			// Print error message without help
 			fmt.Fprintln(output, err.Error())
-			// Return nil to suppress both error message and help
-			return nil
+			// Return it as displayed to suppress both error message and help
+			return cliErrors.Displayed(wrapAPIError(err))
 		}
 
 		logger.Error("Failed to get administrative rule detail: %v", err)
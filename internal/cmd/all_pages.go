@@ -0,0 +1,382 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/cache"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/export"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/i18n"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/logger"
+	outputPkg "github.com/pyhub-apps/pyhub-warp-cli/internal/output"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/stats"
+	"github.com/spf13/cobra"
+)
+
+// maxAllPages bounds the pages --all collects, so a broad query does not run
+// thousands of requests
+const maxAllPages = 200
+
+// allPages holds the --all flags of a law search: collect every page and
+// optionally save each page and the merged results as files
+type allPages struct {
+	all     bool
+	dir     string
+	perPage bool
+	merged  bool
+	append  bool
+
+	// status receives the counts of a streamed collection, which are only
+	// known at the end and would break the streamed data; stderr when nil
+	status io.Writer
+
+	// failed lists the pages of the last collection that could not be fetched
+	failed []int
+}
+
+// addAllPagesFlags registers the --all flags on a law search command
+func addAllPagesFlags(cmd *cobra.Command, p *allPages) {
+	cmd.Flags().BoolVar(&p.all, "all", false, i18n.T("law.flag.all"))
+	cmd.Flags().StringVar(&p.dir, "output-dir", "", i18n.T("law.flag.outputDir"))
+	cmd.Flags().BoolVar(&p.perPage, "per-page", false, i18n.T("law.flag.perPage"))
+	cmd.Flags().BoolVar(&p.merged, "merged", false, i18n.T("law.flag.merged"))
+	cmd.Flags().BoolVar(&p.append, "append", false, i18n.T("law.flag.append"))
+}
+
+// updateAllPagesFlagUsages updates the --all flag descriptions of a law command
+func updateAllPagesFlagUsages(cmd *cobra.Command) {
+	for name, id := range map[string]string{
+		"all":        "law.flag.all",
+		"output-dir": "law.flag.outputDir",
+		"per-page":   "law.flag.perPage",
+		"merged":     "law.flag.merged",
+		"append":     "law.flag.append",
+	} {
+		if flag := cmd.Flags().Lookup(name); flag != nil {
+			flag.Usage = i18n.T(id)
+		}
+	}
+}
+
+// validate checks the combination of the --all flags
+func (p *allPages) validate(cmd *cobra.Command) error {
+	p.failed = nil
+	if !p.all {
+		if p.dir != "" || p.perPage || p.merged || p.append {
+			return fmt.Errorf("--output-dir, --per-page, --merged, --append 옵션은 --all과 함께 사용해야 합니다")
+		}
+		return nil
+	}
+	if p.append && !p.merged {
+		return fmt.Errorf("--append 옵션은 --merged와 함께 사용해야 합니다 (기존 merged.json에 결과 추가)")
+	}
+	if flag := cmd.Flags().Lookup("page"); flag != nil && flag.Changed {
+		return fmt.Errorf("--all 옵션은 --page와 함께 사용할 수 없습니다 (1페이지부터 모두 수집)")
+	}
+	if p.dir == "" && (p.perPage || p.merged) {
+		return fmt.Errorf("--per-page, --merged 옵션은 --output-dir와 함께 사용해야 합니다")
+	}
+	if p.dir != "" && !p.perPage && !p.merged {
+		return fmt.Errorf("--output-dir에 저장할 결과를 --per-page 또는 --merged로 지정하세요")
+	}
+	return nil
+}
+
+// collect fetches every page of the search of rc and returns the results of
+// all pages as one response. The first page must succeed; later pages that
+// fail are noted in the warnings and the saved files and the rest are still
+// collected, see failure. Saved files hold the pages as the API returned them.
+//
+// With onPage set, each page is passed to it as it arrives and the
+// response returned has no laws (unless --merged needs them for its file),
+// so that memory does not grow with the number of pages.
+//
+// The statistics of the merged file are counted page by page and stored with
+// the result cache. With --append the merged file of an earlier collection of
+// the same search is extended: its laws are kept, the laws it lacks are added
+// once and counted into its cached statistics, as if the file had been
+// counted again.
+func (p *allPages) collect(ctx context.Context, client APIClient, rc *api.RequestContext, onPage func(*api.SearchResponse) error) (*api.SearchResponse, error) {
+	p.failed = nil
+	keep := onPage == nil || p.merged
+	count := 0
+
+	base := p.appendBase(rc)
+	summary := stats.New()
+	known := make(map[string]bool)
+	if base != nil {
+		summary = base.Stats
+		for _, law := range base.Laws {
+			known[mergedLawKey(law)] = true
+		}
+	}
+	add := func(merged, resp *api.SearchResponse) error {
+		count += len(resp.Laws)
+		if keep {
+			merged.Laws = append(merged.Laws, resp.Laws...)
+		}
+		if p.merged {
+			fresh := resp.Laws
+			if base != nil {
+				fresh = fresh[:0:0]
+				for _, law := range resp.Laws {
+					if !known[mergedLawKey(law)] {
+						known[mergedLawKey(law)] = true
+						fresh = append(fresh, law)
+					}
+				}
+				base.Laws = append(base.Laws, fresh...)
+			}
+			summary.Add(fresh)
+		}
+		if onPage != nil {
+			return onPage(resp)
+		}
+		return nil
+	}
+	fetchPage := func(page int) (*api.SearchResponse, error) {
+		pageRC := *rc
+		pageRC.Page = page
+		return fetchLaws(ctx, client, &pageRC)
+	}
+
+	first, err := fetchPage(1)
+	if err != nil {
+		return nil, err
+	}
+	pages := 1
+	if rc.Size > 0 {
+		pages = (first.PageableCount() + rc.Size - 1) / rc.Size
+	}
+	if pages < 1 {
+		pages = 1
+	}
+	if pages > maxAllPages {
+		logger.Warn("결과가 많아 %d페이지 중 처음 %d페이지만 수집합니다", pages, maxAllPages)
+		pages = maxAllPages
+	}
+
+	merged := &api.SearchResponse{
+		TotalCount: first.TotalCount,
+		Page:       1,
+		Sources:    first.Sources,
+		Warnings:   first.Warnings,
+	}
+	if err := p.savePage(rc, pages, 1, first, nil); err != nil {
+		return nil, err
+	}
+	if err := add(merged, first); err != nil {
+		return nil, err
+	}
+
+	for page := 2; page <= pages; page++ {
+		resp, err := fetchPage(page)
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if err != nil {
+			logger.Warn("%d페이지 수집 실패: %v", page, err)
+			p.failed = append(p.failed, page)
+			merged.Warnings = append(merged.Warnings, fmt.Sprintf("%d페이지 수집 실패: %v", page, err))
+			if err := p.savePage(rc, pages, page, nil, err); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err := p.savePage(rc, pages, page, resp, nil); err != nil {
+			return nil, err
+		}
+		if err := add(merged, resp); err != nil {
+			return nil, err
+		}
+		// The server may report more results than it pages through
+		if len(resp.Laws) == 0 {
+			pages = page
+			break
+		}
+	}
+	logger.Info("전체 %d페이지 수집 완료: %d개 결과", pages, count)
+
+	if p.merged {
+		laws := merged.Laws
+		if base != nil {
+			laws = base.Laws
+		}
+		path, err := export.SaveMerged(p.dir, &export.MergedFile{
+			Query:       rc.Query,
+			PageSize:    rc.Size,
+			TotalCount:  merged.TotalCount,
+			Pages:       pages,
+			FailedPages: p.failed,
+			Stats:       summary,
+			Laws:        laws,
+		})
+		if err != nil {
+			return nil, err
+		}
+		logger.Info("통합 결과 저장: %s", path)
+		p.storeStats(rc, summary)
+	}
+	return merged, nil
+}
+
+// appendBase returns the merged file that --append extends, or nil to write
+// a new one, with the statistics cached by the collection that wrote it. Once
+// the cache no longer has them, e.g. when they went stale, they are counted
+// again from its laws. A file of another search (query or page size) no
+// longer matches the results, so its laws and statistics are reset.
+func (p *allPages) appendBase(rc *api.RequestContext) *export.MergedFile {
+	if !p.append {
+		return nil
+	}
+	base, err := export.LoadMerged(p.dir)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			logger.Warn("기존 통합 결과를 읽지 못해 새로 작성합니다: %v", err)
+		}
+		return nil
+	}
+	base.Stats = p.cachedStats(rc)
+	if base.Stats == nil {
+		base.Stats = stats.Compute(base.Laws)
+	}
+	if base.Query != rc.Query || base.PageSize != rc.Size {
+		logger.Info("기존 통합 결과는 다른 검색(%q, 크기 %d)의 결과라 통계와 함께 새로 작성합니다", base.Query, base.PageSize)
+		base.Laws = nil
+		base.Stats.Reset()
+	}
+	return base
+}
+
+// statsKey returns the cache key of the statistics of the merged file of rc
+func (p *allPages) statsKey(rc *api.RequestContext) string {
+	dir, err := filepath.Abs(p.dir)
+	if err != nil {
+		dir = p.dir
+	}
+	return cache.StatsKey(dir, rc.Query, rc.Size)
+}
+
+// cachedStats returns the statistics of the merged file of rc if the result
+// cache still has them fresh, nil otherwise
+func (p *allPages) cachedStats(rc *api.RequestContext) *stats.Summary {
+	store := searchCache()
+	if store == nil {
+		return nil
+	}
+	summary, ok := store.LookupStats(p.statsKey(rc))
+	if !ok {
+		return nil
+	}
+	return summary
+}
+
+// storeStats stores the statistics of the merged file of rc with the result
+// cache. Failures are only logged: --append then counts them again.
+func (p *allPages) storeStats(rc *api.RequestContext, summary *stats.Summary) {
+	store := searchCache()
+	if store == nil {
+		return
+	}
+	if err := store.PutStats(p.statsKey(rc), summary); err != nil {
+		logger.Warn("통계를 캐시에 저장하지 못했습니다: %v", err)
+	}
+}
+
+// mergedLawKey identifies a law of the merged file, so that --append adds a
+// law only once
+func mergedLawKey(law api.LawInfo) string {
+	return law.ID + "/" + law.SerialNo
+}
+
+// streams reports whether the pages collected in format are written as they
+// arrive: the format is one of output.StreamFormats and no option needs every
+// result before writing them
+func (p *allPages) streams(format string) bool {
+	return p.all && outputPkg.NewFormatter(format).CanStream() &&
+		!lawCountOnly && !lawQuality && !lawRecords.active() && !lawTree &&
+		!lawFingerprint && !lawSummary && len(lawNotify.notifiers()) == 0
+}
+
+// streamPages returns the stream writing the collected pages to w in format,
+// and the page function of collect that post-processes each page as
+// searchLaws does before writing it
+func (p *allPages) streamPages(ctx context.Context, format string, w io.Writer) (*outputPkg.SearchStream, func(*api.SearchResponse) error, error) {
+	stream, err := outputPkg.NewFormatter(format).
+		WithJSONSchema(lawJSONSchema).
+		WithHanja(lawHanja).
+		NewSearchStream(w)
+	if err != nil {
+		return nil, nil, err
+	}
+	return stream, func(resp *api.SearchResponse) error {
+		resp, err := transformSearchResults(ctx, resp)
+		if err != nil {
+			return err
+		}
+		learnVocabulary(ctx, resp)
+		resp = lawValues.apply(resp)
+		resp, err = lawEffect.apply(resp)
+		if err != nil {
+			return err
+		}
+		return stream.WritePage(resp)
+	}, nil
+}
+
+// reportStream writes the counts of a streamed collection to the status writer
+func (p *allPages) reportStream(resp *api.SearchResponse, stream *outputPkg.SearchStream) {
+	w := p.status
+	if w == nil {
+		w = os.Stderr
+	}
+	fmt.Fprintf(w, "전체 %d개 중 %d개 결과를 출력했습니다\n", resp.TotalCount, stream.Count())
+}
+
+// savePage saves a page fetched by collect when --per-page is set: resp, or
+// the error fetching it
+func (p *allPages) savePage(rc *api.RequestContext, pages, page int, resp *api.SearchResponse, fetchErr error) error {
+	if !p.perPage {
+		return nil
+	}
+	file := &export.PageFile{
+		Query:     rc.Query,
+		Page:      page,
+		PageSize:  rc.Size,
+		Status:    export.PageOK,
+		FetchedAt: time.Now(),
+	}
+	if fetchErr != nil {
+		file.Status = export.PageFailed
+		file.Error = fetchErr.Error()
+	} else {
+		file.TotalCount = resp.TotalCount
+		file.Laws = resp.Laws
+	}
+	path, err := export.SavePage(p.dir, pages, file)
+	if err != nil {
+		return err
+	}
+	logger.Debug("%d페이지 저장: %s", page, path)
+	return nil
+}
+
+// failure returns an error listing the pages the last collection could not
+// fetch, so that a partial collection does not exit successfully
+func (p *allPages) failure() error {
+	if len(p.failed) == 0 {
+		return nil
+	}
+	pages := make([]string, len(p.failed))
+	for i, page := range p.failed {
+		pages[i] = fmt.Sprint(page)
+	}
+	return fmt.Errorf("%d개 페이지를 수집하지 못했습니다: %s페이지", len(p.failed), strings.Join(pages, ", "))
+}
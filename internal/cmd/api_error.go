@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	cliErrors "github.com/pyhub-apps/pyhub-warp-cli/internal/errors"
+)
+
+// wrapAPIError turns an API error of a known kind into a CLIError with a hint
+// on what to do next: set up or check the key, check the network, wait and
+// retry, check the ID, or look the law up once without --offline. Timeouts
+// are handled by wrapTimeout. Other errors are returned unchanged.
+func wrapAPIError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if api.IsTimeout(err) {
+		return wrapTimeout(err)
+	}
+
+	switch {
+	case errors.Is(err, api.ErrOffline):
+		return cliErrors.Wrap(err, cliErrors.New(
+			cliErrors.ErrCodeOffline,
+			err.Error(),
+			"--offline 없이 한 번 조회하면 캐시에 저장되어 오프라인에서도 볼 수 있습니다",
+		))
+	case errors.Is(err, api.ErrNoAPIKey):
+		return cliErrors.Wrap(err, cliErrors.ErrNoAPIKey)
+	case errors.Is(err, api.ErrUnauthorized):
+		return cliErrors.Wrap(err, cliErrors.ErrInvalidAPIKey)
+	case errors.Is(err, api.ErrRateLimited):
+		return cliErrors.Wrap(err, cliErrors.WithHint(cliErrors.ErrRateLimit,
+			"잠시 후 다시 시도하세요. 일괄 조회 중이라면 --rate나 --concurrency 값을 낮추세요"))
+	case errors.Is(err, api.ErrServiceUnavailable):
+		return cliErrors.Wrap(err, cliErrors.WithHint(cliErrors.ErrAPIServerError,
+			"잠시 후 다시 시도하세요. 문제가 계속되면 'warp doctor'로 서비스 상태를 확인하세요"))
+	case api.IsNetworkError(err):
+		return cliErrors.Wrap(err, cliErrors.ErrNoNetwork)
+	case errors.Is(err, api.ErrNotFound):
+		return cliErrors.Wrap(err, cliErrors.New(
+			cliErrors.ErrCodeAPIResponse,
+			"요청한 자료를 찾을 수 없습니다",
+			"ID나 검색어가 올바른지 확인하세요",
+		))
+	}
+	return err
+}
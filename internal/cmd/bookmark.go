@@ -0,0 +1,310 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/bookmark"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/config"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/i18n"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/logger"
+	outputPkg "github.com/pyhub-apps/pyhub-warp-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var (
+	bookmarkCmd       *cobra.Command
+	bookmarkAddCmd    *cobra.Command
+	bookmarkListCmd   *cobra.Command
+	bookmarkOpenCmd   *cobra.Command
+	bookmarkRemoveCmd *cobra.Command
+
+	bookmarkSource   string
+	bookmarkForce    bool
+	bookmarkFormat   string
+	bookmarkArticles bool
+)
+
+// initBookmarkCmd initializes the bookmark command and its subcommands
+func initBookmarkCmd() {
+	bookmarkCmd = &cobra.Command{
+		Use:     "bookmark",
+		Short:   i18n.T("bookmark.short"),
+		Long:    i18n.T("bookmark.long"),
+		Example: i18n.T("bookmark.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return listBookmarks(bookmarkStore(), cmd.OutOrStdout())
+		},
+	}
+
+	bookmarkAddCmd = &cobra.Command{
+		Use:   "add <법령ID> [별칭]",
+		Short: i18n.T("bookmark.add.short"),
+		Args:  cobra.RangeArgs(1, 2),
+		RunE:  runBookmarkAddCommand,
+	}
+	bookmarkAddCmd.Flags().StringVar(&bookmarkSource, "source", "nlic", i18n.T("bookmark.add.flag.source"))
+	bookmarkAddCmd.Flags().BoolVar(&bookmarkForce, "force", false, i18n.T("bookmark.add.flag.force"))
+
+	bookmarkListCmd = &cobra.Command{
+		Use:   "list",
+		Short: i18n.T("bookmark.list.short"),
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return listBookmarks(bookmarkStore(), cmd.OutOrStdout())
+		},
+	}
+
+	bookmarkOpenCmd = &cobra.Command{
+		Use:               "open <별칭>",
+		Short:             i18n.T("bookmark.open.short"),
+		Args:              cobra.ExactArgs(1),
+		RunE:              runBookmarkOpenCommand,
+		ValidArgsFunction: completeBookmarks,
+	}
+	bookmarkOpenCmd.Flags().StringVarP(&bookmarkFormat, "format", "f", "table", i18n.T("law.flag.detailFormat"))
+	bookmarkOpenCmd.Flags().BoolVarP(&bookmarkArticles, "articles", "a", false, i18n.T("law.detail.flag.articles"))
+
+	bookmarkRemoveCmd = &cobra.Command{
+		Use:               "remove <별칭>",
+		Aliases:           []string{"rm"},
+		Short:             i18n.T("bookmark.remove.short"),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeBookmarks,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := bookmarkStore().Remove(args[0]); err != nil {
+				return bookmarkError(err, args[0])
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), i18n.Tf("bookmark.remove.success", strings.TrimSpace(args[0])))
+			return nil
+		},
+	}
+
+	bookmarkCmd.AddCommand(bookmarkAddCmd)
+	bookmarkCmd.AddCommand(bookmarkListCmd)
+	bookmarkCmd.AddCommand(bookmarkOpenCmd)
+	bookmarkCmd.AddCommand(bookmarkRemoveCmd)
+}
+
+// updateBookmarkCommand updates bookmark command descriptions
+func updateBookmarkCommand() {
+	if bookmarkCmd != nil {
+		bookmarkCmd.Short = i18n.T("bookmark.short")
+		bookmarkCmd.Long = i18n.T("bookmark.long")
+		bookmarkCmd.Example = i18n.T("bookmark.example")
+	}
+	if bookmarkAddCmd != nil {
+		bookmarkAddCmd.Short = i18n.T("bookmark.add.short")
+		if flag := bookmarkAddCmd.Flags().Lookup("source"); flag != nil {
+			flag.Usage = i18n.T("bookmark.add.flag.source")
+		}
+		if flag := bookmarkAddCmd.Flags().Lookup("force"); flag != nil {
+			flag.Usage = i18n.T("bookmark.add.flag.force")
+		}
+	}
+	if bookmarkListCmd != nil {
+		bookmarkListCmd.Short = i18n.T("bookmark.list.short")
+	}
+	if bookmarkOpenCmd != nil {
+		bookmarkOpenCmd.Short = i18n.T("bookmark.open.short")
+		if flag := bookmarkOpenCmd.Flags().Lookup("format"); flag != nil {
+			flag.Usage = i18n.T("law.flag.detailFormat")
+		}
+		if flag := bookmarkOpenCmd.Flags().Lookup("articles"); flag != nil {
+			flag.Usage = i18n.T("law.detail.flag.articles")
+		}
+	}
+	if bookmarkRemoveCmd != nil {
+		bookmarkRemoveCmd.Short = i18n.T("bookmark.remove.short")
+	}
+}
+
+// bookmarkStore returns the bookmark store in the config directory
+func bookmarkStore() *bookmark.Store {
+	return bookmark.New(filepath.Join(config.GetConfigDir(), bookmark.FileName))
+}
+
+// bookmarkError describes the errors of the bookmark store for an alias
+func bookmarkError(err error, alias string) error {
+	alias = strings.TrimSpace(alias)
+	switch {
+	case errors.Is(err, bookmark.ErrNotFound):
+		return errors.New(i18n.Tf("bookmark.error.notFound", alias))
+	case errors.Is(err, bookmark.ErrExists):
+		return errors.New(i18n.Tf("bookmark.error.exists", alias))
+	default:
+		return err
+	}
+}
+
+func runBookmarkAddCommand(cmd *cobra.Command, args []string) error {
+	fallback := api.APIType(strings.ToLower(bookmarkSource))
+	if fallback != api.APITypeNLIC && fallback != api.APITypeELIS {
+		return errors.New(i18n.Tf("bookmark.error.source", bookmarkSource))
+	}
+	// IDs written by --ids-only for a unified search carry their source ("elis:456")
+	source, id := api.ParseDetailID(strings.TrimSpace(args[0]), fallback)
+	if id == "" {
+		return errors.New(i18n.T("bookmark.error.emptyID"))
+	}
+	alias := id
+	if len(args) > 1 && strings.TrimSpace(args[1]) != "" {
+		alias = strings.TrimSpace(args[1])
+	}
+
+	store := bookmarkStore()
+	overwrite := bookmarkForce
+	if existing, err := store.Get(alias); err == nil && !overwrite {
+		if !isInteractiveTerminal() {
+			return bookmarkError(bookmark.ErrExists, alias)
+		}
+		question := i18n.Tf("bookmark.add.confirm", alias, bookmarkLabel(existing))
+		if !confirm(cmd.InOrStdin(), cmd.ErrOrStderr(), question) {
+			fmt.Fprintln(cmd.OutOrStdout(), i18n.T("bookmark.add.cancelled"))
+			return nil
+		}
+		overwrite = true
+	} else if err != nil && !errors.Is(err, bookmark.ErrNotFound) {
+		return err
+	}
+
+	b := bookmark.Bookmark{Alias: alias, ID: id, Source: string(source)}
+	if detail, err := fetchBookmarkDetail(source, id); err != nil {
+		// The law name only enriches the list, so a failed lookup keeps the bookmark
+		logger.Warn(i18n.Tf("bookmark.add.lookupFailed", err))
+	} else {
+		b.Name = detail.Name
+		b.LawType = detail.LawType
+		b.Department = detail.Department
+	}
+
+	if err := store.Add(b, overwrite); err != nil {
+		return bookmarkError(err, alias)
+	}
+	fmt.Fprintln(cmd.OutOrStdout(), i18n.Tf("bookmark.add.success", alias, bookmarkLabel(b)))
+	return nil
+}
+
+// fetchBookmarkDetail looks up the law of a bookmark for its name
+func fetchBookmarkDetail(source api.APIType, id string) (*api.LawDetail, error) {
+	var client api.ClientInterface
+	if testDetailClient != nil {
+		client = testDetailClient
+	} else {
+		c, err := api.CreateClient(source)
+		if err != nil {
+			return nil, err
+		}
+		client = c
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), api.Timeout())
+	defer cancel()
+	return client.GetDetail(ctx, id)
+}
+
+// confirm asks question on writer and reports whether the answer read from
+// reader is yes
+func confirm(reader io.Reader, writer io.Writer, question string) bool {
+	fmt.Fprint(writer, question)
+	answer, _ := bufio.NewReader(reader).ReadString('\n')
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes", "예", "네":
+		return true
+	default:
+		return false
+	}
+}
+
+// bookmarkLabel returns the law name of a bookmark with its ID, or the ID alone
+func bookmarkLabel(b bookmark.Bookmark) string {
+	if b.Name == "" {
+		return b.ID
+	}
+	return fmt.Sprintf("%s (%s)", b.Name, b.ID)
+}
+
+// listBookmarks writes the bookmarks in the order they were added
+func listBookmarks(store *bookmark.Store, writer io.Writer) error {
+	bookmarks, err := store.List()
+	if err != nil {
+		return err
+	}
+	if len(bookmarks) == 0 {
+		fmt.Fprintln(writer, i18n.T("bookmark.list.empty"))
+		return nil
+	}
+
+	headers := []string{
+		i18n.T("bookmark.list.alias"),
+		i18n.T("bookmark.list.name"),
+		i18n.T("bookmark.list.id"),
+		i18n.T("bookmark.list.source"),
+		i18n.T("bookmark.list.lawType"),
+		i18n.T("bookmark.list.department"),
+		i18n.T("bookmark.list.addedAt"),
+	}
+	rows := make([][]string, 0, len(bookmarks))
+	for _, b := range bookmarks {
+		rows = append(rows, []string{
+			b.Alias,
+			b.Name,
+			b.ID,
+			b.Source,
+			b.LawType,
+			b.Department,
+			b.AddedAt.Local().Format("2006-01-02"),
+		})
+	}
+	fmt.Fprint(writer, outputPkg.RenderTable(headers, rows, nil))
+	fmt.Fprintln(writer, "\n"+i18n.T("bookmark.list.hint"))
+	return nil
+}
+
+// runBookmarkOpenCommand runs the law detail command for the law of a bookmark
+func runBookmarkOpenCommand(cmd *cobra.Command, args []string) error {
+	b, err := bookmarkStore().Get(args[0])
+	if err != nil {
+		return bookmarkError(err, args[0])
+	}
+
+	id := b.ID
+	if api.APIType(b.Source) == api.APITypeELIS {
+		id = string(api.APITypeELIS) + ":" + id
+	}
+	detailArgs := []string{"law", "detail", id, "--format", bookmarkFormat}
+	if bookmarkArticles {
+		detailArgs = append(detailArgs, "--articles")
+	}
+	logger.Info(i18n.Tf("bookmark.open.running", strings.Join(detailArgs, " ")))
+
+	root := cmd.Root()
+	root.SetArgs(detailArgs)
+	return root.Execute()
+}
+
+// completeBookmarks offers the bookmark aliases with their law names
+func completeBookmarks(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 || config.GetConfigDir() == "" {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	bookmarks, err := bookmarkStore().List()
+	if err != nil {
+		logger.Debug("북마크를 읽을 수 없습니다: %v", err)
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	var aliases []string
+	for _, b := range bookmarks {
+		if strings.HasPrefix(b.Alias, toComplete) {
+			aliases = append(aliases, b.Alias+"\t"+bookmarkLabel(b))
+		}
+	}
+	return aliases, cobra.ShellCompDirectiveNoFileComp
+}
@@ -0,0 +1,167 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/i18n"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/testutil"
+	"github.com/spf13/cobra"
+)
+
+// newBookmarkTestRoot builds a root command with bookmark and law commands
+// and a config directory in a temp dir
+func newBookmarkTestRoot(t *testing.T) *cobra.Command {
+	t.Helper()
+
+	root := newConfiguredTestRoot(t)
+	initLawCmd()
+	initBookmarkCmd()
+	root.AddCommand(lawCmd)
+	root.AddCommand(bookmarkCmd)
+	return root
+}
+
+func TestBookmarkAddListOpen(t *testing.T) {
+	root := newBookmarkTestRoot(t)
+
+	var requested []string
+	testDetailClient = &MockOrdinanceClient{
+		GetDetailFunc: func(ctx context.Context, id string) (*api.LawDetail, error) {
+			requested = append(requested, id)
+			if id == "404" {
+				return nil, errors.New("법령을 찾을 수 없습니다")
+			}
+			return &api.LawDetail{LawInfo: api.LawInfo{ID: id, Name: "개인정보 보호법", LawType: "법률", Department: "개인정보보호위원회"}}, nil
+		},
+	}
+	defer func() { testDetailClient = nil }()
+
+	out, err := testutil.ExecuteCommand(t, root, []string{"bookmark", "add", "011357", "개보법"})
+	if err != nil {
+		t.Fatalf("bookmark add failed: %v", err)
+	}
+	if !strings.Contains(out, "개보법 → 개인정보 보호법 (011357)") {
+		t.Errorf("add output = %q", out)
+	}
+
+	// A failed lookup still saves the bookmark, without a name
+	if _, err := testutil.ExecuteCommand(t, root, []string{"bookmark", "add", "elis:404"}); err != nil {
+		t.Fatalf("bookmark add of an unknown law failed: %v", err)
+	}
+
+	bookmarks, err := bookmarkStore().List()
+	if err != nil || len(bookmarks) != 2 {
+		t.Fatalf("List() = %+v, %v; want 2 bookmarks", bookmarks, err)
+	}
+	if b := bookmarks[0]; b.Alias != "개보법" || b.Source != "nlic" || b.LawType != "법률" || b.Department != "개인정보보호위원회" || b.AddedAt.IsZero() {
+		t.Errorf("bookmark = %+v", b)
+	}
+	if b := bookmarks[1]; b.Alias != "404" || b.ID != "404" || b.Source != "elis" || b.Name != "" {
+		t.Errorf("bookmark without alias = %+v", b)
+	}
+
+	out, err = testutil.ExecuteCommand(t, root, []string{"bookmark", "list"})
+	if err != nil {
+		t.Fatalf("bookmark list failed: %v", err)
+	}
+	for _, want := range []string{"개보법", "개인정보 보호법", "011357", "elis"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("list output should contain %q:\n%s", want, out)
+		}
+	}
+
+	requested = nil
+	out, err = testutil.ExecuteCommand(t, root, []string{"bookmark", "open", "개보법", "--format", "json"})
+	if err != nil {
+		t.Fatalf("bookmark open failed: %v", err)
+	}
+	if len(requested) != 1 || requested[0] != "011357" || !strings.Contains(out, "개인정보 보호법") {
+		t.Errorf("open requested %v, output:\n%s", requested, out)
+	}
+
+	if _, err := testutil.ExecuteCommand(t, root, []string{"bookmark", "open", "없는별칭"}); err == nil || !strings.Contains(err.Error(), "북마크가 없습니다") {
+		t.Errorf("open of a missing alias error = %v", err)
+	}
+
+	if _, err := testutil.ExecuteCommand(t, root, []string{"bookmark", "rm", "404"}); err != nil {
+		t.Fatalf("bookmark rm failed: %v", err)
+	}
+	if bookmarks, _ := bookmarkStore().List(); len(bookmarks) != 1 {
+		t.Errorf("bookmarks after rm = %+v", bookmarks)
+	}
+}
+
+func TestBookmarkDuplicateAlias(t *testing.T) {
+	root := newBookmarkTestRoot(t)
+
+	testDetailClient = &MockOrdinanceClient{
+		GetDetailFunc: func(ctx context.Context, id string) (*api.LawDetail, error) {
+			return &api.LawDetail{LawInfo: api.LawInfo{ID: id, Name: "법령 " + id}}, nil
+		},
+	}
+	defer func() { testDetailClient = nil }()
+
+	origInteractive := isInteractiveTerminal
+	defer func() { isInteractiveTerminal = origInteractive }()
+	isInteractiveTerminal = func() bool { return false }
+
+	if _, err := testutil.ExecuteCommand(t, root, []string{"bookmark", "add", "001", "자주"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Not a terminal: refused
+	_, err := testutil.ExecuteCommand(t, root, []string{"bookmark", "add", "002", "자주"})
+	if err == nil || !strings.Contains(err.Error(), "이미 있는 별칭") {
+		t.Errorf("duplicate alias error = %v", err)
+	}
+
+	// A terminal: the answer decides
+	isInteractiveTerminal = func() bool { return true }
+	root.SetIn(strings.NewReader("n\n"))
+	if _, err := testutil.ExecuteCommand(t, root, []string{"bookmark", "add", "002", "자주"}); err != nil {
+		t.Fatal(err)
+	}
+	if b, _ := bookmarkStore().Get("자주"); b.ID != "001" {
+		t.Errorf("declined overwrite replaced the bookmark: %+v", b)
+	}
+	root.SetIn(strings.NewReader("y\n"))
+	if _, err := testutil.ExecuteCommand(t, root, []string{"bookmark", "add", "002", "자주"}); err != nil {
+		t.Fatal(err)
+	}
+	if b, _ := bookmarkStore().Get("자주"); b.ID != "002" || b.Name != "법령 002" {
+		t.Errorf("confirmed overwrite = %+v", b)
+	}
+
+	// --force overwrites without asking
+	isInteractiveTerminal = func() bool { return false }
+	if _, err := testutil.ExecuteCommand(t, root, []string{"bookmark", "add", "003", "자주", "--force"}); err != nil {
+		t.Fatal(err)
+	}
+	if bookmarks, _ := bookmarkStore().List(); len(bookmarks) != 1 || bookmarks[0].ID != "003" {
+		t.Errorf("bookmarks after --force = %+v", bookmarks)
+	}
+
+	if _, err := testutil.ExecuteCommand(t, root, []string{"bookmark", "add", "004", "--source", "web"}); err == nil {
+		t.Error("unknown --source should fail")
+	}
+}
+
+func TestBookmarkEnglish(t *testing.T) {
+	root := newBookmarkTestRoot(t)
+	if err := i18n.SetLanguage("en"); err != nil {
+		t.Fatal(err)
+	}
+	defer i18n.SetLanguage("ko")
+
+	out, err := testutil.ExecuteCommand(t, root, []string{"bookmark", "list"})
+	if err != nil || !strings.Contains(out, "No bookmarks") {
+		t.Errorf("empty list = %q, %v; want the English message", out, err)
+	}
+	if _, err := testutil.ExecuteCommand(t, root, []string{"bookmark", "open", "missing"}); err == nil || !strings.Contains(err.Error(), "No such bookmark: missing") {
+		t.Errorf("open of a missing bookmark: error = %v, want the English message", err)
+	}
+}
@@ -0,0 +1,32 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	cliErrors "github.com/pyhub-apps/pyhub-warp-cli/internal/errors"
+)
+
+var (
+	// offlineMode is set by --offline: lookups are answered from the cache
+	// only, stale entries included, and no request reaches the network
+	offlineMode bool
+	// cacheDisabled is set by --no-cache: lookups neither read nor fill the cache
+	cacheDisabled bool
+)
+
+// applyCacheMode reads --offline and --no-cache, which cannot be combined:
+// offline mode has nothing but the cache to answer from
+func applyCacheMode(cmd *cobra.Command) error {
+	flags := cmd.Root().PersistentFlags()
+	offlineMode, _ = flags.GetBool("offline")
+	cacheDisabled, _ = flags.GetBool("no-cache")
+	if offlineMode && cacheDisabled {
+		offlineMode, cacheDisabled = false, false
+		return cliErrors.New(
+			cliErrors.ErrCodeInvalidInput,
+			"--offline과 --no-cache는 함께 사용할 수 없습니다",
+			"오프라인 모드는 캐시에 있는 항목만 보여줍니다. 둘 중 하나만 지정하세요",
+		)
+	}
+	return nil
+}
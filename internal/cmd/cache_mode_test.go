@@ -0,0 +1,169 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/cache"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/config"
+	cliErrors "github.com/pyhub-apps/pyhub-warp-cli/internal/errors"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/i18n"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/testutil"
+)
+
+// runRootCommand runs args with the root command and the law commands,
+// returning the output, the error and its exit code
+func runRootCommand(t *testing.T, args ...string) (string, error, int) {
+	t.Helper()
+	initRootCmd()
+	setupFlags()
+	initLawCmd()
+	rootCmd.AddCommand(lawCmd)
+
+	var out bytes.Buffer
+	rootCmd.SetOut(&out)
+	rootCmd.SetErr(&out)
+	rootCmd.SetArgs(append(args, "--no-history"))
+	err := rootCmd.Execute()
+	return out.String(), err, exitCode(err)
+}
+
+func TestOfflineMode(t *testing.T) {
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+	tempDir, cleanup := testutil.CreateTempDir(t, "warp-offline-test-*")
+	t.Cleanup(cleanup)
+	config.ResetConfig()
+	config.SetTestConfigPath(tempDir)
+	if err := config.Initialize(); err != nil {
+		t.Fatalf("Failed to initialize config: %v", err)
+	}
+	t.Setenv(config.EnvVarName("law.nlic.key"), "test-key")
+	t.Cleanup(func() {
+		testAPIClient = nil
+		testDetailClient = nil
+		offlineMode, cacheDisabled = false, false
+		api.SetTransport(nil)
+		config.ResetConfig()
+	})
+
+	// Look the law up online once, filling the cache as the real clients do
+	calls := 0
+	client := &MockOrdinanceClient{
+		SearchFunc: func(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+			calls++
+			return &api.SearchResponse{TotalCount: 1, Laws: []api.LawInfo{{ID: "011357", Name: "개인정보 보호법"}}}, nil
+		},
+		GetDetailFunc: func(ctx context.Context, lawID string) (*api.LawDetail, error) {
+			calls++
+			return &api.LawDetail{LawInfo: api.LawInfo{ID: lawID, Name: "개인정보 보호법"}}, nil
+		},
+		GetHistoryFunc: func(ctx context.Context, lawID string) (*api.LawHistory, error) {
+			calls++
+			return &api.LawHistory{LawID: lawID, LawName: "개인정보 보호법", Histories: []api.HistoryRecord{{Date: "20230314", Type: "일부개정"}}}, nil
+		},
+	}
+	cached := cache.NewClient(client, searchCache(), string(api.APITypeNLIC))
+	testAPIClient, testDetailClient = cached, cached
+	for _, args := range [][]string{
+		{"law", "개인정보", "--format", "json"},
+		{"law", "detail", "011357"},
+		{"law", "history", "011357"},
+	} {
+		if _, err, _ := runRootCommand(t, args...); err != nil {
+			t.Fatalf("%v: %v", args, err)
+		}
+	}
+	if calls != 3 {
+		t.Fatalf("expected 3 API calls online, got %d", calls)
+	}
+
+	// Offline, the real clients answer from the cache without any request
+	testAPIClient, testDetailClient = nil, nil
+	hits := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"--offline", "law", "개인정보", "--format", "json"}, `"011357"`},
+		{[]string{"--offline", "law", "detail", "011357"}, "법령명:       개인정보 보호법"},
+		{[]string{"law", "history", "011357", "--offline"}, "일부개정"},
+	}
+	for _, tt := range hits {
+		out, err, code := runRootCommand(t, tt.args...)
+		if err != nil || code != cliErrors.ExitOK {
+			t.Fatalf("%v: error = %v (exit %d)", tt.args, err, code)
+		}
+		if !strings.Contains(out, tt.want) {
+			t.Errorf("%v: output should contain %q:\n%s", tt.args, tt.want, out)
+		}
+	}
+
+	misses := [][]string{
+		{"--offline", "law", "도로교통법"},
+		{"--offline", "law", "detail", "000001"},
+		{"--offline", "law", "history", "000001"},
+	}
+	for _, args := range misses {
+		_, err, code := runRootCommand(t, args...)
+		if err == nil || !strings.Contains(err.Error(), "오프라인 모드: 캐시에 없음") {
+			t.Errorf("%v: error = %v, want a cache miss", args, err)
+		}
+		if code != cliErrors.ExitNetwork {
+			t.Errorf("%v: exit code = %d, want %d", args, code, cliErrors.ExitNetwork)
+		}
+	}
+	if calls != 3 {
+		t.Errorf("offline mode should not call the API, got %d calls", calls)
+	}
+}
+
+func TestOfflineModeConflicts(t *testing.T) {
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+	tempDir, cleanup := testutil.CreateTempDir(t, "warp-offline-test-*")
+	t.Cleanup(cleanup)
+	config.ResetConfig()
+	config.SetTestConfigPath(tempDir)
+	t.Cleanup(func() {
+		testAPIClient = nil
+		offlineMode, cacheDisabled = false, false
+		api.SetTransport(nil)
+		config.ResetConfig()
+	})
+
+	searches := 0
+	testAPIClient = &mockAPIClient{searchFunc: func(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+		searches++
+		return &api.SearchResponse{TotalCount: 1, Laws: []api.LawInfo{{ID: "011357", Name: "개인정보 보호법"}}}, nil
+	}}
+
+	_, err, code := runRootCommand(t, "--offline", "--no-cache", "law", "개인정보")
+	if err == nil || !strings.Contains(err.Error(), "--offline과 --no-cache는 함께 사용할 수 없습니다") {
+		t.Errorf("error = %v, want the conflict", err)
+	}
+	if code != cliErrors.ExitInvalidInput {
+		t.Errorf("exit code = %d, want %d", code, cliErrors.ExitInvalidInput)
+	}
+	if searches != 0 {
+		t.Errorf("a rejected command should not search, got %d searches", searches)
+	}
+
+	// --no-cache alone turns the cache off for the command
+	if _, err, _ := runRootCommand(t, "--no-cache", "law", "개인정보"); err != nil {
+		t.Fatalf("--no-cache: %v", err)
+	}
+	if searchCache() != nil {
+		t.Error("--no-cache should turn the cache off")
+	}
+	if _, err, _ := runRootCommand(t, "law", "개인정보"); err != nil {
+		t.Fatalf("search: %v", err)
+	}
+	if searchCache() == nil {
+		t.Error("the cache should be on again without --no-cache")
+	}
+}
@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"unicode/utf8"
+
+	cliErrors "github.com/pyhub-apps/pyhub-warp-cli/internal/errors"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/export"
+	"github.com/spf13/cobra"
+)
+
+// clipboardBinaryFormats are output formats that are files rather than text
+// and cannot be pasted
+var clipboardBinaryFormats = map[string]bool{"xlsx": true}
+
+var (
+	// clipboardOutput collects the output of the command with --clipboard,
+	// nil otherwise
+	clipboardOutput *bytes.Buffer
+	// clipboardStdout is where the output goes when it cannot be copied
+	clipboardStdout io.Writer
+	// clipboardRoot is the root command whose output is collected
+	clipboardRoot *cobra.Command
+
+	// copyToClipboard copies text with the clipboard tool of the OS; replaced in tests
+	copyToClipboard = func(text string) error {
+		tool, err := export.FindClipboardTool(runtime.GOOS, os.Getenv, exec.LookPath)
+		if err != nil {
+			return err
+		}
+		return tool.Copy(context.Background(), text)
+	}
+)
+
+// applyClipboard collects the output of the command for --clipboard. Binary
+// formats and the commands that keep talking to the terminal are rejected.
+func applyClipboard(cmd *cobra.Command) error {
+	clipboardOutput, clipboardStdout, clipboardRoot = nil, nil, nil
+	if enabled, _ := cmd.Root().PersistentFlags().GetBool("clipboard"); !enabled {
+		return nil
+	}
+
+	if flag := cmd.Flags().Lookup("format"); flag != nil && clipboardBinaryFormats[strings.ToLower(flag.Value.String())] {
+		return cliErrors.New(
+			cliErrors.ErrCodeInvalidInput,
+			fmt.Sprintf("%s 형식은 클립보드로 복사할 수 없습니다", flag.Value.String()),
+			"파일로 저장하거나 table, markdown, csv 같은 텍스트 형식을 지정하세요",
+		)
+	}
+	if cmd == interactiveCmd || cmd == serveCmd || cmd == ordinanceWatchUICmd {
+		return cliErrors.New(
+			cliErrors.ErrCodeInvalidInput,
+			fmt.Sprintf("%s 명령은 --clipboard와 함께 사용할 수 없습니다", cmd.CommandPath()),
+			"검색이나 상세 조회 명령의 결과를 복사하세요",
+		)
+	}
+
+	clipboardRoot = cmd.Root()
+	clipboardStdout = cmd.OutOrStdout()
+	clipboardOutput = &bytes.Buffer{}
+	clipboardRoot.SetOut(clipboardOutput)
+	return nil
+}
+
+// finishClipboard copies the output collected for --clipboard and reports it
+// to stderr. Output that cannot be copied is written to stdout instead: the
+// output of a failed command, binary output, or any output when no clipboard
+// tool is installed.
+func finishClipboard(stderr io.Writer, cmdErr error) {
+	if clipboardOutput == nil {
+		return
+	}
+	text, stdout := clipboardOutput.String(), clipboardStdout
+	clipboardRoot.SetOut(stdout)
+	clipboardOutput, clipboardStdout, clipboardRoot = nil, nil, nil
+
+	switch {
+	case text == "":
+		return
+	case cmdErr != nil:
+		fmt.Fprint(stdout, text)
+		return
+	case !utf8.ValidString(text):
+		fmt.Fprintln(stderr, "바이너리 출력은 클립보드로 복사할 수 없어 표준 출력으로 내보냅니다")
+		fmt.Fprint(stdout, text)
+		return
+	}
+
+	if err := copyToClipboard(text); err != nil {
+		fmt.Fprintf(stderr, "클립보드 복사 실패: %v\n", err)
+		if errors.Is(err, export.ErrNoClipboardTool) {
+			fmt.Fprintf(stderr, "💡 %s\n", export.ClipboardInstallHint(runtime.GOOS))
+		}
+		fmt.Fprint(stdout, text)
+		return
+	}
+	fmt.Fprintf(stderr, "클립보드에 복사했습니다 (%d줄)\n", strings.Count(strings.TrimRight(text, "\n"), "\n")+1)
+}
@@ -0,0 +1,115 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	cliErrors "github.com/pyhub-apps/pyhub-warp-cli/internal/errors"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/export"
+	"github.com/spf13/cobra"
+)
+
+// clipboardTestCommand returns a command printing out under a root with --clipboard
+func clipboardTestCommand(out string) (*cobra.Command, *cobra.Command) {
+	root := &cobra.Command{Use: "warp"}
+	root.PersistentFlags().BoolP("clipboard", "c", false, "")
+	child := &cobra.Command{
+		Use: "law",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			fmt.Fprint(cmd.OutOrStdout(), out)
+			return nil
+		},
+	}
+	child.Flags().String("format", "table", "")
+	root.AddCommand(child)
+	return root, child
+}
+
+func TestClipboardOutput(t *testing.T) {
+	original := copyToClipboard
+	t.Cleanup(func() { copyToClipboard = original })
+
+	tests := []struct {
+		name       string
+		output     string
+		copyErr    error
+		wantCopied string
+		wantStdout string
+		wantStderr string
+	}{
+		{
+			name:       "copied",
+			output:     "민법\n형법\n",
+			wantCopied: "민법\n형법\n",
+			wantStderr: "클립보드에 복사했습니다 (2줄)",
+		},
+		{
+			name:       "no clipboard tool falls back to stdout",
+			output:     "민법\n",
+			copyErr:    fmt.Errorf("%w (찾은 명령 없음: xclip)", export.ErrNoClipboardTool),
+			wantStdout: "민법\n",
+			wantStderr: "💡 ",
+		},
+		{
+			name:       "binary output falls back to stdout",
+			output:     "PK\x03\x04\xff\xfe",
+			wantStdout: "PK\x03\x04\xff\xfe",
+			wantStderr: "바이너리 출력은 클립보드로 복사할 수 없어",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var copied string
+			copyToClipboard = func(text string) error {
+				if tt.copyErr != nil {
+					return tt.copyErr
+				}
+				copied = text
+				return nil
+			}
+
+			root, child := clipboardTestCommand(tt.output)
+			var stdout, stderr bytes.Buffer
+			root.SetOut(&stdout)
+			root.SetArgs([]string{"law", "--clipboard"})
+			root.PersistentPreRunE = func(cmd *cobra.Command, args []string) error { return applyClipboard(cmd) }
+			err := root.Execute()
+			finishClipboard(&stderr, err)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if copied != tt.wantCopied {
+				t.Errorf("copied %q, want %q", copied, tt.wantCopied)
+			}
+			if stdout.String() != tt.wantStdout {
+				t.Errorf("stdout = %q, want %q", stdout.String(), tt.wantStdout)
+			}
+			if !strings.Contains(stderr.String(), tt.wantStderr) {
+				t.Errorf("stderr should contain %q, got %q", tt.wantStderr, stderr.String())
+			}
+			if child.OutOrStdout() != &stdout {
+				t.Error("the output of the root should be restored")
+			}
+		})
+	}
+}
+
+func TestClipboardRejectsBinaryFormat(t *testing.T) {
+	original := copyToClipboard
+	t.Cleanup(func() { copyToClipboard = original })
+	copyToClipboard = func(string) error { return errors.New("should not be called") }
+
+	root, _ := clipboardTestCommand("PK")
+	root.SetArgs([]string{"law", "-c", "--format", "xlsx"})
+	root.SilenceErrors, root.SilenceUsage = true, true
+	root.PersistentPreRunE = func(cmd *cobra.Command, args []string) error { return applyClipboard(cmd) }
+	err := root.Execute()
+	finishClipboard(&bytes.Buffer{}, err)
+	if exitCode(err) != cliErrors.ExitInvalidInput || !strings.Contains(err.Error(), "xlsx 형식은 클립보드로 복사할 수 없습니다") {
+		t.Errorf("--clipboard with xlsx error = %v, want invalid input", err)
+	}
+}
@@ -0,0 +1,187 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/i18n"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/logger"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/output"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/vocab"
+	"github.com/spf13/cobra"
+)
+
+// completionShells are the shells warp completion writes a script for
+var completionShells = []string{"bash", "zsh", "fish", "powershell"}
+
+// Values of the enum flags, in the order of their help texts
+var (
+	searchFormatValues    = []string{"table", "json", "ndjson", "xml", "markdown", "csv", "html", "html-simple", "fixed", "rss", "atom"}
+	ordinanceFormatValues = []string{"table", "json", "ndjson", "xml", "markdown", "csv", "html", "html-simple", "rss", "atom"}
+	historyFormatValues   = []string{"table", "json", "markdown", "csv", "html", "html-simple"}
+	detailFormatValues    = []string{"table", "markdown", "html", "json", "xml"}
+	simpleFormatValues    = []string{"table", "json"}
+	compareFormatValues   = []string{"table", "markdown", "json"}
+	bookmarkSourceValues  = []string{"nlic", "elis"}
+	lawSourceValues       = []string{"nlic", "elis", "all"}
+	searchSourceValues    = []string{"all", "law", "ordinance"}
+	sortValues            = []string{"relevance", "name", "effectDate", "promulDate", "date"}
+	orderValues           = []string{"asc", "desc"}
+	jsonSchemaValues      = []string{output.SchemaRaw, output.SchemaCanonical}
+	truncateValues        = []string{output.TruncateEllipsis, output.TruncateCut}
+	logFormatValues       = []string{"text", "json"}
+)
+
+// regionValues are the metropolitan governments offered for --region, by
+// their short names with their full names as descriptions. Other regions can
+// still be typed.
+var regionValues = func() []string {
+	values := make([]string, 0, len(api.Regions))
+	for _, region := range api.Regions {
+		values = append(values, region.Aliases[0]+"\t"+region.Name)
+	}
+	return values
+}()
+
+var completionCmd *cobra.Command
+
+// initCompletionCmd initializes the completion command
+func initCompletionCmd() {
+	completionCmd = &cobra.Command{
+		Use:   "completion [bash|zsh|fish|powershell]",
+		Short: "셸 자동완성 스크립트 출력",
+		Long: `지정한 셸의 자동완성 스크립트를 출력합니다.
+명령과 플래그 이름에 더해 --format, --source, --sort 같은 플래그의 값과
+--region의 주요 지자체명도 자동완성됩니다.
+
+Bash (bash-completion 필요):
+  source <(warp completion bash)
+  # 영구 적용 (Linux)
+  warp completion bash > /etc/bash_completion.d/warp
+
+Zsh:
+  warp completion zsh > "${fpath[1]}/_warp"
+
+Fish:
+  warp completion fish > ~/.config/fish/completions/warp.fish
+
+PowerShell:
+  warp completion powershell | Out-String | Invoke-Expression
+
+설정 후 새 셸을 열면 적용됩니다.`,
+		Example: `  # 현재 bash 세션에 적용
+  source <(warp completion bash)
+
+  # zsh 자동완성 파일 생성
+  warp completion zsh > "${fpath[1]}/_warp"`,
+		DisableFlagsInUseLine: true,
+		ValidArgs:             completionShells,
+		Args:                  cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return writeCompletion(cmd.Root(), args[0], cmd.OutOrStdout())
+		},
+	}
+}
+
+// updateCompletionCommand updates completion command descriptions
+func updateCompletionCommand() {
+	if completionCmd != nil {
+		completionCmd.Short = "셸 자동완성 스크립트 출력"
+	}
+}
+
+// writeCompletion writes the completion script of root for shell
+func writeCompletion(root *cobra.Command, shell string, w io.Writer) error {
+	switch shell {
+	case "bash":
+		return root.GenBashCompletionV2(w, true)
+	case "zsh":
+		return root.GenZshCompletion(w)
+	case "fish":
+		return root.GenFishCompletion(w, true)
+	case "powershell":
+		return root.GenPowerShellCompletionWithDesc(w)
+	default:
+		return fmt.Errorf("지원하지 않는 셸: %s (bash, zsh, fish, powershell 중 선택)", shell)
+	}
+}
+
+// registerFlagCompletions registers the values of the enum flags of root and
+// the commands built by their init functions for shell completion
+func registerFlagCompletions(root *cobra.Command) {
+	completeFlag(root, "lang", i18n.Languages()...)
+	completeFlag(root, "log-format", logFormatValues...)
+
+	for _, cmd := range []*cobra.Command{lawCmd, lawSearchCmd} {
+		completeFlag(cmd, "format", searchFormatValues...)
+		completeFlag(cmd, "source", lawSourceValues...)
+		completeFlag(cmd, "json-schema", jsonSchemaValues...)
+		completeFlag(cmd, "truncate", truncateValues...)
+		completeVocabularyFlag(cmd, "department", vocab.KindDepartment)
+		completeVocabularyFlag(cmd, "law-type", vocab.KindLawType)
+	}
+	completeFlag(lawDetailCmd, "format", detailFormatValues...)
+	completeFlag(lawHistoryCmd, "format", historyFormatValues...)
+	completeFlag(lawMapCmd, "format", compareFormatValues...)
+	completeFlag(lawDiffCmd, "format", compareFormatValues...)
+	completeFlag(lawDepartmentsCmd, "format", "table", "json")
+	completeFlag(lawGrepCmd, "format", "table", "json")
+	completeFlag(statsCmd, "format", "table", "json", "prometheus")
+
+	// The ordinance flags are persistent, so this covers its subcommands too
+	completeFlag(ordinanceCmd, "format", ordinanceFormatValues...)
+	completeFlag(ordinanceCmd, "region", regionValues...)
+	completeFlag(ordinanceCmd, "sort", sortValues...)
+	completeFlag(ordinanceCmd, "order", orderValues...)
+	completeFlag(ordinanceCmd, "json-schema", jsonSchemaValues...)
+
+	completeFlag(searchCmd, "format", searchFormatValues...)
+	completeFlag(searchCmd, "source", searchSourceValues...)
+	completeFlag(searchCmd, "region", regionValues...)
+	completeFlag(searchCmd, "sort", sortValues...)
+	completeFlag(searchCmd, "order", orderValues...)
+	completeFlag(searchCmd, "json-schema", jsonSchemaValues...)
+	completeFlag(searchCmd, "truncate", truncateValues...)
+
+	for _, cmd := range []*cobra.Command{
+		precedentSearchCmd, precedentDetailCmd,
+		admruleSearchCmd, admruleDetailCmd,
+		interpretationSearchCmd, interpretationDetailCmd,
+		indexQueryCmd,
+	} {
+		completeFlag(cmd, "format", simpleFormatValues...)
+	}
+
+	completeFlag(prefetchCmd, "source", lawSourceValues...)
+	completeFlag(bookmarkAddCmd, "source", bookmarkSourceValues...)
+	completeFlag(bookmarkOpenCmd, "format", detailFormatValues...)
+
+	for _, cmd := range []*cobra.Command{searchCmd, ordinanceCmd, ordinanceSearchCmd} {
+		if cmd != nil && cmd.Flag("priority-file") != nil {
+			cmd.MarkFlagFilename("priority-file", "yaml", "yml")
+		}
+	}
+}
+
+// completeVocabularyFlag offers the learned values of kind as completions of
+// the flag name of cmd, see completeVocabulary
+func completeVocabularyFlag(cmd *cobra.Command, name, kind string) {
+	if cmd == nil || cmd.Flag(name) == nil {
+		return
+	}
+	if err := cmd.RegisterFlagCompletionFunc(name, completeVocabulary(kind)); err != nil {
+		logger.Debug("Failed to register completion of --%s: %v", name, err)
+	}
+}
+
+// completeFlag offers values as completions of the flag name of cmd. Commands
+// that are not initialized or lack the flag are skipped.
+func completeFlag(cmd *cobra.Command, name string, values ...string) {
+	if cmd == nil || cmd.Flag(name) == nil {
+		return
+	}
+	if err := cmd.RegisterFlagCompletionFunc(name, cobra.FixedCompletions(values, cobra.ShellCompDirectiveNoFileComp)); err != nil {
+		logger.Debug("Failed to register completion of --%s: %v", name, err)
+	}
+}
@@ -0,0 +1,97 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/i18n"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/testutil"
+	"github.com/spf13/cobra"
+)
+
+// newCompletionTestRoot builds a root command with the search commands, the
+// completion command and the flag completions
+func newCompletionTestRoot(t *testing.T) *cobra.Command {
+	t.Helper()
+
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+
+	initLawCmd()
+	initOrdinanceCmd()
+	initSearchCmd()
+	initCompletionCmd()
+
+	root := &cobra.Command{Use: "warp"}
+	root.PersistentFlags().String("lang", "", "")
+	root.AddCommand(lawCmd)
+	root.AddCommand(ordinanceCmd)
+	root.AddCommand(searchCmd)
+	root.AddCommand(completionCmd)
+	registerFlagCompletions(root)
+	return root
+}
+
+func TestCompletionScripts(t *testing.T) {
+	root := newCompletionTestRoot(t)
+
+	for _, shell := range completionShells {
+		t.Run(shell, func(t *testing.T) {
+			output, err := testutil.ExecuteCommand(t, root, []string{"completion", shell})
+			if err != nil {
+				t.Fatalf("completion %s failed: %v", shell, err)
+			}
+			if strings.TrimSpace(output) == "" || !strings.Contains(output, "warp") {
+				t.Errorf("completion %s should write a script for warp, got %q", shell, output)
+			}
+		})
+	}
+
+	if _, err := testutil.ExecuteCommand(t, root, []string{"completion", "tcsh"}); err == nil {
+		t.Error("expected an error for an unsupported shell")
+	}
+}
+
+func TestFlagCompletions(t *testing.T) {
+	root := newCompletionTestRoot(t)
+
+	tests := []struct {
+		name string
+		args []string
+		want []string
+	}{
+		{"law source", []string{"law", "개인정보", "--source", ""}, lawSourceValues},
+		{"law format", []string{"law", "search", "개인정보", "--format", ""}, searchFormatValues},
+		{"search source", []string{"search", "주차", "--source", ""}, searchSourceValues},
+		{"ordinance region", []string{"ordinance", "search", "주차", "--region", ""}, []string{"서울\t서울특별시", "제주\t제주특별자치도"}},
+		{"search region", []string{"search", "주차", "--region", ""}, []string{"경기\t경기도"}},
+		{"json schema", []string{"ordinance", "--json-schema", ""}, jsonSchemaValues},
+		{"lang", []string{"law", "--lang", ""}, []string{"ko", "en"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := testutil.ExecuteCommand(t, root, append([]string{cobra.ShellCompRequestCmd}, tt.args...))
+			if err != nil {
+				t.Fatalf("completion failed: %v", err)
+			}
+			lines := strings.Split(output, "\n")
+			for _, want := range tt.want {
+				found := false
+				for _, line := range lines {
+					if line == want {
+						found = true
+						break
+					}
+				}
+				if !found {
+					t.Errorf("completions %q should include %q", output, want)
+				}
+			}
+			if !strings.Contains(output, "ShellCompDirectiveNoFileComp") {
+				t.Errorf("flag values should not fall back to file names: %q", output)
+			}
+		})
+	}
+}
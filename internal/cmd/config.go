@@ -2,13 +2,20 @@ package cmd
 
 import (
 	"fmt"
+	"io"
+	"strconv"
 	"strings"
+	"unicode"
 
+	"github.com/mattn/go-runewidth"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/cache"
 	"github.com/pyhub-apps/pyhub-warp-cli/internal/config"
 	cliErrors "github.com/pyhub-apps/pyhub-warp-cli/internal/errors"
 	"github.com/pyhub-apps/pyhub-warp-cli/internal/i18n"
 	"github.com/pyhub-apps/pyhub-warp-cli/internal/logger"
 	"github.com/pyhub-apps/pyhub-warp-cli/internal/onboarding"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/security"
 	"github.com/spf13/cobra"
 )
 
@@ -45,8 +52,9 @@ func initConfigSetCmd() {
 			value := strings.TrimSpace(args[1])
 
 			// Validate key format
-			if !isValidConfigKey(key) {
-				return fmt.Errorf(i18n.T("config.set.invalidKey"), key)
+			setting, ok := lookupConfigSetting(key)
+			if !ok {
+				return invalidConfigKeyError("config.set.invalidKey", key)
 			}
 
 			// Validate value is not empty
@@ -54,9 +62,13 @@ func initConfigSetCmd() {
 				return fmt.Errorf(i18n.T("config.set.emptyValue"))
 			}
 
-			// Special handling for API key
-			if key == "law.key" {
-				if err := config.SetAPIKey(value); err != nil {
+			if err := validateConfigValue(setting, value); err != nil {
+				return err
+			}
+
+			// Special handling for API keys
+			if apiKey, ok := apiKeyConfigs[key]; ok {
+				if err := apiKey.set(value); err != nil {
 					return fmt.Errorf(i18n.T("config.set.failed"), err)
 				}
 				guide := onboarding.NewGuideWithWriter(cmd.OutOrStdout(), false)
@@ -95,28 +107,19 @@ func initConfigGetCmd() {
 			// Validate key format
 			if !isValidConfigKey(key) {
 				logger.Error("Invalid config key format: %s", key)
-				return cliErrors.New(
-					cliErrors.ErrCodeInvalidInput,
-					fmt.Sprintf(i18n.T("config.get.invalidKey"), key),
-					i18n.T("config.get.apiKeyHelp"),
-				)
+				return invalidConfigKeyError("config.get.invalidKey", key)
 			}
 
-			// Special handling for API key
-			if key == "law.key" {
-				if !config.IsAPIKeySet() {
+			// Special handling for API keys
+			if apiKeyConfig, ok := apiKeyConfigs[key]; ok {
+				apiKey := apiKeyConfig.get()
+				if apiKey == "" {
 					guide := onboarding.NewGuideWithWriter(cmd.OutOrStdout(), false)
 					guide.ShowAPIKeySetup()
 					return nil
 				}
 
-				apiKey := config.GetAPIKey()
-				// Mask API key for security (show first 10 chars only)
-				if len(apiKey) > 10 {
-					fmt.Fprintf(cmd.OutOrStdout(), "%s: %s...(%d자)\n", key, apiKey[:10], len(apiKey))
-				} else {
-					fmt.Fprintf(cmd.OutOrStdout(), "%s: %s\n", key, apiKey)
-				}
+				fmt.Fprintf(cmd.OutOrStdout(), "%s: %s\n", key, security.MaskSecret(apiKey))
 				return nil
 			}
 
@@ -140,6 +143,89 @@ func initConfigGetCmd() {
 	}
 }
 
+// configUnsetCmd represents the config unset command
+var configUnsetCmd *cobra.Command
+
+// initConfigUnsetCmd initializes the config unset command
+func initConfigUnsetCmd() {
+	configUnsetCmd = &cobra.Command{
+		Use:     "unset <key>",
+		Short:   i18n.T("config.unset.short"),
+		Long:    i18n.T("config.unset.long"),
+		Example: i18n.T("config.unset.example"),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			key := strings.TrimSpace(args[0])
+			if !isValidConfigKey(key) {
+				return invalidConfigKeyError("config.unset.invalidKey", key)
+			}
+
+			removed, err := config.Unset(key)
+			if err != nil {
+				return fmt.Errorf(i18n.T("config.set.saveFailed"), err)
+			}
+			if !removed {
+				fmt.Fprintf(cmd.OutOrStdout(), "❌ %s\n", fmt.Sprintf(i18n.T("config.unset.notSet"), key))
+				return nil
+			}
+
+			guide := onboarding.NewGuideWithWriter(cmd.OutOrStdout(), false)
+			guide.ShowSuccess(fmt.Sprintf(i18n.T("config.unset.success"), key))
+			// An environment variable still provides a value
+			if config.Source(key) == config.SourceEnv {
+				fmt.Fprintln(cmd.OutOrStdout(), fmt.Sprintf(i18n.T("config.unset.envOverride"), config.EnvVarName(key)))
+			}
+			return nil
+		},
+	}
+}
+
+// configResetCmd represents the config reset command
+var (
+	configResetCmd *cobra.Command
+	configResetYes bool
+)
+
+// initConfigResetCmd initializes the config reset command
+func initConfigResetCmd() {
+	configResetCmd = &cobra.Command{
+		Use:     "reset",
+		Short:   i18n.T("config.reset.short"),
+		Long:    i18n.T("config.reset.long"),
+		Example: i18n.T("config.reset.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !configResetYes {
+				if !isInteractiveTerminal() {
+					return cliErrors.New(
+						cliErrors.ErrCodeInvalidInput,
+						i18n.T("config.reset.needYes"),
+						i18n.T("config.reset.needYesHint"),
+					)
+				}
+				question := fmt.Sprintf(i18n.T("config.reset.confirm"), config.GetConfigPath())
+				if !confirm(cmd.InOrStdin(), cmd.ErrOrStderr(), question) {
+					fmt.Fprintln(cmd.OutOrStdout(), i18n.T("config.reset.cancelled"))
+					return nil
+				}
+			}
+
+			backup, err := config.Reset()
+			if err != nil {
+				return fmt.Errorf(i18n.T("config.set.saveFailed"), err)
+			}
+			guide := onboarding.NewGuideWithWriter(cmd.OutOrStdout(), false)
+			guide.ShowSuccess(i18n.T("config.reset.success"))
+			if backup != "" {
+				fmt.Fprintln(cmd.OutOrStdout(), fmt.Sprintf(i18n.T("config.reset.backup"), backup))
+			}
+			return nil
+		},
+	}
+
+	configResetCmd.Flags().BoolVarP(&configResetYes, "yes", "y", false, i18n.T("config.reset.flag.yes"))
+}
+
 // configPathCmd represents the config path command
 var configPathCmd *cobra.Command
 
@@ -157,24 +243,272 @@ func initConfigPathCmd() {
 	}
 }
 
-// isValidConfigKey validates the configuration key format
+// configListCmd represents the config list command
+var configListCmd *cobra.Command
+
+// initConfigListCmd initializes the config list command
+func initConfigListCmd() {
+	configListCmd = &cobra.Command{
+		Use:   "list",
+		Short: i18n.T("config.list.short"),
+		Long:  i18n.T("config.list.long"),
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return writeConfigList(cmd.OutOrStdout())
+		},
+	}
+}
+
+// configProfileCmd represents the config profile command
+var configProfileCmd *cobra.Command
+
+// initConfigProfileCmd initializes the config profile command and its subcommands
+func initConfigProfileCmd() {
+	configProfileCmd = &cobra.Command{
+		Use:     "profile",
+		Short:   i18n.T("config.profile.short"),
+		Long:    i18n.T("config.profile.long"),
+		Example: i18n.T("config.profile.example"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// If no subcommand is provided, show help
+			return cmd.Help()
+		},
+	}
+
+	configProfileListCmd := &cobra.Command{
+		Use:   "list",
+		Short: i18n.T("config.profile.list.short"),
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			profiles := config.ListProfiles()
+			if len(profiles) == 0 {
+				fmt.Fprintln(cmd.OutOrStdout(), i18n.T("config.profile.list.empty"))
+				return nil
+			}
+
+			active := config.GetActiveProfile()
+			for _, name := range profiles {
+				marker := " "
+				if name == active {
+					marker = "*"
+				}
+				fmt.Fprintf(cmd.OutOrStdout(), "%s %s\n", marker, name)
+			}
+			if active == "" {
+				fmt.Fprintln(cmd.OutOrStdout(), i18n.T("config.profile.list.none"))
+			}
+			return nil
+		},
+	}
+
+	configProfileUseCmd := &cobra.Command{
+		Use:   "use <name>",
+		Short: i18n.T("config.profile.use.short"),
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := strings.TrimSpace(args[0])
+			if !config.HasProfile(name) {
+				return fmt.Errorf(i18n.T("config.profile.use.notFound"), name)
+			}
+			if err := config.UseProfile(name); err != nil {
+				return fmt.Errorf(i18n.T("config.profile.use.failed"), err)
+			}
+
+			guide := onboarding.NewGuideWithWriter(cmd.OutOrStdout(), false)
+			guide.ShowSuccess(fmt.Sprintf(i18n.T("config.profile.use.success"), strings.ToLower(name)))
+			return nil
+		},
+	}
+
+	configProfileCmd.AddCommand(configProfileListCmd)
+	configProfileCmd.AddCommand(configProfileUseCmd)
+}
+
+// apiKeyConfig binds an API key config entry to its accessors
+type apiKeyConfig struct {
+	get func() string
+	set func(string) error
+}
+
+// apiKeyConfigs lists the config keys that hold API keys
+var apiKeyConfigs = map[string]apiKeyConfig{
+	"law.key":      {get: config.GetAPIKey, set: config.SetAPIKey},
+	"law.nlic.key": {get: config.GetNLICAPIKey, set: config.SetNLICAPIKey},
+	"law.elis.key": {get: config.GetELISAPIKey, set: config.SetELISAPIKey},
+}
+
+// configSetting describes a config key accepted by config set: the format of
+// its values, its default, and how a value is checked before it is saved
+type configSetting struct {
+	key      string
+	kind     string
+	def      string
+	validate func(string) error
+}
+
+// configSchema lists the config keys, in the order of config list
+var configSchema = []configSetting{
+	{key: "law.key", kind: "API 키 (공백 없음)", validate: validateAPIKey},
+	{key: "law.nlic.key", kind: "API 키 (공백 없음)", validate: validateAPIKey},
+	{key: "law.elis.key", kind: "API 키 (공백 없음)", validate: validateAPIKey},
+	{key: "history.size", kind: "양의 정수", def: strconv.Itoa(config.DefaultHistorySize), validate: func(value string) error {
+		if size, err := strconv.Atoi(value); err != nil || size <= 0 {
+			return fmt.Errorf("양의 정수를 입력하세요: %s", value)
+		}
+		return nil
+	}},
+	{key: "api.timeout", kind: "기간 (예: 45s, 2m)", def: api.DefaultTimeout.String(), validate: func(value string) error {
+		_, err := api.ParseTimeout(value)
+		return err
+	}},
+	{key: "api.proxy", kind: "프록시 URL", validate: func(value string) error {
+		_, err := api.ParseProxyURL(value)
+		return err
+	}},
+	{key: "api.ca_cert", kind: "PEM 파일 경로", validate: func(value string) error {
+		_, err := api.NewTransport(api.TransportOptions{CACert: value})
+		return err
+	}},
+	{key: "api.user_agent", kind: "User-Agent 헤더", def: "warp-cli/<버전>", validate: api.ValidateUserAgent},
+	{key: "cache.ttl", kind: "기간 (예: 2h, 0은 캐시 끔)", def: cache.DefaultTTL.String(), validate: func(value string) error {
+		_, err := cache.ParseTTL(value)
+		return err
+	}},
+	{key: "log.file", kind: "파일 경로"},
+	{key: "log.maxsize", kind: "크기 (예: 20MB)", def: "10MB", validate: func(value string) error {
+		_, err := logger.ParseSize(value)
+		return err
+	}},
+	{key: "log.format", kind: "text 또는 json", def: "text", validate: func(value string) error {
+		_, err := logger.ParseFormat(value)
+		return err
+	}},
+}
+
+// validateAPIKey rejects API keys with whitespace, usually a paste gone wrong
+func validateAPIKey(value string) error {
+	if strings.ContainsFunc(value, unicode.IsSpace) {
+		return fmt.Errorf("API 키에는 공백을 넣을 수 없습니다")
+	}
+	return nil
+}
+
+// lookupConfigSetting returns the schema entry of key
+func lookupConfigSetting(key string) (configSetting, bool) {
+	for _, setting := range configSchema {
+		if setting.key == key {
+			return setting, true
+		}
+	}
+	return configSetting{}, false
+}
+
+// isValidConfigKey reports whether key is in the config schema. Keys under a
+// valid key, such as law.key.extra, are typos and rejected.
 func isValidConfigKey(key string) bool {
-	// Currently only support law.key
-	// Can be extended for more keys in the future
-	validKeys := []string{
-		"law.key",
+	_, ok := lookupConfigSetting(key)
+	return ok
+}
+
+// configKeys returns the keys of the config schema
+func configKeys() []string {
+	keys := make([]string, 0, len(configSchema))
+	for _, setting := range configSchema {
+		keys = append(keys, setting.key)
+	}
+	return keys
+}
+
+// invalidConfigKeyError reports a key missing from the config schema, with
+// the keys that can be used
+func invalidConfigKeyError(format, key string) error {
+	return cliErrors.New(
+		cliErrors.ErrCodeInvalidInput,
+		fmt.Sprintf(i18n.T(format), key),
+		fmt.Sprintf(i18n.T("config.allowedKeys"), strings.Join(configKeys(), ", ")),
+	)
+}
+
+// validateConfigValue checks value against the schema entry of key
+func validateConfigValue(setting configSetting, value string) error {
+	if setting.validate == nil {
+		return nil
+	}
+	if err := setting.validate(value); err != nil {
+		return cliErrors.New(
+			cliErrors.ErrCodeInvalidInput,
+			fmt.Sprintf(i18n.T("config.set.invalidValue"), setting.key, err),
+			fmt.Sprintf(i18n.T("config.set.valueFormat"), setting.key, setting.kind),
+		)
+	}
+	return nil
+}
+
+// configSourceLabels names where the value of a setting comes from
+var configSourceLabels = map[string]string{
+	config.SourceEnv:     "config.list.sourceEnv",
+	config.SourceProfile: "config.list.sourceProfile",
+	config.SourceFile:    "config.list.sourceFile",
+	config.SourceDefault: "config.list.sourceDefault",
+}
+
+// writeConfigList writes the effective value of every setting with its
+// default and where it comes from. API keys are masked.
+func writeConfigList(w io.Writer) error {
+	rows := [][]string{{i18n.T("config.list.key"), i18n.T("config.list.value"), i18n.T("config.list.default"), i18n.T("config.list.source")}}
+	for _, setting := range configSchema {
+		value := config.GetString(setting.key)
+		if apiKey, ok := apiKeyConfigs[setting.key]; ok {
+			value = security.MaskSecret(apiKey.get())
+		}
+		if value == "" {
+			value = setting.def
+		}
+		source := config.Source(setting.key)
+		label := i18n.T(configSourceLabels[source])
+		switch source {
+		case config.SourceEnv:
+			label = fmt.Sprintf(label, config.EnvVarName(setting.key))
+		case config.SourceProfile:
+			label = fmt.Sprintf(label, config.GetActiveProfile())
+		}
+		rows = append(rows, []string{setting.key, dashIfEmpty(value), dashIfEmpty(setting.def), label})
 	}
+	return writeColumns(w, rows)
+}
 
-	for _, validKey := range validKeys {
-		if key == validKey {
-			return true
+// writeColumns writes rows as columns lined up after the widest cell, the
+// last column unpadded. Korean text is wider than its rune count, so the
+// columns are padded by display width.
+func writeColumns(w io.Writer, rows [][]string) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	widths := make([]int, len(rows[0])-1)
+	for _, row := range rows {
+		for i := range widths {
+			widths[i] = max(widths[i], runewidth.StringWidth(row[i]))
+		}
+	}
+	for _, row := range rows {
+		var b strings.Builder
+		for i, cell := range row[:len(widths)] {
+			b.WriteString(runewidth.FillRight(cell, widths[i]) + "  ")
 		}
-		// Check if it's a prefix match for nested keys
-		if strings.HasPrefix(key, validKey+".") {
-			return true
+		b.WriteString(row[len(widths)])
+		if _, err := fmt.Fprintln(w, b.String()); err != nil {
+			return err
 		}
 	}
-	return false
+	return nil
+}
+
+// dashIfEmpty returns "-" for an empty value, so that the columns of config list line up
+func dashIfEmpty(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
 }
 
 // updateConfigCommand updates config command descriptions
@@ -194,10 +528,32 @@ func updateConfigCommand() {
 		configGetCmd.Long = i18n.T("config.get.long")
 		configGetCmd.Example = i18n.T("config.get.example")
 	}
+	if configUnsetCmd != nil {
+		configUnsetCmd.Short = i18n.T("config.unset.short")
+		configUnsetCmd.Long = i18n.T("config.unset.long")
+		configUnsetCmd.Example = i18n.T("config.unset.example")
+	}
+	if configResetCmd != nil {
+		configResetCmd.Short = i18n.T("config.reset.short")
+		configResetCmd.Long = i18n.T("config.reset.long")
+		configResetCmd.Example = i18n.T("config.reset.example")
+		if flag := configResetCmd.Flags().Lookup("yes"); flag != nil {
+			flag.Usage = i18n.T("config.reset.flag.yes")
+		}
+	}
 	if configPathCmd != nil {
 		configPathCmd.Short = i18n.T("config.path.short")
 		configPathCmd.Long = i18n.T("config.path.long")
 	}
+	if configListCmd != nil {
+		configListCmd.Short = i18n.T("config.list.short")
+		configListCmd.Long = i18n.T("config.list.long")
+	}
+	if configProfileCmd != nil {
+		configProfileCmd.Short = i18n.T("config.profile.short")
+		configProfileCmd.Long = i18n.T("config.profile.long")
+		configProfileCmd.Example = i18n.T("config.profile.example")
+	}
 }
 
 func init() {
@@ -2,11 +2,13 @@ package cmd
 
 import (
 	"bytes"
+	"os"
 	"path/filepath"
 	"strings"
 	"testing"
 
 	"github.com/pyhub-apps/pyhub-warp-cli/internal/config"
+	cliErrors "github.com/pyhub-apps/pyhub-warp-cli/internal/errors"
 	"github.com/pyhub-apps/pyhub-warp-cli/internal/i18n"
 	"github.com/pyhub-apps/pyhub-warp-cli/internal/testutil"
 	"github.com/spf13/cobra"
@@ -151,6 +153,60 @@ func TestConfigSetCommand(t *testing.T) {
 			wantErr:    false,
 			wantOutput: "API 키가 성공적으로 설정",
 		},
+		{
+			name:       "Valid NLIC API key",
+			args:       []string{"config", "set", "law.nlic.key", "test-nlic-key"},
+			wantErr:    false,
+			wantOutput: "API 키가 성공적으로 설정",
+		},
+		{
+			name:       "Valid ELIS API key",
+			args:       []string{"config", "set", "law.elis.key", "test-elis-key"},
+			wantErr:    false,
+			wantOutput: "API 키가 성공적으로 설정",
+		},
+		{
+			name:       "Valid history size",
+			args:       []string{"config", "set", "history.size", "100"},
+			wantErr:    false,
+			wantOutput: "history.size",
+		},
+		{
+			name:        "Invalid history size",
+			args:        []string{"config", "set", "history.size", "many"},
+			wantErr:     true,
+			errContains: "양의 정수",
+		},
+		{
+			name:        "Nested key under a valid key",
+			args:        []string{"config", "set", "law.key.extra", "value"},
+			wantErr:     true,
+			errContains: "허용되는 키: law.key, law.nlic.key",
+		},
+		{
+			name:        "API key with whitespace inside",
+			args:        []string{"config", "set", "law.key", "abc def"},
+			wantErr:     true,
+			errContains: "공백을 넣을 수 없습니다",
+		},
+		{
+			name:       "Valid timeout",
+			args:       []string{"config", "set", "api.timeout", "45s"},
+			wantErr:    false,
+			wantOutput: "api.timeout = 45s",
+		},
+		{
+			name:        "Invalid timeout",
+			args:        []string{"config", "set", "api.timeout", "soon"},
+			wantErr:     true,
+			errContains: "api.timeout 값은 기간 (예: 45s, 2m) 형식이어야 합니다",
+		},
+		{
+			name:        "Invalid log format",
+			args:        []string{"config", "set", "log.format", "xml"},
+			wantErr:     true,
+			errContains: "잘못된 설정값: log.format",
+		},
 	}
 
 	for _, tt := range tests {
@@ -254,7 +310,7 @@ func TestConfigGetCommand(t *testing.T) {
 			},
 			args:       []string{"config", "get", "law.key"},
 			wantErr:    false,
-			wantOutput: "law.key: test-api-k",
+			wantOutput: "law.key: te***(18자)",
 		},
 		{
 			name: "Short API key",
@@ -263,7 +319,31 @@ func TestConfigGetCommand(t *testing.T) {
 			},
 			args:       []string{"config", "get", "law.key"},
 			wantErr:    false,
-			wantOutput: "law.key: short",
+			wantOutput: "law.key: sh***(5자)",
+		},
+		{
+			name:       "ELIS API key not set",
+			args:       []string{"config", "get", "law.elis.key"},
+			wantErr:    false,
+			wantOutput: "API 설정이 필요",
+		},
+		{
+			name: "ELIS API key set",
+			setup: func() {
+				config.SetELISAPIKey("elis-api-key-67890")
+			},
+			args:       []string{"config", "get", "law.elis.key"},
+			wantErr:    false,
+			wantOutput: "law.elis.key: el***(18자)",
+		},
+		{
+			name: "NLIC API key set",
+			setup: func() {
+				config.SetNLICAPIKey("nlic-api-key-67890")
+			},
+			args:       []string{"config", "get", "law.nlic.key"},
+			wantErr:    false,
+			wantOutput: "law.nlic.key: nl***(18자)",
 		},
 	}
 
@@ -309,10 +389,140 @@ func TestConfigGetCommand(t *testing.T) {
 
 			// Reset for next test
 			config.SetAPIKey("")
+			config.SetELISAPIKey("")
+		})
+	}
+}
+
+func TestConfigSetAPIKeys(t *testing.T) {
+	// Initialize i18n for testing (Korean by default)
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+
+	// Initialize config commands
+	initConfigCmd()
+	initConfigSetCmd()
+	configCmd.AddCommand(configSetCmd)
+
+	tests := []struct {
+		key      string
+		value    string
+		wantNLIC string
+		wantELIS string
+	}{
+		{key: "law.key", value: "legacy-key", wantNLIC: "legacy-key"},
+		{key: "law.nlic.key", value: "nlic-key", wantNLIC: "nlic-key"},
+		{key: "law.elis.key", value: "elis-key", wantELIS: "elis-key"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			tempDir, cleanup := testutil.CreateTempDir(t, "warp-cmd-test-*")
+			defer cleanup()
+
+			config.ResetConfig()
+			config.SetTestConfigPath(tempDir)
+			if err := config.Initialize(); err != nil {
+				t.Fatalf("Failed to initialize config: %v", err)
+			}
+
+			cmd := &cobra.Command{Use: "test"}
+			cmd.AddCommand(configCmd)
+			var buf bytes.Buffer
+			cmd.SetOut(&buf)
+			cmd.SetErr(&buf)
+			cmd.SetArgs([]string{"config", "set", tt.key, tt.value})
+
+			if err := cmd.Execute(); err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+
+			if got := config.GetNLICAPIKey(); got != tt.wantNLIC {
+				t.Errorf("NLIC key = %q, want %q", got, tt.wantNLIC)
+			}
+			if got := config.GetELISAPIKey(); got != tt.wantELIS {
+				t.Errorf("ELIS key = %q, want %q", got, tt.wantELIS)
+			}
 		})
 	}
 }
 
+func TestConfigProfileCommand(t *testing.T) {
+	// Initialize i18n for testing (Korean by default)
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+
+	// Initialize config commands
+	initConfigCmd()
+	initConfigProfileCmd()
+	configCmd.AddCommand(configProfileCmd)
+
+	tempDir, cleanup := testutil.CreateTempDir(t, "warp-cmd-test-*")
+	defer cleanup()
+	t.Setenv(config.ProfileEnvVar, "")
+
+	config.ResetConfig()
+	config.SetTestConfigPath(tempDir)
+	if err := config.Initialize(); err != nil {
+		t.Fatalf("Failed to initialize config: %v", err)
+	}
+
+	run := func(args ...string) (string, error) {
+		cmd := &cobra.Command{Use: "test"}
+		cmd.AddCommand(configCmd)
+		var buf bytes.Buffer
+		cmd.SetOut(&buf)
+		cmd.SetErr(&buf)
+		cmd.SetArgs(args)
+		err := cmd.Execute()
+		return buf.String(), err
+	}
+
+	// No profiles yet
+	output, err := run("config", "profile", "list")
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if !strings.Contains(output, "정의된 프로파일이 없습니다") {
+		t.Errorf("Output should report no profiles, got %q", output)
+	}
+
+	// Using an undefined profile fails
+	if _, err := run("config", "profile", "use", "work"); err == nil || !strings.Contains(err.Error(), "프로파일을 찾을 수 없습니다") {
+		t.Errorf("Expected profile not found error, got %v", err)
+	}
+
+	// Create profiles by setting keys under them
+	for _, name := range []string{"work", "personal"} {
+		config.SetProfile(name)
+		if err := config.SetAPIKey(name + "-key"); err != nil {
+			t.Fatalf("SetAPIKey() error = %v", err)
+		}
+	}
+	config.SetProfile("")
+
+	output, err = run("config", "profile", "use", "work")
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if !strings.Contains(output, "work") {
+		t.Errorf("Output should mention the profile, got %q", output)
+	}
+
+	output, err = run("config", "profile", "list")
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if !strings.Contains(output, "* work") || !strings.Contains(output, "  personal") {
+		t.Errorf("Output should list profiles with the active one marked, got %q", output)
+	}
+	if got := config.GetAPIKey(); got != "work-key" {
+		t.Errorf("GetAPIKey() = %q, want %q", got, "work-key")
+	}
+}
+
 func TestConfigPathCommand(t *testing.T) {
 	// Initialize i18n for testing (Korean by default)
 	if err := i18n.Init(); err != nil {
@@ -379,7 +589,13 @@ func TestIsValidConfigKey(t *testing.T) {
 		valid bool
 	}{
 		{"law.key", true},
-		{"law.key.extra", true}, // Nested under valid key
+		{"law.key.extra", false}, // Nested under a valid key is a typo
+		{"law.nlic.key", true},
+		{"law.elis.key", true},
+		{"history.size", true},
+		{"api.timeout", true},
+		{"log.format", true},
+		{"law.elis", false},
 		{"invalid", false},
 		{"invalid.key", false},
 		{"law", false},
@@ -397,3 +613,173 @@ func TestIsValidConfigKey(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigListCommand(t *testing.T) {
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+
+	initConfigCmd()
+	initConfigListCmd()
+	configCmd.AddCommand(configListCmd)
+
+	tempDir, cleanup := testutil.CreateTempDir(t, "warp-cmd-test-*")
+	defer cleanup()
+	config.ResetConfig()
+	config.SetTestConfigPath(tempDir)
+	if err := config.Initialize(); err != nil {
+		t.Fatalf("Failed to initialize config: %v", err)
+	}
+	t.Cleanup(config.ResetConfig)
+
+	if err := config.SetNLICAPIKey("nlic-secret-key-123"); err != nil {
+		t.Fatal(err)
+	}
+	config.Set("cache.ttl", "2h")
+	if err := config.Save(); err != nil {
+		t.Fatal(err)
+	}
+	// Read the saved file again, as the next command does
+	config.ResetConfig()
+	config.SetTestConfigPath(tempDir)
+	if err := config.Initialize(); err != nil {
+		t.Fatalf("Failed to initialize config: %v", err)
+	}
+	t.Setenv(config.EnvVarName("api.timeout"), "90s")
+
+	cmd := &cobra.Command{Use: "test"}
+	cmd.AddCommand(configCmd)
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetArgs([]string{"config", "list"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	rows := map[string][]string{}
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n")[1:] {
+		fields := strings.Fields(line)
+		rows[fields[0]] = fields[1:]
+	}
+	if len(rows) != len(configSchema) {
+		t.Errorf("config list shows %d keys, want %d:\n%s", len(rows), len(configSchema), buf.String())
+	}
+
+	tests := []struct {
+		key  string
+		want []string
+	}{
+		{"api.timeout", []string{"90s", "30s", "환경변수", "WARP_API_TIMEOUT"}},
+		{"cache.ttl", []string{"2h", "1h0m0s", "설정", "파일"}},
+		{"log.format", []string{"text", "text", "기본값"}},
+		{"log.file", []string{"-", "-", "기본값"}},
+	}
+	for _, tt := range tests {
+		if got := rows[tt.key]; strings.Join(got, " ") != strings.Join(tt.want, " ") {
+			t.Errorf("%s row = %v, want %v", tt.key, got, tt.want)
+		}
+	}
+
+	if strings.Contains(buf.String(), "nlic-secret-key-123") {
+		t.Errorf("config list should mask API keys:\n%s", buf.String())
+	}
+	if row := rows["law.nlic.key"]; len(row) == 0 || row[len(row)-1] != "파일" {
+		t.Errorf("law.nlic.key row = %v, want a masked value from the config file", row)
+	}
+}
+
+func TestConfigUnsetResetCommand(t *testing.T) {
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+	origInteractive := isInteractiveTerminal
+	defer func() { isInteractiveTerminal = origInteractive }()
+
+	tempDir, cleanup := testutil.CreateTempDir(t, "warp-cmd-test-*")
+	defer cleanup()
+	config.ResetConfig()
+	config.SetTestConfigPath(tempDir)
+	if err := config.Initialize(); err != nil {
+		t.Fatalf("Failed to initialize config: %v", err)
+	}
+	t.Cleanup(config.ResetConfig)
+
+	run := func(stdin string, args ...string) (string, error) {
+		initConfigCmd()
+		initConfigSetCmd()
+		initConfigUnsetCmd()
+		initConfigResetCmd()
+		configCmd.AddCommand(configSetCmd, configUnsetCmd, configResetCmd)
+		cmd := &cobra.Command{Use: "test"}
+		cmd.AddCommand(configCmd)
+		var buf bytes.Buffer
+		cmd.SetOut(&buf)
+		cmd.SetErr(&buf)
+		cmd.SetIn(strings.NewReader(stdin))
+		cmd.SetArgs(args)
+		err := cmd.Execute()
+		return buf.String(), err
+	}
+	fileContent := func() string {
+		data, err := os.ReadFile(config.GetConfigPath())
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(data)
+	}
+
+	if _, err := run("", "config", "set", "api.timeout", "45s"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := run("", "config", "set", "law.key", "secret-key"); err != nil {
+		t.Fatal(err)
+	}
+
+	// unset removes the key from the file
+	out, err := run("", "config", "unset", "api.timeout")
+	if err != nil || !strings.Contains(out, "설정을 삭제했습니다: api.timeout") {
+		t.Fatalf("unset: %q, %v", out, err)
+	}
+	if content := fileContent(); strings.Contains(content, "timeout") || !strings.Contains(content, "secret-key") {
+		t.Errorf("only api.timeout should leave the file:\n%s", content)
+	}
+
+	// A key without a value is reported, an unknown key rejected
+	out, err = run("", "config", "unset", "api.timeout")
+	if err != nil || !strings.Contains(out, "설정되어 있지 않은 키입니다: api.timeout") {
+		t.Errorf("unset of a key without a value: %q, %v", out, err)
+	}
+	if _, err := run("", "config", "unset", "api.timeuot"); exitCode(err) != cliErrors.ExitInvalidInput {
+		t.Errorf("unset of an unknown key: %v, want invalid input", err)
+	}
+
+	// reset asks first, and needs --yes outside a terminal
+	isInteractiveTerminal = func() bool { return false }
+	if _, err := run("", "config", "reset"); exitCode(err) != cliErrors.ExitInvalidInput || !strings.Contains(err.Error(), "--yes") {
+		t.Errorf("reset without a terminal: %v, want a hint to --yes", err)
+	}
+	isInteractiveTerminal = func() bool { return true }
+	out, err = run("n\n", "config", "reset")
+	if err != nil || !strings.Contains(out, "[y/N]") || !strings.Contains(fileContent(), "secret-key") {
+		t.Errorf("declined reset: %q, %v", out, err)
+	}
+	before := fileContent()
+	out, err = run("y\n", "config", "reset")
+	if err != nil || !strings.Contains(out, "기본값으로 초기화했습니다") {
+		t.Fatalf("reset: %q, %v", out, err)
+	}
+	if content := fileContent(); strings.Contains(content, "secret-key") || !strings.Contains(content, "# Warp CLI Configuration") {
+		t.Errorf("the file should be the default config:\n%s", content)
+	}
+	if backup, err := os.ReadFile(config.GetConfigPath() + config.ResetBackupSuffix); err != nil || string(backup) != before {
+		t.Errorf("backup = %q, %v; want the file before the reset", backup, err)
+	}
+	if config.GetAPIKey() != "" {
+		t.Errorf("API key after reset = %q", config.GetAPIKey())
+	}
+
+	isInteractiveTerminal = func() bool { return false }
+	if _, err := run("", "config", "reset", "--yes"); err != nil {
+		t.Errorf("reset --yes: %v", err)
+	}
+}
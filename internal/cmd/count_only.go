@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+// countOnlyPageSize is the page size requested by --count-only: the total
+// count comes with any page, so one result keeps the response small
+const countOnlyPageSize = 1
+
+// searchCount is the json output of --count-only
+type searchCount struct {
+	Count   int           `json:"count"`
+	Sources []sourceCount `json:"sources,omitempty"`
+}
+
+// sourceCount is the count of one source of a unified search
+type sourceCount struct {
+	Source string `json:"source"`
+	Label  string `json:"label"`
+	Status string `json:"status"`
+	Count  int    `json:"count"`
+}
+
+// validateCountOnly checks the flags combined with --count-only. combined
+// reports whether an option that writes or filters the results was also given.
+func validateCountOnly(countOnly bool, format string, combined bool) error {
+	if !countOnly {
+		return nil
+	}
+	if format != "table" && format != "json" {
+		return fmt.Errorf("--count-only 옵션은 table(숫자) 또는 json 형식에서만 사용할 수 있습니다")
+	}
+	if combined {
+		return fmt.Errorf("--count-only 옵션은 결과를 출력하거나 거르는 옵션(--pluck, --ids-only, --tree, --quality-report, --fingerprint, --all, --upcoming, --in-force)과 함께 사용할 수 없습니다")
+	}
+	return nil
+}
+
+// writeSearchCount writes the total count of resp, as a plain number or as
+// {"count":N} in json. A unified search also writes the count of each source.
+func writeSearchCount(w io.Writer, format string, resp *api.SearchResponse) error {
+	count := searchCount{Count: resp.TotalCount}
+	for _, s := range resp.Sources {
+		count.Sources = append(count.Sources, sourceCount{Source: s.Source, Label: s.Label, Status: s.Status, Count: s.TotalCount})
+	}
+
+	if format == "json" {
+		data, err := json.Marshal(count)
+		if err != nil {
+			return fmt.Errorf("JSON 변환 실패: %w", err)
+		}
+		fmt.Fprintln(w, string(data))
+		return nil
+	}
+
+	if len(count.Sources) == 0 {
+		fmt.Fprintln(w, count.Count)
+		return nil
+	}
+	var b strings.Builder
+	for _, s := range count.Sources {
+		fmt.Fprintf(&b, "%s\t%d", s.Label, s.Count)
+		if s.Status == api.SourceFailed {
+			b.WriteString("\t(조회 실패)")
+		}
+		b.WriteString("\n")
+	}
+	fmt.Fprintf(&b, "합계\t%d\n", count.Count)
+	fmt.Fprint(w, b.String())
+	return nil
+}
@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	outputPkg "github.com/pyhub-apps/pyhub-warp-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var (
+	doctorCmd *cobra.Command
+
+	// doctorBaseURL allows pointing the diagnostics at a test server
+	doctorBaseURL = api.BaseURL
+)
+
+// initDoctorCmd initializes the doctor command
+func initDoctorCmd() {
+	doctorCmd = &cobra.Command{
+		Use:   "doctor",
+		Short: "API 키 및 연결 상태 진단",
+		Long: `설정된 API 키로 국가법령(NLIC)과 자치법규(ELIS)에 1건짜리 테스트 검색을 보내
+네트워크 연결, HTTP 상태, HTML 에러 페이지 여부, 응답 파싱 성공 여부를 진단합니다.
+
+하나라도 실패하면 종료 코드 1을 반환하므로 CI에서 사전 점검용으로 사용할 수 있습니다.`,
+		Example: `  # 설정 진단
+  warp doctor`,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			keys := map[api.APIType]string{
+				api.APITypeNLIC: api.DefaultAPIKeyProvider(api.APITypeNLIC),
+				api.APITypeELIS: api.DefaultAPIKeyProvider(api.APITypeELIS),
+			}
+			return runDoctor(context.Background(), &http.Client{Timeout: api.Timeout(), Transport: api.Transport()}, doctorBaseURL, keys, cmd.OutOrStdout())
+		},
+	}
+}
+
+// updateDoctorCommand updates doctor command descriptions
+func updateDoctorCommand() {
+	if doctorCmd != nil {
+		doctorCmd.Short = "API 키 및 연결 상태 진단"
+	}
+}
+
+// runDoctor runs all diagnostic checks, prints a result table and fails if any check failed
+func runDoctor(ctx context.Context, httpClient *http.Client, baseURL string, keys map[api.APIType]string, writer io.Writer) error {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	results := []api.ProbeResult{api.ProbeConnectivity(ctx, httpClient, baseURL)}
+	if results[0].OK {
+		results = append(results,
+			api.ProbeSearch(ctx, httpClient, baseURL, keys[api.APITypeNLIC], api.ProbeNLIC),
+			api.ProbeSearch(ctx, httpClient, baseURL, keys[api.APITypeELIS], api.ProbeELIS),
+		)
+	}
+
+	headers := []string{"항목", "상태", "HTTP", "응답시간", "상세"}
+	rows := make([][]string, 0, len(results))
+	failed := 0
+	for _, r := range results {
+		status := "✅"
+		detail := "정상"
+		if !r.OK {
+			status = "❌"
+			detail = r.Problem
+			if r.Hint != "" {
+				detail += "\n→ " + r.Hint
+			}
+			failed++
+		}
+
+		httpStatus := "-"
+		if r.StatusCode != 0 {
+			httpStatus = fmt.Sprintf("%d", r.StatusCode)
+		}
+
+		rows = append(rows, []string{r.Name, status, httpStatus, r.Elapsed.Round(time.Millisecond).String(), detail})
+	}
+
+	fmt.Fprint(writer, outputPkg.RenderTable(headers, rows, nil))
+
+	if failed > 0 {
+		if !results[0].OK {
+			fmt.Fprintln(writer, "\n네트워크 연결에 실패하여 API 검사를 건너뛰었습니다.")
+		}
+		return fmt.Errorf("진단 실패: %d개 항목에서 문제가 발견되었습니다", failed)
+	}
+
+	fmt.Fprintln(writer, "\n모든 검사를 통과했습니다.")
+	return nil
+}
+
+func init() {
+	// Doctor command will be initialized and added in Execute()
+}
@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+func TestRunDoctor(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("OC") == "bad-key" {
+			w.Write([]byte(`<html><body>페이지 접속에 실패하였습니다</body></html>`))
+			return
+		}
+		switch r.URL.Query().Get("target") {
+		case "law":
+			w.Write([]byte(`{"LawSearch": {"totalCnt": "1"}}`))
+		case "ordin":
+			w.Write([]byte(`{"OrdinSearch": {"resultCode": "00"}}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	client := &http.Client{Timeout: 5 * time.Second}
+
+	tests := []struct {
+		name       string
+		baseURL    string
+		keys       map[api.APIType]string
+		wantErr    bool
+		wantOutput []string
+	}{
+		{
+			name:       "All checks pass",
+			baseURL:    server.URL,
+			keys:       map[api.APIType]string{api.APITypeNLIC: "good-key", api.APITypeELIS: "good-key"},
+			wantOutput: []string{"✅", "모든 검사를 통과했습니다"},
+		},
+		{
+			name:       "ELIS key rejected",
+			baseURL:    server.URL,
+			keys:       map[api.APIType]string{api.APITypeNLIC: "good-key", api.APITypeELIS: "bad-key"},
+			wantErr:    true,
+			wantOutput: []string{"❌", "HTML 에러 페이지", "API 접속 실패"},
+		},
+		{
+			name:       "No keys",
+			baseURL:    server.URL,
+			keys:       map[api.APIType]string{},
+			wantErr:    true,
+			wantOutput: []string{"API 키가 설정되지 않았습니다"},
+		},
+		{
+			name:       "Network failure skips API checks",
+			baseURL:    "http://127.0.0.1:1",
+			keys:       map[api.APIType]string{api.APITypeNLIC: "good-key"},
+			wantErr:    true,
+			wantOutput: []string{"네트워크 연결", "API 검사를 건너뛰었습니다"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := runDoctor(context.Background(), client, tt.baseURL, tt.keys, &buf)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("runDoctor() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			for _, want := range tt.wantOutput {
+				if !strings.Contains(buf.String(), want) {
+					t.Errorf("Output should contain %q, got %q", want, buf.String())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/i18n"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/logger"
+	"github.com/spf13/cobra"
+)
+
+// effectNow returns the current time; replaced in tests
+var effectNow = time.Now
+
+// effectFilter holds the --upcoming, --in-force and --as-of flag values
+type effectFilter struct {
+	upcoming bool
+	inForce  bool
+	asOf     string
+}
+
+// active reports whether a status filter was requested
+func (f *effectFilter) active() bool {
+	return f.upcoming || f.inForce
+}
+
+// validate checks the flag combination and returns the requested status and reference date
+func (f *effectFilter) validate() (api.EffectStatus, time.Time, error) {
+	if f.upcoming && f.inForce {
+		return api.EffectUnknown, time.Time{}, fmt.Errorf("--upcoming과 --in-force는 함께 사용할 수 없습니다")
+	}
+	if f.asOf != "" && !f.active() {
+		return api.EffectUnknown, time.Time{}, fmt.Errorf("--as-of는 --upcoming 또는 --in-force와 함께 사용하세요")
+	}
+
+	asOf := effectNow()
+	if f.asOf != "" {
+		parsed, err := api.ParseEffectDate(f.asOf)
+		if err != nil {
+			return api.EffectUnknown, time.Time{}, fmt.Errorf("잘못된 기준 날짜: %s (YYYYMMDD 형식으로 입력하세요)", f.asOf)
+		}
+		asOf = parsed
+	}
+
+	status := api.EffectInForce
+	if f.upcoming {
+		status = api.EffectUpcoming
+	}
+	return status, asOf, nil
+}
+
+// apply keeps only the laws matching the requested status. Laws whose effective date
+// cannot be determined are excluded and reported separately as a warning.
+func (f *effectFilter) apply(resp *api.SearchResponse) (*api.SearchResponse, error) {
+	if !f.active() {
+		return resp, nil
+	}
+	status, asOf, err := f.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	laws, unknown := api.FilterLawsByEffect(resp.Laws, status, asOf)
+	logger.Info("시행일 필터 (%s, 기준일 %s): 현재 페이지 %d개 중 %d개", status, asOf.Format(api.EffectDateLayout), len(resp.Laws), len(laws))
+	if unknown > 0 {
+		logger.Warn("시행일을 판정할 수 없는 %d개 결과를 제외했습니다 (%s)", unknown, api.EffectUnknown)
+	}
+
+	filtered := *resp
+	filtered.Laws = laws
+	filtered.TotalCount = len(laws)
+	filtered.FetchedCount = 0
+	return &filtered, nil
+}
+
+// updateEffectFlagUsages updates the effective date filter flag descriptions of a law command
+func updateEffectFlagUsages(cmd *cobra.Command) {
+	if flag := cmd.Flags().Lookup("upcoming"); flag != nil {
+		flag.Usage = i18n.T("law.flag.upcoming")
+	}
+	if flag := cmd.Flags().Lookup("in-force"); flag != nil {
+		flag.Usage = i18n.T("law.flag.inForce")
+	}
+	if flag := cmd.Flags().Lookup("as-of"); flag != nil {
+		flag.Usage = i18n.T("law.flag.asOf")
+	}
+}
@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	cliErrors "github.com/pyhub-apps/pyhub-warp-cli/internal/errors"
+	"github.com/spf13/cobra"
+)
+
+// exitCode returns the exit code of the error a command ended with, as
+// cliErrors.ExitCode. API errors returned without a CLIError are classified
+// by their kind first, as wrapAPIError does for the messages.
+func exitCode(err error) int {
+	var cliErr *cliErrors.CLIError
+	if err != nil && !errors.As(err, &cliErr) {
+		err = wrapAPIError(err)
+	}
+	return cliErrors.ExitCode(err)
+}
+
+// flagError turns an unknown flag or invalid flag value into an input error
+func flagError(cmd *cobra.Command, err error) error {
+	return cliErrors.Wrap(err, cliErrors.New(cliErrors.ErrCodeInvalidInput, err.Error(), ""))
+}
+
+// noteResults records in the RequestContext of ctx whether the search found nothing
+func noteResults(ctx context.Context, resp *api.SearchResponse) {
+	if rc, ok := api.RequestContextFrom(ctx); ok {
+		rc.Empty = resp.TotalCount == 0
+	}
+}
+
+// searchFoundNothing reports whether the search run by ctx found nothing
+func searchFoundNothing(ctx context.Context) bool {
+	rc, ok := api.RequestContextFrom(ctx)
+	return ok && rc.Empty
+}
+
+// failOnEmpty returns ErrNoResults when --fail-on-empty is given and the
+// search found nothing, nil otherwise. The output already says there are no
+// results, so the error only sets the exit code.
+func failOnEmpty(cmd *cobra.Command, empty bool) error {
+	if fail, _ := cmd.Root().PersistentFlags().GetBool("fail-on-empty"); fail && empty {
+		return cliErrors.Displayed(cliErrors.ErrNoResults)
+	}
+	return nil
+}
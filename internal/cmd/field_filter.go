@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"strings"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	cliErrors "github.com/pyhub-apps/pyhub-warp-cli/internal/errors"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/i18n"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/logger"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// fieldFilters holds the --filter flag values of a search, combined with AND
+type fieldFilters struct {
+	exprs []string
+}
+
+// addFieldFilterFlags registers the --filter flag on a search command
+func addFieldFilterFlags(cmd *cobra.Command, f *fieldFilters) {
+	cmd.Flags().StringArrayVar(&f.exprs, "filter", nil, i18n.T("law.flag.filter"))
+}
+
+// updateFieldFilterFlagUsages updates the --filter flag description
+func updateFieldFilterFlagUsages(cmd *cobra.Command) {
+	if flag := cmd.Flags().Lookup("filter"); flag != nil {
+		flag.Usage = i18n.T("law.flag.filter")
+	}
+}
+
+// active reports whether a filter was requested
+func (f *fieldFilters) active() bool {
+	return len(f.exprs) > 0
+}
+
+// parse parses the expressions, rejecting an unknown field or operator and an
+// invalid regular expression
+func (f *fieldFilters) parse() ([]output.FieldFilter, error) {
+	filters := make([]output.FieldFilter, 0, len(f.exprs))
+	for _, expr := range f.exprs {
+		filter, err := output.ParseFieldFilter(expr)
+		if err != nil {
+			return nil, cliErrors.New(
+				cliErrors.ErrCodeInvalidInput,
+				err.Error(),
+				"예: --filter 'department=~복지부$' --filter 'name=개인정보' (여러 번 지정하면 모두 만족하는 결과만 남김)",
+			)
+		}
+		filters = append(filters, filter)
+	}
+	return filters, nil
+}
+
+// validate checks the expressions before a search runs
+func (f *fieldFilters) validate() error {
+	_, err := f.parse()
+	return err
+}
+
+// apply keeps only the laws satisfying every filter. The filters run on the
+// results of the page, which is logged.
+func (f *fieldFilters) apply(resp *api.SearchResponse) (*api.SearchResponse, error) {
+	if !f.active() {
+		return resp, nil
+	}
+	filters, err := f.parse()
+	if err != nil {
+		return nil, err
+	}
+
+	laws := output.FilterLaws(resp.Laws, filters)
+	exprs := make([]string, len(filters))
+	for i, filter := range filters {
+		exprs[i] = filter.String()
+	}
+	logger.Info("필드 필터 (%s): 현재 페이지 %d개 중 %d개", strings.Join(exprs, " AND "), len(resp.Laws), len(laws))
+
+	filtered := *resp
+	filtered.Laws = laws
+	filtered.TotalCount = len(laws)
+	filtered.FetchedCount = 0
+	return &filtered, nil
+}
@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/i18n"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/watch"
+)
+
+// validateFingerprint checks that --fingerprint is used with an output that
+// can carry it: a line after the table, or the meta of json, ndjson and xml
+func validateFingerprint(fingerprint bool, format string, records recordOutput, tree, quality bool) error {
+	if !fingerprint {
+		return nil
+	}
+	switch format {
+	case "table", "json", "ndjson", "xml":
+	default:
+		return fmt.Errorf("--fingerprint 옵션은 table, json, ndjson, xml 형식에서만 사용할 수 있습니다")
+	}
+	if records.active() || tree || quality {
+		return fmt.Errorf("--fingerprint 옵션은 --pluck, --ids-only, --tree, --quality-report와 함께 사용할 수 없습니다")
+	}
+	return nil
+}
+
+// setFingerprint sets the fingerprint of the results of resp, which the json,
+// ndjson and xml output then include in their meta
+func setFingerprint(resp *api.SearchResponse) {
+	resp.Fingerprint = watch.Fingerprint(resp.Laws)
+}
+
+// writeFingerprint writes the fingerprint line that follows table output
+func writeFingerprint(w io.Writer, format string, resp *api.SearchResponse) {
+	if format == "table" && resp.Fingerprint != "" {
+		fmt.Fprintln(w, i18n.Tf("law.fingerprint", resp.Fingerprint))
+	}
+}
@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/i18n"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// fixedOutput holds the --widths and --truncate flag values of the fixed format
+type fixedOutput struct {
+	widths   string
+	truncate string
+}
+
+// addFixedFlags registers the fixed format flags on a search command
+func addFixedFlags(cmd *cobra.Command, f *fixedOutput) {
+	cmd.Flags().StringVar(&f.widths, "widths", "", i18n.T("law.flag.widths"))
+	cmd.Flags().StringVar(&f.truncate, "truncate", output.TruncateEllipsis, i18n.T("law.flag.truncate"))
+}
+
+// updateFixedFlagUsages updates the fixed format flag descriptions
+func updateFixedFlagUsages(cmd *cobra.Command) {
+	if flag := cmd.Flags().Lookup("widths"); flag != nil {
+		flag.Usage = i18n.T("law.flag.widths")
+	}
+	if flag := cmd.Flags().Lookup("truncate"); flag != nil {
+		flag.Usage = i18n.T("law.flag.truncate")
+	}
+}
+
+// options validates the flags for format and returns the fixed options
+func (f *fixedOutput) options(format string) (output.FixedOptions, error) {
+	if format != "fixed" {
+		if f.widths != "" {
+			return output.FixedOptions{}, fmt.Errorf("--widths 옵션은 --format fixed와 함께 사용하세요")
+		}
+		return output.FixedOptions{}, nil
+	}
+
+	truncate, err := output.ParseTruncate(f.truncate)
+	if err != nil {
+		return output.FixedOptions{}, err
+	}
+	opts := output.FixedOptions{Widths: output.DefaultFixedWidths, Truncate: truncate}
+	if f.widths != "" {
+		if opts.Widths, err = output.ParseFixedWidths(f.widths); err != nil {
+			return output.FixedOptions{}, err
+		}
+	}
+	return opts, nil
+}
+
+// validate checks the flags before a search runs
+func (f *fixedOutput) validate(format string) error {
+	_, err := f.options(format)
+	return err
+}
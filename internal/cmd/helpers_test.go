@@ -0,0 +1,32 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/config"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/i18n"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/testutil"
+	"github.com/spf13/cobra"
+)
+
+// newConfiguredTestRoot initializes i18n and a config directory in a temp dir
+// and returns a bare root command. The commands under test are initialized
+// and added by the caller, once the config is ready.
+func newConfiguredTestRoot(t *testing.T) *cobra.Command {
+	t.Helper()
+
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+
+	tempDir, cleanup := testutil.CreateTempDir(t, "warp-cmd-test-*")
+	t.Cleanup(cleanup)
+	config.ResetConfig()
+	config.SetTestConfigPath(tempDir)
+	if err := config.Initialize(); err != nil {
+		t.Fatalf("Failed to initialize config: %v", err)
+	}
+	t.Cleanup(config.ResetConfig)
+
+	return &cobra.Command{Use: "test"}
+}
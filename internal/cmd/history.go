@@ -0,0 +1,170 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/config"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/history"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/logger"
+	outputPkg "github.com/pyhub-apps/pyhub-warp-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var (
+	historyCmd      *cobra.Command
+	historyRunCmd   *cobra.Command
+	historyClearCmd *cobra.Command
+)
+
+// initHistoryCmd initializes the history command and its subcommands
+func initHistoryCmd() {
+	historyCmd = &cobra.Command{
+		Use:   "history",
+		Short: "최근 검색 기록 조회 및 재실행",
+		Long: `최근 검색의 검색어, 검색 대상, 출력 형식을 기록하고 다시 실행합니다.
+
+보관 개수는 'warp config set history.size <개수>'로 설정합니다 (기본 50).
+API 키 등 설정 값은 기록하지 않으며, --no-history 플래그로 기록을 끌 수 있습니다.`,
+		Example: `  # 최근 검색 목록
+  warp history
+
+  # 1번 검색 다시 실행
+  warp history run 1
+
+  # 기록 삭제
+  warp history clear`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return listHistory(historyStore(), cmd.OutOrStdout())
+		},
+	}
+
+	historyRunCmd = &cobra.Command{
+		Use:   "run <번호>",
+		Short: "기록된 검색 다시 실행",
+		Args:  cobra.ExactArgs(1),
+		RunE:  runHistoryRunCommand,
+	}
+
+	historyClearCmd = &cobra.Command{
+		Use:   "clear",
+		Short: "검색 기록 삭제",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := historyStore().Clear(); err != nil {
+				return err
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), "검색 기록을 삭제했습니다.")
+			return nil
+		},
+	}
+
+	historyCmd.AddCommand(historyRunCmd)
+	historyCmd.AddCommand(historyClearCmd)
+}
+
+// updateHistoryCommand updates history command descriptions
+func updateHistoryCommand() {
+	if historyCmd != nil {
+		historyCmd.Short = "최근 검색 기록 조회 및 재실행"
+	}
+	if historyRunCmd != nil {
+		historyRunCmd.Short = "기록된 검색 다시 실행"
+	}
+	if historyClearCmd != nil {
+		historyClearCmd.Short = "검색 기록 삭제"
+	}
+}
+
+// historyStore returns the history store in the config directory
+func historyStore() *history.Store {
+	return history.New(filepath.Join(config.GetConfigDir(), history.FileName), config.GetHistorySize())
+}
+
+// recordHistory records the completed search run by ctx unless --no-history is
+// given. Failures are only logged since history must never break a search.
+func recordHistory(ctx context.Context, cmd *cobra.Command) {
+	if noHistory, _ := cmd.Root().PersistentFlags().GetBool("no-history"); noHistory {
+		return
+	}
+	// Config is not initialized when commands run outside Execute (e.g. in tests)
+	if config.GetConfigDir() == "" {
+		return
+	}
+
+	rc, ok := api.RequestContextFrom(ctx)
+	if !ok {
+		return
+	}
+
+	entry := history.Entry{
+		Command:   strings.TrimPrefix(cmd.CommandPath(), cmd.Root().Name()+" "),
+		Query:     rc.Query,
+		Source:    rc.Source,
+		Format:    rc.Format,
+		Timestamp: rc.StartedAt,
+	}
+	if err := historyStore().Add(entry); err != nil {
+		logger.Warn("검색 기록 저장 실패: %v", err)
+	}
+}
+
+// listHistory writes the recorded searches, most recent first
+func listHistory(store *history.Store, writer io.Writer) error {
+	entries, err := store.List()
+	if err != nil {
+		return err
+	}
+	if len(entries) == 0 {
+		fmt.Fprintln(writer, "검색 기록이 없습니다.")
+		return nil
+	}
+
+	headers := []string{"번호", "시각", "명령", "검색어", "대상", "형식"}
+	rows := make([][]string, 0, len(entries))
+	for i, e := range entries {
+		rows = append(rows, []string{
+			strconv.Itoa(i + 1),
+			e.Timestamp.Local().Format("2006-01-02 15:04"),
+			e.Command,
+			e.Query,
+			e.Source,
+			e.Format,
+		})
+	}
+	fmt.Fprint(writer, outputPkg.RenderTable(headers, rows, nil))
+	fmt.Fprintln(writer, "\n'warp history run <번호>'로 다시 실행할 수 있습니다.")
+	return nil
+}
+
+func runHistoryRunCommand(cmd *cobra.Command, args []string) error {
+	n, err := strconv.Atoi(args[0])
+	if err != nil {
+		return fmt.Errorf("잘못된 기록 번호: %s", args[0])
+	}
+
+	entry, err := historyStore().Get(n)
+	if err != nil {
+		return err
+	}
+
+	root := cmd.Root()
+	rerunArgs := entry.Args()
+	if noHistory, _ := root.PersistentFlags().GetBool("no-history"); noHistory {
+		rerunArgs = append(rerunArgs, "--no-history")
+	}
+	logger.Info("검색 재실행: warp %s", strings.Join(rerunArgs, " "))
+
+	root.SetArgs(rerunArgs)
+	return root.Execute()
+}
+
+func init() {
+	// History command will be initialized and added in Execute()
+}
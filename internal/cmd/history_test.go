@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/config"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/history"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/testutil"
+	"github.com/spf13/cobra"
+)
+
+// newHistoryTestRoot builds a root command with history and search commands
+// and a config directory in a temp dir
+func newHistoryTestRoot(t *testing.T) *cobra.Command {
+	t.Helper()
+
+	root := newConfiguredTestRoot(t)
+	initSearchCmd()
+	initHistoryCmd()
+	root.PersistentFlags().Bool("no-history", false, "")
+	root.AddCommand(searchCmd)
+	root.AddCommand(historyCmd)
+	return root
+}
+
+func TestHistoryRecordAndList(t *testing.T) {
+	root := newHistoryTestRoot(t)
+
+	var queries []string
+	testSearchClient = &MockOrdinanceClient{
+		SearchFunc: func(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+			queries = append(queries, req.Query)
+			return &api.SearchResponse{TotalCount: 1, Laws: []api.LawInfo{{Name: "개인정보 보호법"}}}, nil
+		},
+	}
+	defer func() { testSearchClient = nil }()
+
+	if _, err := testutil.ExecuteCommand(t, root, []string{"search", "개인정보", "--source", "law", "--format", "json"}); err != nil {
+		t.Fatalf("search failed: %v", err)
+	}
+	if _, err := testutil.ExecuteCommand(t, root, []string{"search", "비공개", "--no-history"}); err != nil {
+		t.Fatalf("search failed: %v", err)
+	}
+	root.PersistentFlags().Set("no-history", "false")
+
+	entries, err := historyStore().List()
+	if err != nil {
+		t.Fatalf("List() error: %v", err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("expected 1 entry (--no-history skipped), got %d", len(entries))
+	}
+	want := []string{"search", "개인정보", "--source", "law", "--format", "json"}
+	if got := entries[0].Args(); strings.Join(got, " ") != strings.Join(want, " ") {
+		t.Errorf("Args() = %v, want %v", got, want)
+	}
+
+	output, err := testutil.ExecuteCommand(t, root, []string{"history"})
+	if err != nil {
+		t.Fatalf("history failed: %v", err)
+	}
+	for _, s := range []string{"개인정보", "search", "json"} {
+		if !strings.Contains(output, s) {
+			t.Errorf("history output should contain %q, got %q", s, output)
+		}
+	}
+
+	// Re-run the first entry
+	queries = nil
+	if _, err := testutil.ExecuteCommand(t, root, []string{"history", "run", "1"}); err != nil {
+		t.Fatalf("history run failed: %v", err)
+	}
+	if len(queries) != 1 || queries[0] != "개인정보" {
+		t.Errorf("history run searched %v, want [개인정보]", queries)
+	}
+
+	if _, err := testutil.ExecuteCommand(t, root, []string{"history", "run", "5"}); err == nil {
+		t.Error("history run with unknown number should fail")
+	}
+	if _, err := testutil.ExecuteCommand(t, root, []string{"history", "run", "abc"}); err == nil {
+		t.Error("history run with invalid number should fail")
+	}
+
+	if _, err := testutil.ExecuteCommand(t, root, []string{"history", "clear"}); err != nil {
+		t.Fatalf("history clear failed: %v", err)
+	}
+	output, _ = testutil.ExecuteCommand(t, root, []string{"history"})
+	if !strings.Contains(output, "검색 기록이 없습니다") {
+		t.Errorf("expected empty history after clear, got %q", output)
+	}
+}
+
+func TestHistorySize(t *testing.T) {
+	newHistoryTestRoot(t)
+
+	config.Set("history.size", "2")
+	for _, q := range []string{"a", "b", "c"} {
+		if err := historyStore().Add(historyEntry("search", q)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	entries, _ := historyStore().List()
+	if len(entries) != 2 {
+		t.Errorf("history.size=2 should keep 2 entries, got %d", len(entries))
+	}
+}
+
+func historyEntry(command, query string) history.Entry {
+	return history.Entry{Command: command, Query: query}
+}
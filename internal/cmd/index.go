@@ -0,0 +1,315 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/index"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/logger"
+	outputPkg "github.com/pyhub-apps/pyhub-warp-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// indexFetchPageSize is the page size used when collecting search results for an index
+	indexFetchPageSize = 100
+	// indexSnippetLength is the maximum number of characters of article content shown per hit
+	indexSnippetLength = 80
+)
+
+var (
+	indexCmd        *cobra.Command
+	indexBuildCmd   *cobra.Command
+	indexQueryCmd   *cobra.Command
+	indexDir        string
+	indexMaxLaws    int
+	indexFormat     string
+	indexQueryLimit int
+
+	// testIndexClient allows injecting a mock client for testing
+	testIndexClient api.ClientInterface
+)
+
+// initIndexCmd initializes the index command and its subcommands
+func initIndexCmd() {
+	indexCmd = &cobra.Command{
+		Use:   "index",
+		Short: "조문 전문 검색 인덱스 구축 및 질의",
+		Long: `검색 결과의 법령 조문을 모아 로컬 전문 검색 인덱스를 구축하고,
+조문 단위로 전문 검색합니다.
+
+예시:
+  warp index build "도로교통" --dir ./idx     # 인덱스 구축 (증분 추가)
+  warp index query "음주 AND 면허" --dir ./idx  # 조문 전문 검색`,
+	}
+
+	indexBuildCmd = &cobra.Command{
+		Use:   "build <검색어>",
+		Short: "검색 결과로 조문 인덱스 구축",
+		Long: `검색어에 해당하는 법령을 모든 페이지에 걸쳐 수집하고, 각 법령의 조문 전문을
+조회하여 인덱스에 추가합니다. 같은 법령ID/조문번호의 조문은 새 내용으로 갱신됩니다.`,
+		Example: `  # 도로교통 관련 법령의 조문으로 인덱스 구축
+  warp index build "도로교통" --dir ./idx
+
+  # 수집할 법령 수 제한
+  warp index build "개인정보" --dir ./idx --max 20`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: runIndexBuildCommand,
+	}
+
+	indexQueryCmd = &cobra.Command{
+		Use:   "query <질의>",
+		Short: "조문 전문 검색",
+		Long: `구축된 인덱스에서 조문 단위로 전문 검색합니다.
+
+질의 문법:
+  음주 면허            모든 단어 포함 (AND와 동일)
+  음주 AND 면허        모든 단어 포함
+  무면허 OR 자전거     둘 중 하나 포함
+  음주 NOT 자전거      자전거 제외 (-자전거 와 동일)
+  "술에 취한 상태"     구문 일치`,
+		Example: `  warp index query "음주 AND 면허" --dir ./idx
+  warp index query "\"운전면허 취소\"" --dir ./idx --format json`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: runIndexQueryCommand,
+	}
+
+	// Flags
+	indexCmd.PersistentFlags().StringVar(&indexDir, "dir", "./idx", "인덱스 디렉토리")
+	indexBuildCmd.Flags().IntVar(&indexMaxLaws, "max", 100, "수집할 최대 법령 수 (0: 제한 없음)")
+	indexQueryCmd.Flags().StringVarP(&indexFormat, "format", "f", "table", "출력 형식 (table, json)")
+	indexQueryCmd.Flags().IntVarP(&indexQueryLimit, "limit", "l", 50, "최대 결과 수 (0: 제한 없음)")
+
+	indexCmd.AddCommand(indexBuildCmd)
+	indexCmd.AddCommand(indexQueryCmd)
+}
+
+// updateIndexCommand updates index command descriptions
+func updateIndexCommand() {
+	if indexCmd != nil {
+		indexCmd.Short = "조문 전문 검색 인덱스 구축 및 질의"
+	}
+	if indexBuildCmd != nil {
+		indexBuildCmd.Short = "검색 결과로 조문 인덱스 구축"
+	}
+	if indexQueryCmd != nil {
+		indexQueryCmd.Short = "조문 전문 검색"
+	}
+}
+
+func runIndexBuildCommand(cmd *cobra.Command, args []string) error {
+	query := strings.TrimSpace(strings.Join(args, " "))
+	if query == "" {
+		return fmt.Errorf("검색어가 비어있습니다")
+	}
+
+	client := testIndexClient
+	if client == nil {
+		apiClient, err := api.CreateClient(api.APITypeNLIC)
+		if err != nil {
+			logger.Error("Failed to create API client: %v", err)
+			return err
+		}
+		client = apiClient
+	}
+
+	return buildIndex(context.Background(), client, query, indexDir, indexMaxLaws, cmd.OutOrStdout())
+}
+
+// buildIndex collects all laws matching query, fetches their articles and adds them to the index in dir
+func buildIndex(ctx context.Context, client api.ClientInterface, query, dir string, maxLaws int, writer io.Writer) error {
+	ix, err := index.Open(dir)
+	if err != nil {
+		return err
+	}
+
+	laws, err := collectAllLaws(ctx, client, query, maxLaws)
+	if err != nil {
+		var apiKeyErr *api.APIKeyError
+		if errors.As(err, &apiKeyErr) {
+			fmt.Fprintln(writer, err.Error())
+			return nil
+		}
+		return fmt.Errorf("검색 실패: %w", err)
+	}
+
+	added, updated, failed := 0, 0, 0
+	for i, law := range laws {
+		detailID := law.SerialNo
+		if detailID == "" {
+			detailID = law.ID
+		}
+		logger.Info("조문 수집 중... (%d/%d) %s", i+1, len(laws), law.Name)
+
+		detailCtx, cancel := context.WithTimeout(ctx, api.Timeout())
+		detail, err := client.GetDetail(detailCtx, detailID)
+		cancel()
+		if err != nil {
+			logger.Warn("상세 조회 실패 (%s): %v", law.Name, err)
+			failed++
+			continue
+		}
+
+		for _, doc := range articleDocuments(law, detail) {
+			if ix.Add(doc) {
+				updated++
+			} else {
+				added++
+			}
+		}
+	}
+
+	if err := ix.Save(dir); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(writer, "인덱스 구축 완료: 법령 %d개, 조문 %d개 추가, %d개 갱신 (전체 조문 %d개)\n",
+		len(laws)-failed, added, updated, ix.Len())
+	if failed > 0 {
+		fmt.Fprintf(writer, "상세 조회 실패: %d개 법령 (-v 옵션으로 자세한 로그 확인)\n", failed)
+	}
+	fmt.Fprintf(writer, "인덱스 위치: %s\n", dir)
+	return nil
+}
+
+// collectAllLaws fetches every page of search results, up to maxLaws (0 means no limit)
+func collectAllLaws(ctx context.Context, client api.ClientInterface, query string, maxLaws int) ([]api.LawInfo, error) {
+	var laws []api.LawInfo
+
+	for page := 1; ; page++ {
+		pageCtx, cancel := context.WithTimeout(ctx, api.Timeout())
+		resp, err := client.Search(pageCtx, &api.UnifiedSearchRequest{
+			Query:    query,
+			PageNo:   page,
+			PageSize: indexFetchPageSize,
+			Type:     "JSON",
+		})
+		cancel()
+		if err != nil {
+			return nil, err
+		}
+
+		laws = append(laws, resp.Laws...)
+		if maxLaws > 0 && len(laws) >= maxLaws {
+			return laws[:maxLaws], nil
+		}
+		if len(resp.Laws) == 0 || len(laws) >= resp.PageableCount() {
+			return laws, nil
+		}
+	}
+}
+
+// articleDocuments converts the articles of a law into index documents
+func articleDocuments(law api.LawInfo, detail *api.LawDetail) []index.Document {
+	lawID := law.ID
+	if lawID == "" {
+		lawID = detail.LawInfo.ID
+	}
+	if lawID == "" {
+		lawID = law.SerialNo
+	}
+	lawName := law.Name
+	if lawName == "" {
+		lawName = detail.LawInfo.Name
+	}
+
+	docs := make([]index.Document, 0, len(detail.Articles))
+	seen := make(map[string]int)
+	for _, article := range detail.Articles {
+		if strings.TrimSpace(article.Content) == "" {
+			continue
+		}
+
+		// Chapter headings can share a number with the article that follows them
+		articleNo := article.Number
+		seen[articleNo]++
+		if n := seen[articleNo]; n > 1 {
+			articleNo = fmt.Sprintf("%s-%d", articleNo, n)
+		}
+
+		docs = append(docs, index.Document{
+			LawID:     lawID,
+			LawName:   lawName,
+			ArticleNo: articleNo,
+			Title:     article.Title,
+			Content:   article.Content,
+		})
+	}
+	return docs
+}
+
+func runIndexQueryCommand(cmd *cobra.Command, args []string) error {
+	query := strings.TrimSpace(strings.Join(args, " "))
+	return queryIndex(indexDir, query, indexFormat, indexQueryLimit, cmd.OutOrStdout())
+}
+
+// queryIndex searches the index in dir and writes the matching articles
+func queryIndex(dir, query, format string, limit int, writer io.Writer) error {
+	ix, err := index.Open(dir)
+	if err != nil {
+		return err
+	}
+	if ix.Len() == 0 {
+		return fmt.Errorf("인덱스가 비어있습니다: %s ('warp index build' 명령으로 먼저 구축하세요)", dir)
+	}
+
+	docs, err := ix.Search(query)
+	if err != nil {
+		return err
+	}
+
+	total := len(docs)
+	if limit > 0 && len(docs) > limit {
+		docs = docs[:limit]
+	}
+
+	switch strings.ToLower(format) {
+	case "json":
+		data, err := json.MarshalIndent(docs, "", "  ")
+		if err != nil {
+			return fmt.Errorf("JSON 변환 실패: %w", err)
+		}
+		fmt.Fprintln(writer, string(data))
+		return nil
+	case "table":
+		if total == 0 {
+			fmt.Fprintln(writer, "검색 결과가 없습니다.")
+			return nil
+		}
+		fmt.Fprintf(writer, "총 %d개의 조문을 찾았습니다.\n\n", total)
+
+		headers := []string{"법령명", "조문", "제목", "내용"}
+		rows := make([][]string, 0, len(docs))
+		for _, doc := range docs {
+			rows = append(rows, []string{doc.LawName, "제" + doc.ArticleNo + "조", doc.Title, snippet(doc.Content, indexSnippetLength)})
+		}
+		fmt.Fprint(writer, outputPkg.RenderTable(headers, rows, nil))
+
+		if total > len(docs) {
+			fmt.Fprintf(writer, "\n상위 %d개만 표시했습니다 (--limit 옵션으로 조정 가능)\n", len(docs))
+		}
+		return nil
+	default:
+		return fmt.Errorf("지원하지 않는 출력 형식: %s (table, json 중 선택)", format)
+	}
+}
+
+// snippet collapses whitespace and truncates text to maxLen characters
+func snippet(text string, maxLen int) string {
+	text = strings.Join(strings.Fields(text), " ")
+	if utf8.RuneCountInString(text) <= maxLen {
+		return text
+	}
+	runes := []rune(text)
+	return string(runes[:maxLen]) + "..."
+}
+
+func init() {
+	// Index command will be initialized and added in Execute()
+}
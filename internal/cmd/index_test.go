@@ -0,0 +1,130 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/index"
+	"github.com/spf13/cobra"
+)
+
+// newIndexMockClient returns a client serving totalLaws laws over several pages
+func newIndexMockClient(totalLaws int, requestedPages *[]int) *MockOrdinanceClient {
+	return &MockOrdinanceClient{
+		SearchFunc: func(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+			*requestedPages = append(*requestedPages, req.PageNo)
+			start := (req.PageNo - 1) * req.PageSize
+			var laws []api.LawInfo
+			for i := start; i < totalLaws && i < start+req.PageSize; i++ {
+				laws = append(laws, api.LawInfo{
+					ID:       fmt.Sprintf("L%03d", i),
+					Name:     fmt.Sprintf("테스트법 %d", i),
+					SerialNo: fmt.Sprintf("%d", 1000+i),
+				})
+			}
+			return &api.SearchResponse{TotalCount: totalLaws, Page: req.PageNo, Laws: laws}, nil
+		},
+		GetDetailFunc: func(ctx context.Context, id string) (*api.LawDetail, error) {
+			return &api.LawDetail{
+				Articles: []api.Article{
+					{Number: "1", Title: "목적", Content: "이 법은 음주운전 방지를 목적으로 한다. (" + id + ")"},
+					{Number: "2", Title: "면허", Content: "운전면허를 취소할 수 있다."},
+				},
+			}, nil
+		},
+	}
+}
+
+func TestBuildIndex(t *testing.T) {
+	dir := t.TempDir()
+	var pages []int
+	client := newIndexMockClient(150, &pages)
+
+	var buf bytes.Buffer
+	if err := buildIndex(context.Background(), client, "테스트", dir, 0, &buf); err != nil {
+		t.Fatalf("buildIndex() error = %v", err)
+	}
+
+	// All pages must be fetched
+	if len(pages) != 2 {
+		t.Errorf("requested pages = %v, want [1 2]", pages)
+	}
+	if !strings.Contains(buf.String(), "조문 300개 추가") {
+		t.Errorf("output should report added articles, got %q", buf.String())
+	}
+
+	ix, err := index.Open(dir)
+	if err != nil {
+		t.Fatalf("index.Open() error = %v", err)
+	}
+	if ix.Len() != 300 {
+		t.Errorf("index has %d documents, want 300", ix.Len())
+	}
+
+	// Rebuilding updates existing articles instead of duplicating them
+	pages = nil
+	buf.Reset()
+	if err := buildIndex(context.Background(), client, "테스트", dir, 10, &buf); err != nil {
+		t.Fatalf("buildIndex() error = %v", err)
+	}
+	if !strings.Contains(buf.String(), "0개 추가, 20개 갱신") {
+		t.Errorf("output should report updated articles, got %q", buf.String())
+	}
+	if ix, _ := index.Open(dir); ix.Len() != 300 {
+		t.Errorf("index has %d documents after rebuild, want 300", ix.Len())
+	}
+}
+
+func TestIndexCommand(t *testing.T) {
+	dir := t.TempDir()
+	var pages []int
+	testIndexClient = newIndexMockClient(3, &pages)
+	defer func() { testIndexClient = nil }()
+
+	initIndexCmd()
+
+	run := func(args ...string) (string, error) {
+		cmd := &cobra.Command{Use: "test"}
+		cmd.AddCommand(indexCmd)
+		var buf bytes.Buffer
+		cmd.SetOut(&buf)
+		cmd.SetErr(&buf)
+		cmd.SetArgs(args)
+		err := cmd.Execute()
+		return buf.String(), err
+	}
+
+	// Querying before building fails with a hint
+	if _, err := run("index", "query", "음주", "--dir", dir); err == nil || !strings.Contains(err.Error(), "warp index build") {
+		t.Errorf("expected empty index error, got %v", err)
+	}
+
+	if _, err := run("index", "build", "테스트", "--dir", dir); err != nil {
+		t.Fatalf("index build error = %v", err)
+	}
+
+	output, err := run("index", "query", "음주 AND 목적", "--dir", dir)
+	if err != nil {
+		t.Fatalf("index query error = %v", err)
+	}
+	if !strings.Contains(output, "총 3개의 조문") || !strings.Contains(output, "테스트법 0") {
+		t.Errorf("unexpected table output: %q", output)
+	}
+
+	output, err = run("index", "query", "면허 NOT 음주", "--dir", dir, "--format", "json")
+	if err != nil {
+		t.Fatalf("index query error = %v", err)
+	}
+	var docs []index.Document
+	if err := json.Unmarshal([]byte(output), &docs); err != nil {
+		t.Fatalf("output should be valid JSON, got %q", output)
+	}
+	if len(docs) != 3 || docs[0].Key() != "L000:2" {
+		t.Errorf("unexpected JSON results: %+v", docs)
+	}
+}
@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	cliErrors "github.com/pyhub-apps/pyhub-warp-cli/internal/errors"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/onboarding"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/repl"
+	"github.com/spf13/cobra"
+)
+
+var (
+	interactiveCmd      *cobra.Command
+	interactivePageSize int
+	interactiveFormat   string
+)
+
+// initInteractiveCmd initializes the interactive command
+func initInteractiveCmd() {
+	interactiveCmd = &cobra.Command{
+		Use:     "interactive",
+		Aliases: []string{"repl"},
+		Short:   "대화형 법령 검색 모드",
+		Long: `프롬프트에서 검색어를 입력해 법령을 연속으로 검색합니다.
+세션 동안 API 클라이언트와 설정을 재사용하며, 터미널에서만 사용할 수 있습니다.
+
+명령:
+  <검색어>         법령 검색
+  :detail <n>      현재 페이지의 n번째 결과 상세 보기
+  :next, :prev     다음/이전 페이지
+  :format <형식>   출력 형식 변경 (table, json, xml, markdown)
+  :help            도움말
+  :quit            종료 (Ctrl+D)`,
+		Example: `  # 대화형 모드 시작
+  warp interactive
+
+  # 한 페이지 10건, JSON 출력으로 시작
+  warp repl --size 10 --format json`,
+		Args: cobra.NoArgs,
+		RunE: runInteractiveCommand,
+	}
+
+	interactiveCmd.Flags().IntVarP(&interactivePageSize, "size", "s", api.DefaultPageSize, "페이지 크기")
+	interactiveCmd.Flags().StringVarP(&interactiveFormat, "format", "f", "table", "출력 형식 (table, json, xml, markdown)")
+}
+
+// updateInteractiveCommand updates interactive command descriptions
+func updateInteractiveCommand() {
+	if interactiveCmd != nil {
+		interactiveCmd.Short = "대화형 법령 검색 모드"
+	}
+}
+
+func runInteractiveCommand(cmd *cobra.Command, args []string) error {
+	if !isInteractiveTerminal() {
+		return cliErrors.New(cliErrors.ErrCodeInvalidInput,
+			"interactive 모드는 터미널에서만 사용할 수 있습니다",
+			"스크립트에서는 'warp law'와 'warp law detail'을 사용하세요")
+	}
+	if err := validatePageFlags(1, interactivePageSize); err != nil {
+		return err
+	}
+
+	if err := repl.ValidateFormat(interactiveFormat); err != nil {
+		return err
+	}
+
+	// One client serves the whole session
+	client := testDetailClient
+	if client == nil {
+		apiClient, err := api.CreateClient(api.APITypeNLIC)
+		if err != nil {
+			if errors.Is(err, api.ErrNoAPIKey) {
+				onboarding.NewGuideWithWriter(cmd.OutOrStdout(), false).ShowAPIKeySetup()
+				return cliErrors.Displayed(cliErrors.Wrap(err, cliErrors.ErrNoAPIKey)) // Shown by the guide
+			}
+			return err
+		}
+		client = apiClient
+	}
+	session := &repl.Session{
+		Client:   client,
+		Out:      cmd.OutOrStdout(),
+		PageSize: interactivePageSize,
+		Format:   interactiveFormat,
+		Timeout:  api.Timeout(),
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	fmt.Fprintln(cmd.OutOrStdout(), "검색어를 입력하세요. :help로 명령을 보고, Ctrl+D로 종료합니다.")
+	return session.Run(ctx, cmd.InOrStdin())
+}
@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	cliErrors "github.com/pyhub-apps/pyhub-warp-cli/internal/errors"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/testutil"
+)
+
+func TestInteractiveCommand(t *testing.T) {
+	origInteractive := isInteractiveTerminal
+	defer func() { isInteractiveTerminal = origInteractive }()
+
+	var requests []api.UnifiedSearchRequest
+	testDetailClient = &MockOrdinanceClient{SearchFunc: func(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+		requests = append(requests, *req)
+		return &api.SearchResponse{TotalCount: 1, Laws: []api.LawInfo{{ID: "011357", Name: "개인정보 보호법"}}}, nil
+	}}
+	defer func() { testDetailClient = nil }()
+
+	t.Run("refused outside a terminal", func(t *testing.T) {
+		isInteractiveTerminal = func() bool { return false }
+		initInteractiveCmd()
+		_, err := testutil.ExecuteCommand(t, interactiveCmd, nil)
+		if err == nil || !strings.Contains(err.Error(), "터미널에서만") || exitCode(err) != cliErrors.ExitInvalidInput {
+			t.Errorf("Execute() error = %v, want a terminal-only input error", err)
+		}
+	})
+
+	t.Run("invalid format", func(t *testing.T) {
+		isInteractiveTerminal = func() bool { return true }
+		initInteractiveCmd()
+		_, err := testutil.ExecuteCommand(t, interactiveCmd, []string{"--format", "csv"})
+		if err == nil || !strings.Contains(err.Error(), "지원하지 않는 출력 형식: csv") {
+			t.Errorf("Execute() error = %v, want an unsupported format", err)
+		}
+	})
+
+	t.Run("session", func(t *testing.T) {
+		isInteractiveTerminal = func() bool { return true }
+		initInteractiveCmd()
+		interactiveCmd.SetIn(strings.NewReader("개인정보\n:detail 1\n"))
+		out, err := testutil.ExecuteCommand(t, interactiveCmd, []string{"--size", "5"})
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		for _, want := range []string{"Ctrl+D", "개인정보 보호법", "서울특별시 주차장 설치 및 관리 조례"} {
+			if !strings.Contains(out, want) {
+				t.Errorf("output should contain %q, got:\n%s", want, out)
+			}
+		}
+		if len(requests) != 1 || requests[0].Query != "개인정보" || requests[0].PageSize != 5 {
+			t.Errorf("requests = %+v", requests)
+		}
+	})
+}
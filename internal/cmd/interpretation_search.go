@@ -5,9 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"strings"
-	"time"
 
 	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	cliErrors "github.com/pyhub-apps/pyhub-warp-cli/internal/errors"
 	"github.com/pyhub-apps/pyhub-warp-cli/internal/logger"
 	outputPkg "github.com/pyhub-apps/pyhub-warp-cli/internal/output"
 	"github.com/spf13/cobra"
@@ -37,7 +37,7 @@ func initInterpretationSearchCmd() {
 	// Flags
 	interpretationSearchCmd.Flags().StringVarP(&interpOutputFormat, "format", "f", "table", "출력 형식 (table, json)")
 	interpretationSearchCmd.Flags().IntVarP(&interpPageNo, "page", "p", 1, "페이지 번호")
-	interpretationSearchCmd.Flags().IntVarP(&interpPageSize, "size", "s", 50, "페이지 크기")
+	interpretationSearchCmd.Flags().IntVarP(&interpPageSize, "size", "s", api.DefaultPageSize, "페이지 크기")
 }
 
 // updateInterpretationSearchCommand updates legal interpretation search command descriptions
@@ -71,6 +71,9 @@ func runInterpretationSearchCommand(cmd *cobra.Command, args []string) error {
 		logger.Error("Search query is empty")
 		return fmt.Errorf("검색어가 비어있습니다")
 	}
+	if err := validatePageFlags(interpPageNo, interpPageSize); err != nil {
+		return err
+	}
 
 	logger.Info("법령해석례 검색 중... (검색어: %s, 페이지: %d, 크기: %d)", query, interpPageNo, interpPageSize)
 
@@ -90,7 +93,7 @@ func runInterpretationSearchCommand(cmd *cobra.Command, args []string) error {
 	}
 
 	// Search with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), api.Timeout())
 	defer cancel()
 
 	results, err := client.Search(ctx, req)
@@ -100,17 +103,23 @@ func runInterpretationSearchCommand(cmd *cobra.Command, args []string) error {
 		if errors.As(err, &apiKeyErr) {
 			// Print error message without help
 			fmt.Fprintln(output, err.Error())
-			// Return nil to suppress both error message and help
-			return nil
+			// Return it as displayed to suppress both error message and help
+			return cliErrors.Displayed(wrapAPIError(err))
 		}
 
 		logger.Error("Search failed: %v", err)
-		return fmt.Errorf("검색 실패: %v", err)
+		return fmt.Errorf("검색 실패: %w", err)
 	}
 
 	logger.Info("검색 완료: %d개의 결과 (페이지: %d, 크기: %d)",
 		results.TotalCount, interpPageNo, interpPageSize)
 
+	results, err = transformSearchResults(ctx, results)
+	if err != nil {
+		logger.Error("Transform failed: %v", err)
+		return err
+	}
+
 	// Format and output results
 	formatter := outputPkg.NewFormatter(interpOutputFormat)
 	formattedOutput, err := formatter.FormatSearchResultToString(results)
@@ -122,5 +131,5 @@ func runInterpretationSearchCommand(cmd *cobra.Command, args []string) error {
 	// Write formatted output
 	fmt.Fprint(output, formattedOutput)
 
-	return nil
+	return failOnEmpty(cmd, results.TotalCount == 0)
 }
@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/config"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/i18n"
+	"github.com/spf13/cobra"
+)
+
+var (
+	langCmd     *cobra.Command
+	langListCmd *cobra.Command
+)
+
+// initLangCmd initializes the lang command and its list subcommand
+func initLangCmd() {
+	langCmd = &cobra.Command{
+		Use:   "lang",
+		Short: i18n.T("lang.short"),
+		Long:  i18n.T("lang.long"),
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	langListCmd = &cobra.Command{
+		Use:          "list",
+		Short:        i18n.T("lang.list.short"),
+		Long:         i18n.T("lang.list.long"),
+		Example:      i18n.T("lang.list.example"),
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dir, _ := cmd.Root().PersistentFlags().GetString("locale-dir")
+			if dir == "" {
+				dir = config.LocaleDir()
+			}
+			return writeLangList(cmd.OutOrStdout(), dir)
+		},
+	}
+	langCmd.AddCommand(langListCmd)
+}
+
+// updateLangCommand updates lang command descriptions
+func updateLangCommand() {
+	if langCmd != nil {
+		langCmd.Short = i18n.T("lang.short")
+		langCmd.Long = i18n.T("lang.long")
+	}
+	if langListCmd != nil {
+		langListCmd.Short = i18n.T("lang.list.short")
+		langListCmd.Long = i18n.T("lang.list.long")
+		langListCmd.Example = i18n.T("lang.list.example")
+	}
+}
+
+// writeLangList writes the languages of the messages, the current one
+// marked, with the external translation directory dir
+func writeLangList(w io.Writer, dir string) error {
+	current := i18n.GetCurrentLanguage()
+	rows := [][]string{{"", i18n.T("lang.list.code"), i18n.T("lang.list.messages"), i18n.T("lang.list.missing"), i18n.T("lang.list.source")}}
+	for _, locale := range i18n.Locales() {
+		marker := ""
+		if locale.Lang == current {
+			marker = "*"
+		}
+		var source string
+		switch {
+		case locale.Builtin && locale.Path != "":
+			source = i18n.Tf("lang.list.builtinOverridden", locale.Path)
+		case locale.Builtin:
+			source = i18n.T("lang.list.builtin")
+		default:
+			source = locale.Path
+		}
+		rows = append(rows, []string{marker, locale.Lang, strconv.Itoa(locale.Messages), strconv.Itoa(locale.Missing), source})
+	}
+	if err := writeColumns(w, rows); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintf(w, "\n%s\n", i18n.Tf("lang.list.dir", dashIfEmpty(dir)))
+	return err
+}
@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/i18n"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/testutil"
+	"github.com/spf13/cobra"
+)
+
+func TestLangListCommand(t *testing.T) {
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+	t.Cleanup(func() { _ = i18n.Init() })
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "ja.json"), []byte(`{"cli.lang": "出力言語"}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if warnings := i18n.LoadLocaleDir(dir); len(warnings) != 0 {
+		t.Fatalf("LoadLocaleDir() warnings = %v", warnings)
+	}
+
+	initLangCmd()
+	root := &cobra.Command{Use: "test"}
+	root.PersistentFlags().String("locale-dir", "", "")
+	root.AddCommand(langCmd)
+
+	output, err := testutil.ExecuteCommand(t, root, []string{"--locale-dir", dir, "lang", "list"})
+	if err != nil {
+		t.Fatalf("lang list error = %v", err)
+	}
+	lines := strings.Split(output, "\n")
+	if len(lines) < 4 {
+		t.Fatalf("lang list output = %q, want a row per language", output)
+	}
+	if fields := strings.Fields(lines[1]); len(fields) < 2 || fields[0] != "*" || fields[1] != "ko" {
+		t.Errorf("ko row = %q, want it marked current", lines[1])
+	}
+	if !strings.Contains(lines[3], "ja") || !strings.Contains(lines[3], filepath.Join(dir, "ja.json")) {
+		t.Errorf("ja row = %q, want the external file", lines[3])
+	}
+	if !strings.Contains(output, "외부 번역 디렉터리: "+dir) {
+		t.Errorf("lang list output = %q, want the directory", output)
+	}
+}
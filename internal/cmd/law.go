@@ -10,14 +10,31 @@ import (
 	"github.com/pyhub-apps/pyhub-warp-cli/internal/i18n"
 	"github.com/pyhub-apps/pyhub-warp-cli/internal/logger"
 	"github.com/pyhub-apps/pyhub-warp-cli/internal/onboarding"
+	outputPkg "github.com/pyhub-apps/pyhub-warp-cli/internal/output"
 	"github.com/spf13/cobra"
 )
 
 var (
-	outputFormat string
-	pageNo       int
-	pageSize     int
-	sourceFlag   string // "all", "nlic", "elis"
+	outputFormat   string
+	pageNo         int
+	pageSize       int
+	sourceFlag     string // "all", "nlic", "elis"
+	lawEffect      effectFilter
+	lawValues      valueFilter
+	lawMatch       queryMatch
+	lawFilters     fieldFilters
+	lawJSONSchema  string
+	lawRecords     recordOutput
+	lawNotify      notifyOptions
+	lawTree        bool
+	lawQuality     bool
+	lawFingerprint bool
+	lawHanja       bool
+	lawSummary     bool
+	lawSuggest     suggestOptions
+	lawCountOnly   bool
+	lawAll         allPages
+	lawFixed       fixedOutput
 
 	// testAPIClient allows injecting a mock client for testing
 	testAPIClient APIClient
@@ -40,7 +57,10 @@ func initLawCmd() {
   warp law detail 001234
   
   # 법령 이력 조회
-  warp law history 001234`,
+  warp law history 001234
+  
+  # 법률-시행령-시행규칙 계층 트리로 표시
+  warp law "도로교통법" --tree`,
 		// Run default search when args provided without subcommand
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// If args are provided without subcommand, run search
@@ -56,17 +76,43 @@ func initLawCmd() {
 	initLawSearchCmd()
 	initLawDetailCmd()
 	initLawHistoryCmd()
+	initLawMapCmd()
+	initLawDiffCmd()
+	initLawDepartmentsCmd()
+	initLawGrepCmd()
 
 	// Add subcommands
 	lawCmd.AddCommand(lawSearchCmd)
 	lawCmd.AddCommand(lawDetailCmd)
 	lawCmd.AddCommand(lawHistoryCmd)
+	lawCmd.AddCommand(lawMapCmd)
+	lawCmd.AddCommand(lawDiffCmd)
+	lawCmd.AddCommand(lawDepartmentsCmd)
+	lawCmd.AddCommand(lawGrepCmd)
 
 	// Flags for backward compatibility (when using law without subcommand)
-	lawCmd.Flags().StringVarP(&outputFormat, "format", "f", "table", i18n.T("law.flag.format"))
+	lawCmd.Flags().StringVarP(&outputFormat, "format", "f", "table", i18n.T("law.flag.searchFormat"))
+	lawCmd.Flags().StringVar(&lawJSONSchema, "json-schema", outputPkg.SchemaRaw, i18n.T("law.flag.jsonSchema"))
 	lawCmd.Flags().IntVarP(&pageNo, "page", "p", 1, i18n.T("law.flag.page"))
-	lawCmd.Flags().IntVarP(&pageSize, "size", "s", 50, i18n.T("law.flag.size"))
+	lawCmd.Flags().IntVarP(&pageSize, "size", "s", api.DefaultPageSize, i18n.T("law.flag.size"))
 	lawCmd.Flags().StringVar(&sourceFlag, "source", "nlic", i18n.T("law.flag.source"))
+	lawCmd.Flags().BoolVar(&lawEffect.upcoming, "upcoming", false, i18n.T("law.flag.upcoming"))
+	lawCmd.Flags().BoolVar(&lawEffect.inForce, "in-force", false, i18n.T("law.flag.inForce"))
+	lawCmd.Flags().StringVar(&lawEffect.asOf, "as-of", "", i18n.T("law.flag.asOf"))
+	addValueFilterFlags(lawCmd, &lawValues)
+	addQueryMatchFlags(lawCmd, &lawMatch)
+	addFieldFilterFlags(lawCmd, &lawFilters)
+	addRecordFlags(lawCmd, &lawRecords)
+	lawCmd.Flags().BoolVar(&lawTree, "tree", false, i18n.T("law.flag.tree"))
+	addNotifyFlags(lawCmd, &lawNotify)
+	lawCmd.Flags().BoolVar(&lawQuality, "quality-report", false, i18n.T("law.flag.qualityReport"))
+	lawCmd.Flags().BoolVar(&lawFingerprint, "fingerprint", false, i18n.T("law.flag.fingerprint"))
+	lawCmd.Flags().BoolVar(&lawHanja, "hanja", false, i18n.T("law.flag.hanja"))
+	lawCmd.Flags().BoolVar(&lawCountOnly, "count-only", false, i18n.T("law.flag.countOnly"))
+	lawCmd.Flags().BoolVar(&lawSummary, "summary", false, i18n.T("law.flag.summary"))
+	lawCmd.Flags().BoolVar(&lawSuggest.retry, "suggest", false, i18n.T("law.flag.suggest"))
+	addAllPagesFlags(lawCmd, &lawAll)
+	addFixedFlags(lawCmd, &lawFixed)
 }
 
 // updateLawCommand updates law command descriptions
@@ -77,7 +123,10 @@ func updateLawCommand() {
 
 		// Update flag descriptions
 		if flag := lawCmd.Flags().Lookup("format"); flag != nil {
-			flag.Usage = i18n.T("law.flag.format")
+			flag.Usage = i18n.T("law.flag.searchFormat")
+		}
+		if flag := lawCmd.Flags().Lookup("json-schema"); flag != nil {
+			flag.Usage = i18n.T("law.flag.jsonSchema")
 		}
 		if flag := lawCmd.Flags().Lookup("page"); flag != nil {
 			flag.Usage = i18n.T("law.flag.page")
@@ -85,11 +134,44 @@ func updateLawCommand() {
 		if flag := lawCmd.Flags().Lookup("size"); flag != nil {
 			flag.Usage = i18n.T("law.flag.size")
 		}
+		updateEffectFlagUsages(lawCmd)
+		updateValueFilterFlagUsages(lawCmd)
+		updateQueryMatchFlagUsages(lawCmd)
+		updateFieldFilterFlagUsages(lawCmd)
+		updateRecordFlagUsages(lawCmd)
+		updateNotifyFlagUsages(lawCmd)
+		if flag := lawCmd.Flags().Lookup("tree"); flag != nil {
+			flag.Usage = i18n.T("law.flag.tree")
+		}
+		if flag := lawCmd.Flags().Lookup("quality-report"); flag != nil {
+			flag.Usage = i18n.T("law.flag.qualityReport")
+		}
+		if flag := lawCmd.Flags().Lookup("fingerprint"); flag != nil {
+			flag.Usage = i18n.T("law.flag.fingerprint")
+		}
+		if flag := lawCmd.Flags().Lookup("hanja"); flag != nil {
+			flag.Usage = i18n.T("law.flag.hanja")
+		}
+		if flag := lawCmd.Flags().Lookup("count-only"); flag != nil {
+			flag.Usage = i18n.T("law.flag.countOnly")
+		}
+		if flag := lawCmd.Flags().Lookup("summary"); flag != nil {
+			flag.Usage = i18n.T("law.flag.summary")
+		}
+		if flag := lawCmd.Flags().Lookup("suggest"); flag != nil {
+			flag.Usage = i18n.T("law.flag.suggest")
+		}
+		updateAllPagesFlagUsages(lawCmd)
+		updateFixedFlagUsages(lawCmd)
 
 		// Update subcommands
 		updateLawSearchCommand()
 		updateLawDetailCommand()
 		updateLawHistoryCommand()
+		updateLawMapCommand()
+		updateLawDiffCommand()
+		updateLawDepartmentsCommand()
+		updateLawGrepCommand()
 	}
 }
 
@@ -102,6 +184,18 @@ type APIClient interface {
 	Search(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error)
 }
 
+// lawAPIType returns the API of a --source value of the law commands
+func lawAPIType(source string) api.APIType {
+	switch source {
+	case "all":
+		return api.APITypeAll
+	case "elis":
+		return api.APITypeELIS
+	default:
+		return api.APITypeNLIC
+	}
+}
+
 func runLawCommand(cmd *cobra.Command, args []string) error {
 	// Get search query
 	query := strings.TrimSpace(args[0])
@@ -112,40 +206,72 @@ func runLawCommand(cmd *cobra.Command, args []string) error {
 
 	logger.Debug("Starting law search for query: %s", query)
 
+	if err := validatePageFlags(pageNo, pageSize); err != nil {
+		return err
+	}
+	if _, _, err := lawEffect.validate(); err != nil {
+		return err
+	}
+	if err := lawRecords.validate(); err != nil {
+		return err
+	}
+	if err := validateTreeOutput(lawTree, outputFormat, lawRecords); err != nil {
+		return err
+	}
+	if err := validateQualityReport(lawQuality, outputFormat, lawRecords, lawTree); err != nil {
+		return err
+	}
+	if err := validateFingerprint(lawFingerprint, outputFormat, lawRecords, lawTree, lawQuality); err != nil {
+		return err
+	}
+	if err := lawAll.validate(cmd); err != nil {
+		return err
+	}
+	if err := lawFixed.validate(outputFormat); err != nil {
+		return err
+	}
+	if err := lawNotify.validate(); err != nil {
+		return err
+	}
+	if err := validateCountOnly(lawCountOnly, outputFormat,
+		lawRecords.active() || lawTree || lawQuality || lawFingerprint || lawAll.all || lawEffect.active() || lawMatch.active() || lawFilters.active()); err != nil {
+		return err
+	}
+	if err := validateSummary(lawSummary, outputFormat, lawRecords, lawTree, lawQuality, lawCountOnly); err != nil {
+		return err
+	}
+	if err := lawMatch.validate(); err != nil {
+		return err
+	}
+	if err := lawFilters.validate(); err != nil {
+		return err
+	}
+	lawValues.warnUnknownDepartment()
+	if err := lawValues.checkLawTypes(); err != nil {
+		return err
+	}
+
 	// Use test client if available (for testing)
 	var client APIClient
 	if testAPIClient != nil {
 		client = testAPIClient
 	} else {
-		// Determine which API to use based on source flag
-		var apiType api.APIType
-		switch sourceFlag {
-		case "all":
-			apiType = api.APITypeAll
-		case "elis":
-			apiType = api.APITypeELIS
-		case "nlic":
-			fallthrough
-		default:
-			apiType = api.APITypeNLIC
-		}
-
 		// Create API client using the factory
-		apiClient, err := api.CreateClient(apiType)
+		apiClient, err := api.CreateClientWithOptions(lawAPIType(sourceFlag), cacheOption())
 		if err != nil {
 			// Check if it's an API key error (either CLIError or regular error with API key message)
 			var cliErr *cliErrors.CLIError
 			if errors.As(err, &cliErr) && cliErr.Code == cliErrors.ErrCodeNoAPIKey {
 				guide := onboarding.NewGuideWithWriter(cmd.OutOrStdout(), false)
 				guide.ShowAPIKeySetup()
-				return nil // Return nil to avoid printing the error twice
+				return cliErrors.Displayed(cliErrors.Wrap(err, cliErrors.ErrNoAPIKey)) // Shown by the guide
 			}
 
-			// Also check for direct API key error message from factory
-			if strings.Contains(err.Error(), "API 키가 설정되지 않았습니다") {
+			// Also check for the missing key error of the factory
+			if errors.Is(err, api.ErrNoAPIKey) {
 				guide := onboarding.NewGuideWithWriter(cmd.OutOrStdout(), false)
 				guide.ShowAPIKeySetup()
-				return nil // Return nil to avoid printing the error twice
+				return cliErrors.Displayed(cliErrors.Wrap(err, cliErrors.ErrNoAPIKey)) // Shown by the guide
 			}
 
 			verbose, _ := cmd.Flags().GetBool("verbose")
@@ -159,5 +285,17 @@ func runLawCommand(cmd *cobra.Command, args []string) error {
 	verbose, _ := cmd.Flags().GetBool("verbose")
 
 	// Use searchLaws for the actual search logic
-	return searchLaws(client, query, outputFormat, pageNo, pageSize, cmd.OutOrStdout(), verbose)
+	lawSuggest.quiet = quietOutput(cmd)
+	lawAll.status = cmd.ErrOrStderr()
+	ctx := startSearch(cmd, query, sourceFlag, outputFormat, countOnlyPage(pageNo), countOnlySize(pageSize))
+	if err := searchLaws(ctx, client, cmd.OutOrStdout(), verbose); err != nil {
+		return err
+	}
+	finishSearch(ctx)
+
+	recordHistory(ctx, cmd)
+	if err := lawAll.failure(); err != nil {
+		return err
+	}
+	return failOnEmpty(cmd, searchFoundNothing(ctx))
 }
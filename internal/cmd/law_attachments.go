@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/export"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/logger"
+	outputPkg "github.com/pyhub-apps/pyhub-warp-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// attachmentOptions holds the flags downloading the attachments of a law
+type attachmentOptions struct {
+	dir         string
+	concurrency int
+}
+
+var attachmentDownload attachmentOptions
+
+// addAttachmentFlags registers the attachment download flags on the law detail command
+func addAttachmentFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&attachmentDownload.dir, "download-attachments", "", "첨부파일(별표 서식 등)을 원본 파일명으로 저장할 디렉터리")
+	cmd.Flags().IntVar(&attachmentDownload.concurrency, "download-concurrency", export.DefaultAttachmentConcurrency, "첨부파일을 동시에 내려받는 최대 개수")
+}
+
+// requested reports whether attachments are to be downloaded
+func (o *attachmentOptions) requested() bool {
+	return o.dir != ""
+}
+
+// validate checks the attachment flags
+func (o *attachmentOptions) validate() error {
+	if !o.requested() {
+		return nil
+	}
+	if o.concurrency < 1 {
+		return fmt.Errorf("--download-concurrency는 1 이상이어야 합니다")
+	}
+	if detailBatch.requested() {
+		return fmt.Errorf("--download-attachments 옵션은 법령 하나를 조회할 때만 사용할 수 있습니다")
+	}
+	return nil
+}
+
+// downloadAttachments saves the attachments of detail in --download-attachments,
+// logging each file as it finishes. Failed files are reported and the others
+// go on; the error afterwards counts them.
+func (o *attachmentOptions) downloadAttachments(ctx context.Context, detail *api.LawDetail) error {
+	if len(detail.Attachments) == 0 {
+		logger.Info("내려받을 첨부파일이 없습니다")
+		return nil
+	}
+
+	// Sizes listed by the API give the expected total, if every file has one
+	var expected int64
+	for _, attachment := range detail.Attachments {
+		if attachment.Size <= 0 {
+			expected = 0
+			break
+		}
+		expected += attachment.Size
+	}
+	if expected > 0 {
+		logger.Info("첨부파일 내려받는 중... (%d개, 약 %s, 동시 %d개)", len(detail.Attachments), outputPkg.FormatFileSize(expected), o.concurrency)
+	} else {
+		logger.Info("첨부파일 내려받는 중... (%d개, 동시 %d개)", len(detail.Attachments), o.concurrency)
+	}
+	var total int64
+	results := export.DownloadAttachments(ctx, o.dir, detail.Attachments, export.AttachmentOptions{
+		Concurrency: o.concurrency,
+		// The shared transport, so that downloads honor the proxy and offline mode
+		Client:  &http.Client{Transport: api.Transport()},
+		Timeout: api.Timeout(),
+		Progress: func(result export.AttachmentResult, done, count int) {
+			if result.Err != nil {
+				logger.Warn("[%d/%d] 첨부파일 내려받기 실패 (%s): %v", done, count, result.Attachment.Name, result.Err)
+				return
+			}
+			total += result.Size
+			logger.Info("[%d/%d] %s (%s, 누적 %s)", done, count, result.Path,
+				outputPkg.FormatFileSize(result.Size), outputPkg.FormatFileSize(total))
+		},
+	})
+
+	failed := 0
+	for _, result := range results {
+		if result.Err != nil {
+			failed++
+		}
+	}
+	logger.Info("첨부파일 %d개 중 %d개 저장 (총 %s): %s", len(results), len(results)-failed, outputPkg.FormatFileSize(total), o.dir)
+	if failed > 0 {
+		return fmt.Errorf("첨부파일 %d개 중 %d개를 내려받지 못했습니다", len(results), failed)
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/logger"
+	outputPkg "github.com/pyhub-apps/pyhub-warp-cli/internal/output"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/stats"
+	"github.com/spf13/cobra"
+)
+
+var (
+	lawDepartmentsCmd *cobra.Command
+	departmentsFormat string
+	departmentsPage   int
+	departmentsSize   int
+)
+
+// departmentCount is the number of laws of a department in the searched page
+type departmentCount struct {
+	Department string `json:"department"`
+	Count      int    `json:"count"`
+}
+
+// departmentsReport is the json output of law departments
+type departmentsReport struct {
+	Query       string            `json:"query"`
+	TotalCount  int               `json:"total_count"`
+	PageCount   int               `json:"page_count"`
+	Departments []departmentCount `json:"departments"`
+}
+
+// initLawDepartmentsCmd initializes the law departments command
+func initLawDepartmentsCmd() {
+	lawDepartmentsCmd = &cobra.Command{
+		Use:   "departments <검색어>",
+		Short: "검색 결과의 소관부처 집계",
+		Long: `검색 결과에 나타난 소관부처명을 법령 수가 많은 순으로 보여줍니다.
+--department 필터에 쓸 정확한 부처명을 확인할 때 사용합니다.
+
+집계는 요청한 페이지의 결과 기준입니다. 여러 부처가 공동 소관인 법령은
+부처마다 한 번씩 셉니다.`,
+		Example: `  # 소관부처 집계
+  warp law departments "개인정보"
+
+  # 더 많은 결과로 집계
+  warp law departments "주차" --size 100
+
+  # JSON 형식으로 출력
+  warp law departments "개인정보" --format json`,
+		Args: cobra.ExactArgs(1),
+		RunE: runLawDepartmentsCommand,
+	}
+
+	lawDepartmentsCmd.Flags().StringVarP(&departmentsFormat, "format", "f", "table", "출력 형식 (table, json)")
+	lawDepartmentsCmd.Flags().IntVarP(&departmentsPage, "page", "p", 1, "집계할 페이지 번호")
+	lawDepartmentsCmd.Flags().IntVarP(&departmentsSize, "size", "s", 100, "집계할 페이지 크기")
+}
+
+// updateLawDepartmentsCommand updates law departments command descriptions
+func updateLawDepartmentsCommand() {
+	if lawDepartmentsCmd != nil {
+		lawDepartmentsCmd.Short = "검색 결과의 소관부처 집계"
+	}
+}
+
+func runLawDepartmentsCommand(cmd *cobra.Command, args []string) error {
+	if departmentsFormat != "table" && departmentsFormat != "json" {
+		return fmt.Errorf("지원하지 않는 출력 형식: %s (table, json 중 선택)", departmentsFormat)
+	}
+	if err := validatePageFlags(departmentsPage, departmentsSize); err != nil {
+		return err
+	}
+
+	var client APIClient
+	if testAPIClient != nil {
+		client = testAPIClient
+	} else {
+		apiClient, err := api.CreateClientWithOptions(api.APITypeNLIC, cacheOption())
+		if err != nil {
+			logger.Error("Failed to create API client: %v", err)
+			return err
+		}
+		client = apiClient
+	}
+
+	ctx := startSearch(cmd, args[0], "nlic", departmentsFormat, departmentsPage, departmentsSize)
+	rc, err := searchRequest(ctx)
+	if err != nil {
+		return err
+	}
+	resp, err := fetchLaws(ctx, client, rc)
+	if err != nil {
+		var apiKeyErr *api.APIKeyError
+		if errors.As(err, &apiKeyErr) {
+			fmt.Fprintln(cmd.OutOrStdout(), err.Error())
+			return nil
+		}
+		return wrapAPIError(err)
+	}
+	learnVocabulary(ctx, resp)
+	finishSearch(ctx)
+
+	report := departmentsReport{
+		Query:       rc.Query,
+		TotalCount:  resp.TotalCount,
+		PageCount:   len(resp.Laws),
+		Departments: countDepartments(resp.Laws),
+	}
+	return writeDepartments(cmd.OutOrStdout(), rc.Format, report)
+}
+
+// countDepartments counts the laws of each department, the most frequent
+// first. A law of several departments counts for each of them.
+func countDepartments(laws []api.LawInfo) []departmentCount {
+	counts := make(map[string]int)
+	for _, law := range laws {
+		departments := splitDepartments(law.Department)
+		if len(departments) == 0 {
+			departments = []string{stats.Unknown}
+		}
+		for _, department := range departments {
+			counts[department]++
+		}
+	}
+
+	result := make([]departmentCount, 0, len(counts))
+	for department, count := range counts {
+		result = append(result, departmentCount{Department: department, Count: count})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Count != result[j].Count {
+			return result[i].Count > result[j].Count
+		}
+		return result[i].Department < result[j].Department
+	})
+	return result
+}
+
+// writeDepartments writes the department counts of report as a table or json
+func writeDepartments(w io.Writer, format string, report departmentsReport) error {
+	if format == "json" {
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return fmt.Errorf("JSON 변환 실패: %w", err)
+		}
+		fmt.Fprintln(w, string(data))
+		return nil
+	}
+
+	if len(report.Departments) == 0 {
+		fmt.Fprintln(w, "검색 결과가 없습니다.")
+		return nil
+	}
+	rows := make([][]string, 0, len(report.Departments))
+	for _, d := range report.Departments {
+		rows = append(rows, []string{d.Department, strconv.Itoa(d.Count)})
+	}
+	fmt.Fprint(w, outputPkg.RenderTable([]string{"소관부처", "법령 수"}, rows, nil))
+	fmt.Fprintf(w, "\n현재 페이지 결과 %d건 기준 (전체 %d건)\n", report.PageCount, report.TotalCount)
+	return nil
+}
@@ -4,13 +4,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"strings"
-	"time"
 
 	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	cliErrors "github.com/pyhub-apps/pyhub-warp-cli/internal/errors"
 	"github.com/pyhub-apps/pyhub-warp-cli/internal/i18n"
 	"github.com/pyhub-apps/pyhub-warp-cli/internal/logger"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/onboarding"
 	outputPkg "github.com/pyhub-apps/pyhub-warp-cli/internal/output"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/refs"
 	"github.com/spf13/cobra"
 )
 
@@ -19,31 +22,85 @@ var (
 	showArticles      bool
 	showTables        bool
 	showSupplementary bool
+	plainText         bool
+	resolveRefs       bool
+	articleFilter     string
+	articleGrep       string
+	showTOC           bool
+	resolveFirst      bool
+	showHanja         bool
 )
 
 // initLawDetailCmd initializes the law detail command
 func initLawDetailCmd() {
 	lawDetailCmd = &cobra.Command{
-		Use:   "detail <법령ID>",
+		Use:   "detail <법령ID|법령명>",
 		Short: i18n.T("law.detail.short"),
 		Long:  i18n.T("law.detail.long"),
 		Example: `  # 법령ID로 상세 조회
   warp law detail 001234
   
+  # 법령명으로 조회 (여러 법령이 검색되면 선택, --first는 첫 번째 법령)
+  warp law detail "개인정보 보호법"
+  warp law detail 개인정보 --first
+  
+  # 검색 결과 ID를 이어서 조회 (통합 검색 ID는 nlic:, elis: 접두 포함)
+  warp law "개인정보" --ids-only | xargs -n1 warp law detail
+  
   # 조문 포함하여 조회
   warp law detail 001234 --articles
   
   # JSON 형식으로 출력
-  warp law detail 001234 --format json`,
-		Args: cobra.ExactArgs(1),
+  warp law detail 001234 --format json
+  
+  # 법령명에 한자명 병기
+  warp law detail 001234 --hanja
+  
+  # 조문 전체를 장식 없는 텍스트로 출력 (별표/부칙 포함)
+  warp law detail 001234 --plain --tables --addendum > law.txt
+  
+  # 조문에서 인용한 다른 법령을 법령ID와 함께 표시
+  warp law detail 001234 --resolve-refs
+  
+  # 특정 조문만 표시 (58, 58조, 제58조 모두 가능)
+  warp law detail 001234 --article 58
+  
+  # 키워드가 포함된 조문만 강조하여 표시
+  warp law detail 001234 --grep 과태료
+  
+  # 조문 목차와 함께 markdown 문서로 저장 (목차 항목은 조문으로 이동하는 링크)
+  warp law detail 001234 --toc --format markdown > law.md
+  
+  # 목차와 조문 앵커(#article-58)가 있는 단일 HTML 파일로 저장
+  warp law detail 001234 --articles --tables --addendum --format html > law.html
+  
+  # 여러 법령을 병렬로 조회해 JSON 배열로 출력 (실패한 ID는 error 항목으로 기록)
+  warp law detail --ids 001,002,003 --format json
+  
+  # 검색 상위 결과의 본문을 법령별 파일로 저장
+  warp law "개인정보" --size 10 --ids-only > ids.txt
+  warp law detail --ids-file ids.txt --articles --format markdown --output-dir ./laws
+  
+  # 별표 서식 등 첨부파일을 원본 파일명으로 내려받기
+  warp law detail 001234 --download-attachments ./files`,
+		Args: lawDetailArgs,
 		RunE: runLawDetailCommand,
 	}
 
 	// Flags
-	lawDetailCmd.Flags().StringVarP(&outputFormat, "format", "f", "table", i18n.T("law.flag.format"))
+	lawDetailCmd.Flags().StringVarP(&outputFormat, "format", "f", "table", i18n.T("law.flag.detailFormat"))
 	lawDetailCmd.Flags().BoolVarP(&showArticles, "articles", "a", false, i18n.T("law.detail.flag.articles"))
 	lawDetailCmd.Flags().BoolVarP(&showTables, "tables", "t", false, "별표 내용 표시")
 	lawDetailCmd.Flags().BoolVar(&showSupplementary, "addendum", false, "부칙 내용 표시")
+	lawDetailCmd.Flags().BoolVar(&plainText, "plain", false, "조문을 장식 없는 텍스트로 출력 (--tables, --addendum과 함께 사용 가능)")
+	lawDetailCmd.Flags().BoolVar(&resolveRefs, "resolve-refs", false, "조문에서 인용한 다른 법령과 법령ID 표시")
+	lawDetailCmd.Flags().StringVar(&articleFilter, "article", "", "지정한 조문만 표시 (예: 58, 58조, 제58조)")
+	lawDetailCmd.Flags().StringVar(&articleGrep, "grep", "", "키워드가 포함된 조문만 강조하여 표시")
+	lawDetailCmd.Flags().BoolVar(&showTOC, "toc", false, "조문 앞에 조문 번호와 제목의 목차 표시 (table, markdown, html 형식)")
+	lawDetailCmd.Flags().BoolVar(&showHanja, "hanja", false, "법령명 옆에 한자명 병기 (table, markdown 형식)")
+	lawDetailCmd.Flags().BoolVar(&resolveFirst, "first", false, "법령명으로 여러 법령이 검색되면 묻지 않고 첫 번째 법령 선택")
+	addDetailBatchFlags(lawDetailCmd)
+	addAttachmentFlags(lawDetailCmd)
 }
 
 // updateLawDetailCommand updates law detail command descriptions
@@ -54,7 +111,7 @@ func updateLawDetailCommand() {
 
 		// Update flag descriptions
 		if flag := lawDetailCmd.Flags().Lookup("format"); flag != nil {
-			flag.Usage = i18n.T("law.flag.format")
+			flag.Usage = i18n.T("law.flag.detailFormat")
 		}
 		if flag := lawDetailCmd.Flags().Lookup("articles"); flag != nil {
 			flag.Usage = i18n.T("law.detail.flag.articles")
@@ -65,41 +122,108 @@ func updateLawDetailCommand() {
 		if flag := lawDetailCmd.Flags().Lookup("addendum"); flag != nil {
 			flag.Usage = "부칙 내용 표시"
 		}
+		if flag := lawDetailCmd.Flags().Lookup("plain"); flag != nil {
+			flag.Usage = "조문을 장식 없는 텍스트로 출력 (--tables, --addendum과 함께 사용 가능)"
+		}
+		if flag := lawDetailCmd.Flags().Lookup("resolve-refs"); flag != nil {
+			flag.Usage = "조문에서 인용한 다른 법령과 법령ID 표시"
+		}
+		if flag := lawDetailCmd.Flags().Lookup("article"); flag != nil {
+			flag.Usage = "지정한 조문만 표시 (예: 58, 58조, 제58조)"
+		}
+		if flag := lawDetailCmd.Flags().Lookup("grep"); flag != nil {
+			flag.Usage = "키워드가 포함된 조문만 강조하여 표시"
+		}
+		if flag := lawDetailCmd.Flags().Lookup("toc"); flag != nil {
+			flag.Usage = "조문 앞에 조문 번호와 제목의 목차 표시 (table, markdown, html 형식)"
+		}
+		if flag := lawDetailCmd.Flags().Lookup("hanja"); flag != nil {
+			flag.Usage = "법령명 옆에 한자명 병기 (table, markdown 형식)"
+		}
+		if flag := lawDetailCmd.Flags().Lookup("first"); flag != nil {
+			flag.Usage = "법령명으로 여러 법령이 검색되면 묻지 않고 첫 번째 법령 선택"
+		}
 	}
 }
 
 func runLawDetailCommand(cmd *cobra.Command, args []string) error {
+	if plainText && outputFormat != "table" {
+		return fmt.Errorf("--plain 옵션은 table 형식에서만 사용할 수 있습니다")
+	}
+	if resolveRefs && (plainText || outputFormat != "table") {
+		return fmt.Errorf("--resolve-refs 옵션은 table 형식에서만 사용할 수 있습니다")
+	}
+	if showTOC && (plainText || (outputFormat != "table" && outputFormat != "markdown" && outputFormat != "md" && outputFormat != "html")) {
+		return fmt.Errorf("--toc 옵션은 table, markdown, html 형식에서만 사용할 수 있습니다")
+	}
+	if err := attachmentDownload.validate(); err != nil {
+		return err
+	}
+	if detailBatch.requested() {
+		return runLawDetailBatch(cmd)
+	}
+
 	// Get law ID
 	lawID := strings.TrimSpace(args[0])
 	if lawID == "" {
 		return fmt.Errorf(i18n.T("law.detail.error.emptyID"))
 	}
 
-	logger.Info(i18n.Tf("law.detail.searching", lawID))
+	articleNumber := ""
+	if articleFilter != "" {
+		number, err := outputPkg.NormalizeArticleNumber(articleFilter)
+		if err != nil {
+			return err
+		}
+		articleNumber = number
+	}
+
+	// IDs written by --ids-only for a unified search carry their source ("elis:456")
+	byName := !isLawID(lawID)
+	source, lawID := api.ParseDetailID(lawID, api.APITypeNLIC)
 
 	// Create API client
-	client, err := api.CreateDefaultClient()
-	if err != nil {
-		logger.Error("Failed to create API client: %v", err)
-		return err
+	var client api.ClientInterface
+	if testDetailClient != nil {
+		client = testDetailClient
+	} else {
+		c, err := api.CreateClientWithOptions(source, cacheOption())
+		if err != nil {
+			logger.Error("Failed to create API client: %v", err)
+			return err
+		}
+		client = c
 	}
 
 	// Get law detail with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), api.Timeout())
 	defer cancel()
 
+	// A law name is looked up in the search for its ID
+	if byName {
+		id, err := resolveLawName(ctx, cmd, client, lawID, resolveFirst)
+		if err != nil {
+			return lawResolveError(cmd, err)
+		}
+		lawID = id
+	}
+
+	logger.Info(i18n.Tf("law.detail.searching", lawID))
+
 	detail, err := client.GetDetail(ctx, lawID)
 	if err != nil {
-		// Check if it's an API key error
+		// A rejected key is set up again with the guide
 		var apiKeyErr *api.APIKeyError
 		if errors.As(err, &apiKeyErr) {
-			// Print error message without help
-			fmt.Fprintln(cmd.OutOrStdout(), err.Error())
-			// Return nil to suppress both error message and help
-			return nil
+			fmt.Fprintln(cmd.OutOrStdout(), apiKeyErr.Error())
+			onboarding.NewGuideWithWriter(cmd.OutOrStdout(), false).ShowAPIKeySetup()
+			return cliErrors.Displayed(wrapAPIError(err)) // Shown by the guide
 		}
 
 		logger.Error("Failed to get law detail: %v", err)
+		if apiErr := wrapAPIError(err); apiErr != err {
+			return apiErr
+		}
 		return fmt.Errorf(i18n.T("law.detail.error.failed"), err)
 	}
 
@@ -116,11 +240,17 @@ func runLawDetailCommand(cmd *cobra.Command, args []string) error {
 	}
 	logger.Info(i18n.Tf("law.detail.searchComplete", nameToShow))
 
-	// Format and output results
-	formatter := outputPkg.NewFormatter(outputFormat)
+	// Filtering articles and the table of contents imply showing them
+	withArticles := showArticles || showTOC
+	if articleNumber != "" || articleGrep != "" {
+		if err := filterDetailArticles(detail, articleNumber, articleGrep, outputFormat == "table" && !plainText); err != nil {
+			return err
+		}
+		withArticles = true
+	}
 
-	// Use the formatter with options
-	formattedOutput, err := formatter.FormatDetailToStringWithOptions(detail, showArticles, showTables, showSupplementary)
+	// Format and output results
+	formattedOutput, err := formatLawDetail(detail, withArticles)
 	if err != nil {
 		logger.Error("Failed to format output: %v", err)
 		return fmt.Errorf(i18n.T("law.outputFailed"))
@@ -129,5 +259,83 @@ func runLawDetailCommand(cmd *cobra.Command, args []string) error {
 	// Write formatted output
 	fmt.Fprint(cmd.OutOrStdout(), formattedOutput)
 
+	if resolveRefs {
+		writeReferences(context.Background(), client, detail, cmd.OutOrStdout())
+	}
+
+	if attachmentDownload.requested() {
+		ctx := cmd.Context()
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		return attachmentDownload.downloadAttachments(ctx, detail)
+	}
+
 	return nil
 }
+
+// formatLawDetail formats detail in the --format given, with the sections
+// selected by the detail flags
+func formatLawDetail(detail *api.LawDetail, withArticles bool) (string, error) {
+	formatter := outputPkg.NewFormatter(outputFormat).WithTOC(showTOC).WithHanja(showHanja)
+	if plainText {
+		return formatter.FormatDetailPlainText(detail, showTables, showSupplementary)
+	}
+	return formatter.FormatDetailToStringWithOptions(detail, withArticles, showTables, showSupplementary)
+}
+
+// filterDetailArticles keeps only the articles of detail matching number and
+// keyword, highlighting keyword when highlight is set and the terminal supports color
+func filterDetailArticles(detail *api.LawDetail, number, keyword string, highlight bool) error {
+	matched := outputPkg.FilterArticles(detail.Articles, number, keyword)
+	if len(matched) == 0 {
+		var conditions []string
+		if number != "" {
+			conditions = append(conditions, articleLabel(number))
+		}
+		if keyword != "" {
+			conditions = append(conditions, fmt.Sprintf("키워드 '%s'", keyword))
+		}
+		return fmt.Errorf("일치하는 조문이 없습니다: %s (전체 조문 %d개)", strings.Join(conditions, ", "), len(detail.Articles))
+	}
+
+	if highlight {
+		matched = outputPkg.HighlightArticles(matched, keyword, outputPkg.GetDefaultTableStyle().UseColor)
+	}
+	detail.Articles = matched
+	return nil
+}
+
+// articleLabel formats a normalized article number ("58", "58의2") as "제58조" or "제58조의2"
+func articleLabel(number string) string {
+	if main, branch, ok := strings.Cut(number, "의"); ok {
+		return "제" + main + "조의" + branch
+	}
+	return "제" + number + "조"
+}
+
+// writeReferences extracts citations of other laws from the articles of
+// detail, resolves each unique law name once and writes them as a table
+func writeReferences(ctx context.Context, client refs.Searcher, detail *api.LawDetail, writer io.Writer) {
+	citations := refs.Extract(detail)
+	if len(citations) == 0 {
+		fmt.Fprintln(writer, "\n인용된 다른 법령이 없습니다.")
+		return
+	}
+
+	logger.Info("인용 법령 조회 중... (%d건)", len(citations))
+	references := refs.NewResolver(client).ResolveAll(ctx, citations)
+
+	headers := []string{"인용 법령", "조문", "법령ID", "인용 위치"}
+	rows := make([][]string, 0, len(references))
+	for _, ref := range references {
+		lawID := ref.LawID
+		if lawID == "" {
+			lawID = "-"
+		}
+		rows = append(rows, []string{ref.LawName, ref.Article, lawID, ref.From})
+	}
+
+	fmt.Fprintf(writer, "\n인용 법령 (%d건)\n", len(references))
+	fmt.Fprint(writer, outputPkg.RenderTable(headers, rows, nil))
+}
@@ -0,0 +1,289 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/logger"
+	"github.com/spf13/cobra"
+)
+
+// detailBatchOptions holds the flags of a law detail batch
+type detailBatchOptions struct {
+	ids         []string
+	idsFile     string
+	concurrency int
+	rate        float64
+	outputDir   string
+}
+
+var (
+	detailBatch detailBatchOptions
+
+	// testDetailClient allows injecting a mock client for batch detail tests
+	testDetailClient api.ClientInterface
+)
+
+// batchDetailEntry is an element of the JSON array written by a batch
+type batchDetailEntry struct {
+	ID     string         `json:"id"`
+	Detail *api.LawDetail `json:"detail,omitempty"`
+	Error  string         `json:"error,omitempty"`
+}
+
+// unsafeFileChars matches characters replaced in file names of --output-dir
+var unsafeFileChars = regexp.MustCompile(`[^0-9A-Za-z._-]`)
+
+// addDetailBatchFlags registers the batch flags on the law detail command
+func addDetailBatchFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSliceVar(&detailBatch.ids, "ids", nil, "쉼표로 구분한 여러 법령ID를 한 번에 조회 (예: 001,002,elis:456)")
+	cmd.Flags().StringVar(&detailBatch.idsFile, "ids-file", "", "조회할 법령ID 목록 파일 (한 줄에 하나, -는 표준 입력)")
+	cmd.Flags().IntVar(&detailBatch.concurrency, "concurrency", 4, "일괄 조회 시 동시에 보내는 최대 요청 수")
+	cmd.Flags().Float64Var(&detailBatch.rate, "rate", 5, "일괄 조회 시 초당 최대 요청 수 (0: 제한 없음)")
+	cmd.Flags().StringVar(&detailBatch.outputDir, "output-dir", "", "일괄 조회 결과를 법령ID별 파일로 저장할 디렉터리")
+}
+
+// lawDetailArgs requires a law ID unless the IDs are given with --ids or --ids-file
+func lawDetailArgs(cmd *cobra.Command, args []string) error {
+	if detailBatch.requested() {
+		if len(args) > 0 {
+			return fmt.Errorf("법령ID 인자와 --ids, --ids-file 옵션은 함께 사용할 수 없습니다")
+		}
+		return nil
+	}
+	return cobra.ExactArgs(1)(cmd, args)
+}
+
+// requested reports whether the IDs of a batch are given
+func (b *detailBatchOptions) requested() bool {
+	return len(b.ids) > 0 || b.idsFile != ""
+}
+
+// validate checks the batch flags and the detail flags that only apply to a single law
+func (b *detailBatchOptions) validate() error {
+	if b.concurrency < 1 {
+		return fmt.Errorf("--concurrency는 1 이상이어야 합니다")
+	}
+	if b.rate < 0 {
+		return fmt.Errorf("--rate는 0 이상이어야 합니다")
+	}
+	if resolveRefs || articleFilter != "" || articleGrep != "" {
+		return fmt.Errorf("--resolve-refs, --article, --grep 옵션은 법령 하나를 조회할 때만 사용할 수 있습니다")
+	}
+	return nil
+}
+
+// interval returns the minimum time between two requests for --rate
+func (b *detailBatchOptions) interval() time.Duration {
+	return rateInterval(b.rate)
+}
+
+// rateInterval returns the minimum time between two requests for a --rate of
+// requests per second, 0 for no limit
+func rateInterval(rate float64) time.Duration {
+	if rate <= 0 {
+		return 0
+	}
+	return time.Duration(float64(time.Second) / rate)
+}
+
+// collectIDs returns the IDs of --ids and --ids-file without duplicates, in
+// the order given. Blank lines and lines starting with # are skipped.
+func (b *detailBatchOptions) collectIDs(stdin io.Reader) ([]string, error) {
+	candidates := append([]string(nil), b.ids...)
+
+	if b.idsFile != "" {
+		reader := stdin
+		if b.idsFile != "-" {
+			file, err := os.Open(b.idsFile)
+			if err != nil {
+				return nil, fmt.Errorf("ID 목록 파일을 열 수 없습니다: %w", err)
+			}
+			defer file.Close()
+			reader = file
+		}
+
+		scanner := bufio.NewScanner(reader)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			candidates = append(candidates, line)
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, fmt.Errorf("ID 목록 파일 읽기 실패: %w", err)
+		}
+	}
+
+	seen := make(map[string]bool)
+	var ids []string
+	for _, id := range candidates {
+		id = strings.TrimSpace(id)
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("조회할 법령ID가 없습니다")
+	}
+	return ids, nil
+}
+
+// runLawDetailBatch fetches the details of several laws in parallel and writes
+// them as a JSON array, one after another, or as a file per law
+func runLawDetailBatch(cmd *cobra.Command) error {
+	if err := detailBatch.validate(); err != nil {
+		return err
+	}
+	ids, err := detailBatch.collectIDs(cmd.InOrStdin())
+	if err != nil {
+		return err
+	}
+
+	// Create the clients up front: one per source, shared by the workers
+	clients := make(map[api.APIType]api.ClientInterface)
+	for _, id := range ids {
+		source, _ := api.ParseDetailID(id, api.APITypeNLIC)
+		if _, ok := clients[source]; ok {
+			continue
+		}
+		if testDetailClient != nil {
+			clients[source] = testDetailClient
+			continue
+		}
+		client, err := api.CreateClientWithOptions(source, cacheOption())
+		if err != nil {
+			logger.Error("Failed to create API client: %v", err)
+			return err
+		}
+		clients[source] = client
+	}
+
+	fetch := func(ctx context.Context, id string) (*api.LawDetail, error) {
+		source, lawID := api.ParseDetailID(id, api.APITypeNLIC)
+		ctx, cancel := context.WithTimeout(ctx, api.Timeout())
+		defer cancel()
+		return clients[source].GetDetail(ctx, lawID)
+	}
+
+	logger.Info("법령 상세 일괄 조회 중... (%d건, 동시 요청 %d개)", len(ids), detailBatch.concurrency)
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	results := api.GetDetails(ctx, ids, fetch, api.BatchOptions{
+		Concurrency: detailBatch.concurrency,
+		Interval:    detailBatch.interval(),
+	})
+
+	failed := 0
+	for _, result := range results {
+		if result.Err != nil {
+			failed++
+			logger.Warn("상세 조회 실패 (%s): %v", result.ID, result.Err)
+		}
+	}
+	logger.Info("법령 상세 일괄 조회 완료: %d건 중 %d건 성공, %d건 실패", len(results), len(results)-failed, failed)
+
+	if err := writeDetailBatch(results, cmd.OutOrStdout()); err != nil {
+		return err
+	}
+	if failed == len(results) {
+		return fmt.Errorf("모든 법령의 상세 조회에 실패했습니다 (%d건)", failed)
+	}
+	return nil
+}
+
+// writeDetailBatch writes the results of a batch to --output-dir or writer
+func writeDetailBatch(results []api.DetailResult, writer io.Writer) error {
+	if detailBatch.outputDir != "" {
+		return writeDetailFiles(results, detailBatch.outputDir, writer)
+	}
+
+	// JSON keeps the failures next to the details so that scripts can see them
+	if outputFormat == "json" {
+		entries := make([]batchDetailEntry, len(results))
+		for i, result := range results {
+			entries[i] = batchDetailEntry{ID: result.ID, Detail: result.Detail}
+			if result.Err != nil {
+				entries[i].Error = result.Err.Error()
+			}
+		}
+		data, err := json.MarshalIndent(entries, "", "  ")
+		if err != nil {
+			return fmt.Errorf("JSON 변환 실패: %w", err)
+		}
+		fmt.Fprintln(writer, string(data))
+		return nil
+	}
+
+	first := true
+	for _, result := range results {
+		if result.Err != nil {
+			continue
+		}
+		formatted, err := formatLawDetail(result.Detail, showArticles || showTOC)
+		if err != nil {
+			return err
+		}
+		if !first {
+			fmt.Fprintln(writer)
+		}
+		first = false
+		fmt.Fprint(writer, formatted)
+	}
+	return nil
+}
+
+// writeDetailFiles writes each fetched detail to a file named after its ID
+func writeDetailFiles(results []api.DetailResult, dir string, writer io.Writer) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("출력 디렉터리를 만들 수 없습니다: %w", err)
+	}
+
+	written := 0
+	for _, result := range results {
+		if result.Err != nil {
+			continue
+		}
+		formatted, err := formatLawDetail(result.Detail, showArticles || showTOC)
+		if err != nil {
+			return err
+		}
+		path := filepath.Join(dir, unsafeFileChars.ReplaceAllString(result.ID, "_")+detailFileExt(outputFormat))
+		if err := os.WriteFile(path, []byte(formatted), 0644); err != nil {
+			return fmt.Errorf("파일 저장 실패 (%s): %w", path, err)
+		}
+		written++
+	}
+	fmt.Fprintf(writer, "%d개 파일을 %s에 저장했습니다.\n", written, dir)
+	return nil
+}
+
+// detailFileExt returns the file extension of a detail format
+func detailFileExt(format string) string {
+	switch format {
+	case "json":
+		return ".json"
+	case "xml":
+		return ".xml"
+	case "markdown", "md":
+		return ".md"
+	case "html":
+		return ".html"
+	default:
+		return ".txt"
+	}
+}
@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/logger"
+	outputPkg "github.com/pyhub-apps/pyhub-warp-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var lawDiffCmd *cobra.Command
+
+// initLawDiffCmd initializes the law diff command
+func initLawDiffCmd() {
+	lawDiffCmd = &cobra.Command{
+		Use:   "diff <이전 법령ID> <이후 법령ID>",
+		Short: "두 법령의 조문 비교 (추가/삭제/변경)",
+		Long: `두 법령의 조문을 조문번호로 짝지어 추가, 삭제, 변경된 조문을 보여줍니다.
+
+변경된 조문은 내용을 줄 단위로 비교해 삭제된 줄은 빨간색(-), 추가된 줄은 초록색(+)으로 표시합니다.
+개정으로 번호가 바뀐 조문(조문이동이전/이후)은 이전 번호의 조문과 비교합니다.
+같은 법령의 다른 버전은 법령일련번호로 지정합니다 ('warp law history'로 확인).`,
+		Example: `  # 개정 전후 조문 비교
+  warp law diff 228817 253527
+
+  # 개정 영향 검토 문서에 붙일 markdown
+  warp law diff 228817 253527 --format markdown > diff.md
+
+  # 구조화된 diff (스크립트용)
+  warp law diff 228817 253527 --format json`,
+		Args: cobra.ExactArgs(2),
+		RunE: runLawDiffCommand,
+	}
+
+	lawDiffCmd.Flags().StringVarP(&outputFormat, "format", "f", "table", "출력 형식 (table, markdown, json)")
+}
+
+// updateLawDiffCommand updates law diff command descriptions
+func updateLawDiffCommand() {
+	if lawDiffCmd != nil {
+		lawDiffCmd.Short = "두 법령의 조문 비교 (추가/삭제/변경)"
+	}
+}
+
+func runLawDiffCommand(cmd *cobra.Command, args []string) error {
+	switch outputFormat {
+	case "table", "markdown", "md", "json":
+	default:
+		return fmt.Errorf("지원하지 않는 출력 형식: %s (table, markdown, json 중 선택)", outputFormat)
+	}
+
+	var details [2]*api.LawDetail
+	for i, arg := range args {
+		id := strings.TrimSpace(arg)
+		if id == "" {
+			return fmt.Errorf("법령ID를 입력하세요")
+		}
+		detail, err := fetchComparedDetail(id)
+		if err != nil {
+			return err
+		}
+		if len(detail.Articles) == 0 {
+			return fmt.Errorf("조문이 없는 법령입니다: %s", id)
+		}
+		details[i] = detail
+	}
+
+	d := outputPkg.NewLawDiff(details[0], details[1])
+	logger.Info("조문 비교: 추가 %d개, 삭제 %d개, 변경 %d개, 이동 %d개",
+		d.Summary[outputPkg.DiffAdded], d.Summary[outputPkg.DiffRemoved], d.Summary[outputPkg.DiffChanged], d.Summary[outputPkg.DiffMoved])
+
+	result, err := outputPkg.NewFormatter(outputFormat).FormatLawDiff(d)
+	if err != nil {
+		return err
+	}
+	fmt.Fprint(cmd.OutOrStdout(), result)
+	return nil
+}
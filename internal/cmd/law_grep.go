@@ -0,0 +1,250 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/i18n"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/logger"
+	outputPkg "github.com/pyhub-apps/pyhub-warp-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// grepOptions holds the flags of law grep
+type grepOptions struct {
+	query       string
+	max         int
+	concurrency int
+	rate        float64
+	context     int
+	format      string
+}
+
+var (
+	lawGrepCmd *cobra.Command
+	lawGrep    grepOptions
+)
+
+// grepLaw is a law whose articles contain the keyword
+type grepLaw struct {
+	ID       string                   `json:"id"`
+	Name     string                   `json:"name"`
+	LawType  string                   `json:"law_type,omitempty"`
+	Articles []outputPkg.ArticleMatch `json:"articles"`
+}
+
+// grepFailure is a candidate whose detail could not be fetched
+type grepFailure struct {
+	ID    string `json:"id"`
+	Error string `json:"error"`
+}
+
+// grepReport is the json output of law grep
+type grepReport struct {
+	Keyword    string        `json:"keyword"`
+	Query      string        `json:"query"`
+	Candidates int           `json:"candidates"`
+	Laws       []grepLaw     `json:"laws"`
+	Failures   []grepFailure `json:"failures,omitempty"`
+}
+
+// initLawGrepCmd initializes the law grep command
+func initLawGrepCmd() {
+	lawGrepCmd = &cobra.Command{
+		Use:     "grep <키워드>",
+		Short:   i18n.T("law.grep.short"),
+		Long:    i18n.T("law.grep.long"),
+		Example: i18n.T("law.grep.example"),
+		Args:    cobra.ExactArgs(1),
+		RunE:    runLawGrepCommand,
+	}
+
+	lawGrepCmd.Flags().StringVarP(&lawGrep.query, "query", "q", "", i18n.T("law.grep.flag.query"))
+	lawGrepCmd.Flags().IntVar(&lawGrep.max, "max", 20, i18n.T("law.grep.flag.max"))
+	lawGrepCmd.Flags().IntVar(&lawGrep.concurrency, "concurrency", 4, i18n.T("law.grep.flag.concurrency"))
+	lawGrepCmd.Flags().Float64Var(&lawGrep.rate, "rate", 5, i18n.T("law.grep.flag.rate"))
+	lawGrepCmd.Flags().IntVar(&lawGrep.context, "context", outputPkg.DefaultExcerptContext, i18n.T("law.grep.flag.context"))
+	lawGrepCmd.Flags().StringVarP(&lawGrep.format, "format", "f", "table", i18n.T("law.grep.flag.format"))
+}
+
+// updateLawGrepCommand updates law grep command descriptions
+func updateLawGrepCommand() {
+	if lawGrepCmd == nil {
+		return
+	}
+	lawGrepCmd.Short = i18n.T("law.grep.short")
+	lawGrepCmd.Long = i18n.T("law.grep.long")
+	lawGrepCmd.Example = i18n.T("law.grep.example")
+	for name, id := range map[string]string{
+		"query":       "law.grep.flag.query",
+		"max":         "law.grep.flag.max",
+		"concurrency": "law.grep.flag.concurrency",
+		"rate":        "law.grep.flag.rate",
+		"context":     "law.grep.flag.context",
+		"format":      "law.grep.flag.format",
+	} {
+		if flag := lawGrepCmd.Flags().Lookup(name); flag != nil {
+			flag.Usage = i18n.T(id)
+		}
+	}
+}
+
+// validate checks the flags of law grep
+func (g *grepOptions) validate() error {
+	if g.format != "table" && g.format != "json" {
+		return errors.New(i18n.Tf("law.grep.error.format", g.format))
+	}
+	if g.max < 1 {
+		return errors.New(i18n.Tf("law.grep.error.atLeastOne", "--max"))
+	}
+	if g.concurrency < 1 {
+		return errors.New(i18n.Tf("law.grep.error.atLeastOne", "--concurrency"))
+	}
+	if g.rate < 0 {
+		return errors.New(i18n.Tf("law.grep.error.notNegative", "--rate"))
+	}
+	if g.context < 0 {
+		return errors.New(i18n.Tf("law.grep.error.notNegative", "--context"))
+	}
+	return nil
+}
+
+func runLawGrepCommand(cmd *cobra.Command, args []string) error {
+	keyword := strings.TrimSpace(args[0])
+	if keyword == "" {
+		return errors.New(i18n.T("law.grep.error.emptyKeyword"))
+	}
+	if err := lawGrep.validate(); err != nil {
+		return err
+	}
+	query := strings.TrimSpace(lawGrep.query)
+	if query == "" {
+		query = keyword
+	}
+
+	var client api.ClientInterface
+	if testDetailClient != nil {
+		client = testDetailClient
+	} else {
+		c, err := api.CreateClientWithOptions(api.APITypeNLIC, cacheOption())
+		if err != nil {
+			logger.Error("Failed to create API client: %v", err)
+			return err
+		}
+		client = c
+	}
+
+	ctx := startSearch(cmd, query, "nlic", lawGrep.format, 1, lawGrep.max)
+	rc, err := searchRequest(ctx)
+	if err != nil {
+		return err
+	}
+	resp, err := fetchLaws(ctx, client, rc)
+	if err != nil {
+		return lawResolveError(cmd, err)
+	}
+	finishSearch(ctx)
+
+	var ids []string
+	laws := make(map[string]api.LawInfo)
+	for _, law := range resp.Laws {
+		id := api.DetailID(law)
+		if id == "" || len(ids) >= lawGrep.max {
+			continue
+		}
+		if _, ok := laws[id]; !ok {
+			ids = append(ids, id)
+			laws[id] = law
+		}
+	}
+	if len(ids) == 0 {
+		return errors.New(i18n.Tf("law.grep.error.noCandidates", query))
+	}
+
+	fetch := func(ctx context.Context, id string) (*api.LawDetail, error) {
+		_, lawID := api.ParseDetailID(id, client.GetAPIType())
+		ctx, cancel := context.WithTimeout(ctx, api.Timeout())
+		defer cancel()
+		return client.GetDetail(ctx, lawID)
+	}
+	logger.Info(i18n.Tf("law.grep.searching", len(ids), keyword, lawGrep.concurrency))
+	results := api.GetDetails(ctx, ids, fetch, api.BatchOptions{
+		Concurrency: lawGrep.concurrency,
+		Interval:    rateInterval(lawGrep.rate),
+	})
+
+	report := grepReport{Keyword: keyword, Query: query, Candidates: len(ids), Laws: []grepLaw{}}
+	for _, result := range results {
+		if result.Err != nil {
+			logger.Warn(i18n.Tf("law.grep.detailFailed", result.ID, result.Err))
+			report.Failures = append(report.Failures, grepFailure{ID: result.ID, Error: wrapAPIError(result.Err).Error()})
+			continue
+		}
+		matches := outputPkg.GrepArticles(result.Detail.Articles, keyword, lawGrep.context)
+		if len(matches) == 0 {
+			continue
+		}
+		law := laws[result.ID]
+		name := result.Detail.Name
+		if name == "" {
+			name = law.Name
+		}
+		report.Laws = append(report.Laws, grepLaw{ID: result.ID, Name: name, LawType: law.LawType, Articles: matches})
+	}
+	if len(report.Failures) == len(ids) {
+		return errors.New(i18n.Tf("law.grep.error.allFailed", len(ids)))
+	}
+
+	return writeGrepReport(cmd.OutOrStdout(), rc.Format, report,
+		outputPkg.GetDefaultTableStyle().UseColor)
+}
+
+// writeGrepReport writes the laws and article excerpts of report as json, or
+// as a list with the keyword highlighted when useColor is set
+func writeGrepReport(w io.Writer, format string, report grepReport, useColor bool) error {
+	if format == "json" {
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return fmt.Errorf("%s: %w", i18n.T("law.grep.error.json"), err)
+		}
+		fmt.Fprintln(w, string(data))
+		return nil
+	}
+
+	if len(report.Laws) == 0 {
+		fmt.Fprintln(w, i18n.Tf("law.grep.notFound", report.Candidates, report.Keyword))
+		return nil
+	}
+
+	articles := 0
+	for _, law := range report.Laws {
+		fmt.Fprintf(w, "■ %s", law.Name)
+		if law.LawType != "" {
+			fmt.Fprintf(w, " [%s]", law.LawType)
+		}
+		fmt.Fprintf(w, " (%s)\n", law.ID)
+		for _, article := range law.Articles {
+			label := articleLabel(article.Number)
+			if article.Title != "" {
+				label += "(" + article.Title + ")"
+			}
+			fmt.Fprintf(w, "  %s\n", label)
+			for _, excerpt := range article.Excerpts {
+				fmt.Fprintf(w, "    %s\n", outputPkg.HighlightKeyword(excerpt, report.Keyword, useColor))
+			}
+			articles++
+		}
+		fmt.Fprintln(w)
+	}
+	fmt.Fprint(w, i18n.Tf("law.grep.summary", report.Candidates, len(report.Laws), articles, report.Keyword))
+	if len(report.Failures) > 0 {
+		fmt.Fprint(w, i18n.Tf("law.grep.failures", len(report.Failures)))
+	}
+	fmt.Fprintln(w)
+	return nil
+}
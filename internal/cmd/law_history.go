@@ -5,9 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"strings"
-	"time"
 
 	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	cliErrors "github.com/pyhub-apps/pyhub-warp-cli/internal/errors"
 	"github.com/pyhub-apps/pyhub-warp-cli/internal/i18n"
 	"github.com/pyhub-apps/pyhub-warp-cli/internal/logger"
 	outputPkg "github.com/pyhub-apps/pyhub-warp-cli/internal/output"
@@ -17,17 +17,21 @@ import (
 var (
 	lawHistoryCmd *cobra.Command
 	historyLimit  int
+	historyFirst  bool
 )
 
 // initLawHistoryCmd initializes the law history command
 func initLawHistoryCmd() {
 	lawHistoryCmd = &cobra.Command{
-		Use:   "history <법령ID>",
+		Use:   "history <법령ID|법령명>",
 		Short: i18n.T("law.history.short"),
 		Long:  i18n.T("law.history.long"),
 		Example: `  # 법령ID로 이력 조회
   warp law history 001234
   
+  # 법령명으로 이력 조회
+  warp law history "개인정보 보호법"
+  
   # 최근 10개만 조회
   warp law history 001234 --limit 10
   
@@ -40,6 +44,7 @@ func initLawHistoryCmd() {
 	// Flags
 	lawHistoryCmd.Flags().StringVarP(&outputFormat, "format", "f", "table", i18n.T("law.flag.format"))
 	lawHistoryCmd.Flags().IntVarP(&historyLimit, "limit", "l", 0, i18n.T("law.history.flag.limit"))
+	lawHistoryCmd.Flags().BoolVar(&historyFirst, "first", false, "법령명으로 여러 법령이 검색되면 묻지 않고 첫 번째 법령 선택")
 }
 
 // updateLawHistoryCommand updates law history command descriptions
@@ -55,6 +60,9 @@ func updateLawHistoryCommand() {
 		if flag := lawHistoryCmd.Flags().Lookup("limit"); flag != nil {
 			flag.Usage = i18n.T("law.history.flag.limit")
 		}
+		if flag := lawHistoryCmd.Flags().Lookup("first"); flag != nil {
+			flag.Usage = "법령명으로 여러 법령이 검색되면 묻지 않고 첫 번째 법령 선택"
+		}
 	}
 }
 
@@ -65,19 +73,34 @@ func runLawHistoryCommand(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf(i18n.T("law.history.error.emptyID"))
 	}
 
-	logger.Info(i18n.Tf("law.history.searching", lawID))
-
 	// Create API client
-	client, err := api.CreateDefaultClient()
-	if err != nil {
-		logger.Error("Failed to create API client: %v", err)
-		return err
+	var client api.ClientInterface
+	if testDetailClient != nil {
+		client = testDetailClient
+	} else {
+		c, err := api.CreateClientWithOptions(api.APITypeNLIC, cacheOption())
+		if err != nil {
+			logger.Error("Failed to create API client: %v", err)
+			return err
+		}
+		client = c
 	}
 
 	// Get law history with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), api.Timeout())
 	defer cancel()
 
+	// A law name is looked up in the search for its ID
+	if !isLawID(lawID) {
+		id, err := resolveLawName(ctx, cmd, client, lawID, historyFirst)
+		if err != nil {
+			return lawResolveError(cmd, err)
+		}
+		lawID = id
+	}
+
+	logger.Info(i18n.Tf("law.history.searching", lawID))
+
 	history, err := client.GetHistory(ctx, lawID)
 	if err != nil {
 		// Check if it's an API key error
@@ -85,11 +108,14 @@ func runLawHistoryCommand(cmd *cobra.Command, args []string) error {
 		if errors.As(err, &apiKeyErr) {
 			// Print error message without help
 			fmt.Fprintln(cmd.OutOrStdout(), err.Error())
-			// Return nil to suppress both error message and help
-			return nil
+			// Return it as displayed to suppress both error message and help
+			return cliErrors.Displayed(wrapAPIError(err))
 		}
 
 		logger.Error("Failed to get law history: %v", err)
+		if apiErr := wrapAPIError(err); apiErr != err {
+			return apiErr
+		}
 		return fmt.Errorf(i18n.T("law.history.error.failed"), err)
 	}
 
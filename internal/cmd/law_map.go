@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/logger"
+	outputPkg "github.com/pyhub-apps/pyhub-warp-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var (
+	lawMapCmd    *cobra.Command
+	mapThreshold float64
+)
+
+// initLawMapCmd initializes the law map command
+func initLawMapCmd() {
+	lawMapCmd = &cobra.Command{
+		Use:   "map <법령ID1> <법령ID2>",
+		Short: "두 법령의 조문 대응표 출력",
+		Long: `두 법령의 조문을 짝지어 좌우 대응표로 출력합니다.
+
+같은 번호의 조문은 제목이 같거나 유사도가 임계값 이상이면 대응시키고,
+남은 조문은 제목과 내용의 유사도가 높은 쌍부터 대응시킵니다.
+첫 번째 법령에만 있는 조문은 삭제, 두 번째 법령에만 있는 조문은 신설로 표시합니다.`,
+		Example: `  # 두 법령의 조문 대응표
+  warp law map 001234 005678
+
+  # 유사도 기준을 높여 확실한 대응만 표시
+  warp law map 001234 005678 --threshold 0.7
+
+  # 개정 영향 분석 문서에 붙일 markdown 표
+  warp law map 001234 005678 --format markdown > map.md`,
+		Args: cobra.ExactArgs(2),
+		RunE: runLawMapCommand,
+	}
+
+	lawMapCmd.Flags().StringVarP(&outputFormat, "format", "f", "table", "출력 형식 (table, markdown, json)")
+	lawMapCmd.Flags().Float64Var(&mapThreshold, "threshold", outputPkg.DefaultMapThreshold, "조문을 대응시킬 최소 유사도 (0~1)")
+}
+
+// updateLawMapCommand updates law map command descriptions
+func updateLawMapCommand() {
+	if lawMapCmd != nil {
+		lawMapCmd.Short = "두 법령의 조문 대응표 출력"
+	}
+}
+
+func runLawMapCommand(cmd *cobra.Command, args []string) error {
+	if mapThreshold < 0 || mapThreshold > 1 {
+		return fmt.Errorf("잘못된 임계값: %g (0에서 1 사이로 지정하세요)", mapThreshold)
+	}
+	switch outputFormat {
+	case "table", "markdown", "md", "json":
+	default:
+		return fmt.Errorf("지원하지 않는 출력 형식: %s (table, markdown, json 중 선택)", outputFormat)
+	}
+
+	var details [2]*api.LawDetail
+	for i, arg := range args {
+		id := strings.TrimSpace(arg)
+		if id == "" {
+			return fmt.Errorf("법령ID를 입력하세요")
+		}
+		detail, err := fetchComparedDetail(id)
+		if err != nil {
+			return err
+		}
+		if len(detail.Articles) == 0 {
+			return fmt.Errorf("조문이 없는 법령입니다: %s", id)
+		}
+		details[i] = detail
+	}
+
+	m := outputPkg.NewArticleMap(details[0], details[1], mapThreshold)
+	logger.Info("조문 대응: 대응 %d개, 신설 %d개, 삭제 %d개",
+		m.Summary[outputPkg.MapMatched], m.Summary[outputPkg.MapAdded], m.Summary[outputPkg.MapRemoved])
+
+	result, err := outputPkg.NewFormatter(outputFormat).FormatArticleMap(m)
+	if err != nil {
+		return err
+	}
+	fmt.Fprint(cmd.OutOrStdout(), result)
+	return nil
+}
+
+// fetchComparedDetail fetches the detail of a law to compare. IDs of a unified search
+// carry their source ("elis:456").
+func fetchComparedDetail(id string) (*api.LawDetail, error) {
+	source, lawID := api.ParseDetailID(id, api.APITypeNLIC)
+
+	var client api.ClientInterface
+	if testDetailClient != nil {
+		client = testDetailClient
+	} else {
+		c, err := api.CreateClient(source)
+		if err != nil {
+			logger.Error("Failed to create API client: %v", err)
+			return nil, err
+		}
+		client = c
+	}
+
+	logger.Info("법령 상세 조회 중: %s", lawID)
+	ctx, cancel := context.WithTimeout(context.Background(), api.Timeout())
+	defer cancel()
+
+	detail, err := client.GetDetail(ctx, lawID)
+	if err != nil {
+		logger.Error("Failed to get law detail: %v", err)
+		if apiErr := wrapAPIError(err); apiErr != err {
+			return nil, apiErr
+		}
+		return nil, fmt.Errorf("법령 상세 조회 실패 (%s): %w", id, err)
+	}
+	return detail, nil
+}
@@ -0,0 +1,133 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/logger"
+	"github.com/spf13/cobra"
+)
+
+// resolvePageSize is the number of search results considered as candidates for a law name
+const resolvePageSize = 10
+
+// isLawID reports whether the argument of law detail or history is a law ID
+// rather than a law name. Law IDs and serial numbers are digits ("001234"),
+// optionally after the source prefix written by --ids-only ("elis:456");
+// anything else is searched as a law name.
+func isLawID(arg string) bool {
+	_, id := api.ParseDetailID(arg, api.APITypeNLIC)
+	if id == "" {
+		return false
+	}
+	for _, r := range id {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// resolveLawName searches name with client and returns the ID of the law it
+// names. A single law whose name or abbreviation equals name, ignoring spaces,
+// is taken as is. Otherwise the candidates are offered for selection on a
+// terminal, the first is taken with first, and an error lists them elsewhere.
+func resolveLawName(ctx context.Context, cmd *cobra.Command, client api.ClientInterface, name string, first bool) (string, error) {
+	logger.Info("법령명으로 법령ID 조회 중: %s", name)
+
+	resp, err := client.Search(ctx, &api.UnifiedSearchRequest{
+		Query:    name,
+		PageNo:   1,
+		PageSize: resolvePageSize,
+		Type:     "JSON",
+	})
+	if err != nil {
+		return "", err
+	}
+
+	candidates := lawNameCandidates(name, resp.Laws)
+	if len(candidates) == 0 {
+		return "", fmt.Errorf("'%s'에 해당하는 법령을 찾을 수 없습니다", name)
+	}
+
+	law := candidates[0]
+	if len(candidates) > 1 && !first {
+		if !isInteractiveTerminal() {
+			return "", fmt.Errorf("'%s'에 해당하는 법령이 %d개입니다. 법령ID를 지정하거나 --first로 첫 번째 법령을 선택하세요:\n%s",
+				name, len(candidates), lawCandidateList(candidates))
+		}
+		selected, err := selectLawCandidate(cmd, name, candidates)
+		if err != nil {
+			return "", err
+		}
+		law = selected
+	}
+
+	_, id := api.ParseDetailID(api.DetailID(law), client.GetAPIType())
+	logger.Info("법령명 '%s' → %s (%s)", name, law.Name, id)
+	return id, nil
+}
+
+// lawNameCandidates returns the laws whose name or abbreviation equals name,
+// ignoring spaces, or all the laws found when none does
+func lawNameCandidates(name string, laws []api.LawInfo) []api.LawInfo {
+	key := strings.ReplaceAll(name, " ", "")
+	var exact, found []api.LawInfo
+	for _, law := range laws {
+		if api.DetailID(law) == "" {
+			continue
+		}
+		found = append(found, law)
+		if strings.ReplaceAll(law.Name, " ", "") == key ||
+			(law.NameAbbrev != "" && strings.ReplaceAll(law.NameAbbrev, " ", "") == key) {
+			exact = append(exact, law)
+		}
+	}
+	if len(exact) > 0 {
+		return exact
+	}
+	return found
+}
+
+// lawCandidateList writes the candidates one per line with their number and ID
+func lawCandidateList(candidates []api.LawInfo) string {
+	var b strings.Builder
+	for i, law := range candidates {
+		fmt.Fprintf(&b, "  %d. %s", i+1, law.Name)
+		if law.LawType != "" {
+			fmt.Fprintf(&b, " [%s]", law.LawType)
+		}
+		fmt.Fprintf(&b, " (%s)\n", api.DetailID(law))
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// selectLawCandidate lists the candidates and reads the number of the law to use
+func selectLawCandidate(cmd *cobra.Command, name string, candidates []api.LawInfo) (api.LawInfo, error) {
+	writer := cmd.ErrOrStderr()
+	fmt.Fprintf(writer, "'%s'에 해당하는 법령이 %d개입니다.\n%s\n", name, len(candidates), lawCandidateList(candidates))
+	fmt.Fprintf(writer, "번호를 선택하세요 [1-%d]: ", len(candidates))
+
+	answer, _ := bufio.NewReader(cmd.InOrStdin()).ReadString('\n')
+	n, err := strconv.Atoi(strings.TrimSpace(answer))
+	if err != nil || n < 1 || n > len(candidates) {
+		return api.LawInfo{}, fmt.Errorf("잘못된 번호입니다: %s (1-%d 중 선택)", strings.TrimSpace(answer), len(candidates))
+	}
+	return candidates[n-1], nil
+}
+
+// lawResolveError reports a failed name lookup like the detail and history
+// commands report their own API errors
+func lawResolveError(cmd *cobra.Command, err error) error {
+	var apiKeyErr *api.APIKeyError
+	if errors.As(err, &apiKeyErr) {
+		fmt.Fprintln(cmd.OutOrStdout(), err.Error())
+		return nil
+	}
+	return wrapAPIError(err)
+}
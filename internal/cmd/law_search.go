@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"io"
 	"strings"
-	"time"
 
 	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
 	cliErrors "github.com/pyhub-apps/pyhub-warp-cli/internal/errors"
@@ -14,6 +13,7 @@ import (
 	"github.com/pyhub-apps/pyhub-warp-cli/internal/logger"
 	"github.com/pyhub-apps/pyhub-warp-cli/internal/onboarding"
 	outputPkg "github.com/pyhub-apps/pyhub-warp-cli/internal/output"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/pipeline"
 	"github.com/spf13/cobra"
 )
 
@@ -34,16 +34,45 @@ func initLawSearchCmd() {
   warp law search "도로교통법" --format json
   
   # 페이지네이션 옵션
-  warp law search "민법" --page 2 --size 20`,
-		Args: cobra.ExactArgs(1),
+  warp law search "민법" --page 2 --size 20
+  
+  # 법률-시행령-시행규칙 계층 트리로 표시
+  warp law search "도로교통법" --tree
+  
+  # 법령명 한자 열 추가
+  warp law search "민법" --hanja
+  
+  # 여러 검색어를 한 번에 검색 (한 줄에 하나)
+  cat queries.txt | warp law search --stdin --format json
+  warp law search --queries-file queries.txt --format csv --concurrency 2`,
+		Args: lawSearchArgs,
 		RunE: runLawSearchCommand,
 	}
 
 	// Flags
-	lawSearchCmd.Flags().StringVarP(&outputFormat, "format", "f", "table", i18n.T("law.flag.format"))
+	lawSearchCmd.Flags().StringVarP(&outputFormat, "format", "f", "table", i18n.T("law.flag.searchFormat"))
+	lawSearchCmd.Flags().StringVar(&lawJSONSchema, "json-schema", outputPkg.SchemaRaw, i18n.T("law.flag.jsonSchema"))
 	lawSearchCmd.Flags().IntVarP(&pageNo, "page", "p", 1, i18n.T("law.flag.page"))
-	lawSearchCmd.Flags().IntVarP(&pageSize, "size", "s", 50, i18n.T("law.flag.size"))
+	lawSearchCmd.Flags().IntVarP(&pageSize, "size", "s", api.DefaultPageSize, i18n.T("law.flag.size"))
 	lawSearchCmd.Flags().StringVar(&sourceFlag, "source", "nlic", i18n.T("law.flag.source"))
+	lawSearchCmd.Flags().BoolVar(&lawEffect.upcoming, "upcoming", false, i18n.T("law.flag.upcoming"))
+	lawSearchCmd.Flags().BoolVar(&lawEffect.inForce, "in-force", false, i18n.T("law.flag.inForce"))
+	lawSearchCmd.Flags().StringVar(&lawEffect.asOf, "as-of", "", i18n.T("law.flag.asOf"))
+	addValueFilterFlags(lawSearchCmd, &lawValues)
+	addQueryMatchFlags(lawSearchCmd, &lawMatch)
+	addFieldFilterFlags(lawSearchCmd, &lawFilters)
+	addRecordFlags(lawSearchCmd, &lawRecords)
+	lawSearchCmd.Flags().BoolVar(&lawTree, "tree", false, i18n.T("law.flag.tree"))
+	addNotifyFlags(lawSearchCmd, &lawNotify)
+	lawSearchCmd.Flags().BoolVar(&lawQuality, "quality-report", false, i18n.T("law.flag.qualityReport"))
+	lawSearchCmd.Flags().BoolVar(&lawFingerprint, "fingerprint", false, i18n.T("law.flag.fingerprint"))
+	lawSearchCmd.Flags().BoolVar(&lawHanja, "hanja", false, i18n.T("law.flag.hanja"))
+	lawSearchCmd.Flags().BoolVar(&lawCountOnly, "count-only", false, i18n.T("law.flag.countOnly"))
+	lawSearchCmd.Flags().BoolVar(&lawSummary, "summary", false, i18n.T("law.flag.summary"))
+	lawSearchCmd.Flags().BoolVar(&lawSuggest.retry, "suggest", false, i18n.T("law.flag.suggest"))
+	addAllPagesFlags(lawSearchCmd, &lawAll)
+	addFixedFlags(lawSearchCmd, &lawFixed)
+	addSearchBatchFlags(lawSearchCmd)
 }
 
 // updateLawSearchCommand updates law search command descriptions
@@ -54,7 +83,10 @@ func updateLawSearchCommand() {
 
 		// Update flag descriptions
 		if flag := lawSearchCmd.Flags().Lookup("format"); flag != nil {
-			flag.Usage = i18n.T("law.flag.format")
+			flag.Usage = i18n.T("law.flag.searchFormat")
+		}
+		if flag := lawSearchCmd.Flags().Lookup("json-schema"); flag != nil {
+			flag.Usage = i18n.T("law.flag.jsonSchema")
 		}
 		if flag := lawSearchCmd.Flags().Lookup("page"); flag != nil {
 			flag.Usage = i18n.T("law.flag.page")
@@ -62,18 +94,103 @@ func updateLawSearchCommand() {
 		if flag := lawSearchCmd.Flags().Lookup("size"); flag != nil {
 			flag.Usage = i18n.T("law.flag.size")
 		}
+		updateEffectFlagUsages(lawSearchCmd)
+		updateValueFilterFlagUsages(lawSearchCmd)
+		updateQueryMatchFlagUsages(lawSearchCmd)
+		updateFieldFilterFlagUsages(lawSearchCmd)
+		updateRecordFlagUsages(lawSearchCmd)
+		updateNotifyFlagUsages(lawSearchCmd)
+		if flag := lawSearchCmd.Flags().Lookup("tree"); flag != nil {
+			flag.Usage = i18n.T("law.flag.tree")
+		}
+		if flag := lawSearchCmd.Flags().Lookup("quality-report"); flag != nil {
+			flag.Usage = i18n.T("law.flag.qualityReport")
+		}
+		if flag := lawSearchCmd.Flags().Lookup("fingerprint"); flag != nil {
+			flag.Usage = i18n.T("law.flag.fingerprint")
+		}
+		if flag := lawSearchCmd.Flags().Lookup("hanja"); flag != nil {
+			flag.Usage = i18n.T("law.flag.hanja")
+		}
+		if flag := lawSearchCmd.Flags().Lookup("count-only"); flag != nil {
+			flag.Usage = i18n.T("law.flag.countOnly")
+		}
+		if flag := lawSearchCmd.Flags().Lookup("summary"); flag != nil {
+			flag.Usage = i18n.T("law.flag.summary")
+		}
+		if flag := lawSearchCmd.Flags().Lookup("suggest"); flag != nil {
+			flag.Usage = i18n.T("law.flag.suggest")
+		}
+		updateAllPagesFlagUsages(lawSearchCmd)
+		updateFixedFlagUsages(lawSearchCmd)
+		updateSearchBatchFlagUsages(lawSearchCmd)
 	}
 }
 
 func runLawSearchCommand(cmd *cobra.Command, args []string) error {
-	// Get search query
-	query := strings.TrimSpace(args[0])
-	if query == "" {
-		logger.Debug("Empty query provided")
-		return cliErrors.ErrEmptyQuery
+	// Get search query, or the queries of a batch
+	var query string
+	if searchBatch.requested() {
+		// A batch is written as json unless csv is asked for
+		if !cmd.Flags().Changed("format") {
+			outputFormat = "json"
+		}
+		if err := searchBatch.validate(outputFormat); err != nil {
+			return err
+		}
+	} else {
+		query = strings.TrimSpace(args[0])
+		if query == "" {
+			logger.Debug("Empty query provided")
+			return cliErrors.ErrEmptyQuery
+		}
+		logger.Debug("Starting law search for query: %s", query)
 	}
 
-	logger.Debug("Starting law search for query: %s", query)
+	if err := validatePageFlags(pageNo, pageSize); err != nil {
+		return err
+	}
+	if _, _, err := lawEffect.validate(); err != nil {
+		return err
+	}
+	if err := lawRecords.validate(); err != nil {
+		return err
+	}
+	if err := validateTreeOutput(lawTree, outputFormat, lawRecords); err != nil {
+		return err
+	}
+	if err := validateQualityReport(lawQuality, outputFormat, lawRecords, lawTree); err != nil {
+		return err
+	}
+	if err := validateFingerprint(lawFingerprint, outputFormat, lawRecords, lawTree, lawQuality); err != nil {
+		return err
+	}
+	if err := lawAll.validate(cmd); err != nil {
+		return err
+	}
+	if err := lawFixed.validate(outputFormat); err != nil {
+		return err
+	}
+	if err := lawNotify.validate(); err != nil {
+		return err
+	}
+	if err := validateCountOnly(lawCountOnly, outputFormat,
+		lawRecords.active() || lawTree || lawQuality || lawFingerprint || lawAll.all || lawEffect.active() || lawMatch.active() || lawFilters.active()); err != nil {
+		return err
+	}
+	if err := validateSummary(lawSummary, outputFormat, lawRecords, lawTree, lawQuality, lawCountOnly); err != nil {
+		return err
+	}
+	if err := lawMatch.validate(); err != nil {
+		return err
+	}
+	if err := lawFilters.validate(); err != nil {
+		return err
+	}
+	lawValues.warnUnknownDepartment()
+	if err := lawValues.checkLawTypes(); err != nil {
+		return err
+	}
 
 	// Use test client if available (for testing)
 	var client APIClient
@@ -81,21 +198,21 @@ func runLawSearchCommand(cmd *cobra.Command, args []string) error {
 		client = testAPIClient
 	} else {
 		// Create API client using the new factory
-		apiClient, err := api.CreateDefaultClient()
+		apiClient, err := api.CreateClientWithOptions(api.APITypeNLIC, cacheOption())
 		if err != nil {
 			// Check if it's an API key error (either CLIError or regular error with API key message)
 			var cliErr *cliErrors.CLIError
 			if errors.As(err, &cliErr) && cliErr.Code == cliErrors.ErrCodeNoAPIKey {
 				guide := onboarding.NewGuideWithWriter(cmd.OutOrStdout(), false)
 				guide.ShowAPIKeySetup()
-				return nil // Return nil to avoid printing the error twice
+				return cliErrors.Displayed(cliErrors.Wrap(err, cliErrors.ErrNoAPIKey)) // Shown by the guide
 			}
 
-			// Also check for direct API key error message from factory
-			if strings.Contains(err.Error(), "API 키가 설정되지 않았습니다") {
+			// Also check for the missing key error of the factory
+			if errors.Is(err, api.ErrNoAPIKey) {
 				guide := onboarding.NewGuideWithWriter(cmd.OutOrStdout(), false)
 				guide.ShowAPIKeySetup()
-				return nil // Return nil to avoid printing the error twice
+				return cliErrors.Displayed(cliErrors.Wrap(err, cliErrors.ErrNoAPIKey)) // Shown by the guide
 			}
 
 			verbose, _ := cmd.Flags().GetBool("verbose")
@@ -104,59 +221,189 @@ func runLawSearchCommand(cmd *cobra.Command, args []string) error {
 		}
 		client = apiClient
 	}
+	if searchBatch.requested() {
+		return runLawSearchBatch(cmd, client)
+	}
 
 	// Get verbose flag
 	verbose, _ := cmd.Flags().GetBool("verbose")
 
 	// Use searchLaws for the actual search logic
-	return searchLaws(client, query, outputFormat, pageNo, pageSize, cmd.OutOrStdout(), verbose)
+	lawSuggest.quiet = quietOutput(cmd)
+	lawAll.status = cmd.ErrOrStderr()
+	ctx := startSearch(cmd, query, sourceFlag, outputFormat, countOnlyPage(pageNo), countOnlySize(pageSize))
+	if err := searchLaws(ctx, client, cmd.OutOrStdout(), verbose); err != nil {
+		return err
+	}
+	finishSearch(ctx)
+
+	recordHistory(ctx, cmd)
+	if err := lawAll.failure(); err != nil {
+		return err
+	}
+	return failOnEmpty(cmd, searchFoundNothing(ctx))
+}
+
+// countOnlyPage returns the page searched by the law commands, the first
+// with --count-only
+func countOnlyPage(page int) int {
+	if lawCountOnly {
+		return 1
+	}
+	return page
 }
 
-// searchLaws performs the actual law search - reused from law.go
-func searchLaws(client APIClient, query string, format string, page int, size int, output io.Writer, verbose bool) error {
-	logger.Info(i18n.Tf("law.searching", query, page, size))
+// countOnlySize returns the page size searched by the law commands, one
+// result with --count-only
+func countOnlySize(size int) int {
+	if lawCountOnly {
+		return countOnlyPageSize
+	}
+	return size
+}
 
-	// Create search request
-	req := &api.UnifiedSearchRequest{
-		Query:    query,
-		Type:     "XML",
-		PageNo:   page,
-		PageSize: size,
+// lawSearchRequest returns the request of a law search, with the --law-type
+// and --department of the command so that the server filters by them. warp
+// prefetch builds the same request so that its cached responses answer later
+// searches. The quotes of a phrase query are not sent; the results are
+// filtered instead.
+func lawSearchRequest(query string, page, size int) *api.UnifiedSearchRequest {
+	query, _ = api.UnquoteQuery(query)
+	return &api.UnifiedSearchRequest{
+		Query:      query,
+		Type:       "XML",
+		PageNo:     page,
+		PageSize:   size,
+		LawType:    lawValues.lawType,
+		Department: pipeline.NormalizeDepartment(lawValues.department),
 	}
+}
+
+// fetchLaws runs the law search of rc with the API timeout and returns the
+// response before any post-processing. warp serve answers its requests with it.
+func fetchLaws(ctx context.Context, client APIClient, rc *api.RequestContext) (*api.SearchResponse, error) {
+	logger.Info(i18n.Tf("law.searching", rc.Query, rc.Page, rc.Size))
 
-	// Search with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, api.Timeout())
 	defer cancel()
 
-	resp, err := client.Search(ctx, req)
+	resp, err := client.Search(ctx, lawSearchRequest(rc.Query, rc.Page, rc.Size))
+	if err != nil {
+		return nil, err
+	}
+	logger.Info(i18n.Tf("law.searchComplete", resp.TotalCount, rc.Page, rc.Size))
+	return resp, nil
+}
+
+// searchLaws performs the actual law search described by the RequestContext of
+// ctx, written in its format - reused from law.go
+func searchLaws(ctx context.Context, client APIClient, output io.Writer, verbose bool) error {
+	rc, err := searchRequest(ctx)
+	if err != nil {
+		return err
+	}
+	format := rc.Format
+	var resp *api.SearchResponse
+	var stream *outputPkg.SearchStream
+	if lawAll.all {
+		// Formats written row by row get each page as it arrives
+		var onPage func(*api.SearchResponse) error
+		if lawAll.streams(format) {
+			if stream, onPage, err = lawAll.streamPages(ctx, format, output); err != nil {
+				return err
+			}
+		}
+		resp, err = lawAll.collect(ctx, client, rc, onPage)
+	} else {
+		resp, err = fetchLaws(ctx, client, rc)
+	}
 	if err != nil {
 		// Check if it's an API key error
 		var apiKeyErr *api.APIKeyError
 		if errors.As(err, &apiKeyErr) {
 			// Print error message without help
 			fmt.Fprintln(output, err.Error())
-			// Return nil to suppress both error message and help
-			return nil
+			// Return it as displayed to suppress both error message and help
+			return cliErrors.Displayed(wrapAPIError(err))
 		}
 
 		logger.LogError(err, verbose)
+		err = wrapAPIError(err)
 
 		// Show user-friendly error with hint
 		var cliErr *cliErrors.CLIError
 		if errors.As(err, &cliErr) {
 			guide := onboarding.NewGuideWithWriter(output, false)
 			guide.ShowError(err.Error())
-			return nil // Error already displayed
+			return cliErrors.Displayed(err) // Error already displayed
 		}
 		return err
 	}
+	noteResults(ctx, resp)
+
+	if stream != nil {
+		lawAll.reportStream(resp, stream)
+		return nil
+	}
 
-	logger.Info(i18n.Tf("law.searchComplete", resp.TotalCount, page, size))
+	// Only the total count is written, without rendering or post-processing the results
+	if lawCountOnly {
+		return writeSearchCount(output, format, resp)
+	}
 
-	// Format and output results using the formatter package
-	formatter := outputPkg.NewFormatter(format)
-	formattedOutput, err := formatter.FormatSearchResultToString(resp)
+	resp, err = transformSearchResults(ctx, resp)
 	if err != nil {
+		return err
+	}
+	learnVocabulary(ctx, resp)
+	resp = lawValues.apply(resp)
+	resp = lawMatch.apply(rc.Query, resp)
+
+	resp, err = lawEffect.apply(resp)
+	if err != nil {
+		return err
+	}
+	resp, err = lawFilters.apply(resp)
+	if err != nil {
+		return err
+	}
+	lawNotify.send(ctx, resp)
+	if lawFingerprint {
+		setFingerprint(resp)
+	}
+	if lawSummary {
+		setSummary(resp)
+	}
+
+	if lawQuality {
+		return writeQualityReport(output, format, resp.Laws)
+	}
+	if lawRecords.active() {
+		return lawRecords.write(output, resp.Laws)
+	}
+
+	fixedOpts, err := lawFixed.options(format)
+	if err != nil {
+		return err
+	}
+
+	// Collected pages are shown as one, without page navigation
+	navSize := rc.Size
+	if lawAll.all {
+		navSize = 0
+	}
+
+	// Format and output results using the formatter package
+	formatter := outputPkg.NewFormatter(format).
+		WithPagination(withPageSize(fmt.Sprintf("warp law %q", rc.Query), rc.Size), navSize).
+		WithJSONSchema(lawJSONSchema).
+		WithFixed(fixedOpts).
+		WithHanja(lawHanja)
+	if lawTree {
+		fmt.Fprint(output, formatter.FormatLawTree(resp))
+		return nil
+	}
+	if err := writeSearchOutput(formatter, format, resp, output); err != nil {
 		logger.Error("Failed to format output: %v", err)
 		return cliErrors.Wrap(err, cliErrors.New(
 			cliErrors.ErrCodeDataFormat,
@@ -164,9 +411,15 @@ func searchLaws(client APIClient, query string, format string, page int, size in
 			i18n.T("law.checkFormat"),
 		))
 	}
+	writeSummary(output, format, resp)
+	writeFingerprint(output, format, resp)
 
-	// Write formatted output
-	fmt.Fprint(output, formattedOutput)
+	// Suggest other queries after an empty search
+	if lawSuggest.shouldSuggest(format, resp) {
+		lawSuggest.show(ctx, client, "warp law", rc.Query, func(query string) *api.UnifiedSearchRequest {
+			return lawSearchRequest(query, 1, suggestPageSize)
+		}, output)
+	}
 
 	return nil
 }
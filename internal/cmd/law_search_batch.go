@@ -0,0 +1,223 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/i18n"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/logger"
+	outputPkg "github.com/pyhub-apps/pyhub-warp-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// searchBatchOptions holds the flags of a law search batch
+type searchBatchOptions struct {
+	stdin       bool
+	queriesFile string
+	concurrency int
+	rate        float64
+}
+
+var searchBatch searchBatchOptions
+
+// batchSearchEntry is an element of the JSON array written by a search batch
+type batchSearchEntry struct {
+	Query      string        `json:"query"`
+	TotalCount int           `json:"total_count"`
+	Laws       []api.LawInfo `json:"laws"`
+	Error      string        `json:"error,omitempty"`
+}
+
+// addSearchBatchFlags registers the batch flags on the law search command
+func addSearchBatchFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&searchBatch.stdin, "stdin", false, i18n.T("law.flag.stdin"))
+	cmd.Flags().StringVar(&searchBatch.queriesFile, "queries-file", "", i18n.T("law.flag.queriesFile"))
+	cmd.Flags().IntVar(&searchBatch.concurrency, "concurrency", 1, i18n.T("law.flag.concurrency"))
+	cmd.Flags().Float64Var(&searchBatch.rate, "rate", 5, i18n.T("law.flag.rate"))
+}
+
+// updateSearchBatchFlagUsages updates the descriptions of the batch flags
+func updateSearchBatchFlagUsages(cmd *cobra.Command) {
+	usages := map[string]string{
+		"stdin":        "law.flag.stdin",
+		"queries-file": "law.flag.queriesFile",
+		"concurrency":  "law.flag.concurrency",
+		"rate":         "law.flag.rate",
+	}
+	for name, key := range usages {
+		if flag := cmd.Flags().Lookup(name); flag != nil {
+			flag.Usage = i18n.T(key)
+		}
+	}
+}
+
+// lawSearchArgs requires a query unless the queries are given with --stdin or --queries-file
+func lawSearchArgs(cmd *cobra.Command, args []string) error {
+	if searchBatch.requested() {
+		if len(args) > 0 {
+			return fmt.Errorf("검색어 인자와 --stdin, --queries-file 옵션은 함께 사용할 수 없습니다")
+		}
+		return nil
+	}
+	return cobra.ExactArgs(1)(cmd, args)
+}
+
+// requested reports whether the queries of a batch are given
+func (b *searchBatchOptions) requested() bool {
+	return b.stdin || b.queriesFile != ""
+}
+
+// validate checks the batch flags and the search flags that only apply to a single query
+func (b *searchBatchOptions) validate(format string) error {
+	if b.stdin && b.queriesFile != "" {
+		return fmt.Errorf("--stdin과 --queries-file 옵션은 함께 사용할 수 없습니다")
+	}
+	if format != "json" && format != "csv" {
+		return fmt.Errorf("여러 검색어를 검색할 때는 json 또는 csv 형식만 사용할 수 있습니다")
+	}
+	if b.concurrency < 1 {
+		return fmt.Errorf("--concurrency는 1 이상이어야 합니다")
+	}
+	if b.rate < 0 {
+		return fmt.Errorf("--rate는 0 이상이어야 합니다")
+	}
+	if lawRecords.active() || lawTree || lawQuality || lawFingerprint || lawAll.all || lawCountOnly {
+		return fmt.Errorf("--pluck, --ids-only, --tree, --quality-report, --fingerprint, --all, --count-only 옵션은 검색어 하나를 검색할 때만 사용할 수 있습니다")
+	}
+	return nil
+}
+
+// collectQueries returns the queries of --stdin or --queries-file without
+// duplicates, in the order given. Blank lines and lines starting with # are skipped.
+func (b *searchBatchOptions) collectQueries(stdin io.Reader) ([]string, error) {
+	reader := stdin
+	if b.queriesFile != "" && b.queriesFile != "-" {
+		file, err := os.Open(b.queriesFile)
+		if err != nil {
+			return nil, fmt.Errorf("검색어 목록 파일을 열 수 없습니다: %w", err)
+		}
+		defer file.Close()
+		reader = file
+	}
+
+	seen := make(map[string]bool)
+	var queries []string
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || seen[line] {
+			continue
+		}
+		seen[line] = true
+		queries = append(queries, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("검색어 목록 읽기 실패: %w", err)
+	}
+	if len(queries) == 0 {
+		return nil, fmt.Errorf("검색할 검색어가 없습니다")
+	}
+	return queries, nil
+}
+
+// runLawSearchBatch searches several queries, at most --concurrency at a time,
+// and writes the results grouped by query. A failed query records its error
+// and the others go on.
+func runLawSearchBatch(cmd *cobra.Command, client APIClient) error {
+	queries, err := searchBatch.collectQueries(cmd.InOrStdin())
+	if err != nil {
+		return err
+	}
+
+	logger.Info("여러 검색어 검색 중... (%d건, 동시 요청 %d개)", len(queries), searchBatch.concurrency)
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	entries := make([]batchSearchEntry, len(queries))
+	errs := api.RunBatch(ctx, len(queries), api.BatchOptions{
+		Concurrency: searchBatch.concurrency,
+		Interval:    rateInterval(searchBatch.rate),
+	}, func(ctx context.Context, i int) error {
+		ctx, cancel := context.WithTimeout(ctx, api.Timeout())
+		defer cancel()
+
+		resp, err := client.Search(ctx, lawSearchRequest(queries[i], pageNo, pageSize))
+		if err != nil {
+			return err
+		}
+		resp = lawValues.apply(resp)
+		if resp, err = lawEffect.apply(resp); err != nil {
+			return err
+		}
+		entries[i].TotalCount = resp.TotalCount
+		entries[i].Laws = resp.Laws
+		return nil
+	})
+
+	failed := 0
+	for i, query := range queries {
+		entries[i].Query = query
+		if entries[i].Laws == nil {
+			entries[i].Laws = []api.LawInfo{}
+		}
+		if errs[i] != nil {
+			failed++
+			entries[i].Error = wrapAPIError(errs[i]).Error()
+			logger.Warn("검색 실패 (%s): %v", query, errs[i])
+		}
+	}
+	logger.Info("여러 검색어 검색 완료: %d건 중 %d건 성공, %d건 실패", len(queries), len(queries)-failed, failed)
+
+	if err := writeSearchBatch(cmd.OutOrStdout(), outputFormat, entries); err != nil {
+		return err
+	}
+	if failed == len(queries) {
+		return fmt.Errorf("모든 검색어의 검색에 실패했습니다 (%d건)", failed)
+	}
+	return nil
+}
+
+// writeSearchBatch writes the results of a search batch as a JSON array of
+// queries or as CSV rows led by their query
+func writeSearchBatch(w io.Writer, format string, entries []batchSearchEntry) error {
+	if format == "json" {
+		data, err := json.MarshalIndent(entries, "", "  ")
+		if err != nil {
+			return fmt.Errorf("JSON 변환 실패: %w", err)
+		}
+		fmt.Fprintln(w, string(data))
+		return nil
+	}
+
+	headers := []string{"검색어", "번호", "법령ID", "법령명", "법령종류", "소관부처", "시행일자", "오류"}
+	var rows [][]string
+	for _, entry := range entries {
+		if entry.Error != "" {
+			rows = append(rows, []string{entry.Query, "", "", "", "", "", "", entry.Error})
+			continue
+		}
+		for i, law := range entry.Laws {
+			effectDate := law.EffectDate
+			if effectDate == "" {
+				effectDate = law.PromulDate
+			}
+			rows = append(rows, []string{
+				entry.Query, strconv.Itoa(i + 1), api.DetailID(law), law.Name, law.LawType, law.Department, effectDate, "",
+			})
+		}
+	}
+	result, err := outputPkg.RenderCSV(headers, rows, true)
+	if err != nil {
+		return err
+	}
+	fmt.Fprint(w, result)
+	return nil
+}
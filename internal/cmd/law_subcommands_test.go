@@ -2,10 +2,23 @@ package cmd
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	cliErrors "github.com/pyhub-apps/pyhub-warp-cli/internal/errors"
 	"github.com/pyhub-apps/pyhub-warp-cli/internal/i18n"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/testutil"
 	"github.com/spf13/cobra"
 )
 
@@ -39,10 +52,11 @@ func TestLawSubcommands(t *testing.T) {
 			errContains: "검색어를 입력해주세요",
 		},
 		{
-			name:       "Search with valid query (no API key)",
-			args:       []string{"search", "테스트"},
-			wantErr:    false, // Shows API key setup guide, not an error
-			wantOutput: "API 설정이 필요합니다",
+			name:        "Search with valid query (no API key)",
+			args:        []string{"search", "테스트"},
+			wantErr:     true, // Shows API key setup guide, then exits as an authentication error
+			errContains: "API 키가 설정되지 않았습니다",
+			wantOutput:  "API 설정이 필요합니다",
 		},
 		// Detail subcommand tests
 		{
@@ -215,3 +229,749 @@ func TestLawBackwardCompatibility(t *testing.T) {
 		})
 	}
 }
+
+func TestWriteReferences(t *testing.T) {
+	var queries []string
+	client := &MockOrdinanceClient{
+		SearchFunc: func(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+			queries = append(queries, req.Query)
+			if req.Query == "개인정보 보호법" {
+				return &api.SearchResponse{TotalCount: 1, Laws: []api.LawInfo{{ID: "011357", Name: "개인정보 보호법"}}}, nil
+			}
+			return &api.SearchResponse{}, nil
+		},
+	}
+
+	detail := &api.LawDetail{
+		LawInfo: api.LawInfo{Name: "테스트법"},
+		Articles: []api.Article{
+			{Number: "3", Content: "「개인정보 보호법」 제17조 및 「없는법」 제1조에 따른다."},
+			{Number: "4", Content: "「개인정보 보호법」 제18조를 준용한다."},
+		},
+	}
+
+	var buf bytes.Buffer
+	writeReferences(context.Background(), client, detail, &buf)
+	output := buf.String()
+
+	for _, want := range []string{"인용 법령 (3건)", "011357", "제17조", "제18조", "없는법", "제3조"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output should contain %q, got:\n%s", want, output)
+		}
+	}
+	if len(queries) != 2 {
+		t.Errorf("expected 2 lookups for 2 unique laws, got %v", queries)
+	}
+
+	buf.Reset()
+	writeReferences(context.Background(), client, &api.LawDetail{}, &buf)
+	if !strings.Contains(buf.String(), "인용된 다른 법령이 없습니다") {
+		t.Errorf("expected no-citation message, got %q", buf.String())
+	}
+}
+
+func TestFilterDetailArticles(t *testing.T) {
+	newDetail := func() *api.LawDetail {
+		return &api.LawDetail{Articles: []api.Article{
+			{Number: "57", Title: "벌칙", Content: "제57조(벌칙) 벌금에 처한다."},
+			{Number: "58", Title: "과태료", Content: "제58조(과태료) 과태료를 부과한다."},
+		}}
+	}
+
+	detail := newDetail()
+	if err := filterDetailArticles(detail, "58", "", false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(detail.Articles) != 1 || detail.Articles[0].Number != "58" {
+		t.Errorf("expected only article 58, got %+v", detail.Articles)
+	}
+
+	err := filterDetailArticles(newDetail(), "58의2", "없는말", false)
+	if err == nil {
+		t.Fatal("expected error when no article matches")
+	}
+	for _, want := range []string{"제58조의2", "없는말", "전체 조문 2개"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error should contain %q, got %q", want, err.Error())
+		}
+	}
+}
+
+func TestLawDetailBatch(t *testing.T) {
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+
+	var inFlight, maxInFlight int32
+	testDetailClient = &MockOrdinanceClient{
+		GetDetailFunc: func(ctx context.Context, id string) (*api.LawDetail, error) {
+			n := atomic.AddInt32(&inFlight, 1)
+			defer atomic.AddInt32(&inFlight, -1)
+			for {
+				max := atomic.LoadInt32(&maxInFlight)
+				if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+
+			if id == "404" {
+				return nil, errors.New("법령을 찾을 수 없습니다")
+			}
+			return &api.LawDetail{LawInfo: api.LawInfo{ID: id, Name: "테스트법 " + id}}, nil
+		},
+	}
+	defer func() { testDetailClient = nil }()
+
+	newRoot := func() *cobra.Command {
+		initLawCmd()
+		root := &cobra.Command{Use: "test"}
+		root.AddCommand(lawCmd)
+		return root
+	}
+
+	t.Run("json array with partial failure", func(t *testing.T) {
+		out, err := testutil.ExecuteCommand(t, newRoot(), []string{"law", "detail", "--ids", "001,404,002,001,003,004,005", "--concurrency", "2", "--rate", "0", "--format", "json"})
+		if err != nil {
+			t.Fatalf("batch detail failed: %v", err)
+		}
+
+		var entries []batchDetailEntry
+		if err := json.Unmarshal([]byte(out), &entries); err != nil {
+			t.Fatalf("output is not a JSON array: %v\n%s", err, out)
+		}
+		wantIDs := []string{"001", "404", "002", "003", "004", "005"}
+		if len(entries) != len(wantIDs) {
+			t.Fatalf("expected %d entries (duplicates removed), got %d", len(wantIDs), len(entries))
+		}
+		for i, entry := range entries {
+			if entry.ID != wantIDs[i] {
+				t.Errorf("entry %d has ID %q, want %q", i, entry.ID, wantIDs[i])
+			}
+			if entry.ID == "404" {
+				if entry.Error == "" || entry.Detail != nil {
+					t.Errorf("failed ID should record its error, got %+v", entry)
+				}
+			} else if entry.Error != "" || entry.Detail == nil || entry.Detail.Name != "테스트법 "+entry.ID {
+				t.Errorf("unexpected entry %+v", entry)
+			}
+		}
+		if got := atomic.LoadInt32(&maxInFlight); got > 2 {
+			t.Errorf("at most 2 requests should be in flight, got %d", got)
+		}
+	})
+
+	t.Run("ids file and output dir", func(t *testing.T) {
+		dir := t.TempDir()
+		idsFile := filepath.Join(dir, "ids.txt")
+		if err := os.WriteFile(idsFile, []byte("# 상위 결과\n001\n\nelis:456\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		outDir := filepath.Join(dir, "laws")
+
+		out, err := testutil.ExecuteCommand(t, newRoot(), []string{"law", "detail", "--ids-file", idsFile, "--format", "markdown", "--output-dir", outDir})
+		if err != nil {
+			t.Fatalf("batch detail failed: %v", err)
+		}
+		if !strings.Contains(out, "2개 파일을") {
+			t.Errorf("output should report the files written, got %q", out)
+		}
+		for name, want := range map[string]string{"001.md": "# 테스트법 001", "elis_456.md": "# 테스트법 456"} {
+			data, err := os.ReadFile(filepath.Join(outDir, name))
+			if err != nil {
+				t.Errorf("expected file %s: %v", name, err)
+				continue
+			}
+			if !strings.Contains(string(data), want) {
+				t.Errorf("%s should contain %q, got %q", name, want, data)
+			}
+		}
+	})
+
+	t.Run("all failed", func(t *testing.T) {
+		_, err := testutil.ExecuteCommand(t, newRoot(), []string{"law", "detail", "--ids", "404", "--format", "json"})
+		if err == nil || !strings.Contains(err.Error(), "모든 법령의 상세 조회에 실패했습니다") {
+			t.Errorf("expected an error when every ID fails, got %v", err)
+		}
+	})
+
+	for _, tt := range []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"ID argument and --ids", []string{"law", "detail", "001", "--ids", "002"}, "함께 사용할 수 없습니다"},
+		{"invalid concurrency", []string{"law", "detail", "--ids", "001", "--concurrency", "0"}, "--concurrency는 1 이상"},
+		{"single law options", []string{"law", "detail", "--ids", "001", "--article", "3"}, "법령 하나를 조회할 때만"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := testutil.ExecuteCommand(t, newRoot(), tt.args)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestLawMap(t *testing.T) {
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+
+	laws := map[string]*api.LawDetail{
+		"001": {
+			LawInfo: api.LawInfo{ID: "001", Name: "테스트법"},
+			Articles: []api.Article{
+				{Number: "1", Title: "목적", Content: "제1조(목적) 이 법은 시험에 관한 사항을 정한다."},
+				{Number: "2", Title: "위원회", Content: "제2조(위원회) 위원회는 9명의 위원으로 구성한다."},
+			},
+		},
+		"002": {
+			LawInfo: api.LawInfo{ID: "002", Name: "테스트법 개정안"},
+			Articles: []api.Article{
+				{Number: "1", Title: "목적", Content: "제1조(목적) 이 법은 시험에 관한 사항을 정한다."},
+				{Number: "2", Title: "정의", Content: "제2조(정의) 이 법에서 사용하는 용어의 뜻은 다음과 같다."},
+				{Number: "3", Title: "위원회", Content: "제3조(위원회) 위원회는 9명의 위원으로 구성한다."},
+			},
+		},
+		"empty": {LawInfo: api.LawInfo{ID: "empty", Name: "빈 법령"}},
+	}
+	testDetailClient = &MockOrdinanceClient{
+		GetDetailFunc: func(ctx context.Context, id string) (*api.LawDetail, error) {
+			if law, ok := laws[id]; ok {
+				return law, nil
+			}
+			return nil, errors.New("법령을 찾을 수 없습니다")
+		},
+	}
+	defer func() { testDetailClient = nil }()
+
+	newRoot := func() *cobra.Command {
+		initLawCmd()
+		root := &cobra.Command{Use: "test"}
+		root.AddCommand(lawCmd)
+		return root
+	}
+
+	out, err := testutil.ExecuteCommand(t, newRoot(), []string{"law", "map", "001", "002", "--format", "json"})
+	if err != nil {
+		t.Fatalf("law map failed: %v", err)
+	}
+	var m struct {
+		Summary map[string]int `json:"summary"`
+		Rows    []struct {
+			Status string `json:"status"`
+			Left   *struct {
+				Number string `json:"number"`
+			} `json:"left"`
+			Right *struct {
+				Number string `json:"number"`
+			} `json:"right"`
+			MatchedBy string `json:"matched_by"`
+		} `json:"rows"`
+	}
+	if err := json.Unmarshal([]byte(out), &m); err != nil {
+		t.Fatalf("output is not json: %v\n%s", err, out)
+	}
+	if m.Summary["대응"] != 2 || m.Summary["신설"] != 1 || m.Summary["삭제"] != 0 || len(m.Rows) != 3 {
+		t.Fatalf("unexpected map: %s", out)
+	}
+	if m.Rows[2].Left.Number != "2" || m.Rows[2].Right.Number != "3" || m.Rows[2].MatchedBy != "similarity" {
+		t.Errorf("renumbered article should be matched by similarity: %s", out)
+	}
+
+	out, err = testutil.ExecuteCommand(t, newRoot(), []string{"law", "map", "001", "002"})
+	if err != nil {
+		t.Fatalf("law map table failed: %v", err)
+	}
+	if !strings.Contains(out, "제2조(정의)") || !strings.Contains(out, "대응 2, 신설 1, 삭제 0") {
+		t.Errorf("table output missing rows or summary:\n%s", out)
+	}
+
+	for _, tt := range []struct {
+		args []string
+		want string
+	}{
+		{[]string{"law", "map", "001", "002", "--threshold", "1.5"}, "잘못된 임계값"},
+		{[]string{"law", "map", "001", "002", "--format", "csv"}, "지원하지 않는 출력 형식"},
+		{[]string{"law", "map", "001", "empty"}, "조문이 없는 법령"},
+		{[]string{"law", "map", "001", "404"}, "404"},
+	} {
+		_, err := testutil.ExecuteCommand(t, newRoot(), tt.args)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%v: error = %v, want containing %q", tt.args, err, tt.want)
+		}
+	}
+}
+
+func TestLawDetailDownloadAttachments(t *testing.T) {
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/missing" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte("file" + r.URL.Path))
+	}))
+	defer server.Close()
+
+	testDetailClient = &MockOrdinanceClient{
+		GetDetailFunc: func(ctx context.Context, id string) (*api.LawDetail, error) {
+			return &api.LawDetail{
+				LawInfo: api.LawInfo{ID: id, Name: "테스트법"},
+				Attachments: []api.Attachment{
+					{Name: "서식.hwp", URL: server.URL + "/a"},
+					{Name: "서식.hwp", URL: server.URL + "/b"},
+					{Name: "없음.pdf", URL: server.URL + "/missing"},
+				},
+			}, nil
+		},
+	}
+	defer func() { testDetailClient = nil }()
+
+	newRoot := func() *cobra.Command {
+		initLawCmd()
+		root := &cobra.Command{Use: "test"}
+		root.AddCommand(lawCmd)
+		return root
+	}
+
+	dir := filepath.Join(t.TempDir(), "files")
+	out, err := testutil.ExecuteCommand(t, newRoot(), []string{"law", "detail", "001", "--download-attachments", dir, "--download-concurrency", "1"})
+	if err == nil || !strings.Contains(err.Error(), "3개 중 1개") {
+		t.Errorf("error = %v, want the failed download counted", err)
+	}
+	if !strings.Contains(out, "테스트법") {
+		t.Errorf("detail should be printed before downloading:\n%s", out)
+	}
+	for name, want := range map[string]string{"서식.hwp": "file/a", "서식 (2).hwp": "file/b"} {
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil || string(data) != want {
+			t.Errorf("%s = %q, %v; want %q", name, data, err, want)
+		}
+	}
+
+	for _, tt := range []struct {
+		args []string
+		want string
+	}{
+		{[]string{"law", "detail", "001", "--download-attachments", dir, "--download-concurrency", "0"}, "--download-concurrency"},
+		{[]string{"law", "detail", "--ids", "001,002", "--download-attachments", dir}, "법령 하나"},
+	} {
+		_, err := testutil.ExecuteCommand(t, newRoot(), tt.args)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%v: error = %v, want containing %q", tt.args, err, tt.want)
+		}
+	}
+}
+
+func TestLawDiff(t *testing.T) {
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+
+	laws := map[string]*api.LawDetail{
+		"100": {
+			LawInfo: api.LawInfo{ID: "100", Name: "테스트법"},
+			Articles: []api.Article{
+				{Number: "1", Title: "목적", Content: "제1조(목적) 이 법은 시험에 관한 사항을 정한다."},
+				{Number: "2", Title: "벌칙", Content: "제2조(벌칙) 100만원 이하의 벌금에 처한다."},
+			},
+		},
+		"200": {
+			LawInfo: api.LawInfo{ID: "200", Name: "테스트법"},
+			Articles: []api.Article{
+				{Number: "1", Title: "목적", Content: "제1조(목적) 이 법은 시험에 관한 사항을 정한다."},
+				{Number: "2", Title: "정의", Content: "제2조(정의) 용어의 뜻은 다음과 같다."},
+				{Number: "3", Title: "벌칙", Content: "제3조(벌칙) 500만원 이하의 벌금에 처한다.", MoveBefore: "2"},
+			},
+		},
+	}
+	testDetailClient = &MockOrdinanceClient{
+		GetDetailFunc: func(ctx context.Context, id string) (*api.LawDetail, error) {
+			if law, ok := laws[id]; ok {
+				return law, nil
+			}
+			return nil, errors.New("법령을 찾을 수 없습니다")
+		},
+	}
+	defer func() { testDetailClient = nil }()
+
+	newRoot := func() *cobra.Command {
+		initLawCmd()
+		root := &cobra.Command{Use: "test"}
+		root.AddCommand(lawCmd)
+		return root
+	}
+
+	out, err := testutil.ExecuteCommand(t, newRoot(), []string{"law", "diff", "100", "200", "--format", "json"})
+	if err != nil {
+		t.Fatalf("law diff failed: %v", err)
+	}
+	var d struct {
+		Summary  map[string]int `json:"summary"`
+		Articles []struct {
+			Status string `json:"status"`
+			Number string `json:"number"`
+			From   string `json:"from"`
+			Lines  []struct {
+				Op   string `json:"op"`
+				Text string `json:"text"`
+			} `json:"lines"`
+		} `json:"articles"`
+	}
+	if err := json.Unmarshal([]byte(out), &d); err != nil {
+		t.Fatalf("output is not json: %v\n%s", err, out)
+	}
+	if d.Summary["추가"] != 1 || d.Summary["변경"] != 1 || d.Summary["동일"] != 1 || len(d.Articles) != 2 {
+		t.Fatalf("unexpected diff: %s", out)
+	}
+	if moved := d.Articles[1]; moved.Status != "변경" || moved.Number != "3" || moved.From != "2" || len(moved.Lines) != 2 {
+		t.Errorf("moved article should be compared with its old number: %+v", moved)
+	}
+
+	out, err = testutil.ExecuteCommand(t, newRoot(), []string{"law", "diff", "100", "200"})
+	if err != nil {
+		t.Fatalf("law diff text failed: %v", err)
+	}
+	for _, want := range []string{"[변경] 제3조(벌칙) ← 제2조", "- 100만원 이하의 벌금에 처한다.", "+ 500만원 이하의 벌금에 처한다."} {
+		if !strings.Contains(out, want) {
+			t.Errorf("text output should contain %q:\n%s", want, out)
+		}
+	}
+
+	if _, err := testutil.ExecuteCommand(t, newRoot(), []string{"law", "diff", "100", "200", "--format", "csv"}); err == nil || !strings.Contains(err.Error(), "지원하지 않는 출력 형식") {
+		t.Errorf("csv error = %v", err)
+	}
+	if _, err := testutil.ExecuteCommand(t, newRoot(), []string{"law", "diff", "100", "404"}); err == nil {
+		t.Error("diff with a missing law should fail")
+	}
+}
+
+func TestIsLawID(t *testing.T) {
+	tests := []struct {
+		arg  string
+		want bool
+	}{
+		{"001234", true},
+		{"elis:2012345", true},
+		{"NLIC:253527", true},
+		{"개인정보 보호법", false},
+		{"개보법", false},
+		{"elis:서울특별시 주차장 조례", false},
+		{"민법 제3조", false},
+		{"elis:", false},
+	}
+	for _, tt := range tests {
+		if got := isLawID(tt.arg); got != tt.want {
+			t.Errorf("isLawID(%q) = %v, want %v", tt.arg, got, tt.want)
+		}
+	}
+}
+
+func TestLawDetailByName(t *testing.T) {
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+
+	origInteractive := isInteractiveTerminal
+	defer func() { isInteractiveTerminal = origInteractive }()
+	isInteractiveTerminal = func() bool { return false }
+
+	results := map[string][]api.LawInfo{
+		"개인정보 보호법": {
+			{ID: "011357", SerialNo: "253527", Name: "개인정보 보호법 시행령"},
+			{ID: "011356", SerialNo: "248613", Name: "개인정보 보호법", NameAbbrev: "개인정보보호법"},
+		},
+		"개보법": {{ID: "011356", SerialNo: "248613", Name: "개인정보 보호법", NameAbbrev: "개보법"}},
+		"개인정보": {
+			{ID: "011356", SerialNo: "248613", Name: "개인정보 보호법"},
+			{ID: "011357", SerialNo: "253527", Name: "개인정보 보호법 시행령"},
+		},
+	}
+	var searched, requested []string
+	testDetailClient = &MockOrdinanceClient{
+		SearchFunc: func(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+			searched = append(searched, req.Query)
+			return &api.SearchResponse{Laws: results[req.Query], TotalCount: len(results[req.Query])}, nil
+		},
+		GetDetailFunc: func(ctx context.Context, id string) (*api.LawDetail, error) {
+			requested = append(requested, id)
+			return &api.LawDetail{LawInfo: api.LawInfo{SerialNo: id, Name: "법령 " + id}}, nil
+		},
+		GetHistoryFunc: func(ctx context.Context, id string) (*api.LawHistory, error) {
+			requested = append(requested, id)
+			return &api.LawHistory{LawID: id, LawName: "법령 " + id}, nil
+		},
+	}
+	defer func() { testDetailClient = nil }()
+
+	newRoot := func() *cobra.Command {
+		initLawCmd()
+		root := &cobra.Command{Use: "test"}
+		root.AddCommand(lawCmd)
+		return root
+	}
+	run := func(args ...string) error {
+		searched, requested = nil, nil
+		_, err := testutil.ExecuteCommand(t, newRoot(), args)
+		return err
+	}
+
+	// An exact name, ignoring spaces, wins over other results
+	if err := run("law", "detail", "개인정보 보호법", "--format", "json"); err != nil {
+		t.Fatalf("detail by name failed: %v", err)
+	}
+	if len(searched) != 1 || len(requested) != 1 || requested[0] != "248613" {
+		t.Errorf("searched %v, requested %v; want the serial number of the exact match", searched, requested)
+	}
+
+	// The abbreviation names the law too, for history as well
+	if err := run("law", "history", "개보법", "--format", "json"); err != nil {
+		t.Fatalf("history by abbreviation failed: %v", err)
+	}
+	if len(requested) != 1 || requested[0] != "248613" {
+		t.Errorf("history requested %v", requested)
+	}
+
+	// IDs are not searched
+	if err := run("law", "detail", "001234", "--format", "json"); err != nil {
+		t.Fatal(err)
+	}
+	if len(searched) != 0 || requested[0] != "001234" {
+		t.Errorf("an ID was searched: searched %v, requested %v", searched, requested)
+	}
+
+	// Several candidates outside a terminal are listed in the error
+	err := run("law", "detail", "개인정보")
+	if err == nil || !strings.Contains(err.Error(), "2개") || !strings.Contains(err.Error(), "2. 개인정보 보호법 시행령 (253527)") || !strings.Contains(err.Error(), "--first") {
+		t.Errorf("ambiguous name error = %v", err)
+	}
+	if len(requested) != 0 {
+		t.Errorf("an ambiguous name was looked up: %v", requested)
+	}
+
+	// --first takes the first candidate
+	if err := run("law", "detail", "개인정보", "--first", "--format", "json"); err != nil {
+		t.Fatal(err)
+	}
+	if len(requested) != 1 || requested[0] != "248613" {
+		t.Errorf("--first requested %v", requested)
+	}
+
+	// A terminal selects by number
+	isInteractiveTerminal = func() bool { return true }
+	root := newRoot()
+	root.SetIn(strings.NewReader("2\n"))
+	requested = nil
+	if _, err := testutil.ExecuteCommand(t, root, []string{"law", "detail", "개인정보", "--format", "json"}); err != nil {
+		t.Fatal(err)
+	}
+	if len(requested) != 1 || requested[0] != "253527" {
+		t.Errorf("selection 2 requested %v", requested)
+	}
+	root = newRoot()
+	root.SetIn(strings.NewReader("9\n"))
+	if _, err := testutil.ExecuteCommand(t, root, []string{"law", "detail", "개인정보"}); err == nil || !strings.Contains(err.Error(), "잘못된 번호") {
+		t.Errorf("invalid selection error = %v", err)
+	}
+
+	if err := run("law", "detail", "없는법"); err == nil || !strings.Contains(err.Error(), "찾을 수 없습니다") {
+		t.Errorf("unknown name error = %v", err)
+	}
+}
+
+func TestLawDetailAuthError(t *testing.T) {
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		err      error
+		wantCode int
+		guide    bool
+	}{
+		{"rejected key", &api.APIKeyError{Message: "API 인증 실패"}, cliErrors.ExitAuth, true},
+		{"rejected key of a fallback source", &api.DetailError{ID: "270351", Attempts: []api.DetailAttempt{
+			{Source: api.APITypeNLIC, Err: errors.New("법령 상세 정보를 찾을 수 없습니다")},
+			{Source: api.APITypeELIS, Err: &api.APIKeyError{Message: "API 인증 실패"}},
+		}}, cliErrors.ExitAuth, true},
+		{"not found by either source", &api.DetailError{ID: "270351", Attempts: []api.DetailAttempt{
+			{Source: api.APITypeNLIC, Err: fmt.Errorf("법령 없음: %w", api.ErrNotFound)},
+			{Source: api.APITypeELIS, Err: fmt.Errorf("자치법규 없음: %w", api.ErrNotFound)},
+		}}, cliErrors.ExitFailure, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testDetailClient = &MockOrdinanceClient{
+				GetDetailFunc: func(ctx context.Context, id string) (*api.LawDetail, error) {
+					return nil, tt.err
+				},
+			}
+			defer func() { testDetailClient = nil }()
+
+			initLawCmd()
+			root := &cobra.Command{Use: "test"}
+			root.AddCommand(lawCmd)
+			output, err := testutil.ExecuteCommand(t, root, []string{"law", "detail", "270351"})
+			if code := exitCode(err); code != tt.wantCode {
+				t.Errorf("exit code = %d (%v), want %d", code, err, tt.wantCode)
+			}
+			if got := strings.Contains(output, "API 설정이 필요합니다"); got != tt.guide {
+				t.Errorf("guide shown = %v, want %v:\n%s", got, tt.guide, output)
+			}
+		})
+	}
+}
+func TestLawDepartments(t *testing.T) {
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+
+	testAPIClient = &mockAPIClient{
+		searchFunc: func(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+			return &api.SearchResponse{TotalCount: 40, Page: req.PageNo, Laws: []api.LawInfo{
+				{ID: "1", Department: "국토교통부"},
+				{ID: "2", Department: "국토교통부, 행정안전부"},
+				{ID: "3", Department: "경찰청"},
+				{ID: "4", Department: "행정안전부 ,국토교통부"},
+				{ID: "5"},
+			}}, nil
+		},
+	}
+	defer func() { testAPIClient = nil }()
+
+	newRoot := func() *cobra.Command {
+		initLawCmd()
+		root := &cobra.Command{Use: "test"}
+		root.AddCommand(lawCmd)
+		return root
+	}
+
+	out, err := testutil.ExecuteCommand(t, newRoot(), []string{"law", "departments", "주차", "--format", "json"})
+	if err != nil {
+		t.Fatalf("law departments failed: %v", err)
+	}
+	var report departmentsReport
+	if err := json.Unmarshal([]byte(out), &report); err != nil {
+		t.Fatalf("output is not json: %v\n%s", err, out)
+	}
+	want := []departmentCount{{"국토교통부", 3}, {"행정안전부", 2}, {"경찰청", 1}, {"미상", 1}}
+	if report.Query != "주차" || report.TotalCount != 40 || report.PageCount != 5 || len(report.Departments) != len(want) {
+		t.Fatalf("report = %+v", report)
+	}
+	for i, d := range want {
+		if report.Departments[i] != d {
+			t.Errorf("departments[%d] = %+v, want %+v", i, report.Departments[i], d)
+		}
+	}
+
+	out, err = testutil.ExecuteCommand(t, newRoot(), []string{"law", "departments", "주차"})
+	if err != nil {
+		t.Fatalf("law departments table failed: %v", err)
+	}
+	for _, want := range []string{"소관부처", "국토교통부", "현재 페이지 결과 5건 기준 (전체 40건)"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("table output should contain %q:\n%s", want, out)
+		}
+	}
+
+	if _, err := testutil.ExecuteCommand(t, newRoot(), []string{"law", "departments", "주차", "--format", "csv"}); err == nil {
+		t.Error("law departments should reject csv")
+	}
+}
+
+func TestLawGrep(t *testing.T) {
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+
+	details := map[string]*api.LawDetail{
+		"100": {LawInfo: api.LawInfo{Name: "주차장법"}, Articles: []api.Article{
+			{Number: "1", Title: "목적", Content: "제1조(목적) 이 법은 주차장의 설치·정비 및 관리에 필요한 사항을 정한다."},
+			{Number: "29", Title: "과태료", Content: "제29조(과태료) 다음 각 호의 어느 하나에 해당하는 자에게는 과태료를 부과한다."},
+		}},
+		"200": {LawInfo: api.LawInfo{Name: "주차장법 시행령"}, Articles: []api.Article{
+			{Number: "1", Title: "목적", Content: "제1조(목적) 이 영은 주차장법에서 위임된 사항을 정한다."},
+		}},
+	}
+	var searchedSize int
+	testDetailClient = &MockOrdinanceClient{
+		SearchFunc: func(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+			searchedSize = req.PageSize
+			return &api.SearchResponse{TotalCount: 3, Laws: []api.LawInfo{
+				{SerialNo: "100", Name: "주차장법", LawType: "법률"},
+				{SerialNo: "200", Name: "주차장법 시행령", LawType: "대통령령"},
+				{SerialNo: "300", Name: "주차장법 시행규칙", LawType: "국토교통부령"},
+			}}, nil
+		},
+		GetDetailFunc: func(ctx context.Context, id string) (*api.LawDetail, error) {
+			if detail, ok := details[id]; ok {
+				return detail, nil
+			}
+			return nil, errors.New("connection refused")
+		},
+	}
+	defer func() { testDetailClient = nil }()
+
+	newRoot := func() *cobra.Command {
+		initLawCmd()
+		root := &cobra.Command{Use: "test"}
+		root.PersistentFlags().Bool("no-history", true, "")
+		root.AddCommand(lawCmd)
+		return root
+	}
+
+	output, err := testutil.ExecuteCommand(t, newRoot(), []string{"law", "grep", "과태료", "--query", "주차", "--context", "5", "--rate", "0"})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	for _, want := range []string{"■ 주차장법 [법률] (100)", "제29조(과태료)", "    …자에게는 과태료를 부과한…\n\n", "후보 법령 3개 중 1개 법령, 조문 1개에서 '과태료' 발견 (상세 조회 실패 1개)"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("Output should contain %q:\n%s", want, output)
+		}
+	}
+	if strings.Contains(output, "주차장법 시행령") {
+		t.Errorf("Laws without the keyword should be left out:\n%s", output)
+	}
+
+	output, err = testutil.ExecuteCommand(t, newRoot(), []string{"law", "grep", "주차장", "--max", "2", "-f", "json", "--rate", "0"})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	var report grepReport
+	if err := json.Unmarshal([]byte(output), &report); err != nil {
+		t.Fatalf("Output is not JSON: %v\n%s", err, output)
+	}
+	if report.Query != "주차장" || report.Candidates != 2 || searchedSize != 2 {
+		t.Errorf("report = %+v (page size %d), want the keyword searched for 2 candidates", report, searchedSize)
+	}
+	if len(report.Laws) != 2 || report.Laws[1].Name != "주차장법 시행령" || len(report.Laws[1].Articles) != 1 {
+		t.Errorf("report.Laws = %+v, want both laws with their matching articles", report.Laws)
+	}
+
+	if _, err := testutil.ExecuteCommand(t, newRoot(), []string{"law", "grep", "과태료", "--max", "0"}); err == nil ||
+		!strings.Contains(err.Error(), "--max는 1 이상") {
+		t.Errorf("Execute() error = %v, want a --max error", err)
+	}
+
+	if err := i18n.SetLanguage("en"); err != nil {
+		t.Fatal(err)
+	}
+	defer i18n.SetLanguage("ko")
+	output, err = testutil.ExecuteCommand(t, newRoot(), []string{"law", "grep", "과태료", "--query", "주차", "--rate", "0"})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if want := "'과태료' found in 1 articles of 1 of 3 candidate laws (1 detail lookups failed)"; !strings.Contains(output, want) {
+		t.Errorf("English output should contain %q:\n%s", want, output)
+	}
+	if _, err := testutil.ExecuteCommand(t, newRoot(), []string{"law", "grep", "과태료", "--max", "0"}); err == nil ||
+		!strings.Contains(err.Error(), "--max must be at least 1") {
+		t.Errorf("Execute() error = %v, want an English --max error", err)
+	}
+}
@@ -4,15 +4,26 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"encoding/xml"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
 	"github.com/pyhub-apps/pyhub-warp-cli/internal/config"
+	cliErrors "github.com/pyhub-apps/pyhub-warp-cli/internal/errors"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/export"
 	"github.com/pyhub-apps/pyhub-warp-cli/internal/i18n"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/stats"
 	"github.com/pyhub-apps/pyhub-warp-cli/internal/testutil"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/watch"
 	"github.com/spf13/cobra"
 )
 
@@ -45,10 +56,11 @@ func TestLawCommand(t *testing.T) {
 			errContains: "검색어를 입력해주세요",
 		},
 		{
-			name:       "Multiple arguments",
-			args:       []string{"arg1", "arg2"},
-			wantErr:    false, // Shows API key guide for search with "arg1"
-			wantOutput: "API 설정이 필요합니다",
+			name:        "Multiple arguments",
+			args:        []string{"arg1", "arg2"},
+			wantErr:     true, // Shows API key guide for search with "arg1", then exits as an authentication error
+			errContains: "API 키가 설정되지 않았습니다",
+			wantOutput:  "API 설정이 필요합니다",
 		},
 		{
 			name:        "Whitespace only query",
@@ -131,8 +143,8 @@ func TestLawCommandFlags(t *testing.T) {
 	}
 
 	sizeFlag := lawCmd.Flag("size")
-	if sizeFlag.DefValue != "50" {
-		t.Errorf("size flag default = %s, want 50", sizeFlag.DefValue)
+	if want := strconv.Itoa(api.DefaultPageSize); sizeFlag.DefValue != want {
+		t.Errorf("size flag default = %s, want %s", sizeFlag.DefValue, want)
 	}
 }
 
@@ -333,10 +345,10 @@ func TestLawCommandNoAPIKey(t *testing.T) {
 	// Set args
 	cmd.SetArgs([]string{"law", "개인정보"})
 
-	// Execute command - should show API key setup guide (no error)
+	// Execute command - should show API key setup guide, exiting as an authentication error
 	err := cmd.Execute()
-	if err != nil {
-		t.Errorf("Execute() should not return error when showing API key guide, got: %v", err)
+	if !cliErrors.IsDisplayed(err) || exitCode(err) != cliErrors.ExitAuth {
+		t.Errorf("Execute() should return a displayed authentication error when showing API key guide, got: %v", err)
 	}
 
 	// Check that output contains API key setup instruction
@@ -376,9 +388,11 @@ func TestSearchLaws(t *testing.T) {
 		},
 	}
 
+	ctx := api.WithRequestContext(context.Background(), api.NewRequestContext("테스트", "", "table", 1, 10))
+
 	// Test table output
 	var buf bytes.Buffer
-	err := searchLaws(mockClient, "테스트", "table", 1, 10, &buf, false)
+	err := searchLaws(ctx, mockClient, &buf, false)
 	if err != nil {
 		t.Errorf("searchLaws() error = %v", err)
 	}
@@ -390,7 +404,8 @@ func TestSearchLaws(t *testing.T) {
 
 	// Test JSON output
 	buf.Reset()
-	err = searchLaws(mockClient, "테스트", "json", 1, 10, &buf, false)
+	ctx = api.WithRequestContext(context.Background(), api.NewRequestContext("테스트", "", "json", 1, 10))
+	err = searchLaws(ctx, mockClient, &buf, false)
 	if err != nil {
 		t.Errorf("searchLaws() error = %v", err)
 	}
@@ -416,3 +431,1338 @@ func (m *mockAPIClient) Search(ctx context.Context, req *api.UnifiedSearchReques
 	}
 	return &api.SearchResponse{}, nil
 }
+
+func TestLawEffectFilter(t *testing.T) {
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+
+	effectNow = func() time.Time { return time.Date(2024, 3, 15, 12, 0, 0, 0, time.Local) }
+	defer func() { effectNow = time.Now }()
+
+	testAPIClient = &mockAPIClient{
+		searchFunc: func(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+			return &api.SearchResponse{
+				TotalCount: 4,
+				Page:       1,
+				Laws: []api.LawInfo{
+					{ID: "001", Name: "시행중법", EffectDate: "20240101", LawType: "법률"},
+					{ID: "002", Name: "오늘시행법", EffectDate: "20240315", LawType: "법률"},
+					{ID: "003", Name: "시행예정법", EffectDate: "20240701", LawType: "법률"},
+					{ID: "004", Name: "날짜없는법", EffectDate: "", LawType: "법률"},
+				},
+			}, nil
+		},
+	}
+	defer func() { testAPIClient = nil }()
+
+	tests := []struct {
+		name        string
+		args        []string
+		contains    []string
+		notContains []string
+		errContains string
+	}{
+		{
+			name:        "upcoming",
+			args:        []string{"law", "법", "--upcoming"},
+			contains:    []string{"시행예정법"},
+			notContains: []string{"시행중법", "오늘시행법", "날짜없는법"},
+		},
+		{
+			name:        "in force includes today",
+			args:        []string{"law", "search", "법", "--in-force"},
+			contains:    []string{"시행중법", "오늘시행법"},
+			notContains: []string{"시행예정법", "날짜없는법"},
+		},
+		{
+			name:        "as-of moves the reference date",
+			args:        []string{"law", "법", "--upcoming", "--as-of", "20231231"},
+			contains:    []string{"시행중법", "오늘시행법", "시행예정법"},
+			notContains: []string{"날짜없는법"},
+		},
+		{
+			name:        "as-of boundary is in force",
+			args:        []string{"law", "법", "--upcoming", "--as-of", "20240701"},
+			notContains: []string{"시행중법", "시행예정법"},
+		},
+		{
+			name:        "conflicting filters",
+			args:        []string{"law", "법", "--upcoming", "--in-force"},
+			errContains: "함께 사용할 수 없습니다",
+		},
+		{
+			name:        "invalid as-of",
+			args:        []string{"law", "법", "--in-force", "--as-of", "2024-03-15"},
+			errContains: "잘못된 기준 날짜",
+		},
+		{
+			name:        "as-of without filter",
+			args:        []string{"law", "법", "--as-of", "20240315"},
+			errContains: "--upcoming 또는 --in-force",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			initLawCmd()
+			root := &cobra.Command{Use: "test"}
+			root.AddCommand(lawCmd)
+
+			output, err := testutil.ExecuteCommand(t, root, tt.args)
+			if tt.errContains != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errContains) {
+					t.Fatalf("Execute() error = %v, want error containing %q", err, tt.errContains)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			for _, s := range tt.contains {
+				if !strings.Contains(output, s) {
+					t.Errorf("Output should contain %q, got:\n%s", s, output)
+				}
+			}
+			for _, s := range tt.notContains {
+				if strings.Contains(output, s) {
+					t.Errorf("Output should not contain %q, got:\n%s", s, output)
+				}
+			}
+		})
+	}
+}
+
+func TestLawRecordOutput(t *testing.T) {
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+
+	testAPIClient = &mockAPIClient{
+		searchFunc: func(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+			return &api.SearchResponse{
+				TotalCount: 2,
+				Page:       1,
+				Laws: []api.LawInfo{
+					{ID: "001", Name: "개인정보 보호법", SerialNo: "100"},
+					{ID: "002", Name: "개인정보 보호법 시행령", SerialNo: "200"},
+				},
+			}, nil
+		},
+	}
+	defer func() { testAPIClient = nil }()
+
+	tests := []struct {
+		name        string
+		args        []string
+		want        string
+		errContains string
+	}{
+		{
+			name: "pluck with default delimiter",
+			args: []string{"law", "개인정보", "--pluck", "serial_no,law_name"},
+			want: "100\t개인정보 보호법\n200\t개인정보 보호법 시행령\n",
+		},
+		{
+			name: "null terminated records",
+			args: []string{"law", "search", "개인정보", "--pluck", "law_name", "--null"},
+			want: "개인정보 보호법\x00개인정보 보호법 시행령\x00",
+		},
+		{
+			name: "custom delimiter",
+			args: []string{"law", "개인정보", "--pluck", "law_id,serial_no", "--delimiter", ","},
+			want: "001,100\n002,200\n",
+		},
+		{
+			name:        "delimiter without pluck",
+			args:        []string{"law", "개인정보", "--null"},
+			errContains: "--pluck 또는 --ids-only와 함께",
+		},
+		{
+			name: "ids only",
+			args: []string{"law", "개인정보", "--ids-only"},
+			want: "100\n200\n",
+		},
+		{
+			name: "null terminated ids",
+			args: []string{"law", "search", "개인정보", "--ids-only", "--null"},
+			want: "100\x00200\x00",
+		},
+		{
+			name:        "ids only with pluck",
+			args:        []string{"law", "개인정보", "--ids-only", "--pluck", "law_name"},
+			errContains: "함께 사용할 수 없습니다",
+		},
+		{
+			name:        "unknown field",
+			args:        []string{"law", "개인정보", "--pluck", "name"},
+			errContains: "알 수 없는 필드",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			initLawCmd()
+			root := &cobra.Command{Use: "test"}
+			root.AddCommand(lawCmd)
+
+			output, err := testutil.ExecuteCommand(t, root, tt.args)
+			if tt.errContains != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errContains) {
+					t.Fatalf("Execute() error = %v, want error containing %q", err, tt.errContains)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			if output != tt.want {
+				t.Errorf("Output = %q, want %q", output, tt.want)
+			}
+		})
+	}
+}
+
+func TestLawIDsOnlyUnifiedAndEmpty(t *testing.T) {
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+	defer func() { testAPIClient = nil }()
+
+	tests := []struct {
+		name string
+		laws []api.LawInfo
+		want string
+	}{
+		{
+			name: "unified search results carry source prefixes",
+			laws: []api.LawInfo{
+				{ID: "001", SerialNo: "100", Source: "국가법령"},
+				{ID: "ORD1", SerialNo: "456", Source: "자치법규"},
+			},
+			want: "nlic:100\nelis:456\n",
+		},
+		{
+			name: "empty results print nothing",
+			laws: []api.LawInfo{},
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testAPIClient = &mockAPIClient{
+				searchFunc: func(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+					return &api.SearchResponse{TotalCount: len(tt.laws), Page: 1, Laws: tt.laws}, nil
+				},
+			}
+
+			initLawCmd()
+			root := &cobra.Command{Use: "test"}
+			root.AddCommand(lawCmd)
+
+			output, err := testutil.ExecuteCommand(t, root, []string{"law", "개인정보", "--ids-only"})
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			if output != tt.want {
+				t.Errorf("Output = %q, want %q", output, tt.want)
+			}
+		})
+	}
+}
+
+func TestLawTreeOutput(t *testing.T) {
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+	defer func() { testAPIClient = nil }()
+
+	testAPIClient = &mockAPIClient{
+		searchFunc: func(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+			return &api.SearchResponse{TotalCount: 2, Page: 1, Laws: []api.LawInfo{
+				{Name: "도로교통법 시행령", LawType: "대통령령", SerialNo: "200"},
+				{Name: "도로교통법", LawType: "법률", SerialNo: "100"},
+			}}, nil
+		},
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		want    string
+		wantErr string
+	}{
+		{"tree", []string{"law", "도로교통법", "--tree"}, "도로교통법 [법률] 100\n└── 도로교통법 시행령 [대통령령] 200\n", ""},
+		{"search subcommand", []string{"law", "search", "도로교통법", "--tree"}, "└── 도로교통법 시행령", ""},
+		{"table only", []string{"law", "도로교통법", "--tree", "--format", "json"}, "", "table 형식에서만"},
+		{"not with records", []string{"law", "도로교통법", "--tree", "--ids-only"}, "", "--pluck, --ids-only와 함께"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			initLawCmd()
+			root := &cobra.Command{Use: "test"}
+			root.AddCommand(lawCmd)
+
+			output, err := testutil.ExecuteCommand(t, root, tt.args)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Execute() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			if !strings.Contains(output, tt.want) {
+				t.Errorf("Output should contain %q, got:\n%s", tt.want, output)
+			}
+		})
+	}
+}
+
+func TestLawQualityReport(t *testing.T) {
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+	defer func() { testAPIClient = nil }()
+
+	testAPIClient = &mockAPIClient{
+		searchFunc: func(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+			return &api.SearchResponse{TotalCount: 2, Page: 1, Laws: []api.LawInfo{
+				{ID: "001", Name: "개인정보 보호법", SerialNo: "100", EffectDate: "20240701"},
+				{ID: "002", Name: "개인정보 보호법 시행령", SerialNo: "200", EffectDate: "미정"},
+			}}, nil
+		},
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		want    []string
+		wantErr string
+	}{
+		{"table", []string{"law", "개인정보", "--quality-report"}, []string{"데이터 품질 리포트 (총 2건)", "effect_date", "이상치: 200", "누락: 100, 200"}, ""},
+		{"search subcommand json", []string{"law", "search", "개인정보", "--quality-report", "-f", "json"}, []string{`"field": "effect_date"`, `"invalid_samples": [`, `"missing_rate": 1`}, ""},
+		{"unsupported format", []string{"law", "개인정보", "--quality-report", "-f", "csv"}, nil, "table 또는 json 형식에서만"},
+		{"not with records", []string{"law", "개인정보", "--quality-report", "--ids-only"}, nil, "--pluck, --ids-only, --tree와 함께"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			initLawCmd()
+			root := &cobra.Command{Use: "test"}
+			root.AddCommand(lawCmd)
+
+			output, err := testutil.ExecuteCommand(t, root, tt.args)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Execute() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(output, want) {
+					t.Errorf("Output should contain %q, got:\n%s", want, output)
+				}
+			}
+			if strings.Contains(output, "개인정보 보호법 시행령") {
+				t.Errorf("the report should replace the results, got:\n%s", output)
+			}
+		})
+	}
+}
+
+func TestLawFingerprint(t *testing.T) {
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+	defer func() { testAPIClient = nil }()
+
+	laws := []api.LawInfo{
+		{ID: "001", Name: "개인정보 보호법", LawType: "법률", EffectDate: "20240315"},
+		{ID: "002", Name: "개인정보 보호법 시행령", LawType: "대통령령", EffectDate: "20240315"},
+	}
+	fingerprint := watch.Fingerprint(laws)
+
+	// Every other search returns the results in reverse order
+	calls := 0
+	testAPIClient = &mockAPIClient{
+		searchFunc: func(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+			calls++
+			results := append([]api.LawInfo(nil), laws...)
+			if calls%2 == 0 {
+				results[0], results[1] = results[1], results[0]
+			}
+			return &api.SearchResponse{TotalCount: 2, Page: 1, Laws: results}, nil
+		},
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		want    string
+		wantErr string
+	}{
+		{"table", []string{"law", "개인정보", "--fingerprint"}, "결과 지문 (SHA-256): " + fingerprint, ""},
+		{"table reordered", []string{"law", "개인정보", "--fingerprint"}, "결과 지문 (SHA-256): " + fingerprint, ""},
+		{"json meta", []string{"law", "search", "개인정보", "--fingerprint", "-f", "json"}, `"fingerprint": "` + fingerprint + `"`, ""},
+		{"canonical json", []string{"law", "개인정보", "--fingerprint", "-f", "json", "--json-schema", "canonical"}, `"fingerprint": "` + fingerprint + `"`, ""},
+		{"ndjson meta", []string{"law", "개인정보", "--fingerprint", "-f", "ndjson"}, `"fingerprint":"` + fingerprint + `"`, ""},
+		{"xml", []string{"law", "개인정보", "--fingerprint", "-f", "xml"}, "<fingerprint>" + fingerprint + "</fingerprint>", ""},
+		{"unsupported format", []string{"law", "개인정보", "--fingerprint", "-f", "csv"}, "", "table, json, ndjson, xml 형식에서만"},
+		{"not with records", []string{"law", "개인정보", "--fingerprint", "--ids-only"}, "", "함께 사용할 수 없습니다"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			initLawCmd()
+			root := &cobra.Command{Use: "test"}
+			root.AddCommand(lawCmd)
+
+			output, err := testutil.ExecuteCommand(t, root, tt.args)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Execute() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			if !strings.Contains(output, tt.want) {
+				t.Errorf("Output should contain %q, got:\n%s", tt.want, output)
+			}
+		})
+	}
+
+	// Without the flag there is no fingerprint
+	initLawCmd()
+	root := &cobra.Command{Use: "test"}
+	root.AddCommand(lawCmd)
+	output, err := testutil.ExecuteCommand(t, root, []string{"law", "개인정보", "-f", "json"})
+	if err != nil || strings.Contains(output, "fingerprint") {
+		t.Errorf("json without --fingerprint should not have one, got %v:\n%s", err, output)
+	}
+}
+
+func TestLawAllPages(t *testing.T) {
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+	defer func() { testAPIClient = nil }()
+
+	// 5 results over pages of 2; failPage, if set, fails once
+	failPage := 0
+	testAPIClient = &mockAPIClient{
+		searchFunc: func(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+			if req.PageNo == failPage {
+				failPage = 0
+				return nil, fmt.Errorf("서버 오류")
+			}
+			var laws []api.LawInfo
+			for i := (req.PageNo-1)*2 + 1; i <= 5 && i <= req.PageNo*2; i++ {
+				laws = append(laws, api.LawInfo{ID: fmt.Sprintf("%03d", i), Name: fmt.Sprintf("법령 %d", i)})
+			}
+			return &api.SearchResponse{TotalCount: 5, Page: req.PageNo, Laws: laws}, nil
+		},
+	}
+
+	run := func(args ...string) (string, error) {
+		initLawCmd()
+		root := &cobra.Command{Use: "test"}
+		root.AddCommand(lawCmd)
+		return testutil.ExecuteCommand(t, root, append([]string{"law", "법령", "--size", "2"}, args...))
+	}
+	readJSON := func(path string, v interface{}) {
+		t.Helper()
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := json.Unmarshal(data, v); err != nil {
+			t.Fatalf("%s is not valid JSON: %v", path, err)
+		}
+	}
+
+	t.Run("pages and merged agree", func(t *testing.T) {
+		dir := filepath.Join(t.TempDir(), "pages")
+		output, err := run("--all", "--output-dir", dir, "--per-page", "--merged", "-f", "json")
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+
+		var pageLaws []api.LawInfo
+		for page := 1; page <= 3; page++ {
+			var file export.PageFile
+			readJSON(filepath.Join(dir, export.PageFileName(page, 3)), &file)
+			if file.Status != export.PageOK || file.Page != page || file.TotalCount != 5 {
+				t.Errorf("page %d file = %+v", page, file)
+			}
+			pageLaws = append(pageLaws, file.Laws...)
+		}
+		var merged export.MergedFile
+		readJSON(filepath.Join(dir, export.MergedFileName), &merged)
+		if merged.Pages != 3 || merged.Count != 5 || len(merged.FailedPages) != 0 || !reflect.DeepEqual(merged.Laws, pageLaws) {
+			t.Errorf("merged file = %+v, want the 5 laws of the pages", merged)
+		}
+
+		var printed api.SearchResponse
+		if err := json.Unmarshal([]byte(output), &printed); err != nil {
+			t.Fatalf("output is not JSON: %v\n%s", err, output)
+		}
+		if !reflect.DeepEqual(printed.Laws, pageLaws) {
+			t.Errorf("printed laws = %v, want the collected ones", printed.Laws)
+		}
+		if entries, _ := os.ReadDir(dir); len(entries) != 4 {
+			t.Errorf("output dir holds %d files, want 3 pages and the merged file", len(entries))
+		}
+	})
+
+	t.Run("failed page", func(t *testing.T) {
+		dir := t.TempDir()
+		failPage = 2
+		output, err := run("--all", "--output-dir", dir, "--per-page", "--merged")
+		if err == nil || !strings.Contains(err.Error(), "2페이지") {
+			t.Errorf("a failed page should fail the command, got %v", err)
+		}
+		if !strings.Contains(output, "법령 5") || strings.Contains(output, "법령 3") {
+			t.Errorf("output should have the pages that were fetched, got:\n%s", output)
+		}
+
+		var failed export.PageFile
+		readJSON(filepath.Join(dir, "page-002.json"), &failed)
+		if failed.Status != export.PageFailed || !strings.Contains(failed.Error, "서버 오류") || len(failed.Laws) != 0 {
+			t.Errorf("failed page file = %+v", failed)
+		}
+		var merged export.MergedFile
+		readJSON(filepath.Join(dir, export.MergedFileName), &merged)
+		if !reflect.DeepEqual(merged.FailedPages, []int{2}) || merged.Count != 3 {
+			t.Errorf("merged file = %+v, want 3 laws and page 2 failed", merged)
+		}
+	})
+
+	t.Run("appended merged file", func(t *testing.T) {
+		dir := t.TempDir()
+		path := filepath.Join(dir, export.MergedFileName)
+		failPage = 2
+		if _, err := run("--all", "--output-dir", dir, "--merged"); err == nil {
+			t.Fatal("the failed page should fail the first collection")
+		}
+		if _, err := run("--all", "--output-dir", dir, "--merged", "--append"); err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+
+		// The laws of the missed page are added once, and the statistics
+		// updated with them match a count of the whole file
+		var merged export.MergedFile
+		readJSON(path, &merged)
+		var got []string
+		for _, law := range merged.Laws {
+			got = append(got, law.ID)
+		}
+		if !reflect.DeepEqual(got, []string{"001", "002", "005", "003", "004"}) {
+			t.Errorf("appended laws = %v, want the first collection and then the missing laws", got)
+		}
+		if merged.Stats == nil || !merged.Stats.Equal(stats.Compute(merged.Laws)) || merged.Stats.Total != 5 {
+			t.Errorf("stats = %+v, want the counts of the 5 laws", merged.Stats)
+		}
+
+		// A file of another search is reset with its statistics
+		merged.Query = "다른 검색어"
+		merged.Stats.Add(merged.Laws)
+		data, _ := json.Marshal(merged)
+		if err := os.WriteFile(path, data, 0644); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := run("--all", "--output-dir", dir, "--merged", "--append"); err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		readJSON(path, &merged)
+		if merged.Query != "법령" || merged.Count != 5 || merged.Stats.Total != 5 {
+			t.Errorf("merged file of another search = %+v, want a new collection", merged)
+		}
+
+		if _, err := run("--all", "--append"); err == nil || !strings.Contains(err.Error(), "--merged") {
+			t.Errorf("--append without --merged: error = %v", err)
+		}
+	})
+
+	t.Run("streamed csv", func(t *testing.T) {
+		initLawCmd()
+		root := &cobra.Command{Use: "test"}
+		root.AddCommand(lawCmd)
+		stdout, stderr, err := testutil.ExecuteCommandSeparateOutputs(t, root, []string{"law", "법령", "--size", "2", "--all", "-f", "csv"})
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		lines := strings.Split(strings.TrimSpace(stdout), "\n")
+		if len(lines) != 6 || !strings.Contains(lines[0], "법령ID") {
+			t.Fatalf("stdout should have one header and 5 rows, got:\n%s", stdout)
+		}
+		if !strings.HasPrefix(lines[5], "5,005,법령 5") {
+			t.Errorf("rows should be numbered across pages, last row = %q", lines[5])
+		}
+		if strings.Contains(stdout, "전체") || !strings.Contains(stderr, "전체 5개 중 5개 결과를 출력했습니다") {
+			t.Errorf("the counts should go to stderr only\nstdout:\n%s\nstderr:\n%s", stdout, stderr)
+		}
+	})
+
+	t.Run("streamed pages", func(t *testing.T) {
+		lawAll = allPages{all: true}
+		defer func() { lawAll = allPages{} }()
+		var seen []int
+		resp, err := lawAll.collect(context.Background(), testAPIClient, &api.RequestContext{Query: "법령", Page: 1, Size: 2},
+			func(page *api.SearchResponse) error {
+				seen = append(seen, page.Page)
+				return nil
+			})
+		if err != nil {
+			t.Fatalf("collect() error = %v", err)
+		}
+		if !reflect.DeepEqual(seen, []int{1, 2, 3}) {
+			t.Errorf("pages passed = %v, want 1, 2, 3 in order", seen)
+		}
+		if len(resp.Laws) != 0 || resp.TotalCount != 5 {
+			t.Errorf("streamed collection should keep no laws, got %d of %d", len(resp.Laws), resp.TotalCount)
+		}
+	})
+
+	for _, tt := range []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{"output dir without all", []string{"--output-dir", "out", "--per-page"}, "--all과 함께"},
+		{"all with page", []string{"--all", "--page", "2"}, "--page와 함께"},
+		{"per-page without dir", []string{"--all", "--per-page"}, "--output-dir와 함께"},
+		{"dir without what to save", []string{"--all", "--output-dir", "out"}, "--per-page 또는 --merged"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := run(tt.args...); err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Execute() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLawAllAppendCachedStats(t *testing.T) {
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+	tempDir, cleanup := testutil.CreateTempDir(t, "warp-append-test-*")
+	t.Cleanup(cleanup)
+	config.ResetConfig()
+	config.SetTestConfigPath(tempDir)
+	if err := config.Initialize(); err != nil {
+		t.Fatalf("Failed to initialize config: %v", err)
+	}
+	t.Cleanup(func() {
+		testAPIClient = nil
+		config.ResetConfig()
+	})
+
+	// Pages 2 and 3 both hold law 004; onlyFirst fails every later page
+	onlyFirst := true
+	pages := map[int][]string{1: {"001", "002"}, 2: {"003", "004"}, 3: {"004", "005"}}
+	testAPIClient = &mockAPIClient{
+		searchFunc: func(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+			if onlyFirst && req.PageNo > 1 {
+				return nil, fmt.Errorf("서버 오류")
+			}
+			var laws []api.LawInfo
+			for _, id := range pages[req.PageNo] {
+				laws = append(laws, api.LawInfo{ID: id, Name: "법령 " + id, Department: "법무부"})
+			}
+			return &api.SearchResponse{TotalCount: 6, Page: req.PageNo, Laws: laws}, nil
+		},
+	}
+
+	dir := t.TempDir()
+	run := func(args ...string) error {
+		initLawCmd()
+		root := &cobra.Command{Use: "test"}
+		root.AddCommand(lawCmd)
+		_, err := testutil.ExecuteCommand(t, root, append([]string{"law", "법령", "--size", "2", "--all", "--output-dir", dir, "--merged"}, args...))
+		return err
+	}
+	readMerged := func() export.MergedFile {
+		t.Helper()
+		var merged export.MergedFile
+		data, err := os.ReadFile(filepath.Join(dir, export.MergedFileName))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := json.Unmarshal(data, &merged); err != nil {
+			t.Fatal(err)
+		}
+		return merged
+	}
+
+	if err := run(); err == nil {
+		t.Fatal("the failed pages should fail the first collection")
+	}
+	// The statistics are kept with the result cache
+	store := searchCache()
+	key := (&allPages{dir: dir}).statsKey(&api.RequestContext{Query: "법령", Size: 2})
+	cached, ok := store.LookupStats(key)
+	if !ok || cached.Total != 2 {
+		t.Fatalf("cached stats = %+v, %v; want the 2 laws of the first page", cached, ok)
+	}
+
+	// --append adds a law repeated over the pages once and counts it once,
+	// starting from the cached statistics
+	onlyFirst = false
+	if err := run("--append"); err != nil {
+		t.Fatalf("--append: %v", err)
+	}
+	merged := readMerged()
+	var got []string
+	for _, law := range merged.Laws {
+		got = append(got, law.ID)
+	}
+	if !reflect.DeepEqual(got, []string{"001", "002", "003", "004", "005"}) {
+		t.Errorf("appended laws = %v, want each law once", got)
+	}
+	if !merged.Stats.Equal(stats.Compute(merged.Laws)) {
+		t.Errorf("stats = %+v, want the counts of the 5 laws", merged.Stats)
+	}
+	if cached, _ := store.LookupStats(key); !cached.Equal(merged.Stats) {
+		t.Errorf("cached stats = %+v, want the ones of the merged file", cached)
+	}
+
+	// Statistics gone from the cache are counted again from the laws, not
+	// taken from the merged file
+	if err := os.RemoveAll(store.Dir()); err != nil {
+		t.Fatal(err)
+	}
+	merged.Stats.Add(merged.Laws)
+	data, _ := json.Marshal(merged)
+	if err := os.WriteFile(filepath.Join(dir, export.MergedFileName), data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := run("--append"); err != nil {
+		t.Fatalf("--append: %v", err)
+	}
+	if merged := readMerged(); merged.Stats.Total != 5 || !merged.Stats.Equal(stats.Compute(merged.Laws)) {
+		t.Errorf("stats after the cache was cleared = %+v, want the counts of the 5 laws", merged.Stats)
+	}
+}
+
+func TestLawFixedOutput(t *testing.T) {
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+	defer func() { testAPIClient = nil }()
+
+	testAPIClient = &mockAPIClient{
+		searchFunc: func(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+			return &api.SearchResponse{TotalCount: 1, Page: 1, Laws: []api.LawInfo{
+				{ID: "011357", Name: "개인정보 보호법", LawType: "법률", Department: "개인정보보호위원회", EffectDate: "20240315"},
+			}}, nil
+		},
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		want    string
+		wantErr string
+	}{
+		{"widths", []string{"law", "개인정보", "-f", "fixed", "--widths", "6,10,6,8,10"}, "011357개인정... 법률  개인... 2024-03-15\n", ""},
+		{"cut", []string{"law", "search", "개인정보", "-f", "fixed", "--widths", "6,10,6,8,10", "--truncate", "cut"}, "011357개인정보  법률  개인정보2024-03-15\n", ""},
+		{"widths without fixed", []string{"law", "개인정보", "--widths", "6,10,6,8,10"}, "", "--format fixed와 함께"},
+		{"wrong number of widths", []string{"law", "개인정보", "-f", "fixed", "--widths", "6,10"}, "", "컬럼 5개"},
+		{"unknown truncation", []string{"law", "개인정보", "-f", "fixed", "--truncate", "wrap"}, "", "지원하지 않는 절단 방식"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			initLawCmd()
+			root := &cobra.Command{Use: "test"}
+			root.AddCommand(lawCmd)
+
+			output, err := testutil.ExecuteCommand(t, root, tt.args)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Execute() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			if output != tt.want {
+				t.Errorf("output = %q, want %q", output, tt.want)
+			}
+		})
+	}
+}
+
+func TestLawFeedOutput(t *testing.T) {
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+	defer func() { testAPIClient = nil }()
+
+	testAPIClient = &mockAPIClient{
+		searchFunc: func(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+			return &api.SearchResponse{TotalCount: 1, Page: 1, Laws: []api.LawInfo{
+				{ID: "011357", Name: "개인정보 보호법", LawType: "법률", Department: "개인정보보호위원회", PromulDate: "20230314"},
+			}}, nil
+		},
+	}
+
+	for _, format := range []string{"rss", "atom"} {
+		t.Run(format, func(t *testing.T) {
+			initLawCmd()
+			root := &cobra.Command{Use: "test"}
+			root.AddCommand(lawCmd)
+
+			output, err := testutil.ExecuteCommand(t, root, []string{"law", "개인정보", "-f", format})
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			// The feed is the whole output, so that it can be written to a file
+			if !strings.HasPrefix(output, xml.Header) {
+				t.Errorf("output should be only the feed:\n%s", output)
+			}
+			var v struct{}
+			if err := xml.Unmarshal([]byte(output), &v); err != nil {
+				t.Errorf("output is not valid XML: %v", err)
+			}
+			if !strings.Contains(output, "https://www.law.go.kr/LSW/lsInfoP.do?lsId=011357") {
+				t.Errorf("output should link the law:\n%s", output)
+			}
+		})
+	}
+}
+
+func TestLawCountOnly(t *testing.T) {
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+	defer func() {
+		testAPIClient = nil
+		testSearchClient = nil
+	}()
+
+	var requests []*api.UnifiedSearchRequest
+	single := func(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+		requests = append(requests, req)
+		return &api.SearchResponse{TotalCount: 1234, Page: 1, Laws: []api.LawInfo{{ID: "001", Name: "개인정보 보호법"}}}, nil
+	}
+	unified := func(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+		requests = append(requests, req)
+		return &api.SearchResponse{TotalCount: 150, Page: 1, Laws: []api.LawInfo{{ID: "001", Name: "주차장법"}}, Sources: []api.SourceStatus{
+			{Source: "NLIC", Label: "국가법령", Status: api.SourceOK, TotalCount: 120},
+			{Source: "ELIS", Label: "자치법규", Status: api.SourceOK, TotalCount: 30},
+		}}, nil
+	}
+	testAPIClient = &mockAPIClient{searchFunc: single}
+	testSearchClient = &MockOrdinanceClient{SearchFunc: unified}
+
+	tests := []struct {
+		name    string
+		args    []string
+		want    string
+		wantErr string
+	}{
+		{"single source", []string{"law", "개인정보", "--count-only", "--page", "3", "--size", "100"}, "1234", ""},
+		{"single source json", []string{"law", "search", "개인정보", "--count-only", "-f", "json"}, `{"count":1234}`, ""},
+		{"unified", []string{"search", "주차", "--count-only"}, "국가법령\t120\n자치법규\t30\n합계\t150", ""},
+		{"unified json", []string{"search", "주차", "--count-only", "-f", "json"},
+			`{"count":150,"sources":[{"source":"NLIC","label":"국가법령","status":"ok","count":120},{"source":"ELIS","label":"자치법규","status":"ok","count":30}]}`, ""},
+		{"unsupported format", []string{"law", "개인정보", "--count-only", "-f", "csv"}, "", "table(숫자) 또는 json 형식에서만"},
+		{"not with records", []string{"law", "개인정보", "--count-only", "--ids-only"}, "", "함께 사용할 수 없습니다"},
+		{"not with effect filters", []string{"search", "주차", "--count-only", "--in-force"}, "", "함께 사용할 수 없습니다"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			initLawCmd()
+			initSearchCmd()
+			root := &cobra.Command{Use: "test"}
+			root.PersistentFlags().Bool("no-history", true, "")
+			root.AddCommand(lawCmd)
+			root.AddCommand(searchCmd)
+
+			requests = nil
+			output, err := testutil.ExecuteCommand(t, root, tt.args)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Execute() error = %v, want %q", err, tt.wantErr)
+				}
+				if len(requests) != 0 {
+					t.Errorf("an invalid combination should not search")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			if got := strings.TrimSpace(output); got != tt.want {
+				t.Errorf("Output = %q, want %q", got, tt.want)
+			}
+			if len(requests) != 1 || requests[0].PageNo != 1 || requests[0].PageSize != 1 {
+				t.Errorf("--count-only should request one result of the first page, got %+v", requests)
+			}
+		})
+	}
+}
+
+func TestLawSearchBatch(t *testing.T) {
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+	defer func() { testAPIClient = nil }()
+
+	testAPIClient = &mockAPIClient{searchFunc: func(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+		if req.Query == "실패" {
+			return nil, fmt.Errorf("connection refused")
+		}
+		return &api.SearchResponse{TotalCount: 1, Laws: []api.LawInfo{{ID: "001", Name: req.Query + "법", LawType: "법률"}}}, nil
+	}}
+
+	newRoot := func(input string) *cobra.Command {
+		initLawCmd()
+		root := &cobra.Command{Use: "test"}
+		root.PersistentFlags().Bool("no-history", true, "")
+		root.AddCommand(lawCmd)
+		root.SetIn(strings.NewReader(input))
+		return root
+	}
+
+	t.Run("json grouped by query", func(t *testing.T) {
+		output, err := testutil.ExecuteCommand(t, newRoot("민법\n\n# 주석\n실패\n형법\n민법\n"),
+			[]string{"law", "search", "--stdin", "--concurrency", "2", "--rate", "0"})
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		var entries []batchSearchEntry
+		if err := json.Unmarshal([]byte(output), &entries); err != nil {
+			t.Fatalf("Output is not a JSON array: %v\n%s", err, output)
+		}
+		if len(entries) != 3 {
+			t.Fatalf("got %d entries, want 3 (blank, comment and duplicate lines skipped): %+v", len(entries), entries)
+		}
+		for i, query := range []string{"민법", "실패", "형법"} {
+			if entries[i].Query != query {
+				t.Errorf("entries[%d].Query = %q, want %q", i, entries[i].Query, query)
+			}
+		}
+		if entries[0].TotalCount != 1 || len(entries[0].Laws) != 1 || entries[0].Laws[0].Name != "민법법" {
+			t.Errorf("entries[0] = %+v, want the results of its query", entries[0])
+		}
+		if entries[1].Error == "" || entries[1].Laws == nil || len(entries[1].Laws) != 0 {
+			t.Errorf("entries[1] = %+v, want an error and no results", entries[1])
+		}
+	})
+
+	t.Run("csv rows led by query", func(t *testing.T) {
+		output, err := testutil.ExecuteCommand(t, newRoot("민법\n실패\n"),
+			[]string{"law", "search", "--stdin", "--format", "csv", "--rate", "0"})
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		if !strings.Contains(output, "민법,1,001,민법법,법률") {
+			t.Errorf("CSV should lead the rows with their query:\n%s", output)
+		}
+		if !strings.Contains(output, "실패,,,,,,,") {
+			t.Errorf("CSV should keep a row for the failed query:\n%s", output)
+		}
+	})
+
+	t.Run("queries file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "queries.txt")
+		if err := os.WriteFile(path, []byte("형법\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		output, err := testutil.ExecuteCommand(t, newRoot(""), []string{"law", "search", "--queries-file", path, "--rate", "0"})
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		if !strings.Contains(output, `"query": "형법"`) {
+			t.Errorf("Output should hold the query of the file:\n%s", output)
+		}
+	})
+
+	errTests := []struct {
+		name    string
+		input   string
+		args    []string
+		wantErr string
+	}{
+		{"all failed", "실패\n", []string{"law", "search", "--stdin"}, "모든 검색어의 검색에 실패했습니다"},
+		{"no queries", "\n# 주석\n", []string{"law", "search", "--stdin"}, "검색할 검색어가 없습니다"},
+		{"with query argument", "민법\n", []string{"law", "search", "민법", "--stdin"}, "함께 사용할 수 없습니다"},
+		{"table format", "민법\n", []string{"law", "search", "--stdin", "-f", "table"}, "json 또는 csv 형식만"},
+		{"single query option", "민법\n", []string{"law", "search", "--stdin", "--tree"}, "검색어 하나를 검색할 때만"},
+	}
+	for _, tt := range errTests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := testutil.ExecuteCommand(t, newRoot(tt.input), tt.args)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Execute() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSearchSummary(t *testing.T) {
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+	defer func() {
+		testAPIClient = nil
+		testSearchClient = nil
+	}()
+
+	testAPIClient = &mockAPIClient{searchFunc: func(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+		if req.Query == "없음" {
+			return &api.SearchResponse{}, nil
+		}
+		return &api.SearchResponse{TotalCount: 3, Page: 1, Laws: []api.LawInfo{
+			{ID: "001", Name: "주차장법", LawType: "법률"},
+			{ID: "002", Name: "주차장법 시행령", LawType: "대통령령"},
+			{ID: "003", Name: "도로법", LawType: "법률"},
+		}}, nil
+	}}
+	testSearchClient = &MockOrdinanceClient{SearchFunc: func(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+		return &api.SearchResponse{TotalCount: 3, Page: 1, Laws: []api.LawInfo{
+			{ID: "001", Name: "주차장법", LawType: "법률", Source: "국가법령"},
+			{ID: "101", Name: "서울특별시 주차장 설치 조례", LawType: "조례", Source: "자치법규"},
+			{ID: "102", Name: "부산광역시 주차장 조례", LawType: "조례", Source: "자치법규"},
+		}}, nil
+	}}
+
+	newRoot := func() *cobra.Command {
+		initLawCmd()
+		initSearchCmd()
+		root := &cobra.Command{Use: "test"}
+		root.PersistentFlags().Bool("no-history", true, "")
+		root.AddCommand(lawCmd)
+		root.AddCommand(searchCmd)
+		return root
+	}
+
+	t.Run("footer after the table", func(t *testing.T) {
+		output, err := testutil.ExecuteCommand(t, newRoot(), []string{"search", "주차", "--summary"})
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		sources := strings.Index(output, "소스별: 자치법규 2 · 국가법령 1")
+		types := strings.Index(output, "법령구분별: 조례 2 · 법률 1")
+		table := strings.Index(output, "부산광역시 주차장 조례")
+		if sources < 0 || types < 0 {
+			t.Fatalf("Output should end with the summary:\n%s", output)
+		}
+		if table < 0 || sources < table || types < sources {
+			t.Errorf("The summary should follow the table, sources first:\n%s", output)
+		}
+	})
+
+	t.Run("single source has no source counts", func(t *testing.T) {
+		output, err := testutil.ExecuteCommand(t, newRoot(), []string{"law", "주차", "--summary"})
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		if strings.Contains(output, "소스별:") || !strings.Contains(output, "법령구분별: 법률 2 · 대통령령 1") {
+			t.Errorf("Output should hold only the law types:\n%s", output)
+		}
+	})
+
+	t.Run("json summary object", func(t *testing.T) {
+		output, err := testutil.ExecuteCommand(t, newRoot(), []string{"law", "search", "주차", "--summary", "-f", "json"})
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		var resp api.SearchResponse
+		if err := json.Unmarshal([]byte(output), &resp); err != nil {
+			t.Fatalf("Output is not JSON: %v\n%s", err, output)
+		}
+		want := &api.ResultSummary{ByLawType: []api.SummaryCount{{Name: "법률", Count: 2}, {Name: "대통령령", Count: 1}}}
+		if !reflect.DeepEqual(resp.Summary, want) {
+			t.Errorf("Summary = %+v, want %+v", resp.Summary, want)
+		}
+	})
+
+	t.Run("empty results have no summary", func(t *testing.T) {
+		output, err := testutil.ExecuteCommand(t, newRoot(), []string{"law", "없음", "--summary"})
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		if strings.Contains(output, "법령구분별:") {
+			t.Errorf("Empty results should have no summary:\n%s", output)
+		}
+	})
+
+	t.Run("unsupported format", func(t *testing.T) {
+		_, err := testutil.ExecuteCommand(t, newRoot(), []string{"law", "주차", "--summary", "-f", "csv"})
+		if err == nil || !strings.Contains(err.Error(), "--summary 옵션은 table, json, ndjson, xml") {
+			t.Errorf("Execute() error = %v, want a format error", err)
+		}
+	})
+}
+
+func TestWithPageSize(t *testing.T) {
+	tests := []struct {
+		size int
+		want string
+	}{
+		{0, `warp law "민법"`},
+		{api.DefaultPageSize, `warp law "민법"`},
+		{20, `warp law "민법" --size 20`},
+	}
+	for _, tt := range tests {
+		if got := withPageSize(`warp law "민법"`, tt.size); got != tt.want {
+			t.Errorf("withPageSize(%d) = %q, want %q", tt.size, got, tt.want)
+		}
+	}
+}
+
+func TestLawQueryMatch(t *testing.T) {
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+	defer func() {
+		testAPIClient = nil
+		testSearchClient = nil
+	}()
+
+	var queries []string
+	laws := []api.LawInfo{
+		{ID: "1", Name: "개인정보 보호법"},
+		{ID: "2", Name: "보호관찰 등에 관한 법률"},
+		{ID: "3", Name: "신용정보의 이용 및 보호에 관한 법률"},
+	}
+	search := func(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+		queries = append(queries, req.Query)
+		return &api.SearchResponse{TotalCount: 3, Laws: laws}, nil
+	}
+	testAPIClient = &mockAPIClient{searchFunc: search}
+	testSearchClient = &MockOrdinanceClient{SearchFunc: search}
+
+	newRoot := func() *cobra.Command {
+		initLawCmd()
+		initSearchCmd()
+		root := &cobra.Command{Use: "test"}
+		root.PersistentFlags().Bool("no-history", true, "")
+		root.AddCommand(lawCmd)
+		root.AddCommand(searchCmd)
+		return root
+	}
+
+	tests := []struct {
+		args      []string
+		want      string
+		wantQuery string
+	}{
+		{[]string{"law", "정보 보호", "--ids-only"}, "1\n2\n3", "정보 보호"},
+		{[]string{"law", "정보 보호", "--exact", "--ids-only"}, "1", "정보 보호"},
+		{[]string{"law", `"정보 보호"`, "--ids-only"}, "1", "정보 보호"},
+		{[]string{"law", "search", "정보 보호", "--all-terms", "--ids-only"}, "1\n3", "정보 보호"},
+		{[]string{"search", `"정보 보호"`, "--ids-only"}, "1", "정보 보호"},
+		{[]string{"search", "보호 관찰", "--all-terms", "--ids-only"}, "2", "보호 관찰"},
+	}
+	for _, tt := range tests {
+		queries = nil
+		output, err := testutil.ExecuteCommand(t, newRoot(), tt.args)
+		if err != nil {
+			t.Fatalf("%v: %v", tt.args, err)
+		}
+		if got := strings.TrimSpace(output); got != tt.want {
+			t.Errorf("%v: ids = %q, want %q", tt.args, got, tt.want)
+		}
+		if len(queries) != 1 || queries[0] != tt.wantQuery {
+			t.Errorf("%v: API queries = %q, want %q", tt.args, queries, tt.wantQuery)
+		}
+	}
+
+	_, err := testutil.ExecuteCommand(t, newRoot(), []string{"law", "정보 보호", "--exact", "--all-terms"})
+	if err == nil || !strings.Contains(err.Error(), "--exact와 --all-terms는 함께 사용할 수 없습니다") {
+		t.Errorf("--exact with --all-terms: error = %v", err)
+	}
+}
+
+func TestLawFieldFilter(t *testing.T) {
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+	defer func() {
+		testAPIClient = nil
+		testSearchClient = nil
+	}()
+
+	laws := []api.LawInfo{
+		{ID: "1", Name: "국민건강보험법", LawType: "법률", Department: "보건복지부"},
+		{ID: "2", Name: "국민건강보험법 시행령", LawType: "대통령령", Department: "보건복지부"},
+		{ID: "3", Name: "개인정보 보호법", LawType: "법률", Department: "개인정보보호위원회"},
+	}
+	search := func(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+		return &api.SearchResponse{TotalCount: 3, Laws: laws}, nil
+	}
+	testAPIClient = &mockAPIClient{searchFunc: search}
+	testSearchClient = &MockOrdinanceClient{SearchFunc: search}
+
+	newRoot := func() *cobra.Command {
+		initLawCmd()
+		initSearchCmd()
+		root := &cobra.Command{Use: "test"}
+		root.PersistentFlags().Bool("no-history", true, "")
+		root.AddCommand(lawCmd)
+		root.AddCommand(searchCmd)
+		return root
+	}
+
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"law", "건강", "--filter", "department=~복지부$", "--ids-only"}, "1\n2"},
+		{[]string{"law", "search", "건강", "--filter", "department=~복지부$", "--filter", "type=법률", "--ids-only"}, "1"},
+		{[]string{"search", "법", "--filter", "name!=시행령", "--ids-only"}, "1\n3"},
+	}
+	for _, tt := range tests {
+		output, err := testutil.ExecuteCommand(t, newRoot(), tt.args)
+		if err != nil {
+			t.Fatalf("%v: %v", tt.args, err)
+		}
+		if got := strings.TrimSpace(output); got != tt.want {
+			t.Errorf("%v: ids = %q, want %q", tt.args, got, tt.want)
+		}
+	}
+
+	for _, tt := range []struct {
+		filter  string
+		wantErr string
+	}{
+		{"ministry=복지부", "알 수 없는 필터 필드: ministry"},
+		{"name=~[", "잘못된 정규식"},
+	} {
+		_, err := testutil.ExecuteCommand(t, newRoot(), []string{"law", "건강", "--filter", tt.filter})
+		if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("--filter %s: error = %v, want %q", tt.filter, err, tt.wantErr)
+		}
+		if code := exitCode(err); code != cliErrors.ExitInvalidInput {
+			t.Errorf("--filter %s: exit code = %d, want %d", tt.filter, code, cliErrors.ExitInvalidInput)
+		}
+	}
+}
+
+func TestSearchLimit(t *testing.T) {
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+	defer func() { testSearchClient = nil }()
+
+	var limits []int
+	testSearchClient = &MockOrdinanceClient{SearchFunc: func(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+		limits = append(limits, req.Limit)
+		return &api.SearchResponse{TotalCount: 120, Page: 1, PageSize: req.PageSize, Laws: []api.LawInfo{
+			{ID: "1", Name: "개인정보 보호법", EffectDate: "20240101"},
+			{ID: "2", Name: "정보통신망법", EffectDate: "20240101"},
+			{ID: "3", Name: "개인정보 보호법 시행령", EffectDate: "20240101"},
+		}}, nil
+	}}
+
+	newRoot := func() *cobra.Command {
+		initSearchCmd()
+		root := &cobra.Command{Use: "test"}
+		root.PersistentFlags().Bool("no-history", true, "")
+		root.AddCommand(searchCmd)
+		return root
+	}
+
+	tests := []struct {
+		args      []string
+		want      string
+		wantLimit int
+	}{
+		{[]string{"search", "정보", "--ids-only"}, "1\n2\n3", 0},
+		{[]string{"search", "정보", "--limit", "2", "--ids-only"}, "1\n2", 2},
+		{[]string{"search", "정보", "-l", "5", "--ids-only"}, "1\n2\n3", 5},
+		// Filters after the search need the whole page
+		{[]string{"search", "개인정보 보호법", "--exact", "--limit", "1", "--ids-only"}, "1", 0},
+	}
+	for _, tt := range tests {
+		limits = nil
+		output, err := testutil.ExecuteCommand(t, newRoot(), tt.args)
+		if err != nil {
+			t.Fatalf("%v: %v", tt.args, err)
+		}
+		if got := strings.TrimSpace(output); got != tt.want {
+			t.Errorf("%v: ids = %q, want %q", tt.args, got, tt.want)
+		}
+		if len(limits) != 1 || limits[0] != tt.wantLimit {
+			t.Errorf("%v: request limits = %v, want %d", tt.args, limits, tt.wantLimit)
+		}
+	}
+
+	// The pages are still counted by --size
+	output, err := testutil.ExecuteCommand(t, newRoot(), []string{"search", "정보", "--limit", "2", "--size", "10"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(output, `warp search "정보" --limit 2 --size 10 --page 2`) {
+		t.Errorf("the next page hint should follow --size:\n%s", output)
+	}
+
+	_, err = testutil.ExecuteCommand(t, newRoot(), []string{"search", "정보", "--limit", "-1"})
+	if exitCode(err) != cliErrors.ExitInvalidInput {
+		t.Errorf("--limit -1: error = %v, want invalid input", err)
+	}
+}
+
+func TestPageFlagsValidation(t *testing.T) {
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+	defer func() { testAPIClient, testSearchClient = nil, nil }()
+
+	searched := 0
+	search := func(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+		searched++
+		return &api.SearchResponse{TotalCount: 1, Page: req.PageNo, Laws: []api.LawInfo{{ID: "1", Name: "개인정보 보호법"}}}, nil
+	}
+	testAPIClient = &mockAPIClient{searchFunc: search}
+	testSearchClient = &MockOrdinanceClient{SearchFunc: search}
+
+	newRoot := func() *cobra.Command {
+		initLawCmd()
+		initSearchCmd()
+		root := &cobra.Command{Use: "test"}
+		root.PersistentFlags().Bool("no-history", true, "")
+		root.AddCommand(lawCmd, searchCmd)
+		return root
+	}
+
+	tests := []struct {
+		args    []string
+		wantErr string
+	}{
+		{[]string{"law", "개인정보", "--page", "0"}, "--page 값은 1 이상"},
+		{[]string{"law", "개인정보", "--page", "-1"}, "--page 값은 1 이상"},
+		{[]string{"law", "개인정보", "--size", "0"}, "--size 값은 1 이상 1000 이하"},
+		{[]string{"search", "개인정보", "--size", "-20"}, "--size 값은 1 이상 1000 이하"},
+		{[]string{"search", "개인정보", "--size", "100000"}, "--size 값은 1 이상 1000 이하"},
+	}
+	for _, tt := range tests {
+		searched = 0
+		_, err := testutil.ExecuteCommand(t, newRoot(), tt.args)
+		if exitCode(err) != cliErrors.ExitInvalidInput || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("%v: error = %v, want invalid input %q", tt.args, err, tt.wantErr)
+		}
+		if searched != 0 {
+			t.Errorf("%v: searched %d times, want none", tt.args, searched)
+		}
+	}
+
+	if _, err := testutil.ExecuteCommand(t, newRoot(), []string{"law", "개인정보", "--page", "1", "--size", "1000"}); err != nil {
+		t.Errorf("--size 1000: error = %v, want the largest page accepted", err)
+	}
+}
@@ -0,0 +1,97 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/export"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/i18n"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/logger"
+	"github.com/spf13/cobra"
+)
+
+// testNotifySender replaces the HTTP sender of notifications in tests
+var testNotifySender export.Sender
+
+// notifyOptions holds the --notify-slack and --notify-discord flag values
+type notifyOptions struct {
+	slack   string
+	discord string
+}
+
+// addNotifyFlags registers the notification flags on a search command
+func addNotifyFlags(cmd *cobra.Command, n *notifyOptions) {
+	cmd.Flags().StringVar(&n.slack, "notify-slack", "", i18n.T("law.flag.notifySlack"))
+	cmd.Flags().StringVar(&n.discord, "notify-discord", "", i18n.T("law.flag.notifyDiscord"))
+}
+
+// updateNotifyFlagUsages updates the notification flag descriptions
+func updateNotifyFlagUsages(cmd *cobra.Command) {
+	if flag := cmd.Flags().Lookup("notify-slack"); flag != nil {
+		flag.Usage = i18n.T("law.flag.notifySlack")
+	}
+	if flag := cmd.Flags().Lookup("notify-discord"); flag != nil {
+		flag.Usage = i18n.T("law.flag.notifyDiscord")
+	}
+}
+
+// validate checks that the webhooks are http(s) URLs before searching
+func (n *notifyOptions) validate() error {
+	for _, hook := range []struct{ flag, value string }{
+		{"--notify-slack", n.slack},
+		{"--notify-discord", n.discord},
+	} {
+		if hook.value == "" {
+			continue
+		}
+		u, err := url.Parse(hook.value)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("%s 옵션에는 웹훅 URL(https://...)을 지정하세요", hook.flag)
+		}
+	}
+	return nil
+}
+
+// notifiers returns a notifier for each webhook given
+func (n *notifyOptions) notifiers() []*export.Notifier {
+	var notifiers []*export.Notifier
+	if n.slack != "" {
+		notifiers = append(notifiers, export.NewNotifier(export.PlatformSlack, n.slack))
+	}
+	if n.discord != "" {
+		notifiers = append(notifiers, export.NewNotifier(export.PlatformDiscord, n.discord))
+	}
+	if testNotifySender != nil {
+		for _, notifier := range notifiers {
+			notifier.Sender = testNotifySender
+		}
+	}
+	return notifiers
+}
+
+// send posts the summary of resp to the webhooks when there are results.
+// Failures are only logged as warnings since the search itself succeeded.
+func (n *notifyOptions) send(ctx context.Context, resp *api.SearchResponse) {
+	notifiers := n.notifiers()
+	if len(notifiers) == 0 {
+		return
+	}
+	if len(resp.Laws) == 0 {
+		logger.Info("검색 결과가 없어 알림을 보내지 않습니다")
+		return
+	}
+
+	// The search may have used up most of its deadline, so notifications get their own
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), api.Timeout())
+	defer cancel()
+
+	for _, notifier := range notifiers {
+		if err := notifier.Notify(ctx, resp); err != nil {
+			logger.Warn("%v", err)
+			continue
+		}
+		logger.Info("%s 알림을 보냈습니다", notifier.Platform)
+	}
+}
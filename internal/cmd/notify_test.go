@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/i18n"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/testutil"
+	"github.com/spf13/cobra"
+)
+
+// recordingSender records notifications instead of posting them
+type recordingSender struct {
+	webhooks []string
+	payloads []string
+	err      error
+}
+
+func (s *recordingSender) Send(ctx context.Context, webhookURL string, payload []byte) error {
+	s.webhooks = append(s.webhooks, webhookURL)
+	s.payloads = append(s.payloads, string(payload))
+	return s.err
+}
+
+func TestSearchNotify(t *testing.T) {
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+
+	laws := []api.LawInfo{{ID: "001", Name: "개인정보 보호법", LawType: "법률"}}
+	tests := []struct {
+		name      string
+		args      []string
+		laws      []api.LawInfo
+		sendErr   error
+		wantErr   string
+		wantSent  []string
+		wantInOut string
+	}{
+		{
+			name:      "slack and discord",
+			args:      []string{"search", "개인정보", "--source", "law", "--notify-slack", "https://hooks.slack.com/services/T/B/x", "--notify-discord", "https://discord.com/api/webhooks/1/y"},
+			laws:      laws,
+			wantSent:  []string{"https://hooks.slack.com/services/T/B/x", "https://discord.com/api/webhooks/1/y"},
+			wantInOut: "개인정보 보호법",
+		},
+		{
+			name:      "send failure is only a warning",
+			args:      []string{"search", "개인정보", "--source", "law", "--notify-slack", "https://hooks.slack.com/services/T/B/x"},
+			laws:      laws,
+			sendErr:   errors.New("HTTP 404: no_service"),
+			wantSent:  []string{"https://hooks.slack.com/services/T/B/x"},
+			wantInOut: "개인정보 보호법",
+		},
+		{
+			name: "no results",
+			args: []string{"search", "없는법", "--source", "law", "--notify-slack", "https://hooks.slack.com/services/T/B/x"},
+		},
+		{
+			name:    "invalid webhook",
+			args:    []string{"search", "개인정보", "--notify-discord", "discord.com/api/webhooks/1/y"},
+			laws:    laws,
+			wantErr: "--notify-discord 옵션에는 웹훅 URL",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			initSearchCmd()
+			root := &cobra.Command{Use: "test"}
+			root.PersistentFlags().Bool("no-history", false, "")
+			root.AddCommand(searchCmd)
+
+			searched := false
+			testSearchClient = &MockOrdinanceClient{
+				SearchFunc: func(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+					searched = true
+					return &api.SearchResponse{TotalCount: len(tt.laws), Laws: tt.laws}, nil
+				},
+			}
+			sender := &recordingSender{err: tt.sendErr}
+			testNotifySender = sender
+			defer func() {
+				testSearchClient = nil
+				testNotifySender = nil
+			}()
+
+			out, err := testutil.ExecuteCommand(t, root, tt.args)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				if searched {
+					t.Error("webhooks should be validated before searching")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("search failed: %v", err)
+			}
+
+			if strings.Join(sender.webhooks, " ") != strings.Join(tt.wantSent, " ") {
+				t.Errorf("sent to %v, want %v", sender.webhooks, tt.wantSent)
+			}
+			for _, payload := range sender.payloads {
+				if !strings.Contains(payload, "개인정보 (총 1건)") || !strings.Contains(payload, "개인정보 보호법") {
+					t.Errorf("payload should summarize the results, got %s", payload)
+				}
+			}
+			if !strings.Contains(out, tt.wantInOut) {
+				t.Errorf("output should contain %q, got %q", tt.wantInOut, out)
+			}
+		})
+	}
+}
@@ -26,6 +26,12 @@ var (
 	ordinancePageSize     int
 	ordinanceRegion       string
 	ordinanceSort         string
+	ordinanceOrder        string
+	ordinanceJSONSchema   string
+	ordinanceRecords      recordOutput
+	ordinanceFilters      fieldFilters
+	ordinanceNotify       notifyOptions
+	ordinancePriority     priorityFile
 )
 
 // Test helper - allows injection of mock client
@@ -45,7 +51,10 @@ func initOrdinanceCmd() {
   warp ordinance search "도시계획" --region 서울
   
   # 조례 상세 조회
-  warp ordinance detail ORD123456`,
+  warp ordinance detail ORD123456
+  
+  # 검색 결과 실시간 모니터링
+  warp ordinance watch-ui "주차" --interval 1m`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// If args are provided without subcommand, run search
 			if len(args) > 0 {
@@ -59,17 +68,29 @@ func initOrdinanceCmd() {
 	// Initialize subcommands
 	initOrdinanceSearchCmd()
 	initOrdinanceDetailCmd()
+	initOrdinanceWatchUICmd()
 
 	// Add subcommands
 	ordinanceCmd.AddCommand(ordinanceSearchCmd)
 	ordinanceCmd.AddCommand(ordinanceDetailCmd)
+	ordinanceCmd.AddCommand(ordinanceWatchUICmd)
 
 	// Flags
 	ordinanceCmd.PersistentFlags().StringVarP(&ordinanceOutputFormat, "format", "f", "table", i18n.T("ordinance.flag.format"))
 	ordinanceCmd.PersistentFlags().IntVarP(&ordinancePageNo, "page", "p", 1, i18n.T("ordinance.flag.page"))
-	ordinanceCmd.PersistentFlags().IntVarP(&ordinancePageSize, "size", "s", 50, i18n.T("ordinance.flag.size"))
+	ordinanceCmd.PersistentFlags().IntVarP(&ordinancePageSize, "size", "s", api.DefaultPageSize, i18n.T("ordinance.flag.size"))
 	ordinanceCmd.PersistentFlags().StringVarP(&ordinanceRegion, "region", "r", "", i18n.T("ordinance.flag.region"))
 	ordinanceCmd.PersistentFlags().StringVar(&ordinanceSort, "sort", "date", i18n.T("ordinance.flag.sort"))
+	ordinanceCmd.PersistentFlags().StringVar(&ordinanceOrder, "order", "", i18n.T("ordinance.flag.order"))
+	addRecordFlags(ordinanceCmd, &ordinanceRecords)
+	addRecordFlags(ordinanceSearchCmd, &ordinanceRecords)
+	addFieldFilterFlags(ordinanceCmd, &ordinanceFilters)
+	addFieldFilterFlags(ordinanceSearchCmd, &ordinanceFilters)
+	addPriorityFlag(ordinanceCmd, &ordinancePriority)
+	addPriorityFlag(ordinanceSearchCmd, &ordinancePriority)
+	addNotifyFlags(ordinanceCmd, &ordinanceNotify)
+	addNotifyFlags(ordinanceSearchCmd, &ordinanceNotify)
+	ordinanceCmd.PersistentFlags().StringVar(&ordinanceJSONSchema, "json-schema", output.SchemaRaw, i18n.T("law.flag.jsonSchema"))
 }
 
 // updateOrdinanceCommand updates ordinance command descriptions
@@ -94,11 +115,22 @@ func updateOrdinanceCommand() {
 		if flag := ordinanceCmd.PersistentFlags().Lookup("sort"); flag != nil {
 			flag.Usage = i18n.T("ordinance.flag.sort")
 		}
+		if flag := ordinanceCmd.PersistentFlags().Lookup("order"); flag != nil {
+			flag.Usage = i18n.T("ordinance.flag.order")
+		}
+		if flag := ordinanceCmd.PersistentFlags().Lookup("json-schema"); flag != nil {
+			flag.Usage = i18n.T("law.flag.jsonSchema")
+		}
+		updateRecordFlagUsages(ordinanceCmd)
+		updateFieldFilterFlagUsages(ordinanceCmd)
+		updatePriorityFlagUsage(ordinanceCmd)
+		updateNotifyFlagUsages(ordinanceCmd)
 	}
 
 	// Update subcommands
 	updateOrdinanceSearchCommand()
 	updateOrdinanceDetailCommand()
+	updateOrdinanceWatchUICommand()
 }
 
 // runOrdinanceSearch handles the ordinance search command
@@ -112,27 +144,46 @@ func runOrdinanceSearch(cmd *cobra.Command, args []string) error {
 
 	logger.Debug("Starting ordinance search for query: %s", query)
 
+	if err := validatePageFlags(ordinancePageNo, ordinancePageSize); err != nil {
+		return err
+	}
+	if err := ordinanceRecords.validate(); err != nil {
+		return err
+	}
+	if err := ordinanceFilters.validate(); err != nil {
+		return err
+	}
+	if err := ordinanceNotify.validate(); err != nil {
+		return err
+	}
+	if err := api.ValidateSort(ordinanceSort, ordinanceOrder); err != nil {
+		return err
+	}
+	if err := ordinancePriority.load(); err != nil {
+		return err
+	}
+
 	// Use test client if available (for testing)
 	var client api.ClientInterface
 	if testOrdinanceClient != nil {
 		client = testOrdinanceClient
 	} else {
 		// Create ELIS API client
-		apiClient, err := api.CreateClient(api.APITypeELIS)
+		apiClient, err := api.CreateClientWithOptions(api.APITypeELIS, cacheOption())
 		if err != nil {
 			// Check if it's an API key error
 			var cliErr *cliErrors.CLIError
 			if errors.As(err, &cliErr) && cliErr.Code == cliErrors.ErrCodeNoAPIKey {
 				guide := onboarding.NewGuideWithWriter(cmd.OutOrStdout(), false)
 				guide.ShowAPIKeySetup()
-				return nil
+				return cliErrors.Displayed(cliErrors.Wrap(err, cliErrors.ErrNoAPIKey)) // Shown by the guide
 			}
 
 			// Also check for direct API key error message
-			if strings.Contains(err.Error(), "API 키가 설정되지 않았습니다") {
+			if errors.Is(err, api.ErrNoAPIKey) {
 				guide := onboarding.NewGuideWithWriter(cmd.OutOrStdout(), false)
 				guide.ShowAPIKeySetup()
-				return nil
+				return cliErrors.Displayed(cliErrors.Wrap(err, cliErrors.ErrNoAPIKey)) // Shown by the guide
 			}
 
 			verbose, _ := cmd.Flags().GetBool("verbose")
@@ -146,11 +197,32 @@ func runOrdinanceSearch(cmd *cobra.Command, args []string) error {
 	verbose, _ := cmd.Flags().GetBool("verbose")
 
 	// Search ordinances
-	return searchOrdinances(client, query, ordinanceRegion, ordinanceOutputFormat, ordinancePageNo, ordinancePageSize, ordinanceSort, cmd.OutOrStdout(), verbose)
+	ctx := startSearch(cmd, query, "", ordinanceOutputFormat, ordinancePageNo, ordinancePageSize)
+	if err := searchOrdinances(ctx, client, ordinanceRegion, ordinanceSort, cmd.OutOrStdout(), verbose); err != nil {
+		return err
+	}
+
+	// Point to the national laws above an empty search, as warp search does
+	if shouldShowUpperLawHints(cmd, "ordinance", ordinanceOutputFormat, searchFoundNothing(ctx)) {
+		if hintClient := upperLawHintClient(); hintClient != nil {
+			showUpperLawHints(ctx, hintClient, query, cmd.OutOrStdout())
+		}
+	}
+	finishSearch(ctx)
+
+	recordHistory(ctx, cmd)
+	return failOnEmpty(cmd, searchFoundNothing(ctx))
 }
 
-// searchOrdinances performs the actual ordinance search
-func searchOrdinances(client api.ClientInterface, query string, region string, format string, pageNo int, pageSize int, sort string, writer io.Writer, verbose bool) error {
+// searchOrdinances performs the actual ordinance search described by the
+// RequestContext of ctx
+func searchOrdinances(ctx context.Context, client api.ClientInterface, region string, sort string, writer io.Writer, verbose bool) error {
+	rc, err := searchRequest(ctx)
+	if err != nil {
+		return err
+	}
+	query, pageNo, pageSize, format := rc.Query, rc.Page, rc.Size, rc.Format
+
 	// Log search parameters
 	if region != "" {
 		logger.Info("조례 검색 중... (검색어: %s, 지역: %s, 페이지: %d, 크기: %d)", query, region, pageNo, pageSize)
@@ -165,11 +237,15 @@ func searchOrdinances(client api.ClientInterface, query string, region string, f
 		PageNo:   pageNo,
 		PageSize: pageSize,
 		Sort:     sort,
+		Order:    ordinanceOrder,
+		Priority: ordinancePriority.priority,
 		Type:     "json",
 	}
 
-	// Perform search
-	ctx := context.Background()
+	// Perform search with timeout
+	ctx, cancel := context.WithTimeout(ctx, api.Timeout())
+	defer cancel()
+
 	result, err := client.Search(ctx, searchReq)
 	if err != nil {
 		// Check if it's an API key error
@@ -177,28 +253,46 @@ func searchOrdinances(client api.ClientInterface, query string, region string, f
 		if errors.As(err, &apiKeyErr) {
 			// Print error message without help
 			fmt.Fprintln(writer, err.Error())
-			// Return nil to suppress both error message and help
-			return nil
+			// Return it as displayed to suppress both error message and help
+			return cliErrors.Displayed(wrapAPIError(err))
 		}
 
 		logger.LogError(err, verbose)
-		return err
+		return wrapAPIError(err)
 	}
 
 	// Log search results
 	logger.Info("검색 완료: %d개의 결과 (페이지: %d, 크기: %d)", result.TotalCount, pageNo, pageSize)
+	noteResults(ctx, result)
+
+	result, err = transformSearchResults(ctx, result)
+	if err != nil {
+		logger.LogError(err, verbose)
+		return err
+	}
+	learnVocabulary(ctx, result)
+	result, err = ordinanceFilters.apply(result)
+	if err != nil {
+		return err
+	}
+	ordinanceNotify.send(ctx, result)
+
+	if ordinanceRecords.active() {
+		return ordinanceRecords.write(writer, result.Laws)
+	}
 
 	// Create formatter with the specified format
-	formatter := output.NewFormatter(format)
+	pageCommand := fmt.Sprintf("warp ordinance %q", query)
+	if region != "" {
+		pageCommand += fmt.Sprintf(" --region %q", region)
+	}
+	pageCommand = withPageSize(pageCommand, pageSize)
+	formatter := output.NewFormatter(format).WithPagination(pageCommand, pageSize).WithJSONSchema(ordinanceJSONSchema)
 
 	// Format and output results
-	outputStr, err := formatter.FormatSearchResultToString(result)
-
-	if err != nil {
+	if err := writeSearchOutput(formatter, format, result, writer); err != nil {
 		logger.LogError(err, verbose)
 		return err
 	}
-
-	fmt.Fprint(writer, outputStr)
 	return nil
 }
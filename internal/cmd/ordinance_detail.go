@@ -52,6 +52,13 @@ func runOrdinanceDetailCommand(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("조례 ID를 입력해주세요")
 	}
 
+	// Accept the prefixed IDs written by --ids-only
+	source, id := api.ParseDetailID(ordinanceID, api.APITypeELIS)
+	if source != api.APITypeELIS {
+		return fmt.Errorf("국가법령 ID입니다: %s ('warp law detail %s'로 조회하세요)", ordinanceID, ordinanceID)
+	}
+	ordinanceID = id
+
 	logger.Info("조례 상세 정보 조회 중... (ID: %s)", ordinanceID)
 
 	// Use test client if available (for testing)
@@ -60,7 +67,7 @@ func runOrdinanceDetailCommand(cmd *cobra.Command, args []string) error {
 		client = testOrdinanceClient
 	} else {
 		// Create ELIS API client
-		apiClient, err := api.CreateClient(api.APITypeELIS)
+		apiClient, err := api.CreateClientWithOptions(api.APITypeELIS, cacheOption())
 		if err != nil {
 			// Check if it's an API key error
 			var cliErr *cliErrors.CLIError
@@ -68,15 +75,15 @@ func runOrdinanceDetailCommand(cmd *cobra.Command, args []string) error {
 				logger.Error("Failed to create API client: %v", err)
 				guide := onboarding.NewGuideWithWriter(cmd.OutOrStdout(), false)
 				guide.ShowAPIKeySetup()
-				return nil
+				return cliErrors.Displayed(cliErrors.Wrap(err, cliErrors.ErrNoAPIKey)) // Shown by the guide
 			}
 
 			// Also check for direct API key error message
-			if strings.Contains(err.Error(), "API 키가 설정되지 않았습니다") {
+			if errors.Is(err, api.ErrNoAPIKey) {
 				logger.Error("Failed to create API client: %v", err)
 				guide := onboarding.NewGuideWithWriter(cmd.OutOrStdout(), false)
 				guide.ShowAPIKeySetup()
-				return nil
+				return cliErrors.Displayed(cliErrors.Wrap(err, cliErrors.ErrNoAPIKey)) // Shown by the guide
 			}
 
 			logger.Error("Failed to create API client: %v", err)
@@ -88,8 +95,10 @@ func runOrdinanceDetailCommand(cmd *cobra.Command, args []string) error {
 	// Get verbose flag from parent command
 	verbose, _ := cmd.Flags().GetBool("verbose")
 
-	// Get detail from API
-	ctx := context.Background()
+	// Get detail from API with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), api.Timeout())
+	defer cancel()
+
 	detail, err := client.GetDetail(ctx, ordinanceID)
 	if err != nil {
 		// Check if it's an API key error
@@ -97,12 +106,12 @@ func runOrdinanceDetailCommand(cmd *cobra.Command, args []string) error {
 		if errors.As(err, &apiKeyErr) {
 			// Print error message without help
 			fmt.Fprintln(cmd.OutOrStdout(), err.Error())
-			// Return nil to suppress both error message and help
-			return nil
+			// Return it as displayed to suppress both error message and help
+			return cliErrors.Displayed(wrapAPIError(err))
 		}
 
 		logger.LogError(err, verbose)
-		return err
+		return wrapAPIError(err)
 	}
 
 	// Get format flag
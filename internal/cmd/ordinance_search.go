@@ -34,7 +34,7 @@ func initOrdinanceSearchCmd() {
 		RunE: runOrdinanceSearchCommand,
 	}
 
-	// Flags are inherited from parent command
+	// Flags are inherited from parent command; record and notification flags are registered in initOrdinanceCmd
 }
 
 // updateOrdinanceSearchCommand updates ordinance search command descriptions
@@ -42,6 +42,10 @@ func updateOrdinanceSearchCommand() {
 	if ordinanceSearchCmd != nil {
 		ordinanceSearchCmd.Short = i18n.T("ordinance.search.short")
 		ordinanceSearchCmd.Long = i18n.T("ordinance.search.long")
+		updateRecordFlagUsages(ordinanceSearchCmd)
+		updateFieldFilterFlagUsages(ordinanceSearchCmd)
+		updatePriorityFlagUsage(ordinanceSearchCmd)
+		updateNotifyFlagUsages(ordinanceSearchCmd)
 	}
 }
 
@@ -3,18 +3,23 @@ package cmd
 import (
 	"bytes"
 	"context"
+	"os"
+	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 
 	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
 	"github.com/pyhub-apps/pyhub-warp-cli/internal/i18n"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/testutil"
 	"github.com/spf13/cobra"
 )
 
 // MockOrdinanceClient is a mock implementation for testing
 type MockOrdinanceClient struct {
-	SearchFunc    func(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error)
-	GetDetailFunc func(ctx context.Context, ordinanceID string) (*api.LawDetail, error)
+	SearchFunc     func(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error)
+	GetDetailFunc  func(ctx context.Context, ordinanceID string) (*api.LawDetail, error)
+	GetHistoryFunc func(ctx context.Context, ordinanceID string) (*api.LawHistory, error)
 }
 
 func (m *MockOrdinanceClient) Search(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
@@ -53,6 +58,9 @@ func (m *MockOrdinanceClient) GetDetail(ctx context.Context, ordinanceID string)
 }
 
 func (m *MockOrdinanceClient) GetHistory(ctx context.Context, ordinanceID string) (*api.LawHistory, error) {
+	if m.GetHistoryFunc != nil {
+		return m.GetHistoryFunc(ctx, ordinanceID)
+	}
 	return nil, nil
 }
 
@@ -230,6 +238,62 @@ func TestOrdinanceSearchCommand(t *testing.T) {
 	}
 }
 
+func TestOrdinancePriorityFile(t *testing.T) {
+	i18n.Init()
+
+	dir := t.TempDir()
+	priorityPath := filepath.Join(dir, "priorities.yaml")
+	if err := os.WriteFile(priorityPath, []byte("departments:\n  - 서울특별시\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	brokenPath := filepath.Join(dir, "broken.yaml")
+	if err := os.WriteFile(brokenPath, []byte("departments: [서울특별시\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var got *api.UnifiedSearchRequest
+	testOrdinanceClient = &MockOrdinanceClient{SearchFunc: func(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+		got = req
+		return &api.SearchResponse{}, nil
+	}}
+	defer func() { testOrdinanceClient = nil }()
+
+	run := func(args ...string) error {
+		cmd := &cobra.Command{Use: "test"}
+		initOrdinanceCmd()
+		cmd.AddCommand(ordinanceCmd)
+		_, err := testutil.ExecuteCommand(t, cmd, append([]string{"ordinance", "search", "주차"}, args...))
+		return err
+	}
+
+	if err := run("--priority-file", priorityPath); err != nil {
+		t.Fatalf("search failed: %v", err)
+	}
+	if got.Priority == nil || !reflect.DeepEqual(got.Priority.Departments, []string{"서울특별시"}) {
+		t.Errorf("request priority = %+v, want the departments of the file", got.Priority)
+	}
+
+	if err := run(); err != nil {
+		t.Fatalf("search failed: %v", err)
+	}
+	if got.Priority != nil {
+		t.Errorf("search without --priority-file should have no priority, got %+v", got.Priority)
+	}
+
+	got = nil
+	err := run("--priority-file", brokenPath)
+	if err == nil || !strings.Contains(err.Error(), "broken.yaml 파싱 실패") || !strings.Contains(err.Error(), "line") {
+		t.Errorf("expected a parse error naming the file and line, got %v", err)
+	}
+	if got != nil {
+		t.Error("a broken priority file should stop the search before the API call")
+	}
+
+	if err := run("--priority-file", filepath.Join(dir, "none.yaml")); err == nil || !strings.Contains(err.Error(), "읽을 수 없습니다") {
+		t.Errorf("expected an error for a missing file, got %v", err)
+	}
+}
+
 func TestOrdinanceDetailCommand(t *testing.T) {
 	// Initialize i18n for testing
 	i18n.Init()
@@ -311,3 +375,79 @@ func TestOrdinanceDetailCommand(t *testing.T) {
 		})
 	}
 }
+
+func TestOrdinanceWatchUICommand(t *testing.T) {
+	i18n.Init()
+
+	testOrdinanceClient = &MockOrdinanceClient{}
+	defer func() { testOrdinanceClient = nil }()
+
+	tests := []struct {
+		name        string
+		args        []string
+		terminal    bool
+		errContains string
+	}{
+		{
+			name:        "Non-terminal output is rejected",
+			args:        []string{"ordinance", "watch-ui", "주차"},
+			terminal:    false,
+			errContains: "터미널에서만",
+		},
+		{
+			name:        "Interval below minimum",
+			args:        []string{"ordinance", "watch-ui", "주차", "--interval", "1s"},
+			terminal:    true,
+			errContains: "갱신 주기가 너무 짧습니다",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := isInteractiveTerminal
+			isInteractiveTerminal = func() bool { return tt.terminal }
+			defer func() { isInteractiveTerminal = original }()
+
+			initOrdinanceCmd()
+			root := &cobra.Command{Use: "test"}
+			root.AddCommand(ordinanceCmd)
+
+			_, err := testutil.ExecuteCommand(t, root, tt.args)
+			if err == nil || !strings.Contains(err.Error(), tt.errContains) {
+				t.Errorf("Execute() error = %v, want error containing %q", err, tt.errContains)
+			}
+		})
+	}
+}
+
+func TestOrdinanceWatchFetcher(t *testing.T) {
+	initOrdinanceCmd()
+	ordinanceRegion = "서울"
+	defer func() { ordinanceRegion = "" }()
+
+	var got *api.UnifiedSearchRequest
+	client := &MockOrdinanceClient{
+		SearchFunc: func(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+			got = req
+			return &api.SearchResponse{TotalCount: 1, Page: 1, Laws: []api.LawInfo{{ID: "ORD001", Name: "주차장 조례"}}}, nil
+		},
+	}
+
+	resp, err := ordinanceWatchFetcher(client, "주차")(context.Background())
+	if err != nil {
+		t.Fatalf("fetch error = %v", err)
+	}
+	if len(resp.Laws) != 1 {
+		t.Errorf("Expected 1 result, got %d", len(resp.Laws))
+	}
+	if got.Query != "주차" || got.Region != "서울" || got.PageNo != 1 || got.Sort != "date" {
+		t.Errorf("Unexpected request: %+v", got)
+	}
+
+	status := ordinanceWatchStatus("주차")
+	for _, s := range []string{"검색어: 주차", "지역: 서울", "정렬: date"} {
+		if !strings.Contains(status, s) {
+			t.Errorf("Status %q should contain %q", status, s)
+		}
+	}
+}
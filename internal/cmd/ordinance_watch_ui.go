@@ -0,0 +1,179 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	cliErrors "github.com/pyhub-apps/pyhub-warp-cli/internal/errors"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/logger"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/onboarding"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/output"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/watch"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+// minWatchInterval keeps the live view from hammering the API
+const minWatchInterval = 5 * time.Second
+
+var (
+	ordinanceWatchUICmd *cobra.Command
+	watchUIInterval     time.Duration
+
+	// isInteractiveTerminal reports whether stdin and stdout are terminals; replaced in tests
+	isInteractiveTerminal = func() bool {
+		return term.IsTerminal(int(os.Stdin.Fd())) && term.IsTerminal(int(os.Stdout.Fd()))
+	}
+)
+
+// initOrdinanceWatchUICmd initializes the ordinance watch-ui subcommand
+func initOrdinanceWatchUICmd() {
+	ordinanceWatchUICmd = &cobra.Command{
+		Use:   "watch-ui <검색어>",
+		Short: "자치법규 검색 결과 실시간 모니터링",
+		Long: `검색을 주기적으로 다시 실행해 결과 표를 화면에 갱신하고,
+직전 갱신 이후 새로 나타나거나 바뀐 항목을 강조합니다.
+
+검색어, 지역, 정렬 조건은 화면 상단에 항상 표시됩니다.
+터미널에서만 사용할 수 있습니다.
+
+키:
+  q  종료
+  p  일시정지/재개
+  r  즉시 갱신`,
+		Example: `  # 1분마다 갱신
+  warp ordinance watch-ui "주차" --interval 1m
+
+  # 지역 필터와 함께
+  warp ordinance watch-ui "도시계획" --region 서울`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: runOrdinanceWatchUICommand,
+	}
+
+	ordinanceWatchUICmd.Flags().DurationVar(&watchUIInterval, "interval", 30*time.Second, "갱신 주기 (최소 5s)")
+}
+
+// updateOrdinanceWatchUICommand updates ordinance watch-ui command descriptions
+func updateOrdinanceWatchUICommand() {
+	if ordinanceWatchUICmd != nil {
+		ordinanceWatchUICmd.Short = "자치법규 검색 결과 실시간 모니터링"
+	}
+}
+
+func runOrdinanceWatchUICommand(cmd *cobra.Command, args []string) error {
+	query := strings.TrimSpace(strings.Join(args, " "))
+	if query == "" {
+		return cliErrors.ErrEmptyQuery
+	}
+	if err := api.ValidateSort(ordinanceSort, ordinanceOrder); err != nil {
+		return err
+	}
+	if watchUIInterval < minWatchInterval {
+		return fmt.Errorf("갱신 주기가 너무 짧습니다: %s (최소 %s)", watchUIInterval, minWatchInterval)
+	}
+	if !isInteractiveTerminal() {
+		return fmt.Errorf("watch-ui는 터미널에서만 사용할 수 있습니다 (스크립트에서는 'warp ordinance search'를 사용하세요)")
+	}
+
+	client := testOrdinanceClient
+	if client == nil {
+		apiClient, err := api.CreateClient(api.APITypeELIS)
+		if err != nil {
+			var cliErr *cliErrors.CLIError
+			if errors.As(err, &cliErr) && cliErr.Code == cliErrors.ErrCodeNoAPIKey {
+				onboarding.NewGuideWithWriter(cmd.OutOrStdout(), false).ShowAPIKeySetup()
+				return cliErrors.Displayed(cliErrors.Wrap(err, cliErrors.ErrNoAPIKey)) // Shown by the guide
+			}
+			return err
+		}
+		client = apiClient
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Raw mode delivers key presses without waiting for Enter
+	fd := int(os.Stdin.Fd())
+	oldState, err := term.MakeRaw(fd)
+	if err != nil {
+		return fmt.Errorf("터미널 설정 실패: %w", err)
+	}
+	defer func() {
+		if err := term.Restore(fd, oldState); err != nil {
+			logger.Warn("터미널 복원 실패: %v", err)
+		}
+	}()
+
+	view := &watch.View{
+		Fetch:    ordinanceWatchFetcher(client, query),
+		Interval: watchUIInterval,
+		Out:      cmd.OutOrStdout(),
+		Keys:     readKeys(os.Stdin),
+		Status:   ordinanceWatchStatus(query),
+		UseColor: output.GetDefaultTableStyle().UseColor,
+		RawMode:  true,
+	}
+	return view.Run(ctx)
+}
+
+// ordinanceWatchFetcher runs the ordinance search with the current flags
+func ordinanceWatchFetcher(client api.ClientInterface, query string) watch.Fetcher {
+	return func(ctx context.Context) (*api.SearchResponse, error) {
+		searchCtx, cancel := context.WithTimeout(ctx, api.Timeout())
+		defer cancel()
+
+		resp, err := client.Search(searchCtx, &api.UnifiedSearchRequest{
+			Query:    query,
+			Region:   ordinanceRegion,
+			PageNo:   1,
+			PageSize: ordinancePageSize,
+			Sort:     ordinanceSort,
+			Order:    ordinanceOrder,
+			Type:     "json",
+		})
+		if err != nil {
+			return nil, err
+		}
+		return transformSearchResults(ctx, resp)
+	}
+}
+
+// ordinanceWatchStatus describes the watched search for the top of the screen
+func ordinanceWatchStatus(query string) string {
+	parts := []string{"검색어: " + query}
+	if ordinanceRegion != "" {
+		parts = append(parts, "지역: "+ordinanceRegion)
+	}
+	sort := ordinanceSort
+	if ordinanceOrder != "" {
+		sort += " " + ordinanceOrder
+	}
+	parts = append(parts, "정렬: "+sort, fmt.Sprintf("크기: %d", ordinancePageSize))
+	return "warp ordinance watch-ui | " + strings.Join(parts, " | ")
+}
+
+// readKeys delivers bytes read from f until it is closed or fails
+func readKeys(f *os.File) <-chan byte {
+	keys := make(chan byte)
+	go func() {
+		defer close(keys)
+		buf := make([]byte, 1)
+		for {
+			n, err := f.Read(buf)
+			if err != nil {
+				return
+			}
+			if n == 1 {
+				keys <- buf[0]
+			}
+		}
+	}()
+	return keys
+}
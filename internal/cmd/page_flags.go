@@ -0,0 +1,28 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	cliErrors "github.com/pyhub-apps/pyhub-warp-cli/internal/errors"
+)
+
+// validatePageFlags rejects a --page below 1 and a --size outside 1 to
+// api.MaxPageSize, before a search sends them
+func validatePageFlags(page, size int) error {
+	if page < 1 {
+		return cliErrors.New(
+			cliErrors.ErrCodeInvalidInput,
+			fmt.Sprintf("--page 값은 1 이상이어야 합니다: %d", page),
+			"첫 페이지는 --page 1입니다",
+		)
+	}
+	if size < 1 || size > api.MaxPageSize {
+		return cliErrors.New(
+			cliErrors.ErrCodeInvalidInput,
+			fmt.Sprintf("--size 값은 1 이상 %d 이하여야 합니다: %d", api.MaxPageSize, size),
+			fmt.Sprintf("더 많은 결과는 --page로 나누어 조회하세요 (기본 크기 %d)", api.DefaultPageSize),
+		)
+	}
+	return nil
+}
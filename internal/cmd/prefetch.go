@@ -0,0 +1,267 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/cache"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/config"
+	cliErrors "github.com/pyhub-apps/pyhub-warp-cli/internal/errors"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/logger"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/onboarding"
+	"github.com/spf13/cobra"
+)
+
+// prefetchOptions holds the flags of the prefetch command
+type prefetchOptions struct {
+	file        string
+	source      string
+	page        int
+	size        int
+	concurrency int
+	rate        float64
+	force       bool
+}
+
+var (
+	prefetchCmd *cobra.Command
+	prefetchOpt prefetchOptions
+
+	// testPrefetchClient allows injecting a mock client for testing
+	testPrefetchClient APIClient
+)
+
+// prefetchSummary counts the outcome of the queries of a prefetch
+type prefetchSummary struct {
+	Filled  int
+	Skipped int
+	Failed  int
+}
+
+// initPrefetchCmd initializes the prefetch command
+func initPrefetchCmd() {
+	prefetchCmd = &cobra.Command{
+		Use:   "prefetch",
+		Short: "자주 쓰는 검색어로 검색 캐시 미리 채우기",
+		Long: `목록 파일의 각 검색어로 법령을 검색해 결과를 검색 캐시에 미리 저장합니다.
+이후 같은 검색어, 페이지, 크기로 'warp law' 검색을 하면 API를 호출하지 않고
+캐시에서 바로 결과를 보여줍니다.
+
+목록 파일은 한 줄에 검색어 하나이며, 빈 줄과 #으로 시작하는 줄은 건너뜁니다.
+유효 시간(cache.ttl, 기본 1h)이 지나지 않은 캐시는 다시 검색하지 않습니다.
+캐시 유효 시간은 'warp config set cache.ttl 2h'로 바꾸고, 0으로 설정하면 캐시를 끕니다.`,
+		Example: `  # 목록의 검색어로 캐시 채우기
+  warp prefetch --file queries.txt
+
+  # 동시 요청과 초당 요청 수 제한
+  warp prefetch --file queries.txt --concurrency 2 --rate 1
+
+  # 신선한 캐시도 다시 검색
+  warp prefetch --file queries.txt --force`,
+		Args: cobra.NoArgs,
+		RunE: runPrefetchCommand,
+	}
+
+	prefetchCmd.Flags().StringVar(&prefetchOpt.file, "file", "", "검색어 목록 파일 (한 줄에 하나, -는 표준 입력)")
+	prefetchCmd.Flags().StringVar(&prefetchOpt.source, "source", "nlic", "검색 대상 API (nlic, elis, all)")
+	prefetchCmd.Flags().IntVarP(&prefetchOpt.page, "page", "p", 1, "캐시할 결과 페이지")
+	prefetchCmd.Flags().IntVarP(&prefetchOpt.size, "size", "s", api.DefaultPageSize, "캐시할 페이지 크기 (warp law 검색과 같아야 캐시가 사용됩니다)")
+	prefetchCmd.Flags().IntVar(&prefetchOpt.concurrency, "concurrency", 4, "동시에 보내는 최대 요청 수")
+	prefetchCmd.Flags().Float64Var(&prefetchOpt.rate, "rate", 5, "초당 최대 요청 수 (0: 제한 없음)")
+	prefetchCmd.Flags().BoolVar(&prefetchOpt.force, "force", false, "신선한 캐시도 다시 검색")
+	prefetchCmd.MarkFlagRequired("file")
+}
+
+// updatePrefetchCommand updates prefetch command descriptions
+func updatePrefetchCommand() {
+	if prefetchCmd != nil {
+		prefetchCmd.Short = "자주 쓰는 검색어로 검색 캐시 미리 채우기"
+	}
+}
+
+// validate checks the prefetch flags
+func (o *prefetchOptions) validate() error {
+	switch o.source {
+	case "nlic", "elis", "all":
+	default:
+		return fmt.Errorf("지원하지 않는 검색 대상: %s (nlic, elis, all 중 선택)", o.source)
+	}
+	if err := validatePageFlags(o.page, o.size); err != nil {
+		return err
+	}
+	if o.concurrency < 1 {
+		return fmt.Errorf("--concurrency는 1 이상이어야 합니다")
+	}
+	if o.rate < 0 {
+		return fmt.Errorf("--rate는 0 이상이어야 합니다")
+	}
+	return nil
+}
+
+func runPrefetchCommand(cmd *cobra.Command, args []string) error {
+	if err := prefetchOpt.validate(); err != nil {
+		return err
+	}
+	queries, err := readQueryFile(prefetchOpt.file, cmd.InOrStdin())
+	if err != nil {
+		return err
+	}
+
+	store := searchCache()
+	if store == nil {
+		return fmt.Errorf("검색 캐시가 꺼져 있습니다. 'warp config set cache.ttl 1h' 명령으로 켜세요")
+	}
+
+	apiType := lawAPIType(prefetchOpt.source)
+	client := testPrefetchClient
+	if client == nil {
+		apiClient, err := api.CreateClient(apiType)
+		if err != nil {
+			if errors.Is(err, api.ErrNoAPIKey) {
+				guide := onboarding.NewGuideWithWriter(cmd.OutOrStdout(), false)
+				guide.ShowAPIKeySetup()
+				return cliErrors.Displayed(cliErrors.Wrap(err, cliErrors.ErrNoAPIKey)) // Shown by the guide
+			}
+			logger.Error("Failed to create API client: %v", err)
+			return err
+		}
+		client = apiClient
+	}
+
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	summary := prefetch(ctx, client, store, string(apiType), queries, prefetchOpt)
+	fmt.Fprintf(cmd.OutOrStdout(), "캐시 워밍업 완료: 채움 %d건, 스킵 %d건, 실패 %d건\n",
+		summary.Filled, summary.Skipped, summary.Failed)
+
+	if summary.Failed > 0 && summary.Filled == 0 && summary.Skipped == 0 {
+		return fmt.Errorf("모든 검색어의 캐시 워밍업에 실패했습니다 (%d건)", summary.Failed)
+	}
+	return nil
+}
+
+// prefetch searches the queries that have no fresh response in store, with at
+// most opts.concurrency requests at a time and opts.rate requests per second,
+// and stores the responses under the keys of the law search
+func prefetch(ctx context.Context, client APIClient, store *cache.Store, source string, queries []string, opts prefetchOptions) prefetchSummary {
+	type pendingQuery struct {
+		req *api.UnifiedSearchRequest
+		key string
+	}
+
+	var summary prefetchSummary
+	var pending []pendingQuery
+	for _, query := range queries {
+		req := lawSearchRequest(query, opts.page, opts.size)
+		key := cache.SearchKey(source, req)
+		if !opts.force && store.Fresh(key) {
+			logger.Debug("Skipping fresh cache entry for %q", query)
+			summary.Skipped++
+			continue
+		}
+		pending = append(pending, pendingQuery{req: req, key: key})
+	}
+
+	logger.Info("캐시 워밍업 중... (%d건, 동시 요청 %d개)", len(pending), opts.concurrency)
+	errs := api.RunBatch(ctx, len(pending), api.BatchOptions{
+		Concurrency: opts.concurrency,
+		Interval:    rateInterval(opts.rate),
+	}, func(ctx context.Context, i int) error {
+		ctx, cancel := context.WithTimeout(ctx, api.Timeout())
+		defer cancel()
+		resp, err := client.Search(ctx, pending[i].req)
+		if err != nil {
+			return err
+		}
+		return store.Put(pending[i].key, resp)
+	})
+
+	for i, err := range errs {
+		if err != nil {
+			logger.Warn("캐시 워밍업 실패 (%s): %v", pending[i].req.Query, err)
+			summary.Failed++
+			continue
+		}
+		summary.Filled++
+	}
+	return summary
+}
+
+// readQueryFile returns the queries of a list file, or of stdin for "-",
+// without duplicates. Blank lines and lines starting with # are skipped.
+func readQueryFile(path string, stdin io.Reader) ([]string, error) {
+	reader := stdin
+	if path != "-" {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("검색어 목록 파일을 열 수 없습니다: %w", err)
+		}
+		defer file.Close()
+		reader = file
+	}
+
+	seen := make(map[string]bool)
+	var queries []string
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || seen[line] {
+			continue
+		}
+		seen[line] = true
+		queries = append(queries, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("검색어 목록 파일 읽기 실패: %w", err)
+	}
+	if len(queries) == 0 {
+		return nil, fmt.Errorf("캐시할 검색어가 없습니다")
+	}
+	return queries, nil
+}
+
+// searchCache returns the cache in the config directory, or nil when the
+// cache is turned off with a cache.ttl of 0 or --no-cache, or the config is
+// not initialized. Offline mode reads the cache even with a cache.ttl of 0,
+// since it has nothing else to answer from.
+func searchCache() *cache.Store {
+	if config.GetConfigDir() == "" || cacheDisabled {
+		return nil
+	}
+
+	ttl := cache.DefaultTTL
+	if value := config.GetString("cache.ttl"); value != "" {
+		parsed, err := cache.ParseTTL(value)
+		switch {
+		case err != nil:
+			logger.Warn("cache.ttl 설정을 무시합니다: %v", err)
+		case parsed == 0:
+			if !offlineMode {
+				return nil
+			}
+		default:
+			ttl = parsed
+		}
+	}
+	return cache.New(filepath.Join(config.GetConfigDir(), cache.DirName), ttl)
+}
+
+// cacheOption has the factory answer the searches, details and histories of
+// its clients from the cache, unless the cache is off. In offline mode they
+// are answered from the cache only.
+func cacheOption() api.ClientOption {
+	store := searchCache()
+	if store == nil {
+		return api.WithCache(nil)
+	}
+	return api.WithCache(cache.Wrapper(store, offlineMode))
+}
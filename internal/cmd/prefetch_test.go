@@ -0,0 +1,168 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/cache"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/config"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/testutil"
+	"github.com/spf13/cobra"
+)
+
+// newPrefetchTestRoot builds a root command with the prefetch and law commands
+// and a config directory in a temp dir
+func newPrefetchTestRoot(t *testing.T) *cobra.Command {
+	t.Helper()
+
+	root := newConfiguredTestRoot(t)
+	initPrefetchCmd()
+	initLawCmd()
+	root.PersistentFlags().Bool("no-history", true, "")
+	root.AddCommand(prefetchCmd)
+	root.AddCommand(lawCmd)
+	return root
+}
+
+func TestPrefetch(t *testing.T) {
+	root := newPrefetchTestRoot(t)
+
+	queryFile := filepath.Join(t.TempDir(), "queries.txt")
+	if err := os.WriteFile(queryFile, []byte("# 매일 보는 법령\n개인정보\n\n도로교통\n개인정보\n실패\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var mu sync.Mutex
+	searched := make(map[string]int)
+	search := func(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+		mu.Lock()
+		searched[req.Query]++
+		mu.Unlock()
+		if req.Query == "실패" {
+			return nil, errors.New("server error")
+		}
+		return &api.SearchResponse{TotalCount: 1, Laws: []api.LawInfo{{ID: "001", Name: req.Query + "법"}}}, nil
+	}
+	testPrefetchClient = &mockAPIClient{searchFunc: search}
+	defer func() { testPrefetchClient = nil }()
+
+	output, err := testutil.ExecuteCommand(t, root, []string{"prefetch", "--file", queryFile})
+	if err != nil {
+		t.Fatalf("prefetch failed: %v", err)
+	}
+	if !strings.Contains(output, "채움 2건, 스킵 0건, 실패 1건") {
+		t.Errorf("unexpected summary: %s", output)
+	}
+	if searched["개인정보"] != 1 || searched["도로교통"] != 1 {
+		t.Errorf("each query should be searched once, got %v", searched)
+	}
+
+	// Fresh entries are skipped; the failed query is tried again
+	output, err = testutil.ExecuteCommand(t, root, []string{"prefetch", "--file", queryFile, "--concurrency", "1", "--rate", "0"})
+	if err != nil {
+		t.Fatalf("prefetch failed: %v", err)
+	}
+	if !strings.Contains(output, "채움 0건, 스킵 2건, 실패 1건") {
+		t.Errorf("unexpected summary: %s", output)
+	}
+	if searched["개인정보"] != 1 || searched["실패"] != 2 {
+		t.Errorf("fresh queries should not be searched again, got %v", searched)
+	}
+
+	// --force refreshes fresh entries
+	if _, err := testutil.ExecuteCommand(t, root, []string{"prefetch", "--file", queryFile, "--force"}); err != nil {
+		t.Fatalf("prefetch failed: %v", err)
+	}
+	if searched["개인정보"] != 2 {
+		t.Errorf("--force should search fresh queries again, got %v", searched)
+	}
+}
+
+func TestPrefetchCacheHit(t *testing.T) {
+	root := newPrefetchTestRoot(t)
+
+	queryFile := filepath.Join(t.TempDir(), "queries.txt")
+	if err := os.WriteFile(queryFile, []byte("개인정보 보호법\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	calls := 0
+	client := &mockAPIClient{searchFunc: func(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+		calls++
+		return &api.SearchResponse{TotalCount: 1, Laws: []api.LawInfo{{ID: "011357", Name: "개인정보 보호법"}}}, nil
+	}}
+	testPrefetchClient = client
+	defer func() { testPrefetchClient = nil }()
+
+	if _, err := testutil.ExecuteCommand(t, root, []string{"prefetch", "--file", queryFile}); err != nil {
+		t.Fatalf("prefetch failed: %v", err)
+	}
+	if calls != 1 {
+		t.Fatalf("expected 1 search by prefetch, got %d", calls)
+	}
+
+	// The law search goes through the same cache as with a real client
+	testAPIClient = cache.NewClient(client, searchCache(), "nlic")
+	defer func() { testAPIClient = nil }()
+
+	output, err := testutil.ExecuteCommand(t, root, []string{"law", "개인정보 보호법", "--format", "json"})
+	if err != nil {
+		t.Fatalf("law search failed: %v", err)
+	}
+	if !strings.Contains(output, "011357") {
+		t.Errorf("expected the cached law in output: %s", output)
+	}
+	if calls != 1 {
+		t.Errorf("prefetched query should be a cache hit, got %d API searches", calls)
+	}
+
+	// Another page size is a different request
+	if _, err := testutil.ExecuteCommand(t, root, []string{"law", "개인정보 보호법", "--size", "10", "--format", "json"}); err != nil {
+		t.Fatalf("law search failed: %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("expected a cache miss for another page size, got %d API searches", calls)
+	}
+}
+
+func TestPrefetchErrors(t *testing.T) {
+	root := newPrefetchTestRoot(t)
+
+	emptyFile := filepath.Join(t.TempDir(), "empty.txt")
+	if err := os.WriteFile(emptyFile, []byte("# 없음\n\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"missing file flag", []string{"prefetch"}, "file"},
+		{"empty list", []string{"prefetch", "--file", emptyFile}, "캐시할 검색어가 없습니다"},
+		{"unknown file", []string{"prefetch", "--file", filepath.Join(t.TempDir(), "none.txt")}, "열 수 없습니다"},
+		{"bad concurrency", []string{"prefetch", "--file", emptyFile, "--concurrency", "0"}, "--concurrency"},
+		{"bad source", []string{"prefetch", "--file", emptyFile, "--source", "prec"}, "지원하지 않는 검색 대상"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := testutil.ExecuteCommand(t, root, tt.args)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+
+	// With cache.ttl 0 the cache is off
+	config.Set("cache.ttl", "0")
+	if searchCache() != nil {
+		t.Error("cache.ttl 0 should turn the cache off")
+	}
+}
@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/i18n"
+	"github.com/spf13/cobra"
+)
+
+// priorityFile holds the --priority-file flag value of a sorted search and
+// the priority loaded from it
+type priorityFile struct {
+	path     string
+	priority *api.Priority
+}
+
+// addPriorityFlag registers the --priority-file flag on a search command
+func addPriorityFlag(cmd *cobra.Command, p *priorityFile) {
+	cmd.Flags().StringVar(&p.path, "priority-file", "", i18n.T("law.flag.priorityFile"))
+}
+
+// updatePriorityFlagUsage updates the --priority-file flag description
+func updatePriorityFlagUsage(cmd *cobra.Command) {
+	if flag := cmd.Flags().Lookup("priority-file"); flag != nil {
+		flag.Usage = i18n.T("law.flag.priorityFile")
+	}
+}
+
+// load reads the priority file, if any, before a search runs. Without
+// --priority-file the priority is nil and results keep the --sort order.
+func (p *priorityFile) load() error {
+	p.priority = nil
+	if p.path == "" {
+		return nil
+	}
+
+	data, err := os.ReadFile(p.path)
+	if err != nil {
+		return fmt.Errorf("우선순위 파일을 읽을 수 없습니다: %w", err)
+	}
+	priority, err := api.ParsePriority(data)
+	if err != nil {
+		return fmt.Errorf("우선순위 파일 %s 파싱 실패: %w", p.path, err)
+	}
+	p.priority = priority
+	return nil
+}
@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/output"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/stats"
+)
+
+// validateQualityReport checks that --quality-report is used with table or json
+// output and without the other output options that replace the results
+func validateQualityReport(report bool, format string, records recordOutput, tree bool) error {
+	if !report {
+		return nil
+	}
+	if format != "table" && format != "json" {
+		return fmt.Errorf("--quality-report 옵션은 table 또는 json 형식에서만 사용할 수 있습니다")
+	}
+	if records.active() || tree {
+		return fmt.Errorf("--quality-report 옵션은 --pluck, --ids-only, --tree와 함께 사용할 수 없습니다")
+	}
+	return nil
+}
+
+// writeQualityReport writes the data quality report of laws instead of the results
+func writeQualityReport(w io.Writer, format string, laws []api.LawInfo) error {
+	report := stats.Quality(laws)
+
+	if format == "json" {
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return fmt.Errorf("JSON 변환 실패: %w", err)
+		}
+		fmt.Fprintln(w, string(data))
+		return nil
+	}
+
+	fmt.Fprintf(w, "데이터 품질 리포트 (총 %d건)\n\n", report.Total)
+	rows := make([][]string, len(report.Fields))
+	for i, f := range report.Fields {
+		rows[i] = []string{
+			f.Field,
+			strconv.Itoa(f.Missing),
+			formatRate(f.MissingRate),
+			strconv.Itoa(f.Invalid),
+			qualitySamples(f),
+		}
+	}
+	fmt.Fprint(w, output.RenderTable([]string{"필드", "누락", "누락률", "이상치", "샘플 ID"}, rows, nil))
+
+	if len(report.Problems()) == 0 && report.Total > 0 {
+		fmt.Fprintln(w, "\n누락되거나 형식이 잘못된 값이 없습니다.")
+	}
+	return nil
+}
+
+// formatRate formats a fraction as a percentage with one decimal
+func formatRate(rate float64) string {
+	return strconv.FormatFloat(rate*100, 'f', 1, 64) + "%"
+}
+
+// qualitySamples lists the sample IDs of the problems of a field
+func qualitySamples(f stats.FieldQuality) string {
+	var parts []string
+	if len(f.MissingSamples) > 0 {
+		parts = append(parts, "누락: "+strings.Join(f.MissingSamples, ", "))
+	}
+	if len(f.InvalidSamples) > 0 {
+		parts = append(parts, "이상치: "+strings.Join(f.InvalidSamples, ", "))
+	}
+	return strings.Join(parts, " / ")
+}
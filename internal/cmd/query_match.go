@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"strings"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	cliErrors "github.com/pyhub-apps/pyhub-warp-cli/internal/errors"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/i18n"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/logger"
+	"github.com/spf13/cobra"
+)
+
+// queryMatch holds the --exact and --all-terms flag values of a search. A
+// query in double quotes asks for its phrase as --exact does.
+type queryMatch struct {
+	exact    bool
+	allTerms bool
+}
+
+// addQueryMatchFlags registers the --exact and --all-terms flags on a search command
+func addQueryMatchFlags(cmd *cobra.Command, m *queryMatch) {
+	cmd.Flags().BoolVar(&m.exact, "exact", false, i18n.T("law.flag.exact"))
+	cmd.Flags().BoolVar(&m.allTerms, "all-terms", false, i18n.T("law.flag.allTerms"))
+}
+
+// updateQueryMatchFlagUsages updates the --exact and --all-terms flag descriptions
+func updateQueryMatchFlagUsages(cmd *cobra.Command) {
+	if flag := cmd.Flags().Lookup("exact"); flag != nil {
+		flag.Usage = i18n.T("law.flag.exact")
+	}
+	if flag := cmd.Flags().Lookup("all-terms"); flag != nil {
+		flag.Usage = i18n.T("law.flag.allTerms")
+	}
+}
+
+// validate rejects --exact together with --all-terms
+func (m *queryMatch) validate() error {
+	if m.exact && m.allTerms {
+		return cliErrors.New(
+			cliErrors.ErrCodeInvalidInput,
+			"--exact와 --all-terms는 함께 사용할 수 없습니다",
+			"구문 전체가 그대로 포함된 결과는 --exact, 단어가 모두 포함된 결과는 --all-terms를 지정하세요",
+		)
+	}
+	return nil
+}
+
+// active reports whether a flag asks to filter the results
+func (m *queryMatch) active() bool {
+	return m.exact || m.allTerms
+}
+
+// mode returns how query must appear in the names of the results
+func (m *queryMatch) mode(query string) api.QueryMatch {
+	_, quoted := api.UnquoteQuery(query)
+	switch {
+	case m.exact || quoted:
+		return api.MatchPhrase
+	case m.allTerms:
+		return api.MatchAllTerms
+	default:
+		return api.MatchAnyTerm
+	}
+}
+
+// apply keeps only the laws whose name matches the query as asked. The
+// search APIs cannot be asked for it, so the results of the page are
+// filtered, which is logged.
+func (m *queryMatch) apply(query string, resp *api.SearchResponse) *api.SearchResponse {
+	mode := m.mode(query)
+	if mode == api.MatchAnyTerm {
+		return resp
+	}
+
+	laws := api.FilterQueryMatch(resp.Laws, query, mode)
+	unquoted, _ := api.UnquoteQuery(query)
+	if mode == api.MatchPhrase {
+		logger.Info("정확 일치: 검색 API에 구문 검색 파라미터가 없어 법령명에 %q 구문이 포함된 결과만 남깁니다 (현재 페이지 %d개 중 %d개)", unquoted, len(resp.Laws), len(laws))
+	} else {
+		logger.Info("모든 단어 일치: 검색 API는 단어 하나만 맞아도 결과를 돌려주므로 법령명에 %s가 모두 포함된 결과만 남깁니다 (현재 페이지 %d개 중 %d개)", strings.Join(strings.Fields(unquoted), ", "), len(resp.Laws), len(laws))
+	}
+
+	filtered := *resp
+	filtered.Laws = laws
+	filtered.TotalCount = len(laws)
+	filtered.FetchedCount = 0
+	return &filtered
+}
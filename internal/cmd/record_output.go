@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/i18n"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// recordOutput holds the --pluck, --ids-only, --delimiter and --null flag values
+type recordOutput struct {
+	pluck     string
+	idsOnly   bool
+	delimiter string
+	null      bool
+}
+
+// addRecordFlags registers the record output flags on a search command
+func addRecordFlags(cmd *cobra.Command, r *recordOutput) {
+	cmd.Flags().StringVar(&r.pluck, "pluck", "", i18n.T("law.flag.pluck"))
+	cmd.Flags().BoolVar(&r.idsOnly, "ids-only", false, i18n.T("law.flag.idsOnly"))
+	cmd.Flags().StringVar(&r.delimiter, "delimiter", "", i18n.T("law.flag.delimiter"))
+	cmd.Flags().BoolVar(&r.null, "null", false, i18n.T("law.flag.null"))
+}
+
+// updateRecordFlagUsages updates the record output flag descriptions
+func updateRecordFlagUsages(cmd *cobra.Command) {
+	if flag := cmd.Flags().Lookup("pluck"); flag != nil {
+		flag.Usage = i18n.T("law.flag.pluck")
+	}
+	if flag := cmd.Flags().Lookup("ids-only"); flag != nil {
+		flag.Usage = i18n.T("law.flag.idsOnly")
+	}
+	if flag := cmd.Flags().Lookup("delimiter"); flag != nil {
+		flag.Usage = i18n.T("law.flag.delimiter")
+	}
+	if flag := cmd.Flags().Lookup("null"); flag != nil {
+		flag.Usage = i18n.T("law.flag.null")
+	}
+}
+
+// active reports whether record output replaces the formatted output
+func (r *recordOutput) active() bool {
+	return r.pluck != "" || r.idsOnly
+}
+
+// options validates the flags and returns the record options
+func (r *recordOutput) options() (output.RecordOptions, error) {
+	if !r.active() {
+		if r.delimiter != "" || r.null {
+			return output.RecordOptions{}, fmt.Errorf("--delimiter, --null 옵션은 --pluck 또는 --ids-only와 함께 사용하세요")
+		}
+		return output.RecordOptions{}, nil
+	}
+	if r.idsOnly && r.pluck != "" {
+		return output.RecordOptions{}, fmt.Errorf("--ids-only와 --pluck은 함께 사용할 수 없습니다")
+	}
+
+	// --ids-only is a shorthand for --pluck detail_id
+	fields := []string{output.DetailIDField}
+	if !r.idsOnly {
+		var err error
+		if fields, err = output.ParsePluckFields(r.pluck); err != nil {
+			return output.RecordOptions{}, err
+		}
+	}
+	opts := output.RecordOptions{Fields: fields, Null: r.null}
+	if r.delimiter != "" {
+		delimiter, err := output.ParseDelimiter(r.delimiter)
+		if err != nil {
+			return output.RecordOptions{}, err
+		}
+		opts.Delimiter = delimiter
+	}
+	return opts, nil
+}
+
+// validate checks the flags before a search runs
+func (r *recordOutput) validate() error {
+	_, err := r.options()
+	return err
+}
+
+// write writes the laws as records
+func (r *recordOutput) write(writer io.Writer, laws []api.LawInfo) error {
+	opts, err := r.options()
+	if err != nil {
+		return err
+	}
+	return output.WriteRecords(writer, laws, opts)
+}
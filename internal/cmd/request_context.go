@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/logger"
+	"github.com/spf13/cobra"
+)
+
+// startSearch builds the RequestContext of the search a command is starting,
+// with the output format of its results, and returns the command context
+// carrying it
+func startSearch(cmd *cobra.Command, query, source, format string, page, size int) context.Context {
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	rc := api.NewRequestContext(query, source, format, page, size)
+	logger.Debug("[%s] %s 시작 (검색어: %s, 대상: %s, 형식: %s, 페이지: %d, 크기: %d)",
+		rc.CorrelationID, cmd.CommandPath(), rc.Query, rc.Source, rc.Format, rc.Page, rc.Size)
+	return api.WithRequestContext(ctx, rc)
+}
+
+// searchRequest returns the RequestContext of the search run by ctx
+func searchRequest(ctx context.Context) (*api.RequestContext, error) {
+	rc, ok := api.RequestContextFrom(ctx)
+	if !ok {
+		return nil, fmt.Errorf("검색 요청 정보가 없습니다")
+	}
+	return rc, nil
+}
+
+// finishSearch logs the end of the search run by ctx
+func finishSearch(ctx context.Context) {
+	if rc, ok := api.RequestContextFrom(ctx); ok {
+		logger.Debug("[%s] 검색 완료 (%s 소요)", rc.CorrelationID, rc.Elapsed().Round(time.Millisecond))
+	}
+}
@@ -0,0 +1,150 @@
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/i18n"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/pipeline"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/testutil"
+	"github.com/spf13/cobra"
+)
+
+// requestCapture is a transformer recording the RequestContext it is run with
+type requestCapture struct{}
+
+// capturedRequest is the RequestContext seen by the last pipeline run
+var capturedRequest *api.RequestContext
+
+func (requestCapture) Name() string { return "test-request-capture" }
+
+func (requestCapture) Transform(ctx context.Context, resp *api.SearchResponse) (*api.SearchResponse, error) {
+	capturedRequest, _ = api.RequestContextFrom(ctx)
+	return nil, pipeline.ErrSkip
+}
+
+func init() {
+	pipeline.Register(1000, requestCapture{})
+}
+
+func TestSearchRequestContextPropagation(t *testing.T) {
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+
+	searchResult := func(ctx context.Context, seen **api.RequestContext) (*api.SearchResponse, error) {
+		*seen, _ = api.RequestContextFrom(ctx)
+		return &api.SearchResponse{TotalCount: 1, Laws: []api.LawInfo{{ID: "001", Name: "개인정보 보호법"}}}, nil
+	}
+
+	tests := []struct {
+		name       string
+		args       []string
+		setup      func(seen **api.RequestContext) func()
+		wantQuery  string
+		wantSource string
+		wantFormat string
+		wantPage   int
+		wantSize   int
+	}{
+		{
+			name: "law",
+			args: []string{"law", "개인정보", "--source", "all", "--page", "2", "--size", "5", "--format", "json"},
+			setup: func(seen **api.RequestContext) func() {
+				testAPIClient = &mockAPIClient{
+					searchFunc: func(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+						return searchResult(ctx, seen)
+					},
+				}
+				return func() { testAPIClient = nil }
+			},
+			wantQuery:  "개인정보",
+			wantSource: "all",
+			wantFormat: "json",
+			wantPage:   2,
+			wantSize:   5,
+		},
+		{
+			name: "search",
+			args: []string{"search", "개인정보", "보호", "--source", "law", "--size", "20"},
+			setup: func(seen **api.RequestContext) func() {
+				testSearchClient = &MockOrdinanceClient{
+					SearchFunc: func(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+						return searchResult(ctx, seen)
+					},
+				}
+				return func() { testSearchClient = nil }
+			},
+			wantQuery:  "개인정보 보호",
+			wantSource: "law",
+			wantFormat: "table",
+			wantPage:   1,
+			wantSize:   20,
+		},
+		{
+			name: "ordinance",
+			args: []string{"ordinance", "주차", "--page", "3"},
+			setup: func(seen **api.RequestContext) func() {
+				testOrdinanceClient = &MockOrdinanceClient{
+					SearchFunc: func(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+						return searchResult(ctx, seen)
+					},
+				}
+				return func() { testOrdinanceClient = nil }
+			},
+			wantQuery:  "주차",
+			wantFormat: "table",
+			wantPage:   3,
+			wantSize:   50,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			initLawCmd()
+			initSearchCmd()
+			initOrdinanceCmd()
+			root := &cobra.Command{Use: "test"}
+			root.PersistentFlags().Bool("no-history", false, "")
+			root.AddCommand(lawCmd)
+			root.AddCommand(searchCmd)
+			root.AddCommand(ordinanceCmd)
+
+			var seen *api.RequestContext
+			defer tt.setup(&seen)()
+			capturedRequest = nil
+
+			if _, err := testutil.ExecuteCommand(t, root, tt.args); err != nil {
+				t.Fatalf("%v failed: %v", tt.args, err)
+			}
+
+			if seen == nil {
+				t.Fatal("client should receive the RequestContext")
+			}
+			if seen.Query != tt.wantQuery || seen.Source != tt.wantSource || seen.Format != tt.wantFormat || seen.Page != tt.wantPage || seen.Size != tt.wantSize {
+				t.Errorf("RequestContext = {%q %q %q %d %d}, want {%q %q %q %d %d}",
+					seen.Query, seen.Source, seen.Format, seen.Page, seen.Size,
+					tt.wantQuery, tt.wantSource, tt.wantFormat, tt.wantPage, tt.wantSize)
+			}
+			if seen.CorrelationID == "" || seen.StartedAt.IsZero() {
+				t.Errorf("RequestContext should have a correlation ID and start time, got %+v", seen)
+			}
+			if capturedRequest != seen {
+				t.Errorf("pipeline should run with the RequestContext of the search, got %+v", capturedRequest)
+			}
+		})
+	}
+}
+
+func TestSearchRequestContextMissing(t *testing.T) {
+	if _, err := searchRequest(context.Background()); err == nil {
+		t.Error("searchRequest() should fail without a RequestContext")
+	}
+
+	first := api.NewRequestContext("민법", "nlic", "table", 1, 10)
+	second := api.NewRequestContext("민법", "nlic", "table", 1, 10)
+	if first.CorrelationID == second.CorrelationID {
+		t.Errorf("correlation IDs should differ, got %q twice", first.CorrelationID)
+	}
+}
@@ -3,10 +3,16 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"strings"
+	"time"
 
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
 	"github.com/pyhub-apps/pyhub-warp-cli/internal/config"
+	cliErrors "github.com/pyhub-apps/pyhub-warp-cli/internal/errors"
 	"github.com/pyhub-apps/pyhub-warp-cli/internal/i18n"
 	"github.com/pyhub-apps/pyhub-warp-cli/internal/logger"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/onboarding"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/output"
 	"github.com/spf13/cobra"
 )
 
@@ -43,7 +49,10 @@ func initRootCmd() {
 			// If no subcommand is provided, show help
 			return cmd.Help()
 		},
+		// Execute prints the error itself, with the exit code of its kind
+		SilenceErrors: true,
 	}
+	rootCmd.SetFlagErrorFunc(flagError)
 }
 
 // Execute adds all child commands to the root command and sets flags appropriately
@@ -52,6 +61,15 @@ func Execute() {
 	if err := i18n.Init(); err != nil {
 		fmt.Fprintf(os.Stderr, "Failed to initialize i18n: %v\n", err)
 	}
+	// Load external translations and switch the language before the commands
+	// are built, so that help is translated too
+	loadLocales(os.Args[1:])
+	if lang := languageFromArgs(os.Args[1:]); lang != "" {
+		if err := i18n.SetLanguage(lang); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(cliErrors.ExitInvalidInput)
+		}
+	}
 
 	// Initialize root command with i18n support
 	initRootCmd()
@@ -62,13 +80,28 @@ func Execute() {
 	initConfigCmd()
 	initConfigSetCmd()
 	initConfigGetCmd()
+	initConfigUnsetCmd()
+	initConfigResetCmd()
 	initConfigPathCmd()
+	initConfigListCmd()
+	initConfigProfileCmd()
 	initLawCmd()
 	initOrdinanceCmd()
 	initPrecedentCmd()
 	initAdmruleCmd()
 	initInterpretationCmd()
 	initSearchCmd()
+	initIndexCmd()
+	initDoctorCmd()
+	initHistoryCmd()
+	initVocabCmd()
+	initBookmarkCmd()
+	initPrefetchCmd()
+	initServeCmd()
+	initInteractiveCmd()
+	initStatsCmd()
+	initLangCmd()
+	initCompletionCmd()
 
 	// Add version command to root
 	rootCmd.AddCommand(versionCmd)
@@ -80,12 +113,24 @@ func Execute() {
 	configSetCmd.SilenceErrors = true
 	configGetCmd.SilenceUsage = true
 	configGetCmd.SilenceErrors = true
+	configUnsetCmd.SilenceUsage = true
+	configUnsetCmd.SilenceErrors = true
+	configResetCmd.SilenceUsage = true
+	configResetCmd.SilenceErrors = true
 	configPathCmd.SilenceUsage = true
 	configPathCmd.SilenceErrors = true
+	configListCmd.SilenceUsage = true
+	configListCmd.SilenceErrors = true
+	configProfileCmd.SilenceUsage = true
+	configProfileCmd.SilenceErrors = true
 
 	configCmd.AddCommand(configSetCmd)
 	configCmd.AddCommand(configGetCmd)
+	configCmd.AddCommand(configUnsetCmd)
+	configCmd.AddCommand(configResetCmd)
 	configCmd.AddCommand(configPathCmd)
+	configCmd.AddCommand(configListCmd)
+	configCmd.AddCommand(configProfileCmd)
 	rootCmd.AddCommand(configCmd)
 
 	// Add law command to root
@@ -106,18 +151,108 @@ func Execute() {
 	// Add unified search command to root
 	rootCmd.AddCommand(searchCmd)
 
-	if err := rootCmd.Execute(); err != nil {
-		fmt.Fprintln(os.Stderr, err)
-		os.Exit(1)
+	// Add full-text index command to root
+	rootCmd.AddCommand(indexCmd)
+
+	// Add diagnostics command to root
+	rootCmd.AddCommand(doctorCmd)
+
+	// Add search history command to root
+	rootCmd.AddCommand(historyCmd)
+
+	// Add completion vocabulary command to root
+	rootCmd.AddCommand(vocabCmd)
+
+	// Add bookmark command to root
+	rootCmd.AddCommand(bookmarkCmd)
+
+	// Add search cache warm-up command to root
+	rootCmd.AddCommand(prefetchCmd)
+
+	// Add HTTP search server command to root
+	rootCmd.AddCommand(serveCmd)
+
+	// Add interactive search command to root
+	rootCmd.AddCommand(interactiveCmd)
+
+	// Add API call metrics command to root
+	rootCmd.AddCommand(statsCmd)
+	rootCmd.AddCommand(langCmd)
+
+	// Add shell completion command to root, with the values of the enum flags
+	rootCmd.AddCommand(completionCmd)
+	registerFlagCompletions(rootCmd)
+
+	err := rootCmd.Execute()
+	finishClipboard(os.Stderr, err)
+	finishMetrics(os.Stderr)
+	closeTraceFile()
+	closeLogFile()
+	if err != nil {
+		// Errors shown by a guide or the output already are not printed again
+		if !cliErrors.IsDisplayed(err) {
+			fmt.Fprintln(os.Stderr, err)
+		}
+		os.Exit(exitCode(err))
 	}
 }
 
 func setupFlags() {
-	// Initialize configuration
-	cobra.OnInitialize(initConfig)
+	// Apply global flags, then initialize configuration, before any command runs
+	rootCmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		// The arguments and flags were accepted, so errors from here on are not
+		// usage errors and are shown without the usage
+		cmd.SilenceUsage = true
+		if err := applyLanguage(cmd); err != nil {
+			return err
+		}
+		applyGlobalFlags(cmd)
+		initConfig()
+		if err := applyLogFormat(cmd); err != nil {
+			return err
+		}
+		if err := applyLogFile(cmd); err != nil {
+			return err
+		}
+		if err := applyTimeout(cmd); err != nil {
+			return err
+		}
+		if err := applyCacheMode(cmd); err != nil {
+			return err
+		}
+		if err := applyTransport(cmd); err != nil {
+			return err
+		}
+		applyUserAgent()
+		if err := applyTrace(cmd); err != nil {
+			return err
+		}
+		applyMetrics(cmd)
+		return applyClipboard(cmd)
+	}
 
 	// Global flags
 	rootCmd.PersistentFlags().BoolP("verbose", "v", false, i18n.T("cli.verbose"))
+	rootCmd.PersistentFlags().BoolP("quiet", "q", false, i18n.T("cli.quiet"))
+	rootCmd.PersistentFlags().Bool("no-color", false, i18n.T("cli.noColor"))
+	rootCmd.PersistentFlags().String("profile", "", i18n.T("cli.profile"))
+	rootCmd.PersistentFlags().Bool("no-history", false, i18n.T("cli.noHistory"))
+	rootCmd.PersistentFlags().Bool("fail-on-empty", false, i18n.T("cli.failOnEmpty"))
+	rootCmd.PersistentFlags().Bool("offline", false, i18n.T("cli.offline"))
+	rootCmd.PersistentFlags().Bool("no-cache", false, i18n.T("cli.noCache"))
+	rootCmd.PersistentFlags().Int("width", 0, i18n.T("cli.width"))
+	rootCmd.PersistentFlags().Duration("timeout", 0, i18n.T("cli.timeout"))
+	rootCmd.PersistentFlags().String("lang", "", i18n.T("cli.lang"))
+	rootCmd.PersistentFlags().String("locale-dir", "", i18n.T("cli.localeDir"))
+	rootCmd.PersistentFlags().String("log-file", "", i18n.T("cli.logFile"))
+	rootCmd.PersistentFlags().Bool("log-also-stderr", false, i18n.T("cli.logAlsoStderr"))
+	rootCmd.PersistentFlags().String("log-format", "", i18n.T("cli.logFormat"))
+	rootCmd.PersistentFlags().Bool("insecure-skip-verify", false, i18n.T("cli.insecureSkipVerify"))
+	rootCmd.PersistentFlags().Bool("trace", false, i18n.T("cli.trace"))
+	rootCmd.PersistentFlags().String("trace-file", "", i18n.T("cli.traceFile"))
+	rootCmd.PersistentFlags().Bool("metrics", false, i18n.T("cli.metrics"))
+	rootCmd.PersistentFlags().String("metrics-file", "", i18n.T("cli.metricsFile"))
+	rootCmd.PersistentFlags().BoolP("clipboard", "c", false, i18n.T("cli.clipboard"))
 
 	// Version flag
 	rootCmd.Version = fmt.Sprintf("%s (built %s, commit %s)", Version, BuildDate, GitCommit)
@@ -134,6 +269,66 @@ func updateCommandDescriptions() {
 	if flag := rootCmd.PersistentFlags().Lookup("verbose"); flag != nil {
 		flag.Usage = i18n.T("cli.verbose")
 	}
+	if flag := rootCmd.PersistentFlags().Lookup("quiet"); flag != nil {
+		flag.Usage = i18n.T("cli.quiet")
+	}
+	if flag := rootCmd.PersistentFlags().Lookup("no-color"); flag != nil {
+		flag.Usage = i18n.T("cli.noColor")
+	}
+	if flag := rootCmd.PersistentFlags().Lookup("profile"); flag != nil {
+		flag.Usage = i18n.T("cli.profile")
+	}
+	if flag := rootCmd.PersistentFlags().Lookup("no-history"); flag != nil {
+		flag.Usage = i18n.T("cli.noHistory")
+	}
+	if flag := rootCmd.PersistentFlags().Lookup("fail-on-empty"); flag != nil {
+		flag.Usage = i18n.T("cli.failOnEmpty")
+	}
+	if flag := rootCmd.PersistentFlags().Lookup("offline"); flag != nil {
+		flag.Usage = i18n.T("cli.offline")
+	}
+	if flag := rootCmd.PersistentFlags().Lookup("no-cache"); flag != nil {
+		flag.Usage = i18n.T("cli.noCache")
+	}
+	if flag := rootCmd.PersistentFlags().Lookup("width"); flag != nil {
+		flag.Usage = i18n.T("cli.width")
+	}
+	if flag := rootCmd.PersistentFlags().Lookup("timeout"); flag != nil {
+		flag.Usage = i18n.T("cli.timeout")
+	}
+	if flag := rootCmd.PersistentFlags().Lookup("lang"); flag != nil {
+		flag.Usage = i18n.T("cli.lang")
+	}
+	if flag := rootCmd.PersistentFlags().Lookup("locale-dir"); flag != nil {
+		flag.Usage = i18n.T("cli.localeDir")
+	}
+	if flag := rootCmd.PersistentFlags().Lookup("log-file"); flag != nil {
+		flag.Usage = i18n.T("cli.logFile")
+	}
+	if flag := rootCmd.PersistentFlags().Lookup("log-also-stderr"); flag != nil {
+		flag.Usage = i18n.T("cli.logAlsoStderr")
+	}
+	if flag := rootCmd.PersistentFlags().Lookup("log-format"); flag != nil {
+		flag.Usage = i18n.T("cli.logFormat")
+	}
+	if flag := rootCmd.PersistentFlags().Lookup("insecure-skip-verify"); flag != nil {
+		flag.Usage = i18n.T("cli.insecureSkipVerify")
+	}
+	if flag := rootCmd.PersistentFlags().Lookup("trace"); flag != nil {
+		flag.Usage = i18n.T("cli.trace")
+	}
+	if flag := rootCmd.PersistentFlags().Lookup("trace-file"); flag != nil {
+		flag.Usage = i18n.T("cli.traceFile")
+	}
+	if flag := rootCmd.PersistentFlags().Lookup("metrics"); flag != nil {
+		flag.Usage = i18n.T("cli.metrics")
+	}
+	if flag := rootCmd.PersistentFlags().Lookup("metrics-file"); flag != nil {
+		flag.Usage = i18n.T("cli.metricsFile")
+	}
+	if flag := rootCmd.PersistentFlags().Lookup("clipboard"); flag != nil {
+		flag.Usage = i18n.T("cli.clipboard")
+	}
 
 	// Update subcommands (these will be updated in their respective files)
 	updateVersionCommand()
@@ -144,17 +339,299 @@ func updateCommandDescriptions() {
 	updateAdmruleCommand()
 	updateInterpretationCommand()
 	updateSearchCommand()
+	updateIndexCommand()
+	updateDoctorCommand()
+	updateHistoryCommand()
+	updateVocabCommand()
+	updateBookmarkCommand()
+	updatePrefetchCommand()
+	updateServeCommand()
+	updateInteractiveCommand()
+	updateStatsCommand()
+	updateLangCommand()
+	updateCompletionCommand()
 }
 
 func init() {
 	// Commands will be added in their respective init functions
 }
 
+// applyGlobalFlags applies the logging and color flags.
+// Order matters: --verbose sets the base log level and --quiet then raises it
+// to Error, so --quiet wins when both are given. --no-color is applied after
+// the NO_COLOR/TERM environment detection and always takes precedence over it.
+// --width overrides the detected terminal width used to fit tables.
+func applyGlobalFlags(cmd *cobra.Command) {
+	flags := cmd.Root().PersistentFlags()
+
+	verbose, _ := flags.GetBool("verbose")
+	logger.SetVerbose(verbose)
+
+	if quiet, _ := flags.GetBool("quiet"); quiet {
+		logger.SetLevel(logger.ErrorLevel)
+	}
+
+	if noColor, _ := flags.GetBool("no-color"); noColor {
+		logger.SetColorEnabled(false)
+		onboarding.SetColorEnabled(false)
+	}
+
+	width, _ := flags.GetInt("width")
+	output.SetTableWidth(width)
+}
+
+// applyLanguage switches the output language to --lang. Execute already
+// applied it before building the commands; this covers commands run without
+// Execute and rejects unsupported languages.
+func applyLanguage(cmd *cobra.Command) error {
+	lang, _ := cmd.Root().PersistentFlags().GetString("lang")
+	if lang == "" || lang == i18n.GetCurrentLanguage() {
+		return nil
+	}
+	if err := i18n.SetLanguage(lang); err != nil {
+		return err
+	}
+	if rootCmd != nil {
+		updateCommandDescriptions()
+	}
+	return nil
+}
+
+// languageFromArgs returns the value of --lang in the command line arguments,
+// or "" if it is not given
+func languageFromArgs(args []string) string {
+	return flagFromArgs(args, "lang")
+}
+
+// flagFromArgs returns the value of the --name flag in the command line
+// arguments, or "" if it is not given. It reads the flags needed before the
+// commands are built.
+func flagFromArgs(args []string, name string) string {
+	for i, arg := range args {
+		if arg == "--" {
+			break
+		}
+		if value, ok := strings.CutPrefix(arg, "--"+name+"="); ok {
+			return value
+		}
+		if arg == "--"+name && i+1 < len(args) {
+			return args[i+1]
+		}
+	}
+	return ""
+}
+
+// loadLocales loads the external translations of --locale-dir, or else of
+// the locales directory of the config. Files that cannot be loaded are
+// skipped with a warning.
+func loadLocales(args []string) {
+	dir := flagFromArgs(args, "locale-dir")
+	if dir == "" {
+		dir = config.LocaleDir()
+	}
+	for _, err := range i18n.LoadLocaleDir(dir) {
+		logger.Warn("%v", err)
+	}
+}
+
+// applyTimeout sets the timeout of API operations from --timeout, or else from
+// the api.timeout setting. It runs after initConfig so that the setting (and its
+// WARP_API_TIMEOUT override) is available.
+func applyTimeout(cmd *cobra.Command) error {
+	flags := cmd.Root().PersistentFlags()
+	if flag := flags.Lookup("timeout"); flag != nil && flag.Changed {
+		timeout, _ := flags.GetDuration("timeout")
+		if timeout <= 0 {
+			return fmt.Errorf("--timeout 값은 0보다 커야 합니다: %s", timeout)
+		}
+		api.SetTimeout(timeout)
+		return nil
+	}
+
+	var timeout time.Duration
+	if value := config.GetString("api.timeout"); value != "" {
+		parsed, err := api.ParseTimeout(value)
+		if err != nil {
+			logger.Warn("api.timeout 설정을 무시합니다: %v", err)
+		}
+		timeout = parsed
+	}
+	api.SetTimeout(timeout)
+	return nil
+}
+
+// applyUserAgent sets the User-Agent of the requests from the api.user_agent
+// setting, or else to warp-cli/<version>. An invalid setting is ignored with
+// a warning.
+func applyUserAgent() {
+	ua := config.GetString("api.user_agent")
+	if ua != "" {
+		if err := api.ValidateUserAgent(ua); err != nil {
+			logger.Warn("api.user_agent 설정을 무시합니다: %v", err)
+			ua = ""
+		}
+	}
+	if ua == "" {
+		ua = api.DefaultUserAgent(Version)
+	}
+	api.SetUserAgent(ua)
+}
+
+// applyTransport configures the HTTP transport of the clients from the
+// api.proxy and api.ca_cert settings and --insecure-skip-verify. Without them
+// the default transport is kept, which honors HTTPS_PROXY. In offline mode
+// the transport refuses every request.
+func applyTransport(cmd *cobra.Command) error {
+	if offlineMode {
+		api.SetTransport(api.OfflineTransport)
+		return nil
+	}
+
+	insecure, _ := cmd.Root().PersistentFlags().GetBool("insecure-skip-verify")
+	opts := api.TransportOptions{
+		Proxy:              config.GetString("api.proxy"),
+		CACert:             config.GetString("api.ca_cert"),
+		InsecureSkipVerify: insecure,
+	}
+	if opts == (api.TransportOptions{}) {
+		api.SetTransport(nil)
+		return nil
+	}
+
+	transport, err := api.NewTransport(opts)
+	if err != nil {
+		return err
+	}
+	if opts.Proxy != "" {
+		logger.Debug("프록시 사용: %s", api.RedactProxyURL(opts.Proxy))
+	}
+	if opts.CACert != "" {
+		logger.Debug("추가 CA 인증서 사용: %s", opts.CACert)
+	}
+	if insecure {
+		logger.Warn("--insecure-skip-verify: 서버 인증서를 검증하지 않습니다. 중간자 공격에 노출될 수 있으니 신뢰할 수 있는 네트워크에서만 사용하고, 가능하면 api.ca_cert로 사내 CA 인증서를 지정하세요")
+	}
+	api.SetTransport(transport)
+	return nil
+}
+
+// traceFile is the file opened for --trace-file, closed when the command ends
+var traceFile *os.File
+
+// applyTrace traces the HTTP requests of the clients: to stderr with the
+// bodies cut with --trace, and in full to the file of --trace-file
+func applyTrace(cmd *cobra.Command) error {
+	flags := cmd.Root().PersistentFlags()
+	enabled, _ := flags.GetBool("trace")
+	path, _ := flags.GetString("trace-file")
+	if !enabled && path == "" {
+		api.SetTracer(nil)
+		return nil
+	}
+
+	tracer := &api.Tracer{BodyLimit: api.DefaultTraceBodyLimit}
+	if enabled {
+		tracer.Output = cmd.ErrOrStderr()
+	}
+	if path != "" {
+		file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+		if err != nil {
+			return fmt.Errorf("트레이스 파일을 열 수 없습니다: %w", err)
+		}
+		closeTraceFile()
+		traceFile = file
+		tracer.File = file
+	}
+	api.SetTracer(tracer)
+	return nil
+}
+
+// closeTraceFile stops tracing to the trace file and closes it
+func closeTraceFile() {
+	if traceFile == nil {
+		return
+	}
+	api.SetTracer(nil)
+	if err := traceFile.Close(); err != nil {
+		fmt.Fprintf(os.Stderr, "트레이스 파일 닫기 실패: %v\n", err)
+	}
+	traceFile = nil
+}
+
+// applyLogFormat sets the layout of the log lines from --log-format, or else
+// from the log.format setting
+func applyLogFormat(cmd *cobra.Command) error {
+	if value, _ := cmd.Root().PersistentFlags().GetString("log-format"); value != "" {
+		format, err := logger.ParseFormat(value)
+		if err != nil {
+			return fmt.Errorf("--log-format: %w", err)
+		}
+		logger.SetFormat(format)
+		return nil
+	}
+
+	format, err := logger.ParseFormat(config.GetString("log.format"))
+	logger.SetFormat(format)
+	if err != nil {
+		logger.Warn("log.format 설정을 무시합니다: %v", err)
+	}
+	return nil
+}
+
+// logFile is the log file opened by applyLogFile, closed when the command ends
+var logFile *logger.RotatingFile
+
+// applyLogFile writes the logs to --log-file or the log.file setting,
+// rotating the file at log.maxsize. The logs then leave stderr unless
+// --log-also-stderr is given.
+func applyLogFile(cmd *cobra.Command) error {
+	flags := cmd.Root().PersistentFlags()
+	path, _ := flags.GetString("log-file")
+	if path == "" {
+		path = config.GetString("log.file")
+	}
+	if path == "" {
+		return nil
+	}
+
+	maxSize := logger.DefaultMaxSize
+	if value := config.GetString("log.maxsize"); value != "" {
+		parsed, err := logger.ParseSize(value)
+		if err != nil {
+			logger.Warn("log.maxsize 설정을 무시합니다: %v", err)
+		} else {
+			maxSize = parsed
+		}
+	}
+
+	file, err := logger.OpenRotatingFile(path, maxSize, logger.DefaultBackups)
+	if err != nil {
+		return err
+	}
+	closeLogFile()
+	logFile = file
+	alsoStderr, _ := flags.GetBool("log-also-stderr")
+	logger.SetFileOutput(file, alsoStderr)
+	return nil
+}
+
+// closeLogFile stops writing logs to the log file and closes it
+func closeLogFile() {
+	if logFile == nil {
+		return
+	}
+	logger.SetFileOutput(nil, false)
+	if err := logFile.Close(); err != nil {
+		fmt.Fprintf(os.Stderr, "로그 파일 닫기 실패: %v\n", err)
+	}
+	logFile = nil
+}
+
 // initConfig initializes the configuration
 func initConfig() {
-	// Set up logging based on verbose flag
-	if verbose, _ := rootCmd.PersistentFlags().GetBool("verbose"); verbose {
-		logger.SetVerbose(true)
+	// Select configuration profile (overrides WARP_PROFILE and the saved default)
+	if profile, _ := rootCmd.PersistentFlags().GetString("profile"); profile != "" {
+		config.SetProfile(profile)
 	}
 
 	if err := config.Initialize(); err != nil {
@@ -2,10 +2,25 @@ package cmd
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/fatih/color"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/config"
+	cliErrors "github.com/pyhub-apps/pyhub-warp-cli/internal/errors"
 	"github.com/pyhub-apps/pyhub-warp-cli/internal/i18n"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/logger"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/onboarding"
 	"github.com/spf13/cobra"
 )
 
@@ -115,6 +130,96 @@ func TestRootCommandVerboseFlag(t *testing.T) {
 	}
 }
 
+func TestRootCommandQuietAndNoColorFlags(t *testing.T) {
+	// Initialize i18n for testing (Korean by default)
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+
+	// Keep config initialization away from the real home directory
+	t.Setenv("HOME", t.TempDir())
+	config.ResetConfig()
+
+	origNoColor := color.NoColor
+	defer func() {
+		color.NoColor = origNoColor
+		logger.SetOutput(os.Stderr)
+		logger.SetVerbose(false)
+		logger.SetColorEnabled(!origNoColor)
+		onboarding.SetColorEnabled(true)
+		config.ResetConfig()
+	}()
+
+	tests := []struct {
+		name      string
+		flags     []string
+		wantInfo  bool
+		wantDebug bool
+		wantError bool
+		wantColor bool
+	}{
+		{"No flags", nil, true, false, true, true},
+		{"Verbose", []string{"--verbose"}, true, true, true, true},
+		{"Quiet", []string{"--quiet"}, false, false, true, true},
+		{"Quiet short flag", []string{"-q"}, false, false, true, true},
+		{"No color", []string{"--no-color"}, true, false, true, false},
+		{"Quiet and no color", []string{"--quiet", "--no-color"}, false, false, true, false},
+		{"Quiet wins over verbose", []string{"--verbose", "--quiet"}, false, false, true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Reset global state between runs
+			logger.SetVerbose(false)
+			logger.SetColorEnabled(true)
+			onboarding.SetColorEnabled(true)
+
+			initRootCmd()
+			setupFlags()
+			var logs bytes.Buffer
+			executed := false
+			rootCmd.AddCommand(&cobra.Command{
+				Use: "probe",
+				Run: func(cmd *cobra.Command, args []string) {
+					executed = true
+					logger.SetOutput(&logs)
+					logger.Debug("debug-message")
+					logger.Info("info-message")
+					logger.Error("error-message")
+				},
+			})
+
+			var out bytes.Buffer
+			rootCmd.SetOut(&out)
+			rootCmd.SetErr(&out)
+			rootCmd.SetArgs(append(tt.flags, "probe"))
+			if err := rootCmd.Execute(); err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			if !executed {
+				t.Fatal("probe command was not executed")
+			}
+
+			output := logs.String()
+			if got := strings.Contains(output, "info-message"); got != tt.wantInfo {
+				t.Errorf("info logged = %v, want %v (logs: %q)", got, tt.wantInfo, output)
+			}
+			if got := strings.Contains(output, "debug-message"); got != tt.wantDebug {
+				t.Errorf("debug logged = %v, want %v (logs: %q)", got, tt.wantDebug, output)
+			}
+			if got := strings.Contains(output, "error-message"); got != tt.wantError {
+				t.Errorf("error logged = %v, want %v (logs: %q)", got, tt.wantError, output)
+			}
+
+			var guideOut bytes.Buffer
+			onboarding.NewGuideWithWriter(&guideOut, true).ShowSuccess("done")
+			if got := strings.Contains(guideOut.String(), "\x1b["); got != tt.wantColor {
+				t.Errorf("guide colored = %v, want %v (output: %q)", got, tt.wantColor, guideOut.String())
+			}
+		})
+	}
+}
+
 func TestSetVersionInfo(t *testing.T) {
 	// Save original values
 	origVersion := Version
@@ -184,3 +289,477 @@ func TestExecuteFunction(t *testing.T) {
 	// This should not panic or exit
 	Execute()
 }
+
+func TestRootCommandTimeoutFlag(t *testing.T) {
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+
+	// Keep config initialization away from the real home directory
+	t.Setenv("HOME", t.TempDir())
+	config.ResetConfig()
+	defer func() {
+		api.SetTimeout(0)
+		config.ResetConfig()
+	}()
+
+	tests := []struct {
+		name    string
+		flags   []string
+		env     string
+		want    time.Duration
+		wantErr string
+	}{
+		{"Default", nil, "", api.DefaultTimeout, ""},
+		{"Flag", []string{"--timeout", "45s"}, "", 45 * time.Second, ""},
+		{"Setting", nil, "1m", time.Minute, ""},
+		{"Setting in seconds", nil, "20", 20 * time.Second, ""},
+		{"Flag wins over setting", []string{"--timeout", "5s"}, "1m", 5 * time.Second, ""},
+		{"Invalid setting is ignored", nil, "soon", api.DefaultTimeout, ""},
+		{"Zero flag", []string{"--timeout", "0s"}, "", 0, "0보다 커야"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("WARP_API_TIMEOUT", tt.env)
+			api.SetTimeout(0)
+
+			initRootCmd()
+			setupFlags()
+			var got time.Duration
+			rootCmd.AddCommand(&cobra.Command{
+				Use: "probe",
+				Run: func(cmd *cobra.Command, args []string) {
+					got = api.Timeout()
+				},
+			})
+
+			var out bytes.Buffer
+			rootCmd.SetOut(&out)
+			rootCmd.SetErr(&out)
+			rootCmd.SetArgs(append(tt.flags, "probe"))
+			err := rootCmd.Execute()
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Execute() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("api.Timeout() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRootCommandUserAgent(t *testing.T) {
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+
+	t.Setenv("HOME", t.TempDir())
+	config.ResetConfig()
+	origVersion := Version
+	defer func() {
+		Version = origVersion
+		api.SetUserAgent("")
+		config.ResetConfig()
+	}()
+	Version = "1.2.0"
+
+	tests := []struct {
+		name string
+		env  string
+		want string
+	}{
+		{"Version", "", "warp-cli/1.2.0"},
+		{"Setting", "warp-cli/1.2.0 (team@example.com)", "warp-cli/1.2.0 (team@example.com)"},
+		{"Invalid setting is ignored", "warp\x7fcli", "warp-cli/1.2.0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("WARP_API_USER_AGENT", tt.env)
+			initRootCmd()
+			setupFlags()
+			var got string
+			rootCmd.AddCommand(&cobra.Command{
+				Use: "probe",
+				Run: func(cmd *cobra.Command, args []string) {
+					got = api.UserAgent()
+				},
+			})
+			var out bytes.Buffer
+			rootCmd.SetOut(&out)
+			rootCmd.SetErr(&out)
+			rootCmd.SetArgs([]string{"probe"})
+			if err := rootCmd.Execute(); err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("api.UserAgent() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWrapTimeout(t *testing.T) {
+	api.SetTimeout(45 * time.Second)
+	defer api.SetTimeout(0)
+
+	err := wrapTimeout(fmt.Errorf("NLIC: %w", context.DeadlineExceeded))
+	var cliErr *cliErrors.CLIError
+	if !errors.As(err, &cliErr) || cliErr.Code != cliErrors.ErrCodeTimeout {
+		t.Fatalf("wrapTimeout() = %v, want a timeout CLIError", err)
+	}
+	if !strings.Contains(cliErr.Message, "45s") || !strings.Contains(cliErr.Hint, "--timeout") {
+		t.Errorf("timeout error should name the limit and --timeout, got %q / %q", cliErr.Message, cliErr.Hint)
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("wrapped error should keep the cause: %v", err)
+	}
+
+	other := fmt.Errorf("서버 오류")
+	if got := wrapTimeout(other); got != other {
+		t.Errorf("wrapTimeout() should leave other errors unchanged, got %v", got)
+	}
+}
+
+func TestWrapAPIError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantCode cliErrors.ErrorCode
+		wantHint string
+	}{
+		{"no key", fmt.Errorf("%w. 설정하세요", api.ErrNoAPIKey), cliErrors.ErrCodeNoAPIKey, "warp config set law.key"},
+		{"unauthorized", fmt.Errorf("검색 실패: %w", &api.StatusError{Kind: api.ErrUnauthorized, StatusCode: 401}), cliErrors.ErrCodeInvalidAPIKey, "warp config get law.key"},
+		{"api key error", &api.APIKeyError{Message: "API 인증 실패"}, cliErrors.ErrCodeInvalidAPIKey, "warp config get law.key"},
+		{"rate limited", &api.RetryableError{Err: &api.StatusError{Kind: api.ErrRateLimited, StatusCode: 429}}, cliErrors.ErrCodeRateLimit, "--rate"},
+		{"unavailable", &api.StatusError{Kind: api.ErrServiceUnavailable, StatusCode: 503}, cliErrors.ErrCodeServerError, "warp doctor"},
+		{"not found", &api.StatusError{Kind: api.ErrNotFound, StatusCode: 404}, cliErrors.ErrCodeAPIResponse, "ID"},
+		{"timeout", fmt.Errorf("NLIC: %w", context.DeadlineExceeded), cliErrors.ErrCodeTimeout, "--timeout"},
+		{"network", fmt.Errorf("검색 실패: %w", &url.Error{Op: "Get", URL: "http://localhost", Err: errors.New("connection refused")}), cliErrors.ErrCodeNetwork, "인터넷 연결"},
+		{"offline miss", fmt.Errorf("%w: 011357", api.ErrOffline), cliErrors.ErrCodeOffline, "--offline 없이"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := wrapAPIError(tt.err)
+			var cliErr *cliErrors.CLIError
+			if !errors.As(err, &cliErr) || cliErr.Code != tt.wantCode {
+				t.Fatalf("wrapAPIError() = %v, want code %s", err, tt.wantCode)
+			}
+			if !strings.Contains(cliErr.Hint, tt.wantHint) {
+				t.Errorf("hint %q should contain %q", cliErr.Hint, tt.wantHint)
+			}
+			if !errors.Is(err, tt.err) {
+				t.Errorf("wrapped error should keep the cause: %v", err)
+			}
+		})
+	}
+
+	other := fmt.Errorf("응답 파싱 실패")
+	if got := wrapAPIError(other); got != other {
+		t.Errorf("wrapAPIError() should leave other errors unchanged, got %v", got)
+	}
+	if wrapAPIError(nil) != nil {
+		t.Error("wrapAPIError(nil) should be nil")
+	}
+}
+
+func TestRootCommandLangFlag(t *testing.T) {
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+	t.Setenv("HOME", t.TempDir())
+	config.ResetConfig()
+	defer func() {
+		i18n.Init()
+		config.ResetConfig()
+	}()
+
+	testAPIClient = &mockAPIClient{searchFunc: func(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+		return &api.SearchResponse{TotalCount: 1, Laws: []api.LawInfo{{ID: "011357", Name: "개인정보 보호법", Department: "개인정보보호위원회"}}}, nil
+	}}
+	defer func() { testAPIClient = nil }()
+
+	tests := []struct {
+		name      string
+		args      []string
+		contains  []string
+		forbidden []string
+		wantErr   string
+	}{
+		{
+			name:     "Korean by default",
+			args:     []string{"law", "개인정보"},
+			contains: []string{"총 1개의 법령을 찾았습니다.", "소관부처"},
+		},
+		{
+			name:      "English",
+			args:      []string{"--lang", "en", "law", "개인정보"},
+			contains:  []string{"Found 1 laws.", "MINISTRY"},
+			forbidden: []string{"찾았습니다", "소관부처"},
+		},
+		{
+			name:    "Unsupported language",
+			args:    []string{"--lang=fr", "law", "개인정보"},
+			wantErr: "unsupported language",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i18n.Init()
+			initRootCmd()
+			setupFlags()
+			initLawCmd()
+			rootCmd.AddCommand(lawCmd)
+
+			var out bytes.Buffer
+			rootCmd.SetOut(&out)
+			rootCmd.SetErr(&out)
+			rootCmd.SetArgs(append(tt.args, "--no-history"))
+			err := rootCmd.Execute()
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Execute() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			for _, s := range tt.contains {
+				if !strings.Contains(out.String(), s) {
+					t.Errorf("output should contain %q, got:\n%s", s, out.String())
+				}
+			}
+			for _, s := range tt.forbidden {
+				if strings.Contains(out.String(), s) {
+					t.Errorf("output should not contain %q, got:\n%s", s, out.String())
+				}
+			}
+		})
+	}
+}
+
+func TestRootCommandLogFile(t *testing.T) {
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+	t.Setenv("HOME", t.TempDir())
+	config.ResetConfig()
+	defer func() {
+		closeLogFile()
+		logger.SetVerbose(false)
+		config.ResetConfig()
+	}()
+
+	testAPIClient = &mockAPIClient{searchFunc: func(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+		return &api.SearchResponse{TotalCount: 1, Laws: []api.LawInfo{{ID: "011357", Name: "개인정보 보호법"}}}, nil
+	}}
+	defer func() { testAPIClient = nil }()
+
+	run := func(args ...string) {
+		t.Helper()
+		initRootCmd()
+		setupFlags()
+		initLawCmd()
+		rootCmd.AddCommand(lawCmd)
+
+		var out bytes.Buffer
+		rootCmd.SetOut(&out)
+		rootCmd.SetErr(&out)
+		rootCmd.SetArgs(append(args, "--no-history"))
+		if err := rootCmd.Execute(); err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		closeLogFile()
+	}
+
+	dir := t.TempDir()
+	flagPath := filepath.Join(dir, "flag", "warp.log")
+	run("law", "개인정보", "--verbose", "--log-file", flagPath)
+	data, err := os.ReadFile(flagPath)
+	if err != nil {
+		t.Fatalf("log file not written: %v", err)
+	}
+	if !strings.Contains(string(data), "[DEBUG]") || strings.Contains(string(data), "\x1b[") {
+		t.Errorf("log file should hold the verbose logs without colors, got:\n%s", data)
+	}
+
+	// Without the flag the log.file setting is used
+	configPath := filepath.Join(dir, "config.log")
+	config.Set("log.file", configPath)
+	run("law", "개인정보")
+	if data, err := os.ReadFile(configPath); err != nil || !strings.Contains(string(data), "[INFO]") {
+		t.Errorf("log.file should receive the logs, got %q, %v", data, err)
+	}
+}
+
+func TestRootCommandLogFormat(t *testing.T) {
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+	t.Setenv("HOME", t.TempDir())
+	config.ResetConfig()
+	defer func() {
+		closeLogFile()
+		logger.SetFormat(logger.TextFormat)
+		logger.SetVerbose(false)
+		config.ResetConfig()
+	}()
+
+	testAPIClient = &mockAPIClient{searchFunc: func(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+		return &api.SearchResponse{TotalCount: 1, Laws: []api.LawInfo{{ID: "011357", Name: "개인정보 보호법"}}}, nil
+	}}
+	defer func() { testAPIClient = nil }()
+
+	run := func(args ...string) error {
+		t.Helper()
+		initRootCmd()
+		setupFlags()
+		initLawCmd()
+		rootCmd.AddCommand(lawCmd)
+
+		var out bytes.Buffer
+		rootCmd.SetOut(&out)
+		rootCmd.SetErr(&out)
+		rootCmd.SetArgs(append(args, "--no-history"))
+		defer closeLogFile()
+		return rootCmd.Execute()
+	}
+
+	path := filepath.Join(t.TempDir(), "warp.log")
+	if err := run("law", "개인정보", "--verbose", "--log-file", path, "--log-format", "json"); err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	for _, line := range lines {
+		var entry struct {
+			Level string `json:"level"`
+			Msg   string `json:"msg"`
+		}
+		if err := json.Unmarshal([]byte(line), &entry); err != nil || entry.Level == "" || entry.Msg == "" {
+			t.Errorf("log line is not a JSON log entry: %q (%v)", line, err)
+		}
+	}
+
+	if err := run("law", "개인정보", "--log-format", "xml"); err == nil || !strings.Contains(err.Error(), "--log-format") {
+		t.Errorf("an unknown --log-format should fail, got %v", err)
+	}
+}
+
+func TestLanguageFromArgs(t *testing.T) {
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"law", "개인정보"}, ""},
+		{[]string{"--lang", "en", "law", "개인정보"}, "en"},
+		{[]string{"law", "개인정보", "--lang=en"}, "en"},
+		{[]string{"law", "--", "--lang", "en"}, ""},
+		{[]string{"law", "--lang"}, ""},
+	}
+
+	for _, tt := range tests {
+		if got := languageFromArgs(tt.args); got != tt.want {
+			t.Errorf("languageFromArgs(%v) = %q, want %q", tt.args, got, tt.want)
+		}
+	}
+}
+
+func TestSearchCommandsPageSize(t *testing.T) {
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+	initLawCmd()
+	initSearchCmd()
+	initOrdinanceCmd()
+	initPrecedentSearchCmd()
+	initInterpretationSearchCmd()
+	initAdmruleSearchCmd()
+	initPrefetchCmd()
+
+	// Every search shows pages of the same size unless --size is given
+	want := strconv.Itoa(api.DefaultPageSize)
+	for name, cmd := range map[string]*cobra.Command{
+		"law":                   lawCmd,
+		"law search":            lawSearchCmd,
+		"search":                searchCmd,
+		"ordinance":             ordinanceCmd,
+		"precedent search":      precedentSearchCmd,
+		"interpretation search": interpretationSearchCmd,
+		"admrule search":        admruleSearchCmd,
+		"prefetch":              prefetchCmd,
+	} {
+		flag := cmd.Flag("size")
+		if flag == nil {
+			t.Errorf("%s has no --size flag", name)
+			continue
+		}
+		if flag.DefValue != want {
+			t.Errorf("%s --size default = %s, want %s", name, flag.DefValue, want)
+		}
+	}
+}
+
+func TestRootCommandExitCodes(t *testing.T) {
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+	defer func() { testAPIClient = nil }()
+
+	found := &api.SearchResponse{TotalCount: 1, Laws: []api.LawInfo{{ID: "011357", Name: "개인정보 보호법"}}}
+	tests := []struct {
+		name     string
+		args     []string
+		resp     *api.SearchResponse
+		err      error
+		want     int
+		contains string
+	}{
+		{"results", []string{"law", "개인정보"}, found, nil, cliErrors.ExitOK, "개인정보 보호법"},
+		{"no results", []string{"law", "없는법"}, &api.SearchResponse{}, nil, cliErrors.ExitOK, "검색 결과가 없습니다"},
+		{"no results with --fail-on-empty", []string{"--fail-on-empty", "law", "없는법"}, &api.SearchResponse{}, nil, cliErrors.ExitNoResults, "검색 결과가 없습니다"},
+		{"results with --fail-on-empty", []string{"law", "search", "개인정보", "--fail-on-empty"}, found, nil, cliErrors.ExitOK, "개인정보 보호법"},
+		{"count only without results", []string{"--fail-on-empty", "law", "없는법", "--count-only"}, &api.SearchResponse{}, nil, cliErrors.ExitNoResults, "0"},
+		{"rejected API key", []string{"law", "개인정보"}, nil, &api.APIKeyError{Message: "API 인증 실패"}, cliErrors.ExitAuth, "API 인증 실패"},
+		{"network failure", []string{"law", "개인정보"}, nil, &api.RetryableError{Err: &url.Error{Op: "Get", URL: "http://localhost", Err: errors.New("connection refused")}}, cliErrors.ExitNetwork, "서버에 연결할 수 없습니다"},
+		{"timeout", []string{"law", "개인정보"}, nil, context.DeadlineExceeded, cliErrors.ExitNetwork, "요청 시간이 초과되었습니다"},
+		{"server error", []string{"law", "개인정보"}, nil, &api.StatusError{Kind: api.ErrServiceUnavailable, StatusCode: 503}, cliErrors.ExitFailure, "API 서버에서 오류가 발생했습니다"},
+		{"empty query", []string{"law", "   "}, found, nil, cliErrors.ExitInvalidInput, ""},
+		{"unknown flag", []string{"law", "개인정보", "--no-such-flag"}, found, nil, cliErrors.ExitInvalidInput, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testAPIClient = &mockAPIClient{searchFunc: func(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+				return tt.resp, tt.err
+			}}
+			initRootCmd()
+			setupFlags()
+			initLawCmd()
+			rootCmd.AddCommand(lawCmd)
+
+			var out bytes.Buffer
+			rootCmd.SetOut(&out)
+			rootCmd.SetErr(&out)
+			rootCmd.SetArgs(append(tt.args, "--no-history"))
+			err := rootCmd.Execute()
+			if got := exitCode(err); got != tt.want {
+				t.Errorf("exit code = %d (error %v), want %d", got, err, tt.want)
+			}
+			if !strings.Contains(out.String(), tt.contains) {
+				t.Errorf("output should contain %q, got:\n%s", tt.contains, out.String())
+			}
+		})
+	}
+}
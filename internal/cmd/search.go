@@ -10,9 +10,11 @@ import (
 
 	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
 	cliErrors "github.com/pyhub-apps/pyhub-warp-cli/internal/errors"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/i18n"
 	"github.com/pyhub-apps/pyhub-warp-cli/internal/logger"
 	"github.com/pyhub-apps/pyhub-warp-cli/internal/onboarding"
 	"github.com/pyhub-apps/pyhub-warp-cli/internal/output"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/pipeline"
 	"github.com/spf13/cobra"
 )
 
@@ -20,9 +22,25 @@ var (
 	searchOutputFormat string
 	searchPageNo       int
 	searchPageSize     int
+	searchLimit        int
 	searchSource       string // "all", "law", "ordinance"
 	searchRegion       string
 	searchSort         string
+	searchOrder        string
+	searchEffect       effectFilter
+	searchJSONSchema   string
+	searchRecords      recordOutput
+	searchNotify       notifyOptions
+	searchQuality      bool
+	searchFixed        fixedOutput
+	searchMatch        queryMatch
+	searchFilters      fieldFilters
+	searchPriority     priorityFile
+	searchTree         bool
+	searchCountOnly    bool
+	searchSummary      bool
+	searchSuggest      suggestOptions
+	searchStream       bool
 
 	// testSearchClient allows injecting a mock client for testing
 	testSearchClient api.ClientInterface
@@ -53,18 +71,48 @@ UnifiedClient를 사용하여 병렬로 검색을 수행하며,
   warp search "주차" --region 서울
   
   # JSON 형식으로 출력
-  warp search "도로교통법" --format json`,
+  warp search "도로교통법" --format json
+  
+  # 공포되었지만 아직 시행되지 않은 법령만 표시
+  warp search "개인정보" --upcoming
+  
+  # 조례와 시행규칙을 계층 트리로 표시
+  warp search "주차장" --source ordinance --tree
+  
+  # 공포일자가 가장 최근인 5건만 표시
+  warp search "개인정보" --limit 5
+  
+  # 소스마다 결과를 받는 즉시 한 줄씩 ndjson으로 출력
+  warp search "주차" --stream | jq -r .source`,
 		Args: cobra.MinimumNArgs(1),
 		RunE: runSearchCommand,
 	}
 
 	// Add flags
-	searchCmd.Flags().StringVarP(&searchOutputFormat, "format", "f", "table", "출력 형식 (table, json, markdown, csv, html, html-simple)")
+	searchCmd.Flags().StringVarP(&searchOutputFormat, "format", "f", "table", "출력 형식 (table, json, ndjson, xml, markdown, csv, html, html-simple, fixed, rss, atom)")
 	searchCmd.Flags().IntVarP(&searchPageNo, "page", "p", 1, "페이지 번호")
-	searchCmd.Flags().IntVarP(&searchPageSize, "size", "s", 50, "페이지 크기")
+	searchCmd.Flags().IntVarP(&searchPageSize, "size", "s", api.DefaultPageSize, "페이지 크기")
+	searchCmd.Flags().IntVarP(&searchLimit, "limit", "l", 0, "표시할 결과 상한: 정렬한 페이지의 앞에서부터 N건만 표시 (0: 제한 없음; 페이지는 --size로 나눔)")
 	searchCmd.Flags().StringVar(&searchSource, "source", "all", "검색 대상 (all, law, ordinance)")
 	searchCmd.Flags().StringVarP(&searchRegion, "region", "r", "", "지역 필터 (자치법규용)")
-	searchCmd.Flags().StringVar(&searchSort, "sort", "date", "정렬 순서 (date: 날짜순, name: 이름순)")
+	searchCmd.Flags().StringVar(&searchSort, "sort", "date", "정렬 기준 (relevance: API 반환 순서, name: 법령명, effectDate: 시행일자, promulDate/date: 공포일자)")
+	searchCmd.Flags().StringVar(&searchOrder, "order", "", "정렬 방향 (asc, desc; 기본: name은 asc, 날짜는 desc)")
+	addPriorityFlag(searchCmd, &searchPriority)
+	addRecordFlags(searchCmd, &searchRecords)
+	searchCmd.Flags().BoolVar(&searchTree, "tree", false, "결과를 법률-시행령-시행규칙 계층 트리로 표시 (법령명과 법령구분으로 추정)")
+	searchCmd.Flags().StringVar(&searchJSONSchema, "json-schema", output.SchemaRaw, "JSON 출력 스키마 (raw: API 원본 키, canonical: 영문 snake_case 키)")
+	searchCmd.Flags().BoolVar(&searchEffect.upcoming, "upcoming", false, "시행일이 기준일 이후인(시행 예정) 법령만 표시")
+	searchCmd.Flags().BoolVar(&searchEffect.inForce, "in-force", false, "기준일 현재 시행 중인 법령만 표시")
+	searchCmd.Flags().StringVar(&searchEffect.asOf, "as-of", "", "시행일 필터 기준 날짜 (YYYYMMDD, 기본값: 오늘)")
+	addNotifyFlags(searchCmd, &searchNotify)
+	addFixedFlags(searchCmd, &searchFixed)
+	addQueryMatchFlags(searchCmd, &searchMatch)
+	addFieldFilterFlags(searchCmd, &searchFilters)
+	searchCmd.Flags().BoolVar(&searchQuality, "quality-report", false, "결과 대신 필드별 누락률과 이상치(잘못된 날짜 형식 등) 리포트를 출력 (table, json)")
+	searchCmd.Flags().BoolVar(&searchCountOnly, "count-only", false, `검색 결과 대신 전체 개수만 출력 (숫자, json이면 {"count":N}; 통합 검색은 소스별 개수와 합계)`)
+	searchCmd.Flags().BoolVar(&searchSummary, "summary", false, "테이블 아래에 소스별·법령구분별 건수 요약 추가 (json, ndjson, xml은 summary 객체)")
+	searchCmd.Flags().BoolVar(&searchSuggest.retry, "suggest", false, "결과가 없으면 띄어쓰기를 바꾸거나 줄인 검색어로 다시 검색해 비슷한 법령 이름 제안")
+	searchCmd.Flags().BoolVar(&searchStream, "stream", false, "결과를 소스마다 받는 즉시 한 줄씩 ndjson으로 출력 (각 줄에 source 필드, 소스 사이 순서는 보장하지 않음)")
 }
 
 // updateSearchCommand updates search command descriptions
@@ -78,7 +126,7 @@ UnifiedClient를 사용하여 병렬로 검색을 수행하며,
 
 		// Update flag descriptions
 		if flag := searchCmd.Flags().Lookup("format"); flag != nil {
-			flag.Usage = "출력 형식 (table, json, markdown, csv, html, html-simple)"
+			flag.Usage = "출력 형식 (table, json, ndjson, xml, markdown, csv, html, html-simple, fixed, rss, atom)"
 		}
 		if flag := searchCmd.Flags().Lookup("page"); flag != nil {
 			flag.Usage = "페이지 번호"
@@ -86,6 +134,9 @@ UnifiedClient를 사용하여 병렬로 검색을 수행하며,
 		if flag := searchCmd.Flags().Lookup("size"); flag != nil {
 			flag.Usage = "페이지 크기"
 		}
+		if flag := searchCmd.Flags().Lookup("limit"); flag != nil {
+			flag.Usage = "표시할 결과 상한: 정렬한 페이지의 앞에서부터 N건만 표시 (0: 제한 없음; 페이지는 --size로 나눔)"
+		}
 		if flag := searchCmd.Flags().Lookup("source"); flag != nil {
 			flag.Usage = "검색 대상 (all, law, ordinance)"
 		}
@@ -93,7 +144,34 @@ UnifiedClient를 사용하여 병렬로 검색을 수행하며,
 			flag.Usage = "지역 필터 (자치법규용)"
 		}
 		if flag := searchCmd.Flags().Lookup("sort"); flag != nil {
-			flag.Usage = "정렬 순서 (date: 날짜순, name: 이름순)"
+			flag.Usage = "정렬 기준 (relevance: API 반환 순서, name: 법령명, effectDate: 시행일자, promulDate/date: 공포일자)"
+		}
+		if flag := searchCmd.Flags().Lookup("order"); flag != nil {
+			flag.Usage = "정렬 방향 (asc, desc; 기본: name은 asc, 날짜는 desc)"
+		}
+		updateRecordFlagUsages(searchCmd)
+		updatePriorityFlagUsage(searchCmd)
+		updateNotifyFlagUsages(searchCmd)
+		updateFixedFlagUsages(searchCmd)
+		updateQueryMatchFlagUsages(searchCmd)
+		updateFieldFilterFlagUsages(searchCmd)
+		if flag := searchCmd.Flags().Lookup("tree"); flag != nil {
+			flag.Usage = "결과를 법률-시행령-시행규칙 계층 트리로 표시 (법령명과 법령구분으로 추정)"
+		}
+		if flag := searchCmd.Flags().Lookup("json-schema"); flag != nil {
+			flag.Usage = "JSON 출력 스키마 (raw: API 원본 키, canonical: 영문 snake_case 키)"
+		}
+		if flag := searchCmd.Flags().Lookup("count-only"); flag != nil {
+			flag.Usage = `검색 결과 대신 전체 개수만 출력 (숫자, json이면 {"count":N}; 통합 검색은 소스별 개수와 합계)`
+		}
+		if flag := searchCmd.Flags().Lookup("summary"); flag != nil {
+			flag.Usage = "테이블 아래에 소스별·법령구분별 건수 요약 추가 (json, ndjson, xml은 summary 객체)"
+		}
+		if flag := searchCmd.Flags().Lookup("suggest"); flag != nil {
+			flag.Usage = "결과가 없으면 띄어쓰기를 바꾸거나 줄인 검색어로 다시 검색해 비슷한 법령 이름 제안"
+		}
+		if flag := searchCmd.Flags().Lookup("stream"); flag != nil {
+			flag.Usage = "결과를 소스마다 받는 즉시 한 줄씩 ndjson으로 출력 (각 줄에 source 필드, 소스 사이 순서는 보장하지 않음)"
 		}
 	}
 }
@@ -109,6 +187,54 @@ func runSearchCommand(cmd *cobra.Command, args []string) error {
 
 	logger.Debug("Starting unified search for query: %s", query)
 
+	if _, _, err := searchEffect.validate(); err != nil {
+		return err
+	}
+	if err := validatePageFlags(searchPageNo, searchPageSize); err != nil {
+		return err
+	}
+	if err := validateSearchLimit(searchLimit); err != nil {
+		return err
+	}
+	if err := searchRecords.validate(); err != nil {
+		return err
+	}
+	if err := validateTreeOutput(searchTree, searchOutputFormat, searchRecords); err != nil {
+		return err
+	}
+	if err := validateQualityReport(searchQuality, searchOutputFormat, searchRecords, searchTree); err != nil {
+		return err
+	}
+	if err := searchFixed.validate(searchOutputFormat); err != nil {
+		return err
+	}
+	if err := validateCountOnly(searchCountOnly, searchOutputFormat,
+		searchRecords.active() || searchTree || searchQuality || searchEffect.active() || searchMatch.active() || searchFilters.active() || searchLimit > 0); err != nil {
+		return err
+	}
+	if err := validateSummary(searchSummary, searchOutputFormat, searchRecords, searchTree, searchQuality, searchCountOnly); err != nil {
+		return err
+	}
+	if err := searchNotify.validate(); err != nil {
+		return err
+	}
+	if err := validateSearchStream(cmd, searchStream, searchOutputFormat,
+		searchRecords.active() || searchTree || searchQuality || searchCountOnly || searchSummary || searchSuggest.retry || searchLimit > 0 || len(searchNotify.notifiers()) > 0); err != nil {
+		return err
+	}
+	if err := searchMatch.validate(); err != nil {
+		return err
+	}
+	if err := searchFilters.validate(); err != nil {
+		return err
+	}
+	if err := api.ValidateSort(searchSort, searchOrder); err != nil {
+		return err
+	}
+	if err := searchPriority.load(); err != nil {
+		return err
+	}
+
 	// Get verbose flag from root command
 	verbose, _ := cmd.Root().Flags().GetBool("verbose")
 
@@ -131,21 +257,21 @@ func runSearchCommand(cmd *cobra.Command, args []string) error {
 		}
 
 		// Create appropriate API client
-		apiClient, err := api.CreateClient(apiType)
+		apiClient, err := api.CreateClientWithOptions(apiType, searchCacheOption())
 		if err != nil {
 			// Check if it's an API key error
 			var cliErr *cliErrors.CLIError
 			if errors.As(err, &cliErr) && cliErr.Code == cliErrors.ErrCodeNoAPIKey {
 				guide := onboarding.NewGuideWithWriter(cmd.OutOrStdout(), false)
 				guide.ShowAPIKeySetup()
-				return nil
+				return cliErrors.Displayed(cliErrors.Wrap(err, cliErrors.ErrNoAPIKey)) // Shown by the guide
 			}
 
 			// Also check for direct API key error message
-			if strings.Contains(err.Error(), "API 키가 설정되지 않았습니다") {
+			if errors.Is(err, api.ErrNoAPIKey) {
 				guide := onboarding.NewGuideWithWriter(cmd.OutOrStdout(), false)
 				guide.ShowAPIKeySetup()
-				return nil
+				return cliErrors.Displayed(cliErrors.Wrap(err, cliErrors.ErrNoAPIKey)) // Shown by the guide
 			}
 
 			logger.LogError(err, verbose)
@@ -165,19 +291,50 @@ func runSearchCommand(cmd *cobra.Command, args []string) error {
 		logger.Info("지역 필터 적용: %s", searchRegion)
 	}
 
-	// Create search request
+	// The total count comes with any page, so --count-only asks for one result
+	page, size := searchPageNo, searchPageSize
+	if searchCountOnly {
+		page, size = 1, countOnlyPageSize
+	}
+
+	// Create search request; the quotes of a phrase query are not sent
+	apiQuery, _ := api.UnquoteQuery(query)
 	req := &api.UnifiedSearchRequest{
-		Query:    query,
-		PageNo:   searchPageNo,
-		PageSize: searchPageSize,
+		Query:    apiQuery,
+		PageNo:   page,
+		PageSize: size,
 		Region:   searchRegion,
 		Sort:     searchSort,
+		Order:    searchOrder,
+		Priority: searchPriority.priority,
 		Type:     "JSON", // Use JSON for unified search
 	}
+	// The sources are read only as far as the results shown, unless the
+	// results are filtered after the search
+	if !searchEffect.active() && !searchFilters.active() && searchMatch.mode(query) == api.MatchAnyTerm {
+		req.Limit = searchLimit
+	}
+
+	// Search with timeout; both sources of a unified search share the deadline
+	format := searchOutputFormat
+	if searchStream {
+		format = "ndjson"
+	}
+	ctx := startSearch(cmd, query, searchSource, format, page, size)
+	searchCtx, cancel := context.WithTimeout(ctx, api.Timeout())
+	defer cancel()
+
+	if searchStream {
+		if err := streamSearch(searchCtx, client, req, query, cmd.OutOrStdout()); err != nil {
+			logger.LogError(err, verbose)
+			return err
+		}
+		finishSearch(ctx)
+		recordHistory(ctx, cmd)
+		return failOnEmpty(cmd, searchFoundNothing(ctx))
+	}
 
-	// Search
-	ctx := context.Background()
-	response, err := client.Search(ctx, req)
+	response, err := client.Search(searchCtx, req)
 	if err != nil {
 		// Check if it's an API key error
 		var apiKeyErr *api.APIKeyError
@@ -185,54 +342,225 @@ func runSearchCommand(cmd *cobra.Command, args []string) error {
 			// If API returns an API key error, show setup guide
 			guide := onboarding.NewGuideWithWriter(cmd.OutOrStdout(), false)
 			guide.ShowAPIKeySetup()
-			return nil
+			return cliErrors.Displayed(wrapAPIError(err)) // Shown by the guide
 		}
 
 		logger.LogError(err, verbose)
+		if apiErr := wrapAPIError(err); apiErr != err {
+			return apiErr
+		}
 		return fmt.Errorf("검색 실패: %w", err)
 	}
 
 	// Log completion
-	logger.Info("검색 완료: %d개의 결과 (페이지: %d, 크기: %d)", response.TotalCount, searchPageNo, searchPageSize)
+	logger.Info("검색 완료: %d개의 결과 (페이지: %d, 크기: %d)", response.TotalCount, page, size)
+	noteResults(ctx, response)
+
+	// Only the total count is written, without rendering or post-processing the results
+	if searchCountOnly {
+		if err := writeSearchCount(cmd.OutOrStdout(), format, response); err != nil {
+			return err
+		}
+		finishSearch(ctx)
+		recordHistory(ctx, cmd)
+		return failOnEmpty(cmd, searchFoundNothing(ctx))
+	}
+
+	response, err = transformSearchResults(ctx, response)
+	if err != nil {
+		return err
+	}
+	learnVocabulary(ctx, response)
+	response = searchMatch.apply(query, response)
+
+	response, err = searchEffect.apply(response)
+	if err != nil {
+		return err
+	}
+	response, err = searchFilters.apply(response)
+	if err != nil {
+		return err
+	}
+	response = limitSearchResults(response, searchLimit)
+	searchNotify.send(ctx, response)
 
 	// Output results
-	return outputSearchResults(response, query, searchOutputFormat, searchPageNo, searchPageSize, cmd.OutOrStdout())
+	if err := outputSearchResults(ctx, response, cmd.OutOrStdout()); err != nil {
+		return err
+	}
+
+	// Suggest other queries after an empty search
+	searchSuggest.quiet = quietOutput(cmd)
+	if searchSuggest.shouldSuggest(format, response) {
+		searchSuggest.show(ctx, client, "warp search", query, func(query string) *api.UnifiedSearchRequest {
+			suggestReq := *req
+			suggestReq.Query, suggestReq.PageNo, suggestReq.PageSize = query, 1, suggestPageSize
+			return &suggestReq
+		}, cmd.OutOrStdout())
+	}
+
+	// Point to the national laws above an empty ordinance search
+	if shouldShowUpperLawHints(cmd, searchSource, format, response.TotalCount == 0) {
+		if hintClient := upperLawHintClient(); hintClient != nil {
+			showUpperLawHints(ctx, hintClient, query, cmd.OutOrStdout())
+		}
+	}
+
+	finishSearch(ctx)
+
+	recordHistory(ctx, cmd)
+	return failOnEmpty(cmd, searchFoundNothing(ctx))
+}
+
+// validateSearchLimit rejects a negative --limit
+func validateSearchLimit(limit int) error {
+	if limit < 0 {
+		return cliErrors.New(
+			cliErrors.ErrCodeInvalidInput,
+			fmt.Sprintf("--limit 값은 0 이상이어야 합니다: %d", limit),
+			"표시할 결과 수를 지정하거나 0으로 제한을 없애세요",
+		)
+	}
+	return nil
 }
 
-// outputSearchResults outputs search results in the specified format
-func outputSearchResults(response *api.SearchResponse, query, format string, pageNo, pageSize int, writer io.Writer) error {
+// limitSearchResults keeps the first limit results of the page, after
+// sorting and filtering; a limit of 0 keeps them all. The total count and
+// the pages, sized by --size, stay those of the search.
+func limitSearchResults(resp *api.SearchResponse, limit int) *api.SearchResponse {
+	if limit <= 0 || len(resp.Laws) <= limit {
+		return resp
+	}
+	logger.Debug("--limit: 현재 페이지 %d개 중 앞의 %d개만 표시합니다", len(resp.Laws), limit)
+	limited := *resp
+	limited.Laws = resp.Laws[:limit]
+	return &limited
+}
+
+// transformSearchResults runs the registered post-processing transformers on a search response.
+// The transformers receive ctx, so they can read the RequestContext of the search.
+func transformSearchResults(ctx context.Context, resp *api.SearchResponse) (*api.SearchResponse, error) {
+	chain := pipeline.Default()
+	if rc, ok := api.RequestContextFrom(ctx); ok {
+		logger.Debug("[%s] 결과 후처리 (변환기 %d개)", rc.CorrelationID, chain.Len())
+	}
+	transformed, err := chain.Run(ctx, resp)
+	if err != nil {
+		return nil, fmt.Errorf("결과 후처리 실패: %w", err)
+	}
+	return transformed, nil
+}
+
+// outputSearchResults outputs the results of the search run by ctx in its format
+func outputSearchResults(ctx context.Context, response *api.SearchResponse, writer io.Writer) error {
 	if writer == nil {
 		writer = os.Stdout
 	}
 
+	rc, err := searchRequest(ctx)
+	if err != nil {
+		return err
+	}
+	pageNo, pageSize, format := rc.Page, rc.Size, rc.Format
+
+	if searchQuality {
+		return writeQualityReport(writer, format, response.Laws)
+	}
+	// Records are meant for scripts, so they are written without a summary
+	if searchRecords.active() {
+		return searchRecords.write(writer, response.Laws)
+	}
+
+	fixedOpts, err := searchFixed.options(format)
+	if err != nil {
+		return err
+	}
+
+	// Create formatter
+	formatter := output.NewFormatter(format).
+		WithPagination(withPageSize(searchPageCommand(rc.Query), pageSize), pageSize).
+		WithJSONSchema(searchJSONSchema).
+		WithFixed(fixedOpts)
+	if searchTree {
+		fmt.Fprint(writer, formatter.FormatLawTree(response))
+		return nil
+	}
+	if searchSummary {
+		setSummary(response)
+	}
+
+	// json, xml, ndjson, fixed and the feeds are consumed by programs, so they are
+	// written without a summary that would break the document
+	if format == "json" || format == "xml" || format == "ndjson" || format == "fixed" || format == "rss" || format == "atom" {
+		if err := writeSearchOutput(formatter, format, response, writer); err != nil {
+			return fmt.Errorf("출력 형식 생성 실패: %w", err)
+		}
+		return nil
+	}
+
 	// Print summary
-	fmt.Fprintf(writer, "총 %d개의 법령을 찾았습니다.\n\n", response.TotalCount)
+	fmt.Fprintf(writer, "%s\n\n", i18n.Tf("output.summary", response.TotalCount, ""))
 
 	if response.TotalCount == 0 {
-		fmt.Fprintln(writer, "검색 결과가 없습니다.")
+		fmt.Fprint(writer, output.FormatWarnings(response.Warnings))
+		fmt.Fprintln(writer, i18n.T("output.table.noResults"))
 		return nil
 	}
 
-	// Create formatter
-	formatter := output.NewFormatter(format)
-
 	// Format and output
-	formattedOutput, err := formatter.FormatSearchResultToString(response)
-	if err != nil {
+	if err := writeSearchOutput(formatter, format, response, writer); err != nil {
 		return fmt.Errorf("출력 형식 생성 실패: %w", err)
 	}
 
-	fmt.Fprint(writer, formattedOutput)
-
 	// Print pagination info for table format
-	if format == "table" && response.TotalCount > pageSize {
-		totalPages := (response.TotalCount + pageSize - 1) / pageSize
-		fmt.Fprintf(writer, "\n페이지 %d/%d (--page 옵션으로 다른 페이지 조회 가능)\n", pageNo, totalPages)
+	// A unified search can only page through the results it merged
+	if format == "table" && response.PageableCount() > pageSize {
+		totalPages := (response.PageableCount() + pageSize - 1) / pageSize
+		fmt.Fprintf(writer, "\n%s\n", i18n.Tf("output.page", pageNo, totalPages))
 	}
+	writeSummary(writer, format, response)
 
 	return nil
 }
 
+// writeSearchOutput writes the formatted search results. ndjson is streamed line by
+// line; the other formats are rendered in full before writing.
+func writeSearchOutput(formatter *output.Formatter, format string, resp *api.SearchResponse, writer io.Writer) error {
+	if format == "ndjson" {
+		return formatter.WriteNDJSON(writer, resp)
+	}
+	formatted, err := formatter.FormatSearchResultToString(resp)
+	if err != nil {
+		return err
+	}
+	fmt.Fprint(writer, formatted)
+	return nil
+}
+
+// searchPageCommand returns the command that repeats a search, used for page navigation hints
+func searchPageCommand(query string) string {
+	command := fmt.Sprintf("warp search %q", query)
+	if searchSource != "" && searchSource != "all" {
+		command += " --source " + searchSource
+	}
+	if searchRegion != "" {
+		command += fmt.Sprintf(" --region %q", searchRegion)
+	}
+	if searchLimit > 0 {
+		command += fmt.Sprintf(" --limit %d", searchLimit)
+	}
+	return command
+}
+
+// withPageSize adds --size to a page command when the page size is not the
+// default, so that the suggested pages are counted by the same size
+func withPageSize(command string, size int) string {
+	if size <= 0 || size == api.DefaultPageSize {
+		return command
+	}
+	return fmt.Sprintf("%s --size %d", command, size)
+}
+
 func init() {
 	// Search command will be initialized and added in Execute()
 }
\ No newline at end of file
@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// upperLawHintPageSize limits the single lookup made for upper law hints
+	upperLawHintPageSize = 20
+	// upperLawHintPerTier is the maximum number of laws shown for each tier
+	upperLawHintPerTier = 3
+)
+
+var (
+	// testSearchHintClient allows injecting a mock client for upper law hints in tests
+	testSearchHintClient api.ClientInterface
+)
+
+// upperLawTiers lists the tiers of the national law hierarchy above
+// ordinances, from the highest down
+var upperLawTiers = []string{"법률", "시행령", "시행규칙"}
+
+// upperLawTier returns the hierarchy tier of a national law, or "" if it
+// does not belong to one of upperLawTiers
+func upperLawTier(law api.LawInfo) string {
+	switch law.LawType {
+	case "법률":
+		return "법률"
+	case "대통령령":
+		return "시행령"
+	case "총리령", "부령":
+		return "시행규칙"
+	}
+
+	// Fall back to the name when the law type is missing
+	switch {
+	case strings.HasSuffix(law.Name, "시행규칙"):
+		return "시행규칙"
+	case strings.HasSuffix(law.Name, "시행령"):
+		return "시행령"
+	}
+	return ""
+}
+
+// groupUpperLaws groups laws by hierarchy tier, keeping at most
+// upperLawHintPerTier laws per tier
+func groupUpperLaws(laws []api.LawInfo) map[string][]api.LawInfo {
+	groups := make(map[string][]api.LawInfo)
+	for _, law := range laws {
+		tier := upperLawTier(law)
+		if tier == "" || len(groups[tier]) >= upperLawHintPerTier {
+			continue
+		}
+		groups[tier] = append(groups[tier], law)
+	}
+	return groups
+}
+
+// shouldShowUpperLawHints reports whether upper law hints apply to a search of
+// warp search or warp ordinance. Hints are only shown for human-readable
+// output of an empty ordinance search.
+func shouldShowUpperLawHints(cmd *cobra.Command, source, format string, empty bool) bool {
+	if !empty || source != "ordinance" || format != "table" {
+		return false
+	}
+	return !quietOutput(cmd)
+}
+
+// showUpperLawHints looks up national laws related to query with a single
+// one-page request and prints them as the upper law hierarchy. Lookup failures
+// are ignored because the hint is optional.
+func showUpperLawHints(ctx context.Context, client api.ClientInterface, query string, writer io.Writer) {
+	ctx, cancel := context.WithTimeout(ctx, api.Timeout())
+	defer cancel()
+
+	resp, err := client.Search(ctx, &api.UnifiedSearchRequest{
+		Query:    query,
+		PageNo:   1,
+		PageSize: upperLawHintPageSize,
+		Type:     "JSON",
+	})
+	if err != nil || resp == nil {
+		return
+	}
+
+	groups := groupUpperLaws(resp.Laws)
+	if len(groups) == 0 {
+		return
+	}
+
+	fmt.Fprintln(writer, "\n💡 관련 상위 법령 (법률 → 시행령 → 시행규칙 → 자치법규):")
+	var first string
+	for _, tier := range upperLawTiers {
+		for _, law := range groups[tier] {
+			fmt.Fprintf(writer, "  [%s] %s\n", tier, law.Name)
+			if first == "" {
+				first = law.Name
+			}
+		}
+	}
+	fmt.Fprintf(writer, "\n상위 법령에서 위임 근거를 확인해 보세요: warp law \"%s\"\n", first)
+}
+
+// upperLawHintClient returns the client used for upper law hints, or nil if none is available
+func upperLawHintClient() api.ClientInterface {
+	if testSearchHintClient != nil {
+		return testSearchHintClient
+	}
+	client, err := api.CreateClient(api.APITypeNLIC)
+	if err != nil {
+		return nil
+	}
+	return client
+}
@@ -0,0 +1,187 @@
+package cmd
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/i18n"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/testutil"
+	"github.com/spf13/cobra"
+)
+
+func TestUpperLawTier(t *testing.T) {
+	tests := []struct {
+		law  api.LawInfo
+		want string
+	}{
+		{api.LawInfo{Name: "주차장법", LawType: "법률"}, "법률"},
+		{api.LawInfo{Name: "주차장법 시행령", LawType: "대통령령"}, "시행령"},
+		{api.LawInfo{Name: "주차장법 시행규칙", LawType: "부령"}, "시행규칙"},
+		{api.LawInfo{Name: "개인정보 보호법 시행규칙", LawType: "총리령"}, "시행규칙"},
+		{api.LawInfo{Name: "주차장법 시행령"}, "시행령"},
+		{api.LawInfo{Name: "대한민국헌법", LawType: "헌법"}, ""},
+		{api.LawInfo{Name: "주차장 고시", LawType: "고시"}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.law.Name, func(t *testing.T) {
+			if got := upperLawTier(tt.law); got != tt.want {
+				t.Errorf("upperLawTier(%+v) = %q, want %q", tt.law, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGroupUpperLaws_LimitPerTier(t *testing.T) {
+	var laws []api.LawInfo
+	for i := 0; i < upperLawHintPerTier+2; i++ {
+		laws = append(laws, api.LawInfo{Name: "법", LawType: "법률"})
+	}
+	laws = append(laws, api.LawInfo{Name: "령", LawType: "대통령령"})
+
+	groups := groupUpperLaws(laws)
+	if len(groups["법률"]) != upperLawHintPerTier {
+		t.Errorf("법률 tier has %d laws, want %d", len(groups["법률"]), upperLawHintPerTier)
+	}
+	if len(groups["시행령"]) != 1 {
+		t.Errorf("시행령 tier has %d laws, want 1", len(groups["시행령"]))
+	}
+}
+
+func TestSearchUpperLawHints(t *testing.T) {
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+
+	testSearchClient = &MockOrdinanceClient{
+		SearchFunc: func(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+			return &api.SearchResponse{TotalCount: 0}, nil
+		},
+	}
+	defer func() { testSearchClient = nil }()
+
+	var hintRequests []*api.UnifiedSearchRequest
+	testSearchHintClient = &MockOrdinanceClient{
+		SearchFunc: func(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+			hintRequests = append(hintRequests, req)
+			return &api.SearchResponse{TotalCount: 4, Laws: []api.LawInfo{
+				{Name: "주차장법 시행규칙", LawType: "부령"},
+				{Name: "주차장법", LawType: "법률"},
+				{Name: "주차장법 시행령", LawType: "대통령령"},
+				{Name: "주차장 관련 고시", LawType: "고시"},
+			}}, nil
+		},
+	}
+	defer func() { testSearchHintClient = nil }()
+
+	tests := []struct {
+		name     string
+		args     []string
+		wantHint bool
+	}{
+		{"Empty ordinance search shows hints", []string{"search", "주차장", "--source", "ordinance"}, true},
+		{"JSON output suppresses hints", []string{"search", "주차장", "--source", "ordinance", "--format", "json"}, false},
+		{"Quiet suppresses hints", []string{"search", "주차장", "--source", "ordinance", "--quiet"}, false},
+		{"Law source has no upper laws", []string{"search", "주차장", "--source", "law"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hintRequests = nil
+			initSearchCmd()
+			root := &cobra.Command{Use: "test"}
+			root.PersistentFlags().Bool("quiet", false, "")
+			root.AddCommand(searchCmd)
+
+			output, err := testutil.ExecuteCommand(t, root, tt.args)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !tt.wantHint {
+				if len(hintRequests) != 0 {
+					t.Errorf("expected no hint lookup, got %d", len(hintRequests))
+				}
+				if strings.Contains(output, "관련 상위 법령") {
+					t.Errorf("hint should be suppressed, got %q", output)
+				}
+				return
+			}
+
+			if len(hintRequests) != 1 {
+				t.Fatalf("expected exactly 1 hint lookup, got %d", len(hintRequests))
+			}
+			if req := hintRequests[0]; req.PageNo != 1 || req.PageSize != upperLawHintPageSize || req.Query != "주차장" {
+				t.Errorf("hint lookup = %+v, want page 1 of size %d for 주차장", req, upperLawHintPageSize)
+			}
+
+			// Tiers are listed from the top of the hierarchy down
+			lawIdx := strings.Index(output, "[법률] 주차장법")
+			decreeIdx := strings.Index(output, "[시행령] 주차장법 시행령")
+			ruleIdx := strings.Index(output, "[시행규칙] 주차장법 시행규칙")
+			if lawIdx < 0 || decreeIdx < lawIdx || ruleIdx < decreeIdx {
+				t.Errorf("hint tiers out of order or missing: %q", output)
+			}
+			if strings.Contains(output, "고시") {
+				t.Errorf("non-hierarchy laws should be omitted: %q", output)
+			}
+		})
+	}
+}
+
+func TestOrdinanceUpperLawHints(t *testing.T) {
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+
+	testOrdinanceClient = &MockOrdinanceClient{
+		SearchFunc: func(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+			return &api.SearchResponse{TotalCount: 0}, nil
+		},
+	}
+	defer func() { testOrdinanceClient = nil }()
+
+	var hints int
+	testSearchHintClient = &MockOrdinanceClient{
+		SearchFunc: func(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+			hints++
+			if _, ok := ctx.Deadline(); !ok {
+				t.Error("hint lookup should have a deadline")
+			}
+			return &api.SearchResponse{TotalCount: 1, Laws: []api.LawInfo{{Name: "주차장법", LawType: "법률"}}}, nil
+		},
+	}
+	defer func() { testSearchHintClient = nil }()
+
+	tests := []struct {
+		name     string
+		args     []string
+		wantHint bool
+	}{
+		{"Empty search shows hints", []string{"ordinance", "주차장"}, true},
+		{"JSON output suppresses hints", []string{"ordinance", "주차장", "--format", "json"}, false},
+		{"Quiet suppresses hints", []string{"ordinance", "주차장", "--quiet"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hints = 0
+			initOrdinanceCmd()
+			root := &cobra.Command{Use: "test"}
+			root.PersistentFlags().Bool("quiet", false, "")
+			root.AddCommand(ordinanceCmd)
+
+			output, err := testutil.ExecuteCommand(t, root, tt.args)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			shown := strings.Contains(output, "[법률] 주차장법")
+			if tt.wantHint != shown || tt.wantHint != (hints == 1) {
+				t.Errorf("hint shown = %v after %d lookups, want %v: %q", shown, hints, tt.wantHint, output)
+			}
+		})
+	}
+}
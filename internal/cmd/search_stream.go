@@ -0,0 +1,153 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	cliErrors "github.com/pyhub-apps/pyhub-warp-cli/internal/errors"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/logger"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// sourceSearcher is a client that sends the results of each source as they
+// arrive (api.UnifiedClient)
+type sourceSearcher interface {
+	SearchEachSource(ctx context.Context, req *api.UnifiedSearchRequest) <-chan api.SourceResults
+}
+
+// validateSearchStream rejects --stream with a format other than ndjson, with
+// --clipboard, which holds the output back, and with the options that need
+// all the results of the search at once
+func validateSearchStream(cmd *cobra.Command, stream bool, format string, wholeResults bool) error {
+	if !stream {
+		return nil
+	}
+	if cmd.Flags().Changed("format") && format != "ndjson" {
+		return cliErrors.New(
+			cliErrors.ErrCodeInvalidInput,
+			fmt.Sprintf("--stream은 ndjson으로만 출력합니다: --format %s", format),
+			"--format을 빼거나 --format ndjson을 지정하세요",
+		)
+	}
+	if clipboard, _ := cmd.Root().PersistentFlags().GetBool("clipboard"); clipboard || wholeResults {
+		return cliErrors.New(
+			cliErrors.ErrCodeInvalidInput,
+			"--stream은 결과를 받는 대로 출력하므로 전체 결과가 필요한 옵션과 함께 사용할 수 없습니다",
+			"--count-only, --summary, --tree, --quality-report, --limit, --pluck, --ids-only, --suggest, --notify-slack, --notify-discord, --clipboard 없이 실행하세요",
+		)
+	}
+	return nil
+}
+
+// searchCacheOption returns the cache option of the search client. The cache
+// keeps merged pages, so a stream asks the sources themselves unless offline.
+func searchCacheOption() api.ClientOption {
+	if searchStream && !offlineMode {
+		return api.WithCache(nil)
+	}
+	return cacheOption()
+}
+
+// streamSearch writes the results of req for --stream: one ndjson line per
+// law, flushed as soon as its source answers, with the source it came from.
+// A unified search writes each source as it arrives, in no particular order;
+// a failed source gets an error line, and the search fails only when every
+// source failed. The results of a source are filtered like a page of the
+// search.
+func streamSearch(ctx context.Context, client api.ClientInterface, req *api.UnifiedSearchRequest, query string, w io.Writer) error {
+	stream, err := output.NewFormatter("ndjson").WithJSONSchema(searchJSONSchema).NewSourceStream(w)
+	if err != nil {
+		return err
+	}
+
+	searcher, ok := client.(sourceSearcher)
+	if !ok {
+		resp, err := client.Search(ctx, req)
+		if err != nil {
+			return streamSearchError(err)
+		}
+		if resp, err = filterStreamedResults(ctx, query, resp); err != nil {
+			return err
+		}
+		noteResults(ctx, resp)
+		for _, law := range resp.Laws {
+			if err := stream.WriteLaws(streamSource(law), []api.LawInfo{law}); err != nil {
+				return err
+			}
+		}
+		logger.Info("스트리밍 완료: %d개 출력", stream.Count())
+		return nil
+	}
+
+	total, sources := 0, 0
+	var errs []error
+	for result := range searcher.SearchEachSource(ctx, req) {
+		sources++
+		resp, err := result.Response, result.Err
+		if err == nil {
+			resp, err = filterStreamedResults(ctx, query, resp)
+		}
+		if err != nil {
+			logger.Warn("%s 검색 실패: %v", result.Source, err)
+			errs = append(errs, fmt.Errorf("%s: %w", result.Source, err))
+			if err := stream.WriteError(result.Source, err); err != nil {
+				return err
+			}
+			continue
+		}
+
+		logger.Debug("%s returned %d results", result.Source, len(resp.Laws))
+		total += resp.TotalCount
+		if err := stream.WriteLaws(result.Source, resp.Laws); err != nil {
+			return err
+		}
+	}
+	if len(errs) == sources {
+		return streamSearchError(fmt.Errorf("모든 API 검색 실패: %w", errors.Join(errs...)))
+	}
+
+	noteResults(ctx, &api.SearchResponse{TotalCount: total})
+	logger.Info("스트리밍 완료: %d개 출력", stream.Count())
+	return nil
+}
+
+// filterStreamedResults post-processes and filters the results of a source
+// as the results of a page are
+func filterStreamedResults(ctx context.Context, query string, resp *api.SearchResponse) (*api.SearchResponse, error) {
+	resp, err := transformSearchResults(ctx, resp)
+	if err != nil {
+		return nil, err
+	}
+	resp = searchMatch.apply(query, resp)
+	if resp, err = searchEffect.apply(resp); err != nil {
+		return nil, err
+	}
+	return searchFilters.apply(resp)
+}
+
+// streamSource returns the source code of a law of a search that is not
+// streamed by source, from its label or else the --source of the search
+func streamSource(law api.LawInfo) string {
+	switch {
+	case law.Source == "자치법규":
+		return "ELIS"
+	case law.Source == "국가법령":
+		return "NLIC"
+	case searchSource == "ordinance":
+		return "ELIS"
+	default:
+		return "NLIC"
+	}
+}
+
+// streamSearchError returns the error of a streamed search that found nothing
+func streamSearchError(err error) error {
+	if apiErr := wrapAPIError(err); apiErr != err {
+		return apiErr
+	}
+	return fmt.Errorf("검색 실패: %w", err)
+}
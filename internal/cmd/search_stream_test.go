@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	cliErrors "github.com/pyhub-apps/pyhub-warp-cli/internal/errors"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/i18n"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/testutil"
+	"github.com/spf13/cobra"
+)
+
+// streamLine is a line of --stream output
+type streamLine struct {
+	Source string                 `json:"source"`
+	Law    map[string]interface{} `json:"law"`
+	Error  string                 `json:"error"`
+}
+
+// runSearchStream runs the search command with args on a bare root
+func runSearchStream(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+	initSearchCmd()
+	root := &cobra.Command{Use: "test"}
+	root.PersistentFlags().Bool("clipboard", false, "")
+	root.AddCommand(searchCmd)
+	return testutil.ExecuteCommand(t, root, append([]string{"search"}, args...))
+}
+
+func TestSearchStream(t *testing.T) {
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+
+	server := testutil.NewAPIServer(t)
+	server.Handle(testutil.TargetLaw, testutil.HTMLError("일시적인 오류"))
+	server.Handle(testutil.TargetOrdinance, testutil.Results(2,
+		testutil.Item{"자치법규ID": "E1", "자치법규명": "서울특별시 주차장 조례", "공포일자": "20230101"},
+		testutil.Item{"자치법규ID": "E2", "자치법규명": "가평군 주차장 조례", "공포일자": "20240101"},
+	))
+	client, err := api.CreateClientWithOptions(api.APITypeAll, api.WithAPIKey("test-key"), api.WithBaseURL(server.URL))
+	if err != nil {
+		t.Fatalf("CreateClientWithOptions() error = %v", err)
+	}
+	testSearchClient = client
+	defer func() { testSearchClient = nil }()
+
+	output, err := runSearchStream(t, "주차", "--stream")
+	if err != nil {
+		t.Fatalf("a failed source should not fail the stream: %v", err)
+	}
+
+	// Every line is a JSON object naming its source; the failed source gets an error line
+	laws := map[string]int{}
+	errs := map[string]int{}
+	for i, line := range strings.Split(strings.TrimRight(output, "\n"), "\n") {
+		var decoded streamLine
+		if err := json.Unmarshal([]byte(line), &decoded); err != nil {
+			t.Fatalf("line %d is not valid JSON: %v: %s", i+1, err, line)
+		}
+		switch {
+		case decoded.Law != nil:
+			laws[decoded.Source]++
+		case decoded.Error != "":
+			errs[decoded.Source]++
+		default:
+			t.Errorf("line %d has neither a law nor an error: %s", i+1, line)
+		}
+	}
+	if laws["ELIS"] != 2 || laws["NLIC"] != 0 || errs["NLIC"] != 1 || errs["ELIS"] != 0 {
+		t.Errorf("laws = %v, errors = %v, want 2 ELIS laws and 1 NLIC error:\n%s", laws, errs, output)
+	}
+}
+
+func TestSearchStreamSingleSource(t *testing.T) {
+	testSearchClient = &MockOrdinanceClient{}
+	defer func() { testSearchClient = nil }()
+
+	output, err := runSearchStream(t, "주차", "--stream", "--source", "ordinance", "--json-schema", "canonical")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	lines := strings.Split(strings.TrimRight(output, "\n"), "\n")
+	for i, line := range lines {
+		var decoded streamLine
+		if err := json.Unmarshal([]byte(line), &decoded); err != nil {
+			t.Fatalf("line %d is not valid JSON: %v: %s", i+1, err, line)
+		}
+		if decoded.Source != "ELIS" || decoded.Law["law_id"] == nil {
+			t.Errorf("line %d = %s, want a canonical ELIS law", i+1, line)
+		}
+	}
+}
+
+func TestSearchStreamRejectsWholeResultOptions(t *testing.T) {
+	testSearchClient = &MockOrdinanceClient{}
+	defer func() { testSearchClient = nil }()
+
+	for _, args := range [][]string{
+		{"--format", "json"},
+		{"--count-only"},
+		{"--summary"},
+		{"--limit", "5"},
+		{"--clipboard"},
+	} {
+		_, err := runSearchStream(t, append([]string{"주차", "--stream"}, args...)...)
+		if exitCode(err) != cliErrors.ExitInvalidInput {
+			t.Errorf("--stream %v: error = %v, want invalid input", args, err)
+		}
+	}
+
+	if _, err := runSearchStream(t, "주차", "--stream", "--format", "ndjson"); err != nil {
+		t.Errorf("--stream --format ndjson: unexpected error %v", err)
+	}
+}
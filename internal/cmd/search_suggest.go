@@ -0,0 +1,159 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/vocab"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// suggestMaxQueries limits the alternative queries searched with --suggest
+	suggestMaxQueries = 3
+	// suggestPageSize is the page size of each alternative search
+	suggestPageSize = 20
+	// suggestMaxNames is the maximum number of law names suggested
+	suggestMaxNames = 5
+	// suggestMinTokenLength is the length in characters below which a token
+	// is too short to be searched on its own
+	suggestMinTokenLength = 2
+)
+
+// suggestOptions holds the suggestion settings of a search command
+type suggestOptions struct {
+	// retry searches the alternative queries of an empty search (--suggest)
+	retry bool
+	// quiet suppresses the hints, as with --quiet
+	quiet bool
+}
+
+// alternativeQueries returns the queries worth trying when query finds
+// nothing: the query without its spaces, then its tokens from the longest.
+// A query of a single word is tried without its last character instead, which
+// drops a mistyped ending or a suffix such as "법".
+func alternativeQueries(query string) []string {
+	tokens := strings.Fields(query)
+	seen := map[string]bool{strings.Join(tokens, " "): true}
+	var queries []string
+	add := func(q string) {
+		if utf8.RuneCountInString(q) >= suggestMinTokenLength && !seen[q] {
+			seen[q] = true
+			queries = append(queries, q)
+		}
+	}
+
+	if len(tokens) == 1 {
+		runes := []rune(tokens[0])
+		add(string(runes[:len(runes)-1]))
+		return queries
+	}
+
+	add(strings.Join(tokens, ""))
+	sorted := append([]string(nil), tokens...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return utf8.RuneCountInString(sorted[i]) > utf8.RuneCountInString(sorted[j])
+	})
+	for _, token := range sorted {
+		add(token)
+	}
+	return queries
+}
+
+// similarLawNames returns up to suggestMaxNames of names ordered by their
+// edit distance to query, spaces ignored, without duplicates
+func similarLawNames(query string, names []string) []string {
+	key := strings.Join(strings.Fields(query), "")
+	type match struct {
+		name     string
+		distance int
+	}
+	var matches []match
+	seen := make(map[string]bool)
+	for _, name := range names {
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		matches = append(matches, match{name, vocab.EditDistance(key, strings.Join(strings.Fields(name), ""))})
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].distance < matches[j].distance
+	})
+	if len(matches) > suggestMaxNames {
+		matches = matches[:suggestMaxNames]
+	}
+	result := make([]string, len(matches))
+	for i, m := range matches {
+		result[i] = m.name
+	}
+	return result
+}
+
+// quietOutput reports whether hints are suppressed with --quiet
+func quietOutput(cmd *cobra.Command) bool {
+	quiet, _ := cmd.Root().PersistentFlags().GetBool("quiet")
+	return quiet
+}
+
+// shouldSuggest reports whether suggestions apply to a search. They are only
+// shown for human-readable output of an empty search.
+func (s suggestOptions) shouldSuggest(format string, response *api.SearchResponse) bool {
+	return response.TotalCount == 0 && format == "table" && !s.quiet
+}
+
+// show prints what to try after query found nothing with command. With
+// --suggest the alternative queries are searched, one page each, and the
+// names of the laws found are suggested; failed searches are ignored because
+// the suggestion is optional.
+func (s suggestOptions) show(ctx context.Context, client APIClient, command, query string,
+	request func(query string) *api.UnifiedSearchRequest, w io.Writer) {
+	queries := alternativeQueries(query)
+
+	fmt.Fprintln(w, "\n💡 검색 결과가 없습니다. 다음을 시도해 보세요:")
+	if strings.Contains(strings.TrimSpace(query), " ") {
+		fmt.Fprintf(w, "  띄어쓰기를 바꿔보세요: %s %q\n", command, strings.Join(strings.Fields(query), ""))
+	} else {
+		fmt.Fprintln(w, "  띄어쓰기를 바꿔보세요: 단어 사이에 공백을 넣어 검색해 보세요")
+	}
+	if len(queries) > 0 {
+		tries := make([]string, 0, len(queries))
+		for _, q := range queries {
+			tries = append(tries, fmt.Sprintf("%s %q", command, q))
+		}
+		fmt.Fprintf(w, "  검색어를 줄여 보세요: %s\n", strings.Join(tries, ", "))
+	}
+	if !s.retry {
+		fmt.Fprintln(w, "  --suggest 옵션을 주면 비슷한 법령 이름을 찾아 드립니다")
+		return
+	}
+
+	if len(queries) > suggestMaxQueries {
+		queries = queries[:suggestMaxQueries]
+	}
+	var names []string
+	for _, q := range queries {
+		searchCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		resp, err := client.Search(searchCtx, request(q))
+		cancel()
+		if err != nil || resp == nil {
+			continue
+		}
+		for _, law := range resp.Laws {
+			names = append(names, law.Name)
+		}
+	}
+
+	if suggestions := similarLawNames(query, names); len(suggestions) > 0 {
+		fmt.Fprintf(w, "\n이 중 하나를 찾으셨나요: %s\n", strings.Join(suggestions, ", "))
+	} else {
+		fmt.Fprintln(w, "\n비슷한 법령 이름을 찾지 못했습니다.")
+	}
+}
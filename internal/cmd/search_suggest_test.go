@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/i18n"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/testutil"
+	"github.com/spf13/cobra"
+)
+
+func TestAlternativeQueries(t *testing.T) {
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{"개인 정보 보호법", []string{"개인정보보호법", "보호법", "개인", "정보"}},
+		{"  주차장  조례 ", []string{"주차장조례", "주차장", "조례"}},
+		{"도로 법", []string{"도로법", "도로"}},
+		{"주차장벚", []string{"주차장"}},
+		{"법", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			if got := alternativeQueries(tt.query); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("alternativeQueries(%q) = %q, want %q", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSimilarLawNames(t *testing.T) {
+	names := []string{"주차장법 시행령", "도로교통법", "주차장법", "주차장법", "", "주택법"}
+
+	got := similarLawNames("주차장벚", names)
+	want := []string{"주차장법", "주택법", "주차장법 시행령", "도로교통법"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("similarLawNames() = %q, want %q", got, want)
+	}
+
+	var many []string
+	for i := 0; i < suggestMaxNames+3; i++ {
+		many = append(many, strings.Repeat("법", i+1))
+	}
+	if got := similarLawNames("법", many); len(got) != suggestMaxNames {
+		t.Errorf("similarLawNames() returned %d names, want %d", len(got), suggestMaxNames)
+	}
+}
+
+func TestSearchSuggestions(t *testing.T) {
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+
+	var queries []string
+	testAPIClient = &mockAPIClient{searchFunc: func(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+		queries = append(queries, req.Query)
+		if req.Query == "주차장법" {
+			return &api.SearchResponse{TotalCount: 2, Laws: []api.LawInfo{
+				{ID: "001", Name: "주차장법 시행령"},
+				{ID: "002", Name: "주차장법"},
+			}}, nil
+		}
+		return &api.SearchResponse{}, nil
+	}}
+	defer func() { testAPIClient = nil }()
+
+	run := func(t *testing.T, args ...string) string {
+		t.Helper()
+		queries = nil
+		initLawCmd()
+		root := &cobra.Command{Use: "test"}
+		root.PersistentFlags().Bool("no-history", true, "")
+		root.AddCommand(lawCmd)
+		output, err := testutil.ExecuteCommand(t, root, args)
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		return output
+	}
+
+	t.Run("hints without searching again", func(t *testing.T) {
+		output := run(t, "law", "search", "주차장 법")
+		for _, want := range []string{`띄어쓰기를 바꿔보세요: warp law "주차장법"`, `warp law "주차장"`, "--suggest"} {
+			if !strings.Contains(output, want) {
+				t.Errorf("output missing %q:\n%s", want, output)
+			}
+		}
+		if len(queries) != 1 {
+			t.Errorf("searched %q, want only the query", queries)
+		}
+	})
+
+	t.Run("suggest searches the alternatives", func(t *testing.T) {
+		output := run(t, "law", "search", "주차장 법", "--suggest")
+		if !strings.Contains(output, "이 중 하나를 찾으셨나요: 주차장법, 주차장법 시행령") {
+			t.Errorf("output missing suggestions:\n%s", output)
+		}
+		if want := []string{"주차장 법", "주차장법", "주차장"}; !reflect.DeepEqual(queries, want) {
+			t.Errorf("searched %q, want %q", queries, want)
+		}
+	})
+
+	t.Run("no hints for json", func(t *testing.T) {
+		output := run(t, "law", "search", "주차장 법", "--suggest", "--format", "json")
+		if strings.Contains(output, "💡") || len(queries) != 1 {
+			t.Errorf("json output has suggestions (searched %q):\n%s", queries, output)
+		}
+	})
+}
@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/i18n"
+)
+
+func TestSearchMachineFormatsWithoutSummary(t *testing.T) {
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+
+	total := 1
+	testSearchClient = &MockOrdinanceClient{
+		SearchFunc: func(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+			resp := &api.SearchResponse{TotalCount: total}
+			if total > 0 {
+				resp.Laws = []api.LawInfo{{ID: "011357", Name: "개인정보 보호법", Source: "국가법령"}}
+			}
+			return resp, nil
+		},
+	}
+	defer func() { testSearchClient = nil }()
+
+	decoders := map[string]func([]byte) error{
+		"json": func(data []byte) error {
+			var v interface{}
+			return json.Unmarshal(data, &v)
+		},
+		"xml": func(data []byte) error {
+			var v struct{}
+			return xml.Unmarshal(data, &v)
+		},
+	}
+	for _, format := range []string{"json", "xml"} {
+		for _, total = range []int{1, 0} {
+			output, err := runSearchStream(t, "개인정보", "-f", format)
+			if err != nil {
+				t.Fatalf("%s: %v", format, err)
+			}
+			if strings.Contains(output, "총 ") {
+				t.Errorf("%s (%d results) should not have a summary line, got:\n%s", format, total, output)
+			}
+			if err := decoders[format]([]byte(output)); err != nil {
+				t.Errorf("%s (%d results) is not a well-formed document: %v\n%s", format, total, err, output)
+			}
+		}
+	}
+}
@@ -0,0 +1,351 @@
+package cmd
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/logger"
+	outputPkg "github.com/pyhub-apps/pyhub-warp-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// serveShutdownTimeout is how long requests in flight may take to finish on shutdown
+const serveShutdownTimeout = 10 * time.Second
+
+// serveOptions holds the flags of the serve command
+type serveOptions struct {
+	host  string
+	port  int
+	token string
+	rate  float64
+}
+
+var (
+	serveCmd *cobra.Command
+	serveOpt serveOptions
+
+	// testServeClient allows injecting a mock client for testing
+	testServeClient APIClient
+)
+
+// initServeCmd initializes the serve command
+func initServeCmd() {
+	serveCmd = &cobra.Command{
+		Use:   "serve",
+		Short: "HTTP로 법령 검색을 제공하는 로컬 서버 실행",
+		Long: `다른 도구에서 HTTP로 법령을 검색할 수 있도록 로컬 검색 서버를 실행합니다.
+
+GET /search?q=검색어&source=nlic&page=1&size=50
+  'warp law' 검색과 같은 결과를 JSON으로 반환합니다.
+  source는 nlic(기본), elis, all 중 하나입니다.
+GET /health
+  서버 상태를 확인합니다 (인증 불필요).
+
+/search 요청에는 'Authorization: Bearer <토큰>' 헤더가 필요합니다.
+--token을 지정하지 않으면 시작할 때 임의의 토큰을 만들어 출력합니다.
+API 호출은 --rate로 제한하며, 검색 캐시(cache.ttl)가 켜져 있으면 캐시를 함께 사용합니다.
+Ctrl+C(SIGINT)로 종료하면 처리 중인 요청을 마친 뒤 종료합니다.`,
+		Example: `  # 8080 포트로 서버 실행
+  warp serve --port 8080
+
+  # 고정 토큰으로 실행하고 검색
+  warp serve --port 8080 --token my-secret
+  curl -H "Authorization: Bearer my-secret" "http://127.0.0.1:8080/search?q=개인정보"`,
+		Args: cobra.NoArgs,
+		RunE: runServeCommand,
+	}
+
+	serveCmd.Flags().StringVar(&serveOpt.host, "host", "127.0.0.1", "서버 주소 (다른 컴퓨터에서 접속하려면 0.0.0.0)")
+	serveCmd.Flags().IntVar(&serveOpt.port, "port", 8080, "서버 포트")
+	serveCmd.Flags().StringVar(&serveOpt.token, "token", "", "인증 토큰 (기본: 시작할 때 임의로 생성)")
+	serveCmd.Flags().Float64Var(&serveOpt.rate, "rate", 5, "초당 최대 API 요청 수 (0: 제한 없음)")
+}
+
+// updateServeCommand updates serve command descriptions
+func updateServeCommand() {
+	if serveCmd != nil {
+		serveCmd.Short = "HTTP로 법령 검색을 제공하는 로컬 서버 실행"
+	}
+}
+
+// validate checks the serve flags
+func (o *serveOptions) validate() error {
+	if o.port < 0 || o.port > 65535 {
+		return fmt.Errorf("--port는 0에서 65535 사이여야 합니다")
+	}
+	if o.rate < 0 {
+		return fmt.Errorf("--rate는 0 이상이어야 합니다")
+	}
+	return nil
+}
+
+func runServeCommand(cmd *cobra.Command, args []string) error {
+	if err := serveOpt.validate(); err != nil {
+		return err
+	}
+
+	token := serveOpt.token
+	if token == "" {
+		generated, err := newServeToken()
+		if err != nil {
+			return err
+		}
+		token = generated
+	}
+
+	listener, err := net.Listen("tcp", net.JoinHostPort(serveOpt.host, strconv.Itoa(serveOpt.port)))
+	if err != nil {
+		return fmt.Errorf("서버를 시작할 수 없습니다: %w", err)
+	}
+
+	clientFor := serveClients()
+	if testServeClient != nil {
+		clientFor = func(string) (APIClient, error) { return testServeClient, nil }
+	}
+	handler := newSearchServer(token, serveOpt.rate, clientFor)
+
+	out := cmd.OutOrStdout()
+	fmt.Fprintf(out, "검색 서버 시작: http://%s\n", listener.Addr())
+	if serveOpt.token == "" {
+		fmt.Fprintf(out, "인증 토큰: %s\n", token)
+	}
+	fmt.Fprintln(out, "종료하려면 Ctrl+C를 누르세요")
+
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if err := serve(ctx, listener, handler); err != nil {
+		return err
+	}
+	fmt.Fprintln(out, "검색 서버를 종료했습니다")
+	return nil
+}
+
+// serve serves handler on listener until ctx is done, then shuts down
+// gracefully, letting requests in flight finish
+func serve(ctx context.Context, listener net.Listener, handler http.Handler) error {
+	server := &http.Server{
+		Handler:           handler,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- server.Serve(listener)
+	}()
+
+	select {
+	case err := <-errCh:
+		return fmt.Errorf("검색 서버 오류: %w", err)
+	case <-ctx.Done():
+	}
+
+	logger.Info("검색 서버 종료 중...")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), serveShutdownTimeout)
+	defer cancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("검색 서버 종료 실패: %w", err)
+	}
+	if err := <-errCh; !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("검색 서버 오류: %w", err)
+	}
+	return nil
+}
+
+// newServeToken returns a random token for a server started without --token
+func newServeToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("인증 토큰 생성 실패: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// serveClients returns a function that creates the API client of a source on
+// first use, with the search cache, and reuses it for later requests
+func serveClients() func(source string) (APIClient, error) {
+	var mu sync.Mutex
+	clients := make(map[string]APIClient)
+	return func(source string) (APIClient, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if client, ok := clients[source]; ok {
+			return client, nil
+		}
+		client, err := api.CreateClientWithOptions(lawAPIType(source), cacheOption())
+		if err != nil {
+			return nil, err
+		}
+		clients[source] = client
+		return client, nil
+	}
+}
+
+// searchServer answers law searches over HTTP
+type searchServer struct {
+	token     string
+	limiter   *api.IntervalLimiter
+	clientFor func(source string) (APIClient, error)
+}
+
+// newSearchServer returns the handler of warp serve. Searches need token as
+// bearer token, and their API calls start at most rate times per second.
+func newSearchServer(token string, rate float64, clientFor func(source string) (APIClient, error)) http.Handler {
+	s := &searchServer{
+		token:     token,
+		limiter:   api.NewIntervalLimiter(rateInterval(rate)),
+		clientFor: clientFor,
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/search", s.handleSearch)
+	mux.HandleFunc("/health", s.handleHealth)
+	return mux
+}
+
+func (s *searchServer) handleHealth(w http.ResponseWriter, r *http.Request) {
+	writeServeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+func (s *searchServer) handleSearch(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		writeServeError(w, http.StatusMethodNotAllowed, "GET 요청만 지원합니다")
+		return
+	}
+	if !s.authorized(r) {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="warp"`)
+		writeServeError(w, http.StatusUnauthorized, "인증 토큰이 없거나 올바르지 않습니다")
+		return
+	}
+
+	query := r.URL.Query()
+	q := strings.TrimSpace(query.Get("q"))
+	if q == "" {
+		writeServeError(w, http.StatusBadRequest, "검색어(q)를 입력해주세요")
+		return
+	}
+	source := query.Get("source")
+	if source == "" {
+		source = "nlic"
+	}
+	switch source {
+	case "nlic", "elis", "all":
+	default:
+		writeServeError(w, http.StatusBadRequest, fmt.Sprintf("지원하지 않는 검색 대상: %s (nlic, elis, all 중 선택)", source))
+		return
+	}
+	page, err := positiveQueryInt(query.Get("page"), 1)
+	if err != nil {
+		writeServeError(w, http.StatusBadRequest, "page는 1 이상의 정수여야 합니다")
+		return
+	}
+	size, err := positiveQueryInt(query.Get("size"), api.DefaultPageSize)
+	if err != nil {
+		writeServeError(w, http.StatusBadRequest, "size는 1 이상의 정수여야 합니다")
+		return
+	}
+
+	client, err := s.clientFor(source)
+	if err != nil {
+		logger.Error("Failed to create API client: %v", err)
+		writeServeError(w, searchErrorStatus(err), err.Error())
+		return
+	}
+
+	// A client that gives up while waiting for its turn needs no answer
+	if err := s.limiter.Wait(r.Context()); err != nil {
+		return
+	}
+
+	rc := api.NewRequestContext(q, source, "json", page, size)
+	ctx := api.WithRequestContext(r.Context(), rc)
+	w.Header().Set("X-Correlation-ID", rc.CorrelationID)
+
+	resp, err := fetchLaws(ctx, client, rc)
+	if err != nil {
+		logger.Warn("[%s] 검색 실패: %v", rc.CorrelationID, err)
+		writeServeError(w, searchErrorStatus(err), err.Error())
+		return
+	}
+	resp, err = transformSearchResults(ctx, resp)
+	if err != nil {
+		writeServeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	body, err := outputPkg.NewFormatter("json").FormatSearchResultToString(resp)
+	if err != nil {
+		writeServeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	logger.Debug("[%s] %q 검색 응답 (%d건, %v)", rc.CorrelationID, q, len(resp.Laws), rc.Elapsed())
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	io.WriteString(w, body)
+}
+
+// authorized reports whether r carries the server token as bearer token
+func (s *searchServer) authorized(r *http.Request) bool {
+	got, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	return ok && subtle.ConstantTimeCompare([]byte(strings.TrimSpace(got)), []byte(s.token)) == 1
+}
+
+// positiveQueryInt parses a query parameter that must be a positive integer,
+// returning def when it is empty
+func positiveQueryInt(value string, def int) (int, error) {
+	if value == "" {
+		return def, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 1 {
+		return 0, fmt.Errorf("invalid positive integer: %q", value)
+	}
+	return n, nil
+}
+
+// searchErrorStatus returns the HTTP status that reports a search error
+func searchErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return http.StatusGatewayTimeout
+	case errors.Is(err, api.ErrRateLimited):
+		return http.StatusTooManyRequests
+	case errors.Is(err, api.ErrNoAPIKey), errors.Is(err, api.ErrServiceUnavailable):
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusBadGateway
+	}
+}
+
+// writeServeError writes an error response with the message in a JSON body
+func writeServeError(w http.ResponseWriter, status int, message string) {
+	writeServeJSON(w, status, map[string]string{"error": message})
+}
+
+// writeServeJSON writes v as the JSON body of a response with status
+func writeServeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		logger.Debug("Failed to write response: %v", err)
+	}
+}
@@ -0,0 +1,238 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/cache"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/i18n"
+)
+
+// getWithToken sends a GET request to url with token as bearer token
+func getWithToken(t *testing.T, url, token string) *http.Response {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("GET %s failed: %v", url, err)
+	}
+	t.Cleanup(func() { resp.Body.Close() })
+	return resp
+}
+
+func TestSearchServer(t *testing.T) {
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+
+	var mu sync.Mutex
+	var sources []string
+	search := func(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+		switch req.Query {
+		case "한도":
+			return nil, fmt.Errorf("검색 실패: %w", api.ErrRateLimited)
+		case "장애":
+			return nil, fmt.Errorf("검색 실패: %w", api.ErrServiceUnavailable)
+		}
+		return &api.SearchResponse{
+			TotalCount: 1,
+			Page:       req.PageNo,
+			Laws:       []api.LawInfo{{ID: "011357", Name: req.Query + "법", LawType: "법률"}},
+		}, nil
+	}
+	clientFor := func(source string) (APIClient, error) {
+		mu.Lock()
+		sources = append(sources, source)
+		mu.Unlock()
+		return &mockAPIClient{searchFunc: search}, nil
+	}
+
+	server := httptest.NewServer(newSearchServer("secret", 0, clientFor))
+	defer server.Close()
+
+	tests := []struct {
+		name       string
+		path       string
+		token      string
+		wantStatus int
+		wantBody   string
+	}{
+		{"search", "/search?q=개인정보", "secret", http.StatusOK, `"011357"`},
+		{"missing token", "/search?q=개인정보", "", http.StatusUnauthorized, "인증 토큰"},
+		{"wrong token", "/search?q=개인정보", "guess", http.StatusUnauthorized, "인증 토큰"},
+		{"missing query", "/search?q=+", "secret", http.StatusBadRequest, "검색어"},
+		{"bad source", "/search?q=개인정보&source=prec", "secret", http.StatusBadRequest, "지원하지 않는 검색 대상"},
+		{"bad page", "/search?q=개인정보&page=0", "secret", http.StatusBadRequest, "page"},
+		{"bad size", "/search?q=개인정보&size=many", "secret", http.StatusBadRequest, "size"},
+		{"rate limited", "/search?q=한도", "secret", http.StatusTooManyRequests, "API 호출 한도 초과"},
+		{"unavailable", "/search?q=장애", "secret", http.StatusServiceUnavailable, "error"},
+		{"health", "/health", "", http.StatusOK, `"ok"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := getWithToken(t, server.URL+tt.path, tt.token)
+			body, _ := io.ReadAll(resp.Body)
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("status = %d, want %d (body %s)", resp.StatusCode, tt.wantStatus, body)
+			}
+			if !strings.Contains(string(body), tt.wantBody) {
+				t.Errorf("body %s should contain %q", body, tt.wantBody)
+			}
+			if ct := resp.Header.Get("Content-Type"); !strings.HasPrefix(ct, "application/json") {
+				t.Errorf("Content-Type = %q, want JSON", ct)
+			}
+		})
+	}
+
+	// The body is the JSON output of warp law
+	resp := getWithToken(t, server.URL+"/search?q=도로교통&source=elis&page=2&size=10", "secret")
+	var result struct {
+		TotalCount int `json:"totalCnt"`
+		Page       int `json:"page"`
+		Laws       []struct {
+			ID   string `json:"법령ID"`
+			Name string `json:"법령명한글"`
+		} `json:"law"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if result.Page != 2 || len(result.Laws) != 1 || result.Laws[0].Name != "도로교통법" {
+		t.Errorf("unexpected response: %+v", result)
+	}
+	if resp.Header.Get("X-Correlation-ID") == "" {
+		t.Error("search responses should carry a correlation ID")
+	}
+	if last := sources[len(sources)-1]; last != "elis" {
+		t.Errorf("client for %q, want elis", last)
+	}
+
+	// Only GET is served
+	post, err := http.Post(server.URL+"/search?q=개인정보", "text/plain", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	post.Body.Close()
+	if post.StatusCode != http.StatusMethodNotAllowed {
+		t.Errorf("POST status = %d, want %d", post.StatusCode, http.StatusMethodNotAllowed)
+	}
+}
+
+func TestSearchServerCacheAndRate(t *testing.T) {
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+
+	var mu sync.Mutex
+	calls := 0
+	client := &mockAPIClient{searchFunc: func(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+		mu.Lock()
+		calls++
+		mu.Unlock()
+		return &api.SearchResponse{TotalCount: 1, Laws: []api.LawInfo{{ID: "001", Name: req.Query}}}, nil
+	}}
+	cached := cache.NewClient(client, cache.New(t.TempDir(), time.Hour), "nlic")
+
+	// 20 requests per second start a request every 50ms
+	server := httptest.NewServer(newSearchServer("secret", 20, func(string) (APIClient, error) { return cached, nil }))
+	defer server.Close()
+
+	start := time.Now()
+	for _, q := range []string{"개인정보", "개인정보", "도로교통"} {
+		if resp := getWithToken(t, server.URL+"/search?q="+q, "secret"); resp.StatusCode != http.StatusOK {
+			t.Fatalf("status = %d, want 200", resp.StatusCode)
+		}
+	}
+	if calls != 2 {
+		t.Errorf("repeated query should be a cache hit, got %d API searches", calls)
+	}
+	if elapsed := time.Since(start); elapsed < 100*time.Millisecond {
+		t.Errorf("3 requests took %v, want them spaced by the rate limit", elapsed)
+	}
+}
+
+func TestServeShutdown(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+		io.WriteString(w, "done")
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	served := make(chan error, 1)
+	go func() { served <- serve(ctx, listener, handler) }()
+
+	// A request in flight when the server is told to stop is still answered
+	got := make(chan string, 1)
+	go func() {
+		resp, err := http.Get("http://" + listener.Addr().String() + "/")
+		if err != nil {
+			got <- err.Error()
+			return
+		}
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		got <- string(body)
+	}()
+	<-started
+	cancel()
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+
+	if body := <-got; body != "done" {
+		t.Errorf("request in flight got %q, want it to finish", body)
+	}
+	select {
+	case err := <-served:
+		if err != nil {
+			t.Errorf("serve() error = %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("serve() did not return after shutdown")
+	}
+}
+
+func TestServeOptionsValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    serveOptions
+		wantErr bool
+	}{
+		{"defaults", serveOptions{port: 8080, rate: 5}, false},
+		{"any free port", serveOptions{port: 0}, false},
+		{"port too large", serveOptions{port: 70000}, true},
+		{"negative rate", serveOptions{port: 8080, rate: -1}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.opts.validate(); (err != nil) != tt.wantErr {
+				t.Errorf("validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
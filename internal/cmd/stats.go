@@ -0,0 +1,182 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/config"
+	cliErrors "github.com/pyhub-apps/pyhub-warp-cli/internal/errors"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/i18n"
+	"github.com/spf13/cobra"
+)
+
+var (
+	statsCmd    *cobra.Command
+	statsFormat string
+
+	// sessionMetrics collects the API calls of the command with --metrics or
+	// --metrics-file, nil otherwise
+	sessionMetrics *api.Metrics
+	// metricsSummary is set by --metrics: the summary is printed when the
+	// command ends
+	metricsSummary bool
+	// metricsFile is the file of --metrics-file, written in the Prometheus
+	// text format when the command ends
+	metricsFile string
+)
+
+// initStatsCmd initializes the stats command
+func initStatsCmd() {
+	statsCmd = &cobra.Command{
+		Use:          "stats",
+		Short:        i18n.T("stats.short"),
+		Long:         i18n.T("stats.long"),
+		Example:      i18n.T("stats.example"),
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runStats(cmd.OutOrStdout(), statsFormat)
+		},
+	}
+
+	statsCmd.Flags().StringVarP(&statsFormat, "format", "f", "table", i18n.T("stats.flag.format"))
+}
+
+// updateStatsCommand updates stats command descriptions
+func updateStatsCommand() {
+	if statsCmd != nil {
+		statsCmd.Short = i18n.T("stats.short")
+		statsCmd.Long = i18n.T("stats.long")
+		statsCmd.Example = i18n.T("stats.example")
+		if flag := statsCmd.Flags().Lookup("format"); flag != nil {
+			flag.Usage = i18n.T("stats.flag.format")
+		}
+	}
+}
+
+// metricsPath returns the file keeping the metrics of the last session, or
+// an empty string without a config directory
+func metricsPath() string {
+	if config.GetConfigDir() == "" {
+		return ""
+	}
+	return filepath.Join(config.GetConfigDir(), api.MetricsFileName)
+}
+
+// runStats writes the metrics of the last session in format
+func runStats(w io.Writer, format string) error {
+	path := metricsPath()
+	if path == "" {
+		return errors.New(i18n.T("stats.error.noConfigDir"))
+	}
+	snapshot, err := api.LoadMetrics(path)
+	if errors.Is(err, os.ErrNotExist) {
+		fmt.Fprintln(w, i18n.T("stats.empty"))
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	switch strings.ToLower(format) {
+	case "table":
+		writeMetricsSummary(w, snapshot)
+		return nil
+	case "json":
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(snapshot)
+	case "prometheus":
+		return snapshot.WritePrometheus(w)
+	default:
+		return cliErrors.New(
+			cliErrors.ErrCodeInvalidInput,
+			i18n.Tf("stats.error.format", format),
+			i18n.T("stats.error.formatHint"),
+		)
+	}
+}
+
+// applyMetrics starts collecting the API calls of the command for --metrics
+// and --metrics-file
+func applyMetrics(cmd *cobra.Command) {
+	flags := cmd.Root().PersistentFlags()
+	metricsSummary, _ = flags.GetBool("metrics")
+	metricsFile, _ = flags.GetString("metrics-file")
+	sessionMetrics = nil
+	if metricsSummary || metricsFile != "" {
+		sessionMetrics = api.NewMetrics()
+	}
+	api.SetMetrics(sessionMetrics)
+}
+
+// finishMetrics stops collecting the API calls of the command and, with
+// --metrics, writes their summary to w and keeps them for warp stats; with
+// --metrics-file, it writes them to that file. Problems are reported to w
+// without failing the command, whose output is already written.
+func finishMetrics(w io.Writer) {
+	if sessionMetrics == nil {
+		return
+	}
+	api.SetMetrics(nil)
+	snapshot := sessionMetrics.Snapshot()
+	sessionMetrics = nil
+
+	if metricsSummary {
+		fmt.Fprintln(w)
+		writeMetricsSummary(w, snapshot)
+		if path := metricsPath(); path != "" && snapshot.Requests > 0 {
+			if err := api.SaveMetrics(path, snapshot); err != nil {
+				fmt.Fprintln(w, i18n.Tf("stats.saveFailed", err))
+			}
+		}
+	}
+	if metricsFile != "" {
+		if err := api.SavePrometheusMetrics(metricsFile, snapshot); err != nil {
+			fmt.Fprintln(w, i18n.Tf("stats.fileSaveFailed", err))
+		}
+	}
+}
+
+// writeMetricsSummary writes the API call metrics of a session
+func writeMetricsSummary(w io.Writer, s api.MetricsSnapshot) {
+	fmt.Fprintln(w, i18n.T("stats.title"))
+	if s.Requests == 0 {
+		fmt.Fprintf(w, "  %s\n", i18n.T("stats.noCalls"))
+		return
+	}
+	fmt.Fprintf(w, "  %s\n", i18n.Tf("stats.requests", s.Requests, s.SuccessRate()*100))
+	fmt.Fprintf(w, "  %s\n", i18n.Tf("stats.retries", s.Retries))
+	fmt.Fprintf(w, "  %s\n", i18n.Tf("stats.failures", s.Failures))
+
+	codes := make([]string, 0, len(s.Statuses))
+	for _, code := range s.StatusCodes() {
+		codes = append(codes, fmt.Sprintf("%d %s", code, i18n.Tf("stats.count", s.Statuses[code])))
+	}
+	if len(codes) > 0 {
+		fmt.Fprintf(w, "  %s\n", i18n.Tf("stats.statuses", strings.Join(codes, ", ")))
+	}
+
+	fmt.Fprintf(w, "  %s\n", i18n.Tf("stats.meanLatency", s.MeanLatency().Round(time.Millisecond)))
+	var buckets []string
+	for i, count := range s.Latency {
+		if count == 0 {
+			continue
+		}
+		label := ">" + api.MetricsLatencyBuckets[len(api.MetricsLatencyBuckets)-1].String()
+		if i < len(api.MetricsLatencyBuckets) {
+			label = "≤" + api.MetricsLatencyBuckets[i].String()
+		}
+		buckets = append(buckets, fmt.Sprintf("%s %s", label, i18n.Tf("stats.count", count)))
+	}
+	if len(buckets) > 0 {
+		fmt.Fprintf(w, "  %s\n", i18n.Tf("stats.latencies", strings.Join(buckets, ", ")))
+	}
+}
@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/config"
+	cliErrors "github.com/pyhub-apps/pyhub-warp-cli/internal/errors"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/i18n"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/testutil"
+)
+
+func TestStatsMetrics(t *testing.T) {
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+	tempDir, cleanup := testutil.CreateTempDir(t, "warp-stats-test-*")
+	t.Cleanup(cleanup)
+	config.ResetConfig()
+	config.SetTestConfigPath(tempDir)
+	if err := config.Initialize(); err != nil {
+		t.Fatalf("Failed to initialize config: %v", err)
+	}
+	t.Cleanup(func() {
+		api.SetMetrics(nil)
+		sessionMetrics, metricsSummary, metricsFile = nil, false, ""
+		config.ResetConfig()
+	})
+
+	var out bytes.Buffer
+	if err := runStats(&out, "table"); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), "저장된 메트릭이 없습니다") {
+		t.Errorf("stats without a session should say so:\n%s", out.String())
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	// A session with --metrics and --metrics-file
+	promPath := filepath.Join(tempDir, "warp.prom")
+	sessionMetrics, metricsSummary, metricsFile = api.NewMetrics(), true, promPath
+	api.SetMetrics(sessionMetrics)
+	api.ProbeConnectivity(context.Background(), server.Client(), server.URL)
+	out.Reset()
+	finishMetrics(&out)
+	for _, want := range []string{"API 호출 메트릭", "요청:       1건 (성공률 100.0%)", "상태 코드:  200 1건", "평균 지연:", "지연 분포:"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("summary should contain %q:\n%s", want, out.String())
+		}
+	}
+	prom, err := os.ReadFile(promPath)
+	if err != nil || !strings.Contains(string(prom), `warp_api_responses_total{code="200"} 1`) {
+		t.Errorf("metrics file = %q, %v", prom, err)
+	}
+
+	// A session without API calls keeps the saved metrics
+	sessionMetrics, metricsSummary, metricsFile = api.NewMetrics(), true, ""
+	api.SetMetrics(sessionMetrics)
+	out.Reset()
+	finishMetrics(&out)
+	if !strings.Contains(out.String(), "API 호출 없음") {
+		t.Errorf("summary of a session without calls:\n%s", out.String())
+	}
+
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{"table", "요청:       1건"},
+		{"json", `"requests": 1`},
+		{"prometheus", "warp_api_requests_total 1"},
+	}
+	for _, tt := range tests {
+		out.Reset()
+		if err := runStats(&out, tt.format); err != nil {
+			t.Fatalf("%s: %v", tt.format, err)
+		}
+		if !strings.Contains(out.String(), tt.want) {
+			t.Errorf("%s should contain %q:\n%s", tt.format, tt.want, out.String())
+		}
+	}
+	if err := runStats(&out, "yaml"); err == nil || exitCode(err) != cliErrors.ExitInvalidInput {
+		t.Errorf("an unknown format should be rejected as invalid input, got %v", err)
+	}
+
+	if err := i18n.SetLanguage("en"); err != nil {
+		t.Fatal(err)
+	}
+	defer i18n.SetLanguage("ko")
+	out.Reset()
+	if err := runStats(&out, "table"); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"API call metrics", "Requests:     1 (100.0% succeeded)", "Statuses:     200 1"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("English summary should contain %q:\n%s", want, out.String())
+		}
+	}
+}
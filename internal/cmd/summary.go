@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/i18n"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/stats"
+)
+
+// validateSummary checks that --summary is used with an output that can carry
+// it: lines after the table, or the summary object of json, ndjson and xml
+func validateSummary(summary bool, format string, records recordOutput, tree, quality, countOnly bool) error {
+	if !summary {
+		return nil
+	}
+	switch format {
+	case "table", "json", "ndjson", "xml":
+	default:
+		return fmt.Errorf("--summary 옵션은 table, json, ndjson, xml 형식에서만 사용할 수 있습니다")
+	}
+	if records.active() || tree || quality || countOnly {
+		return fmt.Errorf("--summary 옵션은 --pluck, --ids-only, --tree, --quality-report, --count-only와 함께 사용할 수 없습니다")
+	}
+	return nil
+}
+
+// setSummary sets the distribution of the results of resp by source and law
+// type, which the json, ndjson and xml output then include. Empty results
+// have no summary.
+func setSummary(resp *api.SearchResponse) {
+	if len(resp.Laws) == 0 {
+		return
+	}
+	resp.Summary = stats.Distribution(resp.Laws)
+}
+
+// writeSummary writes the summary lines that follow table output, such as
+// "국가법령 12 · 자치법규 8"
+func writeSummary(w io.Writer, format string, resp *api.SearchResponse) {
+	if format != "table" || resp.Summary == nil {
+		return
+	}
+	fmt.Fprintln(w)
+	if len(resp.Summary.BySource) > 0 {
+		fmt.Fprintln(w, i18n.Tf("output.resultSummary.bySource", summaryCounts(resp.Summary.BySource)))
+	}
+	fmt.Fprintln(w, i18n.Tf("output.resultSummary.byLawType", summaryCounts(resp.Summary.ByLawType)))
+}
+
+// summaryCounts joins counts as "name count · name count"
+func summaryCounts(counts []api.SummaryCount) string {
+	parts := make([]string, len(counts))
+	for i, c := range counts {
+		parts[i] = fmt.Sprintf("%s %d", c.Name, c.Count)
+	}
+	return strings.Join(parts, " · ")
+}
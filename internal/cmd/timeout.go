@@ -0,0 +1,21 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	cliErrors "github.com/pyhub-apps/pyhub-warp-cli/internal/errors"
+)
+
+// wrapTimeout turns an API error caused by the request timeout into an error
+// naming the limit and how to raise it. Other errors are returned unchanged.
+func wrapTimeout(err error) error {
+	if err == nil || !api.IsTimeout(err) {
+		return err
+	}
+	return cliErrors.Wrap(err, cliErrors.New(
+		cliErrors.ErrCodeTimeout,
+		fmt.Sprintf("요청 시간이 초과되었습니다 (제한: %s)", api.Timeout()),
+		"네트워크 상태를 확인하거나 --timeout 옵션 또는 'warp config set api.timeout 60s'로 제한 시간을 늘리세요",
+	))
+}
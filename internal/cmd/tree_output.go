@@ -0,0 +1,17 @@
+package cmd
+
+import "fmt"
+
+// validateTreeOutput checks that --tree is used with table output and without record output
+func validateTreeOutput(tree bool, format string, records recordOutput) error {
+	if !tree {
+		return nil
+	}
+	if format != "table" {
+		return fmt.Errorf("--tree 옵션은 table 형식에서만 사용할 수 있습니다")
+	}
+	if records.active() {
+		return fmt.Errorf("--tree 옵션은 --pluck, --ids-only와 함께 사용할 수 없습니다")
+	}
+	return nil
+}
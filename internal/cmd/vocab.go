@@ -0,0 +1,342 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/config"
+	cliErrors "github.com/pyhub-apps/pyhub-warp-cli/internal/errors"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/i18n"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/logger"
+	outputPkg "github.com/pyhub-apps/pyhub-warp-cli/internal/output"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/pipeline"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/vocab"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/watch"
+	"github.com/spf13/cobra"
+)
+
+var (
+	vocabCmd      *cobra.Command
+	vocabListCmd  *cobra.Command
+	vocabClearCmd *cobra.Command
+
+	vocabKind string
+)
+
+// vocabKindNames are the names of the vocabulary kinds in the list output
+var vocabKindNames = map[string]string{
+	vocab.KindDepartment: "소관부처",
+	vocab.KindLawType:    "법령구분",
+}
+
+// initVocabCmd initializes the vocab command and its subcommands
+func initVocabCmd() {
+	vocabCmd = &cobra.Command{
+		Use:   "vocab",
+		Short: "자동완성용 소관부처/법령구분 사전 관리",
+		Long: `검색 결과에서 본 소관부처와 법령구분 값을 사전에 모아
+--department, --law-type 플래그의 자동완성 후보로 사용합니다.
+
+값마다 나타난 검색 결과 수(빈도)와 마지막으로 본 시각을 기록하며,
+같은 결과를 다시 검색해도 빈도는 늘지 않습니다.`,
+		Example: `  # 사전 내용 확인
+  warp vocab list
+
+  # 소관부처만 확인
+  warp vocab list --kind department
+
+  # 사전 초기화
+  warp vocab clear`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	vocabListCmd = &cobra.Command{
+		Use:   "list",
+		Short: "사전에 모인 값 조회",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return listVocabulary(vocabStore(), vocabKind, cmd.OutOrStdout())
+		},
+	}
+	vocabListCmd.Flags().StringVar(&vocabKind, "kind", "", "조회할 종류 (department, law_type; 기본: 전체)")
+
+	vocabClearCmd = &cobra.Command{
+		Use:   "clear",
+		Short: "사전 초기화",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := vocabStore().Clear(); err != nil {
+				return err
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), "자동완성 사전을 초기화했습니다.")
+			return nil
+		},
+	}
+
+	vocabCmd.AddCommand(vocabListCmd)
+	vocabCmd.AddCommand(vocabClearCmd)
+}
+
+// updateVocabCommand updates vocab command descriptions
+func updateVocabCommand() {
+	if vocabCmd != nil {
+		vocabCmd.Short = "자동완성용 소관부처/법령구분 사전 관리"
+	}
+	if vocabListCmd != nil {
+		vocabListCmd.Short = "사전에 모인 값 조회"
+	}
+	if vocabClearCmd != nil {
+		vocabClearCmd.Short = "사전 초기화"
+	}
+}
+
+// vocabStore returns the vocabulary store in the config directory
+func vocabStore() *vocab.Store {
+	return vocab.New(filepath.Join(config.GetConfigDir(), vocab.FileName))
+}
+
+// learnVocabulary adds the departments and law types of resp to the
+// vocabulary. Failures are only logged since learning must never break a
+// search.
+func learnVocabulary(ctx context.Context, resp *api.SearchResponse) {
+	// Config is not initialized when commands run outside Execute (e.g. in tests)
+	if config.GetConfigDir() == "" || resp == nil || len(resp.Laws) == 0 {
+		return
+	}
+
+	obs := vocab.Observation{
+		Key:    watch.Fingerprint(resp.Laws),
+		Values: make(map[string][]string),
+	}
+	if rc, ok := api.RequestContextFrom(ctx); ok {
+		obs.At = rc.StartedAt
+	}
+	for _, law := range resp.Laws {
+		obs.Values[vocab.KindDepartment] = append(obs.Values[vocab.KindDepartment], splitDepartments(law.Department)...)
+		obs.Values[vocab.KindLawType] = append(obs.Values[vocab.KindLawType], law.LawType)
+	}
+	if err := vocabStore().Learn(obs); err != nil {
+		logger.Debug("자동완성 사전 갱신 실패: %v", err)
+	}
+}
+
+// splitDepartments returns the departments of a department field, which
+// lists jointly responsible departments separated by commas
+func splitDepartments(department string) []string {
+	department = pipeline.NormalizeDepartment(department)
+	if department == "" {
+		return nil
+	}
+	return strings.Split(department, ", ")
+}
+
+// listVocabulary writes the learned values of kind, or of every kind if kind
+// is empty
+func listVocabulary(store *vocab.Store, kind string, writer io.Writer) error {
+	kinds := vocab.Kinds
+	if kind != "" {
+		if _, ok := vocabKindNames[kind]; !ok {
+			return fmt.Errorf("잘못된 종류: %s (department, law_type 중 선택)", kind)
+		}
+		kinds = []string{kind}
+	}
+
+	headers := []string{"종류", "값", "빈도", "최근"}
+	var rows [][]string
+	for _, k := range kinds {
+		entries, err := store.List(k)
+		if err != nil {
+			return err
+		}
+		for _, e := range entries {
+			rows = append(rows, []string{
+				vocabKindNames[k],
+				e.Value,
+				strconv.Itoa(e.Count),
+				e.LastSeen.Local().Format("2006-01-02 15:04"),
+			})
+		}
+	}
+	if len(rows) == 0 {
+		fmt.Fprintln(writer, "자동완성 사전이 비어 있습니다. 검색하면 결과의 소관부처와 법령구분이 모입니다.")
+		return nil
+	}
+	fmt.Fprint(writer, outputPkg.RenderTable(headers, rows, nil))
+	return nil
+}
+
+// completeVocabulary returns a completion function offering the learned
+// values of kind, most used first, with their counts as descriptions.
+// Departments are followed by the known departments not learned yet.
+func completeVocabulary(kind string) func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		var entries []vocab.Entry
+		// Config is not initialized when commands run outside Execute (e.g. in tests)
+		if config.GetConfigDir() != "" {
+			learned, err := vocabStore().List(kind)
+			if err != nil {
+				logger.Debug("자동완성 사전을 읽을 수 없습니다: %v", err)
+			}
+			entries = learned
+		}
+
+		var values []string
+		seen := make(map[string]bool)
+		for _, e := range entries {
+			seen[e.Value] = true
+			if strings.HasPrefix(e.Value, toComplete) {
+				values = append(values, fmt.Sprintf("%s\t%d회", e.Value, e.Count))
+			}
+		}
+		if kind == vocab.KindDepartment {
+			for _, department := range vocab.KnownDepartments {
+				if !seen[department] && strings.HasPrefix(department, toComplete) {
+					values = append(values, department)
+				}
+			}
+		}
+		return values, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// knownDepartments returns the bundled department names and the ones learned
+// from search results
+func knownDepartments() []string {
+	departments := append([]string(nil), vocab.KnownDepartments...)
+	if config.GetConfigDir() == "" {
+		return departments
+	}
+	learned, err := vocabStore().Values(vocab.KindDepartment)
+	if err != nil {
+		logger.Debug("자동완성 사전을 읽을 수 없습니다: %v", err)
+	}
+	return append(departments, learned...)
+}
+
+// valueFilter holds the --department, --law-type and --strict-law-type flag
+// values of a search
+type valueFilter struct {
+	department    string
+	lawType       string
+	strictLawType bool
+}
+
+// addValueFilterFlags registers the --department and --law-type flags on a search command
+func addValueFilterFlags(cmd *cobra.Command, f *valueFilter) {
+	cmd.Flags().StringVar(&f.department, "department", "", i18n.T("law.flag.department"))
+	cmd.Flags().StringVar(&f.lawType, "law-type", "", i18n.T("law.flag.lawType"))
+	cmd.Flags().BoolVar(&f.strictLawType, "strict-law-type", false, i18n.T("law.flag.strictLawType"))
+}
+
+// updateValueFilterFlagUsages updates the --department and --law-type flag descriptions
+func updateValueFilterFlagUsages(cmd *cobra.Command) {
+	if flag := cmd.Flags().Lookup("department"); flag != nil {
+		flag.Usage = i18n.T("law.flag.department")
+	}
+	if flag := cmd.Flags().Lookup("law-type"); flag != nil {
+		flag.Usage = i18n.T("law.flag.lawType")
+	}
+	if flag := cmd.Flags().Lookup("strict-law-type"); flag != nil {
+		flag.Usage = i18n.T("law.flag.strictLawType")
+	}
+}
+
+// warnUnknownDepartment warns about a --department value that is not a known
+// department name, suggesting similar names. Unknown names still filter the
+// results, since a new department may not be known yet.
+func (f *valueFilter) warnUnknownDepartment() {
+	departments := splitDepartments(f.department)
+	if len(departments) == 0 {
+		return
+	}
+	known := knownDepartments()
+	for _, department := range departments {
+		if containsString(known, department) {
+			continue
+		}
+		if suggestions := vocab.SuggestDepartments(department, known); len(suggestions) > 0 {
+			logger.Warn("알려진 소관부처가 아닙니다: %s. 혹시 이것을 찾으셨나요? %s", department, strings.Join(suggestions, ", "))
+		} else {
+			logger.Warn("알려진 소관부처가 아닙니다: %s ('warp law departments <검색어>'로 소관부처명을 확인하세요)", department)
+		}
+	}
+}
+
+// checkLawTypes warns about --law-type values that are not known law types,
+// which still filter the results, or rejects them with --strict-law-type
+func (f *valueFilter) checkLawTypes() error {
+	var unknown []string
+	for _, lawType := range api.ParseLawTypes(f.lawType) {
+		if !api.IsKnownLawType(lawType) {
+			unknown = append(unknown, lawType)
+		}
+	}
+	if len(unknown) == 0 {
+		return nil
+	}
+	if f.strictLawType {
+		return cliErrors.New(
+			cliErrors.ErrCodeInvalidInput,
+			fmt.Sprintf("알려진 법령구분이 아닙니다: %s", strings.Join(unknown, ", ")),
+			fmt.Sprintf("법령구분은 %s 등입니다", strings.Join(api.KnownLawTypes[:5], ", ")),
+		)
+	}
+	logger.Warn("알려진 법령구분이 아닙니다: %s (--strict-law-type이면 거부합니다)", strings.Join(unknown, ", "))
+	return nil
+}
+
+// apply keeps only the laws of the requested department and law types. A law
+// of several departments matches any of them, and the law types are compared
+// normalized, so that "법률(法律)" matches "법률". The server already filters
+// by the department and a single law type, so a page it filtered is kept
+// whole with the total of the server.
+func (f *valueFilter) apply(resp *api.SearchResponse) *api.SearchResponse {
+	department := pipeline.NormalizeDepartment(f.department)
+	lawTypes := api.ParseLawTypes(f.lawType)
+	if department == "" && len(lawTypes) == 0 {
+		return resp
+	}
+
+	var laws []api.LawInfo
+	for _, law := range resp.Laws {
+		if !api.MatchLawType(law.LawType, lawTypes) {
+			continue
+		}
+		if department != "" && !containsString(splitDepartments(law.Department), department) {
+			continue
+		}
+		laws = append(laws, law)
+	}
+	if len(laws) == len(resp.Laws) {
+		return resp
+	}
+	logger.Info("소관부처/법령구분 필터: 현재 페이지 %d개 중 %d개", len(resp.Laws), len(laws))
+
+	filtered := *resp
+	filtered.Laws = laws
+	filtered.TotalCount = len(laws)
+	filtered.FetchedCount = 0
+	return &filtered
+}
+
+// containsString reports whether values contains value
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func init() {
+	// Vocab command will be initialized and added in Execute()
+}
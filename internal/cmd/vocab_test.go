@@ -0,0 +1,248 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	cliErrors "github.com/pyhub-apps/pyhub-warp-cli/internal/errors"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/i18n"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/logger"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/testutil"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/vocab"
+	"github.com/spf13/cobra"
+)
+
+// newVocabTestRoot builds a root command with the law and vocab commands and
+// a config directory in a temp dir
+func newVocabTestRoot(t *testing.T) *cobra.Command {
+	t.Helper()
+
+	root := newConfiguredTestRoot(t)
+	initLawCmd()
+	initVocabCmd()
+	root.AddCommand(lawCmd)
+	root.AddCommand(vocabCmd)
+	return root
+}
+
+func TestVocabLearnFromSearch(t *testing.T) {
+	root := newVocabTestRoot(t)
+
+	laws := []api.LawInfo{
+		{ID: "1", Name: "개인정보 보호법", LawType: "법률", Department: "개인정보보호위원회"},
+		{ID: "2", Name: "국가재정법", LawType: "법률", Department: "기획재정부, 행정안전부"},
+		{ID: "3", Name: "개인정보 보호법 시행령", LawType: "대통령령", Department: "개인정보보호위원회"},
+	}
+	testAPIClient = &mockAPIClient{
+		searchFunc: func(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+			return &api.SearchResponse{TotalCount: len(laws), Laws: laws}, nil
+		},
+	}
+	defer func() { testAPIClient = nil }()
+
+	// The same results searched twice are learned once
+	for i := 0; i < 2; i++ {
+		if _, err := testutil.ExecuteCommand(t, root, []string{"law", "개인정보", "--format", "json"}); err != nil {
+			t.Fatalf("law search failed: %v", err)
+		}
+	}
+
+	departments, err := vocabStore().List(vocab.KindDepartment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(departments) != 3 || departments[0].Value != "개인정보보호위원회" || departments[0].Count != 1 {
+		t.Errorf("departments = %+v, want the 3 departments counted once", departments)
+	}
+
+	output, err := testutil.ExecuteCommand(t, root, []string{"vocab", "list"})
+	if err != nil {
+		t.Fatalf("vocab list failed: %v", err)
+	}
+	for _, want := range []string{"소관부처", "행정안전부", "법령구분", "대통령령"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("vocab list output should contain %q, got:\n%s", want, output)
+		}
+	}
+	output, _ = testutil.ExecuteCommand(t, root, []string{"vocab", "list", "--kind", "law_type"})
+	if strings.Contains(output, "행정안전부") || !strings.Contains(output, "법률") {
+		t.Errorf("vocab list --kind law_type should list only law types, got:\n%s", output)
+	}
+
+	// Completion offers the learned values matching what was typed
+	complete := completeVocabulary(vocab.KindDepartment)
+	values, directive := complete(lawCmd, nil, "기획")
+	if len(values) != 1 || !strings.HasPrefix(values[0], "기획재정부\t") || directive != cobra.ShellCompDirectiveNoFileComp {
+		t.Errorf("completion of 기획 = %v, %v", values, directive)
+	}
+
+	if _, err := testutil.ExecuteCommand(t, root, []string{"vocab", "clear"}); err != nil {
+		t.Fatalf("vocab clear failed: %v", err)
+	}
+	output, _ = testutil.ExecuteCommand(t, root, []string{"vocab", "list", "--kind", ""})
+	if !strings.Contains(output, "비어 있습니다") {
+		t.Errorf("vocab list after clear = %s", output)
+	}
+	if _, err := testutil.ExecuteCommand(t, root, []string{"vocab", "list", "--kind", "region"}); err == nil {
+		t.Error("vocab list should reject an unknown kind")
+	}
+}
+
+func TestLawValueFilter(t *testing.T) {
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+
+	testAPIClient = &mockAPIClient{
+		searchFunc: func(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+			return &api.SearchResponse{TotalCount: 3, Laws: []api.LawInfo{
+				{ID: "1", Name: "개인정보 보호법", LawType: "법률", Department: "개인정보보호위원회"},
+				{ID: "2", Name: "국가재정법", LawType: "법률", Department: "기획재정부,  행정안전부"},
+				{ID: "3", Name: "재정 시행령", LawType: "대통령령", Department: "기획재정부"},
+				{ID: "4", Name: "재정 시행규칙", LawType: "총리령(總理令)", Department: "국무조정실"},
+			}}, nil
+		},
+	}
+	defer func() { testAPIClient = nil }()
+
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"--department", "행정안전부"}, "2"},
+		{[]string{"--department", "기획재정부", "--law-type", "대통령령"}, "3"},
+		{[]string{"--law-type", "법률"}, "1\n2"},
+		{[]string{"--law-type", "법률, 대통령령"}, "1\n2\n3"},
+		{[]string{"--law-type", "대통령령(大統領令),총리령"}, "3\n4"},
+		{[]string{"--department", "기획재정부", "--law-type", "법률,대통령령"}, "2\n3"},
+	}
+	for _, tt := range tests {
+		initLawCmd()
+		root := &cobra.Command{Use: "test"}
+		root.AddCommand(lawCmd)
+		output, err := testutil.ExecuteCommand(t, root, append([]string{"law", "법", "--ids-only"}, tt.args...))
+		if err != nil {
+			t.Fatalf("%v: %v", tt.args, err)
+		}
+		if got := strings.TrimSpace(output); got != tt.want {
+			t.Errorf("%v: ids = %q, want %q", tt.args, got, tt.want)
+		}
+	}
+}
+
+func TestLawValueFilterRequest(t *testing.T) {
+	if err := i18n.Init(); err != nil {
+		t.Fatalf("Failed to initialize i18n: %v", err)
+	}
+
+	var seen *api.UnifiedSearchRequest
+	testAPIClient = &mockAPIClient{
+		searchFunc: func(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+			seen = req
+			return &api.SearchResponse{TotalCount: 120, Laws: []api.LawInfo{
+				{ID: "1", Name: "국가재정법", LawType: "법률", Department: "기획재정부"},
+			}}, nil
+		},
+	}
+	defer func() { testAPIClient = nil }()
+
+	initLawCmd()
+	root := &cobra.Command{Use: "test"}
+	root.AddCommand(lawCmd)
+	output, err := testutil.ExecuteCommand(t, root, []string{"law", "재정", "--department", " 기획재정부 ", "--law-type", "법률", "--count-only"})
+	if err != nil {
+		t.Fatalf("law failed: %v", err)
+	}
+	if seen == nil || seen.LawType != "법률" || seen.Department != "기획재정부" {
+		t.Fatalf("request should carry the law type and department, got %+v", seen)
+	}
+	// The page filtered by the server keeps its total
+	if got := strings.TrimSpace(output); got != "120" {
+		t.Errorf("count = %q, want 120", got)
+	}
+}
+
+func TestCheckLawTypes(t *testing.T) {
+	var logs bytes.Buffer
+	logger.SetOutput(&logs)
+	defer logger.SetOutput(os.Stderr)
+
+	for _, lawType := range []string{"", "법률,대통령령", "법률(法律)", "기획재정부령"} {
+		logs.Reset()
+		filter := valueFilter{lawType: lawType, strictLawType: true}
+		if err := filter.checkLawTypes(); err != nil || logs.Len() != 0 {
+			t.Errorf("%q: error = %v, warning = %q", lawType, err, logs.String())
+		}
+	}
+
+	// Unknown law types are warned about and still filter, or rejected if strict
+	logs.Reset()
+	filter := valueFilter{lawType: "법률, 법율"}
+	if err := filter.checkLawTypes(); err != nil {
+		t.Errorf("unknown law type without --strict-law-type: %v", err)
+	}
+	if !strings.Contains(logs.String(), "알려진 법령구분이 아닙니다: 법율") {
+		t.Errorf("warning = %q", logs.String())
+	}
+	filter.strictLawType = true
+	err := filter.checkLawTypes()
+	if err == nil || !strings.Contains(err.Error(), "알려진 법령구분이 아닙니다: 법율") {
+		t.Fatalf("strict error = %v", err)
+	}
+	if code := exitCode(err); code != cliErrors.ExitInvalidInput {
+		t.Errorf("exit code = %d, want %d", code, cliErrors.ExitInvalidInput)
+	}
+}
+
+func TestWarnUnknownDepartment(t *testing.T) {
+	newVocabTestRoot(t)
+
+	// A learned department is known like the bundled ones
+	if err := vocabStore().Learn(vocab.Observation{Key: "a", Values: map[string][]string{vocab.KindDepartment: {"신설혁신부"}}}); err != nil {
+		t.Fatal(err)
+	}
+
+	var logs bytes.Buffer
+	logger.SetOutput(&logs)
+	defer logger.SetOutput(os.Stderr)
+
+	tests := []struct {
+		department string
+		want       string
+	}{
+		{"행정안전부", ""},
+		{"신설혁신부", ""},
+		{"행정안정부", "혹시 이것을 찾으셨나요? 행정안전부"},
+		{"신설혁신무", "혹시 이것을 찾으셨나요? 신설혁신부"},
+		{"법무부, 복지부", "알려진 소관부처가 아닙니다: 복지부. 혹시 이것을 찾으셨나요? 보건복지부"},
+		{"우주정거장", "'warp law departments <검색어>'로"},
+	}
+	for _, tt := range tests {
+		logs.Reset()
+		filter := valueFilter{department: tt.department}
+		filter.warnUnknownDepartment()
+		if tt.want == "" {
+			if logs.Len() != 0 {
+				t.Errorf("%s: known department warned: %s", tt.department, logs.String())
+			}
+			continue
+		}
+		if !strings.Contains(logs.String(), tt.want) {
+			t.Errorf("%s: warning = %q, want %q", tt.department, logs.String(), tt.want)
+		}
+	}
+
+	// Completion offers the learned departments before the bundled ones
+	values, _ := completeVocabulary(vocab.KindDepartment)(lawCmd, nil, "신설")
+	if len(values) != 1 || !strings.HasPrefix(values[0], "신설혁신부\t") {
+		t.Errorf("completion of learned departments = %v", values)
+	}
+	values, _ = completeVocabulary(vocab.KindDepartment)(lawCmd, nil, "보건")
+	if len(values) != 1 || values[0] != "보건복지부" {
+		t.Errorf("completion of bundled departments = %v", values)
+	}
+}
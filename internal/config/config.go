@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 
 	"github.com/spf13/viper"
 )
@@ -15,11 +17,16 @@ const (
 	ConfigFileName = "config"
 	// ConfigFileType is the type of the config file
 	ConfigFileType = "yaml"
+	// DefaultHistorySize is the default number of searches kept in the history
+	DefaultHistorySize = 50
+	// EnvPrefix is the prefix for environment variable overrides (e.g. WARP_LAW_KEY)
+	EnvPrefix = "WARP"
 )
 
 // Config holds the application configuration
 type Config struct {
-	Law struct {
+	Version int `mapstructure:"version"` // Schema version of the config file (see CurrentVersion)
+	Law     struct {
 		Key  string `mapstructure:"key"` // Legacy: NLIC API key
 		NLIC struct {
 			Key string `mapstructure:"key"` // National Law Information Center API key
@@ -46,6 +53,7 @@ func SetTestConfigPath(path string) {
 func ResetConfig() {
 	cfg = nil
 	configPath = ""
+	profileOverride = ""
 	viper.Reset()
 }
 
@@ -77,6 +85,7 @@ func Initialize() error {
 	viper.SetDefault("law.key", "")
 	viper.SetDefault("law.nlic.key", "")
 	viper.SetDefault("law.elis.key", "")
+	viper.SetDefault("history.size", DefaultHistorySize)
 
 	// Try to read config file
 	if err := viper.ReadInConfig(); err != nil {
@@ -94,15 +103,34 @@ func Initialize() error {
 		}
 	}
 
+	// Upgrade files of an older schema; the settings are migrated in memory
+	// first, so that a file that cannot be written is still usable
+	migrated := migrate()
+
 	// Unmarshal config
 	cfg = &Config{}
 	if err := viper.Unmarshal(cfg); err != nil {
 		return fmt.Errorf("failed to unmarshal config: %w", err)
 	}
 
+	if migrated {
+		return saveMigrated()
+	}
 	return nil
 }
 
+// EnvVarName returns the environment variable name that overrides the given config key
+func EnvVarName(key string) string {
+	return EnvPrefix + "_" + strings.ToUpper(strings.ReplaceAll(key, ".", "_"))
+}
+
+// lookupEnv returns the environment override for a config key, or "" if unset.
+// Overrides are resolved here rather than through viper.AutomaticEnv so that
+// Save never writes injected secrets back into the config file.
+func lookupEnv(key string) string {
+	return strings.TrimSpace(os.Getenv(EnvVarName(key)))
+}
+
 // createDefaultConfig creates a default configuration file
 func createDefaultConfig() error {
 	configFile := filepath.Join(configPath, ConfigFileName+"."+ConfigFileType)
@@ -111,6 +139,9 @@ func createDefaultConfig() error {
 	defaultConfig := `# Warp CLI Configuration
 # Warp CLI 설정 파일
 
+# 설정 파일 형식 버전 (자동 관리, 수정하지 마세요)
+version: ` + strconv.Itoa(CurrentVersion) + `
+
 # 법령 정보 API 설정
 law:
   # 기본 API 키 (NLIC와 호환)
@@ -127,6 +158,27 @@ law:
     # API 인증키
     # https://www.elis.go.kr 에서 발급
     key: ""
+
+# 검색 기록 (warp history)
+# history:
+#   size: 50   # 보관할 최근 검색 수
+
+# 검색 캐시 (warp law 검색, warp prefetch)
+# cache:
+#   ttl: 1h    # 캐시 유효 시간 (0: 캐시 끔)
+
+# 로그 파일 (--log-file로도 지정, 크기를 넘으면 회전하고 백업 3개 보관)
+# log:
+#   file: warp.log   # 상대 경로는 명령을 실행한 디렉토리 기준
+#   maxsize: 10MB    # 회전 크기 (예: 512KB, 20MB)
+#   format: text     # json: 한 줄에 하나씩 JSON으로 기록 (로그 수집용)
+
+# 프로파일 (선택): --profile <이름> 또는 WARP_PROFILE 환경변수로 선택
+# 프로파일에 없는 값은 위의 기본 설정을 사용합니다
+# profiles:
+#   work:
+#     law:
+#       key: ""
 `
 
 	// Write default config
@@ -139,14 +191,44 @@ law:
 
 // Get returns a configuration value by key
 func Get(key string) interface{} {
+	if v := lookupEnv(key); v != "" {
+		return v
+	}
 	return viper.Get(key)
 }
 
 // GetString returns a string configuration value by key
 func GetString(key string) string {
+	if v := lookupEnv(key); v != "" {
+		return v
+	}
 	return viper.GetString(key)
 }
 
+// Sources of a configuration value, reported by Source
+const (
+	SourceEnv     = "env"
+	SourceProfile = "profile"
+	SourceFile    = "file"
+	SourceDefault = "default"
+)
+
+// Source returns where the value of key comes from: its environment
+// variable, the active profile, the config file, or the default. Profiles
+// only hold the API keys (law.*).
+func Source(key string) string {
+	switch {
+	case lookupEnv(key) != "":
+		return SourceEnv
+	case strings.HasPrefix(key, "law.") && profileString(key) != "":
+		return SourceProfile
+	case viper.InConfig(key) && strings.TrimSpace(viper.GetString(key)) != "":
+		return SourceFile
+	default:
+		return SourceDefault
+	}
+}
+
 // Set sets a configuration value
 func Set(key string, value interface{}) {
 	viper.Set(key, value)
@@ -159,27 +241,19 @@ func Save() error {
 
 // GetAPIKey returns the configured API key (backward compatibility - returns NLIC key)
 func GetAPIKey() string {
-	if cfg == nil {
-		return ""
-	}
-	// First check NLIC-specific key
-	if cfg.Law.NLIC.Key != "" {
-		return cfg.Law.NLIC.Key
-	}
-	// Fall back to legacy key
-	return cfg.Law.Key
+	return GetNLICAPIKey()
 }
 
 // SetAPIKey sets the API key and saves the configuration (backward compatibility - sets NLIC key)
 func SetAPIKey(key string) error {
 	// Set both legacy and NLIC keys for compatibility
-	Set("law.key", key)
-	Set("law.nlic.key", key)
+	Set(scopedKey("law.key"), key)
+	Set(scopedKey("law.nlic.key"), key)
 	if err := Save(); err != nil {
 		return fmt.Errorf("failed to save config: %w", err)
 	}
 	// Update in-memory config
-	if cfg != nil {
+	if cfg != nil && GetActiveProfile() == "" {
 		cfg.Law.Key = key
 		cfg.Law.NLIC.Key = key
 	}
@@ -192,8 +266,21 @@ func IsAPIKeySet() bool {
 	return key != ""
 }
 
-// GetNLICAPIKey returns the NLIC API key
+// GetNLICAPIKey returns the NLIC API key.
+// Lookup order: environment variables, active profile, then top-level config.
 func GetNLICAPIKey() string {
+	if key := lookupEnv("law.nlic.key"); key != "" {
+		return key
+	}
+	if key := lookupEnv("law.key"); key != "" {
+		return key
+	}
+	if key := profileString("law.nlic.key"); key != "" {
+		return key
+	}
+	if key := profileString("law.key"); key != "" {
+		return key
+	}
 	if cfg == nil {
 		return ""
 	}
@@ -207,16 +294,16 @@ func GetNLICAPIKey() string {
 
 // SetNLICAPIKey sets the NLIC API key
 func SetNLICAPIKey(key string) error {
-	Set("law.nlic.key", key)
+	Set(scopedKey("law.nlic.key"), key)
 	// Also set legacy key for backward compatibility if it's empty
-	if GetString("law.key") == "" {
-		Set("law.key", key)
+	if viper.GetString(scopedKey("law.key")) == "" {
+		Set(scopedKey("law.key"), key)
 	}
 	if err := Save(); err != nil {
 		return fmt.Errorf("failed to save config: %w", err)
 	}
 	// Update in-memory config
-	if cfg != nil {
+	if cfg != nil && GetActiveProfile() == "" {
 		cfg.Law.NLIC.Key = key
 		if cfg.Law.Key == "" {
 			cfg.Law.Key = key
@@ -231,8 +318,15 @@ func IsNLICAPIKeySet() bool {
 	return key != ""
 }
 
-// GetELISAPIKey returns the ELIS API key
+// GetELISAPIKey returns the ELIS API key.
+// Lookup order: environment variables, active profile, then top-level config.
 func GetELISAPIKey() string {
+	if key := lookupEnv("law.elis.key"); key != "" {
+		return key
+	}
+	if key := profileString("law.elis.key"); key != "" {
+		return key
+	}
 	if cfg == nil {
 		return ""
 	}
@@ -241,12 +335,12 @@ func GetELISAPIKey() string {
 
 // SetELISAPIKey sets the ELIS API key
 func SetELISAPIKey(key string) error {
-	Set("law.elis.key", key)
+	Set(scopedKey("law.elis.key"), key)
 	if err := Save(); err != nil {
 		return fmt.Errorf("failed to save config: %w", err)
 	}
 	// Update in-memory config
-	if cfg != nil {
+	if cfg != nil && GetActiveProfile() == "" {
 		cfg.Law.ELIS.Key = key
 	}
 	return nil
@@ -258,6 +352,39 @@ func IsELISAPIKeySet() bool {
 	return key != ""
 }
 
+// GetConfigDir returns the configuration directory, or an empty string
+// if the configuration has not been initialized
+func GetConfigDir() string {
+	return configPath
+}
+
+// LocaleDirName is the directory of the config directory holding the
+// external translations
+const LocaleDirName = "locales"
+
+// LocaleDir returns the directory of the external translations. It is used
+// before Initialize, when the messages are loaded, so it falls back to the
+// default config directory.
+func LocaleDir() string {
+	dir := configPath
+	if dir == "" {
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			return ""
+		}
+		dir = filepath.Join(homeDir, ".pyhub", "warp")
+	}
+	return filepath.Join(dir, LocaleDirName)
+}
+
+// GetHistorySize returns the number of searches to keep in the history
+func GetHistorySize() int {
+	if size, err := strconv.Atoi(GetString("history.size")); err == nil && size > 0 {
+		return size
+	}
+	return DefaultHistorySize
+}
+
 // GetConfigPath returns the configuration file path
 func GetConfigPath() string {
 	return filepath.Join(configPath, ConfigFileName+"."+ConfigFileType)
@@ -129,6 +129,113 @@ func TestGetAPIKey(t *testing.T) {
 	}
 }
 
+func TestEnvOverride(t *testing.T) {
+	tests := []struct {
+		name     string
+		env      map[string]string
+		fileKey  string
+		wantNLIC string
+		wantELIS string
+	}{
+		{
+			name:     "No config file, legacy env key",
+			env:      map[string]string{"WARP_LAW_KEY": "env-legacy"},
+			wantNLIC: "env-legacy",
+		},
+		{
+			name:     "No config file, per-source env keys",
+			env:      map[string]string{"WARP_LAW_NLIC_KEY": "env-nlic", "WARP_LAW_ELIS_KEY": "env-elis"},
+			wantNLIC: "env-nlic",
+			wantELIS: "env-elis",
+		},
+		{
+			name:     "Env overrides file value",
+			env:      map[string]string{"WARP_LAW_NLIC_KEY": "env-nlic"},
+			fileKey:  "file-key",
+			wantNLIC: "env-nlic",
+		},
+		{
+			name:     "NLIC env key wins over legacy env key",
+			env:      map[string]string{"WARP_LAW_KEY": "env-legacy", "WARP_LAW_NLIC_KEY": "env-nlic"},
+			wantNLIC: "env-nlic",
+		},
+		{
+			name:     "File value used without env",
+			fileKey:  "file-key",
+			wantNLIC: "file-key",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, name := range []string{"WARP_LAW_KEY", "WARP_LAW_NLIC_KEY", "WARP_LAW_ELIS_KEY"} {
+				t.Setenv(name, tt.env[name])
+			}
+
+			ResetConfig()
+			if tt.fileKey != "" {
+				cfg = &Config{}
+				cfg.Law.Key = tt.fileKey
+			}
+
+			if got := GetAPIKey(); got != tt.wantNLIC {
+				t.Errorf("GetAPIKey() = %q, want %q", got, tt.wantNLIC)
+			}
+			if got := GetNLICAPIKey(); got != tt.wantNLIC {
+				t.Errorf("GetNLICAPIKey() = %q, want %q", got, tt.wantNLIC)
+			}
+			if got := GetELISAPIKey(); got != tt.wantELIS {
+				t.Errorf("GetELISAPIKey() = %q, want %q", got, tt.wantELIS)
+			}
+			if got := IsAPIKeySet(); got != (tt.wantNLIC != "") {
+				t.Errorf("IsAPIKeySet() = %v, want %v", got, tt.wantNLIC != "")
+			}
+		})
+	}
+}
+
+func TestEnvOverride_NotPersisted(t *testing.T) {
+	tempDir, cleanup := testutil.CreateTempDir(t, "warp-config-test-*")
+	defer cleanup()
+
+	t.Setenv("WARP_LAW_ELIS_KEY", "env-secret")
+
+	ResetConfig()
+	SetTestConfigPath(tempDir)
+	if err := Initialize(); err != nil {
+		t.Fatalf("Failed to initialize config: %v", err)
+	}
+
+	if got := GetString("law.elis.key"); got != "env-secret" {
+		t.Errorf("GetString() = %q, want %q", got, "env-secret")
+	}
+
+	// Saving other settings must not write the injected key to disk
+	if err := SetAPIKey("file-key"); err != nil {
+		t.Fatalf("SetAPIKey() error = %v", err)
+	}
+	content, err := os.ReadFile(GetConfigPath())
+	if err != nil {
+		t.Fatalf("Failed to read config file: %v", err)
+	}
+	if contains(string(content), "env-secret") {
+		t.Error("Environment override should not be persisted to the config file")
+	}
+}
+
+func TestEnvVarName(t *testing.T) {
+	tests := map[string]string{
+		"law.key":      "WARP_LAW_KEY",
+		"law.nlic.key": "WARP_LAW_NLIC_KEY",
+		"law.elis.key": "WARP_LAW_ELIS_KEY",
+	}
+	for key, want := range tests {
+		if got := EnvVarName(key); got != want {
+			t.Errorf("EnvVarName(%q) = %q, want %q", key, got, want)
+		}
+	}
+}
+
 func TestSetAPIKey(t *testing.T) {
 	tempDir, cleanup := testutil.CreateTempDir(t, "warp-config-test-*")
 	defer cleanup()
@@ -387,6 +494,131 @@ func TestCreateDefaultConfig(t *testing.T) {
 	}
 }
 
+func TestInitialize_MigratesLegacyConfig(t *testing.T) {
+	tempDir, cleanup := testutil.CreateTempDir(t, "warp-config-test-*")
+	defer cleanup()
+	t.Setenv("WARP_LAW_KEY", "")
+	t.Setenv("WARP_LAW_NLIC_KEY", "")
+
+	ResetConfig()
+	SetTestConfigPath(tempDir)
+
+	// A file of the flat schema, before the version field and law.nlic.key
+	configFile := filepath.Join(tempDir, ConfigFileName+"."+ConfigFileType)
+	legacy := `law:
+  key: "legacy-key"
+  elis:
+    key: "elis-key"
+profiles:
+  work:
+    law:
+      key: "work-key"
+  dev:
+    law:
+      key: "dev-legacy"
+      nlic:
+        key: "dev-nlic"
+`
+	if err := os.WriteFile(configFile, []byte(legacy), 0600); err != nil {
+		t.Fatalf("Failed to create test config file: %v", err)
+	}
+
+	if err := Initialize(); err != nil {
+		t.Fatalf("Initialize() error = %v", err)
+	}
+
+	// The original is kept as a backup
+	backup, err := os.ReadFile(configFile + BackupSuffix)
+	if err != nil || string(backup) != legacy {
+		t.Errorf("Backup = %q, %v, want the original file", backup, err)
+	}
+
+	// The saved file carries the keys under law.nlic.key and the current version
+	ResetConfig()
+	SetTestConfigPath(tempDir)
+	viper.SetConfigFile(configFile)
+	if err := viper.ReadInConfig(); err != nil {
+		t.Fatalf("Failed to read migrated config: %v", err)
+	}
+	checks := map[string]string{
+		"version":                    "2",
+		"law.nlic.key":               "legacy-key",
+		"law.key":                    "legacy-key",
+		"law.elis.key":               "elis-key",
+		"profiles.work.law.nlic.key": "work-key",
+		"profiles.dev.law.nlic.key":  "dev-nlic",
+	}
+	for key, want := range checks {
+		if got := viper.GetString(key); got != want {
+			t.Errorf("%s = %q, want %q", key, got, want)
+		}
+	}
+}
+
+func TestInitialize_MigrationIsIdempotent(t *testing.T) {
+	tempDir, cleanup := testutil.CreateTempDir(t, "warp-config-test-*")
+	defer cleanup()
+	t.Setenv("WARP_LAW_KEY", "")
+	t.Setenv("WARP_LAW_NLIC_KEY", "")
+
+	configFile := filepath.Join(tempDir, ConfigFileName+"."+ConfigFileType)
+	if err := os.WriteFile(configFile, []byte("law:\n  key: \"legacy-key\"\n"), 0600); err != nil {
+		t.Fatalf("Failed to create test config file: %v", err)
+	}
+
+	initialize := func() {
+		t.Helper()
+		ResetConfig()
+		SetTestConfigPath(tempDir)
+		if err := Initialize(); err != nil {
+			t.Fatalf("Initialize() error = %v", err)
+		}
+	}
+	initialize()
+	migrated, err := os.ReadFile(configFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Remove(configFile + BackupSuffix); err != nil {
+		t.Fatalf("Migration should have written a backup: %v", err)
+	}
+
+	// A file of the current version is neither rewritten nor backed up again
+	initialize()
+	again, err := os.ReadFile(configFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(again) != string(migrated) {
+		t.Errorf("Config was rewritten:\n%s\nwant:\n%s", again, migrated)
+	}
+	if _, err := os.Stat(configFile + BackupSuffix); !os.IsNotExist(err) {
+		t.Errorf("A current config should not be backed up, stat error = %v", err)
+	}
+	if GetNLICAPIKey() != "legacy-key" || cfg.Version != CurrentVersion {
+		t.Errorf("GetNLICAPIKey() = %q, Version = %d", GetNLICAPIKey(), cfg.Version)
+	}
+}
+
+func TestInitialize_DefaultConfigIsCurrent(t *testing.T) {
+	tempDir, cleanup := testutil.CreateTempDir(t, "warp-config-test-*")
+	defer cleanup()
+
+	ResetConfig()
+	SetTestConfigPath(tempDir)
+	if err := Initialize(); err != nil {
+		t.Fatalf("Initialize() error = %v", err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d", cfg.Version, CurrentVersion)
+	}
+	configFile := filepath.Join(tempDir, ConfigFileName+"."+ConfigFileType)
+	if _, err := os.Stat(configFile + BackupSuffix); !os.IsNotExist(err) {
+		t.Errorf("A new config should not be migrated, stat error = %v", err)
+	}
+}
+
 // Helper function
 func contains(s, substr string) bool {
 	return len(s) >= len(substr) && (s == substr || len(s) > 0 && (s[0:len(substr)] == substr || contains(s[1:], substr)))
@@ -0,0 +1,100 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	// VersionKey holds the schema version of the config file
+	VersionKey = "version"
+	// CurrentVersion is the schema version written by this release
+	CurrentVersion = 2
+	// legacyVersion is the version of config files written before the
+	// version field, which kept the NLIC API key in the flat law.key
+	legacyVersion = 1
+	// BackupSuffix is appended to the config file name for the copy kept
+	// before a migration
+	BackupSuffix = ".bak"
+)
+
+// migrations upgrade the settings from the version they are keyed by to the next
+var migrations = map[int]func(){
+	1: migrateLegacyKey,
+}
+
+// configVersion returns the schema version of the loaded settings
+func configVersion() int {
+	if version := viper.GetInt(VersionKey); version > 0 {
+		return version
+	}
+	return legacyVersion
+}
+
+// migrate upgrades the loaded settings to CurrentVersion in place and reports
+// whether anything changed. Settings already at CurrentVersion (or written by
+// a newer release) are left alone.
+func migrate() bool {
+	version := configVersion()
+	if version >= CurrentVersion {
+		return false
+	}
+	for ; version < CurrentVersion; version++ {
+		if step, ok := migrations[version]; ok {
+			step()
+		}
+	}
+	mergeSetting([]string{VersionKey}, CurrentVersion)
+	return true
+}
+
+// migrateLegacyKey copies the legacy law.key to law.nlic.key, at the top level
+// and in each profile, unless law.nlic.key is already set. law.key is kept
+// so that older releases sharing the file still find the key.
+func migrateLegacyKey() {
+	scopes := [][]string{nil}
+	for _, name := range ListProfiles() {
+		scopes = append(scopes, []string{profilesKey, name})
+	}
+	for _, scope := range scopes {
+		prefix := ""
+		if len(scope) > 0 {
+			prefix = strings.Join(scope, ".") + "."
+		}
+		legacy := viper.GetString(prefix + "law.key")
+		if legacy != "" && viper.GetString(prefix+"law.nlic.key") == "" {
+			mergeSetting(append(scope, "law", "nlic", "key"), legacy)
+		}
+	}
+}
+
+// mergeSetting sets the value at path in the settings read from the file.
+// Unlike viper.Set, which overrides a whole section when it sets one of its
+// keys, the other keys of the sections on the path stay visible.
+func mergeSetting(path []string, value interface{}) {
+	var setting interface{} = value
+	for i := len(path) - 1; i >= 0; i-- {
+		setting = map[string]interface{}{path[i]: setting}
+	}
+	// MergeConfigMap only fails for values that are not maps
+	_ = viper.MergeConfigMap(setting.(map[string]interface{}))
+}
+
+// saveMigrated copies the config file to its backup and writes the migrated settings
+func saveMigrated() error {
+	path := GetConfigPath()
+	original, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read config for backup: %w", err)
+	}
+	if err := os.WriteFile(path+BackupSuffix, original, 0600); err != nil {
+		return fmt.Errorf("failed to back up config: %w", err)
+	}
+	if err := Save(); err != nil {
+		return fmt.Errorf("failed to save migrated config: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	// ProfileEnvVar selects the active profile when --profile is not given
+	ProfileEnvVar = EnvPrefix + "_PROFILE"
+	// profileKey stores the default profile chosen with 'warp config profile use'
+	profileKey = "profile"
+	// profilesKey is the config section holding named profiles (profiles.<name>.law.*)
+	profilesKey = "profiles"
+)
+
+// profileOverride holds the profile selected on the command line
+var profileOverride string
+
+// SetProfile selects the active profile, taking precedence over WARP_PROFILE and the config file
+func SetProfile(name string) {
+	profileOverride = strings.ToLower(strings.TrimSpace(name))
+}
+
+// GetActiveProfile returns the active profile name, or "" when the top-level settings are used.
+// Precedence: --profile flag, WARP_PROFILE, then the profile saved in the config file.
+func GetActiveProfile() string {
+	if profileOverride != "" {
+		return profileOverride
+	}
+	if name := strings.TrimSpace(os.Getenv(ProfileEnvVar)); name != "" {
+		return strings.ToLower(name)
+	}
+	return strings.ToLower(strings.TrimSpace(viper.GetString(profileKey)))
+}
+
+// ListProfiles returns the names of the profiles defined in the config file, sorted
+func ListProfiles() []string {
+	profiles := viper.GetStringMap(profilesKey)
+	names := make([]string, 0, len(profiles))
+	for name := range profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// HasProfile reports whether a profile with the given name is defined
+func HasProfile(name string) bool {
+	return viper.IsSet(profilesKey + "." + strings.ToLower(strings.TrimSpace(name)))
+}
+
+// UseProfile saves the given profile as the default profile.
+// An empty name switches back to the top-level settings.
+func UseProfile(name string) error {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name != "" && !HasProfile(name) {
+		return fmt.Errorf("profile not found: %s", name)
+	}
+	Set(profileKey, name)
+	if err := Save(); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+	return nil
+}
+
+// scopedKey returns the config key inside the active profile, or key itself without a profile
+func scopedKey(key string) string {
+	if profile := GetActiveProfile(); profile != "" {
+		return profilesKey + "." + profile + "." + key
+	}
+	return key
+}
+
+// profileString returns a value from the active profile, or "" without a profile
+func profileString(key string) string {
+	profile := GetActiveProfile()
+	if profile == "" {
+		return ""
+	}
+	return strings.TrimSpace(viper.GetString(profilesKey + "." + profile + "." + key))
+}
@@ -0,0 +1,135 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/testutil"
+	"github.com/spf13/viper"
+)
+
+// setupProfileConfig writes a config file with top-level and profile keys and initializes it
+func setupProfileConfig(t *testing.T) {
+	t.Helper()
+
+	tempDir, cleanup := testutil.CreateTempDir(t, "warp-profile-test-*")
+	t.Cleanup(cleanup)
+
+	for _, name := range []string{"WARP_LAW_KEY", "WARP_LAW_NLIC_KEY", "WARP_LAW_ELIS_KEY", ProfileEnvVar} {
+		t.Setenv(name, "")
+	}
+
+	ResetConfig()
+	SetTestConfigPath(tempDir)
+
+	content := `law:
+  key: "default-key"
+  elis:
+    key: "default-elis"
+profiles:
+  work:
+    law:
+      key: "work-key"
+  dev:
+    law:
+      nlic:
+        key: "dev-nlic"
+      elis:
+        key: "dev-elis"
+`
+	configFile := filepath.Join(tempDir, ConfigFileName+"."+ConfigFileType)
+	if err := os.WriteFile(configFile, []byte(content), 0600); err != nil {
+		t.Fatalf("Failed to create test config file: %v", err)
+	}
+	if err := Initialize(); err != nil {
+		t.Fatalf("Failed to initialize config: %v", err)
+	}
+}
+
+func TestProfileLookup(t *testing.T) {
+	tests := []struct {
+		name     string
+		profile  string
+		envVar   string
+		wantNLIC string
+		wantELIS string
+	}{
+		{"No profile uses top-level", "", "", "default-key", "default-elis"},
+		{"Profile overrides key, falls back for ELIS", "work", "", "work-key", "default-elis"},
+		{"Profile overrides both keys", "dev", "", "dev-nlic", "dev-elis"},
+		{"Unknown profile falls back to top-level", "missing", "", "default-key", "default-elis"},
+		{"WARP_PROFILE selects profile", "", "work", "work-key", "default-elis"},
+		{"Flag wins over WARP_PROFILE", "dev", "work", "dev-nlic", "dev-elis"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setupProfileConfig(t)
+			t.Setenv(ProfileEnvVar, tt.envVar)
+			SetProfile(tt.profile)
+
+			if got := GetAPIKey(); got != tt.wantNLIC {
+				t.Errorf("GetAPIKey() = %q, want %q", got, tt.wantNLIC)
+			}
+			if got := GetELISAPIKey(); got != tt.wantELIS {
+				t.Errorf("GetELISAPIKey() = %q, want %q", got, tt.wantELIS)
+			}
+		})
+	}
+}
+
+func TestListProfiles(t *testing.T) {
+	setupProfileConfig(t)
+
+	want := []string{"dev", "work"}
+	if got := ListProfiles(); !reflect.DeepEqual(got, want) {
+		t.Errorf("ListProfiles() = %v, want %v", got, want)
+	}
+}
+
+func TestUseProfile(t *testing.T) {
+	setupProfileConfig(t)
+
+	if err := UseProfile("missing"); err == nil {
+		t.Error("UseProfile() should fail for an undefined profile")
+	}
+
+	if err := UseProfile("work"); err != nil {
+		t.Fatalf("UseProfile() error = %v", err)
+	}
+	if got := GetActiveProfile(); got != "work" {
+		t.Errorf("GetActiveProfile() = %q, want %q", got, "work")
+	}
+
+	// The default profile must survive a reload
+	path := configPath
+	ResetConfig()
+	SetTestConfigPath(path)
+	if err := Initialize(); err != nil {
+		t.Fatalf("Failed to reinitialize config: %v", err)
+	}
+	if got := GetAPIKey(); got != "work-key" {
+		t.Errorf("GetAPIKey() after reload = %q, want %q", got, "work-key")
+	}
+}
+
+func TestSetAPIKey_WithProfile(t *testing.T) {
+	setupProfileConfig(t)
+	SetProfile("work")
+
+	if err := SetAPIKey("new-work-key"); err != nil {
+		t.Fatalf("SetAPIKey() error = %v", err)
+	}
+
+	if got := viper.GetString("profiles.work.law.key"); got != "new-work-key" {
+		t.Errorf("profile key = %q, want %q", got, "new-work-key")
+	}
+	if got := viper.GetString("law.key"); got != "default-key" {
+		t.Errorf("top-level key should be unchanged, got %q", got)
+	}
+	if got := GetAPIKey(); got != "new-work-key" {
+		t.Errorf("GetAPIKey() = %q, want %q", got, "new-work-key")
+	}
+}
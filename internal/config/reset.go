@@ -0,0 +1,156 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// ResetBackupSuffix is appended to the config file name for the copy kept
+// before Reset. It differs from BackupSuffix, so that a reset keeps the
+// original of a migrated file.
+const ResetBackupSuffix = ".reset.bak"
+
+// Unset removes key from the config file and reports whether it held a
+// value. API keys (law.*) are removed from the active profile, like they are
+// set; unsetting one also removes the copy SetAPIKey and SetNLICAPIKey keep
+// in the other NLIC key. viper cannot delete a key, so the settings of the
+// file alone, without the defaults, are rebuilt without it, written and read
+// again.
+func Unset(key string) (bool, error) {
+	key = strings.ToLower(strings.TrimSpace(key))
+	keys := []string{key}
+	if strings.HasPrefix(key, "law.") {
+		legacy, nlic := viper.GetString(scopedKey("law.key")), viper.GetString(scopedKey("law.nlic.key"))
+		switch {
+		case key == "law.key":
+			keys = append(keys, "law.nlic.key")
+		case key == "law.nlic.key" && legacy == nlic:
+			keys = append(keys, "law.key")
+		}
+		for i := range keys {
+			keys[i] = scopedKey(keys[i])
+		}
+	}
+
+	settings, err := fileSettings()
+	if err != nil {
+		return false, err
+	}
+	removed := false
+	for _, k := range keys {
+		if deleteSetting(settings, strings.Split(k, ".")) {
+			removed = true
+		}
+	}
+	if !removed {
+		return false, nil
+	}
+	if err := writeSettings(settings); err != nil {
+		return false, err
+	}
+	return true, reload()
+}
+
+// Reset copies the config file to its ResetBackupSuffix backup and writes the
+// default config in its place. It returns the backup, or "" when there was
+// no file to keep.
+func Reset() (string, error) {
+	path := GetConfigPath()
+	backup := ""
+	original, err := os.ReadFile(path)
+	switch {
+	case err == nil:
+		backup = path + ResetBackupSuffix
+		if err := os.WriteFile(backup, original, 0600); err != nil {
+			return "", fmt.Errorf("failed to back up config: %w", err)
+		}
+	case !errors.Is(err, os.ErrNotExist):
+		return "", fmt.Errorf("failed to read config for backup: %w", err)
+	}
+
+	if err := createDefaultConfig(); err != nil {
+		return "", err
+	}
+	return backup, reload()
+}
+
+// deleteSetting removes the value at path from settings and reports whether
+// it held one; an empty string or section counts as no value. Sections left
+// empty are removed too.
+func deleteSetting(settings map[string]interface{}, path []string) bool {
+	value, ok := settings[path[0]]
+	if !ok {
+		return false
+	}
+	if len(path) == 1 {
+		delete(settings, path[0])
+		return !isEmptySetting(value)
+	}
+
+	section, ok := value.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	removed := deleteSetting(section, path[1:])
+	if len(section) == 0 {
+		delete(settings, path[0])
+	}
+	return removed
+}
+
+// isEmptySetting reports whether a setting holds no value
+func isEmptySetting(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return strings.TrimSpace(v) == ""
+	case map[string]interface{}:
+		return len(v) == 0
+	default:
+		return false
+	}
+}
+
+// fileSettings returns the settings of the config file, without the defaults
+// and the values set in memory
+func fileSettings() (map[string]interface{}, error) {
+	path := viper.ConfigFileUsed()
+	if path == "" {
+		path = GetConfigPath()
+	}
+	v := viper.New()
+	v.SetConfigFile(path)
+	v.SetConfigType(ConfigFileType)
+	if err := v.ReadInConfig(); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return map[string]interface{}{}, nil
+		}
+		return nil, fmt.Errorf("failed to read config: %w", err)
+	}
+	return v.AllSettings(), nil
+}
+
+// writeSettings replaces the config file with settings
+func writeSettings(settings map[string]interface{}) error {
+	v := viper.New()
+	v.SetConfigType(ConfigFileType)
+	if err := v.MergeConfigMap(settings); err != nil {
+		return fmt.Errorf("failed to rebuild config: %w", err)
+	}
+	if err := v.WriteConfigAs(GetConfigPath()); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+	return nil
+}
+
+// reload reads the config file again, dropping the settings kept in memory
+func reload() error {
+	cfg = nil
+	viper.Reset()
+	return Initialize()
+}
@@ -0,0 +1,135 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// readConfigFile returns the content of the config file
+func readConfigFile(t *testing.T) string {
+	t.Helper()
+	data, err := os.ReadFile(GetConfigPath())
+	if err != nil {
+		t.Fatalf("Failed to read config file: %v", err)
+	}
+	return string(data)
+}
+
+func TestUnset(t *testing.T) {
+	t.Run("top-level key", func(t *testing.T) {
+		setupProfileConfig(t)
+
+		removed, err := Unset("law.elis.key")
+		if err != nil || !removed {
+			t.Fatalf("Unset() = %v, %v; want true, nil", removed, err)
+		}
+		content := readConfigFile(t)
+		if strings.Contains(content, "default-elis") || !strings.Contains(content, "default-key") || !strings.Contains(content, "work-key") {
+			t.Errorf("only law.elis.key should leave the file:\n%s", content)
+		}
+		if got := GetELISAPIKey(); got != "" {
+			t.Errorf("GetELISAPIKey() = %q after unset", got)
+		}
+	})
+
+	t.Run("key of the active profile", func(t *testing.T) {
+		setupProfileConfig(t)
+		SetProfile("work")
+
+		if removed, err := Unset("law.key"); err != nil || !removed {
+			t.Fatalf("Unset() = %v, %v; want true, nil", removed, err)
+		}
+		content := readConfigFile(t)
+		if strings.Contains(content, "work-key") || !strings.Contains(content, "default-key") || !strings.Contains(content, "dev-nlic") {
+			t.Errorf("only the key of the work profile should leave the file:\n%s", content)
+		}
+		if got := GetAPIKey(); got != "default-key" {
+			t.Errorf("GetAPIKey() = %q, want the top-level key", got)
+		}
+		if got := ListProfiles(); !reflect.DeepEqual(got, []string{"dev"}) {
+			t.Errorf("ListProfiles() = %v, want the emptied work profile removed", got)
+		}
+	})
+
+	t.Run("NLIC key with its legacy copy", func(t *testing.T) {
+		setupProfileConfig(t)
+		if err := SetNLICAPIKey("nlic-key"); err != nil {
+			t.Fatal(err)
+		}
+		Set("law.key", "nlic-key")
+
+		if removed, err := Unset("law.nlic.key"); err != nil || !removed {
+			t.Fatalf("Unset() = %v, %v; want true, nil", removed, err)
+		}
+		if got := GetNLICAPIKey(); got != "" {
+			t.Errorf("GetNLICAPIKey() = %q, want the copy in law.key removed too", got)
+		}
+	})
+
+	t.Run("defaults stay out of the file", func(t *testing.T) {
+		setupProfileConfig(t)
+		// A file of the current schema, which the migration does not rewrite
+		content := fmt.Sprintf("version: %d\nlaw:\n  nlic:\n    key: nlic-key\n  elis:\n    key: elis-key\n", CurrentVersion)
+		if err := os.WriteFile(GetConfigPath(), []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+		if err := reload(); err != nil {
+			t.Fatal(err)
+		}
+
+		if removed, err := Unset("law.elis.key"); err != nil || !removed {
+			t.Fatalf("Unset() = %v, %v; want true, nil", removed, err)
+		}
+		if content := readConfigFile(t); strings.Contains(content, "history") || strings.Contains(content, "size") {
+			t.Errorf("the defaults should not be written to the file:\n%s", content)
+		}
+		if got := Source("history.size"); got != SourceDefault {
+			t.Errorf("Source(history.size) = %q, want %q", got, SourceDefault)
+		}
+		if got := GetHistorySize(); got != DefaultHistorySize {
+			t.Errorf("GetHistorySize() = %d, want the default", got)
+		}
+	})
+
+	t.Run("key without a value", func(t *testing.T) {
+		setupProfileConfig(t)
+		before := readConfigFile(t)
+
+		for _, key := range []string{"api.timeout", "log.file"} {
+			removed, err := Unset(key)
+			if err != nil || removed {
+				t.Errorf("Unset(%q) = %v, %v; want false, nil", key, removed, err)
+			}
+		}
+		if after := readConfigFile(t); after != before {
+			t.Errorf("the file should not change:\n%s", after)
+		}
+	})
+}
+
+func TestReset(t *testing.T) {
+	setupProfileConfig(t)
+	original := readConfigFile(t)
+
+	backup, err := Reset()
+	if err != nil {
+		t.Fatalf("Reset() error = %v", err)
+	}
+	if backup != GetConfigPath()+ResetBackupSuffix {
+		t.Errorf("backup = %q", backup)
+	}
+	if data, err := os.ReadFile(backup); err != nil || string(data) != original {
+		t.Errorf("backup = %q, %v; want the original file", data, err)
+	}
+
+	content := readConfigFile(t)
+	if strings.Contains(content, "default-key") || !strings.Contains(content, "# Warp CLI Configuration") {
+		t.Errorf("the file should be the default config:\n%s", content)
+	}
+	if GetAPIKey() != "" || len(ListProfiles()) != 0 || GetHistorySize() != DefaultHistorySize {
+		t.Errorf("settings after reset: key %q, profiles %v, history %d", GetAPIKey(), ListProfiles(), GetHistorySize())
+	}
+}
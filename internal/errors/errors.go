@@ -12,6 +12,7 @@ const (
 	ErrCodeNetwork ErrorCode = "NET001"
 	ErrCodeTimeout ErrorCode = "NET002"
 	ErrCodeDNS     ErrorCode = "NET003"
+	ErrCodeOffline ErrorCode = "NET004"
 
 	// Authentication errors
 	ErrCodeNoAPIKey      ErrorCode = "AUTH001"
@@ -36,6 +37,9 @@ const (
 	// Validation errors
 	ErrCodeInvalidInput ErrorCode = "VAL001"
 	ErrCodeMissingParam ErrorCode = "VAL002"
+
+	// Result errors
+	ErrCodeNoResults ErrorCode = "RES001"
 )
 
 // CLIError represents a structured error with user-friendly information
@@ -125,6 +129,13 @@ var (
 		Message: "검색어를 입력해주세요",
 		Hint:    "예: warp law \"개인정보 보호법\"",
 	}
+
+	// Result errors
+	ErrNoResults = &CLIError{
+		Code:    ErrCodeNoResults,
+		Message: "검색 결과가 없습니다",
+		Hint:    "--fail-on-empty 없이 실행하면 결과가 없어도 정상 종료합니다",
+	}
 )
 
 // New creates a new CLIError with the given parameters
@@ -0,0 +1,70 @@
+package errors
+
+import (
+	"errors"
+	"strings"
+)
+
+// Exit codes of warp, so that scripts can tell why a command failed without
+// parsing its messages
+const (
+	ExitOK           = 0 // success, also for a search without results
+	ExitFailure      = 1 // any other error: API, parsing, configuration, ...
+	ExitNoResults    = 2 // a search without results, with --fail-on-empty
+	ExitAuth         = 3 // missing, invalid or expired API key
+	ExitNetwork      = 4 // server unreachable or request timed out
+	ExitInvalidInput = 5 // invalid query, arguments or flags
+)
+
+// ExitCode returns the exit code of err: ExitOK for nil, the code of the
+// category of its CLIError, and ExitFailure for errors without one.
+func ExitCode(err error) int {
+	if err == nil {
+		return ExitOK
+	}
+	var cliErr *CLIError
+	if !errors.As(err, &cliErr) {
+		return ExitFailure
+	}
+
+	code := string(cliErr.Code)
+	switch {
+	case cliErr.Code == ErrCodeNoResults:
+		return ExitNoResults
+	case strings.HasPrefix(code, "AUTH"):
+		return ExitAuth
+	case strings.HasPrefix(code, "NET"):
+		return ExitNetwork
+	case strings.HasPrefix(code, "VAL"):
+		return ExitInvalidInput
+	}
+	return ExitFailure
+}
+
+// displayedError is an error already shown to the user
+type displayedError struct {
+	err error
+}
+
+func (e *displayedError) Error() string {
+	return e.err.Error()
+}
+
+func (e *displayedError) Unwrap() error {
+	return e.err
+}
+
+// Displayed marks err as already shown to the user, e.g. by a setup guide, so
+// that it is not printed again. Its exit code is still that of err.
+func Displayed(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &displayedError{err: err}
+}
+
+// IsDisplayed reports whether err was marked by Displayed
+func IsDisplayed(err error) bool {
+	var displayed *displayedError
+	return errors.As(err, &displayed)
+}
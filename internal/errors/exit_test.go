@@ -0,0 +1,57 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestExitCode(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"no error", nil, ExitOK},
+		{"plain error", errors.New("boom"), ExitFailure},
+		{"no API key", ErrNoAPIKey, ExitAuth},
+		{"invalid API key", Wrap(errors.New("401"), ErrInvalidAPIKey), ExitAuth},
+		{"expired API key", New(ErrCodeExpiredAPIKey, "만료", ""), ExitAuth},
+		{"no network", ErrNoNetwork, ExitNetwork},
+		{"offline cache miss", New(ErrCodeOffline, "오프라인 모드: 캐시에 없음", ""), ExitNetwork},
+		{"timeout", ErrTimeout, ExitNetwork},
+		{"empty query", ErrEmptyQuery, ExitInvalidInput},
+		{"missing parameter", New(ErrCodeMissingParam, "누락", ""), ExitInvalidInput},
+		{"no results", ErrNoResults, ExitNoResults},
+		{"rate limit", ErrRateLimit, ExitFailure},
+		{"server error", ErrAPIServerError, ExitFailure},
+		{"parse error", ErrJSONParse, ExitFailure},
+		{"wrapped", fmt.Errorf("검색 실패: %w", ErrNoAPIKey), ExitAuth},
+		{"displayed", Displayed(ErrTimeout), ExitNetwork},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExitCode(tt.err); got != tt.want {
+				t.Errorf("ExitCode(%v) = %d, want %d", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDisplayed(t *testing.T) {
+	if Displayed(nil) != nil {
+		t.Error("Displayed(nil) should be nil")
+	}
+
+	err := Displayed(ErrNoAPIKey)
+	if !IsDisplayed(err) || !IsDisplayed(fmt.Errorf("wrapped: %w", err)) {
+		t.Error("IsDisplayed should report a displayed error")
+	}
+	if IsDisplayed(ErrNoAPIKey) {
+		t.Error("IsDisplayed should not report an undisplayed error")
+	}
+	if err.Error() != ErrNoAPIKey.Error() || !errors.Is(err, ErrNoAPIKey) {
+		t.Errorf("Displayed should keep the error, got %v", err)
+	}
+}
@@ -0,0 +1,224 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+// DefaultAttachmentConcurrency is the number of attachments downloaded at a time
+const DefaultAttachmentConcurrency = 3
+
+// AttachmentOptions controls how attachments are downloaded
+type AttachmentOptions struct {
+	// Concurrency is the maximum number of downloads at a time; at least 1
+	Concurrency int
+	// Client sends the requests; nil uses http.DefaultClient
+	Client *http.Client
+	// Timeout bounds each download; 0 means no limit besides the context
+	Timeout time.Duration
+	// Progress, if set, is called after each download with its result and
+	// the number of downloads finished so far. Calls do not overlap.
+	Progress func(result AttachmentResult, done, total int)
+}
+
+// AttachmentResult is the outcome of downloading one attachment
+type AttachmentResult struct {
+	Attachment api.Attachment
+	Path       string // where the file was saved
+	Size       int64  // bytes written
+	Err        error
+}
+
+// DownloadAttachments saves attachments in dir, creating dir if needed. Each
+// file is named after the Content-Disposition header of its response, or the
+// name of the attachment without it; a name already taken in dir gets a
+// number ("서식 (2).hwp"). A failed download records its error and the others
+// go on. Results keep the order of attachments.
+func DownloadAttachments(ctx context.Context, dir string, attachments []api.Attachment, opts AttachmentOptions) []AttachmentResult {
+	results := make([]AttachmentResult, len(attachments))
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		for i := range results {
+			results[i] = AttachmentResult{Attachment: attachments[i], Err: fmt.Errorf("디렉토리를 만들 수 없습니다: %w", err)}
+		}
+		return results
+	}
+
+	client := opts.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	names := &fileNames{dir: dir, taken: make(map[string]bool)}
+
+	var mu sync.Mutex
+	done := 0
+	errs := api.RunBatch(ctx, len(attachments), api.BatchOptions{Concurrency: opts.Concurrency}, func(ctx context.Context, i int) error {
+		if opts.Timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+			defer cancel()
+		}
+		result := AttachmentResult{Attachment: attachments[i]}
+		result.Path, result.Size, result.Err = downloadAttachment(ctx, client, names, attachments[i])
+
+		mu.Lock()
+		defer mu.Unlock()
+		results[i] = result
+		done++
+		if opts.Progress != nil {
+			opts.Progress(result, done, len(attachments))
+		}
+		return result.Err
+	})
+
+	// Attachments not started when ctx was done only have the context error
+	for i, err := range errs {
+		if results[i].Err == nil && results[i].Path == "" {
+			results[i] = AttachmentResult{Attachment: attachments[i], Err: err}
+		}
+	}
+	return results
+}
+
+// downloadAttachment saves one attachment under a name reserved in names and
+// returns its path and size. The file is written to a temporary file and
+// renamed, so a failed download leaves nothing behind.
+func downloadAttachment(ctx context.Context, client *http.Client, names *fileNames, attachment api.Attachment) (string, int64, error) {
+	if attachment.URL == "" {
+		return "", 0, fmt.Errorf("내려받기 URL이 없습니다")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, attachment.URL, nil)
+	if err != nil {
+		return "", 0, fmt.Errorf("잘못된 URL: %w", err)
+	}
+	req.Header.Set("User-Agent", api.UserAgent())
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", 0, fmt.Errorf("HTTP %d", resp.StatusCode)
+	}
+
+	tmp, err := os.CreateTemp(names.dir, ".download-*.tmp")
+	if err != nil {
+		return "", 0, fmt.Errorf("파일 저장 실패: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	size, err := io.Copy(tmp, resp.Body)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", 0, fmt.Errorf("내려받기 실패: %w", err)
+	}
+
+	path := names.reserve(AttachmentFileName(attachment, resp.Header))
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		names.release(path)
+		return "", 0, fmt.Errorf("파일 저장 실패 (%s): %w", path, err)
+	}
+	return path, size, nil
+}
+
+// AttachmentFileName returns the file name to save an attachment as: the
+// file name of the Content-Disposition header of its response if any, or
+// else the name of the attachment, or the last segment of its URL. A name
+// without an extension gets one of the Content-Type if it is known.
+func AttachmentFileName(attachment api.Attachment, header http.Header) string {
+	name := ""
+	if _, params, err := mime.ParseMediaType(header.Get("Content-Disposition")); err == nil {
+		// mime decodes filename* (RFC 5987) into filename
+		name = sanitizeFileName(params["filename"])
+	}
+	if name == "" {
+		name = sanitizeFileName(attachment.Name)
+	}
+	if name == "" {
+		if u, err := url.Parse(attachment.URL); err == nil {
+			name = sanitizeFileName(path.Base(u.Path))
+		}
+	}
+	if name == "" {
+		name = "attachment"
+	}
+
+	if filepath.Ext(name) == "" {
+		if mediaType, _, err := mime.ParseMediaType(header.Get("Content-Type")); err == nil {
+			if exts, _ := mime.ExtensionsByType(mediaType); len(exts) > 0 {
+				name += exts[0]
+			}
+		}
+	}
+	return name
+}
+
+// sanitizeFileName makes name safe as a file name on every platform: path
+// separators and reserved characters become "_", control characters are
+// dropped and "." or ".." yield ""
+func sanitizeFileName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		switch {
+		case r < 0x20 || r == 0x7f:
+			return -1
+		case strings.ContainsRune(`/\:*?"<>|`, r):
+			return '_'
+		}
+		return r
+	}, name)
+	name = strings.Trim(strings.TrimSpace(name), ".")
+	return name
+}
+
+// fileNames hands out names in a directory that are neither existing files
+// nor reserved by another download
+type fileNames struct {
+	mu    sync.Mutex
+	dir   string
+	taken map[string]bool
+}
+
+// reserve returns the path of name in the directory, numbered "name (2).ext",
+// "name (3).ext" and so on if it is taken
+func (n *fileNames) reserve(name string) string {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	ext := filepath.Ext(name)
+	base := strings.TrimSuffix(name, ext)
+	for i := 1; ; i++ {
+		candidate := name
+		if i > 1 {
+			candidate = fmt.Sprintf("%s (%d)%s", base, i, ext)
+		}
+		path := filepath.Join(n.dir, candidate)
+		if n.taken[path] {
+			continue
+		}
+		if _, err := os.Lstat(path); err == nil {
+			continue
+		}
+		n.taken[path] = true
+		return path
+	}
+}
+
+// release gives back a reserved path that was not used
+func (n *fileNames) release(path string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	delete(n.taken, path)
+}
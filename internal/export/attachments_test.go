@@ -0,0 +1,150 @@
+package export
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+func TestDownloadAttachments(t *testing.T) {
+	var inFlight, maxInFlight int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+
+		switch r.URL.Path {
+		case "/form":
+			w.Write([]byte("form " + r.URL.Query().Get("n")))
+		case "/disposition":
+			w.Header().Set("Content-Disposition", `attachment; filename="fallback.hwp"; filename*=UTF-8''%EB%B3%84%ED%91%9C1.hwp`)
+			w.Write([]byte("table"))
+		case "/pdf":
+			w.Header().Set("Content-Type", "application/pdf")
+			w.Write([]byte("%PDF"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	dir := filepath.Join(t.TempDir(), "files")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	// A file from before is kept and the download is numbered after it
+	if err := os.WriteFile(filepath.Join(dir, "서식.hwp"), []byte("old"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	attachments := []api.Attachment{
+		{Name: "서식.hwp", URL: server.URL + "/form?n=1"},
+		{Name: "서식.hwp", URL: server.URL + "/form?n=2"},
+		{Name: "별표 0001 (HWP)", URL: server.URL + "/disposition"},
+		{Name: "없는 파일.hwp", URL: server.URL + "/missing"},
+		{Name: "안내", URL: server.URL + "/pdf"},
+		{Name: "링크 없음.txt"},
+	}
+	var progress []int
+	results := DownloadAttachments(context.Background(), dir, attachments, AttachmentOptions{
+		Concurrency: 2,
+		Progress: func(result AttachmentResult, done, total int) {
+			if total != len(attachments) {
+				t.Errorf("Progress total = %d", total)
+			}
+			progress = append(progress, done)
+		},
+	})
+
+	if len(progress) != len(attachments) || progress[len(progress)-1] != len(attachments) {
+		t.Errorf("Progress calls = %v", progress)
+	}
+	if got := atomic.LoadInt32(&maxInFlight); got > 2 {
+		t.Errorf("%d downloads in flight, want at most 2", got)
+	}
+	for i, result := range results {
+		if result.Attachment != attachments[i] {
+			t.Errorf("result %d is for %+v, want %+v", i, result.Attachment, attachments[i])
+		}
+	}
+	for _, i := range []int{3, 5} {
+		if results[i].Err == nil {
+			t.Errorf("download of %s should fail", attachments[i].Name)
+		}
+	}
+	if results[2].Path != filepath.Join(dir, "별표1.hwp") || results[2].Size != 5 {
+		t.Errorf("Content-Disposition name not used: %+v", results[2])
+	}
+	if results[4].Path != filepath.Join(dir, "안내.pdf") {
+		t.Errorf("extension of the Content-Type not added: %+v", results[4])
+	}
+
+	// The two forms finish in either order, but both are kept next to the old file
+	var forms []string
+	for _, i := range []int{0, 1} {
+		if results[i].Err != nil {
+			t.Fatalf("download of form %d failed: %v", i, results[i].Err)
+		}
+		data, err := os.ReadFile(results[i].Path)
+		if err != nil || string(data) != "form "+strconv.Itoa(i+1) {
+			t.Errorf("form %d saved as %q: %v", i, data, err)
+		}
+		forms = append(forms, filepath.Base(results[i].Path))
+	}
+	sort.Strings(forms)
+	if forms[0] != "서식 (2).hwp" || forms[1] != "서식 (3).hwp" {
+		t.Errorf("numbered names = %v", forms)
+	}
+	if data, _ := os.ReadFile(filepath.Join(dir, "서식.hwp")); string(data) != "old" {
+		t.Errorf("existing file overwritten: %q", data)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 5 {
+		var names []string
+		for _, entry := range entries {
+			names = append(names, entry.Name())
+		}
+		t.Errorf("files in dir = %v, want the old file and 4 downloads without temporary files", names)
+	}
+}
+
+func TestAttachmentFileName(t *testing.T) {
+	tests := []struct {
+		name       string
+		attachment api.Attachment
+		header     http.Header
+		want       string
+	}{
+		{"attachment name", api.Attachment{Name: "서식 1.hwp"}, http.Header{}, "서식 1.hwp"},
+		{"disposition first", api.Attachment{Name: "a.hwp"}, http.Header{"Content-Disposition": {`attachment; filename="b.pdf"`}}, "b.pdf"},
+		{"separators", api.Attachment{Name: "../별표/1:2.hwp"}, http.Header{}, "_별표_1_2.hwp"},
+		{"url segment", api.Attachment{URL: "https://example.com/files/form.hwpx?x=1"}, http.Header{}, "form.hwpx"},
+		{"nothing", api.Attachment{Name: ".."}, http.Header{}, "attachment"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := AttachmentFileName(tt.attachment, tt.header); got != tt.want {
+				t.Errorf("AttachmentFileName() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
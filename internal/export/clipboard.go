@@ -0,0 +1,88 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// ClipboardTool is a command that copies its standard input to the clipboard
+type ClipboardTool struct {
+	Name string
+	Args []string
+}
+
+// String returns the command line of the tool
+func (t ClipboardTool) String() string {
+	return strings.Join(append([]string{t.Name}, t.Args...), " ")
+}
+
+// ErrNoClipboardTool is returned when none of the clipboard tools of the OS is installed
+var ErrNoClipboardTool = errors.New("클립보드 유틸리티를 찾을 수 없습니다")
+
+// ClipboardTools returns the clipboard tools of goos in the order they are
+// tried. On Linux wl-copy comes first in a Wayland session, where xclip only
+// reaches X applications.
+func ClipboardTools(goos string, getenv func(string) string) []ClipboardTool {
+	switch goos {
+	case "darwin":
+		return []ClipboardTool{{Name: "pbcopy"}}
+	case "windows":
+		return []ClipboardTool{{Name: "clip"}}
+	}
+
+	wayland := ClipboardTool{Name: "wl-copy"}
+	x11 := []ClipboardTool{
+		{Name: "xclip", Args: []string{"-selection", "clipboard"}},
+		{Name: "xsel", Args: []string{"--clipboard", "--input"}},
+	}
+	if getenv("WAYLAND_DISPLAY") != "" {
+		return append([]ClipboardTool{wayland}, x11...)
+	}
+	return append(x11, wayland)
+}
+
+// ClipboardInstallHint tells how to install a clipboard tool on goos
+func ClipboardInstallHint(goos string) string {
+	switch goos {
+	case "darwin":
+		return "pbcopy는 macOS에 기본으로 들어 있습니다. PATH에 /usr/bin이 있는지 확인하세요"
+	case "windows":
+		return "clip.exe는 Windows에 기본으로 들어 있습니다. PATH에 System32가 있는지 확인하세요"
+	default:
+		return "xclip(X11) 또는 wl-clipboard(Wayland)를 설치하세요. 예: sudo apt install xclip 또는 sudo apt install wl-clipboard"
+	}
+}
+
+// FindClipboardTool returns the first clipboard tool of goos that lookPath
+// finds, or an error wrapping ErrNoClipboardTool with the tools tried
+func FindClipboardTool(goos string, getenv func(string) string, lookPath func(string) (string, error)) (ClipboardTool, error) {
+	tools := ClipboardTools(goos, getenv)
+	names := make([]string, 0, len(tools))
+	for _, tool := range tools {
+		if path, err := lookPath(tool.Name); err == nil {
+			tool.Name = path
+			return tool, nil
+		}
+		names = append(names, tool.Name)
+	}
+	return ClipboardTool{}, fmt.Errorf("%w (찾은 명령 없음: %s)", ErrNoClipboardTool, strings.Join(names, ", "))
+}
+
+// Copy runs the tool with text as its standard input
+func (t ClipboardTool) Copy(ctx context.Context, text string) error {
+	cmd := exec.CommandContext(ctx, t.Name, t.Args...)
+	cmd.Stdin = strings.NewReader(text)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return fmt.Errorf("%s 실행 실패: %w: %s", t.Name, err, msg)
+		}
+		return fmt.Errorf("%s 실행 실패: %w", t.Name, err)
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package export
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestClipboardTools(t *testing.T) {
+	env := func(vars map[string]string) func(string) string {
+		return func(key string) string { return vars[key] }
+	}
+	tests := []struct {
+		name string
+		goos string
+		env  map[string]string
+		want []string
+	}{
+		{"macOS", "darwin", nil, []string{"pbcopy"}},
+		{"Windows", "windows", nil, []string{"clip"}},
+		{"Linux X11", "linux", nil, []string{"xclip -selection clipboard", "xsel --clipboard --input", "wl-copy"}},
+		{"Linux Wayland", "linux", map[string]string{"WAYLAND_DISPLAY": "wayland-0"}, []string{"wl-copy", "xclip -selection clipboard", "xsel --clipboard --input"}},
+		{"FreeBSD", "freebsd", nil, []string{"xclip -selection clipboard", "xsel --clipboard --input", "wl-copy"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, tool := range ClipboardTools(tt.goos, env(tt.env)) {
+				got = append(got, tool.String())
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ClipboardTools() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFindClipboardTool(t *testing.T) {
+	noEnv := func(string) string { return "" }
+	installed := func(names ...string) func(string) (string, error) {
+		return func(name string) (string, error) {
+			for _, n := range names {
+				if n == name {
+					return "/usr/bin/" + name, nil
+				}
+			}
+			return "", exec.ErrNotFound
+		}
+	}
+
+	tool, err := FindClipboardTool("linux", noEnv, installed("xsel", "wl-copy"))
+	if err != nil {
+		t.Fatalf("FindClipboardTool() error = %v", err)
+	}
+	if tool.String() != "/usr/bin/xsel --clipboard --input" {
+		t.Errorf("FindClipboardTool() = %q, want xsel, the first tool installed", tool)
+	}
+
+	_, err = FindClipboardTool("linux", noEnv, installed())
+	if !errors.Is(err, ErrNoClipboardTool) {
+		t.Fatalf("FindClipboardTool() without tools error = %v, want ErrNoClipboardTool", err)
+	}
+	if !strings.Contains(err.Error(), "xclip, xsel, wl-copy") {
+		t.Errorf("error should name the tools tried: %v", err)
+	}
+	if hint := ClipboardInstallHint("linux"); !strings.Contains(hint, "xclip") || !strings.Contains(hint, "wl-clipboard") {
+		t.Errorf("ClipboardInstallHint(linux) = %q", hint)
+	}
+}
+
+func TestClipboardToolCopy(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses sh")
+	}
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh not found")
+	}
+
+	path := filepath.Join(t.TempDir(), "clipboard")
+	tool := ClipboardTool{Name: "sh", Args: []string{"-c", `cat > "$0"`, path}}
+	if err := tool.Copy(context.Background(), "민법\n형법\n"); err != nil {
+		t.Fatalf("Copy() error = %v", err)
+	}
+	if got, _ := os.ReadFile(path); string(got) != "민법\n형법\n" {
+		t.Errorf("copied %q", got)
+	}
+
+	failing := ClipboardTool{Name: "sh", Args: []string{"-c", "echo 'no display' >&2; exit 1"}}
+	if err := failing.Copy(context.Background(), "민법"); err == nil || !strings.Contains(err.Error(), "no display") {
+		t.Errorf("Copy() error = %v, want the stderr of the tool", err)
+	}
+}
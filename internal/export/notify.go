@@ -0,0 +1,196 @@
+// Package export sends search results out of the terminal, e.g. as chat
+// messages to a team channel.
+package export
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+// Platform is a chat service that receives notifications through an incoming webhook
+type Platform string
+
+const (
+	// PlatformSlack posts to a Slack incoming webhook
+	PlatformSlack Platform = "slack"
+	// PlatformDiscord posts to a Discord webhook
+	PlatformDiscord Platform = "discord"
+)
+
+// DefaultMaxItems is the number of results listed in a notification
+const DefaultMaxItems = 10
+
+// maxMessageLength returns the message length limit of a platform in characters.
+// Discord rejects content over 2000 characters; Slack truncates long text, so
+// messages are kept short enough to be read in the channel.
+func (p Platform) maxMessageLength() int {
+	if p == PlatformDiscord {
+		return 2000
+	}
+	return 3000
+}
+
+// Sender posts a JSON payload to a webhook. It is an interface so that
+// notifications can be tested without network access.
+type Sender interface {
+	Send(ctx context.Context, webhookURL string, payload []byte) error
+}
+
+// HTTPSender posts payloads with an HTTP client
+type HTTPSender struct {
+	Client *http.Client
+}
+
+// Send posts payload to webhookURL and fails unless the webhook accepts it
+func (s *HTTPSender) Send(ctx context.Context, webhookURL string, payload []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhookURL, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("요청 생성 실패: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := s.Client
+	if client == nil {
+		client = &http.Client{Timeout: api.Timeout(), Transport: api.Transport()}
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		// The webhook URL is a secret, so it is left out of the error
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return fmt.Errorf("네트워크 에러: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("HTTP %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
+
+// Notifier sends a summary of search results to a webhook
+type Notifier struct {
+	Platform Platform
+	Webhook  string
+	Sender   Sender
+	// MaxItems is the number of results listed; the rest are counted as "...외 M건"
+	MaxItems int
+}
+
+// NewNotifier creates a notifier posting to webhook over HTTP
+func NewNotifier(platform Platform, webhook string) *Notifier {
+	return &Notifier{
+		Platform: platform,
+		Webhook:  webhook,
+		Sender:   &HTTPSender{},
+		MaxItems: DefaultMaxItems,
+	}
+}
+
+// Notify sends the summary of resp: the result count and the top results. The
+// query is read from the api.RequestContext of ctx when it has one.
+func (n *Notifier) Notify(ctx context.Context, resp *api.SearchResponse) error {
+	query := ""
+	if rc, ok := api.RequestContextFrom(ctx); ok {
+		query = rc.Query
+	}
+
+	payload, err := n.payload(Message(n.Platform, query, resp, n.MaxItems))
+	if err != nil {
+		return err
+	}
+	if err := n.Sender.Send(ctx, n.Webhook, payload); err != nil {
+		return fmt.Errorf("%s 알림 전송 실패: %w", n.Platform, err)
+	}
+	return nil
+}
+
+// payload wraps a message in the webhook body of the platform
+func (n *Notifier) payload(message string) ([]byte, error) {
+	switch n.Platform {
+	case PlatformSlack:
+		return json.Marshal(map[string]string{"text": message})
+	case PlatformDiscord:
+		return json.Marshal(map[string]string{"content": message})
+	default:
+		return nil, fmt.Errorf("지원하지 않는 알림 대상: %s (slack, discord 중 선택)", n.Platform)
+	}
+}
+
+// Message returns the notification text for resp: a title with the result
+// count and up to maxItems results. Results that are not listed, either past
+// maxItems or dropped to stay within the platform's length limit, are counted
+// in a "...외 M건" line.
+func Message(platform Platform, query string, resp *api.SearchResponse, maxItems int) string {
+	title := fmt.Sprintf("warp 검색 결과: 총 %d건", resp.TotalCount)
+	if query != "" {
+		title = fmt.Sprintf("warp 검색 결과: %s (총 %d건)", query, resp.TotalCount)
+	}
+	title = bold(platform, escape(platform, title))
+
+	items := make([]string, 0, len(resp.Laws))
+	for _, law := range resp.Laws {
+		if maxItems > 0 && len(items) == maxItems {
+			break
+		}
+		items = append(items, "• "+escape(platform, itemLabel(law)))
+	}
+
+	// Drop items from the end until the message fits
+	limit := platform.maxMessageLength()
+	for {
+		message := title
+		if len(items) > 0 {
+			message += "\n" + strings.Join(items, "\n")
+		}
+		if rest := resp.TotalCount - len(items); rest > 0 {
+			message += fmt.Sprintf("\n...외 %d건", rest)
+		}
+		if utf8.RuneCountInString(message) <= limit || len(items) == 0 {
+			return message
+		}
+		items = items[:len(items)-1]
+	}
+}
+
+// itemLabel returns "법령명 [법령구분] (시행 YYYY-MM-DD)"
+func itemLabel(law api.LawInfo) string {
+	label := strings.TrimSpace(law.Name)
+	if law.LawType != "" {
+		label += " [" + law.LawType + "]"
+	}
+	if date := law.EffectDate; len(date) == 8 {
+		label += fmt.Sprintf(" (시행 %s-%s-%s)", date[:4], date[4:6], date[6:])
+	}
+	return label
+}
+
+// bold marks text as bold in the message markup of the platform
+func bold(platform Platform, text string) string {
+	if platform == PlatformDiscord {
+		return "**" + text + "**"
+	}
+	return "*" + text + "*"
+}
+
+// escape keeps law names from being read as markup. Slack only requires &, <
+// and > to be escaped; Discord markdown characters are escaped with a backslash.
+func escape(platform Platform, text string) string {
+	if platform == PlatformDiscord {
+		return strings.NewReplacer(`\`, `\\`, "*", `\*`, "_", `\_`, "~", `\~`, "`", "\\`", "|", `\|`, ">", `\>`).Replace(text)
+	}
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(text)
+}
@@ -0,0 +1,177 @@
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+// fakeSender records the payloads it is asked to send
+type fakeSender struct {
+	webhook  string
+	payloads [][]byte
+	err      error
+}
+
+func (s *fakeSender) Send(ctx context.Context, webhookURL string, payload []byte) error {
+	s.webhook = webhookURL
+	s.payloads = append(s.payloads, payload)
+	return s.err
+}
+
+func notifyTestResponse(total, n int) *api.SearchResponse {
+	resp := &api.SearchResponse{TotalCount: total}
+	for i := 1; i <= n; i++ {
+		resp.Laws = append(resp.Laws, api.LawInfo{
+			Name:       fmt.Sprintf("테스트법 %d", i),
+			LawType:    "법률",
+			EffectDate: "20240701",
+		})
+	}
+	return resp
+}
+
+func TestMessage(t *testing.T) {
+	msg := Message(PlatformSlack, "개인정보", notifyTestResponse(25, 5), 3)
+
+	lines := strings.Split(msg, "\n")
+	want := []string{
+		"*warp 검색 결과: 개인정보 (총 25건)*",
+		"• 테스트법 1 [법률] (시행 2024-07-01)",
+		"• 테스트법 2 [법률] (시행 2024-07-01)",
+		"• 테스트법 3 [법률] (시행 2024-07-01)",
+		"...외 22건",
+	}
+	if strings.Join(lines, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Message() =\n%s\nwant\n%s", msg, strings.Join(want, "\n"))
+	}
+
+	// Without a query and with every result listed there is no "...외" line
+	msg = Message(PlatformDiscord, "", notifyTestResponse(2, 2), 10)
+	if !strings.HasPrefix(msg, "**warp 검색 결과: 총 2건**\n") || strings.Contains(msg, "외") {
+		t.Errorf("unexpected message:\n%s", msg)
+	}
+}
+
+func TestMessageLengthLimit(t *testing.T) {
+	resp := notifyTestResponse(100, 100)
+	for i := range resp.Laws {
+		resp.Laws[i].Name = strings.Repeat("긴법령명", 20) + fmt.Sprint(i)
+	}
+
+	msg := Message(PlatformDiscord, "민법", resp, 100)
+	if n := utf8.RuneCountInString(msg); n > 2000 {
+		t.Fatalf("discord message has %d characters, want at most 2000", n)
+	}
+
+	listed := strings.Count(msg, "• ")
+	if listed == 0 || listed == 100 {
+		t.Fatalf("expected some but not all items to be listed, got %d", listed)
+	}
+	if want := fmt.Sprintf("...외 %d건", 100-listed); !strings.HasSuffix(msg, want) {
+		t.Errorf("message should end with %q:\n%s", want, msg)
+	}
+}
+
+func TestMessageEscape(t *testing.T) {
+	resp := &api.SearchResponse{TotalCount: 1, Laws: []api.LawInfo{{Name: "A&B <특별법> *시행*"}}}
+
+	if msg := Message(PlatformSlack, "", resp, 1); !strings.Contains(msg, "A&amp;B &lt;특별법&gt; *시행*") {
+		t.Errorf("slack message should escape &, < and >:\n%s", msg)
+	}
+	if msg := Message(PlatformDiscord, "", resp, 1); !strings.Contains(msg, `A&B <특별법\> \*시행\*`) {
+		t.Errorf("discord message should escape markdown:\n%s", msg)
+	}
+}
+
+func TestNotifierNotify(t *testing.T) {
+	ctx := api.WithRequestContext(context.Background(), api.NewRequestContext("개인정보", "", "table", 1, 10))
+
+	tests := []struct {
+		platform Platform
+		key      string
+	}{
+		{PlatformSlack, "text"},
+		{PlatformDiscord, "content"},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.platform), func(t *testing.T) {
+			sender := &fakeSender{}
+			notifier := NewNotifier(tt.platform, "https://hooks.example.com/abc")
+			notifier.Sender = sender
+
+			if err := notifier.Notify(ctx, notifyTestResponse(1, 1)); err != nil {
+				t.Fatalf("Notify() error = %v", err)
+			}
+			if sender.webhook != "https://hooks.example.com/abc" || len(sender.payloads) != 1 {
+				t.Fatalf("expected one payload to the webhook, got %d to %q", len(sender.payloads), sender.webhook)
+			}
+
+			var body map[string]string
+			if err := json.Unmarshal(sender.payloads[0], &body); err != nil {
+				t.Fatalf("payload is not JSON: %v", err)
+			}
+			if !strings.Contains(body[tt.key], "개인정보 (총 1건)") {
+				t.Errorf("payload %q should contain the query from the request context, got %v", tt.key, body)
+			}
+		})
+	}
+
+	notifier := NewNotifier(PlatformSlack, "https://hooks.example.com/abc")
+	notifier.Sender = &fakeSender{err: errors.New("boom")}
+	if err := notifier.Notify(ctx, notifyTestResponse(1, 1)); err == nil || !strings.Contains(err.Error(), "slack 알림 전송 실패") {
+		t.Errorf("expected a send error, got %v", err)
+	}
+
+	notifier.Platform = "teams"
+	if err := notifier.Notify(ctx, notifyTestResponse(1, 1)); err == nil {
+		t.Error("expected an error for an unsupported platform")
+	}
+}
+
+func TestHTTPSender(t *testing.T) {
+	var got string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		got = string(body)
+		if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/json" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if strings.HasSuffix(r.URL.Path, "/invalid") {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, "invalid_token")
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	sender := &HTTPSender{Client: server.Client()}
+	if err := sender.Send(context.Background(), server.URL+"/hook", []byte(`{"text":"hi"}`)); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	if got != `{"text":"hi"}` {
+		t.Errorf("server received %q", got)
+	}
+
+	err := sender.Send(context.Background(), server.URL+"/invalid", []byte(`{}`))
+	if err == nil || !strings.Contains(err.Error(), "HTTP 404: invalid_token") {
+		t.Errorf("expected an HTTP 404 error, got %v", err)
+	}
+
+	// Network errors do not leak the webhook URL
+	err = sender.Send(context.Background(), "http://127.0.0.1:1/secret-token", []byte(`{}`))
+	if err == nil || strings.Contains(err.Error(), "secret-token") {
+		t.Errorf("expected a network error without the URL, got %v", err)
+	}
+}
@@ -0,0 +1,131 @@
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/stats"
+)
+
+// Status of a saved page
+const (
+	PageOK     = "ok"
+	PageFailed = "failed"
+)
+
+// MergedFileName is the name of the file holding the results of all pages
+const MergedFileName = "merged.json"
+
+// PageFile is a page of search results saved as is, for keeping a record of
+// what the API returned. A page that could not be fetched is saved with
+// PageFailed and its error, so gaps in a collection stay visible.
+type PageFile struct {
+	Query      string        `json:"query"`
+	Page       int           `json:"page"`
+	PageSize   int           `json:"page_size"`
+	Status     string        `json:"status"`
+	Error      string        `json:"error,omitempty"`
+	TotalCount int           `json:"total_count"`
+	Count      int           `json:"count"`
+	FetchedAt  time.Time     `json:"fetched_at"`
+	Laws       []api.LawInfo `json:"laws"`
+}
+
+// MergedFile holds the results of every page fetched, in page order. Failed
+// pages are listed and contribute no results. Stats counts the laws by
+// department and year; it is kept up to date as laws are added, so that an
+// appended file does not need them all counted again.
+type MergedFile struct {
+	Query       string         `json:"query"`
+	PageSize    int            `json:"page_size"`
+	TotalCount  int            `json:"total_count"`
+	Pages       int            `json:"pages"`
+	FailedPages []int          `json:"failed_pages,omitempty"`
+	Count       int            `json:"count"`
+	Stats       *stats.Summary `json:"stats,omitempty"`
+	Laws        []api.LawInfo  `json:"laws"`
+}
+
+// PageFileName returns the file name of page out of pages: page-001.json,
+// zero-padded to three digits or to the digits of pages if it has more, so
+// the files sort in page order.
+func PageFileName(page, pages int) string {
+	width := len(strconv.Itoa(pages))
+	if width < 3 {
+		width = 3
+	}
+	return fmt.Sprintf("page-%0*d.json", width, page)
+}
+
+// SavePage saves p as the page file in dir, creating dir if needed, and
+// returns its path
+func SavePage(dir string, pages int, p *PageFile) (string, error) {
+	if p.Laws == nil {
+		p.Laws = []api.LawInfo{}
+	}
+	p.Count = len(p.Laws)
+	path := filepath.Join(dir, PageFileName(p.Page, pages))
+	return path, writeJSONFile(path, p)
+}
+
+// SaveMerged saves m as MergedFileName in dir, creating dir if needed, and
+// returns its path
+func SaveMerged(dir string, m *MergedFile) (string, error) {
+	if m.Laws == nil {
+		m.Laws = []api.LawInfo{}
+	}
+	m.Count = len(m.Laws)
+	path := filepath.Join(dir, MergedFileName)
+	return path, writeJSONFile(path, m)
+}
+
+// LoadMerged reads the MergedFileName file of dir. A missing file is an
+// error matching os.ErrNotExist.
+func LoadMerged(dir string) (*MergedFile, error) {
+	path := filepath.Join(dir, MergedFileName)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var m MergedFile
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("통합 결과 파일을 읽을 수 없습니다 (%s): %w", path, err)
+	}
+	return &m, nil
+}
+
+// writeJSONFile writes v as indented JSON to path. The file is written to a
+// temporary file next to it and renamed, so it is either complete or absent.
+func writeJSONFile(path string, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("JSON 변환 실패: %w", err)
+	}
+
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("디렉토리를 만들 수 없습니다: %w", err)
+	}
+	tmp, err := os.CreateTemp(dir, filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("파일 저장 실패 (%s): %w", path, err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(append(data, '\n')); err != nil {
+		tmp.Close()
+		return fmt.Errorf("파일 저장 실패 (%s): %w", path, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("파일 저장 실패 (%s): %w", path, err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("파일 저장 실패 (%s): %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package export
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+func TestPageFileName(t *testing.T) {
+	tests := []struct {
+		page, pages int
+		want        string
+	}{
+		{1, 1, "page-001.json"},
+		{12, 150, "page-012.json"},
+		{7, 1000, "page-0007.json"},
+		{1000, 1000, "page-1000.json"},
+	}
+
+	for _, tt := range tests {
+		if got := PageFileName(tt.page, tt.pages); got != tt.want {
+			t.Errorf("PageFileName(%d, %d) = %q, want %q", tt.page, tt.pages, got, tt.want)
+		}
+	}
+}
+
+func TestSavePage(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "pages", "nested")
+
+	path, err := SavePage(dir, 3, &PageFile{Page: 2, Status: PageFailed, Error: "시간 초과"})
+	if err != nil {
+		t.Fatalf("SavePage() error = %v", err)
+	}
+	if path != filepath.Join(dir, "page-002.json") {
+		t.Errorf("SavePage() path = %s", path)
+	}
+
+	// Saving again replaces the page, and no temporary file is left behind
+	laws := []api.LawInfo{{ID: "001", Name: "개인정보 보호법"}}
+	if _, err := SavePage(dir, 3, &PageFile{Page: 2, Status: PageOK, TotalCount: 5, Laws: laws}); err != nil {
+		t.Fatal(err)
+	}
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != 1 {
+		t.Errorf("directory holds %d files, want only the page file", len(entries))
+	}
+
+	var got PageFile
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("page file is not valid JSON: %v", err)
+	}
+	if got.Status != PageOK || got.Error != "" || got.Count != 1 || got.Laws[0].Name != "개인정보 보호법" {
+		t.Errorf("page file = %+v", got)
+	}
+
+	// A failed page has an empty list of laws, not null
+	if _, err := SavePage(dir, 3, &PageFile{Page: 3, Status: PageFailed}); err != nil {
+		t.Fatal(err)
+	}
+	data, _ = os.ReadFile(filepath.Join(dir, "page-003.json"))
+	var raw map[string]interface{}
+	json.Unmarshal(data, &raw)
+	if laws, ok := raw["laws"].([]interface{}); !ok || len(laws) != 0 {
+		t.Errorf("failed page laws = %v, want []", raw["laws"])
+	}
+}
@@ -0,0 +1,107 @@
+// Package fileutil updates the small state files of warp, such as the search
+// history and the bookmarks, safely while several warp processes run at once.
+package fileutil
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// StaleLockAge is the age after which a leftover lock file is taken over
+const StaleLockAge = 10 * time.Second
+
+// lockPoll is how often WithLock retries a lock held by another process
+const lockPoll = 20 * time.Millisecond
+
+// ErrLocked is returned by WithLock when the lock stays held past its timeout
+var ErrLocked = errors.New("file is locked by another process")
+
+// WithLock runs fn while holding the lock file path+".lock", so that
+// concurrent processes do not lose each other's updates of path. It waits up
+// to timeout for another holder and takes over a lock older than
+// StaleLockAge, left behind by a crashed process.
+func WithLock(path string, timeout time.Duration, fn func() error) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("failed to lock %s: %w", filepath.Base(path), err)
+	}
+
+	lockPath := path + ".lock"
+	deadline := time.Now().Add(timeout)
+	for {
+		f, err := os.OpenFile(lockPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+		if err == nil {
+			f.Close()
+			break
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return fmt.Errorf("failed to lock %s: %w", filepath.Base(path), err)
+		}
+
+		if stealStaleLock(lockPath) {
+			continue
+		}
+		if time.Now().After(deadline) {
+			return ErrLocked
+		}
+		time.Sleep(lockPoll)
+	}
+	defer os.Remove(lockPath)
+
+	return fn()
+}
+
+// stealStaleLock moves a stale lock out of the way and reports whether it
+// did. Removing the stale lock after checking its age would race with another
+// process that took it over meanwhile: it would delete the fresh lock of that
+// process. A rename moves exactly one file, so only one process wins the
+// stale lock, and a lock that turns out to be fresh once moved is put back;
+// the caller then takes the lock with O_EXCL as usual.
+func stealStaleLock(lockPath string) bool {
+	info, err := os.Stat(lockPath)
+	if err != nil || time.Since(info.ModTime()) <= StaleLockAge {
+		return false
+	}
+
+	stolen := lockPath + "." + strconv.Itoa(os.Getpid()) + ".stale"
+	if err := os.Rename(lockPath, stolen); err != nil {
+		// Another process stole it first
+		return false
+	}
+	defer os.Remove(stolen)
+
+	if moved, err := os.Stat(stolen); err == nil && !os.SameFile(info, moved) {
+		// The lock was replaced by a live holder after the check; give it
+		// back unless yet another lock has been taken since
+		_ = os.Link(stolen, lockPath)
+		return false
+	}
+	return true
+}
+
+// WriteAtomic replaces path with data through a temporary file in the same
+// directory, so that readers never see a partial file
+func WriteAtomic(path string, data []byte) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(dir, filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
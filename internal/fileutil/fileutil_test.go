@@ -0,0 +1,125 @@
+package fileutil
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWithLockSerializes(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state", "data.json")
+
+	var holders, overlaps int32
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := WithLock(path, 5*time.Second, func() error {
+				if atomic.AddInt32(&holders, 1) > 1 {
+					atomic.AddInt32(&overlaps, 1)
+				}
+				time.Sleep(time.Millisecond)
+				atomic.AddInt32(&holders, -1)
+				return nil
+			})
+			if err != nil {
+				t.Errorf("WithLock() error = %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if overlaps != 0 {
+		t.Errorf("%d holders overlapped", overlaps)
+	}
+	if _, err := os.Stat(path + ".lock"); !os.IsNotExist(err) {
+		t.Errorf("lock file should be removed, stat error = %v", err)
+	}
+}
+
+func TestWithLockReturnsError(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data.json")
+	want := errors.New("boom")
+	if err := WithLock(path, time.Second, func() error { return want }); err != want {
+		t.Errorf("WithLock() error = %v, want the error of fn", err)
+	}
+}
+
+func TestWithLockHeld(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data.json")
+	lockPath := path + ".lock"
+	if err := os.WriteFile(lockPath, nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	ran := false
+	if err := WithLock(path, 50*time.Millisecond, func() error { ran = true; return nil }); !errors.Is(err, ErrLocked) || ran {
+		t.Errorf("WithLock() with held lock = %v (ran %v), want ErrLocked", err, ran)
+	}
+
+	// A stale lock is taken over
+	old := time.Now().Add(-time.Minute)
+	if err := os.Chtimes(lockPath, old, old); err != nil {
+		t.Fatal(err)
+	}
+	if err := WithLock(path, 50*time.Millisecond, func() error { ran = true; return nil }); err != nil || !ran {
+		t.Errorf("WithLock() with stale lock = %v (ran %v), want nil", err, ran)
+	}
+}
+
+func TestStealStaleLockOnce(t *testing.T) {
+	dir := t.TempDir()
+	lockPath := filepath.Join(dir, "data.json.lock")
+	if err := os.WriteFile(lockPath, nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+	old := time.Now().Add(-time.Minute)
+	if err := os.Chtimes(lockPath, old, old); err != nil {
+		t.Fatal(err)
+	}
+
+	if !stealStaleLock(lockPath) {
+		t.Fatal("stealStaleLock() = false, want the stale lock")
+	}
+	// The lock is gone, so a second taker has nothing to steal
+	if stealStaleLock(lockPath) {
+		t.Error("second stealStaleLock() = true, want false")
+	}
+
+	// A fresh lock is left alone
+	if err := os.WriteFile(lockPath, nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if stealStaleLock(lockPath) {
+		t.Error("stealStaleLock() of a fresh lock = true, want false")
+	}
+	if _, err := os.Stat(lockPath); err != nil {
+		t.Errorf("fresh lock should stay, stat error = %v", err)
+	}
+	if files, _ := filepath.Glob(filepath.Join(dir, "*.stale")); len(files) != 0 {
+		t.Errorf("stolen locks left behind: %v", files)
+	}
+}
+
+func TestWriteAtomic(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "sub", "data.json")
+
+	for _, content := range []string{"first", "second"} {
+		if err := WriteAtomic(path, []byte(content)); err != nil {
+			t.Fatalf("WriteAtomic() error = %v", err)
+		}
+		data, err := os.ReadFile(path)
+		if err != nil || string(data) != content {
+			t.Errorf("file = %q, %v; want %q", data, err, content)
+		}
+	}
+	if files, _ := filepath.Glob(filepath.Join(dir, "sub", "*.tmp")); len(files) != 0 {
+		t.Errorf("temporary files left behind: %v", files)
+	}
+}
@@ -0,0 +1,165 @@
+// Package history records recent searches so they can be listed and re-run.
+//
+// Only the command, query, source and output format are stored; API keys and
+// other configuration values are never written to the history file.
+package history
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/fileutil"
+)
+
+const (
+	// FileName is the name of the history file inside the config directory
+	FileName = "history.json"
+	// DefaultSize is the number of entries kept when no size is configured
+	DefaultSize = 50
+)
+
+// lockTimeout is how long Add and Clear wait for another writer
+var lockTimeout = 2 * time.Second
+
+// ErrLocked is returned when the history file stays locked by another process
+var ErrLocked = errors.New("history file is locked by another process")
+
+// Entry is a single recorded search
+type Entry struct {
+	Command   string    `json:"command"` // command path without the binary name, e.g. "law search"
+	Query     string    `json:"query"`
+	Source    string    `json:"source,omitempty"`
+	Format    string    `json:"format,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// Args returns the command line arguments that re-run the entry
+func (e Entry) Args() []string {
+	args := append(strings.Fields(e.Command), e.Query)
+	if e.Source != "" {
+		args = append(args, "--source", e.Source)
+	}
+	if e.Format != "" {
+		args = append(args, "--format", e.Format)
+	}
+	return args
+}
+
+// sameSearch reports whether two entries describe the same search
+func (e Entry) sameSearch(other Entry) bool {
+	return e.Command == other.Command && e.Query == other.Query &&
+		e.Source == other.Source && e.Format == other.Format
+}
+
+// Store reads and writes the history file
+type Store struct {
+	path string
+	size int
+}
+
+// New creates a store for the history file at path keeping at most size
+// entries. A size of zero or less uses DefaultSize.
+func New(path string, size int) *Store {
+	if size <= 0 {
+		size = DefaultSize
+	}
+	return &Store{path: path, size: size}
+}
+
+// Path returns the history file path
+func (s *Store) Path() string {
+	return s.path
+}
+
+// List returns the recorded entries, most recent first.
+// A missing history file is not an error.
+func (s *Store) List() ([]Entry, error) {
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read history: %w", err)
+	}
+
+	var entries []Entry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("failed to parse history %s: %w", s.path, err)
+	}
+	return entries, nil
+}
+
+// Get returns the entry at the given 1-based position in List order
+func (s *Store) Get(n int) (Entry, error) {
+	entries, err := s.List()
+	if err != nil {
+		return Entry{}, err
+	}
+	if n < 1 || n > len(entries) {
+		return Entry{}, fmt.Errorf("history entry %d not found (1-%d)", n, len(entries))
+	}
+	return entries[n-1], nil
+}
+
+// Add records an entry as the most recent search. Repeating the latest
+// search only refreshes its timestamp, and the oldest entries are dropped
+// once the store is full.
+func (s *Store) Add(entry Entry) error {
+	if entry.Timestamp.IsZero() {
+		entry.Timestamp = time.Now()
+	}
+
+	return s.withLock(func() error {
+		entries, err := s.List()
+		if err != nil {
+			// A corrupt history file should not block new searches
+			entries = nil
+		}
+
+		if len(entries) > 0 && entries[0].sameSearch(entry) {
+			entries = entries[1:]
+		}
+		entries = append([]Entry{entry}, entries...)
+		if len(entries) > s.size {
+			entries = entries[:s.size]
+		}
+		return s.write(entries)
+	})
+}
+
+// Clear removes all recorded entries
+func (s *Store) Clear() error {
+	return s.withLock(func() error {
+		if err := os.Remove(s.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to clear history: %w", err)
+		}
+		return nil
+	})
+}
+
+// write replaces the history file atomically so readers never see a partial file
+func (s *Store) write(entries []Entry) error {
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode history: %w", err)
+	}
+
+	if err := fileutil.WriteAtomic(s.path, data); err != nil {
+		return fmt.Errorf("failed to write history: %w", err)
+	}
+	return nil
+}
+
+// withLock runs fn while holding an exclusive lock file next to the history
+// file, so concurrent warp processes do not lose each other's entries
+func (s *Store) withLock(fn func() error) error {
+	err := fileutil.WithLock(s.path, lockTimeout, fn)
+	if errors.Is(err, fileutil.ErrLocked) {
+		return ErrLocked
+	}
+	return err
+}
@@ -0,0 +1,194 @@
+package history
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+func newTestStore(t *testing.T, size int) *Store {
+	t.Helper()
+	return New(filepath.Join(t.TempDir(), FileName), size)
+}
+
+func TestStoreAddAndList(t *testing.T) {
+	store := newTestStore(t, 10)
+
+	entries, err := store.List()
+	if err != nil || len(entries) != 0 {
+		t.Fatalf("List() on missing file = %v, %v; want empty", entries, err)
+	}
+
+	base := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	for i, query := range []string{"민법", "형법", "상법"} {
+		if err := store.Add(Entry{Command: "search", Query: query, Timestamp: base.Add(time.Duration(i) * time.Minute)}); err != nil {
+			t.Fatalf("Add() error: %v", err)
+		}
+	}
+
+	entries, err = store.List()
+	if err != nil {
+		t.Fatalf("List() error: %v", err)
+	}
+	var queries []string
+	for _, e := range entries {
+		queries = append(queries, e.Query)
+	}
+	if want := []string{"상법", "형법", "민법"}; !reflect.DeepEqual(queries, want) {
+		t.Errorf("queries = %v, want %v (most recent first)", queries, want)
+	}
+
+	entry, err := store.Get(3)
+	if err != nil || entry.Query != "민법" {
+		t.Errorf("Get(3) = %v, %v; want 민법", entry, err)
+	}
+	if _, err := store.Get(4); err == nil {
+		t.Error("Get(4) should fail with 3 entries")
+	}
+	if _, err := store.Get(0); err == nil {
+		t.Error("Get(0) should fail")
+	}
+}
+
+func TestStoreRotation(t *testing.T) {
+	store := newTestStore(t, 3)
+
+	for i := 1; i <= 5; i++ {
+		if err := store.Add(Entry{Command: "search", Query: fmt.Sprintf("q%d", i)}); err != nil {
+			t.Fatalf("Add() error: %v", err)
+		}
+	}
+
+	entries, _ := store.List()
+	if len(entries) != 3 {
+		t.Fatalf("expected 3 entries after rotation, got %d", len(entries))
+	}
+	if entries[0].Query != "q5" || entries[2].Query != "q3" {
+		t.Errorf("rotation kept %q..%q, want q5..q3", entries[0].Query, entries[2].Query)
+	}
+}
+
+func TestStoreAddRepeatedSearch(t *testing.T) {
+	store := newTestStore(t, 10)
+
+	first := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	second := first.Add(time.Hour)
+	store.Add(Entry{Command: "search", Query: "민법", Format: "json", Timestamp: first})
+	store.Add(Entry{Command: "search", Query: "민법", Format: "json", Timestamp: second})
+
+	entries, _ := store.List()
+	if len(entries) != 1 {
+		t.Fatalf("repeated search should not be duplicated, got %d entries", len(entries))
+	}
+	if !entries[0].Timestamp.Equal(second) {
+		t.Errorf("timestamp = %v, want %v", entries[0].Timestamp, second)
+	}
+
+	// A different format is a different search
+	store.Add(Entry{Command: "search", Query: "민법", Format: "table"})
+	if entries, _ := store.List(); len(entries) != 2 {
+		t.Errorf("expected 2 entries, got %d", len(entries))
+	}
+}
+
+func TestStoreClear(t *testing.T) {
+	store := newTestStore(t, 10)
+	store.Add(Entry{Command: "search", Query: "민법"})
+
+	if err := store.Clear(); err != nil {
+		t.Fatalf("Clear() error: %v", err)
+	}
+	if entries, _ := store.List(); len(entries) != 0 {
+		t.Errorf("expected no entries after Clear, got %d", len(entries))
+	}
+	if err := store.Clear(); err != nil {
+		t.Errorf("Clear() on missing file should succeed, got %v", err)
+	}
+}
+
+func TestStoreCorruptFile(t *testing.T) {
+	store := newTestStore(t, 10)
+	if err := os.WriteFile(store.Path(), []byte("{not json"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := store.List(); err == nil {
+		t.Error("List() should report a corrupt file")
+	}
+	if err := store.Add(Entry{Command: "search", Query: "민법"}); err != nil {
+		t.Fatalf("Add() should replace a corrupt file, got %v", err)
+	}
+	if entries, err := store.List(); err != nil || len(entries) != 1 {
+		t.Errorf("List() = %v, %v; want 1 entry", entries, err)
+	}
+}
+
+func TestStoreConcurrentAdd(t *testing.T) {
+	store := newTestStore(t, 100)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if err := store.Add(Entry{Command: "search", Query: fmt.Sprintf("q%d", i)}); err != nil {
+				t.Errorf("Add() error: %v", err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	entries, err := store.List()
+	if err != nil {
+		t.Fatalf("List() error: %v", err)
+	}
+	if len(entries) != 20 {
+		t.Errorf("expected 20 entries, got %d (lost updates)", len(entries))
+	}
+}
+
+func TestStoreLocked(t *testing.T) {
+	saved := lockTimeout
+	lockTimeout = 100 * time.Millisecond
+	defer func() { lockTimeout = saved }()
+
+	store := newTestStore(t, 10)
+	lockPath := store.Path() + ".lock"
+
+	if err := os.WriteFile(lockPath, nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Add(Entry{Command: "search", Query: "민법"}); !errors.Is(err, ErrLocked) {
+		t.Errorf("Add() with held lock = %v, want ErrLocked", err)
+	}
+
+	// A stale lock is taken over
+	old := time.Now().Add(-time.Minute)
+	os.Chtimes(lockPath, old, old)
+	if err := store.Add(Entry{Command: "search", Query: "민법"}); err != nil {
+		t.Errorf("Add() with stale lock = %v, want nil", err)
+	}
+}
+
+func TestEntryArgs(t *testing.T) {
+	tests := []struct {
+		entry Entry
+		want  []string
+	}{
+		{Entry{Command: "search", Query: "개인정보", Source: "law", Format: "json"},
+			[]string{"search", "개인정보", "--source", "law", "--format", "json"}},
+		{Entry{Command: "ordinance search", Query: "주차 조례"},
+			[]string{"ordinance", "search", "주차 조례"}},
+	}
+
+	for _, tt := range tests {
+		if got := tt.entry.Args(); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Args() = %v, want %v", got, tt.want)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"embed"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/nicksnyder/go-i18n/v2/i18n"
 	"golang.org/x/text/language"
@@ -12,9 +13,21 @@ import (
 //go:embed messages/*.json
 var messagesFS embed.FS
 
+// SupportedLanguages are the languages of the messages, in order of preference
+var SupportedLanguages = []string{"ko", "en"}
+
 var (
 	bundle    *i18n.Bundle
 	localizer *i18n.Localizer
+
+	// currentLang is the language of T and Tf
+	currentLang = "ko"
+	// localizers holds a localizer per language for TfIn
+	localizers = make(map[string]*i18n.Localizer)
+	// externalLocales maps the languages loaded by LoadLocaleDir to their files
+	externalLocales = make(map[string]string)
+	// messageIDs holds the IDs of the messages of each language
+	messageIDs = make(map[string]map[string]bool)
 )
 
 // Init initializes the i18n system
@@ -22,27 +35,88 @@ func Init() error {
 	bundle = i18n.NewBundle(language.Korean)
 	bundle.RegisterUnmarshalFunc("json", json.Unmarshal)
 
+	messageIDs = make(map[string]map[string]bool)
+	externalLocales = make(map[string]string)
+
 	// Load Korean messages
 	koData, err := messagesFS.ReadFile("messages/ko.json")
 	if err != nil {
 		return fmt.Errorf("failed to read Korean messages: %w", err)
 	}
-	bundle.MustParseMessageFileBytes(koData, "ko.json")
+	if err := addMessageFile("ko", koData); err != nil {
+		return fmt.Errorf("failed to parse Korean messages: %w", err)
+	}
 
 	// Load English messages
 	enData, err := messagesFS.ReadFile("messages/en.json")
 	if err != nil {
 		return fmt.Errorf("failed to read English messages: %w", err)
 	}
-	bundle.MustParseMessageFileBytes(enData, "en.json")
+	if err := addMessageFile("en", enData); err != nil {
+		return fmt.Errorf("failed to parse English messages: %w", err)
+	}
 
 	// Initialize localizer with detected language
-	lang := detectLanguage()
-	localizer = i18n.NewLocalizer(bundle, lang)
+	localizers = make(map[string]*i18n.Localizer)
+	currentLang = detectLanguage()
+	localizer = localizerFor(currentLang)
 
 	return nil
 }
 
+// addMessageFile adds the messages of lang in the JSON data to the bundle,
+// replacing those of the same ID
+func addMessageFile(lang string, data []byte) error {
+	file, err := bundle.ParseMessageFileBytes(data, lang+".json")
+	if err != nil {
+		return err
+	}
+	if messageIDs[lang] == nil {
+		messageIDs[lang] = make(map[string]bool)
+	}
+	for _, message := range file.Messages {
+		messageIDs[lang][message.ID] = true
+	}
+	return nil
+}
+
+// SetLanguage switches the language of T and Tf to one of SupportedLanguages
+func SetLanguage(lang string) error {
+	lang = strings.ToLower(strings.TrimSpace(lang))
+	if !isSupported(lang) {
+		return fmt.Errorf("unsupported language: %s (%s)", lang, strings.Join(Languages(), ", "))
+	}
+	ensureInit()
+	currentLang = lang
+	localizer = localizerFor(lang)
+	return nil
+}
+
+// isSupported reports whether lang is one of SupportedLanguages or a
+// language loaded by LoadLocaleDir
+func isSupported(lang string) bool {
+	_, ok := externalLocales[lang]
+	return ok || isBuiltin(lang)
+}
+
+// ensureInit loads the messages if Init has not run yet, so that packages
+// translating their output also work without it (e.g. in their tests)
+func ensureInit() {
+	if bundle == nil {
+		_ = Init()
+	}
+}
+
+// localizerFor returns the localizer of lang, creating it on first use
+func localizerFor(lang string) *i18n.Localizer {
+	if l, ok := localizers[lang]; ok {
+		return l
+	}
+	l := i18n.NewLocalizer(bundle, lang)
+	localizers[lang] = l
+	return l
+}
+
 // detectLanguage always returns Korean as this is a Korean law information tool
 func detectLanguage() string {
 	// Always return Korean - this is a Korean law information tool
@@ -61,11 +135,13 @@ func T(messageID string, data ...map[string]interface{}) string {
 		templateData = data[0]
 	}
 
-	msg, err := localizer.Localize(&i18n.LocalizeConfig{
+	// A message missing from the language comes back in Korean, the default
+	// language of the bundle, along with an error
+	msg, _ := localizer.Localize(&i18n.LocalizeConfig{
 		MessageID:    messageID,
 		TemplateData: templateData,
 	})
-	if err != nil {
+	if msg == "" {
 		// Fallback to message ID if translation not found
 		return messageID
 	}
@@ -81,7 +157,29 @@ func Tf(messageID string, args ...interface{}) string {
 	return translated
 }
 
+// TfIn translates a message into lang, whatever the current language, and
+// formats it with args. Unsupported languages fall back to Korean.
+func TfIn(lang, messageID string, args ...interface{}) string {
+	ensureInit()
+	if bundle == nil {
+		return messageID
+	}
+	if !isSupported(lang) {
+		lang = "ko"
+	}
+
+	translated, _ := localizerFor(lang).Localize(&i18n.LocalizeConfig{MessageID: messageID})
+	if translated == "" {
+		// Fallback to message ID if translation not found
+		translated = messageID
+	}
+	if len(args) > 0 {
+		return fmt.Sprintf(translated, args...)
+	}
+	return translated
+}
+
 // GetCurrentLanguage returns the current language code
 func GetCurrentLanguage() string {
-	return detectLanguage()
+	return currentLang
 }
@@ -0,0 +1,119 @@
+package i18n
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"golang.org/x/text/language"
+)
+
+// Locale is a language the messages can be shown in
+type Locale struct {
+	// Lang is the language code, the name of its file without .json
+	Lang string
+	// Builtin reports whether the messages of the language are embedded
+	Builtin bool
+	// Path is the external file loaded over or instead of the embedded
+	// messages, "" if none
+	Path string
+	// Messages is the number of messages of the language
+	Messages int
+	// Missing is the number of Korean messages the language lacks, shown
+	// in Korean instead
+	Missing int
+}
+
+// LoadLocaleDir loads the translations <lang>.json in dir. Their messages
+// replace the embedded ones of the same language and ID, and a file of a
+// new language adds it; messages a file lacks fall back to Korean. Files
+// that cannot be read or parsed are skipped and returned as warnings. A
+// missing dir loads nothing.
+func LoadLocaleDir(dir string) []error {
+	ensureInit()
+	if bundle == nil || dir == "" {
+		return nil
+	}
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return []error{err}
+	}
+	sort.Strings(paths)
+
+	var warnings []error
+	for _, path := range paths {
+		lang := strings.ToLower(strings.TrimSuffix(filepath.Base(path), ".json"))
+		if _, err := language.Parse(lang); err != nil {
+			warnings = append(warnings, fmt.Errorf("언어 코드가 아닌 번역 파일을 건너뜁니다: %s", path))
+			continue
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			warnings = append(warnings, fmt.Errorf("번역 파일을 읽을 수 없어 건너뜁니다: %w", err))
+			continue
+		}
+		if err := addMessageFile(lang, data); err != nil {
+			warnings = append(warnings, fmt.Errorf("번역 파일이 올바른 JSON이 아니어서 건너뜁니다: %s: %w", path, err))
+			continue
+		}
+		externalLocales[lang] = path
+	}
+
+	// The localizers match the languages of the bundle, which may have grown
+	localizers = make(map[string]*i18n.Localizer)
+	localizer = localizerFor(currentLang)
+	return warnings
+}
+
+// Locales returns the languages of the messages: the embedded ones first,
+// then those loaded by LoadLocaleDir by code
+func Locales() []Locale {
+	ensureInit()
+	var locales []Locale
+	for _, lang := range SupportedLanguages {
+		locales = append(locales, Locale{Lang: lang, Builtin: true, Path: externalLocales[lang]})
+	}
+	var external []string
+	for lang := range externalLocales {
+		if !isBuiltin(lang) {
+			external = append(external, lang)
+		}
+	}
+	sort.Strings(external)
+	for _, lang := range external {
+		locales = append(locales, Locale{Lang: lang, Path: externalLocales[lang]})
+	}
+
+	for i := range locales {
+		ids := messageIDs[locales[i].Lang]
+		locales[i].Messages = len(ids)
+		for id := range messageIDs["ko"] {
+			if !ids[id] {
+				locales[i].Missing++
+			}
+		}
+	}
+	return locales
+}
+
+// Languages returns the codes of Locales
+func Languages() []string {
+	var langs []string
+	for _, locale := range Locales() {
+		langs = append(langs, locale.Lang)
+	}
+	return langs
+}
+
+// isBuiltin reports whether lang is one of SupportedLanguages
+func isBuiltin(lang string) bool {
+	for _, supported := range SupportedLanguages {
+		if lang == supported {
+			return true
+		}
+	}
+	return false
+}
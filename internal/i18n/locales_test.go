@@ -0,0 +1,90 @@
+package i18n
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeLocale(t *testing.T, dir, name, content string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0600); err != nil {
+		t.Fatalf("failed to write %s: %v", name, err)
+	}
+}
+
+func TestLoadLocaleDir(t *testing.T) {
+	if err := Init(); err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+	t.Cleanup(func() { _ = Init() })
+
+	dir := t.TempDir()
+	writeLocale(t, dir, "ja.json", `{"cli.lang": "出力言語"}`)
+	writeLocale(t, dir, "en.json", `{"cli.verbose": "Chatty output"}`)
+	writeLocale(t, dir, "fr.json", `{"cli.lang": `)
+	writeLocale(t, dir, "notes_old.json", `{}`)
+
+	warnings := LoadLocaleDir(dir)
+	if len(warnings) != 2 {
+		t.Fatalf("LoadLocaleDir() warnings = %v, want 2", warnings)
+	}
+	joined := warnings[0].Error() + "\n" + warnings[1].Error()
+	for _, want := range []string{"fr.json", "notes_old.json"} {
+		if !strings.Contains(joined, want) {
+			t.Errorf("warnings = %q, want %s", joined, want)
+		}
+	}
+
+	if got := Languages(); strings.Join(got, ",") != "ko,en,ja" {
+		t.Errorf("Languages() = %v, want [ko en ja]", got)
+	}
+	locales := Locales()
+	if en := locales[1]; !en.Builtin || en.Path != filepath.Join(dir, "en.json") || en.Missing != 0 {
+		t.Errorf("en locale = %+v, want builtin overridden by en.json", en)
+	}
+	ja := locales[2]
+	if ja.Builtin || ja.Messages != 1 || ja.Missing != locales[0].Messages-1 {
+		t.Errorf("ja locale = %+v, want 1 message and the other Korean ones missing", ja)
+	}
+
+	// Overridden messages replace the embedded ones, the others stay
+	if err := SetLanguage("en"); err != nil {
+		t.Fatalf("SetLanguage(en) error = %v", err)
+	}
+	if got := T("cli.verbose"); got != "Chatty output" {
+		t.Errorf("T(cli.verbose) = %q, want the external message", got)
+	}
+	if got := T("cli.lang"); got == "cli.lang" || got != TfIn("en", "cli.lang") {
+		t.Errorf("T(cli.lang) = %q, want the embedded English message", got)
+	}
+
+	// A new language is selectable and falls back to Korean
+	if err := SetLanguage("ja"); err != nil {
+		t.Fatalf("SetLanguage(ja) error = %v", err)
+	}
+	if got := T("cli.lang"); got != "出力言語" {
+		t.Errorf("T(cli.lang) = %q, want 出力言語", got)
+	}
+	if got, want := T("cli.verbose"), TfIn("ko", "cli.verbose"); got != want {
+		t.Errorf("T(cli.verbose) = %q, want the Korean %q", got, want)
+	}
+
+	// The invalid file adds no language
+	if err := SetLanguage("fr"); err == nil {
+		t.Error("SetLanguage(fr) succeeded, want an error for the skipped file")
+	}
+}
+
+func TestLoadLocaleDirMissing(t *testing.T) {
+	if err := Init(); err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+	if warnings := LoadLocaleDir(filepath.Join(t.TempDir(), "missing")); len(warnings) != 0 {
+		t.Errorf("LoadLocaleDir() warnings = %v, want none", warnings)
+	}
+	if got := Languages(); strings.Join(got, ",") != "ko,en" {
+		t.Errorf("Languages() = %v, want [ko en]", got)
+	}
+}
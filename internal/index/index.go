@@ -0,0 +1,408 @@
+package index
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const (
+	// FileName is the name of the index file inside the index directory
+	FileName = "index.json"
+	// formatVersion is bumped when the on-disk format changes
+	formatVersion = 1
+)
+
+// Document is a single indexable unit (one article of a law)
+type Document struct {
+	LawID     string `json:"law_id"`
+	LawName   string `json:"law_name"`
+	ArticleNo string `json:"article_no"`
+	Title     string `json:"title,omitempty"`
+	Content   string `json:"content"`
+}
+
+// Key returns the document key (법령ID:조문번호)
+func (d Document) Key() string {
+	return d.LawID + ":" + d.ArticleNo
+}
+
+// Index is a lightweight inverted index over article text.
+// Text is indexed by character unigrams and bigrams so that Korean terms match
+// inside compound words and words with particles (e.g. "음주" in "음주운전을").
+// Candidates from the postings are verified with a substring check.
+type Index struct {
+	docs     map[string]Document
+	text     map[string]string              // normalized searchable text per document
+	postings map[string]map[string]struct{} // gram -> document keys
+}
+
+// fileFormat is the on-disk representation of an index
+type fileFormat struct {
+	Version   int        `json:"version"`
+	Documents []Document `json:"documents"`
+}
+
+// New creates an empty index
+func New() *Index {
+	return &Index{
+		docs:     make(map[string]Document),
+		text:     make(map[string]string),
+		postings: make(map[string]map[string]struct{}),
+	}
+}
+
+// Open loads the index stored in dir, or returns an empty index if none exists
+func Open(dir string) (*Index, error) {
+	ix := New()
+
+	data, err := os.ReadFile(filepath.Join(dir, FileName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return ix, nil
+		}
+		return nil, fmt.Errorf("인덱스 읽기 실패: %w", err)
+	}
+
+	var f fileFormat
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("인덱스 파싱 실패: %w", err)
+	}
+	if f.Version != formatVersion {
+		return nil, fmt.Errorf("지원하지 않는 인덱스 버전: %d (다시 구축해주세요)", f.Version)
+	}
+
+	for _, doc := range f.Documents {
+		ix.Add(doc)
+	}
+	return ix, nil
+}
+
+// Save writes the index to dir, creating the directory if needed
+func (ix *Index) Save(dir string) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("인덱스 디렉토리 생성 실패: %w", err)
+	}
+
+	f := fileFormat{
+		Version:   formatVersion,
+		Documents: ix.Documents(),
+	}
+	data, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return fmt.Errorf("인덱스 직렬화 실패: %w", err)
+	}
+
+	// Write atomically so an interrupted build never leaves a broken index
+	tmp := filepath.Join(dir, FileName+".tmp")
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return fmt.Errorf("인덱스 저장 실패: %w", err)
+	}
+	if err := os.Rename(tmp, filepath.Join(dir, FileName)); err != nil {
+		return fmt.Errorf("인덱스 저장 실패: %w", err)
+	}
+	return nil
+}
+
+// Add inserts or replaces a document. It reports whether an existing document was replaced.
+func (ix *Index) Add(doc Document) bool {
+	key := doc.Key()
+	_, replaced := ix.docs[key]
+	if replaced {
+		ix.remove(key)
+	}
+
+	ix.docs[key] = doc
+	text := normalize(doc.Title + " " + doc.Content)
+	ix.text[key] = text
+	for gram := range grams(text) {
+		keys, ok := ix.postings[gram]
+		if !ok {
+			keys = make(map[string]struct{})
+			ix.postings[gram] = keys
+		}
+		keys[key] = struct{}{}
+	}
+	return replaced
+}
+
+// remove deletes a document and its postings
+func (ix *Index) remove(key string) {
+	for gram := range grams(ix.text[key]) {
+		if keys, ok := ix.postings[gram]; ok {
+			delete(keys, key)
+			if len(keys) == 0 {
+				delete(ix.postings, gram)
+			}
+		}
+	}
+	delete(ix.docs, key)
+	delete(ix.text, key)
+}
+
+// Len returns the number of documents
+func (ix *Index) Len() int {
+	return len(ix.docs)
+}
+
+// Documents returns all documents in key order
+func (ix *Index) Documents() []Document {
+	docs := make([]Document, 0, len(ix.docs))
+	for _, doc := range ix.docs {
+		docs = append(docs, doc)
+	}
+	sortDocuments(docs)
+	return docs
+}
+
+// Search returns the documents matching the query, in key order.
+//
+// Query syntax:
+//   - terms separated by spaces (or AND) must all match
+//   - OR separates alternatives: "음주 AND 면허 OR 무면허"
+//   - NOT or a leading '-' excludes a term: "음주 NOT 자전거"
+//   - double quotes match a phrase: "\"운전면허 취소\""
+func (ix *Index) Search(query string) ([]Document, error) {
+	groups, err := parseQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	matched := make(map[string]struct{})
+	for _, group := range groups {
+		for key := range ix.matchGroup(group) {
+			matched[key] = struct{}{}
+		}
+	}
+
+	docs := make([]Document, 0, len(matched))
+	for key := range matched {
+		docs = append(docs, ix.docs[key])
+	}
+	sortDocuments(docs)
+	return docs, nil
+}
+
+// matchGroup returns the keys of documents matching every clause of an AND group
+func (ix *Index) matchGroup(group []clause) map[string]struct{} {
+	var result map[string]struct{}
+
+	// Positive clauses narrow the candidate set
+	for _, c := range group {
+		if c.negate {
+			continue
+		}
+		keys := ix.match(c.term)
+		if result == nil {
+			result = keys
+			continue
+		}
+		for key := range result {
+			if _, ok := keys[key]; !ok {
+				delete(result, key)
+			}
+		}
+	}
+
+	// Negative clauses filter the remaining candidates
+	for _, c := range group {
+		if !c.negate {
+			continue
+		}
+		for key := range ix.match(c.term) {
+			delete(result, key)
+		}
+	}
+	return result
+}
+
+// match returns the keys of documents containing term
+func (ix *Index) match(term string) map[string]struct{} {
+	var candidates map[string]struct{}
+	for gram := range grams(term) {
+		keys := ix.postings[gram]
+		if candidates == nil {
+			candidates = make(map[string]struct{}, len(keys))
+			for key := range keys {
+				candidates[key] = struct{}{}
+			}
+			continue
+		}
+		for key := range candidates {
+			if _, ok := keys[key]; !ok {
+				delete(candidates, key)
+			}
+		}
+	}
+
+	// Verify candidates, since sharing grams does not guarantee a contiguous match
+	for key := range candidates {
+		if !strings.Contains(ix.text[key], term) {
+			delete(candidates, key)
+		}
+	}
+	if candidates == nil {
+		candidates = make(map[string]struct{})
+	}
+	return candidates
+}
+
+// clause is a single (possibly negated) term of a query
+type clause struct {
+	term   string
+	negate bool
+}
+
+// parseQuery parses a query into OR groups of AND clauses
+func parseQuery(query string) ([][]clause, error) {
+	var (
+		groups  [][]clause
+		current []clause
+		negate  bool
+	)
+
+	flush := func() error {
+		hasPositive := false
+		for _, c := range current {
+			if !c.negate {
+				hasPositive = true
+			}
+		}
+		if len(current) > 0 && !hasPositive {
+			return fmt.Errorf("제외 조건(NOT)만으로는 검색할 수 없습니다")
+		}
+		if len(current) > 0 {
+			groups = append(groups, current)
+		}
+		current = nil
+		return nil
+	}
+
+	for _, token := range tokenizeQuery(query) {
+		if !token.quoted {
+			switch token.text {
+			case "AND":
+				continue
+			case "OR":
+				if err := flush(); err != nil {
+					return nil, err
+				}
+				continue
+			case "NOT":
+				negate = true
+				continue
+			}
+		}
+
+		text := token.text
+		neg := negate
+		if !token.quoted && strings.HasPrefix(text, "-") && len(text) > 1 {
+			text = text[1:]
+			neg = true
+		}
+		negate = false
+
+		if term := normalize(text); term != "" {
+			current = append(current, clause{term: term, negate: neg})
+		}
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+
+	if len(groups) == 0 {
+		return nil, fmt.Errorf("검색어를 입력해주세요")
+	}
+	return groups, nil
+}
+
+// queryToken is a raw token of a query string
+type queryToken struct {
+	text   string
+	quoted bool
+}
+
+// tokenizeQuery splits a query on whitespace, keeping double-quoted phrases together
+func tokenizeQuery(query string) []queryToken {
+	var (
+		tokens  []queryToken
+		buf     strings.Builder
+		inQuote bool
+	)
+
+	emit := func(quoted bool) {
+		if buf.Len() > 0 || quoted {
+			tokens = append(tokens, queryToken{text: buf.String(), quoted: quoted})
+		}
+		buf.Reset()
+	}
+
+	for _, r := range query {
+		switch {
+		case r == '"':
+			if inQuote {
+				emit(true)
+			} else {
+				emit(false)
+			}
+			inQuote = !inQuote
+		case unicode.IsSpace(r) && !inQuote:
+			emit(false)
+		default:
+			buf.WriteRune(r)
+		}
+	}
+	emit(inQuote)
+	return tokens
+}
+
+// normalize lowercases text and collapses everything that is not a letter or digit into single spaces
+func normalize(s string) string {
+	var b strings.Builder
+	space := true
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+			space = false
+		} else if !space {
+			b.WriteByte(' ')
+			space = true
+		}
+	}
+	return strings.TrimSpace(b.String())
+}
+
+// grams returns the character unigrams and bigrams of each word in normalized text
+func grams(text string) map[string]struct{} {
+	result := make(map[string]struct{})
+	for _, word := range strings.Fields(text) {
+		runes := []rune(word)
+		for i := range runes {
+			result[string(runes[i])] = struct{}{}
+			if i+1 < len(runes) {
+				result[string(runes[i:i+2])] = struct{}{}
+			}
+		}
+	}
+	return result
+}
+
+// sortDocuments orders documents by law ID, then by article number
+func sortDocuments(docs []Document) {
+	sort.Slice(docs, func(i, j int) bool {
+		if docs[i].LawID != docs[j].LawID {
+			return docs[i].LawID < docs[j].LawID
+		}
+		ni, errI := strconv.Atoi(docs[i].ArticleNo)
+		nj, errJ := strconv.Atoi(docs[j].ArticleNo)
+		if errI == nil && errJ == nil && ni != nj {
+			return ni < nj
+		}
+		return docs[i].ArticleNo < docs[j].ArticleNo
+	})
+}
@@ -0,0 +1,130 @@
+package index
+
+import (
+	"reflect"
+	"testing"
+)
+
+func sampleIndex() *Index {
+	ix := New()
+	ix.Add(Document{LawID: "001", LawName: "도로교통법", ArticleNo: "44", Title: "술에 취한 상태에서의 운전 금지", Content: "누구든지 술에 취한 상태에서 자동차등을 운전하여서는 아니 된다. 음주운전을 한 경우 면허를 취소한다."})
+	ix.Add(Document{LawID: "001", LawName: "도로교통법", ArticleNo: "93", Title: "운전면허의 취소·정지", Content: "음주 측정에 불응한 경우 운전면허를 취소할 수 있다."})
+	ix.Add(Document{LawID: "001", LawName: "도로교통법", ArticleNo: "152", Title: "벌칙", Content: "무면허운전을 한 사람은 1년 이하의 징역에 처한다."})
+	ix.Add(Document{LawID: "002", LawName: "자전거 이용 활성화에 관한 법률", ArticleNo: "5", Title: "음주 자전거", Content: "자전거 음주운전을 금지한다."})
+	return ix
+}
+
+func keys(docs []Document) []string {
+	result := make([]string, len(docs))
+	for i, doc := range docs {
+		result[i] = doc.Key()
+	}
+	return result
+}
+
+func TestSearch(t *testing.T) {
+	ix := sampleIndex()
+
+	tests := []struct {
+		name  string
+		query string
+		want  []string
+	}{
+		{"Single term", "음주", []string{"001:44", "001:93", "002:5"}},
+		{"Term inside compound word", "면허", []string{"001:44", "001:93", "001:152"}},
+		{"Implicit AND", "음주 면허", []string{"001:44", "001:93"}},
+		{"Explicit AND", "음주 AND 면허", []string{"001:44", "001:93"}},
+		{"OR", "무면허 OR 자전거", []string{"001:152", "002:5"}},
+		{"AND binds tighter than OR", "음주 AND 측정 OR 징역", []string{"001:93", "001:152"}},
+		{"NOT", "음주 NOT 자전거", []string{"001:44", "001:93"}},
+		{"Leading minus", "음주 -자전거", []string{"001:44", "001:93"}},
+		{"Phrase", `"술에 취한 상태"`, []string{"001:44"}},
+		{"Title is searchable", "벌칙", []string{"001:152"}},
+		{"Case and punctuation insensitive", "취소·정지", []string{"001:93"}},
+		{"Grams present but not contiguous", "면음", []string{}},
+		{"No match", "헌법재판", []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			docs, err := ix.Search(tt.query)
+			if err != nil {
+				t.Fatalf("Search(%q) error = %v", tt.query, err)
+			}
+			if got := keys(docs); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Search(%q) = %v, want %v", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearch_InvalidQuery(t *testing.T) {
+	ix := sampleIndex()
+
+	for _, query := range []string{"", "   ", "AND", "NOT 음주", "-음주"} {
+		if _, err := ix.Search(query); err == nil {
+			t.Errorf("Search(%q) should return an error", query)
+		}
+	}
+}
+
+func TestAdd_Incremental(t *testing.T) {
+	ix := sampleIndex()
+
+	// Replacing a document updates its postings
+	replaced := ix.Add(Document{LawID: "001", LawName: "도로교통법", ArticleNo: "152", Title: "벌칙", Content: "과태료를 부과한다."})
+	if !replaced {
+		t.Error("Add() should report replacement of an existing key")
+	}
+	if ix.Len() != 4 {
+		t.Errorf("Len() = %d, want 4", ix.Len())
+	}
+	if docs, _ := ix.Search("무면허"); len(docs) != 0 {
+		t.Errorf("old content should no longer match, got %v", keys(docs))
+	}
+	if docs, _ := ix.Search("과태료"); !reflect.DeepEqual(keys(docs), []string{"001:152"}) {
+		t.Errorf("new content should match, got %v", keys(docs))
+	}
+
+	// Adding a new document extends the index
+	if ix.Add(Document{LawID: "003", ArticleNo: "1", Content: "음주 문화 개선"}) {
+		t.Error("Add() should not report replacement for a new key")
+	}
+	if docs, _ := ix.Search("음주"); len(docs) != 4 {
+		t.Errorf("expected 4 matches after adding, got %v", keys(docs))
+	}
+}
+
+func TestSaveAndOpen(t *testing.T) {
+	dir := t.TempDir()
+
+	// Opening a missing index yields an empty one
+	empty, err := Open(dir)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	if empty.Len() != 0 {
+		t.Errorf("Len() = %d, want 0", empty.Len())
+	}
+
+	ix := sampleIndex()
+	if err := ix.Save(dir); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	loaded, err := Open(dir)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	if !reflect.DeepEqual(loaded.Documents(), ix.Documents()) {
+		t.Error("loaded documents differ from saved documents")
+	}
+
+	docs, err := loaded.Search("음주 AND 면허")
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if !reflect.DeepEqual(keys(docs), []string{"001:44", "001:93"}) {
+		t.Errorf("Search() after reload = %v", keys(docs))
+	}
+}
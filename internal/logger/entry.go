@@ -0,0 +1,77 @@
+package logger
+
+import "os"
+
+// Entry is a logger with structured fields attached to every message. The
+// fields go to "fields" in JSONFormat and after the message as key=value
+// pairs in TextFormat.
+//
+//	logger.WithField("query", query).Info("검색 완료: %d건", total)
+type Entry struct {
+	logger *Logger
+	fields Fields
+}
+
+// WithField returns an entry of l with the field key set to value
+func (l *Logger) WithField(key string, value interface{}) *Entry {
+	return &Entry{logger: l, fields: Fields{key: value}}
+}
+
+// WithFields returns an entry of l with fields
+func (l *Logger) WithFields(fields Fields) *Entry {
+	return (&Entry{logger: l}).WithFields(fields)
+}
+
+// WithField returns a copy of e with the field key set to value
+func (e *Entry) WithField(key string, value interface{}) *Entry {
+	return e.WithFields(Fields{key: value})
+}
+
+// WithFields returns a copy of e with fields added, replacing fields of the
+// same key
+func (e *Entry) WithFields(fields Fields) *Entry {
+	merged := make(Fields, len(e.fields)+len(fields))
+	for k, v := range e.fields {
+		merged[k] = v
+	}
+	for k, v := range fields {
+		merged[k] = v
+	}
+	return &Entry{logger: e.logger, fields: merged}
+}
+
+// Debug logs a debug message with the fields of e
+func (e *Entry) Debug(format string, args ...interface{}) {
+	e.logger.log(DebugLevel, "DEBUG", e.logger.debugColor, e.fields, format, args...)
+}
+
+// Info logs an info message with the fields of e
+func (e *Entry) Info(format string, args ...interface{}) {
+	e.logger.log(InfoLevel, "INFO", e.logger.infoColor, e.fields, format, args...)
+}
+
+// Warn logs a warning message with the fields of e
+func (e *Entry) Warn(format string, args ...interface{}) {
+	e.logger.log(WarnLevel, "WARN", e.logger.warnColor, e.fields, format, args...)
+}
+
+// Error logs an error message with the fields of e
+func (e *Entry) Error(format string, args ...interface{}) {
+	e.logger.log(ErrorLevel, "ERROR", e.logger.errorColor, e.fields, format, args...)
+}
+
+// Fatal logs a fatal error message with the fields of e and exits the program
+func (e *Entry) Fatal(format string, args ...interface{}) {
+	e.logger.log(FatalLevel, "FATAL", e.logger.fatalColor, e.fields, format, args...)
+	os.Exit(1)
+}
+
+// WithField returns an entry of the default logger with the field key set to value
+func WithField(key string, value interface{}) *Entry {
+	return defaultLogger.WithField(key, value)
+}
+
+// WithFields returns an entry of the default logger with fields
+func WithFields(fields Fields) *Entry {
+	return defaultLogger.WithFields(fields)
+}
@@ -0,0 +1,127 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+// decodeLines decodes every line of buf as a JSON log line
+func decodeLines(t *testing.T, buf *bytes.Buffer) []map[string]interface{} {
+	t.Helper()
+	var lines []map[string]interface{}
+	for _, raw := range strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n") {
+		if raw == "" {
+			continue
+		}
+		var line map[string]interface{}
+		if err := json.Unmarshal([]byte(raw), &line); err != nil {
+			t.Fatalf("invalid JSON line %q: %v", raw, err)
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+func TestLoggerJSONFormat(t *testing.T) {
+	var buf bytes.Buffer
+	logger := New(InfoLevel, &buf, true)
+	logger.SetFormat(JSONFormat)
+
+	logger.Debug("filtered")
+	logger.Info("검색 완료: %d건, \"%s\"", 3, "개인정보")
+	logger.WithFields(Fields{"query": "개인정보", "page": 2}).Warn("느린 응답")
+
+	lines := decodeLines(t, &buf)
+	if len(lines) != 2 {
+		t.Fatalf("got %d lines, want 2 (debug filtered):\n%s", len(lines), buf.String())
+	}
+	if strings.Contains(buf.String(), "\x1b[") {
+		t.Errorf("JSON lines should not be colored: %q", buf.String())
+	}
+
+	info := lines[0]
+	if info["level"] != "info" || info["msg"] != `검색 완료: 3건, "개인정보"` {
+		t.Errorf("info line = %v", info)
+	}
+	if _, ok := info["fields"]; ok {
+		t.Errorf("a message without fields should omit fields: %v", info)
+	}
+	if _, err := time.Parse(time.RFC3339Nano, info["ts"].(string)); err != nil {
+		t.Errorf("ts = %v, want RFC 3339: %v", info["ts"], err)
+	}
+
+	warn := lines[1]
+	fields, _ := warn["fields"].(map[string]interface{})
+	if warn["level"] != "warn" || fields["query"] != "개인정보" || fields["page"] != float64(2) {
+		t.Errorf("warn line = %v", warn)
+	}
+}
+
+func TestLoggerJSONFieldValues(t *testing.T) {
+	var buf bytes.Buffer
+	logger := New(DebugLevel, &buf, false)
+	logger.SetFormat(JSONFormat)
+
+	// Errors become their message and unencodable values their fmt text
+	logger.WithField("error", errors.New("시간 초과")).WithField("callback", func() {}).Error("실패")
+
+	lines := decodeLines(t, &buf)
+	if len(lines) != 1 {
+		t.Fatalf("got %d lines, want 1", len(lines))
+	}
+	fields := lines[0]["fields"].(map[string]interface{})
+	if fields["error"] != "시간 초과" {
+		t.Errorf("error field = %v, want its message", fields["error"])
+	}
+	if s, ok := fields["callback"].(string); !ok || s == "" {
+		t.Errorf("callback field = %v, want its fmt text", fields["callback"])
+	}
+}
+
+func TestEntryFields(t *testing.T) {
+	var buf bytes.Buffer
+	logger := New(InfoLevel, &buf, false)
+
+	base := logger.WithField("source", "nlic")
+	base.WithFields(Fields{"query": "도로", "source": "elis"}).Info("검색")
+	base.Debug("filtered")
+	base.Info("base")
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got %d lines, want 2:\n%s", len(lines), buf.String())
+	}
+	// Text lines get the fields sorted by key, later values replacing earlier ones
+	if !strings.HasSuffix(lines[0], "[INFO] 검색 query=도로 source=elis") {
+		t.Errorf("first line = %q", lines[0])
+	}
+	// Adding fields does not change the entry they were added to
+	if !strings.HasSuffix(lines[1], "[INFO] base source=nlic") {
+		t.Errorf("second line = %q", lines[1])
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    Format
+		wantErr bool
+	}{
+		{"", TextFormat, false},
+		{"text", TextFormat, false},
+		{"JSON", JSONFormat, false},
+		{" json ", JSONFormat, false},
+		{"xml", TextFormat, true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseFormat(tt.value)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("ParseFormat(%q) = %v, %v; want %v, error %v", tt.value, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
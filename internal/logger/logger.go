@@ -1,9 +1,13 @@
 package logger
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/fatih/color"
@@ -25,12 +29,44 @@ const (
 	FatalLevel
 )
 
+// Format is the layout of a log line
+type Format int
+
+const (
+	// TextFormat writes "[15:04:05] [INFO] message" lines, colored on a terminal
+	TextFormat Format = iota
+	// JSONFormat writes one JSON object per line, for log collectors
+	JSONFormat
+)
+
+// ParseFormat parses a log format name (text or json)
+func ParseFormat(value string) (Format, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "", "text":
+		return TextFormat, nil
+	case "json":
+		return JSONFormat, nil
+	default:
+		return TextFormat, fmt.Errorf("지원하지 않는 로그 형식: %s (text, json 중 선택)", value)
+	}
+}
+
+// Fields are structured values attached to a log message
+type Fields map[string]interface{}
+
 // Logger provides structured logging with levels
 type Logger struct {
 	level    Level
 	output   io.Writer
 	useColor bool
 	prefix   string
+	format   Format
+
+	// file receives the messages without colors when set; alsoOutput keeps
+	// writing them to output as well
+	file       io.Writer
+	alsoOutput bool
+	mu         sync.Mutex
 
 	// Color functions
 	debugColor *color.Color
@@ -79,6 +115,34 @@ func SetOutput(w io.Writer) {
 	defaultLogger.output = w
 }
 
+// SetFormat sets the layout of the lines l writes
+func (l *Logger) SetFormat(format Format) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.format = format
+}
+
+// SetFormat sets the layout of the lines of the default logger
+func SetFormat(format Format) {
+	defaultLogger.SetFormat(format)
+}
+
+// SetFile makes l write its messages to w, without colors. With alsoOutput
+// they also go to the output as before; otherwise only to w. A nil w goes
+// back to writing to the output only.
+func (l *Logger) SetFile(w io.Writer, alsoOutput bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.file = w
+	l.alsoOutput = alsoOutput
+}
+
+// SetFileOutput makes the default logger write to w, such as a
+// *RotatingFile, and also to stderr if alsoStderr is set
+func SetFileOutput(w io.Writer, alsoStderr bool) {
+	defaultLogger.SetFile(w, alsoStderr)
+}
+
 // SetColorEnabled enables or disables color output
 func SetColorEnabled(enabled bool) {
 	defaultLogger.useColor = enabled
@@ -95,16 +159,88 @@ func (l *Logger) formatMessage(level string, msg string) string {
 	return fmt.Sprintf("[%s] [%s] %s", timestamp, level, msg)
 }
 
+// jsonLine is a log message in JSONFormat
+type jsonLine struct {
+	TS     string                 `json:"ts"`
+	Level  string                 `json:"level"`
+	Prefix string                 `json:"prefix,omitempty"`
+	Msg    string                 `json:"msg"`
+	Fields map[string]interface{} `json:"fields,omitempty"`
+}
+
+// formatJSON formats a log message as a JSON line. Errors are written as their
+// message, and values JSON cannot encode as their fmt text.
+func (l *Logger) formatJSON(level string, msg string, fields Fields) string {
+	line := jsonLine{
+		TS:     time.Now().Format(time.RFC3339Nano),
+		Level:  strings.ToLower(level),
+		Prefix: l.prefix,
+		Msg:    msg,
+	}
+	if len(fields) > 0 {
+		line.Fields = make(map[string]interface{}, len(fields))
+		for k, v := range fields {
+			if err, ok := v.(error); ok {
+				v = err.Error()
+			}
+			if _, err := json.Marshal(v); err != nil {
+				v = fmt.Sprint(v)
+			}
+			line.Fields[k] = v
+		}
+	}
+	data, _ := json.Marshal(line)
+	return string(data)
+}
+
+// appendFields appends fields to a text message as key=value pairs, sorted by key
+func appendFields(msg string, fields Fields) string {
+	if len(fields) == 0 {
+		return msg
+	}
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	b.WriteString(msg)
+	for _, k := range keys {
+		fmt.Fprintf(&b, " %s=%v", k, fields[k])
+	}
+	return b.String()
+}
+
 // log writes a log message at the specified level
-func (l *Logger) log(level Level, levelStr string, colorFunc *color.Color, format string, args ...interface{}) {
+func (l *Logger) log(level Level, levelStr string, colorFunc *color.Color, fields Fields, format string, args ...interface{}) {
 	if level < l.level {
 		return
 	}
 
 	msg := fmt.Sprintf(format, args...)
-	formattedMsg := l.formatMessage(levelStr, msg)
 
-	if l.useColor && colorFunc != nil {
+	// Messages from concurrent requests must not interleave
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var formattedMsg string
+	if l.format == JSONFormat {
+		formattedMsg = l.formatJSON(levelStr, msg, fields)
+	} else {
+		formattedMsg = l.formatMessage(levelStr, appendFields(msg, fields))
+	}
+
+	if l.file != nil {
+		// One write per message, so that a rotating file never splits a line
+		io.WriteString(l.file, formattedMsg+"\n")
+		if !l.alsoOutput {
+			return
+		}
+	}
+
+	// JSON lines stay plain so that collectors can parse them
+	if l.useColor && colorFunc != nil && l.format != JSONFormat {
 		colorFunc.Fprintln(l.output, formattedMsg)
 	} else {
 		fmt.Fprintln(l.output, formattedMsg)
@@ -113,27 +249,27 @@ func (l *Logger) log(level Level, levelStr string, colorFunc *color.Color, forma
 
 // Debug logs a debug message
 func (l *Logger) Debug(format string, args ...interface{}) {
-	l.log(DebugLevel, "DEBUG", l.debugColor, format, args...)
+	l.log(DebugLevel, "DEBUG", l.debugColor, nil, format, args...)
 }
 
 // Info logs an info message
 func (l *Logger) Info(format string, args ...interface{}) {
-	l.log(InfoLevel, "INFO", l.infoColor, format, args...)
+	l.log(InfoLevel, "INFO", l.infoColor, nil, format, args...)
 }
 
 // Warn logs a warning message
 func (l *Logger) Warn(format string, args ...interface{}) {
-	l.log(WarnLevel, "WARN", l.warnColor, format, args...)
+	l.log(WarnLevel, "WARN", l.warnColor, nil, format, args...)
 }
 
 // Error logs an error message
 func (l *Logger) Error(format string, args ...interface{}) {
-	l.log(ErrorLevel, "ERROR", l.errorColor, format, args...)
+	l.log(ErrorLevel, "ERROR", l.errorColor, nil, format, args...)
 }
 
 // Fatal logs a fatal error message and exits the program
 func (l *Logger) Fatal(format string, args ...interface{}) {
-	l.log(FatalLevel, "FATAL", l.fatalColor, format, args...)
+	l.log(FatalLevel, "FATAL", l.fatalColor, nil, format, args...)
 	os.Exit(1)
 }
 
@@ -0,0 +1,163 @@
+package logger
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	// DefaultMaxSize is the size at which a log file is rotated (log.maxsize)
+	DefaultMaxSize int64 = 10 * 1024 * 1024
+	// DefaultBackups is the number of rotated log files kept
+	DefaultBackups = 3
+)
+
+// RotatingFile is a log file that is rotated when a write would make it
+// larger than its maximum size. The current file is renamed to path.1, older
+// backups move up to path.2 and so on, and the oldest beyond the backup count
+// is removed. It is safe for concurrent use.
+type RotatingFile struct {
+	path    string
+	maxSize int64
+	backups int
+
+	mu   sync.Mutex
+	file *os.File
+	size int64
+}
+
+// OpenRotatingFile opens path for appending, creating it and its directory if
+// needed. A maxSize of zero or less uses DefaultMaxSize; a negative backups
+// count uses DefaultBackups.
+func OpenRotatingFile(path string, maxSize int64, backups int) (*RotatingFile, error) {
+	if maxSize <= 0 {
+		maxSize = DefaultMaxSize
+	}
+	if backups < 0 {
+		backups = DefaultBackups
+	}
+
+	f := &RotatingFile{path: path, maxSize: maxSize, backups: backups}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("로그 디렉토리를 만들 수 없습니다: %w", err)
+	}
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// Path returns the path of the current log file
+func (f *RotatingFile) Path() string {
+	return f.path
+}
+
+// Write appends p to the file, rotating first if p does not fit. A single
+// write is never split across files, so a log line always stays whole.
+func (f *RotatingFile) Write(p []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.file == nil {
+		return 0, os.ErrClosed
+	}
+	if f.size > 0 && f.size+int64(len(p)) > f.maxSize {
+		if err := f.rotate(); err != nil {
+			return 0, err
+		}
+	}
+	n, err := f.file.Write(p)
+	f.size += int64(n)
+	return n, err
+}
+
+// Close closes the current log file
+func (f *RotatingFile) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.file == nil {
+		return nil
+	}
+	err := f.file.Close()
+	f.file = nil
+	return err
+}
+
+// open opens the current log file for appending and records its size
+func (f *RotatingFile) open() error {
+	file, err := os.OpenFile(f.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return fmt.Errorf("로그 파일을 열 수 없습니다: %w", err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return fmt.Errorf("로그 파일을 열 수 없습니다: %w", err)
+	}
+	f.file = file
+	f.size = info.Size()
+	return nil
+}
+
+// rotate moves the current file to the first backup and opens a new one.
+// Without backups the current file is emptied.
+func (f *RotatingFile) rotate() error {
+	if err := f.file.Close(); err != nil {
+		return fmt.Errorf("로그 파일 회전 실패: %w", err)
+	}
+	f.file = nil
+
+	if f.backups == 0 {
+		if err := os.Truncate(f.path, 0); err != nil {
+			return fmt.Errorf("로그 파일 회전 실패: %w", err)
+		}
+		return f.open()
+	}
+
+	// A missing backup is not an error; there are fewer before the first rotations
+	if err := os.Remove(f.backupPath(f.backups)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("로그 파일 회전 실패: %w", err)
+	}
+	for i := f.backups - 1; i >= 1; i-- {
+		if err := os.Rename(f.backupPath(i), f.backupPath(i+1)); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("로그 파일 회전 실패: %w", err)
+		}
+	}
+	if err := os.Rename(f.path, f.backupPath(1)); err != nil {
+		return fmt.Errorf("로그 파일 회전 실패: %w", err)
+	}
+	return f.open()
+}
+
+// backupPath returns the path of the n-th backup
+func (f *RotatingFile) backupPath(n int) string {
+	return f.path + "." + strconv.Itoa(n)
+}
+
+// ParseSize parses a file size such as "10MB", "512KB" or "1GB". A plain
+// number is a number of bytes; units are powers of 1024 and case-insensitive.
+func ParseSize(value string) (int64, error) {
+	s := strings.ToUpper(strings.TrimSpace(value))
+	multiplier := int64(1)
+	for _, unit := range []struct {
+		suffix string
+		size   int64
+	}{{"GB", 1 << 30}, {"MB", 1 << 20}, {"KB", 1 << 10}, {"G", 1 << 30}, {"M", 1 << 20}, {"K", 1 << 10}, {"B", 1}} {
+		if strings.HasSuffix(s, unit.suffix) {
+			s = strings.TrimSpace(strings.TrimSuffix(s, unit.suffix))
+			multiplier = unit.size
+			break
+		}
+	}
+
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("잘못된 파일 크기: %q (예: 10MB, 512KB)", value)
+	}
+	return n * multiplier, nil
+}
@@ -0,0 +1,232 @@
+package logger
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/fatih/color"
+)
+
+// readLines returns the lines of a file, or nil if it does not exist
+func readLines(t *testing.T, path string) []string {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+}
+
+func TestRotatingFileRotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logs", "warp.log")
+	f, err := OpenRotatingFile(path, 30, 2)
+	if err != nil {
+		t.Fatalf("OpenRotatingFile() error = %v", err)
+	}
+	defer f.Close()
+
+	// Each line is 10 bytes: three fill the file exactly, the fourth rotates
+	for i := 1; i <= 4; i++ {
+		fmt.Fprintf(f, "line %04d\n", i)
+	}
+	if got := readLines(t, path+".1"); len(got) != 3 || got[0] != "line 0001" {
+		t.Errorf("first backup = %v, want the first 3 lines", got)
+	}
+	if got := readLines(t, path); len(got) != 1 || got[0] != "line 0004" {
+		t.Errorf("current file = %v, want the 4th line", got)
+	}
+
+	// Older backups move up and the oldest beyond the count is removed
+	for i := 5; i <= 10; i++ {
+		fmt.Fprintf(f, "line %04d\n", i)
+	}
+	if got := readLines(t, path+".2"); len(got) != 3 || got[0] != "line 0004" {
+		t.Errorf("second backup = %v, want lines 4-6", got)
+	}
+	if got := readLines(t, path+".1"); len(got) != 3 || got[0] != "line 0007" {
+		t.Errorf("first backup = %v, want lines 7-9", got)
+	}
+	if got := readLines(t, path); len(got) != 1 || got[0] != "line 0010" {
+		t.Errorf("current file = %v, want line 10", got)
+	}
+	if _, err := os.Stat(path + ".3"); !os.IsNotExist(err) {
+		t.Errorf("only 2 backups should be kept, stat .3: %v", err)
+	}
+}
+
+func TestRotatingFileReopen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "warp.log")
+	f, err := OpenRotatingFile(path, 30, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fmt.Fprint(f, "line 0001\n")
+	fmt.Fprint(f, "line 0002\n")
+	f.Close()
+
+	if _, err := f.Write([]byte("late\n")); err == nil {
+		t.Error("Write() after Close() should fail")
+	}
+
+	// The size of what is already in the file counts towards the limit
+	f, err = OpenRotatingFile(path, 30, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	fmt.Fprint(f, "line 0003\n")
+	fmt.Fprint(f, "line 0004\n")
+	if got := readLines(t, path+".1"); len(got) != 3 {
+		t.Errorf("first backup = %v, want the 3 lines written up to the limit", got)
+	}
+	if got := readLines(t, path); len(got) != 1 || got[0] != "line 0004" {
+		t.Errorf("current file = %v, want line 4", got)
+	}
+}
+
+func TestRotatingFileWithoutBackups(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "warp.log")
+	f, err := OpenRotatingFile(path, 20, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	for i := 1; i <= 3; i++ {
+		fmt.Fprintf(f, "line %04d\n", i)
+	}
+	if got := readLines(t, path); len(got) != 1 || got[0] != "line 0003" {
+		t.Errorf("current file = %v, want only the line after the rotation", got)
+	}
+	if _, err := os.Stat(path + ".1"); !os.IsNotExist(err) {
+		t.Errorf("no backup should be kept, stat .1: %v", err)
+	}
+}
+
+func TestRotatingFileConcurrentWrites(t *testing.T) {
+	const workers, messages = 8, 200
+
+	path := filepath.Join(t.TempDir(), "warp.log")
+	f, err := OpenRotatingFile(path, 4096, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	logger := New(DebugLevel, &bytes.Buffer{}, false)
+	logger.SetFile(f, false)
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < messages; i++ {
+				logger.Info("worker %d message %d", w, i)
+			}
+		}(w)
+	}
+	wg.Wait()
+	f.Close()
+
+	// Every line stays whole and in one file, and no file outgrows the limit
+	pattern := regexp.MustCompile(`^\[\d\d:\d\d:\d\d\] \[INFO\] worker \d message \d+$`)
+	seen := make(map[string]bool)
+	files, _ := filepath.Glob(path + "*")
+	for _, name := range files {
+		info, err := os.Stat(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if info.Size() > 4096 {
+			t.Errorf("%s is %d bytes, over the limit", name, info.Size())
+		}
+		file, err := os.Open(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			line := scanner.Text()
+			if !pattern.MatchString(line) {
+				t.Errorf("broken line in %s: %q", name, line)
+			}
+			seen[line[strings.Index(line, "worker"):]] = true
+		}
+		file.Close()
+	}
+	if len(files) < 2 {
+		t.Errorf("expected the log to rotate, got files %v", files)
+	}
+	if len(seen) != workers*messages {
+		t.Errorf("found %d distinct messages, want %d", len(seen), workers*messages)
+	}
+}
+
+func TestLoggerSetFile(t *testing.T) {
+	noColor := color.NoColor
+	color.NoColor = false
+	defer func() { color.NoColor = noColor }()
+
+	var console, file bytes.Buffer
+	logger := New(InfoLevel, &console, true)
+
+	// The file never gets ANSI codes, even with colors on
+	logger.SetFile(&file, false)
+	logger.Warn("to the file")
+	if strings.Contains(file.String(), "\x1b[") || !strings.Contains(file.String(), "[WARN] to the file") {
+		t.Errorf("file output = %q, want plain text", file.String())
+	}
+	if console.Len() != 0 {
+		t.Errorf("output should be empty without alsoOutput, got %q", console.String())
+	}
+
+	logger.SetFile(&file, true)
+	logger.Warn("to both")
+	if !strings.Contains(file.String(), "to both") || !strings.Contains(console.String(), "\x1b[") {
+		t.Errorf("alsoOutput should write plain to the file and colored to the output, got %q and %q", file.String(), console.String())
+	}
+
+	file.Reset()
+	logger.SetFile(nil, false)
+	logger.Warn("back to the output")
+	if file.Len() != 0 || !strings.Contains(console.String(), "back to the output") {
+		t.Errorf("a nil file should write to the output only")
+	}
+}
+
+func TestParseSize(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    int64
+		wantErr bool
+	}{
+		{"10MB", 10 << 20, false},
+		{"512kb", 512 << 10, false},
+		{"1G", 1 << 30, false},
+		{" 20 MB ", 20 << 20, false},
+		{"4096", 4096, false},
+		{"100B", 100, false},
+		{"0", 0, true},
+		{"-1MB", 0, true},
+		{"big", 0, true},
+		{"MB", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseSize(tt.value)
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("ParseSize(%q) = %v, %v; want %v, error %v", tt.value, got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
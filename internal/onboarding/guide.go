@@ -15,11 +15,20 @@ type Guide struct {
 	useColor bool
 }
 
+// colorForcedOff disables colored output for every guide (set by --no-color)
+var colorForcedOff bool
+
+// SetColorEnabled globally enables or disables colored guide output.
+// Disabling overrides terminal detection and the useColor argument of NewGuideWithWriter.
+func SetColorEnabled(enabled bool) {
+	colorForcedOff = !enabled
+}
+
 // NewGuide creates a new onboarding guide
 func NewGuide() *Guide {
 	return &Guide{
 		writer:   os.Stderr,
-		useColor: isTerminal() && !isColorDisabled(),
+		useColor: !colorForcedOff && isTerminal() && !isColorDisabled(),
 	}
 }
 
@@ -27,7 +36,7 @@ func NewGuide() *Guide {
 func NewGuideWithWriter(w io.Writer, useColor bool) *Guide {
 	return &Guide{
 		writer:   w,
-		useColor: useColor,
+		useColor: useColor && !colorForcedOff,
 	}
 }
 
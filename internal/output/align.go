@@ -0,0 +1,150 @@
+package output
+
+import (
+	"strings"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/i18n"
+)
+
+// Alignment is the horizontal alignment of a table column
+type Alignment int
+
+const (
+	// AlignDefault picks the alignment from the column header and values
+	AlignDefault Alignment = iota
+	// AlignLeft aligns cells to the left
+	AlignLeft
+	// AlignRight aligns cells to the right
+	AlignRight
+	// AlignCenter centers cells
+	AlignCenter
+)
+
+// headerAlignments holds the alignment of well-known columns by the message
+// ID of their header, so that they are found under --lang en as well.
+// Counts and sequence numbers read best right-aligned, dates centered.
+var headerAlignments = map[string]Alignment{
+	"output.table.no":            AlignRight,
+	"output.table.seq":           AlignRight,
+	"output.table.count":         AlignRight,
+	"output.table.quantity":      AlignRight,
+	"output.table.effectiveDate": AlignCenter,
+	"output.table.promulDate":    AlignCenter,
+	"output.table.judgeDate":     AlignCenter,
+	"output.table.issueDate":     AlignCenter,
+	"output.table.replyDate":     AlignCenter,
+}
+
+// DefaultAlignments returns the alignment of each column. Well-known headers
+// use headerAlignments; any other column is right-aligned when every
+// non-empty cell is a number and left-aligned otherwise.
+func DefaultAlignments(headers []string, rows [][]string) []Alignment {
+	langs := i18n.Languages()
+	aligns := make([]Alignment, len(headers))
+	for i, header := range headers {
+		if align, ok := headerAlignment(header, langs); ok {
+			aligns[i] = align
+			continue
+		}
+		if isNumericColumn(rows, i) {
+			aligns[i] = AlignRight
+		} else {
+			aligns[i] = AlignLeft
+		}
+	}
+	return aligns
+}
+
+// headerAlignment returns the alignment of header in headerAlignments
+func headerAlignment(header string, langs []string) (Alignment, bool) {
+	for id, align := range headerAlignments {
+		if headerIs(header, id, langs) {
+			return align, true
+		}
+	}
+	return AlignDefault, false
+}
+
+// headerIs reports whether header is the text of the message id in one of
+// langs, whatever the language the table is shown in
+func headerIs(header, id string, langs []string) bool {
+	for _, lang := range langs {
+		if i18n.TfIn(lang, id) == header {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveAlignments fills AlignDefault entries, and columns missing from
+// aligns, with the default alignment for the column
+func resolveAlignments(aligns []Alignment, headers []string, rows [][]string) []Alignment {
+	resolved := DefaultAlignments(headers, rows)
+	for i := range resolved {
+		if i < len(aligns) && aligns[i] != AlignDefault {
+			resolved[i] = aligns[i]
+		}
+	}
+	return resolved
+}
+
+// isNumericColumn reports whether column col has at least one value and all
+// of its non-empty values are numbers such as "12" or "1,234"
+func isNumericColumn(rows [][]string, col int) bool {
+	found := false
+	for _, row := range rows {
+		if col >= len(row) {
+			continue
+		}
+		cell := strings.TrimSpace(row[col])
+		if cell == "" || cell == "-" {
+			continue
+		}
+		if !isNumber(cell) {
+			return false
+		}
+		found = true
+	}
+	return found
+}
+
+// isNumber reports whether s consists of digits with optional thousands separators
+func isNumber(s string) bool {
+	s = strings.TrimPrefix(s, "-")
+	if s == "" || s[0] == ',' || s[len(s)-1] == ',' {
+		return false
+	}
+	for _, r := range s {
+		if (r < '0' || r > '9') && r != ',' {
+			return false
+		}
+	}
+	return true
+}
+
+// tablewriterAlignment converts an alignment to the tablewriter constant
+func tablewriterAlignment(align Alignment) int {
+	switch align {
+	case AlignRight:
+		return tablewriter.ALIGN_RIGHT
+	case AlignCenter:
+		return tablewriter.ALIGN_CENTER
+	default:
+		return tablewriter.ALIGN_LEFT
+	}
+}
+
+// markdownSeparator returns the markdown header separator for an alignment
+func markdownSeparator(align Alignment) string {
+	switch align {
+	case AlignLeft:
+		return ":---"
+	case AlignRight:
+		return "---:"
+	case AlignCenter:
+		return ":---:"
+	default:
+		return "---"
+	}
+}
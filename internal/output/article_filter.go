@@ -0,0 +1,90 @@
+package output
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+var (
+	// articleNumberInput matches user input such as "58", "58조", "제58조", "제58조의2"
+	articleNumberInput = regexp.MustCompile(`^(?:제)?(\d+)(?:조)?(?:의(\d+))?$`)
+	// articleContentHeading matches the heading at the start of article content
+	articleContentHeading = regexp.MustCompile(`^제\s*\d+\s*조(?:\s*의\s*\d+)?`)
+)
+
+// keywordHighlight is the color used to highlight --grep matches
+var keywordHighlight = color.New(color.FgRed, color.Bold)
+
+// NormalizeArticleNumber converts an article number given as "제58조", "58조"
+// or "58" (optionally with a branch such as "의2") to the form "58" or "58의2"
+func NormalizeArticleNumber(input string) (string, error) {
+	compact := strings.Join(strings.Fields(input), "")
+	m := articleNumberInput.FindStringSubmatch(compact)
+	if m == nil {
+		return "", fmt.Errorf("잘못된 조문번호: %s (예: 58, 58조, 제58조, 제58조의2)", input)
+	}
+
+	number := strings.TrimLeft(m[1], "0")
+	if number == "" {
+		number = "0"
+	}
+	if m[2] != "" {
+		return number + "의" + m[2], nil
+	}
+	return number, nil
+}
+
+// articleKey returns the normalized number of an article, or "" for entries
+// that are not articles, such as chapter headings sharing the article number
+func articleKey(article api.Article) string {
+	content := strings.TrimSpace(article.Content)
+	if heading := articleContentHeading.FindString(content); heading != "" {
+		key, _ := NormalizeArticleNumber(heading)
+		return key
+	}
+	if content != "" && article.Title == "" {
+		return ""
+	}
+	key, _ := NormalizeArticleNumber(article.Number)
+	return key
+}
+
+// FilterArticles returns the articles matching the given article number and
+// containing keyword. An empty number or keyword does not filter.
+// number must already be normalized with NormalizeArticleNumber.
+func FilterArticles(articles []api.Article, number, keyword string) []api.Article {
+	var matched []api.Article
+	for _, article := range articles {
+		if number != "" && articleKey(article) != number {
+			continue
+		}
+		if keyword != "" && !strings.Contains(article.Title+"\n"+article.Content, keyword) {
+			continue
+		}
+		matched = append(matched, article)
+	}
+	return matched
+}
+
+// HighlightKeyword colors every occurrence of keyword in text when color is enabled
+func HighlightKeyword(text, keyword string, useColor bool) string {
+	if !useColor || keyword == "" {
+		return text
+	}
+	return strings.ReplaceAll(text, keyword, keywordHighlight.Sprint(keyword))
+}
+
+// HighlightArticles returns a copy of articles with keyword highlighted in titles and contents
+func HighlightArticles(articles []api.Article, keyword string, useColor bool) []api.Article {
+	highlighted := make([]api.Article, len(articles))
+	for i, article := range articles {
+		article.Title = HighlightKeyword(article.Title, keyword, useColor)
+		article.Content = HighlightKeyword(article.Content, keyword, useColor)
+		highlighted[i] = article
+	}
+	return highlighted
+}
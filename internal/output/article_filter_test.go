@@ -0,0 +1,126 @@
+package output
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+func TestNormalizeArticleNumber(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{"58", "58", false},
+		{"58조", "58", false},
+		{"제58조", "58", false},
+		{" 제 58 조 ", "58", false},
+		{"058", "58", false},
+		{"제58조의2", "58의2", false},
+		{"58의2", "58의2", false},
+		{"제58항", "", true},
+		{"abc", "", true},
+		{"", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := NormalizeArticleNumber(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NormalizeArticleNumber(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("NormalizeArticleNumber(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+var filterTestArticles = []api.Article{
+	{Number: "1", Content: "제1장 총칙"},
+	{Number: "1", Title: "목적", Content: "제1조(목적) 이 법은 도로 교통의 안전을 목적으로 한다."},
+	{Number: "58", Title: "과태료", Content: "제58조(과태료) 다음 각 호의 자에게는 과태료를 부과한다."},
+	{Number: "58", Title: "벌칙", Content: "제58조의2(벌칙) 위반한 자는 벌금에 처한다."},
+	{Number: "59", Title: "양벌규정", Content: "제59조(양벌규정) 벌금형 또는 과태료를 과한다."},
+}
+
+func TestFilterArticles(t *testing.T) {
+	tests := []struct {
+		name    string
+		number  string
+		keyword string
+		want    []string
+	}{
+		{"No filter", "", "", []string{"1", "1", "58", "58", "59"}},
+		{"By number skips chapter heading", "1", "", []string{"목적"}},
+		{"By number excludes branch", "58", "", []string{"과태료"}},
+		{"Branch article", "58의2", "", []string{"벌칙"}},
+		{"By keyword", "", "과태료", []string{"과태료", "양벌규정"}},
+		{"Keyword in title", "", "양벌", []string{"양벌규정"}},
+		{"Number and keyword", "59", "과태료", []string{"양벌규정"}},
+		{"No match", "100", "", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FilterArticles(filterTestArticles, tt.number, tt.keyword)
+			if len(got) != len(tt.want) {
+				t.Fatalf("FilterArticles() returned %d articles, want %d: %+v", len(got), len(tt.want), got)
+			}
+			if tt.number == "" && tt.keyword == "" {
+				return
+			}
+			for i, article := range got {
+				if article.Title != tt.want[i] {
+					t.Errorf("article %d title = %q, want %q", i, article.Title, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestHighlightKeyword(t *testing.T) {
+	keywordHighlight.EnableColor()
+	defer keywordHighlight.DisableColor()
+
+	text := "과태료를 부과한다. 과태료는"
+	if got := HighlightKeyword(text, "과태료", false); got != text {
+		t.Errorf("disabled highlight changed text: %q", got)
+	}
+	if got := HighlightKeyword(text, "", true); got != text {
+		t.Errorf("empty keyword changed text: %q", got)
+	}
+
+	got := HighlightKeyword(text, "과태료", true)
+	if strings.Count(got, "\x1b[") < 2 {
+		t.Errorf("expected every occurrence highlighted, got %q", got)
+	}
+	if stripped := stripANSI(got); stripped != text {
+		t.Errorf("highlight should only add color codes, got %q", stripped)
+	}
+
+	articles := HighlightArticles(filterTestArticles[2:3], "과태료", true)
+	if !strings.Contains(articles[0].Content, "\x1b[") {
+		t.Error("HighlightArticles should highlight content")
+	}
+	if strings.Contains(filterTestArticles[2].Content, "\x1b[") {
+		t.Error("HighlightArticles should not modify its input")
+	}
+}
+
+// stripANSI removes color escape sequences
+func stripANSI(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == 0x1b {
+			for i < len(s) && s[i] != 'm' {
+				i++
+			}
+			continue
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
@@ -0,0 +1,89 @@
+package output
+
+import (
+	"strings"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+// DefaultExcerptContext is the number of characters kept on each side of a
+// keyword in an excerpt
+const DefaultExcerptContext = 40
+
+// excerptEllipsis marks text cut from an excerpt
+const excerptEllipsis = "…"
+
+// ArticleMatch is an article containing a keyword, with the excerpts around
+// its occurrences. Number is normalized as by NormalizeArticleNumber.
+type ArticleMatch struct {
+	Number   string   `json:"article_no"`
+	Title    string   `json:"title,omitempty"`
+	Excerpts []string `json:"excerpts"`
+}
+
+// GrepArticles returns the articles whose title or content contains keyword,
+// in their order, with excerpts of context characters around each occurrence
+// in the content below the heading.
+// Entries that are not articles, such as chapter headings, are skipped.
+func GrepArticles(articles []api.Article, keyword string, context int) []ArticleMatch {
+	if keyword == "" {
+		return nil
+	}
+	var matches []ArticleMatch
+	for _, article := range articles {
+		number := articleKey(article)
+		if number == "" {
+			continue
+		}
+		excerpts := Excerpts(strings.Join(articleBody(article), " "), keyword, context)
+		if len(excerpts) == 0 && !strings.Contains(article.Title, keyword) {
+			continue
+		}
+		if len(excerpts) == 0 {
+			excerpts = []string{strings.Join(strings.Fields(article.Title), " ")}
+		}
+		matches = append(matches, ArticleMatch{Number: number, Title: article.Title, Excerpts: excerpts})
+	}
+	return matches
+}
+
+// Excerpts returns the parts of text around each occurrence of keyword, with
+// context characters on each side. Runs of white space are folded into one
+// space, excerpts that overlap are merged, and cut text is marked with "…".
+func Excerpts(text, keyword string, context int) []string {
+	if keyword == "" || context < 0 {
+		return nil
+	}
+	runes := []rune(strings.Join(strings.Fields(text), " "))
+	key := []rune(keyword)
+
+	// Windows [start, end) around the occurrences, merged when they overlap
+	type window struct{ start, end int }
+	var windows []window
+	for i := 0; i+len(key) <= len(runes); {
+		if string(runes[i:i+len(key)]) != keyword {
+			i++
+			continue
+		}
+		start, end := max(0, i-context), min(len(runes), i+len(key)+context)
+		if n := len(windows); n > 0 && start <= windows[n-1].end {
+			windows[n-1].end = end
+		} else {
+			windows = append(windows, window{start, end})
+		}
+		i += len(key)
+	}
+
+	excerpts := make([]string, len(windows))
+	for i, w := range windows {
+		excerpt := strings.TrimSpace(string(runes[w.start:w.end]))
+		if w.start > 0 {
+			excerpt = excerptEllipsis + excerpt
+		}
+		if w.end < len(runes) {
+			excerpt += excerptEllipsis
+		}
+		excerpts[i] = excerpt
+	}
+	return excerpts
+}
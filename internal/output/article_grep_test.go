@@ -0,0 +1,60 @@
+package output
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExcerpts(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		keyword string
+		context int
+		want    []string
+	}{
+		{"whole text", "과태료를 부과한다", "과태료", 10, []string{"과태료를 부과한다"}},
+		{"cut on both sides", "다음 각 호의 자에게는 과태료를 부과한다", "과태료", 3, []string{"…게는 과태료를 부…"}},
+		{"white space folded", "제1항\n\n  과태료를\t부과", "과태료", 20, []string{"제1항 과태료를 부과"}},
+		{"overlapping windows merged", "벌금 또는 벌금", "벌금", 3, []string{"벌금 또는 벌금"}},
+		{"separate windows", "벌금에 처한다. 그 밖의 경우에는 벌금을 면제한다", "벌금", 2, []string{"벌금에…", "…는 벌금을…"}},
+		{"no context", "벌금과 벌금", "벌금", 0, []string{"벌금…", "…벌금"}},
+		{"not found", "과태료를 부과한다", "벌금", 10, nil},
+		{"empty keyword", "과태료", "", 10, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Excerpts(tt.text, tt.keyword, tt.context)
+			if len(got) == 0 && len(tt.want) == 0 {
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Excerpts() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGrepArticles(t *testing.T) {
+	matches := GrepArticles(filterTestArticles, "과태료", 5)
+	var numbers []string
+	for _, m := range matches {
+		numbers = append(numbers, m.Number)
+	}
+	if want := []string{"58", "59"}; !reflect.DeepEqual(numbers, want) {
+		t.Fatalf("GrepArticles() numbers = %v, want %v", numbers, want)
+	}
+	if want := []string{"…자에게는 과태료를 부과한…"}; !reflect.DeepEqual(matches[0].Excerpts, want) {
+		t.Errorf("GrepArticles() excerpts = %q, want %q", matches[0].Excerpts, want)
+	}
+
+	// Chapter headings are not articles
+	if got := GrepArticles(filterTestArticles, "총칙", 5); len(got) != 0 {
+		t.Errorf("GrepArticles() = %v, want no chapter heading", got)
+	}
+	// A keyword found only in the title is shown with the title
+	title := GrepArticles(filterTestArticles[:2], "목적", 0)
+	if len(title) != 1 || title[0].Number != "1" {
+		t.Errorf("GrepArticles() = %v, want the article with the keyword", title)
+	}
+}
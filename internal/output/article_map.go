@@ -0,0 +1,377 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+// DefaultMapThreshold is the similarity from which two articles correspond
+const DefaultMapThreshold = 0.5
+
+// Status of a row of an article map
+const (
+	MapMatched = "대응"
+	MapAdded   = "신설" // only in the right law
+	MapRemoved = "삭제" // only in the left law
+)
+
+// How the articles of a row were matched
+const (
+	MatchedByNumber     = "number"
+	MatchedBySimilarity = "similarity"
+)
+
+// MapArticle is an article in a row of an article map
+type MapArticle struct {
+	Number string `json:"number"` // normalized, such as "58의2"
+	Title  string `json:"title,omitempty"`
+}
+
+// Label returns the article as written in laws, with its title:
+// "제58조의2(목적)"
+func (a MapArticle) Label() string {
+	label := TOCEntry{Number: a.Number}.Label()
+	if a.Title != "" {
+		label += "(" + a.Title + ")"
+	}
+	return label
+}
+
+// ArticleMapRow is a pair of corresponding articles, or an article only one
+// of the laws has
+type ArticleMapRow struct {
+	Status    string      `json:"status"`
+	Left      *MapArticle `json:"left,omitempty"`
+	Right     *MapArticle `json:"right,omitempty"`
+	Score     float64     `json:"score,omitempty"`
+	MatchedBy string      `json:"matched_by,omitempty"`
+}
+
+// ArticleMapLaw is a law compared in an article map
+type ArticleMapLaw struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// ArticleMap is the article correspondence of two laws
+type ArticleMap struct {
+	Left      ArticleMapLaw   `json:"left"`
+	Right     ArticleMapLaw   `json:"right"`
+	Threshold float64         `json:"threshold"`
+	Summary   map[string]int  `json:"summary"`
+	Rows      []ArticleMapRow `json:"rows"`
+}
+
+// NewArticleMap maps the articles of the left law to those of the right law,
+// see MatchArticles
+func NewArticleMap(left, right *api.LawDetail, threshold float64) *ArticleMap {
+	rows := MatchArticles(left.Articles, right.Articles, threshold)
+	summary := map[string]int{MapMatched: 0, MapAdded: 0, MapRemoved: 0}
+	for _, row := range rows {
+		summary[row.Status]++
+	}
+	return &ArticleMap{
+		Left:      mapLaw(left),
+		Right:     mapLaw(right),
+		Threshold: threshold,
+		Summary:   summary,
+		Rows:      rows,
+	}
+}
+
+// mapLaw returns the ID and name of a law detail
+func mapLaw(detail *api.LawDetail) ArticleMapLaw {
+	id := detail.ID
+	if id == "" {
+		id = detail.SerialNo
+	}
+	return ArticleMapLaw{ID: id, Name: detail.Name}
+}
+
+// mapEntry is an article being matched, with its text split into bigrams
+type mapEntry struct {
+	article MapArticle
+	title   map[string]int
+	body    map[string]int
+}
+
+// MatchArticles pairs the articles of left and right. Articles with the same
+// number correspond if their title is the same or their similarity reaches
+// threshold; the remaining articles are then paired by similarity, the most
+// similar first, as long as it reaches threshold. Similarity is the overlap
+// of the character bigrams of titles and contents, from 0 to 1.
+//
+// Rows follow the left law, with the articles only the right law has after
+// the row of the right article preceding them and any removed articles there. Chapter headings and repeated
+// article numbers are skipped.
+func MatchArticles(left, right []api.Article, threshold float64) []ArticleMapRow {
+	lefts, rights := mapEntries(left), mapEntries(right)
+	pairs := make([]int, len(lefts)) // index of the right entry of each left entry, -1 if none
+	scores := make([]float64, len(lefts))
+	by := make([]string, len(lefts))
+	rightUsed := make([]bool, len(rights))
+	for i := range pairs {
+		pairs[i] = -1
+	}
+
+	byNumber := make(map[string]int, len(rights))
+	for j, r := range rights {
+		byNumber[r.article.Number] = j
+	}
+	for i, l := range lefts {
+		j, ok := byNumber[l.article.Number]
+		if !ok {
+			continue
+		}
+		score := similarity(l, rights[j])
+		sameTitle := l.article.Title != "" && l.article.Title == rights[j].article.Title
+		if sameTitle || score >= threshold {
+			pairs[i], scores[i], by[i] = j, score, MatchedByNumber
+			rightUsed[j] = true
+		}
+	}
+
+	type candidate struct {
+		left, right int
+		score       float64
+	}
+	var candidates []candidate
+	for i, l := range lefts {
+		if pairs[i] >= 0 {
+			continue
+		}
+		for j, r := range rights {
+			if rightUsed[j] {
+				continue
+			}
+			if score := similarity(l, r); score >= threshold {
+				candidates = append(candidates, candidate{i, j, score})
+			}
+		}
+	}
+	sort.SliceStable(candidates, func(a, b int) bool {
+		return candidates[a].score > candidates[b].score
+	})
+	for _, c := range candidates {
+		if pairs[c.left] >= 0 || rightUsed[c.right] {
+			continue
+		}
+		pairs[c.left], scores[c.left], by[c.left] = c.right, c.score, MatchedBySimilarity
+		rightUsed[c.right] = true
+	}
+
+	rows := make([]ArticleMapRow, 0, len(lefts)+len(rights))
+	// rightRows[j] is the position of the row holding right entry j
+	rightRows := make(map[int]int, len(rights))
+	for i, l := range lefts {
+		left := l.article
+		if j := pairs[i]; j >= 0 {
+			right := rights[j].article
+			rightRows[j] = len(rows)
+			rows = append(rows, ArticleMapRow{Status: MapMatched, Left: &left, Right: &right, Score: roundScore(scores[i]), MatchedBy: by[i]})
+			continue
+		}
+		rows = append(rows, ArticleMapRow{Status: MapRemoved, Left: &left})
+	}
+
+	for j, r := range rights {
+		if rightUsed[j] {
+			continue
+		}
+		// After the row of the nearest preceding right article and the rows
+		// only one of the laws has that follow it
+		at := 0
+		for k := j - 1; k >= 0; k-- {
+			if pos, ok := rightRows[k]; ok {
+				at = pos + 1
+				break
+			}
+		}
+		for at < len(rows) && rows[at].Status != MapMatched {
+			at++
+		}
+		right := r.article
+		rows = append(rows, ArticleMapRow{})
+		copy(rows[at+1:], rows[at:])
+		rows[at] = ArticleMapRow{Status: MapAdded, Right: &right}
+		for k, pos := range rightRows {
+			if pos >= at {
+				rightRows[k] = pos + 1
+			}
+		}
+		rightRows[j] = at
+	}
+	return rows
+}
+
+// mapEntries returns the articles to match, skipping chapter headings and
+// repeated numbers
+func mapEntries(articles []api.Article) []mapEntry {
+	var entries []mapEntry
+	seen := make(map[string]bool)
+	for _, article := range articles {
+		number := articleKey(article)
+		if number == "" || seen[number] {
+			continue
+		}
+		seen[number] = true
+		title := strings.TrimSpace(article.Title)
+		entries = append(entries, mapEntry{
+			article: MapArticle{Number: number, Title: title},
+			title:   bigrams(title),
+			body:    bigrams(strings.Join(articleBody(article), "\n")),
+		})
+	}
+	return entries
+}
+
+// similarity returns how similar two articles are, from 0 to 1. Titles weigh
+// less than contents; an article lacking either is compared by the other.
+func similarity(a, b mapEntry) float64 {
+	hasTitles := len(a.title) > 0 && len(b.title) > 0
+	hasBodies := len(a.body) > 0 && len(b.body) > 0
+	switch {
+	case hasTitles && hasBodies:
+		return 0.3*dice(a.title, b.title) + 0.7*dice(a.body, b.body)
+	case hasBodies:
+		return dice(a.body, b.body)
+	case hasTitles:
+		return dice(a.title, b.title)
+	default:
+		return 0
+	}
+}
+
+// bigrams returns the character bigrams of s without spaces and punctuation,
+// with their counts. A single character is its own bigram.
+func bigrams(s string) map[string]int {
+	var runes []rune
+	for _, r := range s {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			runes = append(runes, r)
+		}
+	}
+	counts := make(map[string]int)
+	if len(runes) == 1 {
+		counts[string(runes)]++
+	}
+	for i := 0; i+1 < len(runes); i++ {
+		counts[string(runes[i:i+2])]++
+	}
+	return counts
+}
+
+// dice returns the Dice coefficient of two bigram multisets
+func dice(a, b map[string]int) float64 {
+	total := 0
+	for _, n := range a {
+		total += n
+	}
+	for _, n := range b {
+		total += n
+	}
+	if total == 0 {
+		return 0
+	}
+	common := 0
+	for gram, n := range a {
+		if m := b[gram]; m < n {
+			common += m
+		} else {
+			common += n
+		}
+	}
+	return 2 * float64(common) / float64(total)
+}
+
+// roundScore rounds a similarity to two decimals for output
+func roundScore(score float64) float64 {
+	return float64(int(score*100+0.5)) / 100
+}
+
+// FormatArticleMap formats an article map as a table, markdown or json
+func (f *Formatter) FormatArticleMap(m *ArticleMap) (string, error) {
+	switch f.format {
+	case "table", "":
+		return f.formatArticleMapTable(m, false), nil
+	case "markdown", "md":
+		return f.formatArticleMapTable(m, true), nil
+	case "json":
+		data, err := json.MarshalIndent(m, "", "  ")
+		if err != nil {
+			return "", fmt.Errorf("JSON 변환 실패: %w", err)
+		}
+		return string(data) + "\n", nil
+	default:
+		return "", fmt.Errorf("지원하지 않는 출력 형식: %s (table, markdown, json 중 선택)", f.format)
+	}
+}
+
+// formatArticleMapTable writes the rows of an article map side by side
+func (f *Formatter) formatArticleMapTable(m *ArticleMap, markdown bool) string {
+	headers := []string{f.t("output.map.status"), mapLawLabel(m.Left), mapLawLabel(m.Right), f.t("output.map.score")}
+	rows := make([][]string, 0, len(m.Rows))
+	for _, row := range m.Rows {
+		left, right, score := "-", "-", "-"
+		if row.Left != nil {
+			left = row.Left.Label()
+		}
+		if row.Right != nil {
+			right = row.Right.Label()
+		}
+		if row.Status == MapMatched {
+			score = fmt.Sprintf("%.2f", row.Score)
+			if row.MatchedBy == MatchedByNumber {
+				score += " " + f.t("output.map.byNumber")
+			}
+		}
+		rows = append(rows, []string{f.mapStatusLabel(row.Status), left, right, score})
+	}
+	summary := f.t("output.map.summary",
+		f.mapStatusLabel(MapMatched), m.Summary[MapMatched], f.mapStatusLabel(MapAdded), m.Summary[MapAdded],
+		f.mapStatusLabel(MapRemoved), m.Summary[MapRemoved], m.Threshold)
+
+	var buf bytes.Buffer
+	if markdown {
+		fmt.Fprintf(&buf, "# %s\n\n", f.t("output.map.title"))
+		fmt.Fprint(&buf, RenderMarkdownTable(headers, rows))
+		fmt.Fprintf(&buf, "\n%s\n", summary)
+		return buf.String()
+	}
+	fmt.Fprint(&buf, RenderTable(headers, rows, nil))
+	fmt.Fprintf(&buf, "\n%s\n", summary)
+	return buf.String()
+}
+
+// mapStatusLabel returns the status of a row as shown in the table; the json
+// output keeps the status itself
+func (f *Formatter) mapStatusLabel(status string) string {
+	switch status {
+	case MapMatched:
+		return f.t("output.map.matched")
+	case MapAdded:
+		return f.t("output.map.added")
+	case MapRemoved:
+		return f.t("output.map.removed")
+	default:
+		return status
+	}
+}
+
+// mapLawLabel returns the column header of a law of an article map
+func mapLawLabel(law ArticleMapLaw) string {
+	switch {
+	case law.Name != "" && law.ID != "":
+		return law.Name + " (" + law.ID + ")"
+	case law.Name != "":
+		return law.Name
+	default:
+		return law.ID
+	}
+}
@@ -0,0 +1,214 @@
+package output
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+// mapArticle builds an article the way the detail API returns it
+func mapArticle(number, title, body string) api.Article {
+	return api.Article{Number: number, Title: title, Content: "제" + number + "조(" + title + ") " + body}
+}
+
+// mapRowsString describes rows as "status left>right" for comparison
+func mapRowsString(rows []ArticleMapRow) string {
+	var parts []string
+	for _, row := range rows {
+		left, right := "-", "-"
+		if row.Left != nil {
+			left = row.Left.Number
+		}
+		if row.Right != nil {
+			right = row.Right.Number
+		}
+		parts = append(parts, row.Status+" "+left+">"+right)
+	}
+	return strings.Join(parts, ", ")
+}
+
+func TestMatchArticles(t *testing.T) {
+	tests := []struct {
+		name      string
+		left      []api.Article
+		right     []api.Article
+		threshold float64
+		want      string
+	}{
+		{
+			name: "same numbers",
+			left: []api.Article{
+				mapArticle("1", "목적", "이 법은 개인정보의 처리에 관한 사항을 정한다."),
+				mapArticle("2", "정의", "이 법에서 사용하는 용어의 뜻은 다음과 같다."),
+			},
+			right: []api.Article{
+				mapArticle("1", "목적", "이 법은 개인정보의 처리 및 보호에 관한 사항을 정한다."),
+				mapArticle("2", "정의", "이 법에서 사용하는 용어의 뜻은 다음과 같다."),
+			},
+			threshold: 0.5,
+			want:      "대응 1>1, 대응 2>2",
+		},
+		{
+			name: "renumbered articles follow their content",
+			left: []api.Article{
+				mapArticle("1", "목적", "이 법은 개인정보의 처리에 관한 사항을 정한다."),
+				mapArticle("2", "정보주체의 권리", "정보주체는 자신의 개인정보 처리와 관련하여 열람을 요구할 권리를 가진다."),
+				mapArticle("3", "과태료", "다음 각 호의 어느 하나에 해당하는 자에게는 과태료를 부과한다."),
+			},
+			right: []api.Article{
+				mapArticle("1", "목적", "이 법은 개인정보의 처리에 관한 사항을 정한다."),
+				mapArticle("2", "국가의 책무", "국가는 개인정보 보호 정책을 수립하고 시행하여야 한다."),
+				mapArticle("3", "정보주체의 권리", "정보주체는 자신의 개인정보 처리와 관련하여 열람을 요구할 권리를 가진다."),
+				mapArticle("4", "과태료", "다음 각 호의 어느 하나에 해당하는 자에게는 과태료를 부과한다."),
+			},
+			threshold: 0.5,
+			want:      "대응 1>1, 신설 ->2, 대응 2>3, 대응 3>4",
+		},
+		{
+			name: "removed and added",
+			left: []api.Article{
+				mapArticle("1", "목적", "이 영은 법에서 위임된 사항을 정한다."),
+				mapArticle("2", "위원회", "위원회는 위원장 1명을 포함한 9명의 위원으로 구성한다."),
+			},
+			right: []api.Article{
+				mapArticle("1", "목적", "이 영은 법에서 위임된 사항을 정한다."),
+				mapArticle("2", "수수료", "열람 청구에 따른 수수료는 실비의 범위에서 정한다."),
+			},
+			threshold: 0.5,
+			want:      "대응 1>1, 삭제 2>-, 신설 ->2",
+		},
+		{
+			name: "same title matches by number below the threshold",
+			left: []api.Article{
+				mapArticle("5", "벌칙", "3년 이하의 징역에 처한다."),
+			},
+			right: []api.Article{
+				mapArticle("5", "벌칙", "5천만원 이하의 벌금을 부과한다."),
+			},
+			threshold: 0.9,
+			want:      "대응 5>5",
+		},
+		{
+			name: "branch numbers, headings and duplicates",
+			left: []api.Article{
+				{Number: "1", Content: "제1장 총칙"},
+				mapArticle("1", "목적", "이 법은 도로에서 일어나는 위험을 방지한다."),
+				mapArticle("1", "목적", "중복된 조문"),
+				mapArticle("2의2", "정의", "이 법에서 사용하는 용어의 뜻은 다음과 같다."),
+			},
+			right: []api.Article{
+				mapArticle("1", "목적", "이 법은 도로에서 일어나는 위험과 장해를 방지한다."),
+				mapArticle("3", "용어의 정의", "이 법에서 사용하는 용어의 뜻은 다음과 같다."),
+			},
+			threshold: 0.5,
+			want:      "대응 1>1, 대응 2의2>3",
+		},
+		{
+			name:      "empty right law",
+			left:      []api.Article{mapArticle("1", "목적", "목적 조문")},
+			threshold: 0.5,
+			want:      "삭제 1>-",
+		},
+		{
+			name:      "added only",
+			right:     []api.Article{mapArticle("1", "목적", "목적 조문"), mapArticle("2", "정의", "정의 조문")},
+			threshold: 0.5,
+			want:      "신설 ->1, 신설 ->2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mapRowsString(MatchArticles(tt.left, tt.right, tt.threshold)); got != tt.want {
+				t.Errorf("MatchArticles() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchArticlesScores(t *testing.T) {
+	left := []api.Article{mapArticle("1", "목적", "이 법은 개인정보의 처리에 관한 사항을 정한다.")}
+	right := []api.Article{
+		mapArticle("1", "목적", "이 법은 개인정보의 처리에 관한 사항을 정한다."),
+		mapArticle("2", "정의", "이 법은 개인정보의 처리에 관한 사항을 정한다."),
+	}
+	rows := MatchArticles(left, right, 0.5)
+	if rows[0].Score != 1 || rows[0].MatchedBy != MatchedByNumber {
+		t.Errorf("identical articles of the same number: %+v, want score 1 by number", rows[0])
+	}
+
+	// Without a number match the most similar article is chosen
+	left[0].Number, left[0].Content = "9", "제9조(정의) 이 법은 개인정보의 처리에 관한 사항을 정한다."
+	left[0].Title = "정의"
+	rows = MatchArticles(left, right, 0.5)
+	if mapRowsString(rows) != "신설 ->1, 대응 9>2" || rows[1].MatchedBy != MatchedBySimilarity {
+		t.Errorf("rows = %s (%+v)", mapRowsString(rows), rows)
+	}
+}
+
+// articleMapFixture returns two versions of a law for the rendering tests
+func articleMapFixture() *ArticleMap {
+	left := &api.LawDetail{
+		LawInfo: api.LawInfo{ID: "000001", Name: "개인정보 보호법"},
+		Articles: []api.Article{
+			mapArticle("1", "목적", "이 법은 개인정보의 처리에 관한 사항을 정한다."),
+			mapArticle("2", "정보주체의 권리", "정보주체는 자신의 개인정보 처리와 관련하여 열람을 요구할 권리를 가진다."),
+			mapArticle("3", "위원회", "위원회는 위원장 1명을 포함한 9명의 위원으로 구성한다."),
+		},
+	}
+	right := &api.LawDetail{
+		LawInfo: api.LawInfo{ID: "000002", Name: "개인정보 보호법(개정안)"},
+		Articles: []api.Article{
+			mapArticle("1", "목적", "이 법은 개인정보의 처리 및 보호에 관한 사항을 정한다."),
+			mapArticle("2", "국가의 책무", "국가는 개인정보 보호 정책을 수립하고 시행하여야 한다."),
+			mapArticle("3", "정보주체의 권리", "정보주체는 자신의 개인정보 처리와 관련하여 열람을 요구할 권리를 가진다."),
+		},
+	}
+	return NewArticleMap(left, right, DefaultMapThreshold)
+}
+
+func TestFormatArticleMap(t *testing.T) {
+	m := articleMapFixture()
+	if m.Summary[MapMatched] != 2 || m.Summary[MapAdded] != 1 || m.Summary[MapRemoved] != 1 {
+		t.Errorf("Summary = %v", m.Summary)
+	}
+
+	table, err := NewFormatter("table").FormatArticleMap(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertGolden(t, "article_map_table", table)
+
+	markdown, err := NewFormatter("markdown").FormatArticleMap(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertGolden(t, "article_map_markdown", markdown)
+
+	english, err := NewFormatter("markdown").WithLanguage("en").FormatArticleMap(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"# Article Map\n", "| Status |", "Similarity", "| Matched |", "| Added |", "(number)", "Matched 2, Added 1, Removed 1 (similarity threshold 0.50)"} {
+		if !strings.Contains(english, want) {
+			t.Errorf("english output should contain %q:\n%s", want, english)
+		}
+	}
+
+	out, err := NewFormatter("json").FormatArticleMap(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded ArticleMap
+	if err := json.Unmarshal([]byte(out), &decoded); err != nil {
+		t.Fatalf("json output does not parse: %v\n%s", err, out)
+	}
+	if decoded.Left.ID != "000001" || len(decoded.Rows) != 4 || decoded.Rows[1].Status != MapAdded || decoded.Rows[1].Right.Title != "국가의 책무" {
+		t.Errorf("json output = %+v", decoded)
+	}
+
+	if _, err := NewFormatter("csv").FormatArticleMap(m); err == nil {
+		t.Error("FormatArticleMap should reject csv")
+	}
+}
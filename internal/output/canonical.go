@@ -0,0 +1,179 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+// JSON schemas for json and ndjson output
+const (
+	// SchemaRaw keeps the field names of the upstream API (법령명한글, totalCnt, ...)
+	SchemaRaw = "raw"
+	// SchemaCanonical uses stable English snake_case field names
+	SchemaCanonical = "canonical"
+)
+
+// CanonicalLaw is a law in the canonical JSON schema
+type CanonicalLaw struct {
+	ID               string `json:"law_id"`
+	Name             string `json:"law_name"`
+	NameHanja        string `json:"law_name_hanja"`
+	NameAbbrev       string `json:"law_name_abbrev"`
+	SerialNo         string `json:"serial_no"`
+	PromulgationDate string `json:"promulgation_date"`
+	PromulgationNo   string `json:"promulgation_no"`
+	RevisionType     string `json:"revision_type"`
+	Department       string `json:"department"`
+	EffectDate       string `json:"effect_date"`
+	LawType          string `json:"law_type"`
+	Source           string `json:"source,omitempty"`
+}
+
+// CanonicalSearchResponse is a search response in the canonical JSON schema
+type CanonicalSearchResponse struct {
+	TotalCount   int                `json:"total_count"`
+	FetchedCount int                `json:"fetched_count,omitempty"`
+	Page         int                `json:"page"`
+	Laws         []CanonicalLaw     `json:"laws"`
+	Sources      []api.SourceStatus `json:"sources,omitempty"`
+	Warnings     []string           `json:"warnings,omitempty"`
+	Fingerprint  string             `json:"fingerprint,omitempty"`
+	Summary      *api.ResultSummary `json:"summary,omitempty"`
+}
+
+// ndjsonMeta is the first line of ndjson output
+type ndjsonMeta struct {
+	Meta struct {
+		TotalCount   int                `json:"total_count"`
+		FetchedCount int                `json:"fetched_count,omitempty"`
+		Page         int                `json:"page"`
+		Count        int                `json:"count"`
+		Sources      []api.SourceStatus `json:"sources,omitempty"`
+		Warnings     []string           `json:"warnings,omitempty"`
+		Fingerprint  string             `json:"fingerprint,omitempty"`
+		Summary      *api.ResultSummary `json:"summary,omitempty"`
+	} `json:"meta"`
+}
+
+// ToCanonicalLaw converts a law to the canonical JSON schema
+func ToCanonicalLaw(law api.LawInfo) CanonicalLaw {
+	return CanonicalLaw{
+		ID:               law.ID,
+		Name:             law.Name,
+		NameHanja:        law.NameHanja,
+		NameAbbrev:       law.NameAbbrev,
+		SerialNo:         law.SerialNo,
+		PromulgationDate: law.PromulDate,
+		PromulgationNo:   law.PromulNo,
+		RevisionType:     law.Category,
+		Department:       law.Department,
+		EffectDate:       law.EffectDate,
+		LawType:          law.LawType,
+		Source:           law.Source,
+	}
+}
+
+// ToCanonical converts a search response to the canonical JSON schema
+func ToCanonical(resp *api.SearchResponse) *CanonicalSearchResponse {
+	laws := make([]CanonicalLaw, 0, len(resp.Laws))
+	for _, law := range resp.Laws {
+		laws = append(laws, ToCanonicalLaw(law))
+	}
+	return &CanonicalSearchResponse{
+		TotalCount:   resp.TotalCount,
+		FetchedCount: resp.FetchedCount,
+		Page:         resp.Page,
+		Laws:         laws,
+		Sources:      resp.Sources,
+		Warnings:     resp.Warnings,
+		Fingerprint:  resp.Fingerprint,
+		Summary:      resp.Summary,
+	}
+}
+
+// WithJSONSchema sets the schema of json and ndjson output (raw or canonical)
+func (f *Formatter) WithJSONSchema(schema string) *Formatter {
+	f.jsonSchema = strings.ToLower(schema)
+	return f
+}
+
+// validateJSONSchema checks the configured JSON schema
+func (f *Formatter) validateJSONSchema() error {
+	switch f.jsonSchema {
+	case "", SchemaRaw, SchemaCanonical:
+		return nil
+	default:
+		return fmt.Errorf("지원하지 않는 JSON 스키마: %s (raw, canonical 중 선택)", f.jsonSchema)
+	}
+}
+
+// jsonValue returns the value encoded for a search response in the configured schema
+func (f *Formatter) jsonValue(resp *api.SearchResponse) interface{} {
+	if f.jsonSchema == SchemaCanonical {
+		return ToCanonical(resp)
+	}
+	return resp
+}
+
+// jsonLawValue returns the value encoded for a single law in the configured schema
+func (f *Formatter) jsonLawValue(law api.LawInfo) interface{} {
+	if f.jsonSchema == SchemaCanonical {
+		return ToCanonicalLaw(law)
+	}
+	return law
+}
+
+// WriteNDJSON writes the search response as newline-delimited JSON: a meta object
+// with the total count (and the source status of a unified search) on the first line, then one law per line. Each line is
+// flushed as soon as it is encoded when w supports flushing (e.g. bufio.Writer).
+func (f *Formatter) WriteNDJSON(w io.Writer, resp *api.SearchResponse) error {
+	if err := f.validateJSONSchema(); err != nil {
+		return err
+	}
+
+	flusher, _ := w.(interface{ Flush() error })
+	encoder := json.NewEncoder(w)
+	writeLine := func(v interface{}) error {
+		if err := encoder.Encode(v); err != nil {
+			return err
+		}
+		if flusher != nil {
+			return flusher.Flush()
+		}
+		return nil
+	}
+
+	var meta ndjsonMeta
+	meta.Meta.TotalCount = resp.TotalCount
+	meta.Meta.FetchedCount = resp.FetchedCount
+	meta.Meta.Page = resp.Page
+	meta.Meta.Count = len(resp.Laws)
+	meta.Meta.Sources = resp.Sources
+	meta.Meta.Warnings = resp.Warnings
+	meta.Meta.Fingerprint = resp.Fingerprint
+	meta.Meta.Summary = resp.Summary
+	if err := writeLine(meta); err != nil {
+		return err
+	}
+
+	for _, law := range resp.Laws {
+		if err := writeLine(f.jsonLawValue(law)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// formatNDJSONToString formats results as ndjson and returns as string
+func (f *Formatter) formatNDJSONToString(resp *api.SearchResponse) (string, error) {
+	var buf bytes.Buffer
+	if err := f.WriteNDJSON(&buf, resp); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
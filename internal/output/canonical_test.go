@@ -0,0 +1,239 @@
+package output
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+func canonicalTestResponse() *api.SearchResponse {
+	return &api.SearchResponse{
+		TotalCount: 120,
+		Page:       2,
+		Laws: []api.LawInfo{
+			{
+				ID:         "001",
+				Name:       "개인정보 보호법",
+				NameAbbrev: "개인정보법",
+				SerialNo:   "12345",
+				PromulDate: "20230314",
+				PromulNo:   "제19234호",
+				Category:   "일부개정",
+				Department: "개인정보보호위원회",
+				EffectDate: "20230915",
+				LawType:    "법률",
+			},
+			{
+				ID:         "002",
+				Name:       "개인정보 보호법 시행령",
+				EffectDate: "20230915",
+				LawType:    "대통령령",
+				Source:     "국가법령",
+			},
+		},
+	}
+}
+
+func TestCanonicalJSON(t *testing.T) {
+	result, err := NewFormatter("json").WithJSONSchema(SchemaCanonical).FormatSearchResultToString(canonicalTestResponse())
+	if err != nil {
+		t.Fatalf("FormatSearchResultToString() error = %v", err)
+	}
+
+	var got map[string]interface{}
+	if err := json.Unmarshal([]byte(result), &got); err != nil {
+		t.Fatalf("Output is not valid JSON: %v\n%s", err, result)
+	}
+	if got["total_count"] != float64(120) || got["page"] != float64(2) {
+		t.Errorf("Unexpected meta fields: %v", got)
+	}
+
+	laws, ok := got["laws"].([]interface{})
+	if !ok || len(laws) != 2 {
+		t.Fatalf("laws = %v, want 2 entries", got["laws"])
+	}
+	first := laws[0].(map[string]interface{})
+	want := map[string]string{
+		"law_id":            "001",
+		"law_name":          "개인정보 보호법",
+		"law_name_abbrev":   "개인정보법",
+		"serial_no":         "12345",
+		"promulgation_date": "20230314",
+		"promulgation_no":   "제19234호",
+		"revision_type":     "일부개정",
+		"department":        "개인정보보호위원회",
+		"effect_date":       "20230915",
+		"law_type":          "법률",
+	}
+	for key, value := range want {
+		if first[key] != value {
+			t.Errorf("%s = %v, want %q", key, first[key], value)
+		}
+	}
+	if _, ok := first["source"]; ok {
+		t.Errorf("Empty source should be omitted, got %v", first["source"])
+	}
+	if laws[1].(map[string]interface{})["source"] != "국가법령" {
+		t.Errorf("source = %v, want 국가법령", laws[1].(map[string]interface{})["source"])
+	}
+	if strings.Contains(result, "법령명한글") || strings.Contains(result, "totalCnt") {
+		t.Errorf("Canonical output should not contain raw API keys:\n%s", result)
+	}
+}
+
+func TestRawJSONIsDefault(t *testing.T) {
+	result, err := NewFormatter("json").FormatSearchResultToString(canonicalTestResponse())
+	if err != nil {
+		t.Fatalf("FormatSearchResultToString() error = %v", err)
+	}
+	if !strings.Contains(result, `"totalCnt": 120`) || !strings.Contains(result, `"법령명한글": "개인정보 보호법"`) {
+		t.Errorf("Raw output should keep the API keys:\n%s", result)
+	}
+}
+
+func TestInvalidJSONSchema(t *testing.T) {
+	for _, format := range []string{"json", "ndjson"} {
+		_, err := NewFormatter(format).WithJSONSchema("camel").FormatSearchResultToString(canonicalTestResponse())
+		if err == nil || !strings.Contains(err.Error(), "지원하지 않는 JSON 스키마") {
+			t.Errorf("%s: error = %v, want unsupported schema error", format, err)
+		}
+	}
+}
+
+func TestNDJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		schema  string
+		nameKey string
+	}{
+		{"raw", SchemaRaw, "법령명한글"},
+		{"canonical", SchemaCanonical, "law_name"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewFormatter("ndjson").WithJSONSchema(tt.schema).FormatSearchResultToString(canonicalTestResponse())
+			if err != nil {
+				t.Fatalf("FormatSearchResultToString() error = %v", err)
+			}
+
+			lines := strings.Split(strings.TrimSuffix(result, "\n"), "\n")
+			if len(lines) != 3 {
+				t.Fatalf("Expected a meta line and 2 law lines, got %d:\n%s", len(lines), result)
+			}
+
+			var meta ndjsonMeta
+			if err := json.Unmarshal([]byte(lines[0]), &meta); err != nil {
+				t.Fatalf("Meta line is not valid JSON: %v", err)
+			}
+			if meta.Meta.TotalCount != 120 || meta.Meta.Page != 2 || meta.Meta.Count != 2 {
+				t.Errorf("meta = %+v, want total 120, page 2, count 2", meta.Meta)
+			}
+
+			for i, line := range lines[1:] {
+				var law map[string]interface{}
+				if err := json.Unmarshal([]byte(line), &law); err != nil {
+					t.Fatalf("Line %d is not valid JSON: %v", i+2, err)
+				}
+				if _, ok := law[tt.nameKey]; !ok {
+					t.Errorf("Line %d should contain key %q: %s", i+2, tt.nameKey, line)
+				}
+			}
+		})
+	}
+}
+
+// countingWriter counts flushes of a buffered writer
+type countingWriter struct {
+	*bufio.Writer
+	flushes int
+}
+
+func (w *countingWriter) Flush() error {
+	w.flushes++
+	return w.Writer.Flush()
+}
+
+func TestNDJSONFlushesEachLine(t *testing.T) {
+	var buf bytes.Buffer
+	w := &countingWriter{Writer: bufio.NewWriter(&buf)}
+
+	if err := NewFormatter("ndjson").WriteNDJSON(w, canonicalTestResponse()); err != nil {
+		t.Fatalf("WriteNDJSON() error = %v", err)
+	}
+	if w.flushes != 3 {
+		t.Errorf("flushes = %d, want 3 (meta + 2 laws)", w.flushes)
+	}
+	if strings.Count(buf.String(), "\n") != 3 {
+		t.Errorf("Expected 3 lines to be written, got:\n%s", buf.String())
+	}
+}
+
+func TestSourceStatusOutput(t *testing.T) {
+	resp := canonicalTestResponse()
+	resp.Sources = []api.SourceStatus{
+		{Source: "NLIC", Label: "국가법령", Status: api.SourceOK, TotalCount: 120},
+		{Source: "ELIS", Label: "자치법규", Status: api.SourceFailed, Error: "timeout"},
+	}
+	resp.Warnings = api.SourceWarnings(resp.Sources)
+
+	for _, schema := range []string{SchemaRaw, SchemaCanonical} {
+		t.Run("json "+schema, func(t *testing.T) {
+			result, err := NewFormatter("json").WithJSONSchema(schema).FormatSearchResultToString(resp)
+			if err != nil {
+				t.Fatalf("FormatSearchResultToString() error = %v", err)
+			}
+			var decoded struct {
+				Sources  []api.SourceStatus `json:"sources"`
+				Warnings []string           `json:"warnings"`
+			}
+			if err := json.Unmarshal([]byte(result), &decoded); err != nil {
+				t.Fatalf("invalid JSON: %v", err)
+			}
+			if len(decoded.Sources) != 2 || decoded.Sources[1].Status != api.SourceFailed || decoded.Sources[1].Error != "timeout" {
+				t.Errorf("sources = %+v, want the failed ELIS status", decoded.Sources)
+			}
+			if len(decoded.Warnings) != 1 {
+				t.Errorf("warnings = %q, want one", decoded.Warnings)
+			}
+		})
+	}
+
+	t.Run("ndjson meta", func(t *testing.T) {
+		result, err := NewFormatter("ndjson").FormatSearchResultToString(resp)
+		if err != nil {
+			t.Fatalf("FormatSearchResultToString() error = %v", err)
+		}
+		var meta ndjsonMeta
+		if err := json.Unmarshal([]byte(strings.SplitN(result, "\n", 2)[0]), &meta); err != nil {
+			t.Fatalf("meta line is not valid JSON: %v", err)
+		}
+		if len(meta.Meta.Sources) != 2 || len(meta.Meta.Warnings) != 1 {
+			t.Errorf("meta = %+v, want sources and warnings", meta.Meta)
+		}
+	})
+
+	t.Run("table", func(t *testing.T) {
+		result, err := NewFormatter("table").FormatSearchResultToString(resp)
+		if err != nil {
+			t.Fatalf("FormatSearchResultToString() error = %v", err)
+		}
+		if !strings.Contains(result, "⚠️  ELIS(자치법규) 검색에 실패") {
+			t.Errorf("table output should show the warning:\n%s", result)
+		}
+	})
+
+	t.Run("no sources", func(t *testing.T) {
+		result, err := NewFormatter("json").FormatSearchResultToString(canonicalTestResponse())
+		if err != nil {
+			t.Fatalf("FormatSearchResultToString() error = %v", err)
+		}
+		if strings.Contains(result, `"sources"`) || strings.Contains(result, `"warnings"`) {
+			t.Errorf("single source output should not contain sources or warnings:\n%s", result)
+		}
+	})
+}
@@ -0,0 +1,241 @@
+package output
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+// detailHTMLStyle is the inline style sheet of law detail HTML, so that the
+// file reads on its own
+const detailHTMLStyle = `    body { max-width: 52em; margin: 2em auto; padding: 0 1em; font-family: sans-serif; line-height: 1.6; color: #222; }
+    h1 { border-bottom: 2px solid #333; padding-bottom: .3em; }
+    h2 { border-bottom: 1px solid #ddd; padding-bottom: .2em; margin-top: 2em; }
+    table.info { border-collapse: collapse; }
+    table.info th, table.info td { border: 1px solid #ddd; padding: 4px 10px; text-align: left; }
+    table.info th { background-color: #f2f2f2; }
+    nav.toc ul { list-style: none; padding-left: 1em; }
+    .chapter { margin-top: 1.5em; font-weight: bold; }
+    section.article h3 a.anchor { color: #aaa; text-decoration: none; margin-left: .3em; }
+    p.line { margin: .2em 0; white-space: pre-wrap; }
+`
+
+// formatDetailHTML formats law detail as a single HTML document with an
+// inline style sheet. Articles get an id of ArticleAnchor, such as
+// id="article-58", so that the table of contents and other documents
+// (law.html#article-58) can link to them; the table of contents also links to
+// the 별표 and 부칙 sections shown.
+func (f *Formatter) formatDetailHTML(detail *api.LawDetail, showArticles, showTables, showSupplementary bool) string {
+	var buf bytes.Buffer
+
+	name := f.lawName(detail.LawInfo)
+	if name == "" {
+		name = f.t("output.detail.noInfo")
+	}
+	fmt.Fprintln(&buf, `<!DOCTYPE html>`)
+	fmt.Fprintf(&buf, "<html lang=\"%s\">\n", escapeHTML(f.lang))
+	fmt.Fprintln(&buf, `<head>`)
+	fmt.Fprintln(&buf, `  <meta charset="UTF-8">`)
+	fmt.Fprintf(&buf, "  <title>%s</title>\n", escapeHTML(name))
+	fmt.Fprintf(&buf, "  <style>\n%s  </style>\n", detailHTMLStyle)
+	fmt.Fprintln(&buf, `</head>`)
+	fmt.Fprintln(&buf, `<body>`)
+	fmt.Fprintf(&buf, "<h1>%s</h1>\n", escapeHTML(name))
+
+	var rows [][2]string
+	addRow := func(label, value string) {
+		if value != "" {
+			rows = append(rows, [2]string{label, value})
+		}
+	}
+	if detail.ID != "" {
+		addRow(f.t("output.detail.lawID"), detail.ID)
+	} else {
+		addRow(f.t("output.detail.serialNo"), detail.SerialNo)
+	}
+	addRow(f.t("output.detail.abbrev"), detail.NameAbbrev)
+	addRow(f.t("output.detail.lawType"), detail.LawType)
+	addRow(f.t("output.detail.department"), detail.Department)
+	addRow(f.t("output.detail.promulDate"), formatDate(detail.PromulDate))
+	addRow(f.t("output.detail.promulNo"), detail.PromulNo)
+	addRow(f.t("output.detail.effectDate"), formatDate(detail.EffectDate))
+	addRow(f.t("output.detail.category"), detail.Category)
+	if len(rows) > 0 {
+		fmt.Fprintln(&buf, `<table class="info">`)
+		for _, row := range rows {
+			fmt.Fprintf(&buf, "  <tr><th>%s</th><td>%s</td></tr>\n", escapeHTML(row[0]), escapeHTML(row[1]))
+		}
+		fmt.Fprintln(&buf, `</table>`)
+	}
+
+	articles := showArticles && len(detail.Articles) > 0
+	tables := showTables && len(detail.Tables) > 0
+	supplementary := showSupplementary && len(detail.SupplementaryProvisions) > 0
+	f.writeHTMLTOC(&buf, detail, articles, tables, supplementary)
+
+	for _, section := range detail.Sections {
+		fmt.Fprintf(&buf, "<h2>%s</h2>\n", escapeHTML(section.Title))
+		fmt.Fprint(&buf, htmlLines(contentLines(section.Content)))
+	}
+
+	if articles {
+		fmt.Fprintf(&buf, "<h2 id=\"articles\">%s</h2>\n", escapeHTML(f.t("output.detail.articles")))
+		anchored := make(map[string]bool)
+		for _, article := range detail.Articles {
+			number := articleKey(article)
+			if number == "" {
+				// Chapter headings and other entries between articles
+				for _, line := range contentLines(article.Content) {
+					fmt.Fprintf(&buf, "<p class=\"chapter\">%s</p>\n", escapeHTML(strings.TrimSpace(line)))
+				}
+				continue
+			}
+
+			heading := escapeHTML(tocHeading(TOCEntry{Number: number, Title: strings.TrimSpace(article.Title)}))
+			if anchored[number] {
+				fmt.Fprintln(&buf, `<section class="article">`)
+				fmt.Fprintf(&buf, "<h3>%s</h3>\n", heading)
+			} else {
+				anchored[number] = true
+				anchor := ArticleAnchor(number)
+				fmt.Fprintf(&buf, "<section class=\"article\" id=\"%s\">\n", escapeHTML(anchor))
+				fmt.Fprintf(&buf, "<h3>%s<a class=\"anchor\" href=\"#%s\">#</a></h3>\n", heading, escapeHTML(anchor))
+			}
+			fmt.Fprint(&buf, htmlLines(articleBody(article)))
+			fmt.Fprintln(&buf, `</section>`)
+		}
+	}
+
+	if tables {
+		fmt.Fprintf(&buf, "<h2 id=\"tables\">%s</h2>\n", escapeHTML(f.t("output.detail.tables")))
+		for i, table := range detail.Tables {
+			fmt.Fprintf(&buf, "<section id=\"table-%d\">\n", i+1)
+			fmt.Fprintf(&buf, "<h3>%s</h3>\n", escapeHTML(strings.TrimSpace(table.Number+" "+table.Title)))
+			fmt.Fprint(&buf, htmlLines(contentLines(table.Content)))
+			fmt.Fprintln(&buf, `</section>`)
+		}
+	}
+
+	if supplementary {
+		fmt.Fprintf(&buf, "<h2 id=\"supplementary\">%s</h2>\n", escapeHTML(f.t("output.detail.addenda")))
+		for i, supp := range detail.SupplementaryProvisions {
+			fmt.Fprintf(&buf, "<section id=\"supplementary-%d\">\n", i+1)
+			fmt.Fprintf(&buf, "<h3>%s</h3>\n", escapeHTML(f.supplementaryHeading(supp)))
+			fmt.Fprint(&buf, htmlLines(contentLines(supp.Content)))
+			fmt.Fprintln(&buf, `</section>`)
+		}
+	}
+
+	if len(detail.RelatedLaws) > 0 {
+		fmt.Fprintf(&buf, "<h2 id=\"related\">%s</h2>\n", escapeHTML(f.t("output.detail.relatedLaws")))
+		fmt.Fprintln(&buf, `<ul>`)
+		for _, law := range detail.RelatedLaws {
+			fmt.Fprintf(&buf, "  <li>%s</li>\n", htmlLink(law.Name, law.URL))
+		}
+		fmt.Fprintln(&buf, `</ul>`)
+	}
+
+	if len(detail.Attachments) > 0 {
+		fmt.Fprintf(&buf, "<h2 id=\"attachments\">%s</h2>\n", escapeHTML(f.t("output.detail.attachments")))
+		fmt.Fprintln(&buf, `<ul>`)
+		for _, file := range detail.Attachments {
+			item := htmlLink(file.Name, file.URL)
+			if file.Size > 0 {
+				item += " (" + escapeHTML(FormatFileSize(file.Size)) + ")"
+			}
+			fmt.Fprintf(&buf, "  <li>%s</li>\n", item)
+		}
+		fmt.Fprintln(&buf, `</ul>`)
+	}
+
+	fmt.Fprintln(&buf, `</body>`)
+	fmt.Fprintln(&buf, `</html>`)
+	return buf.String()
+}
+
+// writeHTMLTOC writes the table of contents of law detail HTML: the articles
+// by number, then the 별표 and 부칙 sections when they are shown
+func (f *Formatter) writeHTMLTOC(buf *bytes.Buffer, detail *api.LawDetail, articles, tables, supplementary bool) {
+	var entries []TOCEntry
+	if articles {
+		entries = BuildTOC(detail.Articles)
+	}
+	if len(entries) == 0 && !tables && !supplementary {
+		return
+	}
+
+	fmt.Fprintln(buf, `<nav class="toc">`)
+	fmt.Fprintf(buf, "<h2>%s</h2>\n", escapeHTML(f.t("output.toc.heading")))
+	fmt.Fprintln(buf, `<ul>`)
+	if len(entries) > 0 {
+		fmt.Fprintf(buf, "  <li><a href=\"#articles\">%s</a>\n", escapeHTML(f.t("output.detail.articles")))
+		fmt.Fprintln(buf, `    <ul>`)
+		for _, entry := range entries {
+			fmt.Fprintf(buf, "      <li><a href=\"#%s\">%s</a></li>\n", escapeHTML(entry.Anchor()), escapeHTML(tocHeading(entry)))
+		}
+		fmt.Fprintln(buf, `    </ul>`)
+		fmt.Fprintln(buf, `  </li>`)
+	}
+	if tables {
+		fmt.Fprintf(buf, "  <li><a href=\"#tables\">%s</a>\n", escapeHTML(f.t("output.detail.tables")))
+		fmt.Fprintln(buf, `    <ul>`)
+		for i, table := range detail.Tables {
+			fmt.Fprintf(buf, "      <li><a href=\"#table-%d\">%s</a></li>\n", i+1, escapeHTML(strings.TrimSpace(table.Number+" "+table.Title)))
+		}
+		fmt.Fprintln(buf, `    </ul>`)
+		fmt.Fprintln(buf, `  </li>`)
+	}
+	if supplementary {
+		fmt.Fprintf(buf, "  <li><a href=\"#supplementary\">%s</a>\n", escapeHTML(f.t("output.detail.addenda")))
+		fmt.Fprintln(buf, `    <ul>`)
+		for i, supp := range detail.SupplementaryProvisions {
+			fmt.Fprintf(buf, "      <li><a href=\"#supplementary-%d\">%s</a></li>\n", i+1, escapeHTML(f.supplementaryHeading(supp)))
+		}
+		fmt.Fprintln(buf, `    </ul>`)
+		fmt.Fprintln(buf, `  </li>`)
+	}
+	fmt.Fprintln(buf, `</ul>`)
+	fmt.Fprintln(buf, `</nav>`)
+}
+
+// supplementaryHeading returns "부칙 <공포번호> (공포일자)" for a supplementary
+// provision with promulgation information, or its number otherwise
+func (f *Formatter) supplementaryHeading(supp api.SupplementaryProvision) string {
+	if supp.PromulgationDate == "" && supp.PromulgationNo == "" {
+		if supp.Number == "" {
+			return f.t("output.detail.addendum")
+		}
+		return supp.Number
+	}
+	heading := f.t("output.detail.addendum")
+	if supp.PromulgationNo != "" {
+		heading += " <" + supp.PromulgationNo + ">"
+	}
+	if supp.PromulgationDate != "" {
+		heading += " (" + formatDate(supp.PromulgationDate) + ")"
+	}
+	return heading
+}
+
+// htmlLines writes each content line as its own paragraph, escaped, keeping
+// its indentation (the paragraphs preserve white space)
+func htmlLines(lines []string) string {
+	var buf bytes.Buffer
+	for _, line := range lines {
+		fmt.Fprintf(&buf, "<p class=\"line\">%s</p>\n", escapeHTML(strings.TrimRight(line, " \t\r")))
+	}
+	return buf.String()
+}
+
+// htmlLink returns an escaped link to url with text. Only http and https URLs
+// are linked, so that a javascript: URL of a response never runs; the text
+// is written alone otherwise.
+func htmlLink(text, url string) string {
+	lower := strings.ToLower(strings.TrimSpace(url))
+	if !strings.HasPrefix(lower, "http://") && !strings.HasPrefix(lower, "https://") {
+		return escapeHTML(text)
+	}
+	return "<a href=\"" + escapeHTML(strings.TrimSpace(url)) + "\">" + escapeHTML(text) + "</a>"
+}
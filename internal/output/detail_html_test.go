@@ -0,0 +1,127 @@
+package output
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+func TestFormatDetailHTML(t *testing.T) {
+	detail := markdownTestDetail()
+	detail.Articles = append(detail.Articles, api.Article{Number: "58의2", Title: "과태료", Content: "제58조의2(과태료) 1천만원 이하의 과태료를 부과한다."})
+	out, err := NewFormatter("html").FormatDetailToStringWithOptions(detail, true, true, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, want := range []string{
+		"<!DOCTYPE html>\n",
+		"<title>테스트법</title>",
+		"<style>\n",
+		"<tr><th>시행일자</th><td>2024-07-01</td></tr>",
+		// Each article has an anchor the table of contents links to
+		`<section class="article" id="article-1">`,
+		`<section class="article" id="article-58-2">`,
+		`<h3>제58조의2 (과태료)<a class="anchor" href="#article-58-2">#</a></h3>`,
+		`<li><a href="#article-1">제1조 (목적)</a></li>`,
+		`<li><a href="#article-58-2">제58조의2 (과태료)</a></li>`,
+		// and so do the 별표 and 부칙 sections
+		`<li><a href="#tables">별표</a>`,
+		`<li><a href="#table-1">별표 1 과태료의 부과기준</a></li>`,
+		`<h2 id="tables">별표</h2>`,
+		`<li><a href="#supplementary-1">부칙 &lt;제12345호&gt; (2024-01-01)</a></li>`,
+		`<section id="supplementary-1">`,
+		`<p class="chapter">제1장 총칙</p>`,
+		`<p class="line">이 법은 안전을 목적으로 한다.</p>`,
+		`<p class="line">    가. 개인</p>`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output should contain %q:\n%s", want, out)
+		}
+	}
+	// The file needs nothing but itself
+	for _, forbidden := range []string{"<link", "<script", "src="} {
+		if strings.Contains(out, forbidden) {
+			t.Errorf("output should not contain %q", forbidden)
+		}
+	}
+	if strings.Count(out, `id="article-1"`) != 1 {
+		t.Errorf("article 1 should have a single anchor:\n%s", out)
+	}
+}
+
+func TestFormatDetailHTMLEnglish(t *testing.T) {
+	out, err := NewFormatter("html").WithLanguage("en").FormatDetailToStringWithOptions(markdownTestDetail(), true, true, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, want := range []string{
+		`<html lang="en">`,
+		"<tr><th>Effective</th><td>2024-07-01</td></tr>",
+		"<h2>Table of Contents</h2>",
+		`<li><a href="#articles">Articles</a>`,
+		`<h2 id="tables">Tables</h2>`,
+		`<li><a href="#supplementary-1">Addendum &lt;제12345호&gt; (2024-01-01)</a></li>`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output should contain %q:\n%s", want, out)
+		}
+	}
+	for _, unwanted := range []string{"시행일자", "목차", ">조문<", ">별표<", ">부칙"} {
+		if strings.Contains(out, unwanted) {
+			t.Errorf("output should not contain %q:\n%s", unwanted, out)
+		}
+	}
+}
+
+func TestFormatDetailHTMLEscape(t *testing.T) {
+	detail := &api.LawDetail{
+		LawInfo: api.LawInfo{ID: "001234", Name: `<script>alert("x")</script> & 법`},
+		Articles: []api.Article{
+			{Number: "1", Title: "목적 <b>", Content: "제1조(목적 <b>) A & B는 \"갑\"과 '을'의 <계약>을 말한다."},
+		},
+		RelatedLaws: []api.RelatedLaw{
+			{Name: "관련법", URL: "https://www.law.go.kr/법령/관련법?a=1&b=2"},
+			{Name: "위험한 링크", URL: "javascript:alert(1)"},
+		},
+	}
+	out, err := NewFormatter("html").FormatDetailToStringWithOptions(detail, true, false, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if strings.Contains(out, "<script>") || strings.Contains(out, "<b>") || strings.Contains(out, "<계약>") {
+		t.Errorf("markup of the law should be escaped:\n%s", out)
+	}
+	for _, want := range []string{
+		"<h1>&lt;script&gt;alert(&quot;x&quot;)&lt;/script&gt; &amp; 법</h1>",
+		"A &amp; B는 &quot;갑&quot;과 &#39;을&#39;의 &lt;계약&gt;을 말한다.",
+		`<li><a href="https://www.law.go.kr/법령/관련법?a=1&amp;b=2">관련법</a></li>`,
+		"<li>위험한 링크</li>",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output should contain %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "javascript:") {
+		t.Errorf("javascript URLs should not be linked:\n%s", out)
+	}
+
+	// The escaped document still parses
+	body := out[strings.Index(out, "<html"):]
+	decoder := xml.NewDecoder(strings.NewReader(body))
+	decoder.Strict = false
+	decoder.AutoClose = xml.HTMLAutoClose
+	decoder.Entity = xml.HTMLEntity
+	for {
+		if _, err := decoder.Token(); err != nil {
+			if err.Error() != "EOF" {
+				t.Errorf("output is not well-formed: %v", err)
+			}
+			break
+		}
+	}
+}
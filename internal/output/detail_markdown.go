@@ -0,0 +1,182 @@
+package output
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+// formatDetailMarkdown formats law detail as a markdown document. Articles get
+// an anchor (see ArticleAnchor) so that the table of contents can link to them.
+func (f *Formatter) formatDetailMarkdown(detail *api.LawDetail, showArticles, showTables, showSupplementary bool) string {
+	var buf bytes.Buffer
+
+	name := f.lawName(detail.LawInfo)
+	if name == "" {
+		name = f.t("output.detail.noInfo")
+	}
+	fmt.Fprintf(&buf, "# %s\n\n", name)
+
+	var rows [][]string
+	addRow := func(label, value string) {
+		if value != "" {
+			rows = append(rows, []string{label, value})
+		}
+	}
+	if detail.ID != "" {
+		addRow(f.t("output.detail.lawID"), detail.ID)
+	} else {
+		addRow(f.t("output.detail.serialNo"), detail.SerialNo)
+	}
+	addRow(f.t("output.detail.abbrev"), detail.NameAbbrev)
+	addRow(f.t("output.detail.lawType"), detail.LawType)
+	addRow(f.t("output.detail.department"), detail.Department)
+	addRow(f.t("output.detail.promulDate"), formatDate(detail.PromulDate))
+	addRow(f.t("output.detail.promulNo"), detail.PromulNo)
+	addRow(f.t("output.detail.effectDate"), formatDate(detail.EffectDate))
+	addRow(f.t("output.detail.category"), detail.Category)
+	if len(rows) > 0 {
+		fmt.Fprint(&buf, RenderMarkdownTable([]string{f.t("output.detail.field"), f.t("output.detail.value")}, rows))
+		fmt.Fprintf(&buf, "\n")
+	}
+
+	for _, section := range detail.Sections {
+		fmt.Fprintf(&buf, "## %s\n\n", section.Title)
+		fmt.Fprint(&buf, markdownParagraphs(contentLines(section.Content)))
+	}
+
+	if f.toc {
+		fmt.Fprint(&buf, f.formatTOCMarkdown(BuildTOC(detail.Articles)))
+	}
+
+	if showArticles && len(detail.Articles) > 0 {
+		fmt.Fprintf(&buf, "## %s\n\n", f.t("output.detail.articles"))
+		anchored := make(map[string]bool)
+		for _, article := range detail.Articles {
+			number := articleKey(article)
+			if number == "" {
+				// Chapter headings and other entries between articles
+				for _, line := range contentLines(article.Content) {
+					fmt.Fprintf(&buf, "**%s**\n\n", strings.TrimSpace(line))
+				}
+				continue
+			}
+
+			if !anchored[number] {
+				anchored[number] = true
+				fmt.Fprintf(&buf, "<a id=\"%s\"></a>\n\n", ArticleAnchor(number))
+			}
+			fmt.Fprintf(&buf, "### %s\n\n", tocHeading(TOCEntry{Number: number, Title: strings.TrimSpace(article.Title)}))
+			fmt.Fprint(&buf, markdownParagraphs(articleBody(article)))
+		}
+	}
+
+	if showTables && len(detail.Tables) > 0 {
+		fmt.Fprintf(&buf, "## %s\n\n", f.t("output.detail.tables"))
+		for _, table := range detail.Tables {
+			heading := strings.TrimSpace(table.Number + " " + table.Title)
+			fmt.Fprintf(&buf, "### %s\n\n", heading)
+			fmt.Fprint(&buf, markdownParagraphs(contentLines(table.Content)))
+		}
+	}
+
+	if showSupplementary && len(detail.SupplementaryProvisions) > 0 {
+		fmt.Fprintf(&buf, "## %s\n\n", f.t("output.detail.addenda"))
+		for _, supp := range detail.SupplementaryProvisions {
+			fmt.Fprintf(&buf, "### %s\n\n", f.supplementaryHeading(supp))
+			fmt.Fprint(&buf, markdownParagraphs(contentLines(supp.Content)))
+		}
+	}
+
+	if len(detail.RelatedLaws) > 0 {
+		fmt.Fprintf(&buf, "## %s\n\n", f.t("output.detail.relatedLaws"))
+		for _, law := range detail.RelatedLaws {
+			fmt.Fprintf(&buf, "- %s\n", markdownLink(law.Name, law.URL))
+		}
+		fmt.Fprintf(&buf, "\n")
+	}
+
+	if len(detail.Attachments) > 0 {
+		fmt.Fprintf(&buf, "## %s\n\n", f.t("output.detail.attachments"))
+		for _, file := range detail.Attachments {
+			item := markdownLink(file.Name, file.URL)
+			if file.Size > 0 {
+				item += " (" + FormatFileSize(file.Size) + ")"
+			}
+			fmt.Fprintf(&buf, "- %s\n", item)
+		}
+		fmt.Fprintf(&buf, "\n")
+	}
+
+	return buf.String()
+}
+
+// markdownLink returns a markdown link to url with text, or the text alone
+// without a URL
+func markdownLink(text, url string) string {
+	text = strings.NewReplacer("[", "\\[", "]", "\\]").Replace(text)
+	if url == "" {
+		return text
+	}
+	url = strings.NewReplacer("(", "%28", ")", "%29", " ", "%20").Replace(url)
+	return "[" + text + "](" + url + ")"
+}
+
+// articleBody returns the content lines of an article without the leading
+// "제N조(제목)" heading, which the markdown heading already shows
+func articleBody(article api.Article) []string {
+	lines := articleLines(article)
+	if len(lines) > 0 && articleHeadingPattern.MatchString(strings.TrimSpace(lines[0])) {
+		first := strings.TrimSpace(articleHeadingPattern.ReplaceAllString(strings.TrimSpace(lines[0]), ""))
+		if first == "" {
+			return lines[1:]
+		}
+		lines[0] = first
+	}
+	return lines
+}
+
+// markdownParagraphs writes each line as its own paragraph, so that the line
+// breaks between 항, 호 and 목 are kept
+func markdownParagraphs(lines []string) string {
+	var buf bytes.Buffer
+	for _, line := range lines {
+		fmt.Fprintf(&buf, "%s\n\n", markdownLine(line))
+	}
+	return buf.String()
+}
+
+// orderedListPattern matches a line starting like an ordered list item ("1. ", "2) ")
+var orderedListPattern = regexp.MustCompile(`^(\d+)([.)])(\s|$)`)
+
+// markdownLine returns a content line as markdown text. Its indentation is kept
+// with non-breaking spaces, since markdown drops leading spaces or turns four of
+// them into a code block, and a leading list, heading or quote marker is escaped
+// so that "1. 목적" or "- 삭제" stays text instead of becoming a list.
+func markdownLine(line string) string {
+	text := strings.TrimLeft(line, " \t\u3000")
+	indent := 0
+	for _, r := range line[:len(line)-len(text)] {
+		switch r {
+		case '\t':
+			indent += 4
+		case '\u3000': // full-width space
+			indent += 2
+		default:
+			indent++
+		}
+	}
+	text = strings.TrimRightFunc(text, unicode.IsSpace)
+
+	switch {
+	case orderedListPattern.MatchString(text):
+		text = orderedListPattern.ReplaceAllString(text, "$1\\$2$3")
+	case text != "" && strings.ContainsRune("#>-+*=|`~", rune(text[0])):
+		text = "\\" + text
+	}
+	return strings.Repeat("&nbsp;", indent) + text
+}
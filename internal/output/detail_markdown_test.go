@@ -0,0 +1,167 @@
+package output
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+func markdownTestDetail() *api.LawDetail {
+	return &api.LawDetail{
+		LawInfo: api.LawInfo{
+			ID:         "001234",
+			Name:       "테스트법",
+			LawType:    "법률",
+			Department: "법제처",
+			PromulDate: "20240101",
+			EffectDate: "20240701",
+		},
+		Articles: []api.Article{
+			{Number: "1", Content: "제1장 총칙"},
+			{Number: "1", Title: "목적", Content: "제1조(목적) 이 법은 안전을 목적으로 한다."},
+			{Number: "2", Title: "정의", Content: "제2조(정의) 이 법에서 사용하는 용어의 뜻은 다음과 같다.\n  1. \"사업자\"란 사업을 하는 자를 말한다.\n    가. 개인\n  2. - 삭제"},
+		},
+		Tables: []api.Table{
+			{Number: "별표 1", Title: "과태료의 부과기준", Content: "1. 일반기준\n2. 개별기준"},
+		},
+		SupplementaryProvisions: []api.SupplementaryProvision{
+			{Content: "이 법은 공포한 날부터 시행한다.", PromulgationDate: "20240101", PromulgationNo: "제12345호"},
+		},
+	}
+}
+
+func TestFormatDetailMarkdownWithArticles(t *testing.T) {
+	out, err := NewFormatter("md").FormatDetailToStringWithOptions(markdownTestDetail(), true, true, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, want := range []string{
+		"# 테스트법\n",
+		"| 법령ID | 001234 |",
+		"| 시행일자 | 2024-07-01 |",
+		"## 조문\n",
+		"**제1장 총칙**",
+		"### 제1조 (목적)\n\n이 법은 안전을 목적으로 한다.\n",
+		"### 제2조 (정의)\n",
+		"## 별표\n\n### 별표 1 과태료의 부과기준\n",
+		"## 부칙\n\n### 부칙 <제12345호> (2024-01-01)\n\n이 법은 공포한 날부터 시행한다.\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output should contain %q:\n%s", want, out)
+		}
+	}
+
+	// Each line of an article is its own paragraph, indented as in the law,
+	// and numbered items are not turned into markdown lists
+	for _, want := range []string{
+		"\n\n&nbsp;&nbsp;1\\. \"사업자\"란 사업을 하는 자를 말한다.\n\n",
+		"\n\n&nbsp;&nbsp;&nbsp;&nbsp;가. 개인\n\n",
+		"\n\n&nbsp;&nbsp;2\\. - 삭제\n\n",
+		"\n\n1\\. 일반기준\n\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output should contain %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestFormatDetailMarkdownWithoutArticles(t *testing.T) {
+	out, err := NewFormatter("markdown").FormatDetailToStringWithOptions(markdownTestDetail(), false, false, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.Contains(out, "# 테스트법\n") || !strings.Contains(out, "| 소관부처 | 법제처 |") {
+		t.Errorf("output should contain the law information:\n%s", out)
+	}
+	for _, unwanted := range []string{"## 조문", "### 제1조", "## 별표", "## 부칙"} {
+		if strings.Contains(out, unwanted) {
+			t.Errorf("output should not contain %q without the options:\n%s", unwanted, out)
+		}
+	}
+}
+
+func TestFormatDetailMarkdownEnglish(t *testing.T) {
+	out, err := NewFormatter("md").WithLanguage("en").WithTOC(true).FormatDetailToStringWithOptions(markdownTestDetail(), true, true, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, want := range []string{"Field", "| Law ID", "| Effective", "## Table of Contents\n", "## Articles\n", "## Tables\n"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("markdown output missing %q:\n%s", want, out)
+		}
+	}
+	for _, unwanted := range []string{"항목", "법령ID", "시행일자", "목차", "## 조문\n", "## 별표\n"} {
+		if strings.Contains(out, unwanted) {
+			t.Errorf("markdown output should not contain %q:\n%s", unwanted, out)
+		}
+	}
+}
+
+func TestMarkdownLine(t *testing.T) {
+	tests := []struct {
+		line string
+		want string
+	}{
+		{"이 법은 공포한 날부터 시행한다.", "이 법은 공포한 날부터 시행한다."},
+		{"  ① 누구든지", "&nbsp;&nbsp;① 누구든지"},
+		{"\t가. 개인", "&nbsp;&nbsp;&nbsp;&nbsp;가. 개인"},
+		{"1. 목적", "1\\. 목적"},
+		{"12) 기타", "12\\) 기타"},
+		{"2024. 1. 1. 시행", "2024\\. 1. 1. 시행"},
+		{"- 삭제", "\\- 삭제"},
+		{"# 제목", "\\# 제목"},
+		{"> 인용", "\\> 인용"},
+		{"1.5배 이하", "1.5배 이하"},
+		{"내용   ", "내용"},
+	}
+
+	for _, tt := range tests {
+		if got := markdownLine(tt.line); got != tt.want {
+			t.Errorf("markdownLine(%q) = %q, want %q", tt.line, got, tt.want)
+		}
+	}
+}
+
+func TestFormatDetailMarkdownInvalidFormat(t *testing.T) {
+	_, err := NewFormatter("csv").FormatDetailToStringWithOptions(markdownTestDetail(), true, false, false)
+	if err == nil || !strings.Contains(err.Error(), "markdown") {
+		t.Errorf("expected an error listing markdown, got %v", err)
+	}
+}
+
+func TestFormatDetailSections(t *testing.T) {
+	detail := &api.LawDetail{
+		LawInfo: api.LawInfo{ID: "228541", Name: "손해배상(기)", LawType: "판례", Department: "대법원"},
+		Sections: []api.DetailSection{
+			{Title: "판시사항", Content: "[1] 첫째 쟁점\n[2] 둘째 쟁점"},
+			{Title: "전문", Content: "상고를 모두 기각한다."},
+		},
+	}
+
+	table, err := NewFormatter("table").FormatDetailToString(detail)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, want := range []string{" 판시사항\n", "  [1] 첫째 쟁점\n  [2] 둘째 쟁점\n", " 전문\n", "  상고를 모두 기각한다.\n"} {
+		if !strings.Contains(table, want) {
+			t.Errorf("table output missing %q:\n%s", want, table)
+		}
+	}
+	if strings.Index(table, "판시사항") > strings.Index(table, "전문") {
+		t.Errorf("sections are out of order:\n%s", table)
+	}
+
+	md, err := NewFormatter("md").FormatDetailToString(detail)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, want := range []string{"## 판시사항\n\n[1] 첫째 쟁점\n\n[2] 둘째 쟁점\n\n", "## 전문\n\n상고를 모두 기각한다.\n\n"} {
+		if !strings.Contains(md, want) {
+			t.Errorf("markdown output missing %q:\n%s", want, md)
+		}
+	}
+}
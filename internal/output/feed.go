@@ -0,0 +1,189 @@
+package output
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"strings"
+	"time"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+// rssFeed is an RSS 2.0 document
+type rssFeed struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title       string    `xml:"title"`
+	Link        string    `xml:"link"`
+	Description string    `xml:"description"`
+	Items       []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string   `xml:"title"`
+	Link        string   `xml:"link,omitempty"`
+	Description string   `xml:"description,omitempty"`
+	PubDate     string   `xml:"pubDate,omitempty"`
+	GUID        *rssGUID `xml:"guid,omitempty"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+// atomFeed is an Atom 1.0 document
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	Title   string      `xml:"title"`
+	ID      string      `xml:"id"`
+	Updated string      `xml:"updated"`
+	Link    atomLink    `xml:"link"`
+	Author  atomAuthor  `xml:"author"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomEntry struct {
+	Title   string    `xml:"title"`
+	ID      string    `xml:"id"`
+	Updated string    `xml:"updated"`
+	Link    *atomLink `xml:"link,omitempty"`
+	Summary string    `xml:"summary,omitempty"`
+}
+
+// feedItem is a search result as an item of a feed
+type feedItem struct {
+	title       string
+	link        string
+	description string
+	published   time.Time // zero if the law has no promulgation date
+}
+
+// feedItems returns the results of resp as feed items. The link of a law,
+// which is also its GUID, is its page by ID, so that a feed generated again
+// for the same results is the same.
+func (f *Formatter) feedItems(resp *api.SearchResponse) []feedItem {
+	items := make([]feedItem, 0, len(resp.Laws))
+	for _, law := range resp.Laws {
+		link := api.LawIDURL(law)
+		if link == "" {
+			link = api.LawNameURL(law.Name)
+		}
+		var details []string
+		if law.Department != "" {
+			details = append(details, f.t("output.table.ministry")+": "+law.Department)
+		}
+		if law.LawType != "" {
+			details = append(details, f.t("output.table.type")+": "+law.LawType)
+		}
+		published, _ := api.ParseDate(law.PromulDate)
+		items = append(items, feedItem{
+			title:       law.Name,
+			link:        link,
+			description: strings.Join(details, " | "),
+			published:   published,
+		})
+	}
+	return items
+}
+
+// feedTitle returns the title of a feed of search results, naming the
+// command of the search when it is known
+func (f *Formatter) feedTitle() string {
+	title := f.t("output.searchResults")
+	if f.pageCommand != "" {
+		title += ": " + f.pageCommand
+	}
+	return title
+}
+
+// formatRSSToString formats results as an RSS 2.0 feed and returns as string.
+// Promulgation dates are written in RFC 822 (with a four digit year).
+func (f *Formatter) formatRSSToString(resp *api.SearchResponse) (string, error) {
+	feed := rssFeed{
+		Version: "2.0",
+		Channel: rssChannel{
+			Title:       f.feedTitle(),
+			Link:        api.LawSiteURL,
+			Description: f.feedTitle(),
+		},
+	}
+	for _, item := range f.feedItems(resp) {
+		entry := rssItem{Title: item.title, Link: item.link, Description: item.description}
+		if !item.published.IsZero() {
+			entry.PubDate = item.published.Format(time.RFC1123Z)
+		}
+		if item.link != "" {
+			entry.GUID = &rssGUID{IsPermaLink: true, Value: item.link}
+		}
+		feed.Channel.Items = append(feed.Channel.Items, entry)
+	}
+	return marshalFeed(feed)
+}
+
+// formatAtomToString formats results as an Atom feed and returns as string.
+// Dates are written in RFC 3339; the feed is updated at the latest
+// promulgation date of its entries rather than when it is generated, so that
+// the same results give the same feed.
+func (f *Formatter) formatAtomToString(resp *api.SearchResponse) (string, error) {
+	title := f.feedTitle()
+	feed := atomFeed{
+		Title:  title,
+		ID:     feedURN("feed", title),
+		Link:   atomLink{Href: api.LawSiteURL},
+		Author: atomAuthor{Name: "warp"},
+	}
+
+	var updated time.Time
+	for _, item := range f.feedItems(resp) {
+		entry := atomEntry{Title: item.title, Summary: item.description}
+		if item.link != "" {
+			entry.ID = item.link
+			entry.Link = &atomLink{Href: item.link}
+		} else {
+			entry.ID = feedURN("law", item.title)
+		}
+		published := item.published
+		if published.IsZero() {
+			published = time.Unix(0, 0).In(api.KST)
+		}
+		entry.Updated = published.Format(time.RFC3339)
+		if published.After(updated) {
+			updated = published
+		}
+		feed.Entries = append(feed.Entries, entry)
+	}
+	if updated.IsZero() {
+		updated = time.Unix(0, 0).In(api.KST)
+	}
+	feed.Updated = updated.Format(time.RFC3339)
+	return marshalFeed(feed)
+}
+
+// feedURN returns a URN identifying the feed element named name of kind
+func feedURN(kind, name string) string {
+	sum := sha256.Sum256([]byte(name))
+	return "urn:warp:" + kind + ":" + hex.EncodeToString(sum[:8])
+}
+
+// marshalFeed encodes a feed as an indented XML document
+func marshalFeed(feed interface{}) (string, error) {
+	out, err := xml.MarshalIndent(feed, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return xml.Header + string(out) + "\n", nil
+}
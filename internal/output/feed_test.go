@@ -0,0 +1,158 @@
+package output
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+func feedTestResponse() *api.SearchResponse {
+	return &api.SearchResponse{
+		TotalCount: 3,
+		Laws: []api.LawInfo{
+			{ID: "011357", Name: "개인정보 보호법", PromulDate: "20230314", Department: "개인정보보호위원회", LawType: "법률"},
+			{ID: "2000111", Name: "서울특별시 주차장 설치 및 관리 조례", PromulDate: "2024.01.05", Department: "서울특별시", LawType: "자치법규", Source: "자치법규"},
+			{ID: "009999", Name: "공포일자 없는 법 & <시행령>"},
+		},
+	}
+}
+
+func TestFormatRSSToString(t *testing.T) {
+	f := NewFormatter("rss").WithPagination(`warp law "개인정보"`, 0)
+	out, err := f.FormatSearchResultToString(feedTestResponse())
+	if err != nil {
+		t.Fatalf("FormatSearchResultToString() error = %v", err)
+	}
+	if !strings.HasPrefix(out, xml.Header) {
+		t.Errorf("output should start with the XML header:\n%s", out)
+	}
+
+	var feed struct {
+		XMLName xml.Name `xml:"rss"`
+		Version string   `xml:"version,attr"`
+		Channel struct {
+			Title string `xml:"title"`
+			Link  string `xml:"link"`
+			Items []struct {
+				Title       string `xml:"title"`
+				Link        string `xml:"link"`
+				Description string `xml:"description"`
+				PubDate     string `xml:"pubDate"`
+				GUID        struct {
+					IsPermaLink string `xml:"isPermaLink,attr"`
+					Value       string `xml:",chardata"`
+				} `xml:"guid"`
+			} `xml:"item"`
+		} `xml:"channel"`
+	}
+	if err := xml.Unmarshal([]byte(out), &feed); err != nil {
+		t.Fatalf("output is not valid XML: %v\n%s", err, out)
+	}
+	if feed.Version != "2.0" || feed.Channel.Title != `검색 결과: warp law "개인정보"` || feed.Channel.Link != api.LawSiteURL {
+		t.Errorf("channel = %q %q %q", feed.Version, feed.Channel.Title, feed.Channel.Link)
+	}
+	if len(feed.Channel.Items) != 3 {
+		t.Fatalf("items = %d, want 3", len(feed.Channel.Items))
+	}
+
+	first := feed.Channel.Items[0]
+	if first.Title != "개인정보 보호법" || first.Link != "https://www.law.go.kr/LSW/lsInfoP.do?lsId=011357" {
+		t.Errorf("first item = %+v", first)
+	}
+	if first.GUID.Value != first.Link || first.GUID.IsPermaLink != "true" {
+		t.Errorf("guid = %+v, want the link", first.GUID)
+	}
+	if first.Description != "소관부처: 개인정보보호위원회 | 법령구분: 법률" {
+		t.Errorf("description = %q", first.Description)
+	}
+	pubDate, err := time.Parse(time.RFC1123Z, first.PubDate)
+	if err != nil || first.PubDate != "Tue, 14 Mar 2023 00:00:00 +0900" {
+		t.Errorf("pubDate = %q (%v), want RFC 822 in KST", first.PubDate, err)
+	}
+	if !pubDate.Equal(time.Date(2023, 3, 14, 0, 0, 0, 0, api.KST)) {
+		t.Errorf("pubDate = %v", pubDate)
+	}
+
+	second := feed.Channel.Items[1]
+	if second.Link != "https://www.law.go.kr/LSW/ordinInfoP.do?ordinId=2000111" || second.PubDate != "Fri, 05 Jan 2024 00:00:00 +0900" {
+		t.Errorf("ordinance item = %+v", second)
+	}
+	third := feed.Channel.Items[2]
+	if third.Title != "공포일자 없는 법 & <시행령>" || third.PubDate != "" || third.Description != "" {
+		t.Errorf("item without date = %+v", third)
+	}
+
+	// The same results give the same feed, for files regenerated by cron
+	again, _ := f.FormatSearchResultToString(feedTestResponse())
+	if again != out {
+		t.Error("RSS output should be stable")
+	}
+}
+
+func TestFormatAtomToString(t *testing.T) {
+	f := NewFormatter("atom")
+	out, err := f.FormatSearchResultToString(feedTestResponse())
+	if err != nil {
+		t.Fatalf("FormatSearchResultToString() error = %v", err)
+	}
+
+	var feed struct {
+		XMLName xml.Name `xml:"http://www.w3.org/2005/Atom feed"`
+		Title   string   `xml:"title"`
+		ID      string   `xml:"id"`
+		Updated string   `xml:"updated"`
+		Entries []struct {
+			Title   string `xml:"title"`
+			ID      string `xml:"id"`
+			Updated string `xml:"updated"`
+			Link    struct {
+				Href string `xml:"href,attr"`
+			} `xml:"link"`
+			Summary string `xml:"summary"`
+		} `xml:"entry"`
+	}
+	if err := xml.Unmarshal([]byte(out), &feed); err != nil {
+		t.Fatalf("output is not valid XML: %v\n%s", err, out)
+	}
+	if feed.Title != "검색 결과" || !strings.HasPrefix(feed.ID, "urn:warp:feed:") {
+		t.Errorf("feed = %q %q", feed.Title, feed.ID)
+	}
+	// The feed is updated at the latest promulgation date, not when generated
+	if feed.Updated != "2024-01-05T00:00:00+09:00" {
+		t.Errorf("updated = %q", feed.Updated)
+	}
+	if len(feed.Entries) != 3 {
+		t.Fatalf("entries = %d, want 3", len(feed.Entries))
+	}
+	first := feed.Entries[0]
+	if first.ID != "https://www.law.go.kr/LSW/lsInfoP.do?lsId=011357" || first.Link.Href != first.ID {
+		t.Errorf("first entry = %+v", first)
+	}
+	if _, err := time.Parse(time.RFC3339, first.Updated); err != nil || first.Updated != "2023-03-14T00:00:00+09:00" {
+		t.Errorf("entry updated = %q (%v), want RFC 3339", first.Updated, err)
+	}
+	if _, err := time.Parse(time.RFC3339, feed.Entries[2].Updated); err != nil {
+		t.Errorf("entry without date updated = %q: %v", feed.Entries[2].Updated, err)
+	}
+
+	again, _ := f.FormatSearchResultToString(feedTestResponse())
+	if again != out {
+		t.Error("Atom output should be stable")
+	}
+}
+
+func TestFormatFeedEmpty(t *testing.T) {
+	for _, format := range []string{"rss", "atom"} {
+		out, err := NewFormatter(format).FormatSearchResultToString(&api.SearchResponse{})
+		if err != nil {
+			t.Fatalf("%s: error = %v", format, err)
+		}
+		var v struct{}
+		if err := xml.Unmarshal([]byte(out), &v); err != nil {
+			t.Errorf("%s: empty feed is not valid XML: %v\n%s", format, err, out)
+		}
+	}
+}
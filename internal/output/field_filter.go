@@ -0,0 +1,126 @@
+package output
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+// FilterOp is how a --filter compares a field with its value
+type FilterOp string
+
+const (
+	// FilterContains keeps results whose field contains the value
+	FilterContains FilterOp = "="
+	// FilterNotContains keeps results whose field does not contain the value
+	FilterNotContains FilterOp = "!="
+	// FilterRegexp keeps results whose field matches the value as a regular expression
+	FilterRegexp FilterOp = "=~"
+)
+
+// filterFieldAliases are the short field names accepted by --filter besides
+// the --pluck ones
+var filterFieldAliases = map[string]string{
+	"id":   "law_id",
+	"name": "law_name",
+	"type": "law_type",
+}
+
+// FieldFilter is a parsed --filter expression: field, operator and value
+type FieldFilter struct {
+	Field string
+	Op    FilterOp
+	Value string
+
+	value func(api.LawInfo) string
+	re    *regexp.Regexp
+}
+
+// ParseFieldFilter parses a --filter expression of the form <field><op><value>,
+// with the operators = (contains), != (does not contain) and =~ (regular
+// expression). The fields are those of --pluck, plus name, id and type.
+func ParseFieldFilter(expr string) (FieldFilter, error) {
+	i := strings.IndexAny(expr, "!=")
+	if i < 0 {
+		return FieldFilter{}, fmt.Errorf("필터 형식이 올바르지 않습니다: %q (<필드><연산자><값> 형식, 연산자는 =, !=, =~)", expr)
+	}
+	field := strings.ToLower(strings.TrimSpace(expr[:i]))
+	rest := expr[i:]
+
+	var f FieldFilter
+	switch {
+	case strings.HasPrefix(rest, string(FilterRegexp)):
+		f.Op = FilterRegexp
+	case strings.HasPrefix(rest, string(FilterNotContains)):
+		f.Op = FilterNotContains
+	case strings.HasPrefix(rest, string(FilterContains)):
+		f.Op = FilterContains
+	default:
+		return FieldFilter{}, fmt.Errorf("알 수 없는 필터 연산자: %q (=, !=, =~ 중 선택)", expr)
+	}
+	f.Value = rest[len(f.Op):]
+
+	if field == "" {
+		return FieldFilter{}, fmt.Errorf("필터할 필드를 지정하세요: %q", expr)
+	}
+	if alias, ok := filterFieldAliases[field]; ok {
+		field = alias
+	}
+	value, ok := pluckField(field)
+	if !ok {
+		return FieldFilter{}, fmt.Errorf("알 수 없는 필터 필드: %s (%s 중 선택)", field, strings.Join(FilterFieldNames(), ", "))
+	}
+	f.Field, f.value = field, value
+
+	if f.Op == FilterRegexp {
+		re, err := regexp.Compile(f.Value)
+		if err != nil {
+			return FieldFilter{}, fmt.Errorf("잘못된 정규식: %s: %w", f.Value, err)
+		}
+		f.re = re
+	}
+	return f, nil
+}
+
+// FilterFieldNames returns the field names accepted by --filter
+func FilterFieldNames() []string {
+	return append(PluckFieldNames(), "name", "id", "type")
+}
+
+// String returns the expression of the filter
+func (f FieldFilter) String() string {
+	return f.Field + string(f.Op) + f.Value
+}
+
+// Match reports whether law satisfies the filter
+func (f FieldFilter) Match(law api.LawInfo) bool {
+	value := f.value(law)
+	switch f.Op {
+	case FilterRegexp:
+		return f.re.MatchString(value)
+	case FilterNotContains:
+		return !strings.Contains(value, f.Value)
+	default:
+		return strings.Contains(value, f.Value)
+	}
+}
+
+// FilterLaws returns the laws satisfying every filter, in their order
+func FilterLaws(laws []api.LawInfo, filters []FieldFilter) []api.LawInfo {
+	var kept []api.LawInfo
+	for _, law := range laws {
+		matched := true
+		for _, f := range filters {
+			if !f.Match(law) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			kept = append(kept, law)
+		}
+	}
+	return kept
+}
@@ -0,0 +1,105 @@
+package output
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+func filterTestLaws() []api.LawInfo {
+	return []api.LawInfo{
+		{ID: "001", Name: "개인정보 보호법", LawType: "법률", Department: "개인정보보호위원회"},
+		{ID: "002", Name: "국민건강보험법", LawType: "법률", Department: "보건복지부"},
+		{ID: "003", Name: "국민건강보험법 시행령", LawType: "대통령령", Department: "보건복지부"},
+		{ID: "004", Name: "장애인복지법", LawType: "법률", Department: "보건복지부, 고용노동부"},
+	}
+}
+
+func TestParseFieldFilter(t *testing.T) {
+	tests := []struct {
+		expr      string
+		wantField string
+		wantOp    FilterOp
+		wantValue string
+		wantErr   string
+	}{
+		{expr: "department=~복지부$", wantField: "department", wantOp: FilterRegexp, wantValue: "복지부$"},
+		{expr: "name=개인정보", wantField: "law_name", wantOp: FilterContains, wantValue: "개인정보"},
+		{expr: "LAW_TYPE!=법률", wantField: "law_type", wantOp: FilterNotContains, wantValue: "법률"},
+		{expr: "id=", wantField: "law_id", wantOp: FilterContains, wantValue: ""},
+		{expr: "name==a", wantField: "law_name", wantOp: FilterContains, wantValue: "=a"},
+		{expr: "department", wantErr: "필터 형식"},
+		{expr: "=개인정보", wantErr: "필드를 지정"},
+		{expr: "name!개인정보", wantErr: "연산자"},
+		{expr: "ministry=복지부", wantErr: "알 수 없는 필터 필드: ministry"},
+		{expr: "name=~(개인", wantErr: "잘못된 정규식"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			f, err := ParseFieldFilter(tt.expr)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("ParseFieldFilter(%q) error = %v, want %q", tt.expr, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseFieldFilter(%q) error = %v", tt.expr, err)
+			}
+			if f.Field != tt.wantField || f.Op != tt.wantOp || f.Value != tt.wantValue {
+				t.Errorf("ParseFieldFilter(%q) = %s %s %q, want %s %s %q", tt.expr, f.Field, f.Op, f.Value, tt.wantField, tt.wantOp, tt.wantValue)
+			}
+		})
+	}
+}
+
+func TestFieldFilterAccessors(t *testing.T) {
+	law := api.LawInfo{ID: "001", Name: "개인정보 보호법", LawType: "법률", Department: "개인정보보호위원회", SerialNo: "270351", Source: "nlic"}
+	for _, expr := range []string{"law_id=001", "id=001", "name=보호법", "type=법률", "department=위원회", "serial_no=2703", "source=nlic", "detail_id=270351"} {
+		f, err := ParseFieldFilter(expr)
+		if err != nil {
+			t.Fatalf("ParseFieldFilter(%q) error = %v", expr, err)
+		}
+		if !f.Match(law) {
+			t.Errorf("%s does not match %+v", expr, law)
+		}
+	}
+}
+
+func TestFilterLaws(t *testing.T) {
+	tests := []struct {
+		name    string
+		exprs   []string
+		wantIDs string
+	}{
+		{name: "contains", exprs: []string{"name=건강보험"}, wantIDs: "002,003"},
+		{name: "not contains", exprs: []string{"department!=보건복지부"}, wantIDs: "001"},
+		{name: "regexp anchored at the end", exprs: []string{"department=~복지부$"}, wantIDs: "002,003"},
+		{name: "regexp alternation", exprs: []string{"name=~^(개인정보|장애인)"}, wantIDs: "001,004"},
+		{name: "filters are ANDed", exprs: []string{"department=~복지부", "type=법률"}, wantIDs: "002,004"},
+		{name: "nothing left", exprs: []string{"name=개인정보", "department=~복지부$"}, wantIDs: ""},
+		{name: "no filters", wantIDs: "001,002,003,004"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var filters []FieldFilter
+			for _, expr := range tt.exprs {
+				f, err := ParseFieldFilter(expr)
+				if err != nil {
+					t.Fatalf("ParseFieldFilter(%q) error = %v", expr, err)
+				}
+				filters = append(filters, f)
+			}
+			var ids []string
+			for _, law := range FilterLaws(filterTestLaws(), filters) {
+				ids = append(ids, law.ID)
+			}
+			if got := strings.Join(ids, ","); got != tt.wantIDs {
+				t.Errorf("FilterLaws(%v) = %s, want %s", tt.exprs, got, tt.wantIDs)
+			}
+		})
+	}
+}
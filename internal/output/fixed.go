@@ -0,0 +1,143 @@
+package output
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mattn/go-runewidth"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/i18n"
+)
+
+// Truncation modes of fixed-width output for values wider than their column
+const (
+	// TruncateEllipsis cuts the value and ends it with "..."
+	TruncateEllipsis = "ellipsis"
+	// TruncateCut cuts the value at the column width
+	TruncateCut = "cut"
+)
+
+// fixedEllipsis marks a value cut by TruncateEllipsis, the same as truncateString
+const fixedEllipsis = "..."
+
+// fixedColumns are the columns of fixed-width output, in order, with the
+// message ID of their header
+var fixedColumns = []struct {
+	header string
+	value  func(api.LawInfo) string
+}{
+	{"output.table.lawID", func(l api.LawInfo) string { return l.ID }},
+	{"output.table.law", func(l api.LawInfo) string { return l.Name }},
+	{"output.table.type", func(l api.LawInfo) string { return l.LawType }},
+	{"output.table.ministry", func(l api.LawInfo) string { return l.Department }},
+	{"output.table.effectiveDate", func(l api.LawInfo) string {
+		// Same fallback to the promulgation date as the other formats
+		if l.EffectDate == "" {
+			return formatDate(l.PromulDate)
+		}
+		return formatDate(l.EffectDate)
+	}},
+}
+
+// DefaultFixedWidths are the column widths used without --widths
+var DefaultFixedWidths = []int{6, 40, 10, 20, 12}
+
+// FixedOptions configures fixed-width output
+type FixedOptions struct {
+	// Widths are the column widths in display cells, one per column
+	Widths []int
+	// Truncate is TruncateEllipsis or TruncateCut, TruncateEllipsis if empty
+	Truncate string
+}
+
+// FixedColumnHeaders returns the headers of the fixed-width columns, in order,
+// in the current language
+func FixedColumnHeaders() []string {
+	headers := make([]string, len(fixedColumns))
+	for i, c := range fixedColumns {
+		headers[i] = i18n.TfIn(i18n.GetCurrentLanguage(), c.header)
+	}
+	return headers
+}
+
+// ParseFixedWidths parses a comma-separated list of column widths, one per
+// fixed-width column
+func ParseFixedWidths(spec string) ([]int, error) {
+	parts := strings.Split(spec, ",")
+	if len(parts) != len(fixedColumns) {
+		return nil, fmt.Errorf("--widths에는 컬럼 %d개(%s)의 폭을 쉼표로 구분해 지정하세요: %s",
+			len(fixedColumns), strings.Join(FixedColumnHeaders(), ", "), spec)
+	}
+
+	widths := make([]int, len(parts))
+	for i, part := range parts {
+		width, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil || width < 1 {
+			return nil, fmt.Errorf("컬럼 폭은 1 이상의 정수여야 합니다: %q", part)
+		}
+		widths[i] = width
+	}
+	return widths, nil
+}
+
+// ParseTruncate validates a truncation mode
+func ParseTruncate(mode string) (string, error) {
+	switch strings.ToLower(mode) {
+	case "", TruncateEllipsis:
+		return TruncateEllipsis, nil
+	case TruncateCut:
+		return TruncateCut, nil
+	default:
+		return "", fmt.Errorf("지원하지 않는 절단 방식: %s (%s, %s 중 선택)", mode, TruncateEllipsis, TruncateCut)
+	}
+}
+
+// WithFixed sets the column widths and truncation of fixed-width output
+func (f *Formatter) WithFixed(opts FixedOptions) *Formatter {
+	f.fixed = opts
+	return f
+}
+
+// formatFixedToString formats results as lines of fixed-width columns
+func (f *Formatter) formatFixedToString(resp *api.SearchResponse) (string, error) {
+	widths := f.fixed.Widths
+	if len(widths) == 0 {
+		widths = DefaultFixedWidths
+	}
+	if len(widths) != len(fixedColumns) {
+		return "", fmt.Errorf("고정 너비 출력에는 컬럼 폭 %d개가 필요합니다 (지정: %d개)", len(fixedColumns), len(widths))
+	}
+
+	var sb strings.Builder
+	for _, law := range resp.Laws {
+		for i, c := range fixedColumns {
+			sb.WriteString(FixedCell(c.value(law), widths[i], f.fixed.Truncate))
+		}
+		sb.WriteString("\n")
+	}
+	return sb.String(), nil
+}
+
+// FixedCell pads or truncates value to exactly width display cells, counting
+// wide characters such as Hangul as two cells. A wide character that does not
+// fit in the last cell is replaced by a space. Line breaks and tabs become
+// spaces so that each record stays on one line.
+func FixedCell(value string, width int, truncate string) string {
+	value = strings.Map(func(r rune) rune {
+		if r == '\n' || r == '\r' || r == '\t' {
+			return ' '
+		}
+		return r
+	}, value)
+
+	if runewidth.StringWidth(value) > width {
+		if truncate == TruncateCut || width <= len(fixedEllipsis) {
+			value, _ = splitAtWidth(value, width)
+		} else {
+			head, _ := splitAtWidth(value, width-len(fixedEllipsis))
+			value = head + fixedEllipsis
+		}
+	}
+	return value + strings.Repeat(" ", width-runewidth.StringWidth(value))
+}
@@ -0,0 +1,133 @@
+package output
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mattn/go-runewidth"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/i18n"
+)
+
+func TestFixedCell(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		width    int
+		truncate string
+		want     string
+	}{
+		{"pad ascii", "PIPA", 6, TruncateEllipsis, "PIPA  "},
+		{"pad hangul by display width", "법률", 6, TruncateEllipsis, "법률  "},
+		{"exact fit", "법령ID", 6, TruncateCut, "법령ID"},
+		{"ellipsis", "개인정보 보호법 시행령", 12, TruncateEllipsis, "개인정보 ..."},
+		{"cut", "개인정보 보호법 시행령", 12, TruncateCut, "개인정보 보 "},
+		{"cut inside a wide character pads with a space", "개인정보", 5, TruncateCut, "개인 "},
+		{"ellipsis after a wide character", "개인정보보호법", 8, TruncateEllipsis, "개인... "},
+		{"narrow columns are cut", "Personal", 3, TruncateEllipsis, "Per"},
+		{"mixed hangul and ascii", "GDPR 대응법", 10, TruncateCut, "GDPR 대응 "},
+		{"line breaks become spaces", "제1조\n목적", 12, TruncateEllipsis, "제1조 목적  "},
+		{"empty", "", 4, TruncateEllipsis, "    "},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FixedCell(tt.value, tt.width, tt.truncate)
+			if got != tt.want {
+				t.Errorf("FixedCell(%q, %d, %s) = %q, want %q", tt.value, tt.width, tt.truncate, got, tt.want)
+			}
+			if w := runewidth.StringWidth(got); w != tt.width {
+				t.Errorf("FixedCell(%q) has width %d, want %d", tt.value, w, tt.width)
+			}
+		})
+	}
+}
+
+func TestFormatFixed(t *testing.T) {
+	resp := &api.SearchResponse{
+		TotalCount: 3,
+		Laws: []api.LawInfo{
+			{ID: "011357", Name: "개인정보 보호법", LawType: "법률", Department: "개인정보보호위원회", EffectDate: "20240315"},
+			{ID: "1", Name: "Act on the Protection of Personal Information (영문)", LawType: "Act", Department: "PIPC", PromulDate: "20230314"},
+			{ID: "0123456789", Name: "도로교통법 시행규칙", LawType: "행정안전부령", Department: "경찰청"},
+		},
+	}
+
+	widths := []int{6, 20, 10, 12, 10}
+	result, err := NewFormatter("fixed").
+		WithFixed(FixedOptions{Widths: widths, Truncate: TruncateEllipsis}).
+		FormatSearchResultToString(resp)
+	if err != nil {
+		t.Fatalf("FormatSearchResultToString() error = %v", err)
+	}
+
+	want := []string{
+		"011357개인정보 보호법     법률      개인정보... 2024-03-15",
+		"1     Act on the Protec...Act       PIPC        2023-03-14",
+		"012...도로교통법 시행규칙 행정안... 경찰청                ",
+	}
+	lines := strings.Split(strings.TrimSuffix(result, "\n"), "\n")
+	if len(lines) != len(want) {
+		t.Fatalf("expected %d lines, got %d:\n%s", len(want), len(lines), result)
+	}
+	for i, line := range lines {
+		if line != want[i] {
+			t.Errorf("line %d = %q, want %q", i, line, want[i])
+		}
+		// Every column starts at the same display offset in every line
+		if w := runewidth.StringWidth(line); w != 58 {
+			t.Errorf("line %d has width %d, want 58", i, w)
+		}
+	}
+
+	// Without widths the default widths are used
+	result, err = NewFormatter("fixed").FormatSearchResultToString(resp)
+	if err != nil {
+		t.Fatalf("FormatSearchResultToString() error = %v", err)
+	}
+	if w := runewidth.StringWidth(strings.SplitN(result, "\n", 2)[0]); w != 88 {
+		t.Errorf("default line width = %d, want 88", w)
+	}
+}
+
+func TestFixedColumnHeaders(t *testing.T) {
+	if got := FixedColumnHeaders(); got[0] != "법령ID" || got[4] != "시행일자" {
+		t.Errorf("FixedColumnHeaders() = %v, want the Korean headers", got)
+	}
+
+	if err := i18n.SetLanguage("en"); err != nil {
+		t.Fatal(err)
+	}
+	defer i18n.SetLanguage("ko")
+	want := []string{"Law ID", "Law Name", "Law Type", "Ministry", "Effective Date"}
+	if got := FixedColumnHeaders(); !reflect.DeepEqual(got, want) {
+		t.Errorf("FixedColumnHeaders() = %v, want %v", got, want)
+	}
+	if _, err := ParseFixedWidths("6"); err == nil || !strings.Contains(err.Error(), "Law Name") {
+		t.Errorf("ParseFixedWidths() error = %v, want the English headers", err)
+	}
+}
+
+func TestParseFixedWidths(t *testing.T) {
+	widths, err := ParseFixedWidths("6, 40,10,20,12")
+	if err != nil {
+		t.Fatalf("ParseFixedWidths() error = %v", err)
+	}
+	if len(widths) != 5 || widths[1] != 40 || widths[4] != 12 {
+		t.Errorf("ParseFixedWidths() = %v", widths)
+	}
+
+	for _, spec := range []string{"", "6,40,10,20", "6,40,10,20,12,3", "6,x,10,20,12", "6,0,10,20,12"} {
+		if _, err := ParseFixedWidths(spec); err == nil {
+			t.Errorf("ParseFixedWidths(%q) should fail", spec)
+		}
+	}
+
+	if mode, err := ParseTruncate("CUT"); err != nil || mode != TruncateCut {
+		t.Errorf("ParseTruncate(CUT) = %q, %v", mode, err)
+	}
+	if _, err := ParseTruncate("wrap"); err == nil {
+		t.Error("ParseTruncate(wrap) should fail")
+	}
+}
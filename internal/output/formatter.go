@@ -3,23 +3,212 @@ package output
 import (
 	"bytes"
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
+	"html"
 	"os"
 	"strings"
+	"time"
 
+	"github.com/mattn/go-runewidth"
 	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/i18n"
+)
+
+// Root elements of XML output
+const (
+	xmlRootSearch = "SearchResponse"
+	xmlRootDetail = "LawDetail"
 )
 
 // Formatter handles output formatting
 type Formatter struct {
 	format string
+	// pageCommand is the command that reproduces the search, used for page navigation hints
+	pageCommand string
+	// pageSize is the requested page size, 0 if unknown
+	pageSize int
+	// jsonSchema is the schema of json and ndjson output (raw or canonical)
+	jsonSchema string
+	// toc adds a table of contents of the articles to law details
+	toc bool
+	// fixed configures fixed-width output
+	fixed FixedOptions
+	// lang is the language of summaries, headers and labels
+	lang string
+	// hyperlinks makes the links of law details clickable with OSC 8 escape codes
+	hyperlinks bool
+	// hanja writes the Hanja name of laws beside the Korean name
+	hanja bool
+	// color highlights tables with the colors of DefaultTheme
+	color bool
 }
 
-// NewFormatter creates a new formatter with the specified format
+// detailLabelWidth is the display width of the labels of law details, so
+// that the values line up
+const detailLabelWidth = 14
+
+// NewFormatter creates a new formatter with the specified format, writing
+// text in the current language of the i18n package
 func NewFormatter(format string) *Formatter {
 	return &Formatter{
-		format: strings.ToLower(format),
+		format:     strings.ToLower(format),
+		lang:       i18n.GetCurrentLanguage(),
+		hyperlinks: SupportsHyperlinks(),
+		color:      GetDefaultTableStyle().UseColor,
+	}
+}
+
+// WithColor sets whether tables are highlighted with the colors of
+// DefaultTheme; by default they are when stdout is a terminal and colors are
+// not disabled with NO_COLOR or --no-color. Other formats are never colored.
+func (f *Formatter) WithColor(enabled bool) *Formatter {
+	f.color = enabled
+	return f
+}
+
+// WithHyperlinks sets whether the links of law details are written as OSC 8
+// hyperlinks; by default they are when the terminal supports them
+func (f *Formatter) WithHyperlinks(enabled bool) *Formatter {
+	f.hyperlinks = enabled
+	return f
+}
+
+// WithHanja sets whether law details show the Hanja name beside the Korean
+// name and search tables add a column of Hanja names
+func (f *Formatter) WithHanja(enabled bool) *Formatter {
+	f.hanja = enabled
+	return f
+}
+
+// lawName returns the name of a law, followed by its Hanja name in
+// parentheses when enabled and known
+func (f *Formatter) lawName(law api.LawInfo) string {
+	if !f.hanja || law.NameHanja == "" || law.NameHanja == law.Name {
+		return law.Name
+	}
+	return law.Name + " (" + law.NameHanja + ")"
+}
+
+// WithLanguage sets the language of summaries, headers and labels
+func (f *Formatter) WithLanguage(lang string) *Formatter {
+	f.lang = lang
+	return f
+}
+
+// t translates a message into the language of the formatter
+func (f *Formatter) t(messageID string, args ...interface{}) string {
+	return i18n.TfIn(f.lang, messageID, args...)
+}
+
+// searchHeaders returns the column headers of search results. Results of a
+// unified search show their source instead of the law ID.
+func (f *Formatter) searchHeaders(hasSource bool) []string {
+	if hasSource {
+		return []string{f.t("output.table.no"), f.t("output.table.law"), f.t("output.table.category"),
+			f.t("output.table.source"), f.t("output.table.ministry"), f.t("output.table.effectiveDate")}
+	}
+	return []string{f.t("output.table.no"), f.t("output.table.lawID"), f.t("output.table.law"),
+		f.t("output.table.type"), f.t("output.table.ministry"), f.t("output.table.effectiveDate")}
+}
+
+// searchNameColumn returns the index of the law name in the rows of searchHeaders
+func searchNameColumn(hasSource bool) int {
+	if hasSource {
+		return 1
+	}
+	return 2
+}
+
+// sourceTable returns the headers and rows of precedents or legal
+// interpretations, whose court, agencies and dates are kept in ExtraFields
+// rather than the ministry and dates of a law. ok is false for other results.
+func (f *Formatter) sourceTable(laws []api.LawInfo) (headers []string, rows [][]string, ok bool) {
+	var keys []string
+	switch {
+	case hasExtraField(laws, api.ExtraCourtName):
+		headers = []string{f.t("output.table.no"), f.t("output.table.id"), f.t("output.table.caseName"),
+			f.t("output.table.caseType"), f.t("output.table.court"), f.t("output.table.caseNumber"), f.t("output.table.judgeDate")}
+		keys = []string{api.ExtraCourtName, api.ExtraCaseNumber, api.ExtraJudgeDate}
+	case hasExtraField(laws, api.ExtraReplyAgency):
+		headers = []string{f.t("output.table.no"), f.t("output.table.id"), f.t("output.table.subject"),
+			f.t("output.table.queryAgency"), f.t("output.table.replyAgency"), f.t("output.table.replyDate")}
+		keys = []string{api.ExtraQueryAgency, api.ExtraReplyAgency, api.ExtraReplyDate}
+	default:
+		return nil, nil, false
+	}
+
+	for i, law := range laws {
+		row := []string{fmt.Sprintf("%d", i+1), law.ID, law.Name}
+		if len(headers) > len(keys)+3 {
+			row = append(row, law.LawType)
+		}
+		for _, key := range keys {
+			value := law.ExtraFields[key]
+			if key == api.ExtraJudgeDate || key == api.ExtraReplyDate {
+				value = formatDate(value)
+			}
+			row = append(row, value)
+		}
+		rows = append(rows, row)
+	}
+	return headers, rows, true
+}
+
+// hasExtraField reports whether every law carries the ExtraFields key
+func hasExtraField(laws []api.LawInfo, key string) bool {
+	for _, law := range laws {
+		if _, ok := law.ExtraFields[key]; !ok {
+			return false
+		}
+	}
+	return len(laws) > 0
+}
+
+// insertColumn returns values with value inserted at index
+func insertColumn(values []string, index int, value string) []string {
+	out := make([]string, 0, len(values)+1)
+	out = append(out, values[:index]...)
+	out = append(out, value)
+	return append(out, values[index:]...)
+}
+
+// pageHint returns the lines pointing to the other pages of a search: the
+// page position and the commands showing the previous and next pages
+func (f *Formatter) pageHint(meta PageMeta) string {
+	s := "\n" + f.t("output.page", meta.Current, meta.Total) + "\n"
+	if meta.HasPrev() {
+		s += fmt.Sprintf("%s: %s\n", f.t("output.prevPage"), pageCommand(f.pageCommand, meta.Current-1))
 	}
+	if meta.HasNext() {
+		s += fmt.Sprintf("%s: %s\n", f.t("output.nextPage"), pageCommand(f.pageCommand, meta.Current+1))
+	}
+	return s
+}
+
+// writeDetailField writes a label and value of a law detail, the values lined up
+func (f *Formatter) writeDetailField(buf *bytes.Buffer, labelID, value string) {
+	label := f.t(labelID) + ":"
+	padding := detailLabelWidth - runewidth.StringWidth(label)
+	if padding < 1 {
+		padding = 1
+	}
+	fmt.Fprintf(buf, "%s%s%s\n", label, strings.Repeat(" ", padding), value)
+}
+
+// writeDetailSection writes the title of a section of a law detail
+func writeDetailSection(buf *bytes.Buffer, title string) {
+	fmt.Fprintf(buf, "\n───────────────────────────────────────────────────────────\n")
+	fmt.Fprintf(buf, " %s\n", title)
+	fmt.Fprintf(buf, "───────────────────────────────────────────────────────────\n\n")
+}
+
+// WithPagination sets the command that reproduces the search and the requested page size.
+// Page navigation in markdown and HTML output suggests the command with a --page option.
+func (f *Formatter) WithPagination(command string, pageSize int) *Formatter {
+	f.pageCommand = command
+	f.pageSize = pageSize
+	return f
 }
 
 // FormatSearchResult formats and outputs the search results
@@ -27,6 +216,10 @@ func (f *Formatter) FormatSearchResult(resp *api.SearchResponse) error {
 	switch f.format {
 	case "json":
 		return f.formatJSON(resp)
+	case "ndjson":
+		return f.WriteNDJSON(os.Stdout, resp)
+	case "xml":
+		return f.formatXML(resp)
 	case "table", "":
 		return f.formatTable(resp)
 	case "markdown", "md":
@@ -37,8 +230,22 @@ func (f *Formatter) FormatSearchResult(resp *api.SearchResponse) error {
 		return f.formatHTML(resp)
 	case "html-simple":
 		return f.formatHTMLSimple(resp)
+	case "fixed":
+		result, err := f.formatFixedToString(resp)
+		if err != nil {
+			return err
+		}
+		fmt.Print(result)
+		return nil
+	case "rss", "atom":
+		result, err := f.FormatSearchResultToString(resp)
+		if err != nil {
+			return err
+		}
+		fmt.Print(result)
+		return nil
 	default:
-		return fmt.Errorf("지원하지 않는 출력 형식: %s (table, json, markdown, csv, html, html-simple 중 선택)", f.format)
+		return fmt.Errorf("지원하지 않는 출력 형식: %s (table, json, ndjson, xml, markdown, csv, html, html-simple, fixed, rss, atom 중 선택)", f.format)
 	}
 }
 
@@ -51,6 +258,10 @@ func (f *Formatter) FormatSearchResultToString(resp *api.SearchResponse) (string
 	switch f.format {
 	case "json":
 		return f.formatJSONToString(resp)
+	case "ndjson":
+		return f.formatNDJSONToString(resp)
+	case "xml":
+		return f.formatXMLToString(resp)
 	case "table", "":
 		return f.formatTableToString(resp)
 	case "markdown", "md":
@@ -61,8 +272,14 @@ func (f *Formatter) FormatSearchResultToString(resp *api.SearchResponse) (string
 		return f.formatHTMLToString(resp)
 	case "html-simple":
 		return f.formatHTMLSimpleToString(resp)
+	case "fixed":
+		return f.formatFixedToString(resp)
+	case "rss":
+		return f.formatRSSToString(resp)
+	case "atom":
+		return f.formatAtomToString(resp)
 	default:
-		return "", fmt.Errorf("지원하지 않는 출력 형식: %s (table, json, markdown, csv, html, html-simple 중 선택)", f.format)
+		return "", fmt.Errorf("지원하지 않는 출력 형식: %s (table, json, ndjson, xml, markdown, csv, html, html-simple, fixed, rss, atom 중 선택)", f.format)
 	}
 }
 
@@ -84,10 +301,20 @@ func (f *Formatter) FormatDetailToStringWithOptions(detail *api.LawDetail, showA
 			return "", fmt.Errorf("JSON 변환 실패: %w", err)
 		}
 		return string(data) + "\n", nil
+	case "xml":
+		data, err := marshalXML(xmlRootDetail, detail)
+		if err != nil {
+			return "", fmt.Errorf("XML 변환 실패: %w", err)
+		}
+		return data, nil
 	case "table", "":
 		return f.formatDetailTableWithOptions(detail, showArticles, showTables, showSupplementary), nil
+	case "markdown", "md":
+		return f.formatDetailMarkdown(detail, showArticles, showTables, showSupplementary), nil
+	case "html":
+		return f.formatDetailHTML(detail, showArticles, showTables, showSupplementary), nil
 	default:
-		return "", fmt.Errorf("지원하지 않는 출력 형식: %s (table, json 중 선택)", f.format)
+		return "", fmt.Errorf("지원하지 않는 출력 형식: %s (table, markdown, html, json, xml 중 선택)", f.format)
 	}
 }
 
@@ -113,9 +340,45 @@ func (f *Formatter) FormatHistoryToString(history *api.LawHistory) (string, erro
 
 // formatJSON outputs results in JSON format
 func (f *Formatter) formatJSON(resp *api.SearchResponse) error {
+	if err := f.validateJSONSchema(); err != nil {
+		return err
+	}
 	encoder := json.NewEncoder(os.Stdout)
 	encoder.SetIndent("", "  ")
-	return encoder.Encode(resp)
+	return encoder.Encode(f.jsonValue(resp))
+}
+
+// formatXML outputs results in XML format
+func (f *Formatter) formatXML(resp *api.SearchResponse) error {
+	result, err := f.formatXMLToString(resp)
+	if err != nil {
+		return err
+	}
+	fmt.Print(result)
+	return nil
+}
+
+// formatXMLToString formats results as indented XML and returns as string
+func (f *Formatter) formatXMLToString(resp *api.SearchResponse) (string, error) {
+	return marshalXML(xmlRootSearch, resp)
+}
+
+// marshalXML encodes v as an indented XML document with an encoding declaration,
+// using root as the name of the root element
+func marshalXML(root string, v interface{}) (string, error) {
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+
+	encoder := xml.NewEncoder(&buf)
+	encoder.Indent("", "  ")
+	if err := encoder.EncodeElement(v, xml.StartElement{Name: xml.Name{Local: root}}); err != nil {
+		return "", err
+	}
+	if err := encoder.Flush(); err != nil {
+		return "", err
+	}
+	buf.WriteString("\n")
+	return buf.String(), nil
 }
 
 // formatTable outputs results in table format using tablewriter
@@ -128,26 +391,38 @@ func (f *Formatter) formatTable(resp *api.SearchResponse) error {
 	return nil
 }
 
-// formatDate converts YYYYMMDD to YYYY-MM-DD format
-func formatDate(date string) string {
-	// Handle YYYYMMDD format
-	if len(date) == 8 {
-		return fmt.Sprintf("%s-%s-%s", date[:4], date[4:6], date[6:8])
+// FormatWarnings renders search warnings (e.g. a unified search source that
+// failed or returned nothing) as lines shown above the results
+func FormatWarnings(warnings []string) string {
+	var buf strings.Builder
+	for _, warning := range warnings {
+		fmt.Fprintf(&buf, "⚠️  %s\n", warning)
 	}
-	// Handle YYYY.MM.DD format (from some APIs like legal interpretations)
-	if len(date) == 10 && date[4] == '.' && date[7] == '.' {
-		return strings.ReplaceAll(date, ".", "-")
+	if len(warnings) > 0 {
+		buf.WriteString("\n")
+	}
+	return buf.String()
+}
+
+// formatDate converts a date of the APIs (YYYYMMDD, YYYY.MM.DD and the other
+// forms of api.ParseDate) to YYYY-MM-DD format, the same dates search results
+// are sorted by. Other values are returned as-is.
+func formatDate(date string) string {
+	if t, ok := api.ParseDate(date); ok {
+		return t.Format("2006-01-02")
 	}
-	// Return as-is for other formats
 	return date
 }
 
 // formatJSONToString formats results in JSON format and returns as string
 func (f *Formatter) formatJSONToString(resp *api.SearchResponse) (string, error) {
+	if err := f.validateJSONSchema(); err != nil {
+		return "", err
+	}
 	var buf bytes.Buffer
 	encoder := json.NewEncoder(&buf)
 	encoder.SetIndent("", "  ")
-	if err := encoder.Encode(resp); err != nil {
+	if err := encoder.Encode(f.jsonValue(resp)); err != nil {
 		return "", err
 	}
 	return buf.String(), nil
@@ -158,11 +433,12 @@ func (f *Formatter) formatTableToString(resp *api.SearchResponse) (string, error
 	var buf bytes.Buffer
 
 	// Show summary
-	fmt.Fprintf(&buf, "총 %d개의 법령을 찾았습니다.\n\n", resp.TotalCount)
+	fmt.Fprintf(&buf, "%s\n\n", f.t("output.summary", resp.TotalCount, f.fetchedNote(resp)))
+	fmt.Fprint(&buf, FormatWarnings(resp.Warnings))
 
 	// If no results, return early
 	if len(resp.Laws) == 0 {
-		fmt.Fprintln(&buf, "검색 결과가 없습니다.")
+		fmt.Fprintln(&buf, f.t("output.table.noResults"))
 		return buf.String(), nil
 	}
 
@@ -175,15 +451,23 @@ func (f *Formatter) formatTableToString(resp *api.SearchResponse) (string, error
 		}
 	}
 
-	// Prepare headers and rows
-	var headers []string
-	var rows [][]string
+	// Precedents and legal interpretations get the columns of their source
+	if headers, rows, ok := f.sourceTable(resp.Laws); ok && !hasSource {
+		style := GetDefaultTableStyle()
+		style.UseColor = f.color
+		fmt.Fprint(&buf, RenderTable(headers, rows, style))
+		if resp.PageableCount() > len(resp.Laws) {
+			fmt.Fprint(&buf, f.pageHint(NewPageMeta(resp, f.pageSize)))
+		}
+		return buf.String(), nil
+	}
 
-	if hasSource {
-		headers = []string{"번호", "법령명", "구분", "출처", "소관부처", "시행일자"}
-	} else {
-		headers = []string{"번호", "법령ID", "법령명", "법령구분", "소관부처", "시행일자"}
+	// Prepare headers and rows
+	headers := f.searchHeaders(hasSource)
+	if f.hanja {
+		headers = insertColumn(headers, searchNameColumn(hasSource)+1, f.t("output.table.hanja"))
 	}
+	var rows [][]string
 
 	// Add data rows
 	for i, law := range resp.Laws {
@@ -217,24 +501,32 @@ func (f *Formatter) formatTableToString(resp *api.SearchResponse) (string, error
 				effectDate,
 			}
 		}
+		if f.hanja {
+			row = insertColumn(row, searchNameColumn(hasSource)+1, law.NameHanja)
+		}
 		rows = append(rows, row)
 	}
 
 	// Use the new table writer
 	style := GetDefaultTableStyle()
+	style.UseColor = f.color
+	typeColumn, dateColumn := 3, 5
+	if hasSource {
+		typeColumn = 2
+	}
+	if f.hanja {
+		dateColumn++
+		if typeColumn > searchNameColumn(hasSource) {
+			typeColumn++
+		}
+	}
+	style.CellColor = DefaultTheme.lawCellColors(resp.Laws, typeColumn, dateColumn, time.Now())
 	tableStr := RenderTable(headers, rows, style)
 	fmt.Fprint(&buf, tableStr)
 
 	// Show pagination info if there are more results
-	if resp.TotalCount > len(resp.Laws) {
-		currentPage := resp.Page
-		// Use a default page size of 10 if not enough items to determine
-		pageSize := 10
-		if len(resp.Laws) > 0 {
-			pageSize = len(resp.Laws)
-		}
-		totalPages := (resp.TotalCount + pageSize - 1) / pageSize
-		fmt.Fprintf(&buf, "\n페이지 %d/%d (--page 옵션으로 다른 페이지 조회 가능)\n", currentPage, totalPages)
+	if resp.PageableCount() > len(resp.Laws) {
+		fmt.Fprint(&buf, f.pageHint(NewPageMeta(resp, f.pageSize)))
 	}
 
 	return buf.String(), nil
@@ -272,85 +564,93 @@ func (f *Formatter) formatDetailTableWithOptions(detail *api.LawDetail, showArti
 
 	// Basic information
 	fmt.Fprintf(&buf, "═══════════════════════════════════════════════════════════\n")
-	fmt.Fprintf(&buf, " 법령 상세 정보\n")
+	fmt.Fprintf(&buf, " %s\n", f.t("output.detail.title"))
 	fmt.Fprintf(&buf, "═══════════════════════════════════════════════════════════\n\n")
 
 	// Display available information, use "N/A" for missing fields
 	if detail.ID != "" {
-		fmt.Fprintf(&buf, "법령ID:       %s\n", detail.ID)
+		f.writeDetailField(&buf, "output.detail.lawID", detail.ID)
 	} else if detail.SerialNo != "" {
-		fmt.Fprintf(&buf, "법령일련번호: %s\n", detail.SerialNo)
+		f.writeDetailField(&buf, "output.detail.serialNo", detail.SerialNo)
 	}
 
 	if detail.Name != "" {
-		fmt.Fprintf(&buf, "법령명:       %s\n", detail.Name)
+		f.writeDetailField(&buf, "output.detail.name", f.lawName(detail.LawInfo))
 	} else {
-		fmt.Fprintf(&buf, "법령명:       (정보 없음)\n")
+		f.writeDetailField(&buf, "output.detail.name", f.t("output.detail.noInfo"))
 	}
 
 	if detail.NameAbbrev != "" {
-		fmt.Fprintf(&buf, "약칭:         %s\n", detail.NameAbbrev)
+		f.writeDetailField(&buf, "output.detail.abbrev", detail.NameAbbrev)
 	}
 
 	if detail.LawType != "" {
-		fmt.Fprintf(&buf, "법령구분:     %s\n", detail.LawType)
+		f.writeDetailField(&buf, "output.detail.lawType", detail.LawType)
 	}
 
 	if detail.Department != "" {
-		fmt.Fprintf(&buf, "소관부처:     %s\n", detail.Department)
+		f.writeDetailField(&buf, "output.detail.department", detail.Department)
 	}
 
 	if detail.PromulDate != "" {
-		fmt.Fprintf(&buf, "공포일자:     %s\n", formatDate(detail.PromulDate))
+		f.writeDetailField(&buf, "output.detail.promulDate", formatDate(detail.PromulDate))
 	}
 
 	if detail.PromulNo != "" {
-		fmt.Fprintf(&buf, "공포번호:     %s\n", detail.PromulNo)
+		f.writeDetailField(&buf, "output.detail.promulNo", detail.PromulNo)
 	}
 
 	if detail.EffectDate != "" {
-		fmt.Fprintf(&buf, "시행일자:     %s\n", formatDate(detail.EffectDate))
+		f.writeDetailField(&buf, "output.detail.effectDate", formatDate(detail.EffectDate))
 	}
 
 	if detail.Category != "" {
-		fmt.Fprintf(&buf, "제개정구분:   %s\n", detail.Category)
+		f.writeDetailField(&buf, "output.detail.category", detail.Category)
+	}
+
+	// Text of documents without articles, such as interpretations and precedents
+	for _, section := range detail.Sections {
+		writeDetailSection(&buf, section.Title)
+		for _, line := range contentLines(section.Content) {
+			fmt.Fprintf(&buf, "  %s\n", line)
+		}
 	}
 
 	// Show summary of contents
-	fmt.Fprintf(&buf, "\n───────────────────────────────────────────────────────────\n")
-	fmt.Fprintf(&buf, " 내용 요약\n")
-	fmt.Fprintf(&buf, "───────────────────────────────────────────────────────────\n\n")
+	writeDetailSection(&buf, f.t("output.detail.summary"))
 
 	// Show counts
 	if len(detail.Articles) > 0 {
-		fmt.Fprintf(&buf, "조문:         %d개\n", len(detail.Articles))
+		f.writeDetailField(&buf, "output.detail.articles", f.t("output.detail.count", len(detail.Articles)))
 	}
 	if len(detail.Tables) > 0 {
-		fmt.Fprintf(&buf, "별표:         %d개\n", len(detail.Tables))
+		f.writeDetailField(&buf, "output.detail.tables", f.t("output.detail.count", len(detail.Tables)))
 	}
 	if len(detail.SupplementaryProvisions) > 0 {
-		fmt.Fprintf(&buf, "부칙:         %d개\n", len(detail.SupplementaryProvisions))
+		f.writeDetailField(&buf, "output.detail.addenda", f.t("output.detail.count", len(detail.SupplementaryProvisions)))
 	}
 	if detail.HasRevisionText {
-		fmt.Fprintf(&buf, "개정문:       있음\n")
+		f.writeDetailField(&buf, "output.detail.revisionText", f.t("output.detail.present"))
 	}
 
 	// Show hints for additional content
 	if len(detail.Articles) > 0 && !showArticles {
-		fmt.Fprintf(&buf, "\n※ 조문 상세 내용은 --articles 옵션을 사용하세요\n")
+		fmt.Fprintf(&buf, "\n%s\n", f.t("output.detail.articlesHint"))
 	}
 	if len(detail.Tables) > 0 && !showTables {
-		fmt.Fprintf(&buf, "※ 별표 내용은 --tables 옵션을 사용하세요\n")
+		fmt.Fprintf(&buf, "%s\n", f.t("output.detail.tablesHint"))
 	}
 	if len(detail.SupplementaryProvisions) > 0 && !showSupplementary {
-		fmt.Fprintf(&buf, "※ 부칙 내용은 --addendum 옵션을 사용하세요\n")
+		fmt.Fprintf(&buf, "%s\n", f.t("output.detail.addendaHint"))
+	}
+
+	if f.toc {
+		fmt.Fprint(&buf, f.formatTOCTable(BuildTOC(detail.Articles)))
 	}
 
 	// Articles if present and requested
 	if showArticles && len(detail.Articles) > 0 {
-		fmt.Fprintf(&buf, "\n───────────────────────────────────────────────────────────\n")
-		fmt.Fprintf(&buf, " 조문 (%d개)\n", len(detail.Articles))
-		fmt.Fprintf(&buf, "───────────────────────────────────────────────────────────\n\n")
+		writeDetailSection(&buf, f.t("output.detail.articlesTitle", len(detail.Articles)))
 
 		for _, article := range detail.Articles {
 			fmt.Fprintf(&buf, "%s", article.Number)
@@ -359,14 +659,9 @@ func (f *Formatter) formatDetailTableWithOptions(detail *api.LawDetail, showArti
 			}
 			fmt.Fprintf(&buf, "\n")
 
-			// Clean and format content
-			content := strings.TrimSpace(article.Content)
-			content = strings.ReplaceAll(content, "\r\n", "\n")
-			lines := strings.Split(content, "\n")
-			for _, line := range lines {
-				if strings.TrimSpace(line) != "" {
-					fmt.Fprintf(&buf, "  %s\n", line)
-				}
+			// Clean and format content, the 항, 호 and 목 indented
+			for _, line := range articleLines(article) {
+				fmt.Fprintf(&buf, "  %s\n", line)
 			}
 			fmt.Fprintf(&buf, "\n")
 		}
@@ -374,9 +669,7 @@ func (f *Formatter) formatDetailTableWithOptions(detail *api.LawDetail, showArti
 
 	// Tables if present and requested
 	if showTables && len(detail.Tables) > 0 {
-		fmt.Fprintf(&buf, "\n───────────────────────────────────────────────────────────\n")
-		fmt.Fprintf(&buf, " 별표 (%d개)\n", len(detail.Tables))
-		fmt.Fprintf(&buf, "───────────────────────────────────────────────────────────\n\n")
+		writeDetailSection(&buf, f.t("output.detail.tablesTitle", len(detail.Tables)))
 
 		for _, table := range detail.Tables {
 			fmt.Fprintf(&buf, "%s", table.Number)
@@ -386,15 +679,8 @@ func (f *Formatter) formatDetailTableWithOptions(detail *api.LawDetail, showArti
 			fmt.Fprintf(&buf, "\n")
 
 			// Clean and format content
-			if table.Content != "" {
-				content := strings.TrimSpace(table.Content)
-				content = strings.ReplaceAll(content, "\r\n", "\n")
-				lines := strings.Split(content, "\n")
-				for _, line := range lines {
-					if strings.TrimSpace(line) != "" {
-						fmt.Fprintf(&buf, "  %s\n", line)
-					}
-				}
+			for _, line := range contentLines(table.Content) {
+				fmt.Fprintf(&buf, "  %s\n", line)
 			}
 			fmt.Fprintf(&buf, "\n")
 		}
@@ -402,13 +688,11 @@ func (f *Formatter) formatDetailTableWithOptions(detail *api.LawDetail, showArti
 
 	// Supplementary provisions if present and requested
 	if showSupplementary && len(detail.SupplementaryProvisions) > 0 {
-		fmt.Fprintf(&buf, "\n───────────────────────────────────────────────────────────\n")
-		fmt.Fprintf(&buf, " 부칙 (%d개)\n", len(detail.SupplementaryProvisions))
-		fmt.Fprintf(&buf, "───────────────────────────────────────────────────────────\n\n")
+		writeDetailSection(&buf, f.t("output.detail.addendaTitle", len(detail.SupplementaryProvisions)))
 
 		for _, supp := range detail.SupplementaryProvisions {
 			if supp.PromulgationDate != "" || supp.PromulgationNo != "" {
-				fmt.Fprintf(&buf, "부칙")
+				fmt.Fprint(&buf, f.t("output.detail.addendum"))
 				if supp.PromulgationNo != "" {
 					fmt.Fprintf(&buf, " <%s>", supp.PromulgationNo)
 				}
@@ -421,15 +705,8 @@ func (f *Formatter) formatDetailTableWithOptions(detail *api.LawDetail, showArti
 			}
 
 			// Clean and format content
-			if supp.Content != "" {
-				content := strings.TrimSpace(supp.Content)
-				content = strings.ReplaceAll(content, "\r\n", "\n")
-				lines := strings.Split(content, "\n")
-				for _, line := range lines {
-					if strings.TrimSpace(line) != "" {
-						fmt.Fprintf(&buf, "  %s\n", line)
-					}
-				}
+			for _, line := range contentLines(supp.Content) {
+				fmt.Fprintf(&buf, "  %s\n", line)
 			}
 			fmt.Fprintf(&buf, "\n")
 		}
@@ -437,21 +714,21 @@ func (f *Formatter) formatDetailTableWithOptions(detail *api.LawDetail, showArti
 
 	// Related laws if present
 	if len(detail.RelatedLaws) > 0 {
-		fmt.Fprintf(&buf, "\n───────────────────────────────────────────────────────────\n")
-		fmt.Fprintf(&buf, " 관련 법령\n")
-		fmt.Fprintf(&buf, "───────────────────────────────────────────────────────────\n\n")
+		writeDetailSection(&buf, f.t("output.detail.relatedLaws"))
 		for _, law := range detail.RelatedLaws {
-			fmt.Fprintf(&buf, "  • %s\n", law)
+			f.writeDetailLink(&buf, law.Name, law.URL)
 		}
 	}
 
 	// Attachments if present
 	if len(detail.Attachments) > 0 {
-		fmt.Fprintf(&buf, "\n───────────────────────────────────────────────────────────\n")
-		fmt.Fprintf(&buf, " 첨부 파일\n")
-		fmt.Fprintf(&buf, "───────────────────────────────────────────────────────────\n\n")
+		writeDetailSection(&buf, f.t("output.detail.attachments"))
 		for _, file := range detail.Attachments {
-			fmt.Fprintf(&buf, "  • %s\n", file)
+			name := file.Name
+			if file.Size > 0 {
+				name += " (" + FormatFileSize(file.Size) + ")"
+			}
+			f.writeDetailLink(&buf, name, file.URL)
 		}
 	}
 
@@ -460,37 +737,78 @@ func (f *Formatter) formatDetailTableWithOptions(detail *api.LawDetail, showArti
 	return buf.String()
 }
 
+// writeDetailLink writes a linked item of a law detail with its URL below,
+// the name clickable on terminals that support hyperlinks
+func (f *Formatter) writeDetailLink(buf *bytes.Buffer, name, url string) {
+	if f.hyperlinks {
+		name = Hyperlink(name, url)
+	}
+	fmt.Fprintf(buf, "  • %s\n", name)
+	if url != "" {
+		fmt.Fprintf(buf, "    %s\n", url)
+	}
+}
+
+// FormatFileSize returns size in bytes in B, KB or MB
+func FormatFileSize(size int64) string {
+	switch {
+	case size >= 1<<20:
+		return fmt.Sprintf("%.1f MB", float64(size)/(1<<20))
+	case size >= 1<<10:
+		return fmt.Sprintf("%.1f KB", float64(size)/(1<<10))
+	default:
+		return fmt.Sprintf("%d B", size)
+	}
+}
+
+// contentLines normalizes line endings and returns the non-blank lines of content
+func contentLines(content string) []string {
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return nil
+	}
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+
+	var lines []string
+	for _, line := range strings.Split(content, "\n") {
+		if strings.TrimSpace(line) != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
 // formatHistoryTable formats law history as a table
 func (f *Formatter) formatHistoryTable(history *api.LawHistory) string {
 	var buf bytes.Buffer
 
 	fmt.Fprintf(&buf, "═══════════════════════════════════════════════════════════\n")
-	fmt.Fprintf(&buf, " 법령 제/개정 이력\n")
+	fmt.Fprintf(&buf, " %s\n", f.t("output.history.title"))
 	fmt.Fprintf(&buf, "═══════════════════════════════════════════════════════════\n\n")
 
 	if history.LawName != "" {
-		fmt.Fprintf(&buf, "법령명: %s\n", history.LawName)
+		fmt.Fprintf(&buf, "%s: %s\n", f.t("output.history.lawName"), history.LawName)
 	}
 	if history.LawID != "" {
-		fmt.Fprintf(&buf, "법령ID: %s\n", history.LawID)
+		fmt.Fprintf(&buf, "%s: %s\n", f.t("output.history.lawID"), history.LawID)
 	}
 
 	if len(history.Histories) == 0 {
-		fmt.Fprintf(&buf, "\n이력이 없습니다.\n")
+		fmt.Fprintf(&buf, "\n%s\n", f.t("output.history.none"))
 	} else {
-		fmt.Fprintf(&buf, "\n총 %d개의 이력\n", len(history.Histories))
+		fmt.Fprintf(&buf, "\n%s\n", f.t("output.history.count", len(history.Histories)))
 		fmt.Fprintf(&buf, "───────────────────────────────────────────────────────────\n\n")
 
 		for i, record := range history.Histories {
 			fmt.Fprintf(&buf, "[%d] %s - %s\n", i+1, formatDate(record.Date), record.Type)
 			if record.PromulNo != "" {
-				fmt.Fprintf(&buf, "    공포번호: %s\n", record.PromulNo)
+				fmt.Fprintf(&buf, "    %s: %s\n", f.t("output.history.promulNo"), record.PromulNo)
 			}
 			if record.EffectDate != "" {
-				fmt.Fprintf(&buf, "    시행일자: %s\n", formatDate(record.EffectDate))
+				fmt.Fprintf(&buf, "    %s: %s\n", f.t("output.history.effectDate"), formatDate(record.EffectDate))
 			}
 			if record.Reason != "" {
-				fmt.Fprintf(&buf, "    개정이유: %s\n", record.Reason)
+				fmt.Fprintf(&buf, "    %s: %s\n", f.t("output.history.reason"), record.Reason)
 			}
 			fmt.Fprintf(&buf, "\n")
 		}
@@ -516,12 +834,15 @@ func (f *Formatter) formatMarkdownToString(resp *api.SearchResponse) (string, er
 	var buf bytes.Buffer
 
 	// Show summary
-	fmt.Fprintf(&buf, "## 검색 결과\n\n")
-	fmt.Fprintf(&buf, "총 **%d**개의 법령을 찾았습니다.\n\n", resp.TotalCount)
+	fmt.Fprintf(&buf, "## %s\n\n", f.t("output.searchResults"))
+	fmt.Fprintf(&buf, "%s\n\n", f.t("output.summaryMarkdown", resp.TotalCount, f.fetchedNote(resp)))
+	for _, warning := range resp.Warnings {
+		fmt.Fprintf(&buf, "> ⚠️ %s\n\n", warning)
+	}
 
 	// If no results, return early
 	if len(resp.Laws) == 0 {
-		fmt.Fprintln(&buf, "_검색 결과가 없습니다._")
+		fmt.Fprintf(&buf, "_%s_\n", f.t("output.table.noResults"))
 		return buf.String(), nil
 	}
 
@@ -535,15 +856,9 @@ func (f *Formatter) formatMarkdownToString(resp *api.SearchResponse) (string, er
 	}
 
 	// Prepare headers and rows
-	var headers []string
+	headers := f.searchHeaders(hasSource)
 	var rows [][]string
 
-	if hasSource {
-		headers = []string{"번호", "법령명", "구분", "출처", "소관부처", "시행일자"}
-	} else {
-		headers = []string{"번호", "법령ID", "법령명", "법령구분", "소관부처", "시행일자"}
-	}
-
 	// Add data rows
 	for i, law := range resp.Laws {
 		effectDate := formatDate(law.EffectDate)
@@ -559,7 +874,7 @@ func (f *Formatter) formatMarkdownToString(resp *api.SearchResponse) (string, er
 			}
 			row = []string{
 				fmt.Sprintf("%d", i+1),
-				law.Name,
+				markdownLawLink(law),
 				law.LawType,
 				source,
 				law.Department,
@@ -569,7 +884,7 @@ func (f *Formatter) formatMarkdownToString(resp *api.SearchResponse) (string, er
 			row = []string{
 				fmt.Sprintf("%d", i+1),
 				law.ID,
-				law.Name,
+				markdownLawLink(law),
 				law.LawType,
 				law.Department,
 				effectDate,
@@ -583,19 +898,24 @@ func (f *Formatter) formatMarkdownToString(resp *api.SearchResponse) (string, er
 	fmt.Fprint(&buf, tableStr)
 
 	// Show pagination info
-	if resp.TotalCount > len(resp.Laws) {
-		currentPage := resp.Page
-		pageSize := 10
-		if len(resp.Laws) > 0 {
-			pageSize = len(resp.Laws)
-		}
-		totalPages := (resp.TotalCount + pageSize - 1) / pageSize
-		fmt.Fprintf(&buf, "\n> 페이지 %d/%d (--page 옵션으로 다른 페이지 조회 가능)\n", currentPage, totalPages)
+	if resp.PageableCount() > len(resp.Laws) {
+		fmt.Fprint(&buf, f.markdownPageNavigation(NewPageMeta(resp, f.pageSize), f.pageCommand))
 	}
 
 	return buf.String(), nil
 }
 
+// markdownLawLink returns the name of a search result as a markdown link to
+// its page (api.LawSourceURL), or the name alone when the source of the result
+// is unknown. Pipes are escaped by the table.
+func markdownLawLink(law api.LawInfo) string {
+	link := api.LawSourceURL(law)
+	if link == "" {
+		return law.Name
+	}
+	return "[" + escapeMarkdownLinkText(law.Name) + "](" + link + ")"
+}
+
 // formatCSV outputs results in CSV format
 func (f *Formatter) formatCSV(resp *api.SearchResponse) error {
 	result, err := f.formatCSVToString(resp)
@@ -612,61 +932,43 @@ func (f *Formatter) formatCSVToString(resp *api.SearchResponse) (string, error)
 		return "", nil
 	}
 
-	// Check if we have source information
-	hasSource := false
-	for _, law := range resp.Laws {
-		if law.Source != "" {
-			hasSource = true
-			break
-		}
+	hasSource := hasSourceColumn(resp.Laws)
+	headers := f.searchHeaders(hasSource)
+	rows := make([][]string, 0, len(resp.Laws))
+	for i, law := range resp.Laws {
+		rows = append(rows, csvRow(i+1, law, hasSource))
 	}
 
-	// Prepare headers and rows
-	var headers []string
-	var rows [][]string
+	// Render CSV with BOM for Excel compatibility
+	return RenderCSV(headers, rows, true)
+}
 
-	if hasSource {
-		headers = []string{"번호", "법령명", "구분", "출처", "소관부처", "시행일자"}
-	} else {
-		headers = []string{"번호", "법령ID", "법령명", "법령구분", "소관부처", "시행일자"}
+// hasSourceColumn reports whether the results come from a unified search,
+// whose rows show their source instead of the law ID
+func hasSourceColumn(laws []api.LawInfo) bool {
+	for _, law := range laws {
+		if law.Source != "" {
+			return true
+		}
 	}
+	return false
+}
 
-	// Add data rows
-	for i, law := range resp.Laws {
-		effectDate := formatDate(law.EffectDate)
-		if effectDate == "" && law.PromulDate != "" {
-			effectDate = formatDate(law.PromulDate)
-		}
+// csvRow returns the CSV row of the law numbered number
+func csvRow(number int, law api.LawInfo, hasSource bool) []string {
+	effectDate := formatDate(law.EffectDate)
+	if effectDate == "" && law.PromulDate != "" {
+		effectDate = formatDate(law.PromulDate)
+	}
 
-		var row []string
-		if hasSource {
-			source := law.Source
-			if source == "" {
-				source = "-"
-			}
-			row = []string{
-				fmt.Sprintf("%d", i+1),
-				law.Name,
-				law.LawType,
-				source,
-				law.Department,
-				effectDate,
-			}
-		} else {
-			row = []string{
-				fmt.Sprintf("%d", i+1),
-				law.ID,
-				law.Name,
-				law.LawType,
-				law.Department,
-				effectDate,
-			}
+	if hasSource {
+		source := law.Source
+		if source == "" {
+			source = "-"
 		}
-		rows = append(rows, row)
+		return []string{fmt.Sprintf("%d", number), law.Name, law.LawType, source, law.Department, effectDate}
 	}
-
-	// Render CSV with BOM for Excel compatibility
-	return RenderCSV(headers, rows, true)
+	return []string{fmt.Sprintf("%d", number), law.ID, law.Name, law.LawType, law.Department, effectDate}
 }
 
 // formatHTML outputs results in HTML format
@@ -685,20 +987,20 @@ func (f *Formatter) formatHTMLToString(resp *api.SearchResponse) (string, error)
 
 	// HTML header
 	fmt.Fprintln(&buf, `<!DOCTYPE html>`)
-	fmt.Fprintln(&buf, `<html lang="ko">`)
+	fmt.Fprintf(&buf, "<html lang=\"%s\">\n", html.EscapeString(f.lang))
 	fmt.Fprintln(&buf, `<head>`)
 	fmt.Fprintln(&buf, `  <meta charset="UTF-8">`)
-	fmt.Fprintln(&buf, `  <title>법령 검색 결과</title>`)
+	fmt.Fprintf(&buf, "  <title>%s</title>\n", f.t("output.htmlTitle"))
 	fmt.Fprintln(&buf, `</head>`)
 	fmt.Fprintln(&buf, `<body>`)
 
 	// Summary
-	fmt.Fprintf(&buf, `  <h2>검색 결과</h2>%s`, "\n")
-	fmt.Fprintf(&buf, `  <p>총 <strong>%d</strong>개의 법령을 찾았습니다.</p>%s`, resp.TotalCount, "\n")
+	fmt.Fprintf(&buf, "  <h2>%s</h2>\n", f.t("output.searchResults"))
+	fmt.Fprintf(&buf, "  <p>%s</p>\n", f.t("output.summaryHTML", resp.TotalCount))
 
 	// If no results, return early
 	if len(resp.Laws) == 0 {
-		fmt.Fprintln(&buf, `  <p><em>검색 결과가 없습니다.</em></p>`)
+		fmt.Fprintf(&buf, "  <p><em>%s</em></p>\n", f.t("output.table.noResults"))
 		fmt.Fprintln(&buf, `</body>`)
 		fmt.Fprintln(&buf, `</html>`)
 		return buf.String(), nil
@@ -714,15 +1016,9 @@ func (f *Formatter) formatHTMLToString(resp *api.SearchResponse) (string, error)
 	}
 
 	// Prepare headers and rows
-	var headers []string
+	headers := f.searchHeaders(hasSource)
 	var rows [][]string
 
-	if hasSource {
-		headers = []string{"번호", "법령명", "구분", "출처", "소관부처", "시행일자"}
-	} else {
-		headers = []string{"번호", "법령ID", "법령명", "법령구분", "소관부처", "시행일자"}
-	}
-
 	// Add data rows
 	for i, law := range resp.Laws {
 		effectDate := formatDate(law.EffectDate)
@@ -762,14 +1058,8 @@ func (f *Formatter) formatHTMLToString(resp *api.SearchResponse) (string, error)
 	fmt.Fprintln(&buf, tableStr)
 
 	// Pagination info
-	if resp.TotalCount > len(resp.Laws) {
-		currentPage := resp.Page
-		pageSize := 10
-		if len(resp.Laws) > 0 {
-			pageSize = len(resp.Laws)
-		}
-		totalPages := (resp.TotalCount + pageSize - 1) / pageSize
-		fmt.Fprintf(&buf, `  <p style="margin-top: 20px; color: #666;">페이지 %d/%d</p>%s`, currentPage, totalPages, "\n")
+	if resp.PageableCount() > len(resp.Laws) {
+		fmt.Fprint(&buf, f.htmlPageNavigation(NewPageMeta(resp, f.pageSize), f.pageCommand, "  "))
 	}
 
 	fmt.Fprintln(&buf, `</body>`)
@@ -793,12 +1083,12 @@ func (f *Formatter) formatHTMLSimpleToString(resp *api.SearchResponse) (string,
 	var buf bytes.Buffer
 
 	// Summary
-	fmt.Fprintln(&buf, `<h2>검색 결과</h2>`)
-	fmt.Fprintf(&buf, `<p>총 <strong>%d</strong>개의 법령을 찾았습니다.</p>%s`, resp.TotalCount, "\n")
+	fmt.Fprintf(&buf, "<h2>%s</h2>\n", f.t("output.searchResults"))
+	fmt.Fprintf(&buf, "<p>%s</p>\n", f.t("output.summaryHTML", resp.TotalCount))
 
 	// If no results, return early
 	if len(resp.Laws) == 0 {
-		fmt.Fprintln(&buf, `<p><em>검색 결과가 없습니다.</em></p>`)
+		fmt.Fprintf(&buf, "<p><em>%s</em></p>\n", f.t("output.table.noResults"))
 		return buf.String(), nil
 	}
 
@@ -812,15 +1102,9 @@ func (f *Formatter) formatHTMLSimpleToString(resp *api.SearchResponse) (string,
 	}
 
 	// Prepare headers and rows
-	var headers []string
+	headers := f.searchHeaders(hasSource)
 	var rows [][]string
 
-	if hasSource {
-		headers = []string{"번호", "법령명", "구분", "출처", "소관부처", "시행일자"}
-	} else {
-		headers = []string{"번호", "법령ID", "법령명", "법령구분", "소관부처", "시행일자"}
-	}
-
 	// Add data rows
 	for i, law := range resp.Laws {
 		effectDate := formatDate(law.EffectDate)
@@ -860,14 +1144,8 @@ func (f *Formatter) formatHTMLSimpleToString(resp *api.SearchResponse) (string,
 	fmt.Fprintln(&buf, tableStr)
 
 	// Pagination info
-	if resp.TotalCount > len(resp.Laws) {
-		currentPage := resp.Page
-		pageSize := 10
-		if len(resp.Laws) > 0 {
-			pageSize = len(resp.Laws)
-		}
-		totalPages := (resp.TotalCount + pageSize - 1) / pageSize
-		fmt.Fprintf(&buf, `<p>페이지 %d/%d</p>%s`, currentPage, totalPages, "\n")
+	if resp.PageableCount() > len(resp.Laws) {
+		fmt.Fprint(&buf, f.htmlPageNavigation(NewPageMeta(resp, f.pageSize), f.pageCommand, ""))
 	}
 
 	return buf.String(), nil
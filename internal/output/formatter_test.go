@@ -3,6 +3,7 @@ package output
 import (
 	"bytes"
 	"encoding/json"
+	"encoding/xml"
 	"io"
 	"os"
 	"strings"
@@ -177,6 +178,9 @@ func TestFormatDate(t *testing.T) {
 	}{
 		{"20231201", "2023-12-01"},
 		{"20200805", "2020-08-05"},
+		{"2020.08.05", "2020-08-05"},
+		{"2020. 8. 5.", "2020-08-05"},
+		{"20201399", "20201399"},     // Not a day, return as-is
 		{"2023", "2023"},             // Invalid format, return as-is
 		{"", ""},                     // Empty string
 		{"not-a-date", "not-a-date"}, // Invalid input
@@ -282,7 +286,7 @@ func TestFormatSearchResultToString(t *testing.T) {
 		},
 		{
 			name:    "Invalid format",
-			format:  "xml",
+			format:  "yaml",
 			resp:    resp,
 			wantErr: true,
 		},
@@ -382,6 +386,132 @@ func TestFormatJSONToString(t *testing.T) {
 	}
 }
 
+func TestFormatSearchResult_XML(t *testing.T) {
+	resp := &api.SearchResponse{
+		TotalCount: 2,
+		Page:       1,
+		Laws: []api.LawInfo{
+			{
+				ID:         "001234",
+				Name:       "개인정보 보호법",
+				LawType:    "법률",
+				Department: "개인정보보호위원회",
+				EffectDate: "20200805",
+			},
+			{
+				ID:         "001235",
+				Name:       "개인정보 보호법 시행령",
+				LawType:    "대통령령",
+				Department: "개인정보보호위원회",
+				EffectDate: "20210202",
+			},
+		},
+	}
+
+	// Capture output
+	var err error
+	output := captureStdout(func() {
+		f := NewFormatter("xml")
+		err = f.FormatSearchResult(resp)
+	})
+
+	if err != nil {
+		t.Fatalf("FormatSearchResult failed: %v", err)
+	}
+
+	// Parse XML output
+	var result api.SearchResponse
+	if err := xml.Unmarshal([]byte(output), &result); err != nil {
+		t.Fatalf("Failed to parse XML output: %v", err)
+	}
+
+	// Verify
+	if result.TotalCount != resp.TotalCount {
+		t.Errorf("TotalCount = %d, want %d", result.TotalCount, resp.TotalCount)
+	}
+	if len(result.Laws) != len(resp.Laws) {
+		t.Errorf("Laws count = %d, want %d", len(result.Laws), len(resp.Laws))
+	}
+	if result.Laws[1].Name != "개인정보 보호법 시행령" {
+		t.Errorf("Laws[1].Name = %q, want %q", result.Laws[1].Name, "개인정보 보호법 시행령")
+	}
+}
+
+func TestFormatXMLToString(t *testing.T) {
+	resp := &api.SearchResponse{
+		TotalCount: 1,
+		Page:       1,
+		Laws: []api.LawInfo{
+			{
+				ID:   "001",
+				Name: "테스트 법령 & 시행령",
+			},
+		},
+	}
+
+	f := NewFormatter("xml")
+	result, err := f.FormatSearchResultToString(resp)
+	if err != nil {
+		t.Fatalf("FormatSearchResultToString() error = %v", err)
+	}
+
+	// Check declaration and root element
+	if !strings.HasPrefix(result, `<?xml version="1.0" encoding="UTF-8"?>`) {
+		t.Errorf("XML should start with an encoding declaration, got:\n%s", result)
+	}
+	if !strings.Contains(result, "<SearchResponse>") || !strings.HasSuffix(result, "</SearchResponse>\n") {
+		t.Errorf("XML should have a SearchResponse root element, got:\n%s", result)
+	}
+
+	// Check the existing xml tags, escaping and indentation (2 spaces)
+	if !strings.Contains(result, "\n  <totalCnt>1</totalCnt>") {
+		t.Errorf("XML should be indented with 2 spaces, got:\n%s", result)
+	}
+	if !strings.Contains(result, "<법령명한글>테스트 법령 &amp; 시행령</법령명한글>") {
+		t.Errorf("XML should use the law xml tags with escaped values, got:\n%s", result)
+	}
+	if strings.Contains(result, "<error>") {
+		t.Errorf("Empty error should be omitted, got:\n%s", result)
+	}
+}
+
+func TestFormatDetailToString_XML(t *testing.T) {
+	detail := &api.LawDetail{
+		LawInfo: api.LawInfo{
+			ID:   "001234",
+			Name: "개인정보 보호법",
+		},
+		Articles: []api.Article{
+			{Number: "1", Title: "목적", Content: "이 법은 개인정보의 처리 및 보호에 관한 사항을 정한다."},
+		},
+	}
+
+	f := NewFormatter("xml")
+	result, err := f.FormatDetailToString(detail)
+	if err != nil {
+		t.Fatalf("FormatDetailToString() error = %v", err)
+	}
+
+	if !strings.HasPrefix(result, xml.Header) || !strings.Contains(result, "<LawDetail>") {
+		t.Errorf("XML should have a declaration and a LawDetail root element, got:\n%s", result)
+	}
+
+	var parsed api.LawDetail
+	if err := xml.Unmarshal([]byte(result), &parsed); err != nil {
+		t.Fatalf("XML output is invalid: %v", err)
+	}
+	if parsed.Name != "개인정보 보호법" || len(parsed.Articles) != 1 || parsed.Articles[0].Title != "목적" {
+		t.Errorf("Unexpected parsed detail: %+v", parsed)
+	}
+}
+
+func TestFormatDetailToString_InvalidFormat(t *testing.T) {
+	_, err := NewFormatter("yaml").FormatDetailToString(&api.LawDetail{})
+	if err == nil || !strings.Contains(err.Error(), "table, markdown, html, json, xml") {
+		t.Errorf("Error should list the supported formats, got: %v", err)
+	}
+}
+
 func TestFormatTableToString(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -472,3 +602,365 @@ func TestFormatTableToString(t *testing.T) {
 		})
 	}
 }
+
+func TestFormatDetailPlainText(t *testing.T) {
+	detail := &api.LawDetail{
+		LawInfo: api.LawInfo{ID: "001234", Name: "테스트법"},
+		Articles: []api.Article{
+			{Number: "1", Content: "제1장 총칙"},
+			{Number: "1", Title: "목적", Content: "제1조(목적) 이 법은 테스트를 목적으로 한다."},
+			{Number: "2", Title: "정의", Content: "제2조(정의)\r\n  이 법에서 사용하는 용어의 뜻은 다음과 같다.\r\n\r\n  1. \"테스트\"란 시험을 말한다.\r\n"},
+			{Number: "3", Title: "적용 범위", Content: "이 법은 모든 시험에 적용한다."},
+		},
+		Tables: []api.Table{
+			{Number: "별표 1", Title: "수수료", Content: "구분 금액\r\n\r\n신청 1,000원"},
+		},
+		SupplementaryProvisions: []api.SupplementaryProvision{
+			{PromulgationNo: "제100호", PromulgationDate: "20240101", Content: "이 법은 공포한 날부터 시행한다."},
+		},
+	}
+
+	f := NewFormatter("table")
+
+	t.Run("Articles only", func(t *testing.T) {
+		got, err := f.FormatDetailPlainText(detail, false, false)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		want := "제1장 총칙\n" +
+			"\n" +
+			"제1조(목적)\n이 법은 테스트를 목적으로 한다.\n" +
+			"\n" +
+			"제2조(정의)\n이 법에서 사용하는 용어의 뜻은 다음과 같다.\n1. \"테스트\"란 시험을 말한다.\n" +
+			"\n" +
+			"제3조(적용 범위)\n이 법은 모든 시험에 적용한다.\n"
+		if got != want {
+			t.Errorf("FormatDetailPlainText() =\n%q\nwant\n%q", got, want)
+		}
+	})
+
+	t.Run("With tables and addendum", func(t *testing.T) {
+		got, err := f.FormatDetailPlainText(detail, true, true)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		for _, want := range []string{
+			"\n별표 1 수수료\n구분 금액\n신청 1,000원\n",
+			"\n부칙 <제100호> (2024-01-01)\n이 법은 공포한 날부터 시행한다.\n",
+		} {
+			if !strings.Contains(got, want) {
+				t.Errorf("output should contain %q, got:\n%s", want, got)
+			}
+		}
+		for _, decoration := range []string{"═", "─", "  "} {
+			if strings.Contains(got, decoration) {
+				t.Errorf("plain output should not contain %q, got:\n%s", decoration, got)
+			}
+		}
+	})
+
+	t.Run("Nil detail", func(t *testing.T) {
+		if _, err := f.FormatDetailPlainText(nil, false, false); err == nil {
+			t.Error("expected error for nil detail")
+		}
+	})
+}
+
+func TestFormatterLanguage(t *testing.T) {
+	resp := &api.SearchResponse{
+		TotalCount: 25,
+		Page:       1,
+		Laws:       []api.LawInfo{{ID: "011357", Name: "개인정보 보호법", LawType: "법률", Department: "개인정보보호위원회", EffectDate: "20240315"}},
+	}
+	detail := &api.LawDetail{
+		LawInfo:  api.LawInfo{ID: "011357", Name: "개인정보 보호법", Department: "개인정보보호위원회", EffectDate: "20240315"},
+		Articles: []api.Article{{Number: "제1조", Content: "목적"}},
+	}
+	history := &api.LawHistory{
+		LawName:   "개인정보 보호법",
+		Histories: []api.HistoryRecord{{Date: "20230314", Type: "일부개정", Reason: "정보주체 권리 보장"}},
+	}
+
+	tests := []struct {
+		lang      string
+		format    string
+		render    func(f *Formatter) (string, error)
+		contains  []string
+		forbidden []string
+	}{
+		{
+			lang: "ko", format: "table",
+			render:   func(f *Formatter) (string, error) { return f.FormatSearchResultToString(resp) },
+			contains: []string{"총 25개의 법령을 찾았습니다.", "법령명", "소관부처", "페이지 1/25 (--page 옵션으로 다른 페이지 조회 가능)"},
+		},
+		{
+			lang: "en", format: "table",
+			render:    func(f *Formatter) (string, error) { return f.FormatSearchResultToString(resp) },
+			contains:  []string{"Found 25 laws.", "LAW NAME", "MINISTRY", "EFFECTIVE DATE", "Page 1/25 (use --page to view other pages)"},
+			forbidden: []string{"총 ", "법령명", "페이지"},
+		},
+		{
+			lang: "en", format: "markdown",
+			render:    func(f *Formatter) (string, error) { return f.FormatSearchResultToString(resp) },
+			contains:  []string{"## Search Results", "Found **25** laws.", "Next page: `warp law --page 2`"},
+			forbidden: []string{"검색 결과", "다음 페이지"},
+		},
+		{
+			lang: "en", format: "html",
+			render:    func(f *Formatter) (string, error) { return f.FormatSearchResultToString(resp) },
+			contains:  []string{`<html lang="en">`, "<title>Law Search Results</title>", "Found <strong>25</strong> laws.", "Next ▶", "Page 1/25"},
+			forbidden: []string{"검색 결과", "다음 ▶"},
+		},
+		{
+			lang: "en", format: "table",
+			render:    func(f *Formatter) (string, error) { return f.FormatSearchResultToString(&api.SearchResponse{}) },
+			contains:  []string{"Found 0 laws.", "No results found."},
+			forbidden: []string{"검색 결과가 없습니다"},
+		},
+		{
+			lang: "ko", format: "table",
+			render:   func(f *Formatter) (string, error) { return f.FormatDetailToStringWithOptions(detail, false, false, false) },
+			contains: []string{" 법령 상세 정보", "법령ID:       011357", "소관부처:     개인정보보호위원회", " 내용 요약", "조문:         1개", "※ 조문 상세 내용은 --articles 옵션을 사용하세요"},
+		},
+		{
+			lang: "en", format: "table",
+			render:    func(f *Formatter) (string, error) { return f.FormatDetailToStringWithOptions(detail, true, false, false) },
+			contains:  []string{" Law Details", "Law ID:       011357", "Department:   개인정보보호위원회", "Effective:    2024-03-15", " Contents", "Articles:     1", " Articles (1)"},
+			forbidden: []string{"법령 상세", "소관부처", "조문"},
+		},
+		{
+			lang: "en", format: "table",
+			render:    func(f *Formatter) (string, error) { return f.FormatHistoryToString(history) },
+			contains:  []string{" Law Amendment History", "Law Name: 개인정보 보호법", "1 history records", "Reason: 정보주체 권리 보장"},
+			forbidden: []string{"이력", "개정이유"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.lang+"/"+tt.format, func(t *testing.T) {
+			f := NewFormatter(tt.format).WithPagination("warp law", 1).WithLanguage(tt.lang)
+			result, err := tt.render(f)
+			if err != nil {
+				t.Fatalf("render error = %v", err)
+			}
+			for _, s := range tt.contains {
+				if !strings.Contains(result, s) {
+					t.Errorf("result should contain %q, got:\n%s", s, result)
+				}
+			}
+			for _, s := range tt.forbidden {
+				if strings.Contains(result, s) {
+					t.Errorf("result should not contain %q, got:\n%s", s, result)
+				}
+			}
+		})
+	}
+}
+
+func TestFormatMarkdownLawLinks(t *testing.T) {
+	t.Run("with source", func(t *testing.T) {
+		resp := &api.SearchResponse{TotalCount: 3, Laws: []api.LawInfo{
+			{ID: "011357", Name: "개인정보 보호법", Source: "국가법령"},
+			{SerialNo: "1500000", Name: "서울특별시 [정보공개] | 조례", Source: "자치법규"},
+			{Name: "번호 없는 법령", Source: "국가법령"},
+		}}
+		result, err := NewFormatter("markdown").FormatSearchResultToString(resp)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, want := range []string{
+			"| [개인정보 보호법](https://www.law.go.kr/LSW/lsInfoP.do?lsId=011357) |",
+			`| [서울특별시 \[정보공개\] \| 조례](https://www.law.go.kr/LSW/ordinInfoP.do?ordinSeq=1500000) |`,
+			"| 번호 없는 법령 |",
+		} {
+			if !strings.Contains(result, want) {
+				t.Errorf("result should contain %q, got:\n%s", want, result)
+			}
+		}
+	})
+
+	t.Run("without source", func(t *testing.T) {
+		resp := &api.SearchResponse{TotalCount: 1, Laws: []api.LawInfo{{ID: "011357", Name: "개인정보 보호법"}}}
+		result, err := NewFormatter("markdown").FormatSearchResultToString(resp)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(result, "| 011357 | 개인정보 보호법 |") || strings.Contains(result, "](") {
+			t.Errorf("results without a source should show plain names, got:\n%s", result)
+		}
+	})
+}
+
+func TestFormatterHanja(t *testing.T) {
+	withHanja := &api.LawDetail{LawInfo: api.LawInfo{ID: "011357", Name: "개인정보 보호법", NameHanja: "個人情報 保護法"}}
+	withoutHanja := &api.LawDetail{LawInfo: api.LawInfo{ID: "011358", Name: "도로교통법"}}
+
+	t.Run("detail", func(t *testing.T) {
+		for _, format := range []string{"table", "markdown"} {
+			result, err := NewFormatter(format).WithHanja(true).FormatDetailToString(withHanja)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(result, "개인정보 보호법 (個人情報 保護法)") {
+				t.Errorf("%s should show the Hanja name beside the name:\n%s", format, result)
+			}
+
+			result, _ = NewFormatter(format).WithHanja(true).FormatDetailToString(withoutHanja)
+			if !strings.Contains(result, "도로교통법") || strings.Contains(result, "도로교통법 (") {
+				t.Errorf("%s without a Hanja name should show the name alone:\n%s", format, result)
+			}
+
+			result, _ = NewFormatter(format).FormatDetailToString(withHanja)
+			if strings.Contains(result, "個人情報") {
+				t.Errorf("%s without --hanja should not show the Hanja name:\n%s", format, result)
+			}
+		}
+	})
+
+	t.Run("search table", func(t *testing.T) {
+		resp := &api.SearchResponse{TotalCount: 2, Laws: []api.LawInfo{withHanja.LawInfo, withoutHanja.LawInfo}}
+		result, err := NewFormatter("table").WithHanja(true).FormatSearchResultToString(resp)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(result, "한자명") || !strings.Contains(result, "個人情報 保護法") {
+			t.Errorf("table should have a column of Hanja names:\n%s", result)
+		}
+
+		result, _ = NewFormatter("table").FormatSearchResultToString(resp)
+		if strings.Contains(result, "한자명") {
+			t.Errorf("table without --hanja should not have the Hanja column:\n%s", result)
+		}
+	})
+
+	t.Run("json always has the field", func(t *testing.T) {
+		for _, detail := range []*api.LawDetail{withHanja, withoutHanja} {
+			result, err := NewFormatter("json").FormatDetailToString(detail)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var decoded map[string]interface{}
+			if err := json.Unmarshal([]byte(result), &decoded); err != nil {
+				t.Fatal(err)
+			}
+			if value, ok := decoded["법령명한자"]; !ok || value != detail.NameHanja {
+				t.Errorf("json 법령명한자 = %v (present %v), want %q", value, ok, detail.NameHanja)
+			}
+		}
+
+		canonical, _ := NewFormatter("json").WithJSONSchema(SchemaCanonical).FormatSearchResultToString(&api.SearchResponse{Laws: []api.LawInfo{withoutHanja.LawInfo}})
+		if !strings.Contains(canonical, `"law_name_hanja": ""`) {
+			t.Errorf("canonical json should keep an empty law_name_hanja:\n%s", canonical)
+		}
+	})
+}
+
+func TestFormatTableToStringSourceColumns(t *testing.T) {
+	tests := []struct {
+		name     string
+		law      api.LawInfo
+		contains []string
+		excludes []string
+	}{
+		{
+			name: "precedent",
+			law: api.LawInfo{
+				ID: "P1", Name: "개인정보 유출 손해배상", LawType: "민사",
+				ExtraFields: map[string]string{
+					api.ExtraCourtName: "대법원", api.ExtraCaseNumber: "2023다12345", api.ExtraJudgeDate: "20240118",
+				},
+			},
+			contains: []string{"사건명", "사건종류", "법원명", "사건번호", "선고일자", "대법원", "2023다12345", "2024-01-18", "민사"},
+			excludes: []string{"소관부처", "시행일자"},
+		},
+		{
+			name: "interpretation",
+			law: api.LawInfo{
+				ID: "X1", Name: "개인정보 제3자 제공 관련", LawType: "법령해석례",
+				ExtraFields: map[string]string{
+					api.ExtraQueryAgency: "민원인", api.ExtraReplyAgency: "법제처", api.ExtraReplyDate: "20210615",
+				},
+			},
+			contains: []string{"안건명", "질의기관", "회신기관", "회신일자", "민원인", "법제처", "2021-06-15"},
+			excludes: []string{"소관부처", "시행일자"},
+		},
+		{
+			name:     "law",
+			law:      api.LawInfo{ID: "001", Name: "개인정보 보호법", LawType: "법률", Department: "개인정보보호위원회", EffectDate: "20240315"},
+			contains: []string{"법령명", "소관부처", "시행일자", "개인정보보호위원회"},
+			excludes: []string{"법원명", "회신기관"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &api.SearchResponse{TotalCount: 1, Page: 1, Laws: []api.LawInfo{tt.law}}
+			result, err := NewFormatter("table").formatTableToString(resp)
+			if err != nil {
+				t.Fatalf("formatTableToString() error = %v", err)
+			}
+			for _, substr := range tt.contains {
+				if !strings.Contains(result, substr) {
+					t.Errorf("Result should contain %q, got:\n%s", substr, result)
+				}
+			}
+			for _, substr := range tt.excludes {
+				if strings.Contains(result, substr) {
+					t.Errorf("Result should not contain %q, got:\n%s", substr, result)
+				}
+			}
+		})
+	}
+
+	t.Run("unified search keeps the source column", func(t *testing.T) {
+		law := tests[0].law
+		law.Source = "판례"
+		result, err := NewFormatter("table").formatTableToString(&api.SearchResponse{TotalCount: 1, Page: 1, Laws: []api.LawInfo{law}})
+		if err != nil {
+			t.Fatalf("formatTableToString() error = %v", err)
+		}
+		if !strings.Contains(result, "출처") || strings.Contains(result, "선고일자") {
+			t.Errorf("unified results should keep the common columns, got:\n%s", result)
+		}
+	})
+}
+
+func TestFormatDetailArticleParagraphs(t *testing.T) {
+	detail := &api.LawDetail{
+		LawInfo: api.LawInfo{ID: "001234", Name: "개인정보 보호법"},
+		Articles: []api.Article{
+			{Number: "1", Title: "목적", Content: "제1조(목적) 이 법은 개인정보의 처리에 관한 사항을 정한다."},
+			{Number: "2", Title: "정의", Content: "제2조(정의) 이 법에서 사용하는 용어의 뜻은 다음과 같다.", Paragraphs: []api.Paragraph{
+				{Number: "①", Content: "① 용어의 뜻은 다음과 같다.", Items: []api.Paragraph{
+					{Number: "1.", Content: "1. \"개인정보\"란 다음 각 목의 정보를 말한다.", Items: []api.Paragraph{
+						{Number: "가.", Content: "성명 등으로 알아볼 수 있는 정보"},
+					}},
+				}},
+			}},
+		},
+	}
+
+	result := NewFormatter("table").formatDetailTableWithOptions(detail, true, false, false)
+	for _, line := range []string{
+		"  제1조(목적) 이 법은 개인정보의 처리에 관한 사항을 정한다.\n",
+		"  ① 용어의 뜻은 다음과 같다.\n",
+		"    1. \"개인정보\"란 다음 각 목의 정보를 말한다.\n",
+		// A number missing from the text is put in front of it
+		"      가. 성명 등으로 알아볼 수 있는 정보\n",
+	} {
+		if !strings.Contains(result, line) {
+			t.Errorf("table should contain %q, got:\n%s", line, result)
+		}
+	}
+
+	plain, err := NewFormatter("text").FormatDetailPlainText(detail, false, false)
+	if err != nil {
+		t.Fatalf("FormatDetailPlainText() error = %v", err)
+	}
+	// Plain text keeps the units, one per line, without the indentation
+	if !strings.Contains(plain, "제2조(정의)\n이 법에서 사용하는 용어의 뜻은 다음과 같다.\n① 용어의 뜻은 다음과 같다.\n1. ") {
+		t.Errorf("plain text should list the 항, 호 and 목, got:\n%s", plain)
+	}
+}
@@ -0,0 +1,58 @@
+package output
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/fatih/color"
+)
+
+// getenv reads the environment for SupportsHyperlinks; replaced in tests
+var getenv = os.Getenv
+
+// SupportsHyperlinks reports whether stdout is a terminal known to render
+// OSC 8 hyperlinks. FORCE_HYPERLINK=1 or 0 overrides the detection; NO_COLOR
+// and --no-color turn hyperlinks off like colors.
+func SupportsHyperlinks() bool {
+	if force := getenv("FORCE_HYPERLINK"); force != "" {
+		return force != "0"
+	}
+	if color.NoColor || !isTerminal() {
+		return false
+	}
+	return terminalHasHyperlinks()
+}
+
+// terminalHasHyperlinks reports whether the environment names a terminal
+// that renders OSC 8 hyperlinks. Other terminals may print the escape codes
+// as text, so unknown terminals get plain URLs.
+func terminalHasHyperlinks() bool {
+	if getenv("TERM") == "dumb" {
+		return false
+	}
+	switch getenv("TERM_PROGRAM") {
+	case "iTerm.app", "WezTerm", "vscode", "ghostty", "Hyper":
+		return true
+	}
+	if vte, err := strconv.Atoi(getenv("VTE_VERSION")); err == nil && vte >= 5000 {
+		return true
+	}
+	for _, name := range []string{"WT_SESSION", "KONSOLE_VERSION", "DOMTERM", "KITTY_WINDOW_ID"} {
+		if getenv(name) != "" {
+			return true
+		}
+	}
+	term := getenv("TERM")
+	return strings.Contains(term, "kitty") || strings.Contains(term, "alacritty") || strings.Contains(term, "foot")
+}
+
+// Hyperlink returns text linking to url with the OSC 8 escape codes, which
+// supporting terminals show as clickable text
+func Hyperlink(text, url string) string {
+	if url == "" {
+		return text
+	}
+	return fmt.Sprintf("\x1b]8;;%s\x1b\\%s\x1b]8;;\x1b\\", url, text)
+}
@@ -0,0 +1,99 @@
+package output
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+func TestSupportsHyperlinks(t *testing.T) {
+	orig := getenv
+	defer func() { getenv = orig }()
+
+	tests := []struct {
+		name string
+		env  map[string]string
+		want bool
+	}{
+		{"forced on", map[string]string{"FORCE_HYPERLINK": "1"}, true},
+		{"forced off", map[string]string{"FORCE_HYPERLINK": "0", "TERM_PROGRAM": "iTerm.app"}, false},
+		// Test output is not a terminal, so detection alone never enables them
+		{"not a terminal", map[string]string{"TERM_PROGRAM": "iTerm.app"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			getenv = func(key string) string { return tt.env[key] }
+			if got := SupportsHyperlinks(); got != tt.want {
+				t.Errorf("SupportsHyperlinks() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	for _, tt := range []struct {
+		env  map[string]string
+		want bool
+	}{
+		{map[string]string{"TERM_PROGRAM": "WezTerm"}, true},
+		{map[string]string{"VTE_VERSION": "6003"}, true},
+		{map[string]string{"VTE_VERSION": "4205"}, false},
+		{map[string]string{"WT_SESSION": "abc"}, true},
+		{map[string]string{"TERM": "xterm-kitty"}, true},
+		{map[string]string{"TERM": "dumb", "WT_SESSION": "abc"}, false},
+		{map[string]string{"TERM": "xterm-256color"}, false},
+	} {
+		getenv = func(key string) string { return tt.env[key] }
+		if got := terminalHasHyperlinks(); got != tt.want {
+			t.Errorf("terminalHasHyperlinks() with %v = %v, want %v", tt.env, got, tt.want)
+		}
+	}
+}
+
+func TestDetailLinks(t *testing.T) {
+	detail := &api.LawDetail{
+		LawInfo: api.LawInfo{ID: "001234", Name: "개인정보 보호법"},
+		RelatedLaws: []api.RelatedLaw{
+			{Name: "개인정보 보호법 시행령", URL: "https://www.law.go.kr/법령/개인정보보호법시행령"},
+		},
+		Attachments: []api.Attachment{
+			{Name: "서식[1].hwp", URL: "https://www.law.go.kr/LSW/flDownload.do?flSeq=1", Size: 12288},
+			{Name: "링크 없음.txt"},
+		},
+	}
+
+	plain, err := NewFormatter("table").WithHyperlinks(false).FormatDetailToString(detail)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"  • 개인정보 보호법 시행령\n    https://www.law.go.kr/법령/개인정보보호법시행령\n",
+		"  • 서식[1].hwp (12.0 KB)\n    https://www.law.go.kr/LSW/flDownload.do?flSeq=1\n",
+		"  • 링크 없음.txt\n",
+	} {
+		if !strings.Contains(plain, want) {
+			t.Errorf("table output should contain %q, got:\n%s", want, plain)
+		}
+	}
+	if strings.Contains(plain, "\x1b]8;") {
+		t.Error("table output without hyperlinks should not contain OSC 8 escape codes")
+	}
+
+	linked, _ := NewFormatter("table").WithHyperlinks(true).FormatDetailToString(detail)
+	want := "  • \x1b]8;;https://www.law.go.kr/법령/개인정보보호법시행령\x1b\\개인정보 보호법 시행령\x1b]8;;\x1b\\\n    https://www.law.go.kr/법령/개인정보보호법시행령\n"
+	if !strings.Contains(linked, want) {
+		t.Errorf("table output with hyperlinks should contain %q, got:\n%q", want, linked)
+	}
+	if !strings.Contains(linked, "  • 링크 없음.txt\n") {
+		t.Error("an item without a URL should stay plain text")
+	}
+
+	markdown, _ := NewFormatter("markdown").FormatDetailToStringWithOptions(detail, false, false, false)
+	for _, want := range []string{
+		"## 관련 법령\n\n- [개인정보 보호법 시행령](https://www.law.go.kr/법령/개인정보보호법시행령)\n",
+		"- [서식\\[1\\].hwp](https://www.law.go.kr/LSW/flDownload.do?flSeq=1) (12.0 KB)\n- 링크 없음.txt\n",
+	} {
+		if !strings.Contains(markdown, want) {
+			t.Errorf("markdown output should contain %q, got:\n%s", want, markdown)
+		}
+	}
+}
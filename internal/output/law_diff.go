@@ -0,0 +1,426 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+// Status of an article in a law diff
+const (
+	DiffAdded     = "추가"
+	DiffRemoved   = "삭제"
+	DiffChanged   = "변경"
+	DiffMoved     = "이동" // renumbered without changes
+	DiffUnchanged = "동일"
+)
+
+// Operation of a line in the diff of an article
+const (
+	LineEqual  = "equal"
+	LineInsert = "insert"
+	LineDelete = "delete"
+)
+
+var (
+	diffInsertColor = color.New(color.FgGreen)
+	diffDeleteColor = color.New(color.FgRed)
+	diffHeadColor   = color.New(color.FgCyan, color.Bold)
+)
+
+// DiffLine is a line of the content of an article in a diff
+type DiffLine struct {
+	Op   string `json:"op"`
+	Text string `json:"text"`
+}
+
+// ArticleDiff is the change of one article between two versions of a law
+type ArticleDiff struct {
+	Status string `json:"status"`
+	// Number is the number in the new version, or in the old one for a
+	// removed article; From is the old number of a moved article
+	Number   string     `json:"number"`
+	From     string     `json:"from,omitempty"`
+	Title    string     `json:"title,omitempty"`
+	OldTitle string     `json:"old_title,omitempty"` // only if the title changed
+	Lines    []DiffLine `json:"lines,omitempty"`
+}
+
+// Label returns the article as written in laws with its title, and its old
+// number if it was moved: "제7조(정의) ← 제5조"
+func (d ArticleDiff) Label() string {
+	label := MapArticle{Number: d.Number, Title: d.Title}.Label()
+	if d.From != "" {
+		label += " ← " + TOCEntry{Number: d.From}.Label()
+	}
+	return label
+}
+
+// LawDiff is the difference of the articles of two laws, or of two versions
+// of a law. Articles holds only the articles that differ; Summary counts
+// every status, unchanged articles included.
+type LawDiff struct {
+	Left     ArticleMapLaw  `json:"left"`
+	Right    ArticleMapLaw  `json:"right"`
+	Summary  map[string]int `json:"summary"`
+	Articles []ArticleDiff  `json:"articles"`
+}
+
+// NewLawDiff compares the articles of the old law left with those of the new
+// law right, see DiffArticles
+func NewLawDiff(left, right *api.LawDetail) *LawDiff {
+	summary := map[string]int{DiffAdded: 0, DiffRemoved: 0, DiffChanged: 0, DiffMoved: 0, DiffUnchanged: 0}
+	articles := []ArticleDiff{}
+	for _, d := range DiffArticles(left.Articles, right.Articles) {
+		summary[d.Status]++
+		if d.Status != DiffUnchanged {
+			articles = append(articles, d)
+		}
+	}
+	return &LawDiff{Left: mapLaw(left), Right: mapLaw(right), Summary: summary, Articles: articles}
+}
+
+// DiffArticles compares the articles of an old and a new version by their
+// numbers. An article the new version moved, as recorded by its 조문이동이전
+// or the 조문이동이후 of the old article, is compared with the article it
+// was moved from. Changed articles carry the line diff of their contents.
+//
+// The result follows the new version, with removed articles after the
+// article preceding them in the old version. Chapter headings and repeated
+// article numbers are skipped.
+func DiffArticles(left, right []api.Article) []ArticleDiff {
+	lefts, rights := diffEntries(left), diffEntries(right)
+	leftIndex := make(map[string]int, len(lefts))
+	for i, l := range lefts {
+		leftIndex[l.number] = i
+	}
+	rightIndex := make(map[string]int, len(rights))
+	for j, r := range rights {
+		rightIndex[r.number] = j
+	}
+
+	// pairs[j] is the old entry of new entry j, -1 if it was added
+	pairs := make([]int, len(rights))
+	leftUsed := make([]bool, len(lefts))
+	for j := range pairs {
+		pairs[j] = -1
+	}
+	pair := func(i, j int) {
+		if i >= 0 && j >= 0 && pairs[j] < 0 && !leftUsed[i] {
+			pairs[j] = i
+			leftUsed[i] = true
+		}
+	}
+	// Moves first, so that a renumbered article is not compared with the
+	// unrelated article that took its old number
+	for j, r := range rights {
+		if r.moveBefore != "" {
+			if i, ok := leftIndex[r.moveBefore]; ok {
+				pair(i, j)
+			}
+		}
+	}
+	for i, l := range lefts {
+		if l.moveAfter != "" {
+			if j, ok := rightIndex[l.moveAfter]; ok {
+				pair(i, j)
+			}
+		}
+	}
+	for j, r := range rights {
+		if i, ok := leftIndex[r.number]; ok {
+			pair(i, j)
+		}
+	}
+
+	var diffs []ArticleDiff
+	nextLeft := 0
+	flushRemoved := func(upTo int) {
+		for ; nextLeft < upTo; nextLeft++ {
+			if !leftUsed[nextLeft] {
+				l := lefts[nextLeft]
+				diffs = append(diffs, ArticleDiff{Status: DiffRemoved, Number: l.number, Title: l.title, Lines: lineOps(l.lines, LineDelete)})
+			}
+		}
+	}
+	for j, r := range rights {
+		i := pairs[j]
+		if i < 0 {
+			diffs = append(diffs, ArticleDiff{Status: DiffAdded, Number: r.number, Title: r.title, Lines: lineOps(r.lines, LineInsert)})
+			continue
+		}
+		if i >= nextLeft {
+			flushRemoved(i)
+			nextLeft = i + 1
+		}
+		diffs = append(diffs, compareArticles(lefts[i], r))
+	}
+	flushRemoved(len(lefts))
+	return diffs
+}
+
+// diffEntry is an article being compared
+type diffEntry struct {
+	number     string
+	title      string
+	lines      []string
+	moveBefore string
+	moveAfter  string
+}
+
+// diffEntries returns the articles to compare, skipping chapter headings and
+// repeated numbers
+func diffEntries(articles []api.Article) []diffEntry {
+	var entries []diffEntry
+	seen := make(map[string]bool)
+	for _, article := range articles {
+		number := articleKey(article)
+		if number == "" || seen[number] {
+			continue
+		}
+		seen[number] = true
+		entries = append(entries, diffEntry{
+			number:     number,
+			title:      strings.TrimSpace(article.Title),
+			lines:      trimLines(articleBody(article)),
+			moveBefore: moveNumber(article.MoveBefore),
+			moveAfter:  moveNumber(article.MoveAfter),
+		})
+	}
+	return entries
+}
+
+// moveNumber normalizes the number of a 조문이동 field, "" if it has none
+func moveNumber(value string) string {
+	number, err := NormalizeArticleNumber(value)
+	if err != nil {
+		return ""
+	}
+	return number
+}
+
+// trimLines trims the lines of an article so that reindented lines compare equal
+func trimLines(lines []string) []string {
+	trimmed := make([]string, len(lines))
+	for i, line := range lines {
+		trimmed[i] = strings.TrimSpace(line)
+	}
+	return trimmed
+}
+
+// compareArticles returns the diff of an old and a new article paired together
+func compareArticles(before, after diffEntry) ArticleDiff {
+	d := ArticleDiff{Number: after.number, Title: after.title}
+	if before.number != after.number {
+		d.From = before.number
+	}
+	titleChanged := before.title != after.title
+	if titleChanged {
+		d.OldTitle = before.title
+	}
+
+	d.Lines = DiffLines(before.lines, after.lines)
+	changed := titleChanged
+	for _, line := range d.Lines {
+		if line.Op != LineEqual {
+			changed = true
+			break
+		}
+	}
+	switch {
+	case changed:
+		d.Status = DiffChanged
+	case d.From != "":
+		d.Status = DiffMoved
+	default:
+		d.Status = DiffUnchanged
+	}
+	if d.Status != DiffChanged {
+		d.Lines = nil
+	}
+	return d
+}
+
+// lineOps returns lines as diff lines of a single operation
+func lineOps(lines []string, op string) []DiffLine {
+	ops := make([]DiffLine, len(lines))
+	for i, line := range lines {
+		ops[i] = DiffLine{Op: op, Text: line}
+	}
+	return ops
+}
+
+// DiffLines returns the line diff turning a into b, from their longest common
+// subsequence. Deleted lines come before the lines inserted in their place.
+func DiffLines(a, b []string) []DiffLine {
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var lines []DiffLine
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			lines = append(lines, DiffLine{Op: LineEqual, Text: a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			lines = append(lines, DiffLine{Op: LineDelete, Text: a[i]})
+			i++
+		default:
+			lines = append(lines, DiffLine{Op: LineInsert, Text: b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		lines = append(lines, DiffLine{Op: LineDelete, Text: a[i]})
+	}
+	for ; j < len(b); j++ {
+		lines = append(lines, DiffLine{Op: LineInsert, Text: b[j]})
+	}
+	return lines
+}
+
+// FormatLawDiff formats a law diff as colored text, markdown or json
+func (f *Formatter) FormatLawDiff(d *LawDiff) (string, error) {
+	switch f.format {
+	case "table", "":
+		return f.formatLawDiffText(d, GetDefaultTableStyle().UseColor), nil
+	case "markdown", "md":
+		return f.formatLawDiffMarkdown(d), nil
+	case "json":
+		data, err := json.MarshalIndent(d, "", "  ")
+		if err != nil {
+			return "", fmt.Errorf("JSON 변환 실패: %w", err)
+		}
+		return string(data) + "\n", nil
+	default:
+		return "", fmt.Errorf("지원하지 않는 출력 형식: %s (table, markdown, json 중 선택)", f.format)
+	}
+}
+
+// lawDiffSummary returns the counts of a law diff on one line
+func (f *Formatter) lawDiffSummary(d *LawDiff) string {
+	counts := make([]string, 0, 5)
+	for _, status := range []string{DiffAdded, DiffRemoved, DiffChanged, DiffMoved, DiffUnchanged} {
+		counts = append(counts, fmt.Sprintf("%s %d", f.diffStatusLabel(status), d.Summary[status]))
+	}
+	return strings.Join(counts, ", ")
+}
+
+// diffStatusLabel returns the status of an article as shown in the text and
+// markdown output; the json output keeps the status itself
+func (f *Formatter) diffStatusLabel(status string) string {
+	switch status {
+	case DiffAdded:
+		return f.t("output.diff.added")
+	case DiffRemoved:
+		return f.t("output.diff.removed")
+	case DiffChanged:
+		return f.t("output.diff.changed")
+	case DiffMoved:
+		return f.t("output.diff.moved")
+	case DiffUnchanged:
+		return f.t("output.diff.unchanged")
+	default:
+		return status
+	}
+}
+
+// diffLinePrefix returns the unified diff marker of a line operation
+func diffLinePrefix(op string) string {
+	switch op {
+	case LineInsert:
+		return "+ "
+	case LineDelete:
+		return "- "
+	default:
+		return "  "
+	}
+}
+
+// formatLawDiffText writes each differing article with its lines marked as
+// in a unified diff, inserted lines in green and deleted ones in red
+func (f *Formatter) formatLawDiffText(d *LawDiff, useColor bool) string {
+	paint := func(c *color.Color, s string) string {
+		if !useColor {
+			return s
+		}
+		return c.Sprint(s)
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "--- %s\n+++ %s\n", mapLawLabel(d.Left), mapLawLabel(d.Right))
+	for _, a := range d.Articles {
+		fmt.Fprintf(&buf, "\n%s\n", paint(diffHeadColor, fmt.Sprintf("[%s] %s", f.diffStatusLabel(a.Status), a.Label())))
+		if a.OldTitle != "" {
+			fmt.Fprintf(&buf, "  %s\n", f.t("output.diff.titleChange", a.OldTitle, a.Title))
+		}
+		for _, line := range a.Lines {
+			text := diffLinePrefix(line.Op) + line.Text
+			switch line.Op {
+			case LineInsert:
+				text = paint(diffInsertColor, text)
+			case LineDelete:
+				text = paint(diffDeleteColor, text)
+			}
+			fmt.Fprintln(&buf, text)
+		}
+	}
+	if len(d.Articles) == 0 {
+		fmt.Fprintf(&buf, "\n%s\n", f.t("output.diff.none"))
+	}
+	fmt.Fprintf(&buf, "\n%s\n", f.lawDiffSummary(d))
+	return buf.String()
+}
+
+// formatLawDiffMarkdown writes each differing article as a heading with a
+// diff code block, which markdown viewers color
+func (f *Formatter) formatLawDiffMarkdown(d *LawDiff) string {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "# %s\n\n- %s: %s\n- %s: %s\n- %s\n", f.t("output.diff.title"),
+		f.t("output.diff.before"), mapLawLabel(d.Left), f.t("output.diff.after"), mapLawLabel(d.Right), f.lawDiffSummary(d))
+	for _, a := range d.Articles {
+		fmt.Fprintf(&buf, "\n## %s — %s\n", a.Label(), f.diffStatusLabel(a.Status))
+		if a.OldTitle != "" {
+			fmt.Fprintf(&buf, "\n%s\n", f.t("output.diff.titleChange", a.OldTitle, a.Title))
+		}
+		if len(a.Lines) == 0 {
+			continue
+		}
+		fmt.Fprintln(&buf, "\n```diff")
+		for _, line := range a.Lines {
+			marker := " "
+			switch line.Op {
+			case LineInsert:
+				marker = "+"
+			case LineDelete:
+				marker = "-"
+			}
+			fmt.Fprintf(&buf, "%s %s\n", marker, line.Text)
+		}
+		fmt.Fprintln(&buf, "```")
+	}
+	if len(d.Articles) == 0 {
+		fmt.Fprintf(&buf, "\n%s\n", f.t("output.diff.none"))
+	}
+	return buf.String()
+}
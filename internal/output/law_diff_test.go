@@ -0,0 +1,246 @@
+package output
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/fatih/color"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+// diffString describes line diffs as "+a -b =c" for comparison
+func diffString(lines []DiffLine) string {
+	marks := map[string]string{LineEqual: "=", LineInsert: "+", LineDelete: "-"}
+	var parts []string
+	for _, line := range lines {
+		parts = append(parts, marks[line.Op]+line.Text)
+	}
+	return strings.Join(parts, " ")
+}
+
+func TestDiffLines(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b []string
+		want string
+	}{
+		{"equal", []string{"a", "b"}, []string{"a", "b"}, "=a =b"},
+		{"both empty", nil, nil, ""},
+		{"insert", []string{"a", "c"}, []string{"a", "b", "c"}, "=a +b =c"},
+		{"delete", []string{"a", "b", "c"}, []string{"a", "c"}, "=a -b =c"},
+		{"replace", []string{"a", "b", "c"}, []string{"a", "x", "c"}, "=a -b +x =c"},
+		{"all new", []string{"a"}, []string{"b", "c"}, "-a +b +c"},
+		{"append", []string{"a"}, []string{"a", "b"}, "=a +b"},
+		{"reordered", []string{"a", "b", "c"}, []string{"c", "a", "b"}, "+c =a =b -c"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := diffString(DiffLines(tt.a, tt.b)); got != tt.want {
+				t.Errorf("DiffLines() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// diffArticle builds an article with one line per paragraph
+func diffArticle(number, title string, paragraphs ...string) api.Article {
+	return api.Article{Number: number, Title: title, Content: "제" + number + "조(" + title + ") " + strings.Join(paragraphs, "\n")}
+}
+
+// diffsString describes article diffs as "status number<from" for comparison
+func diffsString(diffs []ArticleDiff) string {
+	var parts []string
+	for _, d := range diffs {
+		part := d.Status + " " + d.Number
+		if d.From != "" {
+			part += "<" + d.From
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, ", ")
+}
+
+func TestDiffArticles(t *testing.T) {
+	tests := []struct {
+		name        string
+		left, right []api.Article
+		want        string
+	}{
+		{
+			name:  "unchanged",
+			left:  []api.Article{diffArticle("1", "목적", "목적 조문")},
+			right: []api.Article{diffArticle("1", "목적", "목적 조문")},
+			want:  "동일 1",
+		},
+		{
+			name:  "added, removed and changed",
+			left:  []api.Article{diffArticle("1", "목적", "목적"), diffArticle("2", "정의", "정의"), diffArticle("3", "벌칙", "벌금")},
+			right: []api.Article{diffArticle("1", "목적", "목적"), diffArticle("3", "벌칙", "징역"), diffArticle("4", "과태료", "과태료")},
+			want:  "동일 1, 삭제 2, 변경 3, 추가 4",
+		},
+		{
+			name:  "title change is a change",
+			left:  []api.Article{diffArticle("1", "목적", "같은 내용")},
+			right: []api.Article{diffArticle("1", "목적 및 정의", "같은 내용")},
+			want:  "변경 1",
+		},
+		{
+			name:  "reindented lines are equal",
+			left:  []api.Article{{Number: "1", Title: "목적", Content: "제1조(목적)\n  ① 첫째\n  ② 둘째"}},
+			right: []api.Article{{Number: "1", Title: "목적", Content: "제1조(목적)\n① 첫째\n    ② 둘째"}},
+			want:  "동일 1",
+		},
+		{
+			name: "moved by 조문이동이전",
+			left: []api.Article{diffArticle("1", "목적", "목적"), diffArticle("2", "위원회", "위원회 구성")},
+			right: []api.Article{
+				diffArticle("1", "목적", "목적"),
+				diffArticle("2", "정의", "새로운 정의"),
+				withMove(diffArticle("3", "위원회", "위원회 구성"), "제2조", ""),
+			},
+			want: "동일 1, 추가 2, 이동 3<2",
+		},
+		{
+			name: "moved by 조문이동이후 and changed",
+			left: []api.Article{withMove(diffArticle("5", "벌칙", "벌금"), "", "7"), diffArticle("7", "시행", "시행일")},
+			right: []api.Article{
+				diffArticle("5", "신고", "신고 의무"),
+				diffArticle("7", "벌칙", "징역 또는 벌금"),
+			},
+			want: "추가 5, 변경 7<5, 삭제 7",
+		},
+		{
+			name:  "headings and duplicates",
+			left:  []api.Article{{Number: "1", Content: "제1장 총칙"}, diffArticle("1", "목적", "a"), diffArticle("1", "목적", "b")},
+			right: []api.Article{{Number: "1", Content: "제1장 총칙"}, diffArticle("1", "목적", "a")},
+			want:  "동일 1",
+		},
+		{
+			name: "removed at the end",
+			left: []api.Article{diffArticle("1", "목적", "a"), diffArticle("2", "정의", "b")},
+			want: "삭제 1, 삭제 2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := diffsString(DiffArticles(tt.left, tt.right)); got != tt.want {
+				t.Errorf("DiffArticles() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func withMove(article api.Article, before, after string) api.Article {
+	article.MoveBefore, article.MoveAfter = before, after
+	return article
+}
+
+func TestDiffArticlesLines(t *testing.T) {
+	left := []api.Article{diffArticle("3", "벌칙", "① 3년 이하의 징역", "② 양벌 규정")}
+	right := []api.Article{diffArticle("3", "벌칙", "① 5년 이하의 징역", "② 양벌 규정")}
+	diffs := DiffArticles(left, right)
+	if got := diffString(diffs[0].Lines); got != "-① 3년 이하의 징역 +① 5년 이하의 징역 =② 양벌 규정" {
+		t.Errorf("lines = %q", got)
+	}
+	if diffs[0].OldTitle != "" {
+		t.Errorf("OldTitle should be empty when the title is the same: %q", diffs[0].OldTitle)
+	}
+
+	added := DiffArticles(nil, right)
+	if got := diffString(added[0].Lines); got != "+① 5년 이하의 징역 +② 양벌 규정" {
+		t.Errorf("added lines = %q", got)
+	}
+}
+
+// lawDiffFixture returns the diff of two versions of a law for the rendering tests
+func lawDiffFixture() *LawDiff {
+	left := &api.LawDetail{
+		LawInfo: api.LawInfo{ID: "228817", Name: "개인정보 보호법"},
+		Articles: []api.Article{
+			diffArticle("1", "목적", "이 법은 개인정보의 처리에 관한 사항을 정한다."),
+			diffArticle("2", "위원회", "위원회는 9명의 위원으로 구성한다."),
+			diffArticle("3", "벌칙", "① 3년 이하의 징역에 처한다.", "② 미수범은 처벌한다."),
+			diffArticle("4", "과태료", "과태료를 부과한다."),
+		},
+	}
+	right := &api.LawDetail{
+		LawInfo: api.LawInfo{ID: "253527", Name: "개인정보 보호법"},
+		Articles: []api.Article{
+			diffArticle("1", "목적", "이 법은 개인정보의 처리에 관한 사항을 정한다."),
+			diffArticle("2", "정의", "이 법에서 사용하는 용어의 뜻은 다음과 같다."),
+			withMove(diffArticle("3", "위원회", "위원회는 9명의 위원으로 구성한다."), "2", ""),
+			withMove(diffArticle("4", "형벌", "① 5년 이하의 징역에 처한다.", "② 미수범은 처벌한다."), "3", ""),
+		},
+	}
+	return NewLawDiff(left, right)
+}
+
+func TestFormatLawDiff(t *testing.T) {
+	d := lawDiffFixture()
+	want := map[string]int{DiffAdded: 1, DiffRemoved: 1, DiffChanged: 1, DiffMoved: 1, DiffUnchanged: 1}
+	for status, n := range want {
+		if d.Summary[status] != n {
+			t.Errorf("Summary[%s] = %d, want %d", status, d.Summary[status], n)
+		}
+	}
+	if len(d.Articles) != 4 {
+		t.Errorf("unchanged articles should be left out: %+v", d.Articles)
+	}
+
+	text, err := NewFormatter("table").FormatLawDiff(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertGolden(t, "law_diff_table", text)
+
+	markdown, err := NewFormatter("markdown").FormatLawDiff(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertGolden(t, "law_diff_markdown", markdown)
+
+	english, err := NewFormatter("markdown").WithLanguage("en").FormatLawDiff(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"# Article Comparison\n", "- Before: ", "- After: ", "Added 1, Removed 1, Changed 1, Moved 1, Unchanged 1", " — Changed\n", "Title: 벌칙 → "} {
+		if !strings.Contains(english, want) {
+			t.Errorf("english output should contain %q:\n%s", want, english)
+		}
+	}
+
+	out, err := NewFormatter("json").FormatLawDiff(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded LawDiff
+	if err := json.Unmarshal([]byte(out), &decoded); err != nil {
+		t.Fatalf("json output does not parse: %v\n%s", err, out)
+	}
+	changed := decoded.Articles[2]
+	if changed.Status != DiffChanged || changed.From != "3" || changed.OldTitle != "벌칙" || changed.Lines[0].Op != LineDelete {
+		t.Errorf("json changed article = %+v", changed)
+	}
+
+	if _, err := NewFormatter("csv").FormatLawDiff(d); err == nil {
+		t.Error("FormatLawDiff should reject csv")
+	}
+}
+
+func TestFormatLawDiffColor(t *testing.T) {
+	orig := color.NoColor
+	color.NoColor = false
+	defer func() { color.NoColor = orig }()
+
+	text := NewFormatter("table").formatLawDiffText(lawDiffFixture(), true)
+	if !strings.Contains(text, diffInsertColor.Sprint("+ ① 5년 이하의 징역에 처한다.")) {
+		t.Errorf("inserted lines should be green:\n%q", text)
+	}
+	if !strings.Contains(text, diffDeleteColor.Sprint("- ① 3년 이하의 징역에 처한다.")) {
+		t.Errorf("deleted lines should be red:\n%q", text)
+	}
+	if strings.Contains(NewFormatter("table").formatLawDiffText(lawDiffFixture(), false), "\x1b[") {
+		t.Error("text without color should not contain escape codes")
+	}
+}
@@ -0,0 +1,109 @@
+package output
+
+import (
+	"fmt"
+	"html"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+// defaultPageSize is used when the page size cannot be determined from the request or response
+const defaultPageSize = 10
+
+// PageMeta describes the position of a search response within its result set
+type PageMeta struct {
+	Current int
+	Total   int
+}
+
+// NewPageMeta computes the page metadata of resp. Pages cover the results that
+// can be shown (see api.SearchResponse.PageableCount). A pageSize of 0 falls
+// back to the page size of the request kept in the response, then to the
+// number of results in it, which is short on a last page.
+func NewPageMeta(resp *api.SearchResponse, pageSize int) PageMeta {
+	if pageSize <= 0 {
+		pageSize = resp.PageSize
+	}
+	if pageSize <= 0 {
+		pageSize = len(resp.Laws)
+	}
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+
+	current := resp.Page
+	if current < 1 {
+		current = 1
+	}
+	total := (resp.PageableCount() + pageSize - 1) / pageSize
+	if total < current {
+		total = current
+	}
+	return PageMeta{Current: current, Total: total}
+}
+
+// Paginated reports whether the result set spans more than one page
+func (m PageMeta) Paginated() bool {
+	return m.Total > 1
+}
+
+// HasPrev reports whether there is a page before the current one
+func (m PageMeta) HasPrev() bool {
+	return m.Current > 1
+}
+
+// HasNext reports whether there is a page after the current one
+func (m PageMeta) HasNext() bool {
+	return m.Current < m.Total
+}
+
+// fetchedNote explains that only part of the results can be paged through when a
+// unified search merged fewer results than the servers reported
+func (f *Formatter) fetchedNote(resp *api.SearchResponse) string {
+	if resp.PageableCount() == resp.TotalCount {
+		return ""
+	}
+	return f.t("output.fetchedNote", resp.FetchedCount)
+}
+
+// pageCommand returns the command that shows the given page. Without a base command
+// only the --page option is returned.
+func pageCommand(base string, page int) string {
+	if base == "" {
+		return fmt.Sprintf("--page %d", page)
+	}
+	return fmt.Sprintf("%s --page %d", base, page)
+}
+
+// markdownPageNavigation renders the page position followed by the commands for the
+// previous and next pages
+func (f *Formatter) markdownPageNavigation(meta PageMeta, base string) string {
+	s := fmt.Sprintf("\n> %s\n", f.t("output.page", meta.Current, meta.Total))
+	if meta.HasPrev() {
+		s += fmt.Sprintf(">\n> - %s: `%s`\n", f.t("output.prevPage"), pageCommand(base, meta.Current-1))
+	}
+	if meta.HasNext() {
+		s += fmt.Sprintf(">\n> - %s: `%s`\n", f.t("output.nextPage"), pageCommand(base, meta.Current+1))
+	}
+	return s
+}
+
+// htmlPageNavigation renders previous/next navigation with the command that shows each
+// page. The link for a page that does not exist is rendered disabled.
+func (f *Formatter) htmlPageNavigation(meta PageMeta, base, indent string) string {
+	s := fmt.Sprintf("%s<nav class=\"pagination\">\n", indent)
+	s += htmlPageLink(meta.HasPrev(), f.t("output.prev"), pageCommand(base, meta.Current-1), indent+"  ")
+	s += fmt.Sprintf("%s  <span class=\"page-current\">%s</span>\n", indent, f.t("output.pageCurrent", meta.Current, meta.Total))
+	s += htmlPageLink(meta.HasNext(), f.t("output.next"), pageCommand(base, meta.Current+1), indent+"  ")
+	s += fmt.Sprintf("%s</nav>\n", indent)
+	return s
+}
+
+// htmlPageLink renders a single navigation entry
+func htmlPageLink(enabled bool, label, command, indent string) string {
+	if !enabled {
+		return fmt.Sprintf("%s<span class=\"page-link disabled\" aria-disabled=\"true\">%s</span>\n", indent, label)
+	}
+	return fmt.Sprintf("%s<span class=\"page-link\" title=\"%s\">%s <code>%s</code></span>\n",
+		indent, html.EscapeString(command), label, html.EscapeString(command))
+}
@@ -0,0 +1,181 @@
+package output
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+func pagedResponse(page, total, count int) *api.SearchResponse {
+	laws := make([]api.LawInfo, count)
+	for i := range laws {
+		laws[i] = api.LawInfo{ID: "001", Name: "테스트 법령", LawType: "법률", Department: "테스트부", EffectDate: "20240101"}
+	}
+	return &api.SearchResponse{TotalCount: total, Page: page, Laws: laws}
+}
+
+func withPageSize(resp *api.SearchResponse, pageSize int) *api.SearchResponse {
+	resp.PageSize = pageSize
+	return resp
+}
+
+func fetchedResponse(resp *api.SearchResponse, fetched int) *api.SearchResponse {
+	resp.FetchedCount = fetched
+	return resp
+}
+
+func TestNewPageMeta(t *testing.T) {
+	tests := []struct {
+		name     string
+		resp     *api.SearchResponse
+		pageSize int
+		want     PageMeta
+		hasPrev  bool
+		hasNext  bool
+	}{
+		{"first page", pagedResponse(1, 25, 10), 10, PageMeta{Current: 1, Total: 3}, false, true},
+		{"middle page", pagedResponse(2, 25, 10), 10, PageMeta{Current: 2, Total: 3}, true, true},
+		{"last page", pagedResponse(3, 25, 5), 10, PageMeta{Current: 3, Total: 3}, true, false},
+		{"page size from results", pagedResponse(1, 25, 5), 0, PageMeta{Current: 1, Total: 5}, false, true},
+		{"missing page number", pagedResponse(0, 25, 10), 10, PageMeta{Current: 1, Total: 3}, false, true},
+		{"single page", pagedResponse(1, 3, 3), 10, PageMeta{Current: 1, Total: 1}, false, false},
+		{"unified search pages only merged results", fetchedResponse(pagedResponse(1, 253, 10), 103), 10, PageMeta{Current: 1, Total: 11}, false, true},
+		{"unified search fetched everything", fetchedResponse(pagedResponse(1, 9, 9), 9), 10, PageMeta{Current: 1, Total: 1}, false, false},
+		// The short last page does not stand for the page size, the request does
+		{"last page of 3 with the requested size", withPageSize(pagedResponse(3, 103, 3), 50), 0, PageMeta{Current: 3, Total: 3}, true, false},
+		{"requested size beats the response", withPageSize(pagedResponse(3, 103, 3), 50), 20, PageMeta{Current: 3, Total: 6}, true, true},
+		{"total divisible by the size", withPageSize(pagedResponse(2, 100, 50), 50), 0, PageMeta{Current: 2, Total: 2}, true, false},
+		{"one past a divisible total", withPageSize(pagedResponse(2, 101, 50), 50), 0, PageMeta{Current: 2, Total: 3}, true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewPageMeta(tt.resp, tt.pageSize)
+			if got != tt.want {
+				t.Errorf("NewPageMeta() = %+v, want %+v", got, tt.want)
+			}
+			if got.HasPrev() != tt.hasPrev {
+				t.Errorf("HasPrev() = %v, want %v", got.HasPrev(), tt.hasPrev)
+			}
+			if got.HasNext() != tt.hasNext {
+				t.Errorf("HasNext() = %v, want %v", got.HasNext(), tt.hasNext)
+			}
+		})
+	}
+}
+
+func TestPageNavigation(t *testing.T) {
+	const command = `warp law "개인정보"`
+
+	tests := []struct {
+		name        string
+		format      string
+		resp        *api.SearchResponse
+		contains    []string
+		notContains []string
+	}{
+		{
+			name:        "markdown first page",
+			format:      "markdown",
+			resp:        pagedResponse(1, 25, 10),
+			contains:    []string{"페이지 1/3", "다음 페이지: `" + command + " --page 2`"},
+			notContains: []string{"이전 페이지"},
+		},
+		{
+			name:     "markdown middle page",
+			format:   "markdown",
+			resp:     pagedResponse(2, 25, 10),
+			contains: []string{"이전 페이지: `" + command + " --page 1`", "다음 페이지: `" + command + " --page 3`"},
+		},
+		{
+			name:        "markdown last page",
+			format:      "markdown",
+			resp:        pagedResponse(3, 25, 5),
+			contains:    []string{"페이지 3/3", "이전 페이지: `" + command + " --page 2`"},
+			notContains: []string{"다음 페이지"},
+		},
+		{
+			name:   "html first page",
+			format: "html",
+			resp:   pagedResponse(1, 25, 10),
+			contains: []string{
+				`<nav class="pagination">`,
+				`<span class="page-link disabled" aria-disabled="true">◀ 이전</span>`,
+				"다음 ▶ <code>warp law &#34;개인정보&#34; --page 2</code>",
+			},
+		},
+		{
+			name:   "html last page",
+			format: "html-simple",
+			resp:   pagedResponse(3, 25, 5),
+			contains: []string{
+				"◀ 이전 <code>warp law &#34;개인정보&#34; --page 2</code>",
+				`<span class="page-current">페이지 3/3</span>`,
+				`<span class="page-link disabled" aria-disabled="true">다음 ▶</span>`,
+			},
+		},
+		{
+			name:        "markdown last merged page of a unified search",
+			format:      "markdown",
+			resp:        fetchedResponse(pagedResponse(11, 253, 3), 103),
+			contains:    []string{"총 **253**개", "이 중 103개를 병합해", "페이지 11/11"},
+			notContains: []string{"다음 페이지"},
+		},
+		{
+			name:     "table of a unified search",
+			format:   "table",
+			resp:     fetchedResponse(pagedResponse(1, 253, 10), 103),
+			contains: []string{"총 253개의 법령을 찾았습니다. (이 중 103개를 병합해 페이지로 표시합니다)", "페이지 1/11"},
+		},
+		{
+			name:        "table first page",
+			format:      "table",
+			resp:        pagedResponse(1, 25, 10),
+			contains:    []string{"페이지 1/3", "다음 페이지: " + command + " --page 2\n"},
+			notContains: []string{"이전 페이지"},
+		},
+		{
+			name:        "table last page",
+			format:      "table",
+			resp:        pagedResponse(3, 25, 5),
+			contains:    []string{"페이지 3/3", "이전 페이지: " + command + " --page 2\n"},
+			notContains: []string{"다음 페이지"},
+		},
+		{
+			name:        "single page has no navigation",
+			format:      "html",
+			resp:        pagedResponse(1, 3, 3),
+			notContains: []string{"pagination"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewFormatter(tt.format).WithPagination(command, 10).FormatSearchResultToString(tt.resp)
+			if err != nil {
+				t.Fatalf("FormatSearchResultToString() error = %v", err)
+			}
+			for _, s := range tt.contains {
+				if !strings.Contains(result, s) {
+					t.Errorf("Result should contain %q, got:\n%s", s, result)
+				}
+			}
+			for _, s := range tt.notContains {
+				if strings.Contains(result, s) {
+					t.Errorf("Result should not contain %q, got:\n%s", s, result)
+				}
+			}
+		})
+	}
+}
+
+func TestPageNavigationWithoutCommand(t *testing.T) {
+	result, err := NewFormatter("markdown").FormatSearchResultToString(pagedResponse(1, 25, 10))
+	if err != nil {
+		t.Fatalf("FormatSearchResultToString() error = %v", err)
+	}
+	if !strings.Contains(result, "다음 페이지: `--page 2`") {
+		t.Errorf("Expected a bare --page hint, got:\n%s", result)
+	}
+}
@@ -0,0 +1,46 @@
+package output
+
+import (
+	"strings"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+// paragraphIndent is the indentation of each level below the 항 of an article
+const paragraphIndent = "  "
+
+// articleLines returns the lines of the text of an article: its content,
+// then its 항 with their 호 and 목 indented a level each. An article without
+// the hierarchy is its content alone.
+func articleLines(article api.Article) []string {
+	lines := contentLines(article.Content)
+	for _, p := range article.Paragraphs {
+		lines = append(lines, paragraphLines(p, "")...)
+	}
+	return lines
+}
+
+// paragraphLines returns the lines of p and the units below it, indented by indent
+func paragraphLines(p api.Paragraph, indent string) []string {
+	var lines []string
+	for i, line := range contentLines(paragraphText(p)) {
+		if i > 0 {
+			line = strings.TrimSpace(line)
+		}
+		lines = append(lines, indent+line)
+	}
+	for _, item := range p.Items {
+		lines = append(lines, paragraphLines(item, indent+paragraphIndent)...)
+	}
+	return lines
+}
+
+// paragraphText returns the text of p led by its number, which the text of a
+// 항, 호 or 목 usually starts with already
+func paragraphText(p api.Paragraph) string {
+	content := strings.TrimSpace(p.Content)
+	if p.Number == "" || strings.HasPrefix(content, p.Number) {
+		return content
+	}
+	return strings.TrimSpace(p.Number + " " + content)
+}
@@ -0,0 +1,114 @@
+package output
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+// articleHeadingPattern matches an article heading such as "제5조" or
+// "제5조의2(정의)" at the start of article content
+var articleHeadingPattern = regexp.MustCompile(`^제\s*\d+\s*조(의\s*\d+)?\s*(\([^)]*\))?\s*`)
+
+// FormatDetailPlainText formats the articles of a law as undecorated text for
+// post-processing. Each article is written as "제N조(제목)" followed by its
+// body, with articles separated by a blank line. Tables and supplementary
+// provisions are appended in the same style when requested.
+func (f *Formatter) FormatDetailPlainText(detail *api.LawDetail, showTables, showSupplementary bool) (string, error) {
+	if detail == nil {
+		return "", fmt.Errorf("법령 상세 정보가 없습니다")
+	}
+
+	var blocks []string
+
+	for _, article := range detail.Articles {
+		if block := plainArticle(article); block != "" {
+			blocks = append(blocks, block)
+		}
+	}
+
+	if showTables {
+		for _, table := range detail.Tables {
+			heading := table.Number
+			if table.Title != "" {
+				heading = strings.TrimSpace(heading + " " + table.Title)
+			}
+			if block := plainBlock(heading, contentLines(table.Content)); block != "" {
+				blocks = append(blocks, block)
+			}
+		}
+	}
+
+	if showSupplementary {
+		for _, supp := range detail.SupplementaryProvisions {
+			heading := supp.Number
+			if supp.PromulgationDate != "" || supp.PromulgationNo != "" {
+				heading = "부칙"
+				if supp.PromulgationNo != "" {
+					heading += " <" + supp.PromulgationNo + ">"
+				}
+				if supp.PromulgationDate != "" {
+					heading += " (" + formatDate(supp.PromulgationDate) + ")"
+				}
+			}
+			if block := plainBlock(heading, contentLines(supp.Content)); block != "" {
+				blocks = append(blocks, block)
+			}
+		}
+	}
+
+	if len(blocks) == 0 {
+		return "", nil
+	}
+	return strings.Join(blocks, "\n"), nil
+}
+
+// plainArticle formats a single article as "제N조(제목)\n본문".
+// A heading already present at the start of the content is not repeated, and
+// entries without a title whose content is not an article (chapter headings
+// such as "제1장 총칙") are written as their content alone.
+func plainArticle(article api.Article) string {
+	lines := articleLines(article)
+
+	if len(lines) > 0 && articleHeadingPattern.MatchString(strings.TrimSpace(lines[0])) {
+		first := strings.TrimSpace(articleHeadingPattern.ReplaceAllString(strings.TrimSpace(lines[0]), ""))
+		if first == "" {
+			lines = lines[1:]
+		} else {
+			lines[0] = first
+		}
+	} else if article.Title == "" {
+		return plainBlock("", lines)
+	}
+
+	return plainBlock(articleHeading(article), lines)
+}
+
+// articleHeading returns the "제N조(제목)" heading for an article
+func articleHeading(article api.Article) string {
+	number := strings.TrimSpace(article.Number)
+	if number != "" && !strings.HasPrefix(number, "제") {
+		number = "제" + number + "조"
+	}
+	if article.Title != "" {
+		return number + "(" + article.Title + ")"
+	}
+	return number
+}
+
+// plainBlock joins a heading and body lines, one per line, with a trailing newline
+func plainBlock(heading string, lines []string) string {
+	var buf bytes.Buffer
+	if heading != "" {
+		buf.WriteString(heading)
+		buf.WriteString("\n")
+	}
+	for _, line := range lines {
+		buf.WriteString(strings.TrimSpace(line))
+		buf.WriteString("\n")
+	}
+	return buf.String()
+}
@@ -0,0 +1,146 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+// DefaultDelimiter separates fields of a record unless --delimiter is given
+const DefaultDelimiter = "\t"
+
+// pluckFields lists the field names accepted by --pluck (the canonical JSON keys,
+// plus detail_id written by --ids-only) and their values
+var pluckFields = []struct {
+	name  string
+	value func(api.LawInfo) string
+}{
+	{"law_id", func(l api.LawInfo) string { return l.ID }},
+	{"law_name", func(l api.LawInfo) string { return l.Name }},
+	{"law_name_hanja", func(l api.LawInfo) string { return l.NameHanja }},
+	{"law_name_abbrev", func(l api.LawInfo) string { return l.NameAbbrev }},
+	{"serial_no", func(l api.LawInfo) string { return l.SerialNo }},
+	{"promulgation_date", func(l api.LawInfo) string { return l.PromulDate }},
+	{"promulgation_no", func(l api.LawInfo) string { return l.PromulNo }},
+	{"revision_type", func(l api.LawInfo) string { return l.Category }},
+	{"department", func(l api.LawInfo) string { return l.Department }},
+	{"effect_date", func(l api.LawInfo) string { return l.EffectDate }},
+	{"law_type", func(l api.LawInfo) string { return l.LawType }},
+	{"source", func(l api.LawInfo) string { return l.Source }},
+	{DetailIDField, api.DetailID},
+}
+
+// DetailIDField is the --pluck field with the identifier taken by detail lookups
+const DetailIDField = "detail_id"
+
+// pluckField returns the value function of a --pluck field
+func pluckField(name string) (func(api.LawInfo) string, bool) {
+	for _, f := range pluckFields {
+		if f.name == name {
+			return f.value, true
+		}
+	}
+	return nil, false
+}
+
+// PluckFieldNames returns the field names accepted by --pluck
+func PluckFieldNames() []string {
+	names := make([]string, len(pluckFields))
+	for i, f := range pluckFields {
+		names[i] = f.name
+	}
+	return names
+}
+
+// RecordOptions controls plain record output
+type RecordOptions struct {
+	// Fields are the --pluck field names written for each record
+	Fields []string
+	// Delimiter separates fields; DefaultDelimiter when empty
+	Delimiter string
+	// Null terminates records with NUL instead of a newline (for xargs -0)
+	Null bool
+}
+
+// ParsePluckFields parses a comma-separated list of field names
+func ParsePluckFields(spec string) ([]string, error) {
+	var fields []string
+	for _, name := range strings.Split(spec, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		if _, ok := pluckField(name); !ok {
+			return nil, fmt.Errorf("알 수 없는 필드: %s (%s 중 선택)", name, strings.Join(PluckFieldNames(), ", "))
+		}
+		fields = append(fields, name)
+	}
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("추출할 필드를 지정하세요 (%s 중 선택)", strings.Join(PluckFieldNames(), ", "))
+	}
+	return fields, nil
+}
+
+// ParseDelimiter parses a --delimiter value. It must be a single character;
+// the escapes \t and \0 stand for a tab and NUL.
+func ParseDelimiter(value string) (string, error) {
+	switch value {
+	case `\t`:
+		return "\t", nil
+	case `\0`:
+		return "\x00", nil
+	}
+	if utf8.RuneCountInString(value) != 1 {
+		return "", fmt.Errorf("구분자는 한 글자여야 합니다: %q", value)
+	}
+	if value == "\n" {
+		return "", fmt.Errorf("개행은 구분자로 사용할 수 없습니다 (레코드 구분은 --null 옵션 사용)")
+	}
+	return value, nil
+}
+
+// WriteRecords writes one record per law with the requested fields.
+// Records end with a newline, or with NUL when opts.Null is set. With newline
+// termination, line breaks inside values are replaced by spaces so that every
+// record stays on one line; with NUL termination values are written as is.
+// NUL bytes are always removed from values.
+func WriteRecords(w io.Writer, laws []api.LawInfo, opts RecordOptions) error {
+	delimiter := opts.Delimiter
+	if delimiter == "" {
+		delimiter = DefaultDelimiter
+	}
+	terminator := "\n"
+	if opts.Null {
+		terminator = "\x00"
+	}
+
+	var b strings.Builder
+	for _, law := range laws {
+		for i, name := range opts.Fields {
+			value, ok := pluckField(name)
+			if !ok {
+				return fmt.Errorf("알 수 없는 필드: %s", name)
+			}
+			if i > 0 {
+				b.WriteString(delimiter)
+			}
+			b.WriteString(recordValue(value(law), opts.Null))
+		}
+		b.WriteString(terminator)
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// recordValue makes a value safe to write between record separators
+func recordValue(value string, null bool) string {
+	value = strings.ReplaceAll(value, "\x00", "")
+	if null {
+		return value
+	}
+	return strings.NewReplacer("\r\n", " ", "\n", " ", "\r", " ").Replace(value)
+}
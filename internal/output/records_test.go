@@ -0,0 +1,118 @@
+package output
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+func recordTestLaws() []api.LawInfo {
+	return []api.LawInfo{
+		{ID: "001", Name: "개인정보 보호법", EffectDate: "20230915"},
+		{ID: "002", Name: "여러 줄\n이름 법률", EffectDate: "20240101"},
+		{ID: "003", Name: "NUL\x00포함", EffectDate: ""},
+	}
+}
+
+func TestWriteRecords(t *testing.T) {
+	tests := []struct {
+		name string
+		opts RecordOptions
+		want string
+	}{
+		{
+			name: "single field",
+			opts: RecordOptions{Fields: []string{"law_id"}},
+			want: "001\n002\n003\n",
+		},
+		{
+			name: "default tab delimiter",
+			opts: RecordOptions{Fields: []string{"law_id", "law_name"}},
+			want: "001\t개인정보 보호법\n002\t여러 줄 이름 법률\n003\tNUL포함\n",
+		},
+		{
+			name: "custom delimiter",
+			opts: RecordOptions{Fields: []string{"law_id", "effect_date"}, Delimiter: ","},
+			want: "001,20230915\n002,20240101\n003,\n",
+		},
+		{
+			name: "null terminated keeps newlines in values",
+			opts: RecordOptions{Fields: []string{"law_name"}, Null: true},
+			want: "개인정보 보호법\x00여러 줄\n이름 법률\x00NUL포함\x00",
+		},
+		{
+			name: "null terminated with custom delimiter",
+			opts: RecordOptions{Fields: []string{"law_id", "law_name"}, Delimiter: "|", Null: true},
+			want: "001|개인정보 보호법\x00002|여러 줄\n이름 법률\x00003|NUL포함\x00",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := WriteRecords(&buf, recordTestLaws(), tt.opts); err != nil {
+				t.Fatalf("WriteRecords() error = %v", err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("WriteRecords() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriteRecordsEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteRecords(&buf, nil, RecordOptions{Fields: []string{"law_id"}, Null: true}); err != nil {
+		t.Fatalf("WriteRecords() error = %v", err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("Empty results should write nothing, got %q", buf.String())
+	}
+}
+
+func TestParsePluckFields(t *testing.T) {
+	fields, err := ParsePluckFields(" law_id, LAW_NAME ,,effect_date")
+	if err != nil {
+		t.Fatalf("ParsePluckFields() error = %v", err)
+	}
+	if strings.Join(fields, ",") != "law_id,law_name,effect_date" {
+		t.Errorf("ParsePluckFields() = %v", fields)
+	}
+
+	for _, spec := range []string{"", " , ", "law_id,법령명"} {
+		if _, err := ParsePluckFields(spec); err == nil {
+			t.Errorf("ParsePluckFields(%q) should fail", spec)
+		}
+	}
+}
+
+func TestParseDelimiter(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{",", ",", false},
+		{"|", "|", false},
+		{"·", "·", false},
+		{`\t`, "\t", false},
+		{`\0`, "\x00", false},
+		{"", "", true},
+		{"::", "", true},
+		{"\n", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseDelimiter(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseDelimiter(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseDelimiter(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package output
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+// sourceLine is a line of a SourceStream: a law of a source, or the error
+// that ended the search of the source
+type sourceLine struct {
+	Source string      `json:"source"`
+	Law    interface{} `json:"law,omitempty"`
+	Error  string      `json:"error,omitempty"`
+}
+
+// SourceStream writes search results as ndjson lines in the order the
+// sources send them, without a meta line. The sources are interleaved, so
+// each line names its source ("NLIC" or "ELIS"). A line is written with a
+// single Write, which reaches os.Stdout unbuffered, and flushed at once when
+// w supports flushing (e.g. bufio.Writer), so that a reader of the pipe gets
+// every line as soon as it is known.
+type SourceStream struct {
+	f       *Formatter
+	w       io.Writer
+	flusher interface{ Flush() error }
+	count   int
+}
+
+// NewSourceStream returns a stream writing search results to w
+func (f *Formatter) NewSourceStream(w io.Writer) (*SourceStream, error) {
+	if err := f.validateJSONSchema(); err != nil {
+		return nil, err
+	}
+	s := &SourceStream{f: f, w: w}
+	s.flusher, _ = w.(interface{ Flush() error })
+	return s, nil
+}
+
+// Count returns the number of laws written so far
+func (s *SourceStream) Count() int {
+	return s.count
+}
+
+// WriteLaws writes a line for each of laws, sent by source
+func (s *SourceStream) WriteLaws(source string, laws []api.LawInfo) error {
+	for _, law := range laws {
+		if err := s.writeLine(sourceLine{Source: source, Law: s.f.jsonLawValue(law)}); err != nil {
+			return err
+		}
+		s.count++
+	}
+	return nil
+}
+
+// WriteError writes a line with the error that ended the search of source
+func (s *SourceStream) WriteError(source string, err error) error {
+	return s.writeLine(sourceLine{Source: source, Error: err.Error()})
+}
+
+// writeLine writes v and its newline with one Write and flushes it
+func (s *SourceStream) writeLine(v sourceLine) error {
+	line, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if _, err := s.w.Write(append(line, '\n')); err != nil {
+		return err
+	}
+	if s.flusher != nil {
+		return s.flusher.Flush()
+	}
+	return nil
+}
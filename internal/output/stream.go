@@ -0,0 +1,143 @@
+package output
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+// StreamFormats are the formats written row by row, so that the pages of a
+// long search can be written as they arrive instead of after all of them
+var StreamFormats = []string{"csv", "ndjson"}
+
+// CanStream reports whether the format of the formatter is one of
+// StreamFormats. Tables and other formats that lay out all the results at
+// once are not.
+func (f *Formatter) CanStream() bool {
+	for _, format := range StreamFormats {
+		if f.format == format {
+			return true
+		}
+	}
+	return false
+}
+
+// SearchStream writes search results page by page in a format of
+// StreamFormats. Only the page being written is held in memory: the header
+// is written with the first page and later pages only add their rows, so
+// counts known only at the end are not part of the output.
+type SearchStream struct {
+	f       *Formatter
+	w       io.Writer
+	flusher interface{ Flush() error }
+
+	csv       *csv.Writer
+	encoder   *json.Encoder
+	started   bool
+	hasSource bool
+	count     int
+}
+
+// ndjsonStreamMeta is the first line of streamed ndjson. Unlike ndjsonMeta it
+// has no count, which is unknown until the last page.
+type ndjsonStreamMeta struct {
+	Meta struct {
+		TotalCount int                `json:"total_count"`
+		Streamed   bool               `json:"streamed"`
+		Sources    []api.SourceStatus `json:"sources,omitempty"`
+		Warnings   []string           `json:"warnings,omitempty"`
+	} `json:"meta"`
+}
+
+// NewSearchStream returns a stream writing search results to w. The lines
+// are flushed after each page when w supports flushing (e.g. bufio.Writer).
+func (f *Formatter) NewSearchStream(w io.Writer) (*SearchStream, error) {
+	if !f.CanStream() {
+		return nil, fmt.Errorf("스트리밍을 지원하지 않는 출력 형식: %s (csv, ndjson 중 선택)", f.format)
+	}
+	if err := f.validateJSONSchema(); err != nil {
+		return nil, err
+	}
+	s := &SearchStream{f: f, w: w}
+	s.flusher, _ = w.(interface{ Flush() error })
+	if f.format == "csv" {
+		s.csv = csv.NewWriter(w)
+	} else {
+		s.encoder = json.NewEncoder(w)
+	}
+	return s, nil
+}
+
+// Count returns the number of laws written so far
+func (s *SearchStream) Count() int {
+	return s.count
+}
+
+// WritePage writes the laws of a page. The header comes from the first page:
+// the ndjson meta line with its total count, and the CSV header once there
+// are laws to write (an empty search writes no CSV, as FormatSearchResult).
+func (s *SearchStream) WritePage(resp *api.SearchResponse) error {
+	if s.csv != nil {
+		return s.writeCSV(resp.Laws)
+	}
+
+	if !s.started {
+		s.started = true
+		var meta ndjsonStreamMeta
+		meta.Meta.TotalCount = resp.TotalCount
+		meta.Meta.Streamed = true
+		meta.Meta.Sources = resp.Sources
+		meta.Meta.Warnings = resp.Warnings
+		if err := s.encoder.Encode(meta); err != nil {
+			return err
+		}
+	}
+	for _, law := range resp.Laws {
+		if err := s.encoder.Encode(s.f.jsonLawValue(law)); err != nil {
+			return err
+		}
+		s.count++
+	}
+	return s.flush()
+}
+
+// writeCSV writes the CSV rows of laws, numbered after the rows already written
+func (s *SearchStream) writeCSV(laws []api.LawInfo) error {
+	if len(laws) == 0 {
+		return nil
+	}
+	if !s.started {
+		s.started = true
+		// The layout of the first page is kept for the whole stream
+		s.hasSource = hasSourceColumn(laws)
+		// BOM for Excel compatibility, as RenderCSV
+		if _, err := s.w.Write([]byte{0xEF, 0xBB, 0xBF}); err != nil {
+			return err
+		}
+		if err := s.csv.Write(s.f.searchHeaders(s.hasSource)); err != nil {
+			return fmt.Errorf("CSV 헤더 작성 실패: %w", err)
+		}
+	}
+	for _, law := range laws {
+		s.count++
+		if err := s.csv.Write(csvRow(s.count, law, s.hasSource)); err != nil {
+			return fmt.Errorf("CSV 데이터 작성 실패: %w", err)
+		}
+	}
+	s.csv.Flush()
+	if err := s.csv.Error(); err != nil {
+		return fmt.Errorf("CSV 작성 실패: %w", err)
+	}
+	return s.flush()
+}
+
+// flush flushes w when it supports flushing
+func (s *SearchStream) flush() error {
+	if s.flusher != nil {
+		return s.flusher.Flush()
+	}
+	return nil
+}
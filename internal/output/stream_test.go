@@ -0,0 +1,233 @@
+package output
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+// streamTestPages returns a search of total laws over pages of size
+func streamTestPages(total, size int) []*api.SearchResponse {
+	var pages []*api.SearchResponse
+	for start := 1; start <= total; start += size {
+		page := &api.SearchResponse{TotalCount: total, Page: len(pages) + 1}
+		for i := start; i < start+size && i <= total; i++ {
+			page.Laws = append(page.Laws, api.LawInfo{
+				ID:         fmt.Sprintf("%06d", i),
+				Name:       fmt.Sprintf("법령 %d", i),
+				LawType:    "법률",
+				Department: "법제처",
+				EffectDate: "20240101",
+			})
+		}
+		pages = append(pages, page)
+	}
+	return pages
+}
+
+// mergePages returns the laws of pages as one response
+func mergePages(pages []*api.SearchResponse) *api.SearchResponse {
+	merged := &api.SearchResponse{TotalCount: pages[0].TotalCount, Page: 1}
+	for _, page := range pages {
+		merged.Laws = append(merged.Laws, page.Laws...)
+	}
+	return merged
+}
+
+func writeStream(t testing.TB, format string, w io.Writer, pages []*api.SearchResponse) *SearchStream {
+	t.Helper()
+	stream, err := NewFormatter(format).NewSearchStream(w)
+	if err != nil {
+		t.Fatalf("NewSearchStream() error = %v", err)
+	}
+	for _, page := range pages {
+		if err := stream.WritePage(page); err != nil {
+			t.Fatalf("WritePage() error = %v", err)
+		}
+	}
+	return stream
+}
+
+func TestSearchStreamCSV(t *testing.T) {
+	pages := streamTestPages(5, 2)
+	var buf bytes.Buffer
+	stream := writeStream(t, "csv", &buf, pages)
+
+	// Streaming the pages writes the same CSV as formatting them at once
+	want, err := NewFormatter("csv").FormatSearchResultToString(mergePages(pages))
+	if err != nil {
+		t.Fatalf("FormatSearchResultToString() error = %v", err)
+	}
+	if buf.String() != want {
+		t.Errorf("streamed CSV differs from the merged one:\n%s\n---\n%s", buf.String(), want)
+	}
+	if strings.Count(buf.String(), "\ufeff") != 1 {
+		t.Errorf("streamed CSV should have one BOM:\n%q", buf.String())
+	}
+	if stream.Count() != 5 {
+		t.Errorf("Count() = %d, want 5", stream.Count())
+	}
+
+	t.Run("empty", func(t *testing.T) {
+		var buf bytes.Buffer
+		writeStream(t, "csv", &buf, []*api.SearchResponse{{TotalCount: 0}})
+		if buf.Len() != 0 {
+			t.Errorf("empty search wrote %q, want nothing", buf.String())
+		}
+	})
+}
+
+func TestSearchStreamNDJSON(t *testing.T) {
+	pages := streamTestPages(5, 2)
+	pages[0].Warnings = []string{"경고"}
+	var buf bytes.Buffer
+	stream := writeStream(t, "ndjson", &buf, pages)
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if len(lines) != 6 {
+		t.Fatalf("streamed ndjson has %d lines, want the meta line and 5 laws:\n%s", len(lines), buf.String())
+	}
+	var meta map[string]map[string]interface{}
+	if err := json.Unmarshal([]byte(lines[0]), &meta); err != nil {
+		t.Fatalf("meta line is not JSON: %v", err)
+	}
+	if meta["meta"]["total_count"] != float64(5) || meta["meta"]["streamed"] != true {
+		t.Errorf("meta line = %s", lines[0])
+	}
+	if _, ok := meta["meta"]["count"]; ok {
+		t.Errorf("meta line of a stream should have no count: %s", lines[0])
+	}
+	for i, line := range lines[1:] {
+		var law api.LawInfo
+		if err := json.Unmarshal([]byte(line), &law); err != nil || law.Name != fmt.Sprintf("법령 %d", i+1) {
+			t.Errorf("line %d = %s (%v)", i+2, line, err)
+		}
+	}
+	if stream.Count() != 5 {
+		t.Errorf("Count() = %d, want 5", stream.Count())
+	}
+}
+
+func TestSearchStreamFlushesPages(t *testing.T) {
+	var buf bytes.Buffer
+	w := bufio.NewWriterSize(&buf, 1<<16)
+	stream, err := NewFormatter("csv").NewSearchStream(w)
+	if err != nil {
+		t.Fatalf("NewSearchStream() error = %v", err)
+	}
+	if err := stream.WritePage(streamTestPages(2, 2)[0]); err != nil {
+		t.Fatalf("WritePage() error = %v", err)
+	}
+	if !strings.Contains(buf.String(), "법령 2") {
+		t.Errorf("page was not flushed to the writer: %q", buf.String())
+	}
+}
+
+func TestSearchStreamFormats(t *testing.T) {
+	for _, format := range []string{"table", "json", "xml", "markdown", "html", "fixed"} {
+		if NewFormatter(format).CanStream() {
+			t.Errorf("%s should not stream", format)
+		}
+		if _, err := NewFormatter(format).NewSearchStream(io.Discard); err == nil {
+			t.Errorf("NewSearchStream(%s) error = nil, want unsupported format", format)
+		}
+	}
+	for _, format := range StreamFormats {
+		if !NewFormatter(format).CanStream() {
+			t.Errorf("%s should stream", format)
+		}
+	}
+}
+
+func TestSourceStreamFlushesEachLine(t *testing.T) {
+	var buf bytes.Buffer
+	w := &countingWriter{Writer: bufio.NewWriterSize(&buf, 1<<16)}
+	stream, err := NewFormatter("ndjson").NewSourceStream(w)
+	if err != nil {
+		t.Fatalf("NewSourceStream() error = %v", err)
+	}
+
+	if err := stream.WriteLaws("ELIS", streamTestPages(2, 2)[0].Laws); err != nil {
+		t.Fatalf("WriteLaws() error = %v", err)
+	}
+	// Each law reaches the underlying writer as soon as it is written
+	if w.flushes != 2 || strings.Count(buf.String(), "\n") != 2 {
+		t.Errorf("flushes = %d with output %q, want 2 flushed lines", w.flushes, buf.String())
+	}
+	if err := stream.WriteError("NLIC", fmt.Errorf("timeout")); err != nil {
+		t.Fatalf("WriteError() error = %v", err)
+	}
+	if w.flushes != 3 || stream.Count() != 2 {
+		t.Errorf("flushes, Count() = %d, %d, want 3, 2", w.flushes, stream.Count())
+	}
+
+	lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+	wantSources := []string{"ELIS", "ELIS", "NLIC"}
+	if len(lines) != len(wantSources) {
+		t.Fatalf("got %d lines, want %d:\n%s", len(lines), len(wantSources), buf.String())
+	}
+	for i, line := range lines {
+		var decoded struct {
+			Source string                 `json:"source"`
+			Law    map[string]interface{} `json:"law"`
+			Error  string                 `json:"error"`
+		}
+		if err := json.Unmarshal([]byte(line), &decoded); err != nil {
+			t.Fatalf("line %d is not valid JSON: %v: %s", i+1, err, line)
+		}
+		if decoded.Source != wantSources[i] {
+			t.Errorf("line %d source = %q, want %q", i+1, decoded.Source, wantSources[i])
+		}
+		if (decoded.Law == nil) == (decoded.Error == "") {
+			t.Errorf("line %d should hold either a law or an error: %s", i+1, line)
+		}
+	}
+}
+
+func TestSourceStreamCanonical(t *testing.T) {
+	var buf bytes.Buffer
+	stream, err := NewFormatter("ndjson").WithJSONSchema(SchemaCanonical).NewSourceStream(&buf)
+	if err != nil {
+		t.Fatalf("NewSourceStream() error = %v", err)
+	}
+	if err := stream.WriteLaws("NLIC", streamTestPages(1, 1)[0].Laws); err != nil {
+		t.Fatalf("WriteLaws() error = %v", err)
+	}
+	if !strings.Contains(buf.String(), `"source":"NLIC","law":{"law_id":"000001"`) {
+		t.Errorf("line = %s, want the canonical law under its source", buf.String())
+	}
+
+	if _, err := NewFormatter("ndjson").WithJSONSchema("snake").NewSourceStream(&buf); err == nil {
+		t.Error("NewSourceStream() error = nil, want unknown JSON schema")
+	}
+}
+
+// The streamed benchmarks hold one page at a time, while the merged ones
+// hold every page and the whole formatted output; compare B/op with -benchmem.
+func BenchmarkSearchOutput(b *testing.B) {
+	pages := streamTestPages(5000, 100)
+	for _, format := range StreamFormats {
+		b.Run(format+"/streamed", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				writeStream(b, format, io.Discard, pages)
+			}
+		})
+		b.Run(format+"/merged", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				out, err := NewFormatter(format).FormatSearchResultToString(mergePages(pages))
+				if err != nil {
+					b.Fatal(err)
+				}
+				io.WriteString(io.Discard, out)
+			}
+		})
+	}
+}
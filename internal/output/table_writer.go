@@ -9,30 +9,34 @@ import (
 
 	"github.com/fatih/color"
 	"github.com/olekukonko/tablewriter"
-	"golang.org/x/term"
 )
 
 // TableStyle defines the style for table output
 type TableStyle struct {
-	UseColor      bool
-	Compact       bool
-	BoxDrawing    bool
+	UseColor   bool
+	Compact    bool
+	BoxDrawing bool
+	// TerminalWidth is the width the table is fitted to; 0 keeps the
+	// default column widths (output is not a terminal or its width is unknown)
 	TerminalWidth int
+	// ColumnAlignments sets the alignment of each column. Columns that are
+	// missing or set to AlignDefault use DefaultAlignments.
+	ColumnAlignments []Alignment
+	// CellColor returns the color of a cell, or nil to leave it plain. It is
+	// only used with UseColor.
+	CellColor func(row, column int) *color.Color
 }
 
 // GetDefaultTableStyle returns the default table style
 func GetDefaultTableStyle() *TableStyle {
-	// Check if output is to a terminal
-	useColor := isTerminal()
-
-	// Get terminal width
-	width := getTerminalWidth()
+	// Check if output is to a terminal and color has not been disabled (NO_COLOR, --no-color)
+	useColor := isTerminal() && !color.NoColor
 
 	return &TableStyle{
 		UseColor:      useColor,
 		Compact:       false,
 		BoxDrawing:    true,
-		TerminalWidth: width,
+		TerminalWidth: tableWidth(),
 	}
 }
 
@@ -45,14 +49,6 @@ func isTerminal() bool {
 	return (fileInfo.Mode() & os.ModeCharDevice) != 0
 }
 
-// getTerminalWidth returns the terminal width or a default value
-func getTerminalWidth() int {
-	if width, _, err := term.GetSize(int(os.Stdout.Fd())); err == nil {
-		return width
-	}
-	return 120 // default width
-}
-
 // RenderTable renders a table with the given headers and rows
 func RenderTable(headers []string, rows [][]string, style *TableStyle) string {
 	if style == nil {
@@ -66,7 +62,9 @@ func RenderTable(headers []string, rows [][]string, style *TableStyle) string {
 	if style.UseColor {
 		coloredHeaders := make([]string, len(headers))
 		for i, h := range headers {
-			coloredHeaders[i] = color.New(color.FgCyan, color.Bold).Sprint(h)
+			// Formatted here, since formatting the colored header would
+			// upper-case its escape codes
+			coloredHeaders[i] = DefaultTheme.Header.Sprint(tablewriter.Title(h))
 		}
 		table.SetHeader(coloredHeaders)
 	} else {
@@ -87,13 +85,48 @@ func RenderTable(headers []string, rows [][]string, style *TableStyle) string {
 	}
 
 	// Set alignment
-	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	aligns := resolveAlignments(style.ColumnAlignments, headers, rows)
+	columnAligns := make([]int, len(aligns))
+	for i, align := range aligns {
+		columnAligns[i] = tablewriterAlignment(align)
+	}
+	table.SetColumnAlignment(columnAligns)
 	table.SetHeaderAlignment(tablewriter.ALIGN_LEFT)
 
-	// Auto wrap and merge for long content
-	table.SetAutoWrapText(true)
-	table.SetAutoFormatHeaders(true)
-	table.SetReflowDuringAutoWrap(true)
+	table.SetAutoFormatHeaders(!style.UseColor)
+	if style.TerminalWidth > 0 {
+		// Fit the columns to the terminal and wrap the cells ourselves,
+		// since tablewriter only supports a single maximum column width
+		widths := ColumnWidths(headers, rows, style.TerminalWidth)
+		wrapped := make([][]string, len(rows))
+		for r, row := range rows {
+			wrapped[r] = make([]string, len(row))
+			for i, cell := range row {
+				if i < len(widths) {
+					cell = wrapCell(cell, widths[i])
+				}
+				wrapped[r][i] = cell
+			}
+		}
+		rows = wrapped
+		table.SetAutoWrapText(false)
+	} else {
+		// Auto wrap and merge for long content
+		table.SetAutoWrapText(true)
+		table.SetReflowDuringAutoWrap(true)
+	}
+
+	// Color the cells once wrapped, so that the widths are measured on plain text
+	if style.UseColor && style.CellColor != nil {
+		colored := make([][]string, len(rows))
+		for r, row := range rows {
+			colored[r] = make([]string, len(row))
+			for i, cell := range row {
+				colored[r][i] = paintLines(style.CellColor(r, i), cell)
+			}
+		}
+		rows = colored
+	}
 
 	// Add rows
 	for _, row := range rows {
@@ -106,17 +139,25 @@ func RenderTable(headers []string, rows [][]string, style *TableStyle) string {
 	return buf.String()
 }
 
-// RenderMarkdownTable renders a markdown table
+// RenderMarkdownTable renders a markdown table using the default column alignments
 func RenderMarkdownTable(headers []string, rows [][]string) string {
+	return RenderMarkdownTableWithAlignments(headers, rows, nil)
+}
+
+// RenderMarkdownTableWithAlignments renders a markdown table whose separator
+// row carries the column alignments (":---", "---:", ":---:"), so that it
+// lines up the same way as RenderTable
+func RenderMarkdownTableWithAlignments(headers []string, rows [][]string, aligns []Alignment) string {
 	var buf bytes.Buffer
 
 	// Write headers
 	fmt.Fprintf(&buf, "| %s |\n", strings.Join(headers, " | "))
 
 	// Write separator
+	resolved := resolveAlignments(aligns, headers, rows)
 	separators := make([]string, len(headers))
 	for i := range separators {
-		separators[i] = "---"
+		separators[i] = markdownSeparator(resolved[i])
 	}
 	fmt.Fprintf(&buf, "| %s |\n", strings.Join(separators, " | "))
 
@@ -253,14 +294,8 @@ func HighlightValue(value string, style *TableStyle) string {
 	}
 
 	// Highlight law types
-	switch value {
-	case "법률":
-		return color.GreenString(value)
-	case "대통령령":
-		return color.CyanString(value)
-	case "부령", "총리령":
-		return color.MagentaString(value)
-	default:
-		return value
+	if c, ok := DefaultTheme.LawTypes[value]; ok {
+		return c.Sprint(value)
 	}
+	return value
 }
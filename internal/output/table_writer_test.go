@@ -0,0 +1,86 @@
+package output
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+var updateGolden = flag.Bool("update", false, "update golden files")
+
+// assertGolden compares got with testdata/<name>.golden, rewriting the file with -update
+func assertGolden(t *testing.T, name, got string) {
+	t.Helper()
+
+	path := filepath.Join("testdata", name+".golden")
+	if *updateGolden {
+		if err := os.WriteFile(path, []byte(got), 0644); err != nil {
+			t.Fatalf("failed to update golden file: %v", err)
+		}
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read golden file (run with -update to create): %v", err)
+	}
+	if got != string(want) {
+		t.Errorf("output does not match %s\ngot:\n%s\nwant:\n%s", path, got, want)
+	}
+}
+
+var alignmentHeaders = []string{"번호", "법령명", "법령구분", "조문 수", "시행일자"}
+
+var alignmentRows = [][]string{
+	{"1", "개인정보 보호법", "법률", "76", "2024-03-15"},
+	{"2", "개인정보 보호법 시행령", "대통령령", "1,024", "2024-03-15"},
+	{"10", "도로교통법", "법률", "-", "2023-10-19"},
+}
+
+func TestDefaultAlignments(t *testing.T) {
+	got := DefaultAlignments(alignmentHeaders, alignmentRows)
+	want := []Alignment{AlignRight, AlignLeft, AlignLeft, AlignRight, AlignCenter}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DefaultAlignments() = %v, want %v", got, want)
+	}
+
+	// Well-known columns are found by their header under --lang en as well
+	english := []string{"No.", "Case Name", "Decision Date", "Reply Date"}
+	got = DefaultAlignments(english, [][]string{{"1", "손해배상", "2024-03-15", "2024-03-15"}})
+	want = []Alignment{AlignRight, AlignLeft, AlignCenter, AlignCenter}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DefaultAlignments(%v) = %v, want %v", english, got, want)
+	}
+
+	// A column without any value is text
+	if got := DefaultAlignments([]string{"비고"}, [][]string{{""}, {"-"}}); got[0] != AlignLeft {
+		t.Errorf("empty column alignment = %v, want AlignLeft", got[0])
+	}
+}
+
+func TestIsNumber(t *testing.T) {
+	tests := map[string]bool{
+		"0": true, "42": true, "1,234": true, "-5": true,
+		"": false, "12a": false, ",1": false, "1,": false, "제1조": false, "2024-01-01": false,
+	}
+	for input, want := range tests {
+		if got := isNumber(input); got != want {
+			t.Errorf("isNumber(%q) = %v, want %v", input, got, want)
+		}
+	}
+}
+
+func TestRenderTableAlignment(t *testing.T) {
+	style := &TableStyle{BoxDrawing: true}
+	assertGolden(t, "table_default_align", RenderTable(alignmentHeaders, alignmentRows, style))
+
+	style.ColumnAlignments = []Alignment{AlignDefault, AlignCenter, AlignRight}
+	assertGolden(t, "table_custom_align", RenderTable(alignmentHeaders, alignmentRows, style))
+}
+
+func TestRenderMarkdownTableAlignment(t *testing.T) {
+	assertGolden(t, "markdown_default_align", RenderMarkdownTable(alignmentHeaders, alignmentRows))
+	assertGolden(t, "markdown_custom_align", RenderMarkdownTableWithAlignments(alignmentHeaders, alignmentRows,
+		[]Alignment{AlignDefault, AlignCenter, AlignRight}))
+}
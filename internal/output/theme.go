@@ -0,0 +1,87 @@
+package output
+
+import (
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+// Theme is the colors of table output. It is only applied when the table
+// style has UseColor set, so that no escape codes reach CSV, JSON or other
+// formats read by programs.
+type Theme struct {
+	// Header is the color of the column headers
+	Header *color.Color
+	// Upcoming is the color of the effective date of laws not yet in force
+	Upcoming *color.Color
+	// Repealed is the color of the rows of repealed laws
+	Repealed *color.Color
+	// LawTypes maps a law type, such as "법률", to its color
+	LawTypes map[string]*color.Color
+}
+
+// DefaultTheme is the theme used by the tables of search results
+var DefaultTheme = &Theme{
+	Header:   themeColor(color.FgCyan, color.Bold),
+	Upcoming: themeColor(color.FgYellow),
+	Repealed: themeColor(color.FgHiBlack),
+	LawTypes: map[string]*color.Color{
+		"법률":   themeColor(color.FgGreen),
+		"대통령령": themeColor(color.FgCyan),
+		"총리령":  themeColor(color.FgMagenta),
+		"부령":   themeColor(color.FgMagenta),
+	},
+}
+
+// themeColor returns a color that always writes its escape codes. Whether to
+// color is decided by TableStyle.UseColor, which already honors NO_COLOR,
+// --no-color and output that is not a terminal.
+func themeColor(attrs ...color.Attribute) *color.Color {
+	c := color.New(attrs...)
+	c.EnableColor()
+	return c
+}
+
+// paintLines colors each line of s on its own, so that the color of a cell
+// wrapped over several lines does not run into the table borders
+func paintLines(c *color.Color, s string) string {
+	if c == nil || s == "" {
+		return s
+	}
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		if line != "" {
+			lines[i] = c.Sprint(line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// isRepealed reports whether the revision of a law repealed it
+func isRepealed(law api.LawInfo) bool {
+	return strings.Contains(law.Category, "폐지")
+}
+
+// lawCellColors returns the CellColor of a table of laws, given the columns
+// of their law type and effective date (-1 if absent). Repealed laws are
+// grayed out, laws not yet in force have their date highlighted, and law
+// types get the color of the theme.
+func (t *Theme) lawCellColors(laws []api.LawInfo, typeColumn, dateColumn int, now time.Time) func(row, column int) *color.Color {
+	return func(row, column int) *color.Color {
+		if row < 0 || row >= len(laws) {
+			return nil
+		}
+		law := laws[row]
+		switch {
+		case isRepealed(law):
+			return t.Repealed
+		case column == dateColumn && law.EffectStatusAt(now) == api.EffectUpcoming:
+			return t.Upcoming
+		case column == typeColumn:
+			return t.LawTypes[law.LawType]
+		}
+		return nil
+	}
+}
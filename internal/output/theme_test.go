@@ -0,0 +1,115 @@
+package output
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/fatih/color"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+func themeTestResponse() *api.SearchResponse {
+	return &api.SearchResponse{TotalCount: 3, Page: 1, Laws: []api.LawInfo{
+		{ID: "001", Name: "주차장법", LawType: "법률", Department: "국토교통부", EffectDate: "20200101"},
+		{ID: "002", Name: "미래법", LawType: "대통령령", Department: "법제처", EffectDate: "20991231"},
+		{ID: "003", Name: "옛법", LawType: "부령", Department: "법제처", EffectDate: "19900101", Category: "폐지"},
+	}}
+}
+
+func TestFormatTableColor(t *testing.T) {
+	resp := themeTestResponse()
+
+	t.Run("colored", func(t *testing.T) {
+		out, err := NewFormatter("table").WithColor(true).FormatSearchResultToString(resp)
+		if err != nil {
+			t.Fatalf("FormatSearchResultToString() error = %v", err)
+		}
+		for _, want := range []string{
+			DefaultTheme.Header.Sprint("법령명"),
+			DefaultTheme.Upcoming.Sprint("2099-12-31"),
+			DefaultTheme.LawTypes["법률"].Sprint("법률"),
+			DefaultTheme.Repealed.Sprint("옛법"),
+			DefaultTheme.Repealed.Sprint("1990-01-01"),
+		} {
+			if !strings.Contains(out, want) {
+				t.Errorf("output missing %q:\n%s", want, out)
+			}
+		}
+		// Dates of laws in force stay plain
+		if strings.Contains(out, DefaultTheme.Upcoming.Sprint("2020-01-01")) {
+			t.Errorf("date of a law in force is highlighted:\n%s", out)
+		}
+	})
+
+	t.Run("plain", func(t *testing.T) {
+		out, err := NewFormatter("table").WithColor(false).FormatSearchResultToString(resp)
+		if err != nil {
+			t.Fatalf("FormatSearchResultToString() error = %v", err)
+		}
+		if strings.Contains(out, "\x1b[") {
+			t.Errorf("output has escape codes:\n%q", out)
+		}
+		if !strings.Contains(out, "2099-12-31") || !strings.Contains(out, "옛법") {
+			t.Errorf("output missing rows:\n%s", out)
+		}
+	})
+
+	t.Run("same layout", func(t *testing.T) {
+		colored, _ := NewFormatter("table").WithColor(true).FormatSearchResultToString(resp)
+		plain, _ := NewFormatter("table").WithColor(false).FormatSearchResultToString(resp)
+		if got := stripANSI(colored); got != plain {
+			t.Errorf("colored table differs from the plain one without escape codes:\n%s\n---\n%s", got, plain)
+		}
+	})
+}
+
+func TestFormatColorNeverInDataFormats(t *testing.T) {
+	resp := themeTestResponse()
+	for _, format := range []string{"json", "ndjson", "csv", "xml", "markdown", "html"} {
+		t.Run(format, func(t *testing.T) {
+			out, err := NewFormatter(format).WithColor(true).FormatSearchResultToString(resp)
+			if err != nil {
+				t.Fatalf("FormatSearchResultToString() error = %v", err)
+			}
+			if strings.Contains(out, "\x1b[") {
+				t.Errorf("%s output has escape codes:\n%q", format, out)
+			}
+		})
+	}
+}
+
+func TestRenderTableCellColor(t *testing.T) {
+	headers := []string{"이름", "값"}
+	rows := [][]string{{"가", "1"}, {"나", "2"}}
+	cellColor := func(row, column int) *color.Color {
+		if row == 1 && column == 0 {
+			return DefaultTheme.Repealed
+		}
+		return nil
+	}
+
+	colored := RenderTable(headers, rows, &TableStyle{UseColor: true, BoxDrawing: true, CellColor: cellColor})
+	if !strings.Contains(colored, DefaultTheme.Repealed.Sprint("나")) {
+		t.Errorf("colored table missing the cell color:\n%s", colored)
+	}
+	if strings.Contains(colored, DefaultTheme.Repealed.Sprint("가")) {
+		t.Errorf("colored table colors other cells:\n%s", colored)
+	}
+
+	plain := RenderTable(headers, rows, &TableStyle{BoxDrawing: true, CellColor: cellColor})
+	if strings.Contains(plain, "\x1b[") {
+		t.Errorf("table without UseColor has escape codes:\n%q", plain)
+	}
+}
+
+func TestPaintLines(t *testing.T) {
+	c := DefaultTheme.Repealed
+	got := paintLines(c, "첫 줄\n\n둘째 줄")
+	want := c.Sprint("첫 줄") + "\n\n" + c.Sprint("둘째 줄")
+	if got != want {
+		t.Errorf("paintLines() = %q, want %q", got, want)
+	}
+	if got := paintLines(nil, "text"); got != "text" {
+		t.Errorf("paintLines(nil) = %q, want plain text", got)
+	}
+}
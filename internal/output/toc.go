@@ -0,0 +1,137 @@
+package output
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mattn/go-runewidth"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+// TOCEntry is one article in the table of contents of a law
+type TOCEntry struct {
+	// Number is the normalized article number, such as "58" or "58의2"
+	Number string
+	// Title is the article title, empty when the article has none
+	Title string
+}
+
+// Label returns the article number as written in laws, such as "제58조의2"
+func (e TOCEntry) Label() string {
+	main, branch, _ := strings.Cut(e.Number, "의")
+	if branch != "" {
+		return "제" + main + "조의" + branch
+	}
+	return "제" + main + "조"
+}
+
+// Anchor returns the id of the article heading in markdown output
+func (e TOCEntry) Anchor() string {
+	return ArticleAnchor(e.Number)
+}
+
+// ArticleAnchor returns the markdown anchor id of an article number ("58의2" -> "article-58-2")
+func ArticleAnchor(number string) string {
+	return "article-" + strings.ReplaceAll(number, "의", "-")
+}
+
+// WithTOC adds a table of contents of the articles before the body of law details
+func (f *Formatter) WithTOC(toc bool) *Formatter {
+	f.toc = toc
+	return f
+}
+
+// BuildTOC returns the table of contents of the articles, ordered by article
+// number (제2조 before 제10조, 제5조 before 제5조의2). Entries that are not
+// articles, such as chapter headings, are left out and an article number
+// that appears more than once is listed once.
+func BuildTOC(articles []api.Article) []TOCEntry {
+	seen := make(map[string]bool)
+	var entries []TOCEntry
+	for _, article := range articles {
+		number := articleKey(article)
+		if number == "" || seen[number] {
+			continue
+		}
+		seen[number] = true
+		entries = append(entries, TOCEntry{Number: number, Title: strings.TrimSpace(article.Title)})
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		mi, bi := articleOrder(entries[i].Number)
+		mj, bj := articleOrder(entries[j].Number)
+		if mi != mj {
+			return mi < mj
+		}
+		return bi < bj
+	})
+	return entries
+}
+
+// articleOrder returns the article and branch numbers of a normalized article number
+func articleOrder(number string) (int, int) {
+	main, branch, _ := strings.Cut(number, "의")
+	m, _ := strconv.Atoi(main)
+	b, _ := strconv.Atoi(branch)
+	return m, b
+}
+
+// formatTOCTable renders the table of contents for the terminal, with a hint
+// on how to show a single article
+func (f *Formatter) formatTOCTable(entries []TOCEntry) string {
+	if len(entries) == 0 {
+		return ""
+	}
+
+	width := 0
+	for _, entry := range entries {
+		if w := runewidth.StringWidth(entry.Label()); w > width {
+			width = w
+		}
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "\n───────────────────────────────────────────────────────────\n")
+	fmt.Fprintf(&buf, " %s\n", f.t("output.toc.title", len(entries)))
+	fmt.Fprintf(&buf, "───────────────────────────────────────────────────────────\n\n")
+	for _, entry := range entries {
+		if entry.Title == "" {
+			fmt.Fprintf(&buf, "  %s\n", entry.Label())
+			continue
+		}
+		fmt.Fprintf(&buf, "  %s  %s\n", runewidth.FillRight(entry.Label(), width), entry.Title)
+	}
+	fmt.Fprintf(&buf, "\n%s\n", f.t("output.toc.hint", entries[0].Number))
+	return buf.String()
+}
+
+// formatTOCMarkdown renders the table of contents as a list of links to the article anchors
+func (f *Formatter) formatTOCMarkdown(entries []TOCEntry) string {
+	if len(entries) == 0 {
+		return ""
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "## %s\n\n", f.t("output.toc.heading"))
+	for _, entry := range entries {
+		fmt.Fprintf(&buf, "- [%s](#%s)\n", escapeMarkdownLinkText(tocHeading(entry)), entry.Anchor())
+	}
+	fmt.Fprintf(&buf, "\n")
+	return buf.String()
+}
+
+// tocHeading returns "제N조 (제목)", or the number alone when the article has no title
+func tocHeading(entry TOCEntry) string {
+	if entry.Title == "" {
+		return entry.Label()
+	}
+	return entry.Label() + " (" + entry.Title + ")"
+}
+
+// escapeMarkdownLinkText escapes the characters that would end a markdown link text
+func escapeMarkdownLinkText(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "[", `\[`, "]", `\]`).Replace(s)
+}
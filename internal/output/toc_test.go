@@ -0,0 +1,118 @@
+package output
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+var tocTestArticles = []api.Article{
+	{Number: "1", Content: "제1장 총칙"},
+	{Number: "10", Title: "벌칙", Content: "제10조(벌칙) 위반한 자는 벌금에 처한다."},
+	{Number: "2", Title: "정의", Content: "제2조(정의) 이 법에서 사용하는 용어의 뜻은 다음과 같다."},
+	{Number: "5", Content: "제5조의2 삭제"},
+	{Number: "5", Title: "신고", Content: "제5조(신고) 신고하여야 한다."},
+	{Number: "1", Title: "목적", Content: "제1조(목적) 이 법은 [안전]을 목적으로 한다."},
+}
+
+func TestBuildTOC(t *testing.T) {
+	entries := BuildTOC(tocTestArticles)
+
+	want := []TOCEntry{
+		{Number: "1", Title: "목적"},
+		{Number: "2", Title: "정의"},
+		{Number: "5", Title: "신고"},
+		{Number: "5의2", Title: ""},
+		{Number: "10", Title: "벌칙"},
+	}
+	if len(entries) != len(want) {
+		t.Fatalf("BuildTOC() returned %d entries, want %d: %+v", len(entries), len(want), entries)
+	}
+	for i := range want {
+		if entries[i] != want[i] {
+			t.Errorf("entry %d = %+v, want %+v", i, entries[i], want[i])
+		}
+	}
+}
+
+func TestTOCEntryLabelAndAnchor(t *testing.T) {
+	tests := []struct {
+		entry  TOCEntry
+		label  string
+		anchor string
+	}{
+		{TOCEntry{Number: "58"}, "제58조", "article-58"},
+		{TOCEntry{Number: "58의2"}, "제58조의2", "article-58-2"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.entry.Label(); got != tt.label {
+			t.Errorf("Label() = %q, want %q", got, tt.label)
+		}
+		if got := tt.entry.Anchor(); got != tt.anchor {
+			t.Errorf("Anchor() = %q, want %q", got, tt.anchor)
+		}
+	}
+}
+
+func TestFormatDetailTOCTable(t *testing.T) {
+	detail := &api.LawDetail{LawInfo: api.LawInfo{Name: "테스트법"}, Articles: tocTestArticles}
+
+	out, err := NewFormatter("table").WithTOC(true).FormatDetailToStringWithOptions(detail, true, false, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, want := range []string{"목차 (5개 조문)", "제2조     정의", "제5조의2\n", "--article 1"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output should contain %q:\n%s", want, out)
+		}
+	}
+	if strings.Index(out, "목차") > strings.Index(out, " 조문 (") {
+		t.Errorf("table of contents should come before the articles:\n%s", out)
+	}
+
+	out, _ = NewFormatter("table").FormatDetailToStringWithOptions(detail, true, false, false)
+	if strings.Contains(out, "목차") {
+		t.Errorf("table of contents should only be shown with WithTOC:\n%s", out)
+	}
+
+	out, _ = NewFormatter("table").WithLanguage("en").WithTOC(true).FormatDetailToStringWithOptions(detail, false, false, false)
+	if !strings.Contains(out, " Table of Contents (5 articles)") || !strings.Contains(out, "(e.g. --article 1)") || strings.Contains(out, "목차") {
+		t.Errorf("table of contents should be in English with --lang en:\n%s", out)
+	}
+}
+
+func TestFormatDetailTOCMarkdownAnchors(t *testing.T) {
+	detail := &api.LawDetail{LawInfo: api.LawInfo{Name: "테스트법"}, Articles: tocTestArticles}
+
+	out, err := NewFormatter("markdown").WithTOC(true).FormatDetailToStringWithOptions(detail, true, false, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, want := range []string{"## 목차", "- [제1조 (목적)](#article-1)", "- [제5조의2](#article-5-2)", "### 제10조 (벌칙)"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output should contain %q:\n%s", want, out)
+		}
+	}
+
+	// Every link of the table of contents must point to an anchor of the document
+	links := regexp.MustCompile(`\]\(#([^)]+)\)`).FindAllStringSubmatch(out, -1)
+	if len(links) != 5 {
+		t.Fatalf("expected 5 table of contents links, got %d:\n%s", len(links), out)
+	}
+	for _, link := range links {
+		anchor := `<a id="` + link[1] + `"></a>`
+		if strings.Count(out, anchor) != 1 {
+			t.Errorf("anchor %q should appear exactly once:\n%s", anchor, out)
+		}
+	}
+
+	// The article heading is not repeated in the body
+	if strings.Contains(out, "제2조(정의)") {
+		t.Errorf("article body should not repeat its heading:\n%s", out)
+	}
+}
@@ -0,0 +1,163 @@
+package output
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+// LawTreeNode is a law with the subordinate laws found for it in the same results
+type LawTreeNode struct {
+	Law      api.LawInfo
+	Children []*LawTreeNode
+}
+
+// Ranks of the legal hierarchy used to relate laws. Local ordinances sit below
+// national laws with the same scheme: 조례 is delegated by 법률, 규칙 by 조례.
+const (
+	rankUnknown = iota
+	rankConstitution
+	rankAct
+	rankDecree
+	rankRule
+)
+
+// subordinateSuffixes are the name suffixes of subordinate laws and their rank.
+// The name without the suffix is the name of the parent law.
+var subordinateSuffixes = []struct {
+	suffix string
+	rank   int
+}{
+	{"시행규칙", rankRule},
+	{"시행령", rankDecree},
+}
+
+// lawRank returns the rank of a law in the hierarchy and the name it shares
+// with its parent: "도로교통법 시행령" is rankDecree under "도로교통법".
+func lawRank(law api.LawInfo) (int, string) {
+	name := strings.TrimSpace(law.Name)
+	for _, s := range subordinateSuffixes {
+		if base, ok := strings.CutSuffix(name, s.suffix); ok && strings.TrimSpace(base) != "" {
+			return s.rank, strings.TrimSpace(base)
+		}
+	}
+
+	lawType := strings.TrimSpace(law.LawType)
+	switch {
+	case lawType == "헌법":
+		return rankConstitution, name
+	case lawType == "법률", lawType == "조례":
+		return rankAct, name
+	case lawType == "대통령령", lawType == "규칙":
+		return rankDecree, name
+	case lawType == "총리령", strings.HasSuffix(lawType, "부령"):
+		return rankRule, name
+	}
+	return rankUnknown, name
+}
+
+// BuildLawTree arranges laws into trees of parent and subordinate laws
+// (법률 - 시행령 - 시행규칙, 조례 - 시행규칙), guessing the relation from the law
+// names and types:
+//
+//   - a law is subordinate to another when its name is the other's name (or
+//     abbreviation) followed by 시행령 or 시행규칙, and its rank is lower
+//   - of several candidates the closest rank is the parent, so a 시행규칙 goes
+//     under the 시행령 when both are in the results
+//   - a law whose parent is not in the results, whose rank is unknown or whose
+//     candidates are ambiguous (same name and rank) stays a root
+//
+// Roots and children keep the order of laws.
+func BuildLawTree(laws []api.LawInfo) []*LawTreeNode {
+	nodes := make([]*LawTreeNode, len(laws))
+	ranks := make([]int, len(laws))
+	bases := make([]string, len(laws))
+	for i, law := range laws {
+		nodes[i] = &LawTreeNode{Law: law}
+		ranks[i], bases[i] = lawRank(law)
+	}
+
+	var roots []*LawTreeNode
+	for i, law := range laws {
+		parent := -1
+		ambiguous := false
+		// Only laws named with a subordinate suffix are related to a parent
+		if ranks[i] != rankUnknown && bases[i] != strings.TrimSpace(law.Name) {
+			for j, candidate := range laws {
+				if j == i || ranks[j] == rankUnknown || ranks[j] >= ranks[i] {
+					continue
+				}
+				if bases[j] != bases[i] && strings.TrimSpace(candidate.NameAbbrev) != bases[i] {
+					continue
+				}
+				switch {
+				case parent < 0 || ranks[j] > ranks[parent]:
+					parent, ambiguous = j, false
+				case ranks[j] == ranks[parent]:
+					ambiguous = true
+				}
+			}
+		}
+
+		if parent < 0 || ambiguous {
+			roots = append(roots, nodes[i])
+			continue
+		}
+		nodes[parent].Children = append(nodes[parent].Children, nodes[i])
+	}
+	return roots
+}
+
+// FormatLawTree renders search results as indented trees of parent and
+// subordinate laws, noting that the relations are guessed
+func (f *Formatter) FormatLawTree(resp *api.SearchResponse) string {
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "%s\n\n", f.t("output.summary", resp.TotalCount, f.fetchedNote(resp)))
+	fmt.Fprint(&buf, FormatWarnings(resp.Warnings))
+
+	if len(resp.Laws) == 0 {
+		fmt.Fprintln(&buf, f.t("output.table.noResults"))
+		return buf.String()
+	}
+
+	for _, root := range BuildLawTree(resp.Laws) {
+		writeLawTreeNode(&buf, root, "", "")
+	}
+
+	fmt.Fprintf(&buf, "\n%s\n", f.t("output.tree.guessNote"))
+	fmt.Fprintf(&buf, "%s\n", f.t("output.tree.rootNote"))
+
+	// Subordinate laws on other pages are not related, so point to the other pages
+	if resp.PageableCount() > len(resp.Laws) {
+		fmt.Fprint(&buf, f.pageHint(NewPageMeta(resp, f.pageSize)))
+	}
+	return buf.String()
+}
+
+// writeLawTreeNode writes a node after prefix and its children below it,
+// indented with childPrefix
+func writeLawTreeNode(buf *bytes.Buffer, node *LawTreeNode, prefix, childPrefix string) {
+	fmt.Fprintf(buf, "%s%s\n", prefix, lawTreeLabel(node.Law))
+	for i, child := range node.Children {
+		if i == len(node.Children)-1 {
+			writeLawTreeNode(buf, child, childPrefix+"└── ", childPrefix+"    ")
+		} else {
+			writeLawTreeNode(buf, child, childPrefix+"├── ", childPrefix+"│   ")
+		}
+	}
+}
+
+// lawTreeLabel returns "법령명 [법령구분] 상세 조회 ID"
+func lawTreeLabel(law api.LawInfo) string {
+	label := strings.TrimSpace(law.Name)
+	if law.LawType != "" {
+		label += " [" + law.LawType + "]"
+	}
+	if id := api.DetailID(law); id != "" {
+		label += " " + id
+	}
+	return label
+}
@@ -0,0 +1,140 @@
+package output
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+// treeShape renders a tree as "name(child(grandchild),child)" for comparison
+func treeShape(nodes []*LawTreeNode) string {
+	var parts []string
+	for _, node := range nodes {
+		part := node.Law.Name
+		if len(node.Children) > 0 {
+			part += "(" + treeShape(node.Children) + ")"
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, ",")
+}
+
+func TestBuildLawTree(t *testing.T) {
+	tests := []struct {
+		name string
+		laws []api.LawInfo
+		want string
+	}{
+		{
+			name: "act, decree and rule",
+			laws: []api.LawInfo{
+				{Name: "도로교통법 시행규칙", LawType: "행정안전부령"},
+				{Name: "도로교통법", LawType: "법률"},
+				{Name: "도로교통법 시행령", LawType: "대통령령"},
+			},
+			want: "도로교통법(도로교통법 시행령(도로교통법 시행규칙))",
+		},
+		{
+			name: "rule goes under the act without a decree",
+			laws: []api.LawInfo{
+				{Name: "주차장법", LawType: "법률"},
+				{Name: "주차장법 시행규칙", LawType: "국토교통부령"},
+			},
+			want: "주차장법(주차장법 시행규칙)",
+		},
+		{
+			name: "parent not in the results stays a root",
+			laws: []api.LawInfo{
+				{Name: "건축법 시행령", LawType: "대통령령"},
+				{Name: "건축법 시행규칙", LawType: "국토교통부령"},
+				{Name: "민법", LawType: "법률"},
+			},
+			want: "건축법 시행령(건축법 시행규칙),민법",
+		},
+		{
+			name: "name prefix alone is not a relation",
+			laws: []api.LawInfo{
+				{Name: "개인정보 보호법", LawType: "법률"},
+				{Name: "개인정보 보호법 시행령", LawType: "대통령령"},
+				{Name: "개인정보 보호위원회 직제", LawType: "대통령령"},
+			},
+			want: "개인정보 보호법(개인정보 보호법 시행령),개인정보 보호위원회 직제",
+		},
+		{
+			name: "abbreviation of the parent",
+			laws: []api.LawInfo{
+				{Name: "테스트에 관한 특별법", NameAbbrev: "테스트법", LawType: "법률"},
+				{Name: "테스트법 시행령", LawType: "대통령령"},
+			},
+			want: "테스트에 관한 특별법(테스트법 시행령)",
+		},
+		{
+			name: "ambiguous parents stay roots",
+			laws: []api.LawInfo{
+				{Name: "도로법", LawType: "법률", SerialNo: "1"},
+				{Name: "도로법", LawType: "법률", SerialNo: "2"},
+				{Name: "도로법 시행령", LawType: "대통령령"},
+			},
+			want: "도로법,도로법,도로법 시행령",
+		},
+		{
+			name: "ordinance and its rule",
+			laws: []api.LawInfo{
+				{Name: "서울특별시 주차장 설치 및 관리 조례", LawType: "조례", Source: "자치법규"},
+				{Name: "서울특별시 주차장 설치 및 관리 조례 시행규칙", LawType: "규칙", Source: "자치법규"},
+				{Name: "부산광역시 주차장 설치 및 관리 조례", LawType: "조례", Source: "자치법규"},
+			},
+			want: "서울특별시 주차장 설치 및 관리 조례(서울특별시 주차장 설치 및 관리 조례 시행규칙),부산광역시 주차장 설치 및 관리 조례",
+		},
+		{
+			name: "unknown type with a known name suffix",
+			laws: []api.LawInfo{
+				{Name: "소방기본법"},
+				{Name: "소방기본법 시행령", LawType: "대통령령"},
+			},
+			want: "소방기본법,소방기본법 시행령",
+		},
+		{
+			name: "empty",
+			laws: nil,
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := treeShape(BuildLawTree(tt.laws)); got != tt.want {
+				t.Errorf("BuildLawTree() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatLawTree(t *testing.T) {
+	resp := &api.SearchResponse{
+		TotalCount: 30,
+		Page:       1,
+		Laws: []api.LawInfo{
+			{Name: "도로교통법", LawType: "법률", SerialNo: "100"},
+			{Name: "도로교통법 시행령", LawType: "대통령령", SerialNo: "200"},
+			{Name: "도로교통법 시행규칙", LawType: "행정안전부령", SerialNo: "300"},
+			{Name: "도로교통공단법", LawType: "법률", SerialNo: "400"},
+			{Name: "도로교통공단법 시행령", LawType: "대통령령", SerialNo: "500"},
+		},
+	}
+
+	got := NewFormatter("table").WithPagination("", 5).FormatLawTree(resp)
+
+	want := `도로교통법 [법률] 100
+└── 도로교통법 시행령 [대통령령] 200
+    └── 도로교통법 시행규칙 [행정안전부령] 300
+도로교통공단법 [법률] 400
+└── 도로교통공단법 시행령 [대통령령] 500
+`
+	for _, s := range []string{"총 30개의 법령을 찾았습니다.", want, "추정한 것으로 실제 위임 관계와 다를 수 있습니다", "페이지 1/6"} {
+		if !strings.Contains(got, s) {
+			t.Errorf("FormatLawTree() should contain %q, got:\n%s", s, got)
+		}
+	}
+}
@@ -0,0 +1,256 @@
+package output
+
+import (
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/mattn/go-runewidth"
+	"github.com/olekukonko/tablewriter"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/i18n"
+	"golang.org/x/term"
+)
+
+const (
+	// minColumnWidth is the narrowest a column is shrunk to, in terminal cells
+	minColumnWidth = 4
+	// minFlexibleWidth is the width a flexible column keeps before the fixed
+	// columns are shrunk, so that a long 법령명 stays readable
+	minFlexibleWidth = 20
+)
+
+// flexibleHeaders are the columns with free-form text that receive the space
+// left over once the other columns fit, by the message ID of their header
+var flexibleHeaders = []string{
+	"output.table.law",
+	"output.table.citedLaw",
+	"output.table.title",
+	"output.table.content",
+	"output.table.detail",
+	"output.table.query",
+	"output.table.caseName",
+	"output.table.subject",
+}
+
+// isFlexibleHeader reports whether header is one of flexibleHeaders in one
+// of langs
+func isFlexibleHeader(header string, langs []string) bool {
+	for _, id := range flexibleHeaders {
+		if headerIs(header, id, langs) {
+			return true
+		}
+	}
+	return false
+}
+
+// widthOverride is the table width forced by --width, 0 to detect it
+var widthOverride int
+
+// SetTableWidth forces the width tables are fitted to; 0 restores detection
+func SetTableWidth(width int) {
+	if width < 0 {
+		width = 0
+	}
+	widthOverride = width
+}
+
+// tableWidth returns the width tables are fitted to, or 0 to keep the default
+// column widths. --width wins; otherwise the width of the terminal is used,
+// falling back to the COLUMNS environment variable when its size is unavailable.
+func tableWidth() int {
+	if widthOverride > 0 {
+		return widthOverride
+	}
+	return detectTerminalWidth(isTerminal(), func() (int, error) {
+		width, _, err := term.GetSize(int(os.Stdout.Fd()))
+		return width, err
+	}, os.Getenv("COLUMNS"))
+}
+
+// detectTerminalWidth returns the terminal width, or 0 when output is not a
+// terminal or its width cannot be determined
+func detectTerminalWidth(terminal bool, size func() (int, error), columns string) int {
+	if !terminal {
+		return 0
+	}
+	if width, err := size(); err == nil && width > 0 {
+		return width
+	}
+	if width, err := strconv.Atoi(strings.TrimSpace(columns)); err == nil && width > 0 {
+		return width
+	}
+	return 0
+}
+
+// tableOverhead returns the cells used by borders, separators and padding
+func tableOverhead(columns int) int {
+	return 3*columns + 1
+}
+
+// ColumnWidths distributes total terminal cells among the columns. Columns that
+// fit keep their natural width (the widest cell, counting double-width Hangul as
+// two cells and ignoring color codes). When the table is too wide, the fixed columns keep their natural
+// width where possible and the flexible columns such as 법령명 share what is left.
+// If that leaves a flexible column narrower than minFlexibleWidth, the fixed
+// columns are shrunk as well, but no column goes below its header width.
+func ColumnWidths(headers []string, rows [][]string, total int) []int {
+	natural := make([]int, len(headers))
+	floor := make([]int, len(headers))
+	for i, h := range headers {
+		natural[i] = tablewriter.DisplayWidth(h)
+		floor[i] = natural[i]
+		if floor[i] < minColumnWidth {
+			floor[i] = minColumnWidth
+		}
+	}
+	for _, row := range rows {
+		for i := 0; i < len(row) && i < len(headers); i++ {
+			for _, line := range strings.Split(row[i], "\n") {
+				if w := tablewriter.DisplayWidth(line); w > natural[i] {
+					natural[i] = w
+				}
+			}
+		}
+	}
+	for i := range floor {
+		if floor[i] > natural[i] {
+			floor[i] = natural[i]
+		}
+	}
+
+	available := total - tableOverhead(len(headers))
+	if sum(natural) <= available {
+		return natural
+	}
+
+	var flexible, fixed []int
+	langs := i18n.Languages()
+	for i, h := range headers {
+		if isFlexibleHeader(h, langs) {
+			flexible = append(flexible, i)
+		} else {
+			fixed = append(fixed, i)
+		}
+	}
+	if len(flexible) == 0 {
+		flexible, fixed = fixed, nil
+	}
+
+	widths := make([]int, len(headers))
+	fixedWidth, flexibleReserve := 0, 0
+	for _, i := range fixed {
+		widths[i] = natural[i]
+		fixedWidth += natural[i]
+	}
+	for _, i := range flexible {
+		reserve := minFlexibleWidth
+		if reserve < floor[i] {
+			reserve = floor[i]
+		}
+		if reserve > natural[i] {
+			reserve = natural[i]
+		}
+		flexibleReserve += reserve
+	}
+
+	// Fixed columns give up space only when the flexible ones cannot keep their reserve
+	if fixedWidth+flexibleReserve > available {
+		shrink(widths, natural, floor, fixed, available-flexibleReserve)
+		fixedWidth = 0
+		for _, i := range fixed {
+			fixedWidth += widths[i]
+		}
+	}
+	shrink(widths, natural, floor, flexible, available-fixedWidth)
+	return widths
+}
+
+// shrink sets widths[i] for the given columns so that they add up to at most
+// budget, lowering the widest columns first (max-min fair) and never going
+// below floor
+func shrink(widths, natural, floor, columns []int, budget int) {
+	for _, i := range columns {
+		widths[i] = natural[i]
+	}
+	for {
+		total, widest := 0, -1
+		for _, i := range columns {
+			total += widths[i]
+			if widths[i] > floor[i] && (widest < 0 || widths[i] > widths[widest]) {
+				widest = i
+			}
+		}
+		if total <= budget || widest < 0 {
+			return
+		}
+		widths[widest]--
+	}
+}
+
+// sum adds up a slice of widths
+func sum(values []int) int {
+	total := 0
+	for _, v := range values {
+		total += v
+	}
+	return total
+}
+
+// wrapCell wraps text into lines of at most width cells, breaking at spaces
+// where possible and inside words that are wider than the column
+func wrapCell(text string, width int) string {
+	if width <= 0 {
+		return text
+	}
+
+	var lines []string
+	for _, paragraph := range strings.Split(text, "\n") {
+		// Short cells are kept as is, which also leaves colored values intact
+		if tablewriter.DisplayWidth(paragraph) <= width {
+			lines = append(lines, paragraph)
+			continue
+		}
+
+		line, lineWidth := "", 0
+		for _, word := range strings.Fields(paragraph) {
+			wordWidth := runewidth.StringWidth(word)
+			switch {
+			case lineWidth == 0:
+			case lineWidth+1+wordWidth <= width:
+				line += " "
+				lineWidth++
+			default:
+				lines = append(lines, line)
+				line, lineWidth = "", 0
+			}
+
+			// Break words that cannot fit on a line of their own (the line is empty here)
+			for wordWidth > width {
+				head, rest := splitAtWidth(word, width)
+				if head == "" {
+					// Not even one character fits; emit it anyway to make progress
+					head, rest = splitAtWidth(word, runewidth.RuneWidth([]rune(word)[0]))
+				}
+				lines = append(lines, head)
+				word, wordWidth = rest, runewidth.StringWidth(rest)
+			}
+			line += word
+			lineWidth += wordWidth
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// splitAtWidth splits s after as many runes as fit in width cells
+func splitAtWidth(s string, width int) (string, string) {
+	used := 0
+	for i, r := range s {
+		w := runewidth.RuneWidth(r)
+		if used+w > width {
+			return s[:i], s[i:]
+		}
+		used += w
+	}
+	return s, ""
+}
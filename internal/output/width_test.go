@@ -0,0 +1,181 @@
+package output
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mattn/go-runewidth"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+func TestDetectTerminalWidth(t *testing.T) {
+	sized := func(width int) func() (int, error) {
+		return func() (int, error) { return width, nil }
+	}
+	failing := func() (int, error) { return 0, errors.New("not a tty") }
+
+	tests := []struct {
+		name     string
+		terminal bool
+		size     func() (int, error)
+		columns  string
+		want     int
+	}{
+		{"not a terminal", false, sized(100), "80", 0},
+		{"terminal size", true, sized(100), "80", 100},
+		{"COLUMNS fallback", true, failing, "80", 80},
+		{"zero size uses COLUMNS", true, sized(0), " 72 ", 72},
+		{"invalid COLUMNS", true, failing, "wide", 0},
+		{"unknown width", true, failing, "", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := detectTerminalWidth(tt.terminal, tt.size, tt.columns); got != tt.want {
+				t.Errorf("detectTerminalWidth() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTableWidthOverride(t *testing.T) {
+	defer SetTableWidth(0)
+
+	SetTableWidth(90)
+	if got := tableWidth(); got != 90 {
+		t.Errorf("tableWidth() = %d, want 90", got)
+	}
+
+	SetTableWidth(-5)
+	if widthOverride != 0 {
+		t.Errorf("negative width should restore detection, got override %d", widthOverride)
+	}
+}
+
+func TestColumnWidths(t *testing.T) {
+	headers := []string{"번호", "법령명", "법령구분", "시행일자"}
+	rows := [][]string{
+		{"1", "개인정보 보호법 시행령", "대통령령", "2024-03-15"},
+		{"2", "도로교통법", "법률", "2023-10-19"},
+	}
+
+	t.Run("fits naturally", func(t *testing.T) {
+		// Hangul counts as two cells: "개인정보 보호법 시행령" is 22 wide
+		want := []int{4, 22, 8, 10}
+		if got := ColumnWidths(headers, rows, 120); !reflect.DeepEqual(got, want) {
+			t.Errorf("ColumnWidths() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("law name absorbs the shrink", func(t *testing.T) {
+		// 13 cells of borders and padding leave 42 for the columns
+		want := []int{4, 20, 8, 10}
+		if got := ColumnWidths(headers, rows, 55); !reflect.DeepEqual(got, want) {
+			t.Errorf("ColumnWidths() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("fixed columns shrink for a readable law name", func(t *testing.T) {
+		want := []int{4, 20, 8, 8}
+		if got := ColumnWidths(headers, rows, 53); !reflect.DeepEqual(got, want) {
+			t.Errorf("ColumnWidths() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("never below the header", func(t *testing.T) {
+		want := []int{4, 6, 8, 8}
+		if got := ColumnWidths(headers, rows, 20); !reflect.DeepEqual(got, want) {
+			t.Errorf("ColumnWidths() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("english headers", func(t *testing.T) {
+		// The law name of --lang en absorbs the shrink as well
+		english := []string{"No.", "Law Name", "Law Type", "Effective Date"}
+		want := []int{3, 20, 8, 14}
+		if got := ColumnWidths(english, rows, 58); !reflect.DeepEqual(got, want) {
+			t.Errorf("ColumnWidths() = %v, want %v", got, want)
+		}
+	})
+}
+
+func TestWrapCell(t *testing.T) {
+	tests := []struct {
+		name  string
+		text  string
+		width int
+		want  string
+	}{
+		{"fits", "도로교통법", 10, "도로교통법"},
+		{"breaks at spaces", "개인정보 보호법 시행령", 10, "개인정보\n보호법\n시행령"},
+		{"breaks inside long words", "자동차손해배상보장법", 8, "자동차손\n해배상보\n장법"},
+		{"odd width keeps Hangul whole", "국토계획법", 5, "국토\n계획\n법"},
+		{"keeps line breaks", "제1조\n목적", 10, "제1조\n목적"},
+		{"no width", "개인정보 보호법", 0, "개인정보 보호법"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := wrapCell(tt.text, tt.width)
+			if got != tt.want {
+				t.Errorf("wrapCell(%q, %d) = %q, want %q", tt.text, tt.width, got, tt.want)
+			}
+			if tt.width <= 0 {
+				return
+			}
+			for _, line := range strings.Split(got, "\n") {
+				if w := runewidth.StringWidth(line); w > tt.width {
+					t.Errorf("line %q is %d cells wide, want at most %d", line, w, tt.width)
+				}
+			}
+		})
+	}
+}
+
+func TestFormatSearchTableFitsWidthEnglish(t *testing.T) {
+	SetTableWidth(70)
+	defer SetTableWidth(0)
+
+	resp := &api.SearchResponse{TotalCount: 2, Laws: []api.LawInfo{
+		{ID: "011357", Name: "개인정보 보호법 시행령 일부개정령안에 관한 특례규정", LawType: "대통령령", Department: "개인정보보호위원회", EffectDate: "20240315"},
+		{ID: "000001", Name: "도로교통법", LawType: "법률", Department: "경찰청", EffectDate: "20231019"},
+	}}
+	out, err := NewFormatter("table").WithLanguage("en").FormatSearchResultToString(resp)
+	if err != nil {
+		t.Fatalf("FormatSearchResultToString() error = %v", err)
+	}
+
+	// The law name wraps while the dates and the centered header stay whole
+	for _, s := range []string{"2024-03-15", "2023-10-19", "EFFECTIVE DATE"} {
+		if !strings.Contains(out, s) {
+			t.Errorf("table should contain %q whole, got:\n%s", s, out)
+		}
+	}
+	for _, line := range strings.Split(strings.TrimRight(out, "\n"), "\n") {
+		if w := runewidth.StringWidth(line); w > 70 {
+			t.Errorf("line is %d cells wide:\n%s", w, line)
+		}
+	}
+}
+
+func TestRenderTableFitsWidth(t *testing.T) {
+	headers := []string{"번호", "법령명", "법령구분", "소관부처", "시행일자"}
+	rows := [][]string{
+		{"1", "개인정보 보호법 시행령 일부개정령안에 관한 특례규정", "대통령령", "개인정보보호위원회", "2024-03-15"},
+		{"2", "도로교통법", "법률", "경찰청", "2023-10-19"},
+	}
+
+	for _, width := range []int{60, 80, 100} {
+		out := RenderTable(headers, rows, &TableStyle{BoxDrawing: true, TerminalWidth: width})
+		for _, line := range strings.Split(strings.TrimRight(out, "\n"), "\n") {
+			if w := runewidth.StringWidth(line); w > width {
+				t.Errorf("width %d: line is %d cells wide:\n%s", width, w, line)
+			}
+		}
+		if !strings.Contains(out, "2023-10-") {
+			t.Errorf("width %d: table lost a row:\n%s", width, out)
+		}
+	}
+}
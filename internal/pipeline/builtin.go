@@ -0,0 +1,87 @@
+package pipeline
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+// Execution order of the built-in transformers. Normalizers run first so that
+// later transformers see canonical values.
+const (
+	OrderDateNormalizer       = 10
+	OrderDepartmentNormalizer = 20
+)
+
+func init() {
+	Register(OrderDateNormalizer, DateNormalizer{})
+	Register(OrderDepartmentNormalizer, DepartmentNormalizer{})
+}
+
+// DateNormalizer rewrites promulgation and effective dates to the YYYYMMDD
+// form used by the NLIC API, so results from every source format the same way
+type DateNormalizer struct{}
+
+// Name returns the transformer name
+func (DateNormalizer) Name() string {
+	return "date"
+}
+
+// Transform normalizes the dates of every law in the response
+func (DateNormalizer) Transform(ctx context.Context, resp *api.SearchResponse) (*api.SearchResponse, error) {
+	out := cloneResponse(resp)
+	for i := range out.Laws {
+		out.Laws[i].PromulDate = NormalizeDate(out.Laws[i].PromulDate)
+		out.Laws[i].EffectDate = NormalizeDate(out.Laws[i].EffectDate)
+	}
+	return out, nil
+}
+
+// NormalizeDate converts a date to YYYYMMDD. Values it does not recognize are
+// returned trimmed but otherwise unchanged.
+func NormalizeDate(date string) string {
+	if t, ok := api.ParseDate(date); ok {
+		return t.Format("20060102")
+	}
+	return strings.TrimSpace(date)
+}
+
+// departmentSeparator splits joint departments such as "행정안전부 ,경찰청"
+var departmentSeparator = regexp.MustCompile(`\s*,\s*`)
+
+// DepartmentNormalizer cleans up department names: surrounding and repeated
+// whitespace is removed and joint departments are joined with ", "
+type DepartmentNormalizer struct{}
+
+// Name returns the transformer name
+func (DepartmentNormalizer) Name() string {
+	return "department"
+}
+
+// Transform normalizes the department of every law in the response
+func (DepartmentNormalizer) Transform(ctx context.Context, resp *api.SearchResponse) (*api.SearchResponse, error) {
+	out := cloneResponse(resp)
+	for i := range out.Laws {
+		out.Laws[i].Department = NormalizeDepartment(out.Laws[i].Department)
+	}
+	return out, nil
+}
+
+// NormalizeDepartment returns the canonical form of a department name
+func NormalizeDepartment(department string) string {
+	department = strings.Join(strings.Fields(department), " ")
+	if department == "" {
+		return ""
+	}
+
+	parts := departmentSeparator.Split(department, -1)
+	names := parts[:0]
+	for _, part := range parts {
+		if part != "" {
+			names = append(names, part)
+		}
+	}
+	return strings.Join(names, ", ")
+}
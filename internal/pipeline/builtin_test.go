@@ -0,0 +1,83 @@
+package pipeline
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+func TestNormalizeDate(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"20240101", "20240101"},
+		{"2024.01.01", "20240101"},
+		{"2024-01-01", "20240101"},
+		{"2024/1/5", "20240105"},
+		{"2024. 1. 5.", "20240105"},
+		{" 2024.12.31 ", "20241231"},
+		{"2024.13.01", "2024.13.01"},
+		{"미상", "미상"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := NormalizeDate(tt.input); got != tt.want {
+				t.Errorf("NormalizeDate(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeDepartment(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"행정안전부", "행정안전부"},
+		{"  행정안전부  ", "행정안전부"},
+		{"서울특별시   교통정책과", "서울특별시 교통정책과"},
+		{"행정안전부 ,경찰청", "행정안전부, 경찰청"},
+		{"행정안전부,,경찰청,", "행정안전부, 경찰청"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := NormalizeDepartment(tt.input); got != tt.want {
+				t.Errorf("NormalizeDepartment(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDefaultChain(t *testing.T) {
+	input := &api.SearchResponse{
+		TotalCount: 1,
+		Laws: []api.LawInfo{{
+			Name:       "서울특별시 주차장 조례",
+			PromulDate: "2023.05.01",
+			EffectDate: "2023-06-01",
+			Department: " 서울특별시  교통정책과 ",
+		}},
+	}
+
+	got, err := Default().Run(context.Background(), input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	law := got.Laws[0]
+	if law.PromulDate != "20230501" || law.EffectDate != "20230601" {
+		t.Errorf("dates = %q/%q, want 20230501/20230601", law.PromulDate, law.EffectDate)
+	}
+	if law.Department != "서울특별시 교통정책과" {
+		t.Errorf("Department = %q", law.Department)
+	}
+	if input.Laws[0].PromulDate != "2023.05.01" {
+		t.Error("input response should not be modified")
+	}
+}
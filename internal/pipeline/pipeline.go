@@ -0,0 +1,165 @@
+// Package pipeline provides post-processing hooks that run on search results
+// right before they are output.
+//
+// Transformers are registered at compile time, typically from an init function
+// in the file that defines them:
+//
+//	func init() {
+//		pipeline.Register(100, myTransformer{})
+//	}
+//
+// Execution order: transformers run in ascending order value; ties are broken
+// by name so the order never depends on file or package initialization order.
+//
+// Error propagation: each transformer receives the output of the previous one.
+// Returning an error that wraps ErrSkip leaves the response unchanged and the
+// chain continues. Any other error stops the chain and is returned as a
+// *TransformError naming the failing transformer. A cancelled context also
+// stops the chain before the next transformer runs.
+//
+// Request metadata: the context passed to Run by the search commands carries
+// the api.RequestContext of the search (query, source, page, size), read with
+// api.RequestContextFrom.
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+// ErrSkip signals that a transformer did not apply to a response.
+// The chain keeps the response it passed in and moves on.
+var ErrSkip = errors.New("transformer skipped")
+
+// Transformer post-processes a search response
+type Transformer interface {
+	// Name returns a unique, stable identifier for the transformer
+	Name() string
+	// Transform returns the processed response. Implementations should not
+	// modify resp in place; return a copy instead. Returning a nil response
+	// with a nil error keeps resp unchanged.
+	Transform(ctx context.Context, resp *api.SearchResponse) (*api.SearchResponse, error)
+}
+
+// TransformError reports which transformer failed
+type TransformError struct {
+	Name string
+	Err  error
+}
+
+func (e *TransformError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Name, e.Err)
+}
+
+func (e *TransformError) Unwrap() error {
+	return e.Err
+}
+
+// entry is a registered transformer and its execution order
+type entry struct {
+	order       int
+	transformer Transformer
+}
+
+var (
+	registryMu sync.RWMutex
+	registry   = make(map[string]entry)
+)
+
+// Register adds a transformer to the default chain with the given order.
+// It panics if the transformer is nil or a transformer with the same name is
+// already registered, since both are programming errors.
+func Register(order int, t Transformer) {
+	if t == nil {
+		panic("pipeline: Register transformer is nil")
+	}
+
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	name := t.Name()
+	if _, dup := registry[name]; dup {
+		panic("pipeline: Register called twice for transformer " + name)
+	}
+	registry[name] = entry{order: order, transformer: t}
+}
+
+// Registered returns the registered transformers in execution order
+func Registered() []Transformer {
+	registryMu.RLock()
+	entries := make([]entry, 0, len(registry))
+	for _, e := range registry {
+		entries = append(entries, e)
+	}
+	registryMu.RUnlock()
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].order != entries[j].order {
+			return entries[i].order < entries[j].order
+		}
+		return entries[i].transformer.Name() < entries[j].transformer.Name()
+	})
+
+	transformers := make([]Transformer, len(entries))
+	for i, e := range entries {
+		transformers[i] = e.transformer
+	}
+	return transformers
+}
+
+// Chain runs transformers in sequence
+type Chain struct {
+	transformers []Transformer
+}
+
+// NewChain creates a chain that runs the given transformers in the given order
+func NewChain(transformers ...Transformer) *Chain {
+	return &Chain{transformers: transformers}
+}
+
+// Default creates a chain of all registered transformers
+func Default() *Chain {
+	return NewChain(Registered()...)
+}
+
+// Len returns the number of transformers in the chain
+func (c *Chain) Len() int {
+	return len(c.transformers)
+}
+
+// Run passes resp through every transformer in the chain
+func (c *Chain) Run(ctx context.Context, resp *api.SearchResponse) (*api.SearchResponse, error) {
+	if resp == nil {
+		return nil, nil
+	}
+
+	for _, t := range c.transformers {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		out, err := t.Transform(ctx, resp)
+		if err != nil {
+			if errors.Is(err, ErrSkip) {
+				continue
+			}
+			return nil, &TransformError{Name: t.Name(), Err: err}
+		}
+		if out != nil {
+			resp = out
+		}
+	}
+	return resp, nil
+}
+
+// cloneResponse returns a copy of resp with its own Laws slice
+func cloneResponse(resp *api.SearchResponse) *api.SearchResponse {
+	clone := *resp
+	clone.Laws = append([]api.LawInfo(nil), resp.Laws...)
+	return &clone
+}
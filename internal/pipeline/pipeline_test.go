@@ -0,0 +1,208 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+// funcTransformer adapts a function to the Transformer interface
+type funcTransformer struct {
+	name string
+	fn   func(resp *api.SearchResponse) (*api.SearchResponse, error)
+}
+
+func (f funcTransformer) Name() string { return f.name }
+
+func (f funcTransformer) Transform(ctx context.Context, resp *api.SearchResponse) (*api.SearchResponse, error) {
+	return f.fn(resp)
+}
+
+// appendSource returns a transformer that appends tag to the Source of every law
+func appendSource(name, tag string) Transformer {
+	return funcTransformer{name: name, fn: func(resp *api.SearchResponse) (*api.SearchResponse, error) {
+		out := cloneResponse(resp)
+		for i := range out.Laws {
+			out.Laws[i].Source += tag
+		}
+		return out, nil
+	}}
+}
+
+func failWith(name string, err error) Transformer {
+	return funcTransformer{name: name, fn: func(resp *api.SearchResponse) (*api.SearchResponse, error) {
+		return nil, err
+	}}
+}
+
+func newResponse() *api.SearchResponse {
+	return &api.SearchResponse{TotalCount: 1, Laws: []api.LawInfo{{Name: "테스트법"}}}
+}
+
+func TestChainRun(t *testing.T) {
+	boom := errors.New("boom")
+
+	tests := []struct {
+		name       string
+		chain      *Chain
+		wantSource string
+		wantErr    error
+		wantFailed string
+	}{
+		{
+			name:       "Empty chain",
+			chain:      NewChain(),
+			wantSource: "",
+		},
+		{
+			name:       "Runs in order",
+			chain:      NewChain(appendSource("a", "A"), appendSource("b", "B"), appendSource("c", "C")),
+			wantSource: "ABC",
+		},
+		{
+			name: "Skip keeps previous response",
+			chain: NewChain(
+				appendSource("a", "A"),
+				failWith("skip", fmt.Errorf("not applicable: %w", ErrSkip)),
+				appendSource("c", "C"),
+			),
+			wantSource: "AC",
+		},
+		{
+			name: "Nil response keeps previous response",
+			chain: NewChain(
+				appendSource("a", "A"),
+				funcTransformer{name: "noop", fn: func(resp *api.SearchResponse) (*api.SearchResponse, error) { return nil, nil }},
+				appendSource("c", "C"),
+			),
+			wantSource: "AC",
+		},
+		{
+			name:       "Error stops chain",
+			chain:      NewChain(appendSource("a", "A"), failWith("broken", boom), appendSource("c", "C")),
+			wantErr:    boom,
+			wantFailed: "broken",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := newResponse()
+			got, err := tt.chain.Run(context.Background(), input)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Run() error = %v, want %v", err, tt.wantErr)
+				}
+				var te *TransformError
+				if !errors.As(err, &te) || te.Name != tt.wantFailed {
+					t.Errorf("expected TransformError for %q, got %v", tt.wantFailed, err)
+				}
+				if got != nil {
+					t.Error("expected nil response on error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Laws[0].Source != tt.wantSource {
+				t.Errorf("Source = %q, want %q", got.Laws[0].Source, tt.wantSource)
+			}
+			if input.Laws[0].Source != "" {
+				t.Error("input response should not be modified")
+			}
+		})
+	}
+}
+
+func TestChainRun_CancelledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	called := false
+	chain := NewChain(funcTransformer{name: "never", fn: func(resp *api.SearchResponse) (*api.SearchResponse, error) {
+		called = true
+		return resp, nil
+	}})
+
+	if _, err := chain.Run(ctx, newResponse()); !errors.Is(err, context.Canceled) {
+		t.Errorf("Run() error = %v, want context.Canceled", err)
+	}
+	if called {
+		t.Error("transformer should not run after cancellation")
+	}
+}
+
+func TestChainRun_NilResponse(t *testing.T) {
+	got, err := NewChain(appendSource("a", "A")).Run(context.Background(), nil)
+	if got != nil || err != nil {
+		t.Errorf("Run(nil) = %v, %v; want nil, nil", got, err)
+	}
+}
+
+func TestRegister(t *testing.T) {
+	// Restore the registry so built-in registrations are unaffected
+	registryMu.Lock()
+	saved := registry
+	registry = make(map[string]entry)
+	registryMu.Unlock()
+	defer func() {
+		registryMu.Lock()
+		registry = saved
+		registryMu.Unlock()
+	}()
+
+	Register(20, appendSource("late", "L"))
+	Register(10, appendSource("zeta", "Z"))
+	Register(10, appendSource("alpha", "A"))
+
+	var names []string
+	for _, tr := range Registered() {
+		names = append(names, tr.Name())
+	}
+	if want := []string{"alpha", "zeta", "late"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("Registered() order = %v, want %v", names, want)
+	}
+
+	got, err := Default().Run(context.Background(), newResponse())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Laws[0].Source != "AZL" {
+		t.Errorf("Source = %q, want %q", got.Laws[0].Source, "AZL")
+	}
+
+	t.Run("Duplicate name panics", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("expected panic for duplicate registration")
+			}
+		}()
+		Register(30, appendSource("alpha", "X"))
+	})
+
+	t.Run("Nil transformer panics", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("expected panic for nil transformer")
+			}
+		}()
+		Register(30, nil)
+	})
+}
+
+func TestBuiltinsRegistered(t *testing.T) {
+	var names []string
+	for _, tr := range Registered() {
+		names = append(names, tr.Name())
+	}
+	if want := []string{"date", "department"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("Registered() = %v, want %v", names, want)
+	}
+}
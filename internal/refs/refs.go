@@ -0,0 +1,194 @@
+// Package refs extracts citations of other laws from article text and
+// resolves them to law IDs through the search API.
+package refs
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+// resolvePageSize is the number of search results inspected when resolving a law name
+const resolvePageSize = 10
+
+var (
+	// bracketedCitation matches the formal citation style 「개인정보 보호법」 제17조
+	bracketedCitation = regexp.MustCompile(`「([^」]+)」(?:\s*(제\s*\d+\s*조(?:\s*의\s*\d+)?))?`)
+	// bareCitation matches unbracketed citations such as 도로교통법 제44조.
+	// Only single-word names are matched, since the start of a multi-word
+	// name cannot be told apart from the preceding text.
+	bareCitation = regexp.MustCompile(`([가-힣]+(?:법률|법|시행령|시행규칙))\s*(제\s*\d+\s*조(?:\s*의\s*\d+)?)`)
+	// spaces matches runs of whitespace
+	spaces = regexp.MustCompile(`\s+`)
+)
+
+// selfReferences are words that refer to the citing law itself or to a law
+// cited just before, not to a named law
+var selfReferences = map[string]bool{
+	"이법": true, "같은법": true, "동법": true, "본법": true, "이법률": true,
+}
+
+// Citation is a reference to an article of another law
+type Citation struct {
+	LawName string `json:"법령명"`
+	Article string `json:"조문,omitempty"`   // e.g. "제17조", empty when the whole law is cited
+	From    string `json:"인용위치,omitempty"` // article of the citing law where it first appears
+}
+
+// Reference is a citation together with the resolved law ID
+type Reference struct {
+	Citation
+	LawID string `json:"법령ID,omitempty"` // empty when the law could not be resolved
+}
+
+// Extract returns the unique citations of other laws in detail, in order of
+// first appearance. Citations of the law itself are skipped.
+func Extract(detail *api.LawDetail) []Citation {
+	var citations []Citation
+	seen := make(map[string]bool)
+	self := normalizeName(detail.Name)
+
+	add := func(c Citation) {
+		name := normalizeName(c.LawName)
+		if name == "" || name == self || selfReferences[name] {
+			return
+		}
+		key := name + "|" + c.Article
+		if seen[key] {
+			return
+		}
+		seen[key] = true
+		citations = append(citations, c)
+	}
+
+	for _, article := range detail.Articles {
+		from := article.Number
+		if from != "" && !strings.HasPrefix(from, "제") {
+			from = "제" + from + "조"
+		}
+		for _, c := range ExtractText(article.Content) {
+			c.From = from
+			add(c)
+		}
+	}
+	return citations
+}
+
+// ExtractText returns the citations found in text, in order of appearance
+func ExtractText(text string) []Citation {
+	type match struct {
+		citation Citation
+		pos      int
+	}
+	var matches []match
+
+	// Bracketed names may contain spaces, so blank them out before looking
+	// for bare citations to avoid matching the same text twice
+	masked := []byte(text)
+	for _, m := range bracketedCitation.FindAllStringSubmatchIndex(text, -1) {
+		c := Citation{LawName: strings.TrimSpace(text[m[2]:m[3]])}
+		if m[4] >= 0 {
+			c.Article = normalizeArticle(text[m[4]:m[5]])
+		}
+		matches = append(matches, match{c, m[0]})
+		for i := m[0]; i < m[1]; i++ {
+			masked[i] = ' '
+		}
+	}
+
+	for _, m := range bareCitation.FindAllSubmatchIndex(masked, -1) {
+		matches = append(matches, match{Citation{
+			LawName: string(masked[m[2]:m[3]]),
+			Article: normalizeArticle(string(masked[m[4]:m[5]])),
+		}, m[0]})
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].pos < matches[j].pos
+	})
+
+	citations := make([]Citation, len(matches))
+	for i, m := range matches {
+		citations[i] = m.citation
+	}
+	return citations
+}
+
+// normalizeArticle removes spaces from an article reference ("제 5 조의 2" -> "제5조의2")
+func normalizeArticle(article string) string {
+	return spaces.ReplaceAllString(article, "")
+}
+
+// normalizeName removes spaces so that "개인정보 보호법" and "개인정보보호법" compare equal
+func normalizeName(name string) string {
+	return spaces.ReplaceAllString(strings.TrimSpace(name), "")
+}
+
+// Searcher is the part of the API client needed to resolve law names
+type Searcher interface {
+	Search(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error)
+}
+
+// Resolver resolves law names to law IDs. Each unique name is looked up at
+// most once; results, including failures, are cached for the resolver's lifetime.
+type Resolver struct {
+	client Searcher
+	cache  map[string]string
+}
+
+// NewResolver creates a resolver that searches with client
+func NewResolver(client Searcher) *Resolver {
+	return &Resolver{client: client, cache: make(map[string]string)}
+}
+
+// Resolve returns the ID of the law named name, or "" if no search result
+// matches the name exactly
+func (r *Resolver) Resolve(ctx context.Context, name string) string {
+	key := normalizeName(name)
+	if id, ok := r.cache[key]; ok {
+		return id
+	}
+
+	id := r.lookup(ctx, name)
+	r.cache[key] = id
+	return id
+}
+
+func (r *Resolver) lookup(ctx context.Context, name string) string {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	resp, err := r.client.Search(ctx, &api.UnifiedSearchRequest{
+		Query:    name,
+		PageNo:   1,
+		PageSize: resolvePageSize,
+		Type:     "JSON",
+	})
+	if err != nil || resp == nil {
+		return ""
+	}
+
+	key := normalizeName(name)
+	for _, law := range resp.Laws {
+		if normalizeName(law.Name) == key || (law.NameAbbrev != "" && normalizeName(law.NameAbbrev) == key) {
+			if law.ID != "" {
+				return law.ID
+			}
+			return law.SerialNo
+		}
+	}
+	return ""
+}
+
+// ResolveAll resolves every citation, keeping unresolved ones with an empty LawID
+func (r *Resolver) ResolveAll(ctx context.Context, citations []Citation) []Reference {
+	references := make([]Reference, len(citations))
+	for i, c := range citations {
+		references[i] = Reference{Citation: c, LawID: r.Resolve(ctx, c.LawName)}
+	}
+	return references
+}
@@ -0,0 +1,154 @@
+package refs
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+func TestExtractText(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []Citation
+	}{
+		{
+			name: "Bracketed with article",
+			text: "「개인정보 보호법」 제17조에 따라 제공한다.",
+			want: []Citation{{LawName: "개인정보 보호법", Article: "제17조"}},
+		},
+		{
+			name: "Bracketed without article",
+			text: "「전자정부법」에 따른 행정기관",
+			want: []Citation{{LawName: "전자정부법"}},
+		},
+		{
+			name: "Branch article and spacing",
+			text: "「도로교통법」 제 44 조의 2를 위반한 경우",
+			want: []Citation{{LawName: "도로교통법", Article: "제44조의2"}},
+		},
+		{
+			name: "Bare citation",
+			text: "도로교통법 제44조제1항을 위반하여",
+			want: []Citation{{LawName: "도로교통법", Article: "제44조"}},
+		},
+		{
+			name: "Order of appearance across styles",
+			text: "민법 제3조 및 「상법」 제5조, 형법 제10조",
+			want: []Citation{
+				{LawName: "민법", Article: "제3조"},
+				{LawName: "상법", Article: "제5조"},
+				{LawName: "형법", Article: "제10조"},
+			},
+		},
+		{
+			name: "Self references are not bare citations",
+			text: "이 법 제5조 및 같은 법 제6조에 따른다.",
+			want: nil,
+		},
+		{
+			name: "Bracketed name is not matched twice",
+			text: "「개인정보 보호법」 제17조",
+			want: []Citation{{LawName: "개인정보 보호법", Article: "제17조"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExtractText(tt.text)
+			if len(got) == 0 && len(tt.want) == 0 {
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ExtractText(%q) = %+v, want %+v", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExtract(t *testing.T) {
+	detail := &api.LawDetail{
+		LawInfo: api.LawInfo{Name: "테스트법"},
+		Articles: []api.Article{
+			{Number: "1", Content: "제1조(목적) 이 법은 「개인정보 보호법」 제17조 및 「테스트법」 제2조에 따른다."},
+			{Number: "2", Content: "제2조 「개인정보보호법」 제17조, 「전자정부법」 제2조를 준용한다."},
+		},
+	}
+
+	got := Extract(detail)
+	want := []Citation{
+		{LawName: "개인정보 보호법", Article: "제17조", From: "제1조"},
+		{LawName: "전자정부법", Article: "제2조", From: "제2조"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Extract() = %+v, want %+v", got, want)
+	}
+}
+
+// countingSearcher records the queries it receives
+type countingSearcher struct {
+	queries []string
+	laws    map[string][]api.LawInfo
+	err     error
+}
+
+func (s *countingSearcher) Search(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+	s.queries = append(s.queries, req.Query)
+	if s.err != nil {
+		return nil, s.err
+	}
+	laws := s.laws[req.Query]
+	return &api.SearchResponse{TotalCount: len(laws), Laws: laws}, nil
+}
+
+func TestResolver(t *testing.T) {
+	searcher := &countingSearcher{laws: map[string][]api.LawInfo{
+		"개인정보 보호법": {
+			{ID: "999", Name: "개인정보 보호법 시행령"},
+			{ID: "011357", Name: "개인정보 보호법"},
+		},
+		"도로교통법": {{ID: "001638", Name: "도로교통법"}},
+		"없는법":   {{ID: "123", Name: "비슷한 다른법"}},
+	}}
+	resolver := NewResolver(searcher)
+
+	citations := []Citation{
+		{LawName: "개인정보 보호법", Article: "제17조"},
+		{LawName: "개인정보보호법", Article: "제18조"},
+		{LawName: "도로교통법", Article: "제44조"},
+		{LawName: "없는법", Article: "제1조"},
+		{LawName: "없는법", Article: "제2조"},
+	}
+	refs := resolver.ResolveAll(context.Background(), citations)
+
+	wantIDs := []string{"011357", "011357", "001638", "", ""}
+	for i, ref := range refs {
+		if ref.LawID != wantIDs[i] {
+			t.Errorf("refs[%d].LawID = %q, want %q", i, ref.LawID, wantIDs[i])
+		}
+		if ref.LawName != citations[i].LawName {
+			t.Errorf("refs[%d].LawName = %q, want name kept", i, ref.LawName)
+		}
+	}
+
+	// One lookup per unique name, including failed ones
+	if want := []string{"개인정보 보호법", "도로교통법", "없는법"}; !reflect.DeepEqual(searcher.queries, want) {
+		t.Errorf("queries = %v, want %v", searcher.queries, want)
+	}
+}
+
+func TestResolver_SearchError(t *testing.T) {
+	searcher := &countingSearcher{err: errors.New("network down")}
+	resolver := NewResolver(searcher)
+
+	if id := resolver.Resolve(context.Background(), "민법"); id != "" {
+		t.Errorf("Resolve() = %q, want empty on error", id)
+	}
+	resolver.Resolve(context.Background(), "민법")
+	if len(searcher.queries) != 1 {
+		t.Errorf("failed lookup should be cached, got %d queries", len(searcher.queries))
+	}
+}
@@ -0,0 +1,266 @@
+package repl
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/output"
+)
+
+// Prompt is written before each line read by the session
+const Prompt = "warp> "
+
+// Names of the commands of the session. A line not starting with ':' is a search.
+const (
+	CmdSearch = "search"
+	CmdDetail = "detail"
+	CmdNext   = "next"
+	CmdPrev   = "prev"
+	CmdFormat = "format"
+	CmdHelp   = "help"
+	CmdQuit   = "quit"
+)
+
+// Formats are the output formats of the session, those both the search
+// results and the law details can be written in
+var Formats = []string{"table", "json", "xml", "markdown"}
+
+// helpText lists the commands of the session
+const helpText = `검색어를 입력하면 법령을 검색합니다.
+
+  :detail <n>      현재 페이지의 n번째 결과 상세 보기
+  :next, :prev     다음/이전 페이지
+  :format <형식>   출력 형식 변경 (table, json, xml, markdown)
+  :help            도움말
+  :quit            종료 (Ctrl+D)
+`
+
+// Command is a parsed input line
+type Command struct {
+	Name string
+	Arg  string
+}
+
+// Parse parses an input line. An empty line is a Command without a name.
+func Parse(line string) (Command, error) {
+	line = strings.TrimSpace(line)
+	if line == "" {
+		return Command{}, nil
+	}
+	if !strings.HasPrefix(line, ":") {
+		return Command{Name: CmdSearch, Arg: line}, nil
+	}
+
+	name, arg, _ := strings.Cut(line[1:], " ")
+	cmd := Command{Name: strings.ToLower(name), Arg: strings.TrimSpace(arg)}
+	switch cmd.Name {
+	case "q", "exit":
+		cmd.Name = CmdQuit
+	case "n":
+		cmd.Name = CmdNext
+	case "p":
+		cmd.Name = CmdPrev
+	case "d":
+		cmd.Name = CmdDetail
+	}
+
+	switch cmd.Name {
+	case CmdDetail, CmdFormat:
+		if cmd.Arg == "" {
+			return Command{}, fmt.Errorf(":%s에는 값이 필요합니다 (:help 참고)", cmd.Name)
+		}
+	case CmdNext, CmdPrev, CmdHelp, CmdQuit:
+		if cmd.Arg != "" {
+			return Command{}, fmt.Errorf(":%s에는 값을 줄 수 없습니다", cmd.Name)
+		}
+	default:
+		return Command{}, fmt.Errorf("알 수 없는 명령: :%s (:help 참고)", name)
+	}
+	return cmd, nil
+}
+
+// Session runs searches one after another with one client, keeping the
+// results of the last search for paging and details
+type Session struct {
+	// Client runs the searches and detail lookups
+	Client api.ClientInterface
+	// Out receives the prompt and the results
+	Out io.Writer
+	// PageSize is the number of results of a page
+	PageSize int
+	// Format is the output format, one of Formats
+	Format string
+	// Timeout bounds each search and detail lookup; 0 for none
+	Timeout time.Duration
+
+	query string
+	page  int
+	resp  *api.SearchResponse
+}
+
+// Run reads commands from in until it ends (Ctrl+D), ctx is cancelled or
+// :quit is given. Errors of a command are shown and do not end the session.
+func (s *Session) Run(ctx context.Context, in io.Reader) error {
+	lines := make(chan string)
+	go func() {
+		defer close(lines)
+		scanner := bufio.NewScanner(in)
+		for scanner.Scan() {
+			lines <- scanner.Text()
+		}
+	}()
+
+	for {
+		fmt.Fprint(s.Out, Prompt)
+		var line string
+		select {
+		case <-ctx.Done():
+			fmt.Fprintln(s.Out)
+			return nil
+		case l, ok := <-lines:
+			if !ok {
+				fmt.Fprintln(s.Out)
+				return nil
+			}
+			line = l
+		}
+
+		cmd, err := Parse(line)
+		if err == nil {
+			var quit bool
+			quit, err = s.Execute(ctx, cmd)
+			if quit {
+				return nil
+			}
+		}
+		if err != nil {
+			fmt.Fprintf(s.Out, "✗ %v\n", err)
+		}
+	}
+}
+
+// Execute runs a parsed command and reports whether the session ends
+func (s *Session) Execute(ctx context.Context, cmd Command) (bool, error) {
+	switch cmd.Name {
+	case "":
+		return false, nil
+	case CmdQuit:
+		return true, nil
+	case CmdHelp:
+		fmt.Fprint(s.Out, helpText)
+		return false, nil
+	case CmdSearch:
+		return false, s.search(ctx, cmd.Arg, 1)
+	case CmdNext, CmdPrev:
+		if s.resp == nil {
+			return false, fmt.Errorf("먼저 검색어를 입력하세요")
+		}
+		page := s.page + 1
+		if cmd.Name == CmdPrev {
+			page = s.page - 1
+		}
+		if page < 1 || page > s.lastPage() {
+			return false, fmt.Errorf("페이지 범위를 벗어났습니다 (1-%d)", s.lastPage())
+		}
+		return false, s.search(ctx, s.query, page)
+	case CmdDetail:
+		return false, s.detail(ctx, cmd.Arg)
+	case CmdFormat:
+		return false, s.setFormat(cmd.Arg)
+	}
+	return false, fmt.Errorf("알 수 없는 명령: :%s", cmd.Name)
+}
+
+// search runs the search of query for page and writes its results
+func (s *Session) search(ctx context.Context, query string, page int) error {
+	ctx, cancel := s.withTimeout(ctx)
+	defer cancel()
+
+	resp, err := s.Client.Search(ctx, &api.UnifiedSearchRequest{
+		Query:    query,
+		PageNo:   page,
+		PageSize: s.PageSize,
+		Type:     "JSON",
+	})
+	if err != nil {
+		return fmt.Errorf("검색 실패: %w", err)
+	}
+	s.query, s.page, s.resp = query, page, resp
+
+	formatted, err := output.NewFormatter(s.Format).FormatSearchResultToString(resp)
+	if err != nil {
+		return err
+	}
+	fmt.Fprint(s.Out, formatted)
+	if s.Format == "table" && s.lastPage() > 1 {
+		fmt.Fprintf(s.Out, "\n페이지 %d/%d (:next, :prev, :detail <n>)\n", s.page, s.lastPage())
+	}
+	return nil
+}
+
+// detail writes the detail of the result numbered arg on the current page
+func (s *Session) detail(ctx context.Context, arg string) error {
+	if s.resp == nil {
+		return fmt.Errorf("먼저 검색어를 입력하세요")
+	}
+	n, err := strconv.Atoi(arg)
+	if err != nil || n < 1 || n > len(s.resp.Laws) {
+		return fmt.Errorf("잘못된 결과 번호: %s (1-%d)", arg, len(s.resp.Laws))
+	}
+
+	ctx, cancel := s.withTimeout(ctx)
+	defer cancel()
+	detail, err := s.Client.GetDetail(ctx, s.resp.Laws[n-1].ID)
+	if err != nil {
+		return fmt.Errorf("상세 조회 실패: %w", err)
+	}
+	formatted, err := output.NewFormatter(s.Format).FormatDetailToString(detail)
+	if err != nil {
+		return err
+	}
+	fmt.Fprint(s.Out, formatted)
+	return nil
+}
+
+// ValidateFormat checks that format is one of Formats
+func ValidateFormat(format string) error {
+	for _, f := range Formats {
+		if f == format {
+			return nil
+		}
+	}
+	return fmt.Errorf("지원하지 않는 출력 형식: %s (%s 중 선택)", format, strings.Join(Formats, ", "))
+}
+
+// setFormat switches the output format
+func (s *Session) setFormat(format string) error {
+	format = strings.ToLower(format)
+	if err := ValidateFormat(format); err != nil {
+		return err
+	}
+	s.Format = format
+	fmt.Fprintf(s.Out, "출력 형식: %s\n", format)
+	return nil
+}
+
+// lastPage returns the number of pages of the last search
+func (s *Session) lastPage() int {
+	if s.resp == nil || s.PageSize <= 0 {
+		return 1
+	}
+	return max(1, (s.resp.PageableCount()+s.PageSize-1)/s.PageSize)
+}
+
+// withTimeout bounds ctx by the timeout of the session
+func (s *Session) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if s.Timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, s.Timeout)
+}
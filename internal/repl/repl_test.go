@@ -0,0 +1,191 @@
+package repl
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+// fakeClient serves total laws named after their number, page by page
+type fakeClient struct {
+	total    int
+	requests []api.UnifiedSearchRequest
+	details  []string
+}
+
+func (c *fakeClient) Search(ctx context.Context, req *api.UnifiedSearchRequest) (*api.SearchResponse, error) {
+	c.requests = append(c.requests, *req)
+	resp := &api.SearchResponse{TotalCount: c.total, Page: req.PageNo}
+	for i := (req.PageNo-1)*req.PageSize + 1; i <= c.total && i <= req.PageNo*req.PageSize; i++ {
+		resp.Laws = append(resp.Laws, api.LawInfo{ID: fmt.Sprintf("%06d", i), Name: fmt.Sprintf("법령 %d", i)})
+	}
+	return resp, nil
+}
+
+func (c *fakeClient) GetDetail(ctx context.Context, lawID string) (*api.LawDetail, error) {
+	c.details = append(c.details, lawID)
+	return &api.LawDetail{LawInfo: api.LawInfo{ID: lawID, Name: "법령 " + lawID}}, nil
+}
+
+func (c *fakeClient) GetHistory(ctx context.Context, lawID string) (*api.LawHistory, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (c *fakeClient) GetAPIType() api.APIType {
+	return api.APITypeNLIC
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		line    string
+		want    Command
+		wantErr string
+	}{
+		{"", Command{}, ""},
+		{"   ", Command{}, ""},
+		{"개인정보 보호법", Command{Name: CmdSearch, Arg: "개인정보 보호법"}, ""},
+		{"  도로교통법  ", Command{Name: CmdSearch, Arg: "도로교통법"}, ""},
+		{":detail 3", Command{Name: CmdDetail, Arg: "3"}, ""},
+		{":d 3", Command{Name: CmdDetail, Arg: "3"}, ""},
+		{":next", Command{Name: CmdNext}, ""},
+		{":PREV", Command{Name: CmdPrev}, ""},
+		{":format json", Command{Name: CmdFormat, Arg: "json"}, ""},
+		{":help", Command{Name: CmdHelp}, ""},
+		{":q", Command{Name: CmdQuit}, ""},
+		{":exit", Command{Name: CmdQuit}, ""},
+		{":detail", Command{}, "값이 필요합니다"},
+		{":format", Command{}, "값이 필요합니다"},
+		{":next 2", Command{}, "값을 줄 수 없습니다"},
+		{":open 1", Command{}, "알 수 없는 명령: :open"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.line, func(t *testing.T) {
+			got, err := Parse(tt.line)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Parse(%q) error = %v, want %q", tt.line, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.line, err)
+			}
+			if got != tt.want {
+				t.Errorf("Parse(%q) = %+v, want %+v", tt.line, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSessionExecute(t *testing.T) {
+	client := &fakeClient{total: 25}
+	var out bytes.Buffer
+	s := &Session{Client: client, Out: &out, PageSize: 10, Format: "table"}
+	ctx := context.Background()
+
+	run := func(line string) error {
+		t.Helper()
+		out.Reset()
+		cmd, err := Parse(line)
+		if err != nil {
+			t.Fatalf("Parse(%q) error = %v", line, err)
+		}
+		_, err = s.Execute(ctx, cmd)
+		return err
+	}
+
+	if err := run(":next"); err == nil || !strings.Contains(err.Error(), "먼저 검색어") {
+		t.Errorf(":next before a search error = %v", err)
+	}
+	if err := run("주차"); err != nil {
+		t.Fatalf("search error = %v", err)
+	}
+	if !strings.Contains(out.String(), "법령 10") || !strings.Contains(out.String(), "페이지 1/3") {
+		t.Errorf("first page output:\n%s", out.String())
+	}
+	if err := run(":prev"); err == nil || !strings.Contains(err.Error(), "1-3") {
+		t.Errorf(":prev on the first page error = %v", err)
+	}
+	for _, line := range []string{":next", ":next"} {
+		if err := run(line); err != nil {
+			t.Fatalf("%s error = %v", line, err)
+		}
+	}
+	if !strings.Contains(out.String(), "법령 25") || !strings.Contains(out.String(), "페이지 3/3") {
+		t.Errorf("last page output:\n%s", out.String())
+	}
+	if err := run(":next"); err == nil {
+		t.Error(":next on the last page should fail")
+	}
+
+	// Details are numbered on the current page
+	if err := run(":detail 2"); err != nil {
+		t.Fatalf(":detail error = %v", err)
+	}
+	if err := run(":detail 6"); err == nil || !strings.Contains(err.Error(), "1-5") {
+		t.Errorf(":detail beyond the page error = %v", err)
+	}
+	if !reflect.DeepEqual(client.details, []string{"000022"}) {
+		t.Errorf("details = %v, want the second result of page 3", client.details)
+	}
+
+	if err := run(":format yaml"); err == nil {
+		t.Error(":format yaml should fail")
+	}
+	if err := run(":format json"); err != nil || s.Format != "json" {
+		t.Fatalf(":format json = %v, format %q", err, s.Format)
+	}
+	if err := run(":prev"); err != nil {
+		t.Fatalf(":prev error = %v", err)
+	}
+	if !strings.HasPrefix(strings.TrimSpace(out.String()), "{") {
+		t.Errorf("json output:\n%s", out.String())
+	}
+
+	var pages []int
+	for _, req := range client.requests {
+		if req.Query != "주차" || req.PageSize != 10 {
+			t.Errorf("request = %+v", req)
+		}
+		pages = append(pages, req.PageNo)
+	}
+	if !reflect.DeepEqual(pages, []int{1, 2, 3, 2}) {
+		t.Errorf("pages = %v, want [1 2 3 2]", pages)
+	}
+}
+
+func TestSessionRun(t *testing.T) {
+	client := &fakeClient{total: 3}
+	var out bytes.Buffer
+	s := &Session{Client: client, Out: &out, PageSize: 10, Format: "table"}
+
+	// Errors are shown and the session goes on until the input ends (Ctrl+D)
+	in := strings.NewReader("주차\n:bogus\n\n:detail 1\n")
+	if err := s.Run(context.Background(), in); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if got := strings.Count(out.String(), Prompt); got != 5 {
+		t.Errorf("prompts = %d, want 5:\n%s", got, out.String())
+	}
+	if !strings.Contains(out.String(), "✗ 알 수 없는 명령: :bogus") {
+		t.Errorf("output should show the error:\n%s", out.String())
+	}
+	if !reflect.DeepEqual(client.details, []string{"000001"}) {
+		t.Errorf("details = %v", client.details)
+	}
+
+	// :quit ends the session before the rest of the input
+	client.requests = nil
+	if err := s.Run(context.Background(), strings.NewReader(":quit\n도로\n")); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if len(client.requests) != 0 {
+		t.Errorf("searches after :quit = %v", client.requests)
+	}
+}
@@ -0,0 +1,54 @@
+// Package security masks secrets, such as API keys, before they are shown
+// in command output, logs or traces.
+package security
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// visiblePrefix is the number of leading characters a masked secret keeps
+	visiblePrefix = 2
+	// minMaskedLength is the length up to which a secret is masked entirely,
+	// since its prefix would give away too much of it
+	minMaskedLength = 4
+)
+
+// SecretParams are the URL query parameters holding API keys
+var SecretParams = []string{"OC"}
+
+// MaskSecret masks s as its first two characters, "***" and its length in
+// characters, such as "ab***(32자)". Secrets of up to four characters keep
+// none of them, and an empty secret stays empty.
+func MaskSecret(s string) string {
+	n := utf8.RuneCountInString(s)
+	if n == 0 {
+		return ""
+	}
+	prefix := ""
+	if n > minMaskedLength {
+		prefix = string([]rune(s)[:visiblePrefix])
+	}
+	return fmt.Sprintf("%s***(%d자)", prefix, n)
+}
+
+// SanitizeURL returns rawURL with the values of SecretParams masked with
+// MaskSecret, keeping the rest of the URL as it is
+func SanitizeURL(rawURL string) string {
+	base, query, found := strings.Cut(rawURL, "?")
+	if !found {
+		return rawURL
+	}
+	pairs := strings.Split(query, "&")
+	for i, pair := range pairs {
+		name, value, _ := strings.Cut(pair, "=")
+		for _, secret := range SecretParams {
+			if name == secret {
+				pairs[i] = name + "=" + MaskSecret(value)
+			}
+		}
+	}
+	return base + "?" + strings.Join(pairs, "&")
+}
@@ -0,0 +1,45 @@
+package security
+
+import "testing"
+
+func TestMaskSecret(t *testing.T) {
+	tests := []struct {
+		secret string
+		want   string
+	}{
+		{"", ""},
+		{"a", "***(1자)"},
+		{"abcd", "***(4자)"},
+		{"abcde", "ab***(5자)"},
+		{"test-api-key-12345", "te***(18자)"},
+		{"0123456789abcdef0123456789abcdef", "01***(32자)"},
+		{"한글키를쓰는경우", "한글***(8자)"},
+	}
+
+	for _, tt := range tests {
+		if got := MaskSecret(tt.secret); got != tt.want {
+			t.Errorf("MaskSecret(%q) = %q, want %q", tt.secret, got, tt.want)
+		}
+	}
+}
+
+func TestSanitizeURL(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"https://www.law.go.kr/DRF/lawSearch.do?OC=secret-key&query=%EB%B2%95&type=XML",
+			"https://www.law.go.kr/DRF/lawSearch.do?OC=se***(10자)&query=%EB%B2%95&type=XML"},
+		{"https://www.law.go.kr/DRF/lawService.do?MST=123&OC=secret-key",
+			"https://www.law.go.kr/DRF/lawService.do?MST=123&OC=se***(10자)"},
+		{"https://www.law.go.kr/DRF/lawSearch.do?OC=", "https://www.law.go.kr/DRF/lawSearch.do?OC="},
+		{"https://www.law.go.kr/DRF/lawSearch.do?query=OC", "https://www.law.go.kr/DRF/lawSearch.do?query=OC"},
+		{"https://www.law.go.kr/", "https://www.law.go.kr/"},
+	}
+
+	for _, tt := range tests {
+		if got := SanitizeURL(tt.input); got != tt.want {
+			t.Errorf("SanitizeURL(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
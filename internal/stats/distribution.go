@@ -0,0 +1,48 @@
+package stats
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+// Distribution counts laws by source and by law type, the most frequent
+// first. The sources are only counted when a law has one, as the results of a
+// unified search do; a missing law type counts as Unknown.
+func Distribution(laws []api.LawInfo) *api.ResultSummary {
+	bySource := make(map[string]int)
+	byLawType := make(map[string]int)
+	for _, law := range laws {
+		if source := strings.TrimSpace(law.Source); source != "" {
+			bySource[source]++
+		}
+		lawType := strings.TrimSpace(law.LawType)
+		if lawType == "" {
+			lawType = Unknown
+		}
+		byLawType[lawType]++
+	}
+	return &api.ResultSummary{
+		BySource:  sortedCounts(bySource),
+		ByLawType: sortedCounts(byLawType),
+	}
+}
+
+// sortedCounts returns the counts of a map, the largest first and then by name
+func sortedCounts(counts map[string]int) []api.SummaryCount {
+	if len(counts) == 0 {
+		return nil
+	}
+	result := make([]api.SummaryCount, 0, len(counts))
+	for name, count := range counts {
+		result = append(result, api.SummaryCount{Name: name, Count: count})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Count != result[j].Count {
+			return result[i].Count > result[j].Count
+		}
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
@@ -0,0 +1,39 @@
+package stats
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+func TestDistribution(t *testing.T) {
+	laws := []api.LawInfo{
+		{Source: "국가법령", LawType: "법률"},
+		{Source: "자치법규", LawType: "조례"},
+		{Source: "국가법령", LawType: "대통령령"},
+		{Source: "자치법규", LawType: "조례"},
+		{Source: "국가법령", LawType: ""},
+		{Source: "판례", LawType: "법률"},
+	}
+
+	got := Distribution(laws)
+	wantSources := []api.SummaryCount{{Name: "국가법령", Count: 3}, {Name: "자치법규", Count: 2}, {Name: "판례", Count: 1}}
+	if !reflect.DeepEqual(got.BySource, wantSources) {
+		t.Errorf("BySource = %v, want %v", got.BySource, wantSources)
+	}
+	wantTypes := []api.SummaryCount{{Name: "법률", Count: 2}, {Name: "조례", Count: 2}, {Name: "대통령령", Count: 1}, {Name: Unknown, Count: 1}}
+	if !reflect.DeepEqual(got.ByLawType, wantTypes) {
+		t.Errorf("ByLawType = %v, want %v", got.ByLawType, wantTypes)
+	}
+}
+
+func TestDistributionWithoutSources(t *testing.T) {
+	got := Distribution([]api.LawInfo{{LawType: "법률"}, {LawType: "법률"}})
+	if got.BySource != nil {
+		t.Errorf("BySource = %v, want none for results of a single source", got.BySource)
+	}
+	if len(got.ByLawType) != 1 || got.ByLawType[0] != (api.SummaryCount{Name: "법률", Count: 2}) {
+		t.Errorf("ByLawType = %v", got.ByLawType)
+	}
+}
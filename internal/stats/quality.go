@@ -0,0 +1,122 @@
+package stats
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+// MaxQualitySamples is the number of sample IDs kept per problem of a field
+const MaxQualitySamples = 5
+
+// QualityReport holds the missing values and anomalies of each field of a set
+// of search results
+type QualityReport struct {
+	Total  int            `json:"total"`
+	Fields []FieldQuality `json:"fields"`
+}
+
+// FieldQuality holds the problems found in one field. Rates are fractions of
+// the total, between 0 and 1. Samples are detail IDs of problem records, or
+// their position such as "#3" when a record has no ID.
+type FieldQuality struct {
+	Field          string   `json:"field"`
+	Missing        int      `json:"missing"`
+	MissingRate    float64  `json:"missing_rate"`
+	Invalid        int      `json:"invalid"`
+	InvalidRate    float64  `json:"invalid_rate"`
+	MissingSamples []string `json:"missing_samples,omitempty"`
+	InvalidSamples []string `json:"invalid_samples,omitempty"`
+}
+
+// qualityFields lists the checked fields by their canonical JSON key. valid is
+// nil for fields that are only checked for missing values.
+var qualityFields = []struct {
+	name  string
+	value func(api.LawInfo) string
+	valid func(string) bool
+}{
+	{"law_id", func(l api.LawInfo) string { return l.ID }, nil},
+	{"law_name", func(l api.LawInfo) string { return l.Name }, nil},
+	{"serial_no", func(l api.LawInfo) string { return l.SerialNo }, isDigits},
+	{"promulgation_date", func(l api.LawInfo) string { return l.PromulDate }, isDate},
+	{"promulgation_no", func(l api.LawInfo) string { return l.PromulNo }, nil},
+	{"revision_type", func(l api.LawInfo) string { return l.Category }, nil},
+	{"department", func(l api.LawInfo) string { return l.Department }, nil},
+	{"effect_date", func(l api.LawInfo) string { return l.EffectDate }, isDate},
+	{"law_type", func(l api.LawInfo) string { return l.LawType }, nil},
+}
+
+// Quality checks every field of laws for missing values and, for IDs and
+// dates, values in an unexpected format
+func Quality(laws []api.LawInfo) *QualityReport {
+	report := &QualityReport{Total: len(laws), Fields: make([]FieldQuality, len(qualityFields))}
+
+	for i, f := range qualityFields {
+		fq := FieldQuality{Field: f.name}
+		for n, law := range laws {
+			value := strings.TrimSpace(f.value(law))
+			switch {
+			case value == "":
+				fq.Missing++
+				fq.MissingSamples = appendSample(fq.MissingSamples, law, n)
+			case f.valid != nil && !f.valid(value):
+				fq.Invalid++
+				fq.InvalidSamples = appendSample(fq.InvalidSamples, law, n)
+			}
+		}
+		fq.MissingRate = rate(fq.Missing, len(laws))
+		fq.InvalidRate = rate(fq.Invalid, len(laws))
+		report.Fields[i] = fq
+	}
+	return report
+}
+
+// Problems returns the fields with missing or invalid values
+func (r *QualityReport) Problems() []FieldQuality {
+	var problems []FieldQuality
+	for _, f := range r.Fields {
+		if f.Missing > 0 || f.Invalid > 0 {
+			problems = append(problems, f)
+		}
+	}
+	return problems
+}
+
+// appendSample adds the ID of the n-th law to samples unless they are full
+func appendSample(samples []string, law api.LawInfo, n int) []string {
+	if len(samples) >= MaxQualitySamples {
+		return samples
+	}
+	id := api.DetailID(law)
+	if id == "" {
+		id = fmt.Sprintf("#%d", n+1)
+	}
+	return append(samples, id)
+}
+
+// rate returns count as a fraction of total, 0 for no results
+func rate(count, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(count) / float64(total)
+}
+
+// isDate reports whether s is a valid date in the YYYYMMDD format of the API
+func isDate(s string) bool {
+	_, err := time.Parse(api.EffectDateLayout, s)
+	return err == nil
+}
+
+// isDigits reports whether s consists of ASCII digits only
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
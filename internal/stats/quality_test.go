@@ -0,0 +1,189 @@
+package stats
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+// completeLaw returns a law with every checked field set to a valid value
+func completeLaw(i int) api.LawInfo {
+	return api.LawInfo{
+		ID:         fmt.Sprintf("%06d", i),
+		Name:       fmt.Sprintf("테스트법 %d", i),
+		SerialNo:   fmt.Sprintf("%d", 100000+i),
+		PromulDate: "20240102",
+		PromulNo:   "12345",
+		Category:   "일부개정",
+		Department: "법무부",
+		EffectDate: "20240701",
+		LawType:    "법률",
+	}
+}
+
+// fieldOf returns the quality of the named field of a report
+func fieldOf(t *testing.T, r *QualityReport, name string) FieldQuality {
+	t.Helper()
+	for _, f := range r.Fields {
+		if f.Field == name {
+			return f
+		}
+	}
+	t.Fatalf("report has no field %q", name)
+	return FieldQuality{}
+}
+
+func TestQuality(t *testing.T) {
+	tests := []struct {
+		name        string
+		laws        func() []api.LawInfo
+		field       string
+		wantMissing int
+		wantInvalid int
+		wantRate    float64
+		wantSamples []string
+	}{
+		{
+			name: "complete data",
+			laws: func() []api.LawInfo {
+				return []api.LawInfo{completeLaw(1), completeLaw(2)}
+			},
+			field: "effect_date",
+		},
+		{
+			name: "effect date missing in 1 of 20",
+			laws: func() []api.LawInfo {
+				laws := make([]api.LawInfo, 20)
+				for i := range laws {
+					laws[i] = completeLaw(i)
+				}
+				laws[7].EffectDate = ""
+				return laws
+			},
+			field:       "effect_date",
+			wantMissing: 1,
+			wantRate:    0.05,
+			wantSamples: []string{"100007"},
+		},
+		{
+			name: "blank values count as missing",
+			laws: func() []api.LawInfo {
+				law := completeLaw(1)
+				law.Department = "  "
+				return []api.LawInfo{law, completeLaw(2)}
+			},
+			field:       "department",
+			wantMissing: 1,
+			wantRate:    0.5,
+			wantSamples: []string{"100001"},
+		},
+		{
+			name: "malformed dates",
+			laws: func() []api.LawInfo {
+				laws := []api.LawInfo{completeLaw(1), completeLaw(2), completeLaw(3), completeLaw(4)}
+				laws[0].PromulDate = "2021.05.03"
+				laws[1].PromulDate = "20241301"
+				laws[2].PromulDate = ""
+				return laws
+			},
+			field:       "promulgation_date",
+			wantMissing: 1,
+			wantInvalid: 2,
+			wantRate:    0.25,
+			wantSamples: []string{"100003"},
+		},
+		{
+			name: "records without an ID are sampled by position",
+			laws: func() []api.LawInfo {
+				return []api.LawInfo{completeLaw(1), {Name: "이름만 있는 법"}}
+			},
+			field:       "law_id",
+			wantMissing: 1,
+			wantRate:    0.5,
+			wantSamples: []string{"#2"},
+		},
+		{
+			name: "unified results keep the source prefix",
+			laws: func() []api.LawInfo {
+				law := completeLaw(1)
+				law.Source = "자치법규"
+				law.LawType = ""
+				return []api.LawInfo{law}
+			},
+			field:       "law_type",
+			wantMissing: 1,
+			wantRate:    1,
+			wantSamples: []string{"elis:100001"},
+		},
+		{
+			name: "samples are capped",
+			laws: func() []api.LawInfo {
+				laws := make([]api.LawInfo, 10)
+				for i := range laws {
+					laws[i] = completeLaw(i)
+					laws[i].PromulNo = ""
+				}
+				return laws
+			},
+			field:       "promulgation_no",
+			wantMissing: 10,
+			wantRate:    1,
+			wantSamples: []string{"100000", "100001", "100002", "100003", "100004"},
+		},
+		{
+			name:  "no results",
+			laws:  func() []api.LawInfo { return nil },
+			field: "law_name",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			laws := tt.laws()
+			report := Quality(laws)
+			if report.Total != len(laws) {
+				t.Errorf("Total = %d, want %d", report.Total, len(laws))
+			}
+
+			f := fieldOf(t, report, tt.field)
+			if f.Missing != tt.wantMissing || f.Invalid != tt.wantInvalid {
+				t.Errorf("%s: missing %d, invalid %d; want %d, %d", tt.field, f.Missing, f.Invalid, tt.wantMissing, tt.wantInvalid)
+			}
+			if math.Abs(f.MissingRate-tt.wantRate) > 1e-9 {
+				t.Errorf("%s: missing rate %v, want %v", tt.field, f.MissingRate, tt.wantRate)
+			}
+			if strings.Join(f.MissingSamples, ",") != strings.Join(tt.wantSamples, ",") {
+				t.Errorf("%s: missing samples %v, want %v", tt.field, f.MissingSamples, tt.wantSamples)
+			}
+		})
+	}
+}
+
+func TestQualityInvalidSamples(t *testing.T) {
+	laws := []api.LawInfo{completeLaw(1), completeLaw(2)}
+	laws[1].EffectDate = "2024-07-01"
+	laws[1].SerialNo = "A-17"
+
+	report := Quality(laws)
+
+	// A malformed serial number is reported; samples use the serial number as is
+	serial := fieldOf(t, report, "serial_no")
+	if serial.Invalid != 1 || strings.Join(serial.InvalidSamples, ",") != "A-17" {
+		t.Errorf("serial_no = %+v", serial)
+	}
+	effect := fieldOf(t, report, "effect_date")
+	if effect.Invalid != 1 || effect.InvalidRate != 0.5 || effect.Missing != 0 {
+		t.Errorf("effect_date = %+v", effect)
+	}
+
+	var names []string
+	for _, f := range report.Problems() {
+		names = append(names, f.Field)
+	}
+	if strings.Join(names, ",") != "serial_no,effect_date" {
+		t.Errorf("Problems() = %v", names)
+	}
+}
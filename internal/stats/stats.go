@@ -0,0 +1,104 @@
+package stats
+
+import (
+	"strings"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+// Unknown is the bucket used when a department or year is missing
+const Unknown = "미상"
+
+// Summary holds aggregated statistics for a set of search results.
+// It can be built in one pass with Compute or updated incrementally with Add;
+// both must always produce the same result for the same data.
+type Summary struct {
+	Total        int            `json:"total"`
+	ByDepartment map[string]int `json:"by_department"`
+	ByYear       map[string]int `json:"by_year"`
+}
+
+// New creates an empty summary
+func New() *Summary {
+	return &Summary{
+		ByDepartment: make(map[string]int),
+		ByYear:       make(map[string]int),
+	}
+}
+
+// Compute builds a summary from scratch
+func Compute(laws []api.LawInfo) *Summary {
+	s := New()
+	s.Add(laws)
+	return s
+}
+
+// Add folds newly appended laws into the summary
+func (s *Summary) Add(laws []api.LawInfo) {
+	if s.ByDepartment == nil {
+		s.ByDepartment = make(map[string]int)
+	}
+	if s.ByYear == nil {
+		s.ByYear = make(map[string]int)
+	}
+
+	for _, law := range laws {
+		s.Total++
+		s.ByDepartment[departmentOf(law)]++
+		s.ByYear[yearOf(law)]++
+	}
+}
+
+// Reset clears the summary, e.g. when the underlying results are invalidated
+func (s *Summary) Reset() {
+	s.Total = 0
+	s.ByDepartment = make(map[string]int)
+	s.ByYear = make(map[string]int)
+}
+
+// Equal reports whether two summaries hold the same counts
+func (s *Summary) Equal(other *Summary) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+	return s.Total == other.Total &&
+		equalCounts(s.ByDepartment, other.ByDepartment) &&
+		equalCounts(s.ByYear, other.ByYear)
+}
+
+// departmentOf returns the department bucket for a law
+func departmentOf(law api.LawInfo) string {
+	if dept := strings.TrimSpace(law.Department); dept != "" {
+		return dept
+	}
+	return Unknown
+}
+
+// yearOf returns the promulgation year bucket for a law (YYYYMMDD or YYYY.MM.DD)
+func yearOf(law api.LawInfo) string {
+	date := strings.TrimSpace(law.PromulDate)
+	if len(date) < 4 {
+		return Unknown
+	}
+	for _, r := range date[:4] {
+		if r < '0' || r > '9' {
+			return Unknown
+		}
+	}
+	return date[:4]
+}
+
+// equalCounts compares two count maps, ignoring zero entries
+func equalCounts(a, b map[string]int) bool {
+	for k, v := range a {
+		if v != 0 && b[k] != v {
+			return false
+		}
+	}
+	for k, v := range b {
+		if v != 0 && a[k] != v {
+			return false
+		}
+	}
+	return true
+}
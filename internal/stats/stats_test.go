@@ -0,0 +1,83 @@
+package stats
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+// sampleLaws generates n laws spread across departments and years
+func sampleLaws(n int) []api.LawInfo {
+	departments := []string{"법무부", "행정안전부", "", "개인정보보호위원회"}
+	dates := []string{"20200101", "2021.05.03", "", "20221231", "abcd1234"}
+
+	laws := make([]api.LawInfo, n)
+	for i := range laws {
+		laws[i] = api.LawInfo{
+			ID:         fmt.Sprintf("%03d", i),
+			Name:       fmt.Sprintf("테스트법 %d", i),
+			Department: departments[i%len(departments)],
+			PromulDate: dates[i%len(dates)],
+		}
+	}
+	return laws
+}
+
+func TestCompute(t *testing.T) {
+	laws := []api.LawInfo{
+		{Department: "법무부", PromulDate: "20200101"},
+		{Department: "법무부", PromulDate: "2021.05.03"},
+		{Department: "", PromulDate: ""},
+	}
+
+	s := Compute(laws)
+
+	if s.Total != 3 {
+		t.Errorf("Total = %d, want 3", s.Total)
+	}
+	if s.ByDepartment["법무부"] != 2 || s.ByDepartment[Unknown] != 1 {
+		t.Errorf("ByDepartment = %v", s.ByDepartment)
+	}
+	if s.ByYear["2020"] != 1 || s.ByYear["2021"] != 1 || s.ByYear[Unknown] != 1 {
+		t.Errorf("ByYear = %v", s.ByYear)
+	}
+}
+
+func TestAdd_MatchesFullRecompute(t *testing.T) {
+	laws := sampleLaws(57)
+
+	for _, chunk := range []int{1, 7, 10, 50, 57} {
+		t.Run(fmt.Sprintf("chunk_%d", chunk), func(t *testing.T) {
+			incremental := New()
+			for start := 0; start < len(laws); start += chunk {
+				end := start + chunk
+				if end > len(laws) {
+					end = len(laws)
+				}
+				incremental.Add(laws[start:end])
+
+				// Every intermediate state must match a full recompute of the prefix
+				if full := Compute(laws[:end]); !incremental.Equal(full) {
+					t.Fatalf("after %d laws: incremental %+v != full %+v", end, incremental, full)
+				}
+			}
+		})
+	}
+}
+
+func TestReset(t *testing.T) {
+	s := Compute(sampleLaws(10))
+	s.Reset()
+
+	if !s.Equal(New()) {
+		t.Errorf("Reset() should clear the summary, got %+v", s)
+	}
+
+	// A reset summary must rebuild to the same state as a fresh one
+	laws := sampleLaws(5)
+	s.Add(laws)
+	if !s.Equal(Compute(laws)) {
+		t.Errorf("rebuilt %+v != fresh %+v", s, Compute(laws))
+	}
+}
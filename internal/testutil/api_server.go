@@ -0,0 +1,271 @@
+package testutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// Search targets of the law.go.kr API, which APIServer routes by the target
+// parameter of a request
+const (
+	TargetLaw            = "law"    // 국가법령 (NLIC)
+	TargetOrdinance      = "ordin"  // 자치법규 (ELIS)
+	TargetPrecedent      = "prec"   // 판례
+	TargetAdmRule        = "admrul" // 행정규칙
+	TargetInterpretation = "expc"   // 법령해석례
+)
+
+// Item is a search result, keyed by the field names of the API such as
+// 법령ID and 법령명한글
+type Item map[string]string
+
+// SearchResult is a result page, written in the shape the API uses for the
+// target and type of each request
+type SearchResult struct {
+	Total int
+	Items []Item
+}
+
+// Reply is a canned response of APIServer. Body is written as is; without a
+// Body, Result is written as the search response of the target.
+type Reply struct {
+	// Status is the HTTP status, 200 if zero
+	Status int
+	Header http.Header
+	Body   string
+	Result *SearchResult
+}
+
+// Results returns a reply with total results, of which items are on the page
+func Results(total int, items ...Item) Reply {
+	return Reply{Result: &SearchResult{Total: total, Items: items}}
+}
+
+// Empty returns a reply without results
+func Empty() Reply {
+	return Results(0)
+}
+
+// HTMLError returns the HTML error page the API answers with, for example,
+// an invalid key, with message in its body
+func HTMLError(message string) Reply {
+	return Reply{
+		Header: http.Header{"Content-Type": {"text/html; charset=UTF-8"}},
+		Body:   "<!DOCTYPE html>\n<html><head><title>오류</title></head><body><p>" + message + "</p></body></html>",
+	}
+}
+
+// RateLimited returns a 429 reply, with a Retry-After header of seconds if
+// it is positive
+func RateLimited(seconds int) Reply {
+	reply := Reply{Status: http.StatusTooManyRequests, Header: http.Header{}}
+	if seconds > 0 {
+		reply.Header.Set("Retry-After", strconv.Itoa(seconds))
+	}
+	return reply
+}
+
+// Request is a search request received by APIServer
+type Request struct {
+	Target string
+	Query  string
+	Page   int
+	Params url.Values
+	Header http.Header
+}
+
+// APIServer is a mock of the law.go.kr search API for client tests. Requests
+// are routed by their target parameter; every target answers Empty until a
+// reply is registered. Unknown targets fail the test.
+//
+//	server := testutil.NewAPIServer(t)
+//	server.Handle(testutil.TargetLaw, testutil.Results(1, testutil.Item{"법령ID": "001"}))
+//	server.Handle(testutil.TargetOrdinance, testutil.RateLimited(1))
+type APIServer struct {
+	*httptest.Server
+
+	t        *testing.T
+	mu       sync.Mutex
+	routes   map[string]func(Request) Reply
+	queries  map[string]Reply
+	checks   []func(Request) error
+	requests []Request
+}
+
+// NewAPIServer starts an APIServer that is closed when the test ends
+func NewAPIServer(t *testing.T) *APIServer {
+	t.Helper()
+	s := &APIServer{
+		t:       t,
+		routes:  make(map[string]func(Request) Reply),
+		queries: make(map[string]Reply),
+	}
+	for _, target := range []string{TargetLaw, TargetOrdinance, TargetPrecedent, TargetAdmRule, TargetInterpretation} {
+		s.Handle(target, Empty())
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// Handle makes the search of target answer reply, replacing the previous one
+func (s *APIServer) Handle(target string, reply Reply) {
+	s.HandleFunc(target, func(Request) Reply { return reply })
+}
+
+// HandleFunc makes the search of target answer what fn returns for each
+// request, for replies that depend on the request
+func (s *APIServer) HandleFunc(target string, fn func(Request) Reply) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.routes[target] = fn
+}
+
+// HandleQuery makes the search of target for query answer reply, taking
+// precedence over the reply of Handle
+func (s *APIServer) HandleQuery(target, query string, reply Reply) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.queries[target+"\x00"+query] = reply
+}
+
+// Check registers a hook that validates every request; an error it returns
+// fails the test
+func (s *APIServer) Check(check func(Request) error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.checks = append(s.checks, check)
+}
+
+// Requests returns the requests received so far
+func (s *APIServer) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// serve answers a request with the reply of its target
+func (s *APIServer) serve(w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+	page, _ := strconv.Atoi(params.Get("page"))
+	req := Request{Target: params.Get("target"), Query: params.Get("query"), Page: page, Params: params, Header: r.Header.Clone()}
+
+	s.mu.Lock()
+	s.requests = append(s.requests, req)
+	checks := append([]func(Request) error(nil), s.checks...)
+	fn, routed := s.routes[req.Target]
+	reply, byQuery := s.queries[req.Target+"\x00"+req.Query]
+	s.mu.Unlock()
+
+	for _, check := range checks {
+		if err := check(req); err != nil {
+			s.t.Errorf("mock API request %s: %v", r.URL.RawQuery, err)
+		}
+	}
+	if !routed {
+		s.t.Errorf("mock API request for unknown target %q", req.Target)
+		http.NotFound(w, r)
+		return
+	}
+	if !byQuery {
+		reply = fn(req)
+	}
+
+	for key, values := range reply.Header {
+		for _, value := range values {
+			w.Header().Add(key, value)
+		}
+	}
+	body := reply.Body
+	if body == "" && reply.Result != nil {
+		var contentType string
+		body, contentType = renderResult(req, *reply.Result)
+		if w.Header().Get("Content-Type") == "" {
+			w.Header().Set("Content-Type", contentType)
+		}
+	}
+	if reply.Status != 0 {
+		w.WriteHeader(reply.Status)
+	}
+	fmt.Fprint(w, body)
+}
+
+// renderResult writes result in the shape of the API for the target and the
+// type parameter of req, and returns it with its content type
+func renderResult(req Request, result SearchResult) (string, string) {
+	page := req.Page
+	if page == 0 {
+		page = 1
+	}
+	items := result.Items
+	if items == nil {
+		items = []Item{}
+	}
+
+	switch req.Target {
+	case TargetOrdinance:
+		return renderJSON("OrdinSearch", map[string]interface{}{
+			"resultCode": "00",
+			"resultMsg":  "success",
+			"totalCnt":   strconv.Itoa(result.Total),
+			"page":       strconv.Itoa(page),
+			"law":        items,
+		}), "application/json"
+	case TargetPrecedent:
+		return renderXML("PrecSearch", "prec", result.Total, page, items), "application/xml"
+	case TargetAdmRule:
+		return renderXML("AdmRulSearch", "admrul", result.Total, page, items), "application/xml"
+	case TargetInterpretation:
+		return renderXML("Expc", "expc", result.Total, page, items), "application/xml"
+	default:
+		if strings.EqualFold(req.Params.Get("type"), "json") {
+			return renderJSON("LawSearch", map[string]interface{}{
+				"totalCnt": strconv.Itoa(result.Total),
+				"page":     strconv.Itoa(page),
+				"law":      items,
+			}), "application/json"
+		}
+		return renderXML("LawSearch", "law", result.Total, page, items), "application/xml"
+	}
+}
+
+// renderJSON writes fields wrapped in an object named root
+func renderJSON(root string, fields map[string]interface{}) string {
+	data, _ := json.Marshal(map[string]interface{}{root: fields})
+	return string(data)
+}
+
+// renderXML writes items as elements named item of a root element, with
+// their fields in key order
+func renderXML(root, item string, total, page int, items []Item) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<%s><totalCnt>%d</totalCnt><page>%d</page>", root, total, page)
+	for _, fields := range items {
+		keys := make([]string, 0, len(fields))
+		for key := range fields {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		fmt.Fprintf(&b, "<%s>", item)
+		for _, key := range keys {
+			fmt.Fprintf(&b, "<%s>%s</%s>", key, escapeXML(fields[key]), key)
+		}
+		fmt.Fprintf(&b, "</%s>", item)
+	}
+	fmt.Fprintf(&b, "</%s>", root)
+	return b.String()
+}
+
+// escapeXML escapes the characters that are special in XML text
+func escapeXML(s string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(s)
+}
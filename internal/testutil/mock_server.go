@@ -7,7 +7,8 @@ import (
 	"net/url"
 )
 
-// MockServer represents a mock API server for testing
+// MockServer represents a mock API server for testing. It answers NLIC
+// searches only; APIServer routes the searches of every source.
 type MockServer struct {
 	*httptest.Server
 	Responses map[string]MockResponse
@@ -0,0 +1,101 @@
+package vocab
+
+import (
+	"sort"
+	"strings"
+)
+
+// maxSuggestions is the number of department names suggested for an unknown one
+const maxSuggestions = 3
+
+// KnownDepartments lists the central administrative agencies and other bodies
+// that appear as the department of laws. Departments created later are learned
+// from search results instead (see Store).
+var KnownDepartments = []string{
+	// 부
+	"기획재정부", "교육부", "과학기술정보통신부", "외교부", "통일부", "법무부", "국방부",
+	"행정안전부", "국가보훈부", "문화체육관광부", "농림축산식품부", "산업통상자원부",
+	"보건복지부", "환경부", "고용노동부", "여성가족부", "국토교통부", "해양수산부",
+	"중소벤처기업부",
+	// 처
+	"인사혁신처", "법제처", "식품의약품안전처",
+	// 청
+	"국세청", "관세청", "조달청", "통계청", "재외동포청", "검찰청", "병무청", "방위사업청",
+	"경찰청", "소방청", "국가유산청", "농촌진흥청", "산림청", "특허청", "질병관리청",
+	"기상청", "행정중심복합도시건설청", "새만금개발청", "해양경찰청", "우주항공청",
+	// 위원회와 그 밖의 기관
+	"국무조정실", "방송통신위원회", "공정거래위원회", "금융위원회", "국민권익위원회",
+	"개인정보보호위원회", "원자력안전위원회", "국가인권위원회", "감사원", "국가정보원",
+	"고위공직자범죄수사처", "대법원", "헌법재판소", "국회", "중앙선거관리위원회",
+}
+
+// SuggestDepartments returns up to maxSuggestions names of candidates similar
+// to department, the closest first. A name is similar when it contains
+// department (an abbreviation such as "복지부") or is within an edit distance
+// of a third of its length, spaces ignored.
+func SuggestDepartments(department string, candidates []string) []string {
+	input := []rune(strings.ReplaceAll(department, " ", ""))
+	if len(input) == 0 {
+		return nil
+	}
+	limit := len(input) / 3
+	if limit < 1 {
+		limit = 1
+	}
+
+	type match struct {
+		name     string
+		distance int
+	}
+	var matches []match
+	seen := make(map[string]bool)
+	for _, name := range candidates {
+		key := strings.ReplaceAll(name, " ", "")
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		distance := EditDistance(string(input), key)
+		if distance == 0 {
+			continue
+		}
+		if distance <= limit || strings.Contains(key, string(input)) {
+			matches = append(matches, match{name, distance})
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].distance < matches[j].distance
+	})
+	if len(matches) > maxSuggestions {
+		matches = matches[:maxSuggestions]
+	}
+	names := make([]string, len(matches))
+	for i, m := range matches {
+		names[i] = m.name
+	}
+	return names
+}
+
+// EditDistance returns the Levenshtein distance of a and b in characters
+func EditDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
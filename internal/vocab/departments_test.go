@@ -0,0 +1,58 @@
+package vocab
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"법무부", "법무부", 0},
+		{"법무부", "", 3},
+		{"행정안전부", "행정안정부", 1},
+		{"국토교통부", "국토부", 2},
+		{"기획재정부", "기획제정부", 1},
+	}
+	for _, tt := range tests {
+		if got := EditDistance(tt.a, tt.b); got != tt.want {
+			t.Errorf("EditDistance(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestSuggestDepartments(t *testing.T) {
+	tests := []struct {
+		name       string
+		department string
+		want       []string
+	}{
+		{"typo", "행정안정부", []string{"행정안전부"}},
+		{"spaces ignored", "기획 제정부", []string{"기획재정부"}},
+		{"abbreviation", "복지부", []string{"보건복지부"}},
+		{"several close names", "국방청", []string{"국방부", "국세청", "소방청"}},
+		{"exact name is not suggested", "법무부", []string{}},
+		{"unrelated", "우주정거장", []string{}},
+		{"empty", " ", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SuggestDepartments(tt.department, KnownDepartments)
+			if len(got) == 0 && len(tt.want) == 0 {
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SuggestDepartments(%q) = %v, want %v", tt.department, got, tt.want)
+			}
+		})
+	}
+
+	// Learned names are suggested like the bundled ones, at most maxSuggestions
+	learned := []string{"가부", "나부", "다부", "라부", "다부"}
+	if got := SuggestDepartments("마부", learned); len(got) != maxSuggestions {
+		t.Errorf("SuggestDepartments() = %v, want %d names", got, maxSuggestions)
+	}
+}
@@ -0,0 +1,245 @@
+// Package vocab learns the departments and law types seen in search results,
+// so that --department and --law-type can complete names the bundled lists
+// do not know yet, such as a newly created ministry.
+//
+// Each value records how many distinct result sets it appeared in and when it
+// was last seen. Learning the same result set again changes nothing, so
+// retried or repeated searches do not inflate the counts.
+package vocab
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/fileutil"
+)
+
+const (
+	// FileName is the name of the vocabulary file inside the config directory
+	FileName = "vocab.json"
+
+	// maxValues is the number of values kept per kind; the least used and
+	// oldest are dropped beyond it
+	maxValues = 500
+	// maxSeen is the number of learned result sets remembered to skip repeats
+	maxSeen = 200
+)
+
+// Kinds of learned values
+const (
+	KindDepartment = "department"
+	KindLawType    = "law_type"
+)
+
+// Kinds lists the kinds of learned values in display order
+var Kinds = []string{KindDepartment, KindLawType}
+
+// lockTimeout is how long Learn and Clear wait for another writer
+var lockTimeout = 2 * time.Second
+
+// ErrLocked is returned when the vocabulary file stays locked by another process
+var ErrLocked = errors.New("vocabulary file is locked by another process")
+
+// Entry is a learned value
+type Entry struct {
+	Value    string    `json:"value"`
+	Count    int       `json:"count"`
+	LastSeen time.Time `json:"last_seen"`
+}
+
+// Observation is the values of one result set. Key identifies the result set,
+// for example by its fingerprint; an empty key is never treated as a repeat.
+type Observation struct {
+	Key    string
+	Values map[string][]string // kind -> values
+	At     time.Time
+}
+
+// file is the layout of the vocabulary file
+type file struct {
+	Values map[string][]Entry `json:"values"`
+	Seen   []string           `json:"seen,omitempty"`
+}
+
+// Store reads and writes the vocabulary file
+type Store struct {
+	path string
+}
+
+// New creates a store for the vocabulary file at path
+func New(path string) *Store {
+	return &Store{path: path}
+}
+
+// Path returns the vocabulary file path
+func (s *Store) Path() string {
+	return s.path
+}
+
+// List returns the learned values of kind, most used first and, among
+// values used as often, most recently seen first. A missing vocabulary file
+// is not an error.
+func (s *Store) List(kind string) ([]Entry, error) {
+	f, err := s.read()
+	if err != nil {
+		return nil, err
+	}
+	return f.Values[kind], nil
+}
+
+// Values returns the learned values of kind in List order
+func (s *Store) Values(kind string) ([]string, error) {
+	entries, err := s.List(kind)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]string, len(entries))
+	for i, e := range entries {
+		values[i] = e.Value
+	}
+	return values, nil
+}
+
+// Learn counts the values of obs once each, unless the result set of its key
+// was learned before. Blank values are ignored.
+func (s *Store) Learn(obs Observation) error {
+	if obs.At.IsZero() {
+		obs.At = time.Now()
+	}
+
+	return s.withLock(func() error {
+		f, err := s.read()
+		if err != nil {
+			// A corrupt vocabulary file should not block new searches
+			f = &file{}
+		}
+		if f.Values == nil {
+			f.Values = make(map[string][]Entry)
+		}
+
+		if obs.Key != "" {
+			for _, key := range f.Seen {
+				if key == obs.Key {
+					return nil
+				}
+			}
+			f.Seen = append(f.Seen, obs.Key)
+			if len(f.Seen) > maxSeen {
+				f.Seen = f.Seen[len(f.Seen)-maxSeen:]
+			}
+		}
+
+		changed := obs.Key != ""
+		for kind, values := range obs.Values {
+			if learnValues(f, kind, values, obs.At) {
+				changed = true
+			}
+		}
+		if !changed {
+			return nil
+		}
+		return s.write(f)
+	})
+}
+
+// learnValues counts each distinct value of kind once and reports whether
+// any was counted
+func learnValues(f *file, kind string, values []string, at time.Time) bool {
+	index := make(map[string]int, len(f.Values[kind]))
+	entries := f.Values[kind]
+	for i, e := range entries {
+		index[e.Value] = i
+	}
+
+	counted := make(map[string]bool)
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "" || counted[value] {
+			continue
+		}
+		counted[value] = true
+
+		if i, ok := index[value]; ok {
+			entries[i].Count++
+			if at.After(entries[i].LastSeen) {
+				entries[i].LastSeen = at
+			}
+			continue
+		}
+		index[value] = len(entries)
+		entries = append(entries, Entry{Value: value, Count: 1, LastSeen: at})
+	}
+	if len(counted) == 0 {
+		return false
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].Count != entries[j].Count {
+			return entries[i].Count > entries[j].Count
+		}
+		if !entries[i].LastSeen.Equal(entries[j].LastSeen) {
+			return entries[i].LastSeen.After(entries[j].LastSeen)
+		}
+		return entries[i].Value < entries[j].Value
+	})
+	if len(entries) > maxValues {
+		entries = entries[:maxValues]
+	}
+	f.Values[kind] = entries
+	return true
+}
+
+// Clear removes all learned values
+func (s *Store) Clear() error {
+	return s.withLock(func() error {
+		if err := os.Remove(s.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to clear vocabulary: %w", err)
+		}
+		return nil
+	})
+}
+
+// read loads the vocabulary file; a missing file is an empty vocabulary
+func (s *Store) read() (*file, error) {
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return &file{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read vocabulary: %w", err)
+	}
+
+	var f file
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("failed to parse vocabulary %s: %w", s.path, err)
+	}
+	return &f, nil
+}
+
+// write replaces the vocabulary file atomically so readers never see a partial file
+func (s *Store) write(f *file) error {
+	data, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode vocabulary: %w", err)
+	}
+
+	if err := fileutil.WriteAtomic(s.path, data); err != nil {
+		return fmt.Errorf("failed to write vocabulary: %w", err)
+	}
+	return nil
+}
+
+// withLock runs fn while holding an exclusive lock file next to the
+// vocabulary file, so concurrent searches do not lose each other's values
+func (s *Store) withLock(fn func() error) error {
+	err := fileutil.WithLock(s.path, lockTimeout, fn)
+	if errors.Is(err, fileutil.ErrLocked) {
+		return ErrLocked
+	}
+	return err
+}
@@ -0,0 +1,180 @@
+package vocab
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+func newTestStore(t *testing.T) *Store {
+	t.Helper()
+	return New(filepath.Join(t.TempDir(), FileName))
+}
+
+func TestStoreLearnAndList(t *testing.T) {
+	store := newTestStore(t)
+
+	values, err := store.Values(KindDepartment)
+	if err != nil || len(values) != 0 {
+		t.Fatalf("Values() on missing file = %v, %v; want empty", values, err)
+	}
+
+	base := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	for i, obs := range []Observation{
+		{Key: "a", Values: map[string][]string{KindDepartment: {"법무부", "법무부", " 행정안전부 "}, KindLawType: {"법률"}}},
+		{Key: "b", Values: map[string][]string{KindDepartment: {"법무부", ""}, KindLawType: {"대통령령"}}},
+		{Key: "c", Values: map[string][]string{KindDepartment: {"개인정보보호위원회"}}},
+	} {
+		obs.At = base.Add(time.Duration(i) * time.Hour)
+		if err := store.Learn(obs); err != nil {
+			t.Fatalf("Learn() error: %v", err)
+		}
+	}
+
+	entries, err := store.List(KindDepartment)
+	if err != nil {
+		t.Fatalf("List() error: %v", err)
+	}
+	want := []Entry{
+		{Value: "법무부", Count: 2, LastSeen: base.Add(time.Hour)},
+		{Value: "개인정보보호위원회", Count: 1, LastSeen: base.Add(2 * time.Hour)},
+		{Value: "행정안전부", Count: 1, LastSeen: base},
+	}
+	if !reflect.DeepEqual(entries, want) {
+		t.Errorf("List(department) = %+v, want %+v (by count, then most recent)", entries, want)
+	}
+
+	// The more recent of values seen as often comes first
+	lawTypes, _ := store.Values(KindLawType)
+	if !reflect.DeepEqual(lawTypes, []string{"대통령령", "법률"}) {
+		t.Errorf("Values(law_type) = %v", lawTypes)
+	}
+}
+
+func TestStoreLearnIdempotent(t *testing.T) {
+	store := newTestStore(t)
+	obs := Observation{Key: "fp", Values: map[string][]string{KindDepartment: {"법무부"}}}
+
+	for i := 0; i < 3; i++ {
+		if err := store.Learn(obs); err != nil {
+			t.Fatalf("Learn() error: %v", err)
+		}
+	}
+	before, _ := os.ReadFile(store.Path())
+	if err := store.Learn(obs); err != nil {
+		t.Fatalf("Learn() error: %v", err)
+	}
+	after, _ := os.ReadFile(store.Path())
+
+	entries, _ := store.List(KindDepartment)
+	if len(entries) != 1 || entries[0].Count != 1 {
+		t.Errorf("learning a result set again should not count it, got %+v", entries)
+	}
+	if string(before) != string(after) {
+		t.Error("learning a result set again should not rewrite the file")
+	}
+}
+
+func TestStoreConcurrentLearn(t *testing.T) {
+	store := newTestStore(t)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 40)
+	for i := 0; i < 20; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			errs <- store.Learn(Observation{
+				Key:    fmt.Sprintf("search-%d", i),
+				Values: map[string][]string{KindDepartment: {"법무부", fmt.Sprintf("부처%d", i)}},
+			})
+		}(i)
+		// The same result set learned by concurrent searches counts once
+		go func() {
+			defer wg.Done()
+			errs <- store.Learn(Observation{Key: "same", Values: map[string][]string{KindLawType: {"법률"}}})
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("concurrent Learn() error: %v", err)
+		}
+	}
+
+	departments, _ := store.List(KindDepartment)
+	if len(departments) != 21 || departments[0].Value != "법무부" || departments[0].Count != 20 {
+		t.Errorf("departments = %d values, first %+v; want 21 with 법무부 counted 20 times", len(departments), departments[0])
+	}
+	lawTypes, _ := store.List(KindLawType)
+	if len(lawTypes) != 1 || lawTypes[0].Count != 1 {
+		t.Errorf("law types = %+v, want 법률 counted once", lawTypes)
+	}
+}
+
+func TestStoreLimits(t *testing.T) {
+	store := newTestStore(t)
+
+	values := make([]string, maxValues+10)
+	for i := range values {
+		values[i] = fmt.Sprintf("부처%03d", i)
+	}
+	if err := store.Learn(Observation{Values: map[string][]string{KindDepartment: values}}); err != nil {
+		t.Fatalf("Learn() error: %v", err)
+	}
+	for i := 0; i < maxSeen+5; i++ {
+		if err := store.Learn(Observation{Key: fmt.Sprint(i), Values: map[string][]string{KindLawType: {"법률"}}}); err != nil {
+			t.Fatalf("Learn() error: %v", err)
+		}
+	}
+
+	f, err := store.read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(f.Values[KindDepartment]) != maxValues || len(f.Seen) != maxSeen {
+		t.Errorf("kept %d values and %d keys, want %d and %d", len(f.Values[KindDepartment]), len(f.Seen), maxValues, maxSeen)
+	}
+	// The oldest keys are forgotten, so that result set counts again
+	if err := store.Learn(Observation{Key: "0", Values: map[string][]string{KindLawType: {"법률"}}}); err != nil {
+		t.Fatal(err)
+	}
+	if lawTypes, _ := store.List(KindLawType); lawTypes[0].Count != maxSeen+6 {
+		t.Errorf("법률 count = %d, want %d", lawTypes[0].Count, maxSeen+6)
+	}
+}
+
+func TestStoreClear(t *testing.T) {
+	store := newTestStore(t)
+	if err := store.Clear(); err != nil {
+		t.Fatalf("Clear() on missing file: %v", err)
+	}
+	store.Learn(Observation{Values: map[string][]string{KindDepartment: {"법무부"}}})
+	if err := store.Clear(); err != nil {
+		t.Fatalf("Clear() error: %v", err)
+	}
+	if values, _ := store.Values(KindDepartment); len(values) != 0 {
+		t.Errorf("values after Clear() = %v", values)
+	}
+}
+
+func TestStoreCorruptFile(t *testing.T) {
+	store := newTestStore(t)
+	if err := os.WriteFile(store.Path(), []byte("{not json"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.List(KindDepartment); err == nil {
+		t.Error("List() should report a corrupt file")
+	}
+	if err := store.Learn(Observation{Values: map[string][]string{KindDepartment: {"법무부"}}}); err != nil {
+		t.Fatalf("Learn() should replace a corrupt file: %v", err)
+	}
+	if values, _ := store.Values(KindDepartment); !reflect.DeepEqual(values, []string{"법무부"}) {
+		t.Errorf("values = %v", values)
+	}
+}
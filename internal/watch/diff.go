@@ -0,0 +1,70 @@
+// Package watch re-runs a search periodically and highlights what changed
+// between consecutive results.
+package watch
+
+import (
+	"reflect"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+// Change describes how a result differs from the previous refresh
+type Change int
+
+const (
+	// Unchanged means the result was present before with the same content
+	Unchanged Change = iota
+	// Added means the result was not present in the previous refresh
+	Added
+	// Modified means the result was present but its content changed
+	Modified
+)
+
+// String returns the Korean label of the change, empty for unchanged results
+func (c Change) String() string {
+	switch c {
+	case Added:
+		return "신규"
+	case Modified:
+		return "변경"
+	default:
+		return ""
+	}
+}
+
+// resultKey identifies a result across refreshes
+func resultKey(law api.LawInfo) string {
+	switch {
+	case law.ID != "":
+		return "id:" + law.ID
+	case law.SerialNo != "":
+		return "serial:" + law.SerialNo
+	default:
+		return "name:" + law.Name
+	}
+}
+
+// Diff compares the current results with the previous ones and returns the change
+// of each current result, in the same order. A nil previous slice means there
+// is nothing to compare against, so every result is unchanged.
+func Diff(prev, curr []api.LawInfo) []Change {
+	changes := make([]Change, len(curr))
+	if prev == nil {
+		return changes
+	}
+
+	before := make(map[string]api.LawInfo, len(prev))
+	for _, law := range prev {
+		before[resultKey(law)] = law
+	}
+	for i, law := range curr {
+		old, ok := before[resultKey(law)]
+		switch {
+		case !ok:
+			changes[i] = Added
+		case !reflect.DeepEqual(old, law):
+			changes[i] = Modified
+		}
+	}
+	return changes
+}
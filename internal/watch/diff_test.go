@@ -0,0 +1,40 @@
+package watch
+
+import (
+	"testing"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+func TestDiff(t *testing.T) {
+	prev := []api.LawInfo{
+		{ID: "1", Name: "주차장 조례", EffectDate: "20240101"},
+		{ID: "2", Name: "도로 조례", EffectDate: "20240101"},
+		{SerialNo: "S3", Name: "공원 조례"},
+	}
+	curr := []api.LawInfo{
+		{ID: "2", Name: "도로 조례", EffectDate: "20240301"},
+		{ID: "4", Name: "신규 조례"},
+		{ID: "1", Name: "주차장 조례", EffectDate: "20240101"},
+		{SerialNo: "S3", Name: "공원 조례"},
+	}
+
+	got := Diff(prev, curr)
+	want := []Change{Modified, Added, Unchanged, Unchanged}
+	if len(got) != len(want) {
+		t.Fatalf("Diff() returned %d changes, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("change[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestDiffWithoutPrevious(t *testing.T) {
+	for i, c := range Diff(nil, []api.LawInfo{{ID: "1"}, {ID: "2"}}) {
+		if c != Unchanged {
+			t.Errorf("change[%d] = %v, want unchanged on the first refresh", i, c)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package watch
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"strings"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+// Fingerprint returns the SHA-256 fingerprint of a result set, in hex. It
+// covers the identifying and dated fields of each law, trimmed and sorted by
+// result, so the order of the results, the page they are shown on and how
+// they are displayed do not change it. The same results always give the same
+// fingerprint; an empty set has the fingerprint of no data.
+func Fingerprint(laws []api.LawInfo) string {
+	records := make([]string, len(laws))
+	for i, law := range laws {
+		records[i] = fingerprintRecord(law)
+	}
+	// The result key comes first in each record, so this orders by result and
+	// then by content for results of the same key
+	sort.Strings(records)
+
+	hash := sha256.New()
+	for _, record := range records {
+		hash.Write([]byte(record))
+		hash.Write([]byte{'\x1e'})
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// fingerprintRecord joins the fields of law that make up its fingerprint with
+// a unit separator, which does not occur in the API data
+func fingerprintRecord(law api.LawInfo) string {
+	fields := []string{
+		resultKey(law),
+		law.ID,
+		law.SerialNo,
+		law.Name,
+		law.LawType,
+		law.Department,
+		law.Category,
+		law.PromulDate,
+		law.PromulNo,
+		law.EffectDate,
+	}
+	for i, field := range fields {
+		fields[i] = strings.TrimSpace(field)
+	}
+	return strings.Join(fields, "\x1f")
+}
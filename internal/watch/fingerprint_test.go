@@ -0,0 +1,98 @@
+package watch
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+var fingerprintLaws = []api.LawInfo{
+	{ID: "001", Name: "도로교통법", LawType: "법률", Department: "경찰청", EffectDate: "20240101"},
+	{ID: "002", Name: "도로교통법 시행령", LawType: "대통령령", Department: "경찰청", EffectDate: "20240101"},
+	{SerialNo: "9001", Name: "서울특별시 주차장 설치 조례", LawType: "조례", Source: "자치법규"},
+}
+
+func TestFingerprintDeterministic(t *testing.T) {
+	want := Fingerprint(fingerprintLaws)
+	if len(want) != 64 {
+		t.Fatalf("Fingerprint() = %q, want 64 hex digits of SHA-256", want)
+	}
+
+	// Order, surrounding spaces and display-only fields do not matter
+	reordered := []api.LawInfo{fingerprintLaws[2], fingerprintLaws[0], fingerprintLaws[1]}
+	reordered[1].Name = "  도로교통법 "
+	reordered[0].Source = ""
+	reordered[2].NameAbbrev = "도교법"
+	for i := 0; i < 3; i++ {
+		if got := Fingerprint(reordered); got != want {
+			t.Errorf("Fingerprint() of the same results = %s, want %s", got, want)
+		}
+	}
+}
+
+func TestFingerprintChanges(t *testing.T) {
+	base := Fingerprint(fingerprintLaws)
+
+	tests := []struct {
+		name   string
+		change func([]api.LawInfo) []api.LawInfo
+	}{
+		{"effect date", func(laws []api.LawInfo) []api.LawInfo { laws[0].EffectDate = "20250101"; return laws }},
+		{"department", func(laws []api.LawInfo) []api.LawInfo { laws[1].Department = "국토교통부"; return laws }},
+		{"removed", func(laws []api.LawInfo) []api.LawInfo { return laws[:2] }},
+		{"duplicated", func(laws []api.LawInfo) []api.LawInfo { return append(laws, laws[0]) }},
+		// Fields are separated, so moving text between them is a change
+		{"field boundary", func(laws []api.LawInfo) []api.LawInfo {
+			laws[0].Name, laws[0].LawType = "도로교통법법률", ""
+			return laws
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			laws := tt.change(append([]api.LawInfo(nil), fingerprintLaws...))
+			if Fingerprint(laws) == base {
+				t.Errorf("changing the %s should change the fingerprint", tt.name)
+			}
+		})
+	}
+
+	if Fingerprint(nil) != Fingerprint([]api.LawInfo{}) {
+		t.Error("nil and empty results should have the same fingerprint")
+	}
+}
+
+func TestViewFingerprintChange(t *testing.T) {
+	// Only Source changes between refreshes, which the fingerprint leaves out
+	calls := 0
+	v := &View{
+		Fetch: func(ctx context.Context) (*api.SearchResponse, error) {
+			calls++
+			laws := append([]api.LawInfo(nil), fingerprintLaws...)
+			if calls > 1 {
+				laws[0].Source = "국가법령"
+			}
+			return &api.SearchResponse{TotalCount: len(laws), Laws: laws}, nil
+		},
+		Interval: time.Hour,
+	}
+	var out syncBuffer
+	v.Out = &out
+
+	keys := make(chan byte, 2)
+	keys <- KeyRefresh
+	keys <- KeyQuit
+	v.Keys = keys
+	if err := v.Run(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	screens := strings.Split(out.String(), clearScreen)
+	last := screens[len(screens)-1]
+	if !strings.Contains(last, "신규 0 · 변경 0") || !strings.Contains(last, "지문: "+Fingerprint(fingerprintLaws)[:12]) {
+		t.Errorf("a refresh with the same fingerprint should show no changes, got:\n%s", last)
+	}
+}
@@ -0,0 +1,232 @@
+package watch
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/output"
+)
+
+// Key bindings of the live view
+const (
+	KeyQuit    = 'q'
+	KeyPause   = 'p'
+	KeyRefresh = 'r'
+	// keyInterrupt is Ctrl-C, which arrives as a byte while the terminal is in raw mode
+	keyInterrupt = 0x03
+)
+
+// clearScreen moves the cursor home and clears the terminal
+const clearScreen = "\x1b[H\x1b[2J"
+
+// Fetcher runs the search shown by the live view
+type Fetcher func(ctx context.Context) (*api.SearchResponse, error)
+
+// View periodically re-runs a search and redraws the results, highlighting
+// results that are new or changed since the previous refresh
+type View struct {
+	// Fetch runs the search
+	Fetch Fetcher
+	// Interval is the time between refreshes
+	Interval time.Duration
+	// Out receives the rendered screens
+	Out io.Writer
+	// Keys delivers key presses; nil disables key handling
+	Keys <-chan byte
+	// Status describes the search (query, filters, sort) and stays on top of every screen
+	Status string
+	// UseColor highlights new and changed results in color
+	UseColor bool
+	// RawMode translates line feeds for a terminal in raw mode
+	RawMode bool
+
+	now func() time.Time
+}
+
+// viewState is what the view shows between refreshes
+type viewState struct {
+	resp        *api.SearchResponse
+	changes     []Change
+	fingerprint string
+	err         error
+	updatedAt   time.Time
+	paused      bool
+}
+
+// Run refreshes and redraws until ctx is cancelled or the quit key is pressed.
+// Refresh errors are shown on screen and do not stop the view.
+func (v *View) Run(ctx context.Context) error {
+	if v.Fetch == nil {
+		return fmt.Errorf("검색 함수가 설정되지 않았습니다")
+	}
+	if v.Interval <= 0 {
+		return fmt.Errorf("갱신 주기는 0보다 커야 합니다: %s", v.Interval)
+	}
+	if v.now == nil {
+		v.now = time.Now
+	}
+
+	var state viewState
+	var prev []api.LawInfo
+	refresh := func() {
+		resp, err := v.Fetch(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			state.err = err
+			return
+		}
+		// Results with the fingerprint of the previous refresh have not changed,
+		// even if fields outside the fingerprint did
+		fingerprint := Fingerprint(resp.Laws)
+		if prev != nil && fingerprint == state.fingerprint {
+			state.changes = make([]Change, len(resp.Laws))
+		} else {
+			state.changes = Diff(prev, resp.Laws)
+		}
+		state.fingerprint = fingerprint
+		state.resp = resp
+		state.err = nil
+		state.updatedAt = v.now()
+		prev = resp.Laws
+	}
+
+	refresh()
+	v.render(state)
+
+	ticker := time.NewTicker(v.Interval)
+	defer ticker.Stop()
+
+	keys := v.Keys
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if state.paused {
+				continue
+			}
+			refresh()
+		case key, ok := <-keys:
+			if !ok {
+				keys = nil
+				continue
+			}
+			switch key {
+			case KeyQuit, 'Q', keyInterrupt:
+				return nil
+			case KeyPause, 'P', ' ':
+				state.paused = !state.paused
+			case KeyRefresh, 'R':
+				refresh()
+			default:
+				continue
+			}
+		}
+		if ctx.Err() != nil {
+			return nil
+		}
+		v.render(state)
+	}
+}
+
+// render draws one screen
+func (v *View) render(state viewState) {
+	var b strings.Builder
+	b.WriteString(clearScreen)
+
+	if v.Status != "" {
+		fmt.Fprintln(&b, v.Status)
+	}
+
+	running := "실행 중"
+	if state.paused {
+		running = "일시정지"
+	}
+	updated := "-"
+	if !state.updatedAt.IsZero() {
+		updated = state.updatedAt.Format("15:04:05")
+	}
+	added, modified := countChanges(state.changes)
+	fmt.Fprintf(&b, "갱신: %s | 주기: %s | 상태: %s | 신규 %d · 변경 %d", updated, v.Interval, running, added, modified)
+	if state.fingerprint != "" {
+		fmt.Fprintf(&b, " | 지문: %s", state.fingerprint[:12])
+	}
+	b.WriteString("\n")
+	fmt.Fprintf(&b, "%c: 종료  %c: 일시정지/재개  %c: 즉시 갱신\n\n", KeyQuit, KeyPause, KeyRefresh)
+
+	if state.err != nil {
+		fmt.Fprintf(&b, "⚠️  갱신 실패: %v\n\n", state.err)
+	}
+
+	switch {
+	case state.resp == nil:
+		fmt.Fprintln(&b, "검색 결과를 불러오는 중입니다...")
+	case len(state.resp.Laws) == 0:
+		fmt.Fprintln(&b, "검색 결과가 없습니다.")
+	default:
+		fmt.Fprintf(&b, "총 %d개 중 %d개 표시\n", state.resp.TotalCount, len(state.resp.Laws))
+		b.WriteString(v.renderTable(state.resp.Laws, state.changes))
+	}
+
+	screen := b.String()
+	if v.RawMode {
+		screen = strings.ReplaceAll(screen, "\n", "\r\n")
+	}
+	fmt.Fprint(v.Out, screen)
+}
+
+// renderTable renders the results with a change column
+func (v *View) renderTable(laws []api.LawInfo, changes []Change) string {
+	headers := []string{"변경", "번호", "법령명", "구분", "소관부처", "시행일자"}
+	rows := make([][]string, 0, len(laws))
+	for i, law := range laws {
+		change := Unchanged
+		if i < len(changes) {
+			change = changes[i]
+		}
+		rows = append(rows, []string{
+			v.changeLabel(change),
+			fmt.Sprintf("%d", i+1),
+			law.Name,
+			law.LawType,
+			law.Department,
+			law.EffectDate,
+		})
+	}
+
+	style := output.GetDefaultTableStyle()
+	style.UseColor = v.UseColor
+	return output.RenderTable(headers, rows, style)
+}
+
+// changeLabel returns the change label, colored when enabled
+func (v *View) changeLabel(change Change) string {
+	label := change.String()
+	if !v.UseColor || label == "" {
+		return label
+	}
+	if change == Added {
+		return color.New(color.FgGreen, color.Bold).Sprint(label)
+	}
+	return color.New(color.FgYellow, color.Bold).Sprint(label)
+}
+
+// countChanges counts the added and modified results
+func countChanges(changes []Change) (added, modified int) {
+	for _, c := range changes {
+		switch c {
+		case Added:
+			added++
+		case Modified:
+			modified++
+		}
+	}
+	return added, modified
+}
@@ -0,0 +1,147 @@
+package watch
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+)
+
+// syncBuffer is a bytes.Buffer safe for concurrent reads from the test
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+// countingFetcher returns one more result on every call
+func countingFetcher(calls *int32) Fetcher {
+	return func(ctx context.Context) (*api.SearchResponse, error) {
+		n := atomic.AddInt32(calls, 1)
+		laws := make([]api.LawInfo, n)
+		for i := range laws {
+			laws[i] = api.LawInfo{ID: string(rune('A' + i)), Name: "조례 " + string(rune('A'+i))}
+		}
+		return &api.SearchResponse{TotalCount: int(n), Page: 1, Laws: laws}, nil
+	}
+}
+
+func runView(t *testing.T, v *View, ctx context.Context) <-chan error {
+	t.Helper()
+	done := make(chan error, 1)
+	go func() { done <- v.Run(ctx) }()
+	return done
+}
+
+func waitDone(t *testing.T, done <-chan error) {
+	t.Helper()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("Run() error = %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Run() did not return")
+	}
+}
+
+func TestViewStopsOnContextCancel(t *testing.T) {
+	var calls int32
+	var out syncBuffer
+	v := &View{Fetch: countingFetcher(&calls), Interval: 10 * time.Millisecond, Out: &out}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := runView(t, v, ctx)
+
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+	waitDone(t, done)
+
+	if atomic.LoadInt32(&calls) < 2 {
+		t.Errorf("Expected periodic refreshes, got %d", calls)
+	}
+	if !strings.Contains(out.String(), "신규") {
+		t.Errorf("Results added between refreshes should be highlighted, got:\n%s", out.String())
+	}
+
+	// No refresh may happen once Run has returned
+	after := atomic.LoadInt32(&calls)
+	time.Sleep(30 * time.Millisecond)
+	if atomic.LoadInt32(&calls) != after {
+		t.Error("Fetch was called after Run returned")
+	}
+}
+
+func TestViewKeys(t *testing.T) {
+	var calls int32
+	var out syncBuffer
+	keys := make(chan byte)
+	v := &View{Fetch: countingFetcher(&calls), Interval: 10 * time.Millisecond, Out: &out, Keys: keys, Status: "검색어: 주차"}
+
+	done := runView(t, v, context.Background())
+
+	// The loop only receives the next key once the previous one has been handled
+	keys <- KeyPause
+	keys <- 'x'
+	paused := atomic.LoadInt32(&calls)
+	time.Sleep(50 * time.Millisecond)
+	if got := atomic.LoadInt32(&calls); got != paused {
+		t.Errorf("Fetch was called %d times while paused", got-paused)
+	}
+	if !strings.Contains(out.String(), "상태: 일시정지") {
+		t.Errorf("Paused state should be shown, got:\n%s", out.String())
+	}
+
+	keys <- KeyRefresh
+	keys <- 'x'
+	if got := atomic.LoadInt32(&calls); got != paused+1 {
+		t.Errorf("Refresh key should fetch once, got %d calls", got-paused)
+	}
+
+	keys <- KeyQuit
+	waitDone(t, done)
+
+	if !strings.Contains(out.String(), "검색어: 주차") {
+		t.Errorf("Status line should be shown, got:\n%s", out.String())
+	}
+}
+
+func TestViewRawMode(t *testing.T) {
+	var calls int32
+	var out syncBuffer
+	ctx, cancel := context.WithCancel(context.Background())
+	v := &View{Fetch: countingFetcher(&calls), Interval: time.Hour, Out: &out, RawMode: true}
+
+	done := runView(t, v, ctx)
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+	waitDone(t, done)
+
+	screen := out.String()
+	if strings.Count(screen, "\n") != strings.Count(screen, "\r\n") {
+		t.Errorf("Every line feed should be preceded by a carriage return in raw mode:\n%q", screen)
+	}
+}
+
+func TestViewInvalidInterval(t *testing.T) {
+	v := &View{Fetch: countingFetcher(new(int32)), Out: &bytes.Buffer{}}
+	if err := v.Run(context.Background()); err == nil {
+		t.Error("Run() should fail without a refresh interval")
+	}
+}
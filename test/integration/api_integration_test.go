@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/pyhub-apps/pyhub-warp-cli/internal/api"
+	"github.com/pyhub-apps/pyhub-warp-cli/internal/security"
 	"github.com/pyhub-apps/pyhub-warp-cli/internal/testutil"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
@@ -170,10 +171,7 @@ func TestConfigurationIntegration(t *testing.T) {
 	// Test 4: Get masked API key
 	t.Run("GetMaskedAPIKey", func(t *testing.T) {
 		apiKey := viper.GetString("law.key")
-		if len(apiKey) > 4 {
-			masked := apiKey[:4] + "***"
-			assert.Equal(t, "TEST***", masked)
-		}
+		assert.Equal(t, "TE***(16자)", security.MaskSecret(apiKey))
 	})
 }
 